@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/project"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagBeadNewType        string
+	flagBeadNewPriority    int
+	flagBeadNewDescription string
+	flagBeadNewParent      string
+
+	flagBeadImportEpic         string
+	flagBeadImportSectionEpics bool
+	flagBeadImportType         string
+	flagBeadImportPriority     int
+	flagBeadImportIncludeDone  bool
+)
+
+var beadCmd = &cobra.Command{
+	Use:   "bead",
+	Short: "Manage beads (issues)",
+	Long:  `Manage beads, the issues co turns into work.`,
+}
+
+var beadNewCmd = &cobra.Command{
+	Use:   "new <title>",
+	Short: "Create a bead",
+	Long: `Create a bead with the given title.
+
+Unless --description is given, the description is the template configured for
+the bead's type under [beads.templates.<type>], with {{title}} and {{type}}
+filled in.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runBeadNew,
+}
+
+var beadImportCmd = &cobra.Command{
+	Use:   "import <file.md>",
+	Short: "Create beads from a markdown checklist",
+	Long: `Create a bead for each top-level item of a markdown list, such as the
+"- [ ] item" checklist of a planning doc. Use - to read from stdin.
+
+An item's text becomes the bead's title and everything indented below it, like
+nested sub-items, its description. Headings label the items under them, or
+with --section-epics become epics over them. --epic puts everything under a
+new epic. Checked items are skipped unless --include-done is given.
+
+With --dry-run the beads are listed without being created.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBeadImport,
+}
+
+func init() {
+	beadNewCmd.Flags().StringVar(&flagBeadNewType, "type", "task", "bead type (task, bug, feature, ...)")
+	beadNewCmd.Flags().IntVar(&flagBeadNewPriority, "priority", 2, "priority (0 = highest, 4 = lowest)")
+	beadNewCmd.Flags().StringVar(&flagBeadNewDescription, "description", "", "description (default: the type's template)")
+	beadNewCmd.Flags().StringVar(&flagBeadNewParent, "parent", "", "parent bead ID")
+	beadCmd.AddCommand(beadNewCmd)
+
+	beadImportCmd.Flags().StringVar(&flagBeadImportEpic, "epic", "", "create an epic with this title over all imported beads")
+	beadImportCmd.Flags().BoolVar(&flagBeadImportSectionEpics, "section-epics", false, "make each heading an epic instead of a label")
+	beadImportCmd.Flags().StringVar(&flagBeadImportType, "type", "task", "type of the beads created from items")
+	beadImportCmd.Flags().IntVar(&flagBeadImportPriority, "priority", 2, "priority (0 = highest, 4 = lowest)")
+	beadImportCmd.Flags().BoolVar(&flagBeadImportIncludeDone, "include-done", false, "also import checked items")
+	beadCmd.AddCommand(beadImportCmd)
+	rootCmd.AddCommand(beadCmd)
+}
+
+func runBeadNew(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+
+	title := strings.TrimSpace(strings.Join(args, " "))
+	if title == "" {
+		return fmt.Errorf("title is required")
+	}
+	if flagBeadNewPriority < 0 || flagBeadNewPriority > 4 {
+		return fmt.Errorf("priority must be between 0 and 4, got %d", flagBeadNewPriority)
+	}
+
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer proj.Close()
+
+	description := flagBeadNewDescription
+	if !cmd.Flags().Changed("description") {
+		description = proj.Config.Beads.DescriptionTemplate(flagBeadNewType, title)
+	}
+
+	beadID, err := beads.Create(ctx, proj.BeadsPath(), beads.CreateOptions{
+		Title:       title,
+		Type:        flagBeadNewType,
+		Priority:    flagBeadNewPriority,
+		Description: strings.TrimSpace(description),
+		Parent:      flagBeadNewParent,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created %s %s: %s\n", flagBeadNewType, beadID, title)
+	return nil
+}
+
+func runBeadImport(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+
+	if flagBeadImportPriority < 0 || flagBeadImportPriority > 4 {
+		return fmt.Errorf("priority must be between 0 and 4, got %d", flagBeadImportPriority)
+	}
+	var data []byte
+	var err error
+	if args[0] == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(args[0])
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read checklist: %w", err)
+	}
+
+	items := beads.ParseChecklist(string(data))
+	opts := beads.ChecklistImportOptions{
+		Type:         flagBeadImportType,
+		Priority:     flagBeadImportPriority,
+		Epic:         strings.TrimSpace(flagBeadImportEpic),
+		SectionEpics: flagBeadImportSectionEpics,
+		IncludeDone:  flagBeadImportIncludeDone,
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("no list items found in %s", args[0])
+	}
+
+	if flagGlobalDryRun {
+		if opts.Epic != "" {
+			fmt.Printf("Would create epic %q over:\n", opts.Epic)
+		} else {
+			fmt.Println("Would create:")
+		}
+		for _, item := range items {
+			if item.Done && !opts.IncludeDone {
+				continue
+			}
+			section := ""
+			if item.Section != "" {
+				section = " [" + item.Section + "]"
+			}
+			fmt.Printf("  - %s%s\n", item.Title, section)
+		}
+		return nil
+	}
+
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer proj.Close()
+
+	created, err := beads.ImportChecklist(ctx, beads.NewCLI(proj.BeadsPath()), items, opts)
+	if len(created) > 0 {
+		fmt.Printf("%-16s %-8s %-16s %s\n", "ID", "TYPE", "PARENT", "TITLE")
+		fmt.Printf("%-16s %-8s %-16s %s\n", "--", "----", "------", "-----")
+		for _, bead := range created {
+			parent := bead.Parent
+			if parent == "" {
+				parent = "-"
+			}
+			fmt.Printf("%-16s %-8s %-16s %s\n", bead.ID, bead.Type, parent, bead.Title)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("\nCreated %d bead(s)\n", len(created))
+	return nil
+}
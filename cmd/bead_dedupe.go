@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/work"
+	"github.com/spf13/cobra"
+)
+
+var flagDedupeThreshold float64
+
+var beadDedupeCmd = &cobra.Command{
+	Use:   "dedupe",
+	Short: "Find beads that look like duplicates and merge them",
+	Long: `Compare the titles of every open bead and list the pairs that look like the
+same issue: their words match regardless of case, punctuation and order.
+Pairs at or above --threshold (default: [beads] dedupe_threshold, or 0.85) are
+shown most alike first, and each is confirmed before it's merged:
+
+  y  merge the newer bead into the older one
+  s  merge the other way round
+  n  leave both
+  q  stop
+
+Merging appends the duplicate's description to the survivor's, moves its
+dependencies and dependents to the survivor, moves its work and task
+assignments over, and closes it with a "Duplicate of <id>" comment.
+
+With --dry-run the pairs are listed without asking.`,
+	Args: cobra.NoArgs,
+	RunE: runBeadDedupe,
+}
+
+func init() {
+	beadDedupeCmd.Flags().Float64Var(&flagDedupeThreshold, "threshold", 0, "title similarity from 0 to 1 at which beads are offered as duplicates")
+	beadCmd.AddCommand(beadDedupeCmd)
+}
+
+func runBeadDedupe(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer proj.Close()
+
+	threshold := proj.Config.Beads.GetDedupeThreshold()
+	if cmd.Flags().Changed("threshold") {
+		if flagDedupeThreshold <= 0 || flagDedupeThreshold > 1 {
+			return fmt.Errorf("--threshold must be above 0 and at most 1")
+		}
+		threshold = flagDedupeThreshold
+	}
+
+	all, err := proj.Beads.ListBeads(ctx, "")
+	if err != nil {
+		return fmt.Errorf("failed to list beads: %w", err)
+	}
+	var open []beads.Bead
+	for _, b := range all {
+		if b.Status != beads.StatusClosed {
+			open = append(open, b)
+		}
+	}
+
+	pairs := beads.FindDuplicates(open, threshold)
+	if len(pairs) == 0 {
+		fmt.Printf("No likely duplicates among %d open bead(s).\n", len(open))
+		return nil
+	}
+	fmt.Printf("Found %d likely duplicate pair(s).\n", len(pairs))
+
+	svc := work.NewWorkService(proj)
+	reader := bufio.NewReader(os.Stdin)
+	merged := make(map[string]bool)
+	count := 0
+	for _, pair := range pairs {
+		// A bead merged away earlier can't be merged again
+		if merged[pair.Survivor.ID] || merged[pair.Duplicate.ID] {
+			continue
+		}
+		fmt.Printf("\n%.0f%% alike:\n", pair.Score*100)
+		fmt.Printf("  %-12s %s\n", pair.Survivor.ID, pair.Survivor.Title)
+		fmt.Printf("  %-12s %s\n", pair.Duplicate.ID, pair.Duplicate.Title)
+		if flagGlobalDryRun {
+			continue
+		}
+
+		fmt.Printf("Merge %s into %s? [y]es / [s]wap / [n]o / [q]uit: ", pair.Duplicate.ID, pair.Survivor.ID)
+		response, _ := reader.ReadString('\n')
+		survivor, duplicate := pair.Survivor.ID, pair.Duplicate.ID
+		switch strings.TrimSpace(strings.ToLower(response)) {
+		case "y", "yes":
+		case "s", "swap":
+			survivor, duplicate = duplicate, survivor
+		case "q", "quit":
+			fmt.Printf("\nMerged %d duplicate(s).\n", count)
+			return nil
+		default:
+			continue
+		}
+
+		if err := svc.MergeDuplicateBeads(ctx, survivor, duplicate); err != nil {
+			return err
+		}
+		// Later pairs must see the dependencies as they are now
+		_ = proj.Beads.FlushCache(ctx)
+		merged[duplicate] = true
+		count++
+		fmt.Printf("Merged %s into %s\n", duplicate, survivor)
+	}
+	if !flagGlobalDryRun {
+		fmt.Printf("\nMerged %d duplicate(s).\n", count)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+var beadTriageCmd = &cobra.Command{
+	Use:   "triage",
+	Short: "Walk the open beads one at a time and decide what to do with each",
+	Long: `Open the TUI in triage: the open beads that aren't in a work are shown one at a
+time, in triage sort order (by priority, then bugs before tasks before features),
+with their full details.
+
+Each key settles the bead on screen and moves on to the next:
+
+  0-4  set the priority
+  a    add it to an existing work (picker)
+  w    create a work from it (the usual create work dialog)
+  c    close it
+  s    skip it
+  u    undo the last disposition
+  Esc  finish early
+
+A progress line shows how many beads have been triaged. When the queue is done
+or triage is finished, a summary lists what was done and the TUI stays open on
+the issues panel. Press T in the issues panel to triage again.`,
+	Args: cobra.NoArgs,
+	RunE: runBeadTriage,
+}
+
+func init() {
+	beadTriageCmd.Flags().BoolVar(&flagNoMouse, "no-mouse", false, "disable mouse support in the TUI")
+	beadTriageCmd.Flags().StringVar(&flagTheme, "theme", "", "TUI color theme: auto, dark, light or mono")
+	beadTriageCmd.Flags().StringVar(&flagIcons, "icons", "", "TUI status icons: unicode, ascii or nerd")
+	beadCmd.AddCommand(beadTriageCmd)
+}
+
+func runBeadTriage(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+	proj, err := project.FindLenient(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	theme, err := resolveTUITheme(proj)
+	if err != nil {
+		_ = proj.Close()
+		return err
+	}
+
+	// The TUI owns proj from here and closes it on exit
+	if err := tui.RunTriageTUI(ctx, proj, theme, !flagNoMouse, false); err != nil {
+		return fmt.Errorf("error running TUI: %w", err)
+	}
+	return nil
+}
@@ -41,6 +41,17 @@ func runComplete(cmd *cobra.Command, args []string) error {
 	ctx := GetContext()
 	id := args[0]
 
+	forwarded := []string{"complete", id}
+	if flagCompletePRURL != "" {
+		forwarded = append(forwarded, "--pr", flagCompletePRURL)
+	}
+	if flagCompleteError != "" {
+		forwarded = append(forwarded, "--error", flagCompleteError)
+	}
+	if ok, err := forwardReport(forwarded); ok {
+		return err
+	}
+
 	proj, err := project.Find(ctx, flagCompleteProject)
 	if err != nil {
 		return fmt.Errorf("not in a project directory: %w", err)
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/newhook/co/internal/project"
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Work with the project's config.toml",
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate [path]",
+	Short: "Check config.toml against the schema",
+	Long: `Check a config.toml against the schema: every known key must have the right
+type, and keys under known sections must exist. Unknown keys come with a
+suggestion when they look like a typo. All problems are listed at once, with
+the line each one is on.
+
+Without a path, the .co/config.toml of the project containing the current
+directory is checked. The project itself isn't opened, so this works in CI
+without a tracking database. It exits non-zero when there are problems.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runConfigValidate,
+}
+
+func init() {
+	configCmd.AddCommand(configValidateCmd)
+	rootCmd.AddCommand(configCmd)
+}
+
+func runConfigValidate(cmd *cobra.Command, args []string) error {
+	var path string
+	if len(args) > 0 {
+		path = args[0]
+	} else {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		root, err := project.FindRoot(cwd)
+		if err != nil {
+			return err
+		}
+		path = filepath.Join(root, project.ConfigDir, project.ConfigFile)
+	}
+
+	_, err := project.ValidateConfig(path)
+	var cfgErr *project.ConfigError
+	if errors.As(err, &cfgErr) {
+		return fmt.Errorf("%s: %w", path, cfgErr)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s: OK\n", path)
+	return nil
+}
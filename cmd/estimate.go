@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/newhook/co/internal/db"
@@ -39,14 +40,16 @@ func runEstimate(cmd *cobra.Command, args []string) error {
 	ctx := GetContext()
 	beadID := args[0]
 
-	// Validate score range
-	if flagEstimateScore < 1 || flagEstimateScore > 10 {
-		return fmt.Errorf("score must be between 1 and 10, got %d", flagEstimateScore)
+	if err := db.ValidateComplexity(flagEstimateScore, flagEstimateTokens); err != nil {
+		return err
 	}
 
-	// Validate tokens range (context window is 200K, allow up to 150K per task)
-	if flagEstimateTokens < 5000 || flagEstimateTokens > 150000 {
-		return fmt.Errorf("tokens must be between 5000 and 150000, got %d", flagEstimateTokens)
+	forwarded := []string{"estimate", beadID, "--score", strconv.Itoa(flagEstimateScore), "--tokens", strconv.Itoa(flagEstimateTokens)}
+	if flagEstimateTask != "" {
+		forwarded = append(forwarded, "--task", flagEstimateTask)
+	}
+	if ok, err := forwardReport(forwarded); ok {
+		return err
 	}
 
 	// Find project
@@ -65,10 +68,8 @@ func runEstimate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("bead %s not found", beadID)
 	}
 
-	// Compute description hash
-	// Combine title and description as that's what affects complexity
-	fullDescription := bead.Title + "\n" + bead.Description
-	descHash := db.HashDescription(fullDescription)
+	// Title and description are what affect complexity
+	descHash := db.HashBead(bead.Title, bead.Description)
 
 	// Store estimate in complexity cache
 	if err := proj.DB.CacheComplexity(ctx, beadID, descHash, flagEstimateScore, flagEstimateTokens); err != nil {
@@ -102,7 +103,7 @@ func runEstimate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get task: %w", err)
 	}
 
-	if task != nil && task.TaskType == "estimate" {
+	if task != nil && task.TaskType == db.TaskTypeEstimate {
 		// Get all beads in the task
 		taskBeadIDs, err := proj.DB.GetTaskBeads(ctx, taskID)
 		if err != nil {
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/newhook/co/internal/doctor"
+	"github.com/newhook/co/internal/project"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagInitDir string
+	flagInitYes bool
+)
+
+var initCmd = &cobra.Command{
+	Use:   "init [<repo>]",
+	Short: "Set up a new project, asking for its settings",
+	Long: `Set up a new project for a repository with a short series of questions.
+
+Checks the tools co needs, then asks for the project directory, name, base
+branch and the environment variables Claude sessions get, and creates the
+project like 'co proj create': a documented .co/config.toml, the tracking
+database and beads.
+
+The repo can be a local path (default: the current directory) or a GitHub URL.
+With --yes every question takes its default, for scripts and CI.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runInit,
+}
+
+func init() {
+	initCmd.Flags().StringVar(&flagInitDir, "dir", "", "project directory (default: <repo>-co next to a local repo)")
+	initCmd.Flags().BoolVarP(&flagInitYes, "yes", "y", false, "accept the defaults without asking")
+	rootCmd.AddCommand(initCmd)
+}
+
+// initPrompter asks the init questions, or takes every default with --yes
+type initPrompter struct {
+	in  *bufio.Reader
+	out io.Writer
+	yes bool
+}
+
+// ask prints a question with its default and returns the answer, or the
+// default when the answer is empty
+func (p *initPrompter) ask(question, def string) string {
+	if def != "" {
+		fmt.Fprintf(p.out, "%s [%s]: ", question, def)
+	} else {
+		fmt.Fprintf(p.out, "%s: ", question)
+	}
+	if p.yes {
+		fmt.Fprintln(p.out)
+		return def
+	}
+	answer, _ := p.in.ReadString('\n')
+	if answer = strings.TrimSpace(answer); answer != "" {
+		return answer
+	}
+	return def
+}
+
+func runInit(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+	p := &initPrompter{in: bufio.NewReader(os.Stdin), out: os.Stdout, yes: flagInitYes}
+
+	fmt.Println("Checking tools...")
+	results := doctor.CheckTools()
+	doctor.Report(os.Stdout, results)
+	if missing := doctor.Missing(results); len(missing) > 0 {
+		names := make([]string, len(missing))
+		for i, tool := range missing {
+			names[i] = tool.Name
+		}
+		return fmt.Errorf("install %s before creating a project", strings.Join(names, ", "))
+	}
+	fmt.Println()
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	repo := cwd
+	if len(args) > 0 {
+		repo = args[0]
+	} else {
+		repo = p.ask("Repository (local path or GitHub URL)", repo)
+	}
+
+	dir := flagInitDir
+	if dir == "" {
+		dir = p.ask("Project directory (works get their worktrees here)", defaultInitDir(repo, cwd))
+	}
+	if _, err := os.Stat(filepath.Join(dir, project.ConfigDir)); err == nil {
+		return fmt.Errorf("project already exists at %s", dir)
+	}
+
+	opts := project.CreateOptions{
+		Name: p.ask("Project name", filepath.Base(filepath.Clean(dir))),
+	}
+	if branch := p.ask("Base branch", "main"); branch != "main" {
+		opts.BaseBranch = branch
+	}
+	for {
+		opts.HooksEnv, err = parseHooksEnv(p.ask("Environment for Claude sessions (KEY=value, comma-separated)", ""))
+		if err == nil {
+			break
+		}
+		if p.yes {
+			return err
+		}
+		fmt.Println(err)
+	}
+
+	bdFound := false
+	for _, r := range results {
+		if r.Tool.Name == "bd" {
+			bdFound = r.Found()
+		}
+	}
+	if bdFound {
+		fmt.Println("Beads: the repo's .beads is used if it has one, otherwise issues are kept in the project.")
+	} else {
+		fmt.Println("Beads: bd isn't in PATH yet; mise installs it while the project is created.")
+	}
+
+	if !p.yes {
+		answer := strings.ToLower(p.ask(fmt.Sprintf("Create project %q at %s?", opts.Name, dir), "Y"))
+		if answer != "y" && answer != "yes" {
+			fmt.Println("Aborted.")
+			return nil
+		}
+	}
+
+	fmt.Printf("\nCreating project at %s from %s...\n", dir, repo)
+	proj, err := project.Create(ctx, dir, repo, opts)
+	if err != nil {
+		return fmt.Errorf("failed to create project: %w", err)
+	}
+	defer proj.Close()
+
+	fmt.Printf("Project '%s' created.\n", proj.Config.Project.Name)
+	fmt.Printf("  Config: %s\n", filepath.Join(proj.Root, project.ConfigDir, project.ConfigFile))
+	fmt.Printf("\nNext: cd %s && co tui\n", proj.Root)
+	return nil
+}
+
+// defaultInitDir suggests a project directory for a repo: "<repo>-co" next to
+// a local repo, or a directory named after a GitHub repo in cwd.
+func defaultInitDir(repo, cwd string) string {
+	repo = strings.TrimSuffix(repo, "/")
+	if info, err := os.Stat(repo); err == nil && info.IsDir() {
+		abs, err := filepath.Abs(repo)
+		if err == nil {
+			repo = abs
+		}
+		return filepath.Join(filepath.Dir(repo), filepath.Base(repo)+"-co")
+	}
+	name := strings.TrimSuffix(path.Base(repo), ".git")
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		name = name[i+1:]
+	}
+	return filepath.Join(cwd, name)
+}
+
+// parseHooksEnv splits a comma-separated list of KEY=value pairs
+func parseHooksEnv(s string) ([]string, error) {
+	var env []string
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, _, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(key) == "" || strings.ContainsAny(key, " \t") {
+			return nil, fmt.Errorf("invalid environment variable %q: expected KEY=value", pair)
+		}
+		env = append(env, pair)
+	}
+	return env, nil
+}
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHooksEnv(t *testing.T) {
+	env, err := parseHooksEnv(" A=1, B=two words ,,C=")
+	require.NoError(t, err)
+	require.Equal(t, []string{"A=1", "B=two words", "C="}, env)
+
+	env, err = parseHooksEnv("")
+	require.NoError(t, err)
+	require.Empty(t, env)
+
+	_, err = parseHooksEnv("A=1,B")
+	require.Error(t, err)
+	_, err = parseHooksEnv("=1")
+	require.Error(t, err)
+}
+
+func TestDefaultInitDir(t *testing.T) {
+	parent := t.TempDir()
+	repo := filepath.Join(parent, "myrepo")
+	require.NoError(t, os.Mkdir(repo, 0755))
+
+	require.Equal(t, filepath.Join(parent, "myrepo-co"), defaultInitDir(repo+"/", "/cwd"))
+	require.Equal(t, filepath.Join("/cwd", "services"), defaultInitDir("https://github.com/org/services.git", "/cwd"))
+	require.Equal(t, filepath.Join("/cwd", "services"), defaultInitDir("git@github.com:org/services.git", "/cwd"))
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"database/sql"
 	"fmt"
 
 	"github.com/newhook/co/internal/db"
@@ -55,8 +56,13 @@ func runMigrateStatus(cmd *cobra.Command, args []string) error {
 	}
 	defer proj.Close()
 
+	sqlDB, err := trackingSQLDB(proj)
+	if err != nil {
+		return err
+	}
+
 	// Get migration status
-	versions, err := db.MigrationStatusContext(ctx, proj.DB.DB)
+	versions, err := db.MigrationStatusContext(ctx, sqlDB)
 	if err != nil {
 		return fmt.Errorf("failed to get migration status: %w", err)
 	}
@@ -84,8 +90,13 @@ func runMigrateUp(cmd *cobra.Command, args []string) error {
 	}
 	defer proj.Close()
 
+	sqlDB, err := trackingSQLDB(proj)
+	if err != nil {
+		return err
+	}
+
 	// Run migrations (they're already run on project open, but we can run again to ensure latest)
-	if err := db.RunMigrations(ctx, proj.DB.DB); err != nil {
+	if err := db.RunMigrations(ctx, sqlDB); err != nil {
 		return fmt.Errorf("failed to run migrations: %w", err)
 	}
 
@@ -103,11 +114,26 @@ func runMigrateRollback(cmd *cobra.Command, args []string) error {
 	}
 	defer proj.Close()
 
+	sqlDB, err := trackingSQLDB(proj)
+	if err != nil {
+		return err
+	}
+
 	// Rollback last migration
-	if err := db.RollbackMigration(ctx, proj.DB.DB); err != nil {
+	if err := db.RollbackMigration(ctx, sqlDB); err != nil {
 		return fmt.Errorf("failed to rollback migration: %w", err)
 	}
 
 	fmt.Println("Migration rolled back successfully.")
 	return nil
 }
+
+// trackingSQLDB returns the SQLite connection behind the project's tracking
+// database. Migrations work on the schema itself, below the db.Store interface.
+func trackingSQLDB(proj *project.Project) (*sql.DB, error) {
+	database, ok := proj.DB.(*db.DB)
+	if !ok {
+		return nil, fmt.Errorf("tracking database does not support migrations")
+	}
+	return database.DB, nil
+}
@@ -5,37 +5,54 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/newhook/co/internal/beads"
 	"github.com/newhook/co/internal/claude"
 	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/feedback"
+	"github.com/newhook/co/internal/github"
+	"github.com/newhook/co/internal/logging"
 	"github.com/newhook/co/internal/orchestration"
 	"github.com/newhook/co/internal/procmon"
 	"github.com/newhook/co/internal/project"
 	"github.com/newhook/co/internal/task"
-	"github.com/newhook/co/internal/work"
+	workpkg "github.com/newhook/co/internal/work"
+	"github.com/newhook/co/internal/worktree"
 	"github.com/spf13/cobra"
 )
 
 var (
-	flagOrchestrateWork string
+	flagOrchestrateWork      string
+	flagOrchestrateTask      string
+	flagOrchestrateClaimedBy string
 )
 
+// taskSpawnTimeout is how long a task spawned in its own tab may stay pending
+// before the orchestrator assumes the tab never started and releases its claim
+const taskSpawnTimeout = 2 * time.Minute
+
 var orchestrateCmd = &cobra.Command{
 	Use:   "orchestrate",
 	Short: "[Agent] Execute tasks for a work unit",
 	Long: `[Agent Command - Spawned automatically by the system, not for direct user invocation]
 
 Internal command that polls for ready tasks and executes them. Runs in a zellij tab
-and is spawned automatically when a work unit is created or restarted.`,
+and is spawned automatically when a work unit is created or restarted.
+
+With --task, runs only that task and exits. The orchestrator spawns these in
+their own tabs when the work runs more than one task at a time, and the TUI
+spawns one to run a selected task right away. Errors land in the task's error
+message.`,
 	Hidden: true,
 	RunE:   runOrchestrate,
 }
 
 func init() {
 	orchestrateCmd.Flags().StringVar(&flagOrchestrateWork, "work", "", "work ID to orchestrate")
+	orchestrateCmd.Flags().StringVar(&flagOrchestrateTask, "task", "", "run only this task, then exit")
+	orchestrateCmd.Flags().StringVar(&flagOrchestrateClaimedBy, "claimed-by", "", "claimant to take the --task claim over from")
 }
 
 func runOrchestrate(cmd *cobra.Command, args []string) error {
@@ -68,6 +85,22 @@ func runOrchestrate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("theWork %s not found", workID)
 	}
 
+	// The work's env overrides win over hooks.env, which was applied above
+	applyHooksEnv(theWork.Env)
+
+	if flagOrchestrateTask != "" {
+		return runOrchestrateTask(ctx, proj, theWork, flagOrchestrateTask, flagOrchestrateClaimedBy)
+	}
+
+	// Output also goes to a log file, which the TUI tails in its log pane
+	runner := claude.NewRunner()
+	if terminal, stopLog, err := startOrchestratorLog(proj.OrchestratorLogPath(workID)); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: not writing the orchestrator log: %v\n", err)
+	} else {
+		defer stopLog()
+		runner = claude.NewTerminalRunner(terminal)
+	}
+
 	fmt.Printf("=== Orchestrating theWork: %s ===\n", workID)
 	fmt.Printf("Worktree: %s\n", theWork.WorktreePath)
 	fmt.Printf("Branch: %s (base: %s)\n", theWork.BranchName, theWork.BaseBranch)
@@ -87,8 +120,8 @@ func runOrchestrate(cmd *cobra.Command, args []string) error {
 			fmt.Println("\nSetting up automated workflow...")
 
 			// Create estimate task from unassigned theWork beads (post-estimation will create implement tasks)
-			workSvc := work.NewWorkService(proj)
-			err := workSvc.CreateEstimateTaskFromWorkBeads(ctx, workID, os.Stdout)
+			workSvc := workpkg.NewWorkService(proj)
+			_, err := workSvc.CreateEstimateTaskFromWorkBeads(ctx, workID, os.Stdout)
 			if err != nil {
 				return fmt.Errorf("failed to create estimate task: %w", err)
 			}
@@ -105,6 +138,9 @@ func runOrchestrate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to reset stuck tasks: %w", err)
 	}
 
+	// A previous orchestrator may have died before it updated the work's status
+	reconcileWorkStatus(ctx, proj, theWork)
+
 	// Register this orchestrator process for heartbeat monitoring
 	procManager := procmon.NewManager(proj.DB, db.DefaultHeartbeatInterval)
 	if err := procManager.RegisterOrchestrator(ctx, workID); err != nil {
@@ -117,11 +153,18 @@ func runOrchestrate(cmd *cobra.Command, args []string) error {
 	// git push retries, PR feedback polling, etc. This allows scheduled tasks
 	// to be processed even when no orchestrator is running for a theWork.
 
-	// Create runner once for all tasks
-	runner := claude.NewRunner()
+	// Tasks are claimed before they run, so two processes never start the same one
+	claimant := db.TaskClaimant()
+	// Tasks spawned in their own tab that haven't started yet, by spawn time
+	spawned := make(map[string]time.Time)
+	// Required checks of the work's PR, looked up at most once a minute
+	prChecks := github.NewChecksCache(github.NewClient(), time.Minute)
 
-	// Main orchestration loop: poll for ready tasks and execute them
+	// Main orchestration loop: poll for ready tasks and execute them. The
+	// heartbeat is only kept up while the loop comes round, so a wedged
+	// loop shows as a stale orchestrator rather than a running one.
 	for {
+		procManager.Progress(procmon.ProgressWindow)
 
 		// Check if theWork still exists (may have been destroyed)
 		theWork, err = proj.DB.GetWork(ctx, workID)
@@ -133,19 +176,26 @@ func runOrchestrate(cmd *cobra.Command, args []string) error {
 			return nil
 		}
 
-		// Get ready tasks (pending with all dependencies completed)
-		readyTasks, err := proj.DB.GetReadyTasksForWork(ctx, workID)
+		// A paused work doesn't start new tasks; the previous task already ran to completion
+		if theWork.Paused {
+			orchestration.SpinnerWait("Paused: not starting new tasks. Run 'co work resume' to continue.", 5*time.Second)
+			continue
+		}
+
+		allTasks, err := proj.DB.GetWorkTasks(ctx, workID)
+		if err != nil {
+			return fmt.Errorf("failed to get theWork tasks: %w", err)
+		}
+		taskDeps, err := proj.DB.GetTaskDependenciesForWork(ctx, workID)
 		if err != nil {
-			return fmt.Errorf("failed to get ready tasks: %w", err)
+			return fmt.Errorf("failed to get task dependencies: %w", err)
 		}
 
+		// Get ready tasks (pending with all dependencies completed)
+		readyTasks := orchestration.ReadyTasks(allTasks, taskDeps)
+
 		if len(readyTasks) == 0 {
 			// No ready tasks - check if we're done or blocked
-			allTasks, err := proj.DB.GetWorkTasks(ctx, workID)
-			if err != nil {
-				return fmt.Errorf("failed to get theWork tasks: %w", err)
-			}
-
 			pendingCount := 0
 			processingCount := 0
 			failedCount := 0
@@ -166,6 +216,7 @@ func runOrchestrate(cmd *cobra.Command, args []string) error {
 
 			// If tasks are processing, wait and retry
 			if processingCount > 0 {
+				reconcileWorkStatus(ctx, proj, theWork)
 				msg := fmt.Sprintf("Waiting for %d processing task(s)...", processingCount)
 				orchestration.SpinnerWait(msg, 5*time.Second)
 				continue
@@ -195,13 +246,24 @@ func runOrchestrate(cmd *cobra.Command, args []string) error {
 				continue
 			}
 
+			// All tasks completed - with auto_pr, open the PR before going idle
+			if completedCount > 0 && orchestration.AutoPR(proj.Config, theWork) {
+				prTaskID, err := orchestration.CreateAutoPRTask(ctx, proj.DB, theWork, allTasks)
+				if err != nil {
+					fmt.Printf("Warning: failed to create PR task: %v\n", err)
+				} else if prTaskID != "" {
+					fmt.Printf("\nAll tasks completed. Created PR task %s (auto_pr)\n", prTaskID)
+					continue
+				}
+			}
+
 			// All tasks completed - transition theWork to idle status (waiting for more tasks)
 			if completedCount > 0 && theWork.Status != db.StatusIdle {
 				// Find PR URL from the PR task (if one exists, and we don't already have one)
 				prURL := theWork.PRURL // Start with existing PR URL
 				if prURL == "" {
 					for _, t := range allTasks {
-						if t.TaskType == "pr" && t.Status == db.StatusCompleted && t.PRURL != "" {
+						if t.TaskType == db.TaskTypePR && t.Status == db.StatusCompleted && t.PRURL != "" {
 							prURL = t.PRURL
 							break
 						}
@@ -233,8 +295,31 @@ func runOrchestrate(cmd *cobra.Command, args []string) error {
 			continue
 		}
 
-		// Execute the first ready task
-		task := readyTasks[0]
+		// PR tasks wait for failing required checks to pass
+		readyTasks, failingChecks := orchestration.HoldPRTasks(ctx, proj.Config, prChecks, theWork, readyTasks)
+		if len(readyTasks) == 0 {
+			msg := fmt.Sprintf("Waiting: PR task(s) held while required checks fail: %s", strings.Join(failingChecks, ", "))
+			orchestration.SpinnerWait(msg, 10*time.Second)
+			continue
+		}
+
+		if limit := orchestration.MaxParallelTasks(proj.Config, theWork); limit > 1 {
+			if err := runParallelTasks(ctx, proj, theWork, readyTasks, claimant, limit, spawned); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Execute the first ready task no other process has claimed
+		claimed, err := orchestration.ClaimReadyTasks(ctx, proj.DB, readyTasks, claimant, 1)
+		if err != nil {
+			return fmt.Errorf("failed to claim task: %w", err)
+		}
+		if len(claimed) == 0 {
+			orchestration.SpinnerWait("Waiting: ready task(s) are claimed by another process...", 5*time.Second)
+			continue
+		}
+		task := claimed[0]
 		fmt.Printf("\n=== Executing task: %s (type: %s) ===\n", task.ID, task.TaskType)
 
 		// Update activity when starting execution
@@ -242,12 +327,137 @@ func runOrchestrate(cmd *cobra.Command, args []string) error {
 			fmt.Printf("Warning: failed to update task activity at start: %v\n", err)
 		}
 
-		if err := executeTask(proj, task, theWork, runner); err != nil {
+		// A task runs inline for up to its timeout before the loop comes round
+		// again. The heartbeat row shows which task a wedged orchestrator was
+		// stuck on.
+		procManager.Progress(proj.Config.Claude.GetTaskTimeout() + procmon.ProgressWindow)
+		if err := procManager.SetCurrentTask(ctx, task.ID); err != nil {
+			fmt.Printf("Warning: failed to record current task: %v\n", err)
+		}
+		err = executeTask(proj, task, theWork, runner)
+		if clearErr := procManager.SetCurrentTask(ctx, ""); clearErr != nil {
+			fmt.Printf("Warning: failed to clear current task: %v\n", clearErr)
+		}
+		if err != nil {
 			return fmt.Errorf("task %s failed: %w", task.ID, err)
 		}
 	}
 }
 
+// runParallelTasks starts ready tasks in their own tabs until limit tasks of
+// the work are running, then waits a polling interval.
+func runParallelTasks(ctx context.Context, proj *project.Project, theWork *db.Work, readyTasks []*db.Task, claimant string, limit int, spawned map[string]time.Time) error {
+	// A task whose tab died, or that outlived its own timeout, gives its slot
+	// back; it becomes ready again on the next pass
+	staleAfter := proj.Config.Claude.GetTaskTimeout() + taskSpawnTimeout
+	if _, err := orchestration.ReclaimStaleTasks(ctx, proj, theWork.ID, staleAfter); err != nil {
+		return fmt.Errorf("failed to reclaim stale tasks: %w", err)
+	}
+
+	allTasks, err := proj.DB.GetWorkTasks(ctx, theWork.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get work tasks: %w", err)
+	}
+	status := make(map[string]string, len(allTasks))
+	var running []string
+	for _, t := range allTasks {
+		status[t.ID] = t.Status
+		if t.Status == db.StatusProcessing {
+			running = append(running, t.ID)
+		}
+	}
+
+	// A spawned task counts against the limit until it starts; one that never
+	// starts has its claim released so it can be spawned again
+	for id, at := range spawned {
+		if status[id] != db.StatusPending {
+			delete(spawned, id)
+			continue
+		}
+		if time.Since(at) > taskSpawnTimeout {
+			fmt.Printf("Task %s did not start within %v, releasing it\n", id, taskSpawnTimeout)
+			if err := proj.DB.ResetTaskStatus(ctx, id); err != nil {
+				return fmt.Errorf("failed to release claim on task %s: %w", id, err)
+			}
+			delete(spawned, id)
+			continue
+		}
+		running = append(running, id)
+	}
+
+	if slots := limit - len(running); slots > 0 {
+		claimed, err := orchestration.ClaimReadyTasks(ctx, proj.DB, readyTasks, claimant, slots)
+		if err != nil {
+			return fmt.Errorf("failed to claim tasks: %w", err)
+		}
+		manager := workpkg.NewOrchestratorManager(proj.DB)
+		for _, t := range claimed {
+			fmt.Printf("\n=== Starting task: %s (type: %s) ===\n", t.ID, t.TaskType)
+			if err := manager.SpawnTaskSession(ctx, theWork.ID, t.ID, proj.Config.Project.Name, theWork.SessionDir(proj.Root), claimant, os.Stdout); err != nil {
+				fmt.Printf("Warning: failed to start task %s: %v\n", t.ID, err)
+				if err := proj.DB.ResetTaskStatus(ctx, t.ID); err != nil {
+					return fmt.Errorf("failed to release claim on task %s: %w", t.ID, err)
+				}
+				continue
+			}
+			spawned[t.ID] = time.Now()
+			running = append(running, t.ID)
+		}
+	}
+
+	msg := fmt.Sprintf("Running %d/%d task(s): %s", len(running), limit, strings.Join(running, ", "))
+	orchestration.SpinnerWait(msg, 5*time.Second)
+	return nil
+}
+
+// runOrchestrateTask runs a single task of a work in this process, taking
+// over the claim the spawning orchestrator holds on it.
+func runOrchestrateTask(ctx context.Context, proj *project.Project, theWork *db.Work, taskID, claimedBy string) error {
+	t, err := proj.DB.GetTask(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+	if t == nil || t.WorkID != theWork.ID {
+		return fmt.Errorf("task %s not found in work %s", taskID, theWork.ID)
+	}
+
+	ok, err := proj.DB.ClaimTask(ctx, taskID, db.TaskClaimant(), claimedBy)
+	if err != nil {
+		return fmt.Errorf("failed to claim task: %w", err)
+	}
+	if !ok {
+		return fmt.Errorf("task %s is no longer pending or was claimed by another process", taskID)
+	}
+
+	fmt.Printf("=== Executing task: %s (type: %s) ===\n", t.ID, t.TaskType)
+	fmt.Printf("Work: %s\n", theWork.ID)
+	fmt.Printf("Worktree: %s\n", theWork.WorktreePath)
+
+	if err := proj.DB.UpdateTaskActivity(ctx, t.ID, time.Now()); err != nil {
+		fmt.Printf("Warning: failed to update task activity at start: %v\n", err)
+	}
+	if err := executeTask(proj, t, theWork, claude.NewRunner()); err != nil {
+		recordTaskError(proj, t.ID, err)
+		return fmt.Errorf("task %s failed: %w", t.ID, err)
+	}
+	return nil
+}
+
+// recordTaskError fails a task with err unless the run already finished it, so
+// errors the runner doesn't record (a bad prompt, a crashed session) still
+// land in the task's error message.
+func recordTaskError(proj *project.Project, taskID string, err error) {
+	// Use context.Background() since the task context may be cancelled
+	ctx := context.Background()
+	t, getErr := proj.DB.GetTask(ctx, taskID)
+	if getErr != nil || t == nil || (t.Status != db.StatusPending && t.Status != db.StatusProcessing) {
+		return
+	}
+	if dbErr := proj.DB.FailTaskWithKind(ctx, taskID, claude.FailureKind(err, ""), err.Error()); dbErr != nil {
+		fmt.Printf("Warning: failed to record task error: %v\n", dbErr)
+	}
+}
+
 // executeTask executes a single task inline based on its type.
 func executeTask(proj *project.Project, t *db.Task, work *db.Work, runner claude.Runner) error {
 	ctx := GetContext()
@@ -259,33 +469,59 @@ func executeTask(proj *project.Project, t *db.Task, work *db.Work, runner claude
 
 	fmt.Printf("Task timeout: %v\n", timeout)
 
+	// Give the task a directory for the files it produces (review.md, ...);
+	// Claude inherits the environment variable naming it
+	artifactDir := proj.TaskArtifactDir(work.ID, t.ID)
+	if err := os.MkdirAll(artifactDir, 0755); err != nil {
+		return fmt.Errorf("failed to create artifact directory: %w", err)
+	}
+	_ = os.Setenv(project.ArtifactDirEnv, artifactDir)
+
+	// Commits Claude makes carry the [git] bot identity and, through the
+	// prepare-commit-msg hook, the work and task they came from
+	for _, entry := range proj.Config.Git.CommitEnv(work.ID, t.ID) {
+		key, value, _ := strings.Cut(entry, "=")
+		_ = os.Setenv(key, value)
+	}
+
 	// Build prompt for Claude based on task type
 	prompt, err := buildPromptForTask(taskCtx, proj, t, work)
 	if err != nil {
 		return err
 	}
 
+	// Where the task started from, to check its commits against afterwards
+	base := ""
+	if !work.IsRemote() {
+		base = worktree.HeadCommit(taskCtx, work.WorktreePath)
+	}
+
 	// Execute Claude inline with timeout context
 	if err = runner.Run(taskCtx, proj.DB, t.ID, prompt, work.WorktreePath, proj.Config); err != nil {
 		// Check if it was a timeout error
 		if errors.Is(err, context.DeadlineExceeded) {
 			// Mark the task as failed due to timeout
 			// Use context.Background() since the original context is cancelled
-			if dbErr := proj.DB.FailTask(context.Background(), t.ID, fmt.Sprintf("Task timed out after %v", timeout)); dbErr != nil {
+			if dbErr := proj.DB.FailTaskWithKind(context.Background(), t.ID, db.FailureTimeout, fmt.Sprintf("Task timed out after %v", timeout)); dbErr != nil {
 				fmt.Printf("Warning: failed to mark timed out task as failed: %v\n", dbErr)
 			}
 			return fmt.Errorf("task %s timed out after %v", t.ID, timeout)
 		}
 		return err
 	}
+	if !work.IsRemote() {
+		if err := checkManagedChanges(taskCtx, proj, t, work, base); err != nil {
+			return err
+		}
+	}
 
 	// Post-execution handling based on task type
 	switch t.TaskType {
-	case "estimate":
+	case db.TaskTypeEstimate:
 		if err := handlePostEstimation(proj, t, work); err != nil {
 			return fmt.Errorf("failed to create post-estimation tasks: %w", err)
 		}
-	case "review":
+	case db.TaskTypeReview:
 		if err := handleReviewFixLoop(proj, t, work); err != nil {
 			return fmt.Errorf("failed to handle review completion: %w", err)
 		}
@@ -294,6 +530,25 @@ func executeTask(proj *project.Project, t *db.Task, work *db.Work, runner claude
 	return nil
 }
 
+// checkManagedChanges fails a task that committed or left staged co-managed
+// files, which the pre-commit hook should have refused, naming them in the
+// task's error.
+func checkManagedChanges(ctx context.Context, proj *project.Project, t *db.Task, work *db.Work, base string) error {
+	paths, err := worktree.ManagedChanges(ctx, work.WorktreePath, base)
+	if err != nil {
+		fmt.Printf("Warning: failed to check the task's commits for co-managed files: %v\n", err)
+		return nil
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	msg := fmt.Sprintf("task committed co-managed files, remove them from the branch: %s", strings.Join(paths, ", "))
+	if err := proj.DB.FailTaskWithKind(context.WithoutCancel(ctx), t.ID, db.FailureTaskError, msg); err != nil {
+		fmt.Printf("Warning: failed to record task error: %v\n", err)
+	}
+	return errors.New(msg)
+}
+
 // handlePostEstimation creates implement, review, and PR tasks after estimation completes.
 // Uses bin-packing to group beads based on their complexity estimates.
 func handlePostEstimation(proj *project.Project, estimateTask *db.Task, work *db.Work) error {
@@ -343,21 +598,23 @@ func handlePostEstimation(proj *project.Project, estimateTask *db.Task, work *db
 		return fmt.Errorf("failed to plan tasks: %w", err)
 	}
 
-	if len(tasks) == 0 {
+	plan := workpkg.NewPlanResult(work.ID, tasks, issuesResult.Beads)
+	if len(plan.Groups) == 0 {
 		return fmt.Errorf("planner returned no tasks for %d beads", len(beadIDs))
 	}
 
 	// Create implement tasks and track beadID → taskID mapping
 	var implementTaskIDs []string
 	beadToTask := make(map[string]string) // beadID → taskID
-	for _, t := range tasks {
+	for _, group := range plan.Groups {
 		nextNum, err := proj.DB.GetNextTaskNumber(ctx, work.ID)
 		if err != nil {
 			return fmt.Errorf("failed to get next task number: %w", err)
 		}
 		taskID := fmt.Sprintf("%s.%d", work.ID, nextNum)
+		groupBeadIDs := group.BeadIDs()
 
-		if err := proj.DB.CreateTask(ctx, taskID, "implement", t.BeadIDs, t.Complexity, work.ID); err != nil {
+		if err := proj.DB.CreateTask(ctx, taskID, db.TaskTypeImplement, groupBeadIDs, group.Complexity(), work.ID); err != nil {
 			return fmt.Errorf("failed to create implement task: %w", err)
 		}
 
@@ -367,13 +624,13 @@ func handlePostEstimation(proj *project.Project, estimateTask *db.Task, work *db
 		}
 
 		// Track which task each bead is in
-		for _, beadID := range t.BeadIDs {
+		for _, beadID := range groupBeadIDs {
 			beadToTask[beadID] = taskID
 		}
 
 		implementTaskIDs = append(implementTaskIDs, taskID)
 		fmt.Printf("Created implement task %s (complexity: %d) with %d bead(s): %v\n",
-			taskID, t.Complexity, len(t.BeadIDs), t.BeadIDs)
+			taskID, group.Complexity(), len(groupBeadIDs), groupBeadIDs)
 	}
 
 	// Compute inter-task dependencies from bead dependencies.
@@ -417,7 +674,7 @@ func handlePostEstimation(proj *project.Project, estimateTask *db.Task, work *db
 		return fmt.Errorf("failed to get next task number for review: %w", err)
 	}
 	reviewTaskID := fmt.Sprintf("%s.%d", work.ID, reviewTaskNum)
-	if err := proj.DB.CreateTask(ctx, reviewTaskID, "review", nil, 0, work.ID); err != nil {
+	if err := proj.DB.CreateTask(ctx, reviewTaskID, db.TaskTypeReview, nil, 0, work.ID); err != nil {
 		return fmt.Errorf("failed to create review task: %w", err)
 	}
 	for _, implID := range implementTaskIDs {
@@ -521,7 +778,7 @@ func handleReviewFixLoop(proj *project.Project, reviewTask *db.Task, work *db.Wo
 		}
 		taskID := fmt.Sprintf("%s.%d", work.ID, nextNum)
 
-		if err := proj.DB.CreateTask(ctx, taskID, "implement", []string{b.ID}, 0, work.ID); err != nil {
+		if err := proj.DB.CreateTask(ctx, taskID, db.TaskTypeImplement, []string{b.ID}, 0, work.ID); err != nil {
 			return fmt.Errorf("failed to create fix task: %w", err)
 		}
 
@@ -540,7 +797,7 @@ func handleReviewFixLoop(proj *project.Project, reviewTask *db.Task, work *db.Wo
 		return fmt.Errorf("failed to get next task number for review: %w", err)
 	}
 	newReviewTaskID := fmt.Sprintf("%s.%d", work.ID, newReviewTaskNum)
-	if err := proj.DB.CreateTask(ctx, newReviewTaskID, "review", nil, 0, work.ID); err != nil {
+	if err := proj.DB.CreateTask(ctx, newReviewTaskID, db.TaskTypeReview, nil, 0, work.ID); err != nil {
 		return fmt.Errorf("failed to create new review task: %w", err)
 	}
 	for _, fixID := range fixTaskIDs {
@@ -585,7 +842,7 @@ func createPRTask(proj *project.Project, work *db.Work, reviewTaskID string) err
 	}
 	prTaskID := fmt.Sprintf("%s.%d", work.ID, prTaskNum)
 
-	if err := proj.DB.CreateTask(ctx, prTaskID, "pr", nil, 0, work.ID); err != nil {
+	if err := proj.DB.CreateTask(ctx, prTaskID, db.TaskTypePR, nil, 0, work.ID); err != nil {
 		return fmt.Errorf("failed to create PR task: %w", err)
 	}
 	if err := proj.DB.AddTaskDependency(ctx, prTaskID, reviewTaskID); err != nil {
@@ -606,7 +863,7 @@ func createUpdatePRDescriptionTask(proj *project.Project, work *db.Work, reviewT
 	}
 	taskID := fmt.Sprintf("%s.%d", work.ID, taskNum)
 
-	if err := proj.DB.CreateTask(ctx, taskID, "update-pr-description", nil, 0, work.ID); err != nil {
+	if err := proj.DB.CreateTask(ctx, taskID, db.TaskTypeUpdatePRDescription, nil, 0, work.ID); err != nil {
 		return fmt.Errorf("failed to create update-pr-description task: %w", err)
 	}
 	if err := proj.DB.AddTaskDependency(ctx, taskID, reviewTaskID); err != nil {
@@ -646,3 +903,24 @@ func splitEnvVar(s string) []string {
 	}
 	return []string{s[:idx], s[idx+1:]}
 }
+
+// reconcileWorkStatus corrects a work's status when it doesn't match what its
+// tasks imply, such as a work left pending while a task is processing, and
+// logs the correction. The decision is made on the work and tasks as they are
+// now, not as the loop last read them, since task tabs change both; work's
+// status is updated to match.
+func reconcileWorkStatus(ctx context.Context, proj *project.Project, work *db.Work) {
+	if work == nil {
+		return
+	}
+	correction, err := proj.DB.ReconcileWorkStatus(ctx, work.ID)
+	if err != nil {
+		logging.Warn("failed to reconcile work status", "workID", work.ID, "error", err)
+		return
+	}
+	if correction != nil {
+		work.Status = correction.To
+		logging.Info("work status auto-corrected", "workID", correction.WorkID, "from", correction.From, "to", correction.To)
+		fmt.Printf("Work %s status auto-corrected: %s -> %s\n", correction.WorkID, correction.From, correction.To)
+	}
+}
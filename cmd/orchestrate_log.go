@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// maxOrchestratorLogSize is the size at which the orchestrator log is rotated
+// to <path>.1, replacing the previous rotation.
+const maxOrchestratorLogSize = 1 << 20
+
+// startOrchestratorLog copies everything the process writes to os.Stdout into
+// the log file at path, for the TUI's log pane, and still shows it on the
+// terminal. os.Stdout is replaced by a pipe, so programs that need the real
+// terminal, like Claude, must be given the returned terminal instead. stop
+// restores os.Stdout and closes the log.
+func startOrchestratorLog(path string) (terminal *os.File, stop func(), err error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, nil, fmt.Errorf("failed to create log directory: %w", err)
+	}
+	lw, err := openLineWriter(path, maxOrchestratorLogSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, w, err := os.Pipe()
+	if err != nil {
+		_ = lw.Close()
+		return nil, nil, fmt.Errorf("failed to create log pipe: %w", err)
+	}
+
+	terminal = os.Stdout
+	os.Stdout = w
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// The log is best effort: the terminal keeps getting output if it fails
+		_, _ = io.Copy(io.MultiWriter(terminal, lw), r)
+		_ = r.Close()
+	}()
+
+	var once sync.Once
+	stop = func() {
+		once.Do(func() {
+			os.Stdout = terminal
+			_ = w.Close()
+			<-done
+			_ = lw.Close()
+		})
+	}
+	return terminal, stop, nil
+}
+
+// lineWriter writes terminal output to a log file a line at a time, each line
+// prefixed with the time it was written. A carriage return starts the line
+// over, so a spinner redrawing itself only logs the frame that was current
+// when its line ended. The file is rotated once it reaches maxSize.
+type lineWriter struct {
+	path    string
+	maxSize int64
+	file    *os.File
+	size    int64
+	line    []byte
+	now     func() time.Time
+}
+
+// openLineWriter opens the log at path for appending, rotating it first if
+// it's already too big.
+func openLineWriter(path string, maxSize int64) (*lineWriter, error) {
+	lw := &lineWriter{path: path, maxSize: maxSize, now: time.Now}
+	if info, err := os.Stat(path); err == nil && info.Size() >= maxSize {
+		if err := lw.rotate(); err != nil {
+			return nil, err
+		}
+	}
+	if err := lw.open(); err != nil {
+		return nil, err
+	}
+	return lw, nil
+}
+
+func (lw *lineWriter) open() error {
+	f, err := os.OpenFile(lw.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open log: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to stat log: %w", err)
+	}
+	lw.file, lw.size = f, info.Size()
+	return nil
+}
+
+// rotate moves the closed log file aside.
+func (lw *lineWriter) rotate() error {
+	if err := os.Rename(lw.path, lw.path+".1"); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to rotate log: %w", err)
+	}
+	return nil
+}
+
+// Write implements io.Writer. It never fails, so it can sit in a
+// MultiWriter next to the terminal.
+func (lw *lineWriter) Write(p []byte) (int, error) {
+	for _, b := range p {
+		switch b {
+		case '\r':
+			lw.line = lw.line[:0]
+		case '\n':
+			lw.writeLine()
+		default:
+			lw.line = append(lw.line, b)
+		}
+	}
+	return len(p), nil
+}
+
+func (lw *lineWriter) writeLine() {
+	if lw.file == nil {
+		lw.line = lw.line[:0]
+		return
+	}
+	entry := lw.now().Format("15:04:05") + " " + string(lw.line) + "\n"
+	lw.line = lw.line[:0]
+	n, err := lw.file.WriteString(entry)
+	lw.size += int64(n)
+	if err != nil || lw.size < lw.maxSize {
+		return
+	}
+
+	_ = lw.file.Close()
+	lw.file = nil
+	if lw.rotate() == nil {
+		_ = lw.open()
+	}
+}
+
+// Close writes the unfinished line, if any, and closes the file.
+func (lw *lineWriter) Close() error {
+	if len(lw.line) > 0 {
+		lw.writeLine()
+	}
+	if lw.file == nil {
+		return nil
+	}
+	return lw.file.Close()
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineWriter_WritesLinesAndDropsRedrawnFrames(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "orchestrator.log")
+	lw, err := openLineWriter(path, maxOrchestratorLogSize)
+	require.NoError(t, err)
+	lw.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	_, _ = fmt.Fprint(lw, "=== Orchestrating ===\n")
+	_, _ = fmt.Fprint(lw, "\r⠋ Waiting\r⠙ Waiting")
+	_, _ = fmt.Fprint(lw, "\nDone\nunfinished")
+	require.NoError(t, lw.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "03:04:05 === Orchestrating ===\n03:04:05 ⠙ Waiting\n03:04:05 Done\n03:04:05 unfinished\n", string(data))
+}
+
+func TestLineWriter_Rotates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "orchestrator.log")
+	previous := strings.Repeat("x", 40) + "\n"
+	require.NoError(t, os.WriteFile(path, []byte(previous), 0o644))
+
+	// An oversized log from a previous run is rotated on open
+	lw, err := openLineWriter(path, 30)
+	require.NoError(t, err)
+	lw.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+	rotated, err := os.ReadFile(path + ".1")
+	require.NoError(t, err)
+	require.Equal(t, previous, string(rotated))
+
+	// So is one that grows too big while running
+	_, _ = fmt.Fprint(lw, "first line\nsecond\nthird\n")
+	require.NoError(t, lw.Close())
+
+	rotated, err = os.ReadFile(path + ".1")
+	require.NoError(t, err)
+	require.Equal(t, "03:04:05 first line\n03:04:05 second\n", string(rotated))
+	current, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "03:04:05 third\n", string(current))
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/project"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -638,3 +639,24 @@ func TestMultipleReviewCyclesCreateMultipleUpdatePRTasks(t *testing.T) {
 	assert.Equal(t, 2, updatePRCount, "should have two update-pr-description tasks")
 	assert.Equal(t, 3, reviewCount, "should have three review tasks")
 }
+
+func TestReconcileWorkStatusUsesCurrentRow(t *testing.T) {
+	ctx := context.Background()
+	testDB, cleanup := setupOrchestrateTestDB(t)
+	defer cleanup()
+	proj := &project.Project{DB: testDB}
+
+	require.NoError(t, testDB.CreateWork(ctx, "w-rec", "w-rec", "/tmp/tree", "feat/rec", "main", "", false))
+	require.NoError(t, testDB.CreateTask(ctx, "w-rec.1", "implement", nil, 10, "w-rec"))
+	require.NoError(t, testDB.StartTask(ctx, "w-rec.1", "/tmp/tree"))
+
+	// The loop's copy of the work is out of date and already looks right;
+	// the row itself is still pending
+	theWork := &db.Work{ID: "w-rec", Status: db.StatusProcessing}
+	reconcileWorkStatus(ctx, proj, theWork)
+
+	w, err := testDB.GetWork(ctx, "w-rec")
+	require.NoError(t, err)
+	assert.Equal(t, db.StatusProcessing, w.Status)
+	assert.Equal(t, db.StatusProcessing, theWork.Status)
+}
@@ -3,7 +3,9 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/newhook/co/internal/claude"
 	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/project"
@@ -24,12 +26,24 @@ Claude can then be used to:
 - Plan implementation strategies
 - Create related issues
 
-Each issue gets its own dedicated planning session in a separate tab.`,
+Each issue gets its own dedicated planning session in a separate tab.
+
+The conversation is remembered per issue, so running 'co plan <id>' again,
+for example after its tab was closed, resumes it where it left off. If it
+can't be resumed, a new conversation is started. Use --new to start over.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runPlan,
 }
 
+var flagPlanNew bool
+
+// planResumeFailWindow is how soon claude must exit for a failed resume to be
+// put down to the conversation being gone, rather than to the user ending a
+// resumed session.
+const planResumeFailWindow = 10 * time.Second
+
 func init() {
+	planCmd.Flags().BoolVar(&flagPlanNew, "new", false, "start a new planning conversation instead of resuming the last one")
 	rootCmd.AddCommand(planCmd)
 }
 
@@ -64,10 +78,27 @@ func runPlan(cmd *cobra.Command, args []string) error {
 
 	mainRepoPath := proj.MainRepoPath()
 
-	// Launch Claude with the plan prompt
-	if err := claude.RunPlanSession(ctx, beadID, mainRepoPath, os.Stdin, os.Stdout, os.Stderr, proj.Config); err != nil {
+	sessionID, err := proj.DB.GetPlanConversation(ctx, beadID)
+	if err != nil {
 		return err
 	}
+	if sessionID != "" && !flagPlanNew {
+		fmt.Printf("Resuming the planning conversation for %s...\n", beadID)
+		started := time.Now()
+		err := claude.RunPlanSession(ctx, beadID, sessionID, true, mainRepoPath, os.Stdin, os.Stdout, os.Stderr, proj.Config)
+		if err == nil || time.Since(started) >= planResumeFailWindow {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Could not resume the previous conversation (%v); starting a new one\n", err)
+	}
 
-	return nil
+	// Record the conversation before it starts, so it can be resumed even if
+	// the tab is closed mid-session
+	sessionID = uuid.New().String()
+	if err := proj.DB.SetPlanConversation(ctx, beadID, sessionID); err != nil {
+		return err
+	}
+
+	// Launch Claude with the plan prompt
+	return claude.RunPlanSession(ctx, beadID, sessionID, false, mainRepoPath, os.Stdin, os.Stdout, os.Stderr, proj.Config)
 }
@@ -182,7 +182,7 @@ func printWorkProgress(wp *progress.WorkProgress) {
 
 		taskType := tp.Task.TaskType
 		if taskType == "" {
-			taskType = "implement"
+			taskType = db.TaskTypeImplement
 		}
 
 		fmt.Printf("    %s %s [%s]\n", taskSymbol, tp.Task.ID, taskType)
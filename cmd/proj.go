@@ -71,7 +71,7 @@ func runProjCreate(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("Creating project at %s from %s...\n", dir, repo)
 
-	proj, err := project.Create(ctx, dir, repo)
+	proj, err := project.Create(ctx, dir, repo, project.CreateOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to create project: %w", err)
 	}
@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/newhook/co/internal/remote"
+)
+
+// forwardReport runs a reporting command (co complete, co estimate, co task
+// progress) on the orchestrator's machine when this is a session on a remote
+// host, where there's no tracking database. It returns false when the
+// command should run here as usual.
+func forwardReport(args []string) (bool, error) {
+	socket := os.Getenv(remote.ReportSocketEnv)
+	if socket == "" {
+		return false, nil
+	}
+	code, err := remote.Forward(socket, args, os.Stdout)
+	if err != nil {
+		return true, err
+	}
+	if code != 0 {
+		return true, fmt.Errorf("co %s failed on the orchestrator's machine (exit code %d)", args[0], code)
+	}
+	return true, nil
+}
@@ -4,9 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/newhook/co/internal/project"
 	cosignal "github.com/newhook/co/internal/signal"
-	"github.com/newhook/co/internal/tui"
 	"github.com/spf13/cobra"
 )
 
@@ -17,6 +15,19 @@ var (
 
 	// flagNoMouse disables mouse support in the TUI
 	flagNoMouse bool
+	// flagAllProjects opens the TUI on the project switcher
+	flagAllProjects bool
+	// flagTheme selects the TUI color theme
+	flagTheme string
+	// flagIcons selects the TUI's status icon set
+	flagIcons string
+	// flagReadOnly disables every TUI action that changes the project
+	flagReadOnly bool
+	// flagTour replays the TUI's first-run tour
+	flagTour bool
+	// flagGlobalDryRun makes destructive commands print what they would do
+	// instead of doing it
+	flagGlobalDryRun bool
 
 	// Version information set at build time via ldflags
 	version = "dev"
@@ -48,19 +59,7 @@ var rootCmd = &cobra.Command{
 		}
 	},
 	// Default to TUI when no subcommand is provided
-	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := GetContext()
-		proj, err := project.Find(ctx, "")
-		if err != nil {
-			return fmt.Errorf("not in a project directory: %w", err)
-		}
-		defer proj.Close()
-
-		if err := tui.RunRootTUI(ctx, proj, !flagNoMouse); err != nil {
-			return fmt.Errorf("error running TUI: %w", err)
-		}
-		return nil
-	},
+	RunE: runTUI,
 }
 
 func Execute() error {
@@ -80,8 +79,16 @@ func GetContext() context.Context {
 func init() {
 	// Add TUI flags to root command (when run without subcommand)
 	rootCmd.Flags().BoolVar(&flagNoMouse, "no-mouse", false, "disable mouse support in the TUI")
+	rootCmd.Flags().BoolVar(&flagAllProjects, "all", false, "start in the project switcher, listing every known project")
+	rootCmd.Flags().StringVar(&flagTheme, "theme", "", "TUI color theme: auto, dark, light or mono")
+	rootCmd.Flags().StringVar(&flagIcons, "icons", "", "TUI status icons: unicode, ascii or nerd")
+	rootCmd.Flags().BoolVar(&flagReadOnly, "read-only", false, "view the project in the TUI without changing anything")
+	rootCmd.Flags().BoolVar(&flagTour, "tour", false, "replay the TUI's first-run tour")
+
+	rootCmd.PersistentFlags().BoolVar(&flagGlobalDryRun, "dry-run", false, "print what destructive commands would do without doing it")
 
 	// Add subcommands
+	rootCmd.AddCommand(tuiCmd)
 	rootCmd.AddCommand(runCmd)
 	rootCmd.AddCommand(completeCmd)
 	rootCmd.AddCommand(statusCmd)
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
-	"github.com/newhook/co/internal/project"
 	"github.com/newhook/co/internal/control"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/project"
 	"github.com/newhook/co/internal/work"
 	"github.com/newhook/co/internal/worktree"
 	"github.com/spf13/cobra"
@@ -21,6 +23,13 @@ var (
 	flagRunPlan       bool
 	flagRunAuto       bool
 	flagForceEstimate bool
+	flagRunWait       bool
+	flagRunTimeout    time.Duration
+	flagRunPoll       time.Duration
+	flagRunAt         string
+	flagRunIn         string
+	flagRunUnschedule bool
+	flagRunAll        bool
 )
 
 var runCmd = &cobra.Command{
@@ -34,6 +43,16 @@ are automatically converted to tasks based on their grouping.
 Flags:
   --plan     Use LLM complexity estimation to auto-group beads into tasks
   --auto     Run full automated workflow (implement, review/fix loop, PR)
+  --dry-run  Show how beads would be grouped into tasks (with --plan,
+             the LLM grouping) without creating anything
+  --wait     Block until all tasks finish, printing status changes, and
+             exit non-zero if any task failed (for CI / headless use)
+  --at/--in  Don't run now: schedule the run for a time (--at 02:00) or
+             after a delay (--in 4h). The control plane starts it.
+  --unschedule
+             Cancel a scheduled run
+  --all      Run every work with pending issues or tasks whose
+             orchestrator isn't running, staggering the spawns
 
 Without arguments:
 - If in a work directory or --work specified: runs that work
@@ -46,13 +65,20 @@ With an ID:
 
 func init() {
 	runCmd.Flags().IntVarP(&flagLimit, "limit", "n", 0, "maximum number of tasks to process (0 = unlimited)")
-	runCmd.Flags().BoolVar(&flagDryRun, "dry-run", false, "show plan without executing")
+	runCmd.Flags().BoolVar(&flagDryRun, "dry-run", false, "show how beads would be grouped into tasks without creating them")
 	runCmd.Flags().StringVar(&flagProject, "project", "", "project directory (default: auto-detect from cwd)")
 	runCmd.Flags().StringVar(&flagWork, "work", "", "work ID to run (default: auto-detect from cwd)")
 	runCmd.Flags().BoolVar(&flagAutoClose, "auto-close", false, "automatically close tabs after task completion")
 	runCmd.Flags().BoolVar(&flagRunPlan, "plan", false, "use LLM complexity estimation to auto-group beads")
 	runCmd.Flags().BoolVar(&flagRunAuto, "auto", false, "run full automated workflow (implement, review/fix, PR)")
 	runCmd.Flags().BoolVar(&flagForceEstimate, "force-estimate", false, "force re-estimation of complexity (with --plan)")
+	runCmd.Flags().BoolVar(&flagRunWait, "wait", false, "wait for all tasks to finish and exit non-zero if any failed")
+	runCmd.Flags().DurationVar(&flagRunTimeout, "timeout", 0, "maximum time to wait with --wait (0 = no limit)")
+	runCmd.Flags().DurationVar(&flagRunPoll, "poll-interval", work.DefaultWaitPollInterval, "how often to re-check task status with --wait")
+	runCmd.Flags().StringVar(&flagRunAt, "at", "", "schedule the run for a time (HH:MM or \"YYYY-MM-DD HH:MM\") instead of running now")
+	runCmd.Flags().StringVar(&flagRunIn, "in", "", "schedule the run after a delay (e.g. 4h) instead of running now")
+	runCmd.Flags().BoolVar(&flagRunUnschedule, "unschedule", false, "cancel the work's scheduled run")
+	runCmd.Flags().BoolVar(&flagRunAll, "all", false, "run every idle work with pending issues or tasks")
 }
 
 func runTasks(cmd *cobra.Command, args []string) error {
@@ -70,6 +96,13 @@ func runTasks(cmd *cobra.Command, args []string) error {
 
 	fmt.Printf("Using project: %s\n", proj.Config.Project.Name)
 
+	if flagRunAll {
+		if argID != "" || flagWork != "" {
+			return fmt.Errorf("--all can't be combined with a work ID")
+		}
+		return runAllWorks(proj)
+	}
+
 	// Determine work context (required)
 	// Priority: explicit arg > --work flag > directory context
 	var workID string
@@ -103,9 +136,16 @@ func runTasks(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("work %s not found", workID)
 	}
 
+	if flagRunAt != "" || flagRunIn != "" || flagRunUnschedule {
+		return scheduleRun(proj, workRecord)
+	}
+
 	fmt.Printf("\n=== Running work %s ===\n", workRecord.ID)
 	fmt.Printf("Branch: %s\n", workRecord.BranchName)
 	fmt.Printf("Worktree: %s\n", workRecord.WorktreePath)
+	if workRecord.Paused {
+		fmt.Printf("Warning: work %s is paused; its tasks won't start until you run 'co work resume %s'\n", workRecord.ID, workRecord.ID)
+	}
 
 	// Create WorkService for this operation
 	svc := work.NewWorkService(proj)
@@ -120,10 +160,23 @@ func runTasks(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("work %s has no worktree path configured", workRecord.ID)
 	}
 
-	if !worktree.NewOperations().ExistsPath(workRecord.WorktreePath) {
+	if !workRecord.IsRemote() && !worktree.NewOperations().ExistsPath(workRecord.WorktreePath) {
 		return fmt.Errorf("work %s worktree does not exist at %s", workRecord.ID, workRecord.WorktreePath)
 	}
 
+	// With --dry-run, show how beads would be grouped into tasks and stop
+	if flagDryRun {
+		if flagRunAuto || flagRunWait {
+			return fmt.Errorf("--dry-run can't be combined with --auto or --wait")
+		}
+		plan, err := svc.PreviewPlan(ctx, workID, work.RunWorkOptions{UsePlan: flagRunPlan, ForceEstimate: flagForceEstimate}, os.Stdout)
+		if err != nil {
+			return fmt.Errorf("failed to plan work: %w", err)
+		}
+		printPlan(plan)
+		return nil
+	}
+
 	// If --auto, run full automated workflow
 	if flagRunAuto {
 		result, err := svc.RunWorkAuto(ctx, workID, os.Stdout)
@@ -138,6 +191,9 @@ func runTasks(cmd *cobra.Command, args []string) error {
 		if _, err := control.EnsureControlPlane(ctx, proj); err != nil {
 			fmt.Printf("Warning: failed to ensure control plane: %v\n", err)
 		}
+		if flagRunWait {
+			return waitForRun(svc, workID)
+		}
 		fmt.Println("Switch to the zellij session to monitor progress.")
 		return nil
 	}
@@ -163,6 +219,168 @@ func runTasks(cmd *cobra.Command, args []string) error {
 		fmt.Printf("Warning: failed to ensure control plane: %v\n", err)
 	}
 
+	if flagRunWait {
+		return waitForRun(svc, workID)
+	}
 	fmt.Println("Switch to the zellij session to monitor progress.")
 	return nil
 }
+
+// runAllWorks runs every idle work with pending issues or tasks (--all)
+func runAllWorks(proj *project.Project) error {
+	ctx := GetContext()
+	if flagDryRun || flagRunAuto || flagRunWait || flagRunAt != "" || flagRunIn != "" || flagRunUnschedule {
+		return fmt.Errorf("--all can only be combined with --plan")
+	}
+
+	svc := work.NewWorkService(proj)
+	works, err := svc.IdleWorks(ctx)
+	if err != nil {
+		return err
+	}
+	if len(works) == 0 {
+		fmt.Println("\nNo idle works with pending issues or tasks.")
+		return nil
+	}
+	workIDs := make([]string, len(works))
+	for i, w := range works {
+		workIDs[i] = w.ID
+	}
+
+	stagger := proj.Config.Scheduler.GetRunAllStagger()
+	fmt.Printf("\n=== Running %d work(s), %s apart ===\n", len(works), stagger)
+	opts := work.RunAllOptions{
+		UsePlan:                flagRunPlan,
+		Stagger:                stagger,
+		MaxConsecutiveFailures: proj.Config.Scheduler.GetRunAllMaxFailures(),
+		OnOutcome: func(outcome work.RunAllOutcome) {
+			switch {
+			case outcome.Aborted:
+				fmt.Printf("  %s: not started\n", outcome.WorkID)
+			case outcome.Err != nil:
+				fmt.Printf("  %s: failed: %v\n", outcome.WorkID, outcome.Err)
+			default:
+				fmt.Printf("  %s: started (%d task(s) created)\n", outcome.WorkID, outcome.Result.TasksCreated)
+			}
+		},
+	}
+	outcomes, runErr := svc.RunAllWorks(ctx, workIDs, opts, os.Stdout)
+
+	// Ensure control plane is running (handles scheduled tasks like PR feedback polling)
+	if _, err := control.EnsureControlPlane(ctx, proj); err != nil {
+		fmt.Printf("Warning: failed to ensure control plane: %v\n", err)
+	}
+
+	failed := 0
+	for _, outcome := range outcomes {
+		if outcome.Err != nil {
+			failed++
+		}
+	}
+	if runErr != nil {
+		return runErr
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d work(s) failed to start", failed, len(outcomes))
+	}
+	fmt.Println("\nSwitch to the zellij session to monitor progress.")
+	return nil
+}
+
+// printPlan prints the tasks a run would create (--dry-run).
+func printPlan(plan *work.PlanResult) {
+	if len(plan.Groups) == 0 {
+		fmt.Println("\nNo unassigned beads; no tasks would be created.")
+		return
+	}
+	fmt.Printf("\nWould create %d task(s):\n", len(plan.Groups))
+	for i, group := range plan.Groups {
+		fmt.Printf("\n  Task %d", i+1)
+		if complexity := group.Complexity(); complexity > 0 {
+			fmt.Printf(" (complexity: %d)", complexity)
+		}
+		fmt.Println()
+		for _, b := range group.Beads {
+			fmt.Printf("    %s  %s\n", b.ID, b.Title)
+		}
+	}
+}
+
+// scheduleRun records or cancels a scheduled run of the work (--at, --in,
+// --unschedule) and makes sure the control plane is running to start it.
+func scheduleRun(proj *project.Project, workRecord *db.Work) error {
+	ctx := GetContext()
+	svc := work.NewWorkService(proj)
+
+	if flagRunUnschedule {
+		if flagRunAt != "" || flagRunIn != "" {
+			return fmt.Errorf("--unschedule can't be combined with --at or --in")
+		}
+		if err := svc.CancelScheduledRun(ctx, workRecord.ID); err != nil {
+			return err
+		}
+		fmt.Printf("Cancelled the scheduled run of %s\n", workRecord.ID)
+		return nil
+	}
+	if flagRunAuto || flagRunPlan || flagRunWait {
+		return fmt.Errorf("--at and --in can't be combined with --auto, --plan or --wait")
+	}
+
+	at, err := work.ParseRunTime(flagRunAt, flagRunIn, time.Now())
+	if err != nil {
+		return err
+	}
+	if err := svc.ScheduleRun(ctx, workRecord.ID, at); err != nil {
+		return err
+	}
+	fmt.Printf("Work %s will run at %s\n", workRecord.ID, at.Format("2006-01-02 15:04"))
+
+	// The control plane starts scheduled runs
+	if _, err := control.EnsureControlPlane(ctx, proj); err != nil {
+		fmt.Printf("Warning: failed to ensure control plane: %v\n", err)
+	}
+	return nil
+}
+
+// waitForRun blocks until the work's tasks finish, streaming status changes,
+// then prints a summary. It returns an error if any task failed so the
+// process exits non-zero.
+func waitForRun(svc *work.WorkService, workID string) error {
+	ctx := GetContext()
+
+	fmt.Printf("\nWaiting for work %s to finish...\n", workID)
+	result, err := svc.WaitForWork(ctx, workID, work.WaitOptions{
+		Timeout:      flagRunTimeout,
+		PollInterval: flagRunPoll,
+		OnTransition: func(t work.TaskTransition) {
+			from := t.From
+			if from == "" {
+				from = "new"
+			}
+			fmt.Printf("[%s] %s (%s): %s -> %s\n", time.Now().Format("15:04:05"), t.TaskID, t.TaskType, from, t.To)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	fmt.Printf("%-20s %-10s %-12s %s\n", "TASK", "TYPE", "STATUS", "DURATION")
+	fmt.Printf("%-20s %-10s %-12s %s\n", "----", "----", "------", "--------")
+	for _, t := range result.Tasks {
+		duration := "-"
+		if t.StartedAt != nil && t.CompletedAt != nil {
+			duration = t.CompletedAt.Sub(*t.StartedAt).Round(time.Second).String()
+		}
+		fmt.Printf("%-20s %-10s %-12s %s\n", t.ID, t.TaskType, t.Status, duration)
+		if t.Status == db.StatusFailed && t.ErrorMessage != "" {
+			fmt.Printf("  Error: %s\n", t.ErrorMessage)
+		}
+	}
+
+	if result.Failed > 0 {
+		return fmt.Errorf("%d of %d task(s) failed", result.Failed, len(result.Tasks))
+	}
+	fmt.Printf("\nAll %d task(s) completed.\n", len(result.Tasks))
+	return nil
+}
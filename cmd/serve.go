@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/newhook/co/internal/api"
+	"github.com/newhook/co/internal/project"
+	trackingwatcher "github.com/newhook/co/internal/tracking/watcher"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagServeAddr    string
+	flagServeProject string
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve works and tasks as JSON for editor integrations",
+	Long: `Serve starts a local, read-only HTTP API over the project's works and tasks:
+
+  GET /works             every work, without tasks
+  GET /works/{id}        a work with its issues and tasks
+  GET /works/{id}/tasks  a work's tasks
+  GET /events            server-sent events as the tracking database changes
+
+The server only binds to a loopback address and has no other authentication.
+With the default --addr a free port is picked; the port is written to
+.co/api.port for clients to find, and removed when the server stops on
+Ctrl+C or SIGTERM.`,
+	Args: cobra.NoArgs,
+	RunE: runServe,
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+	serveCmd.Flags().StringVar(&flagServeAddr, "addr", "127.0.0.1:0", "loopback address to listen on; port 0 picks a free one")
+	serveCmd.Flags().StringVar(&flagServeProject, "project", "", "project directory (default: auto-detect)")
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+
+	if err := checkLoopbackAddr(flagServeAddr); err != nil {
+		return err
+	}
+
+	proj, err := project.Find(ctx, flagServeProject)
+	if err != nil {
+		return fmt.Errorf("not in a project directory: %w", err)
+	}
+	defer proj.Close()
+
+	watcher, err := trackingwatcher.New(trackingwatcher.DefaultConfig(filepath.Join(proj.Root, project.ConfigDir, project.TrackingDB)))
+	if err != nil {
+		return fmt.Errorf("failed to create tracking watcher: %w", err)
+	}
+	if err := watcher.Start(); err != nil {
+		return fmt.Errorf("failed to start tracking watcher: %w", err)
+	}
+	defer watcher.Stop()
+
+	listener, err := net.Listen("tcp", flagServeAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", flagServeAddr, err)
+	}
+	addr := listener.Addr().(*net.TCPAddr)
+
+	portPath := filepath.Join(proj.Root, project.ConfigDir, api.PortFile)
+	if err := os.WriteFile(portPath, []byte(strconv.Itoa(addr.Port)+"\n"), 0644); err != nil {
+		listener.Close()
+		return fmt.Errorf("failed to write %s: %w", portPath, err)
+	}
+	defer os.Remove(portPath)
+
+	srv := &http.Server{
+		Handler:           api.NewHandler(api.ProjectSource{Proj: proj}, watcher.Broker()),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.Serve(listener)
+	}()
+	fmt.Printf("Serving %s on http://%s (port written to %s)\n", proj.Config.Project.Name, addr, portPath)
+
+	select {
+	case err := <-serveErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			return fmt.Errorf("failed to serve: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+	}
+
+	// Event streams end with the root context; give other requests a moment
+	// to finish
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down the server: %w", err)
+	}
+	fmt.Println("Server stopped")
+	return nil
+}
+
+// checkLoopbackAddr fails unless addr is on a loopback interface, since the
+// API has no authentication
+func checkLoopbackAddr(addr string) error {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("invalid address %q: %w", addr, err)
+	}
+	if host == "localhost" {
+		return nil
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return fmt.Errorf("refusing to listen on %q: the API has no authentication, so only loopback addresses like 127.0.0.1 are allowed", addr)
+	}
+	return nil
+}
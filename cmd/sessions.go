@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/newhook/co/internal/project"
+	workpkg "github.com/newhook/co/internal/work"
+	"github.com/newhook/co/internal/zellij"
+	"github.com/spf13/cobra"
+)
+
+var sessionsCmd = &cobra.Command{
+	Use:   "sessions",
+	Short: "Manage the project's zellij tabs",
+}
+
+var sessionsPruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Close zellij tabs whose work, task or bead is gone",
+	Long: `List the tabs in the project's zellij session that co created for works,
+tasks and planning sessions which no longer exist (or, for tasks and beads,
+are finished or closed), then offer to close them.
+
+Tabs co didn't create, such as the control plane or your own, are left alone.`,
+	Args: cobra.NoArgs,
+	RunE: runSessionsPrune,
+}
+
+var (
+	flagPruneDryRun bool
+	flagPruneYes    bool
+)
+
+func init() {
+	sessionsPruneCmd.Flags().BoolVar(&flagPruneDryRun, "dry-run", false, "list stale tabs without closing them")
+	sessionsPruneCmd.Flags().BoolVarP(&flagPruneYes, "yes", "y", false, "close stale tabs without asking")
+	sessionsCmd.AddCommand(sessionsPruneCmd)
+	rootCmd.AddCommand(sessionsCmd)
+}
+
+func runSessionsPrune(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return fmt.Errorf("not in a project directory: %w", err)
+	}
+	defer proj.Close()
+
+	sessionName := project.SessionNameForProject(proj.Config.Project.Name)
+	zc := zellij.New()
+	exists, err := zc.SessionExists(ctx, sessionName)
+	if err != nil {
+		return fmt.Errorf("failed to check session existence: %w", err)
+	}
+	if !exists {
+		fmt.Printf("Zellij session %s is not running.\n", sessionName)
+		return nil
+	}
+	session := zc.Session(sessionName)
+	tabNames, err := session.QueryTabNames(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list tabs: %w", err)
+	}
+
+	stale, err := workpkg.NewWorkService(proj).FindStaleTabs(ctx, tabNames)
+	if err != nil {
+		return err
+	}
+	if len(stale) == 0 {
+		fmt.Println("No stale tabs.")
+		return nil
+	}
+
+	fmt.Printf("Stale tabs in %s:\n", sessionName)
+	for _, tab := range stale {
+		fmt.Printf("  %-30s %s\n", tab.Name, tab.Reason)
+	}
+	if flagPruneDryRun {
+		return nil
+	}
+
+	if !flagPruneYes {
+		fmt.Printf("Close %d tab(s)? [y/N]: ", len(stale))
+		reader := bufio.NewReader(os.Stdin)
+		response, _ := reader.ReadString('\n')
+		response = strings.TrimSpace(strings.ToLower(response))
+		if response != "y" && response != "yes" {
+			fmt.Println("Aborted.")
+			return nil
+		}
+	}
+
+	closed := 0
+	for _, tab := range stale {
+		if err := session.TerminateAndCloseTab(ctx, tab.Name); err != nil {
+			fmt.Printf("Warning: failed to close tab %s: %v\n", tab.Name, err)
+			continue
+		}
+		if tab.Kind == workpkg.TabKindPlan {
+			if err := proj.DB.UnregisterPlanSession(ctx, tab.ID); err != nil {
+				fmt.Printf("Warning: failed to unregister plan session for %s: %v\n", tab.ID, err)
+			}
+		}
+		closed++
+	}
+	fmt.Printf("Closed %d tab(s).\n", closed)
+	return nil
+}
@@ -12,6 +12,8 @@ import (
 var (
 	flagTaskStatus string
 	flagTaskType   string
+	flagTaskStep   string
+	flagTaskDetail string
 )
 
 var taskCmd = &cobra.Command{
@@ -54,16 +56,36 @@ var taskResetCmd = &cobra.Command{
 	RunE:  runTaskReset,
 }
 
+var taskProgressCmd = &cobra.Command{
+	Use:   "progress <task-id>",
+	Short: "[Agent] Record a progress step for a running task",
+	Long: `[Agent Command - Called by Claude Code, not for direct user invocation]
+
+Record a step a running task has reached, such as "running tests". The latest
+step is shown under the task in the TUI, and the full history in its details.
+
+Example:
+  co task progress w-abc.1 --step "running tests" --detail "go test ./..."`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTaskProgress,
+}
+
 func init() {
 	rootCmd.AddCommand(taskCmd)
 	taskCmd.AddCommand(taskListCmd)
 	taskCmd.AddCommand(taskShowCmd)
 	taskCmd.AddCommand(taskDeleteCmd)
 	taskCmd.AddCommand(taskResetCmd)
+	taskCmd.AddCommand(taskProgressCmd)
 
 	// List command flags
 	taskListCmd.Flags().StringVar(&flagTaskStatus, "status", "", "filter by status (pending, processing, completed, failed)")
 	taskListCmd.Flags().StringVar(&flagTaskType, "type", "", "filter by type (estimate, implement)")
+
+	// Progress command flags
+	taskProgressCmd.Flags().StringVar(&flagTaskStep, "step", "", "step the task has reached")
+	taskProgressCmd.Flags().StringVar(&flagTaskDetail, "detail", "", "more about the step, such as the command run")
+	taskProgressCmd.MarkFlagRequired("step")
 }
 
 func runTaskList(cmd *cobra.Command, args []string) error {
@@ -129,7 +151,7 @@ func runTaskList(cmd *cobra.Command, args []string) error {
 		// Format type
 		typeDisplay := task.TaskType
 		if typeDisplay == "" {
-			typeDisplay = "implement"
+			typeDisplay = db.TaskTypeImplement
 		}
 
 		// Format created time
@@ -164,7 +186,11 @@ func runTaskList(cmd *cobra.Command, args []string) error {
 
 		// Show error message if failed
 		if task.Status == db.StatusFailed && task.ErrorMessage != "" {
-			fmt.Printf("  ├─ Error: %s\n", task.ErrorMessage)
+			if task.FailureKind != "" {
+				fmt.Printf("  ├─ Error (%s): %s\n", task.FailureKind, task.ErrorMessage)
+			} else {
+				fmt.Printf("  ├─ Error: %s\n", task.ErrorMessage)
+			}
 		}
 
 		// Show PR URL if completed
@@ -225,7 +251,7 @@ func runTaskShow(cmd *cobra.Command, args []string) error {
 	fmt.Printf("Status:      %s\n", formatStatus(task.Status))
 	fmt.Printf("Type:        %s\n", func() string {
 		if task.TaskType == "" {
-			return "implement"
+			return db.TaskTypeImplement
 		}
 		return task.TaskType
 	}())
@@ -255,6 +281,9 @@ func runTaskShow(cmd *cobra.Command, args []string) error {
 	if task.ErrorMessage != "" {
 		fmt.Printf("Error:       %s\n", task.ErrorMessage)
 	}
+	if task.FailureKind != "" {
+		fmt.Printf("Failure:     %s\n", task.FailureKind)
+	}
 
 	// Print beads
 	fmt.Printf("\nBeads (%d):\n", len(beadIDs))
@@ -276,6 +305,19 @@ func runTaskShow(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Print progress steps if any
+	steps, err := proj.DB.GetTaskSteps(ctx, taskID)
+	if err == nil && len(steps) > 0 {
+		fmt.Printf("\nSteps (%d):\n", len(steps))
+		for _, step := range steps {
+			line := fmt.Sprintf("  %s  %s", step.CreatedAt.Local().Format("15:04:05"), step.Step)
+			if step.Detail != "" {
+				line += " (" + step.Detail + ")"
+			}
+			fmt.Println(line)
+		}
+	}
+
 	return nil
 }
 
@@ -343,3 +385,44 @@ func runTaskReset(cmd *cobra.Command, args []string) error {
 	fmt.Printf("Reset task %s to pending\n", taskID)
 	return nil
 }
+
+func runTaskProgress(cmd *cobra.Command, args []string) error {
+	taskID := args[0]
+	ctx := GetContext()
+
+	step := strings.TrimSpace(flagTaskStep)
+	if step == "" {
+		return fmt.Errorf("--step can't be empty")
+	}
+
+	forwarded := []string{"task", "progress", taskID, "--step", step}
+	if flagTaskDetail != "" {
+		forwarded = append(forwarded, "--detail", flagTaskDetail)
+	}
+	if ok, err := forwardReport(forwarded); ok {
+		return err
+	}
+
+	// Find project
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return fmt.Errorf("failed to find project: %w", err)
+	}
+	defer proj.Close()
+
+	// Check task exists
+	task, err := proj.DB.GetTask(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+	if task == nil {
+		return fmt.Errorf("task %s not found", taskID)
+	}
+
+	if err := proj.DB.AddTaskStep(ctx, taskID, step, flagTaskDetail); err != nil {
+		return fmt.Errorf("failed to record progress step: %w", err)
+	}
+
+	fmt.Printf("Recorded step for %s: %s\n", taskID, step)
+	return nil
+}
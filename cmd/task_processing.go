@@ -8,6 +8,7 @@ import (
 	"github.com/newhook/co/internal/claude"
 	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/project"
+	workpkg "github.com/newhook/co/internal/work"
 	"github.com/newhook/co/internal/worktree"
 )
 
@@ -20,41 +21,95 @@ func buildPromptForTask(ctx context.Context, proj *project.Project, task *db.Tas
 	}
 
 	switch task.TaskType {
-	case "estimate":
+	case db.TaskTypeEstimate:
 		issues, err := getBeadsForTask(ctx, proj, task.ID)
 		if err != nil {
 			return "", err
 		}
 		return claude.BuildEstimatePrompt(task.ID, issues), nil
 
-	case "implement":
+	case db.TaskTypeImplement:
 		issues, err := getBeadsForTask(ctx, proj, task.ID)
 		if err != nil {
 			return "", err
 		}
 		return claude.BuildTaskPrompt(task.ID, issues, work.BranchName, baseBranch), nil
 
-	case "review":
-		return claude.BuildReviewPrompt(task.ID, work.ID, work.BranchName, baseBranch, work.RootIssueID), nil
+	case db.TaskTypeReview:
+		ciFailures, err := proj.DB.GetTaskMetadata(ctx, task.ID, workpkg.CIFailuresMetadataKey)
+		if err != nil {
+			return "", fmt.Errorf("failed to get CI failures of review task: %w", err)
+		}
+		return claude.BuildReviewPrompt(task.ID, work.ID, work.BranchName, baseBranch, work.RootIssueID, ciFailures), nil
 
-	case "pr":
+	case db.TaskTypePR:
 		return claude.BuildPRPrompt(task.ID, work.ID, work.BranchName, baseBranch), nil
 
-	case "update-pr-description":
+	case db.TaskTypeUpdatePRDescription:
 		if work.PRURL == "" {
 			return "", fmt.Errorf("work %s has no PR URL set", work.ID)
 		}
 		return claude.BuildUpdatePRDescriptionPrompt(task.ID, work.ID, work.PRURL, work.BranchName, baseBranch), nil
 
-	case "log_analysis":
+	case db.TaskTypeLogAnalysis:
 		// Log analysis tasks have metadata with log content stored by the feedback processor
 		return buildLogAnalysisPromptFromMetadata(ctx, proj, task, work)
 
 	default:
+		// Custom task types from [workflow.task_types] render their own template
+		if taskType, ok := proj.Config.Workflow.GetTaskType(task.TaskType); ok {
+			return buildCustomTaskPrompt(ctx, proj, task, work, taskType, baseBranch)
+		}
 		return "", fmt.Errorf("unknown task type: %s", task.TaskType)
 	}
 }
 
+// buildCustomTaskPrompt renders the prompt template of a config-defined task type
+// with the work's context.
+func buildCustomTaskPrompt(ctx context.Context, proj *project.Project, task *db.Task, work *db.Work, taskType project.TaskTypeConfig, baseBranch string) (string, error) {
+	tmpl, err := claude.LoadCustomTaskTemplate(proj.Root, taskType.Prompt)
+	if err != nil {
+		return "", fmt.Errorf("task type %s: %w", task.TaskType, err)
+	}
+
+	params := claude.CustomTaskParams{
+		TaskID:       task.ID,
+		TaskType:     task.TaskType,
+		WorkID:       work.ID,
+		WorkName:     work.Name,
+		BranchName:   work.BranchName,
+		BaseBranch:   baseBranch,
+		RootIssueID:  work.RootIssueID,
+		PRURL:        work.PRURL,
+		WorktreePath: work.WorktreePath,
+		ArtifactDir:  proj.TaskArtifactDir(work.ID, task.ID),
+	}
+
+	if taskType.NeedsBeads {
+		workBeads, err := proj.DB.GetWorkBeads(ctx, work.ID)
+		if err != nil {
+			return "", err
+		}
+		beadIDs := make([]string, 0, len(workBeads))
+		for _, wb := range workBeads {
+			beadIDs = append(beadIDs, wb.BeadID)
+		}
+		result, err := proj.Beads.GetBeadsWithDeps(ctx, beadIDs)
+		if err != nil {
+			return "", fmt.Errorf("failed to get beads: %w", err)
+		}
+		for _, beadID := range beadIDs {
+			if b, ok := result.Beads[beadID]; ok {
+				params.Beads = append(params.Beads, b)
+			} else {
+				fmt.Printf("Warning: bead %s not found\n", beadID)
+			}
+		}
+	}
+
+	return claude.BuildCustomTaskPrompt(tmpl, params)
+}
+
 // buildLogAnalysisPromptFromMetadata builds a log analysis prompt from task metadata.
 // The metadata is stored by the feedback processor when creating log_analysis tasks.
 func buildLogAnalysisPromptFromMetadata(ctx context.Context, proj *project.Project, task *db.Task, work *db.Work) (string, error) {
@@ -192,7 +247,7 @@ func processTask(proj *project.Project, taskID string, runner claude.Runner) err
 		return fmt.Errorf("work %s has no worktree path configured", work.ID)
 	}
 
-	if !worktree.NewOperations().ExistsPath(work.WorktreePath) {
+	if !work.IsRemote() && !worktree.NewOperations().ExistsPath(work.WorktreePath) {
 		return fmt.Errorf("work %s worktree does not exist at %s", work.ID, work.WorktreePath)
 	}
 
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Open the interactive TUI",
+	Long: `Open the interactive TUI. This is also what co does when run without a subcommand.
+
+With --all, the TUI starts in the project switcher, which lists every project
+co has opened (recorded in ~/.config/co/projects.json) with its number of
+active works. Press P inside the TUI to open the switcher at any time.
+--all also works outside a project directory.
+
+--theme picks the color theme: auto, dark, light or mono. It overrides the
+[tui] theme setting in .co/config.toml. With auto, NO_COLOR selects mono and
+otherwise the terminal background decides between dark and light.
+
+--read-only opens the TUI for viewing: actions that change works, tasks or
+issues or start sessions are dimmed and refused, and checks that need git
+are skipped. It turns on by itself when the project's tracking database
+can't be opened for writing, e.g. on a read-only mount.
+
+The first time the TUI opens in a project without works it shows a short tour
+of issues, works, tasks, the screen and the keys to know; --tour replays it.
+
+If .co/config.toml has problems (see co config validate), the TUI opens with
+a list of them; broken keys use their defaults and actions that change the
+project are refused until the file is fixed and re-checked with ctrl+r.`,
+	Args: cobra.NoArgs,
+	RunE: runTUI,
+}
+
+func init() {
+	tuiCmd.Flags().BoolVar(&flagNoMouse, "no-mouse", false, "disable mouse support in the TUI")
+	tuiCmd.Flags().BoolVar(&flagAllProjects, "all", false, "start in the project switcher, listing every known project")
+	tuiCmd.Flags().StringVar(&flagTheme, "theme", "", "TUI color theme: auto, dark, light or mono")
+	tuiCmd.Flags().StringVar(&flagIcons, "icons", "", "TUI status icons: unicode, ascii or nerd")
+	tuiCmd.Flags().BoolVar(&flagReadOnly, "read-only", false, "view the project without changing anything")
+	tuiCmd.Flags().BoolVar(&flagTour, "tour", false, "replay the first-run tour")
+}
+
+func runTUI(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+	proj, err := project.FindLenient(ctx, "")
+	if err != nil {
+		// A config.toml that can't be parsed at all is reported as it is
+		var cfgErr *project.ConfigError
+		if errors.As(err, &cfgErr) {
+			return err
+		}
+		if !flagAllProjects {
+			return fmt.Errorf("not in a project directory: %w", err)
+		}
+		// Outside a project the switcher is the only way in
+		proj = nil
+	}
+
+	theme, err := resolveTUITheme(proj)
+	if err != nil {
+		if proj != nil {
+			_ = proj.Close()
+		}
+		return err
+	}
+
+	// The TUI owns proj from here and closes it (or whatever project is open) on exit
+	if err := tui.RunRootTUI(ctx, proj, theme, !flagNoMouse, flagAllProjects, flagReadOnly, flagTour); err != nil {
+		return fmt.Errorf("error running TUI: %w", err)
+	}
+	return nil
+}
+
+// resolveTUITheme returns the theme the TUI renders with, with its icon set.
+// The flags win over the project's [tui] config, which proj may be nil for;
+// both default to the auto theme and unicode icons.
+func resolveTUITheme(proj *project.Project) (*tui.Theme, error) {
+	themeName, iconsName := flagTheme, flagIcons
+	if proj != nil {
+		if themeName == "" {
+			themeName = proj.Config.TUI.Theme
+		}
+		if iconsName == "" {
+			iconsName = proj.Config.TUI.Icons
+		}
+	}
+	theme, err := tui.ResolveTheme(themeName)
+	if err != nil {
+		return nil, err
+	}
+	icons, err := tui.ResolveIcons(iconsName)
+	if err != nil {
+		return nil, err
+	}
+	theme.Icons = icons
+	return theme, nil
+}
@@ -2,17 +2,20 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/newhook/co/internal/beads"
 	"github.com/newhook/co/internal/claude"
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/control"
 	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/git"
 	"github.com/newhook/co/internal/project"
-	"github.com/newhook/co/internal/control"
 	workpkg "github.com/newhook/co/internal/work"
 	"github.com/spf13/cobra"
 )
@@ -34,6 +37,10 @@ Transitive dependencies are also included.
 
 Branch name is auto-generated from the bead title - you'll be prompted to accept or customize.
 
+With --remote, the worktree is created and Claude runs on the host configured
+under [remote] in .co/config.toml, over SSH; [remote] default = true makes that
+the default, and --remote=false overrides it.
+
 With --auto flag, runs the full automated workflow:
 1. Creates tasks from beads
 2. Executes all tasks
@@ -100,6 +107,10 @@ var workAddCmd = &cobra.Command{
 Multiple beads can be specified separated by spaces or commas.
 Epics are automatically expanded to include all child beads.
 
+A bead can only be in one active work at a time. Adding one that another
+active work has fails; with --force such beads are skipped with a warning
+and the rest are added.
+
 Use --plan when running to let the LLM group beads intelligently,
 or --auto for a fully automated workflow.`,
 	Args: cobra.MinimumNArgs(1),
@@ -151,35 +162,108 @@ to resume processing.`,
 	RunE: runWorkRestart,
 }
 
+var workPauseCmd = &cobra.Command{
+	Use:   "pause [<id>]",
+	Short: "Stop a work from starting new tasks",
+	Long: `Pause a work so its orchestrator stops picking up new tasks.
+
+A task that is already running is left to finish, and nothing is killed.
+Use this while editing the worktree by hand, then run 'co work resume'.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWorkPause,
+}
+
+var workResumeCmd = &cobra.Command{
+	Use:   "resume [<id>]",
+	Short: "Resume a paused work",
+	Long: `Resume a paused work so its orchestrator picks up tasks again.
+
+The orchestrator is started if it isn't running.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWorkResume,
+}
+
+var workParallelCmd = &cobra.Command{
+	Use:   "parallel <n> [<id>]",
+	Short: "Set how many tasks of a work run at once",
+	Long: `Set how many of a work's ready tasks its orchestrator runs at the same time.
+
+With more than one, each task runs in its own tab. 0 falls back to
+max_parallel_tasks under [workflow] in config.toml (default 1).`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: runWorkParallel,
+}
+
+var workTaskCmd = &cobra.Command{
+	Use:   "task [<id>] --type <name>",
+	Short: "Create a task of a custom type for a work",
+	Long: `Create a task of a type defined under [workflow.task_types] in config.toml.
+
+The task's prompt is rendered from the type's template with the work's
+context, and the work's orchestrator runs it like any other task.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWorkTask,
+}
+
 var workCompleteCmd = &cobra.Command{
 	Use:   "complete [<id>]",
-	Short: "Mark an idle work as completed",
-	Long: `Explicitly mark a work as completed.
-
-When all tasks finish, the work enters an idle state waiting for more tasks.
-Use this command to mark the work as truly completed (e.g., after PR is merged).`,
+	Short: "Clean up and complete a work after its PR is merged",
+	Long: `Finish a work whose PR has been merged.
+
+Runs the following steps, reporting each one:
+  1. Verify the PR is merged on GitHub (skip with --force)
+  2. Close all open beads assigned to the work (skip with --keep-beads)
+  3. Remove the worktree (skip with --keep-worktree)
+  4. Delete the branch, on the host for a remote work (skip with --keep-branch)
+  5. Mark the work as completed
+
+The work must be idle or merged. Its orchestrator, if still running, is
+stopped once the PR check passes.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runWorkComplete,
 }
 
 var (
-	flagAutoRun    bool
-	flagReviewAuto bool
-	flagAddWork    string
-	flagRemoveWork string
-	flagBranchName string
-	flagFromBranch string
-	flagYes        bool
+	flagAutoRun     bool
+	flagReviewAuto  bool
+	flagReviewCI    bool
+	flagAddWork     string
+	flagAddForce    bool
+	flagRemoveWork  string
+	flagBranchName  string
+	flagFromBranch  string
+	flagBaseBranch  string
+	flagRemote      bool
+	flagKeepPartial bool
+	flagYes         bool
+
+	flagWorkTaskType string
+
+	flagCompleteKeepBeads    bool
+	flagCompleteKeepBranch   bool
+	flagCompleteKeepWorktree bool
+	flagCompleteForce        bool
 )
 
 func init() {
 	workCreateCmd.Flags().BoolVar(&flagAutoRun, "auto", false, "run full automated workflow (implement, review, fix, PR)")
 	workCreateCmd.Flags().StringVar(&flagBranchName, "branch", "", "branch name to use (skip prompt)")
 	workCreateCmd.Flags().StringVar(&flagFromBranch, "from-branch", "", "use an existing git branch instead of creating a new one")
+	workCreateCmd.Flags().StringVar(&flagBaseBranch, "base", "", "branch to base the work on (default: [repo] base_branch)")
+	workCreateCmd.Flags().BoolVar(&flagRemote, "remote", false, "run the work on the [remote] host (default: [remote] default)")
+	workCreateCmd.Flags().BoolVar(&flagKeepPartial, "keep-partial", false, "keep the work if starting the control plane fails, instead of removing it")
 	workCreateCmd.Flags().BoolVarP(&flagYes, "yes", "y", false, "skip confirmation prompts")
 	workReviewCmd.Flags().BoolVar(&flagReviewAuto, "auto", false, "run review-fix loop until clean")
+	workReviewCmd.Flags().BoolVar(&flagReviewCI, "ci", false, "include the checks failing on the work's PR, with log excerpts")
 	workAddCmd.Flags().StringVar(&flagAddWork, "work", "", "work ID (default: auto-detect from current directory)")
+	workAddCmd.Flags().BoolVar(&flagAddForce, "force", false, "skip beads another active work has instead of failing")
 	workRemoveCmd.Flags().StringVar(&flagRemoveWork, "work", "", "work ID (default: auto-detect from current directory)")
+	workTaskCmd.Flags().StringVar(&flagWorkTaskType, "type", "", "custom task type from [workflow.task_types]")
+	workTaskCmd.MarkFlagRequired("type")
+	workCompleteCmd.Flags().BoolVar(&flagCompleteKeepBeads, "keep-beads", false, "leave the work's beads open")
+	workCompleteCmd.Flags().BoolVar(&flagCompleteKeepBranch, "keep-branch", false, "keep the local branch")
+	workCompleteCmd.Flags().BoolVar(&flagCompleteKeepWorktree, "keep-worktree", false, "keep the worktree (implies --keep-branch)")
+	workCompleteCmd.Flags().BoolVar(&flagCompleteForce, "force", false, "complete without verifying the PR is merged")
 	workCmd.AddCommand(workCreateCmd)
 	workCmd.AddCommand(workListCmd)
 	workCmd.AddCommand(workShowCmd)
@@ -192,7 +276,12 @@ func init() {
 	workCmd.AddCommand(workClaudeCmd)
 	workCmd.AddCommand(workFeedbackCmd)
 	workCmd.AddCommand(workRestartCmd)
+	workCmd.AddCommand(workPauseCmd)
+	workCmd.AddCommand(workResumeCmd)
+	workCmd.AddCommand(workParallelCmd)
+	workCmd.AddCommand(workTaskCmd)
 	workCmd.AddCommand(workCompleteCmd)
+	workCmd.AddCommand(workReportCmd)
 }
 
 func runWorkCreate(cmd *cobra.Command, args []string) error {
@@ -208,8 +297,11 @@ func runWorkCreate(cmd *cobra.Command, args []string) error {
 	// Create WorkService for this operation
 	svc := workpkg.NewWorkService(proj)
 
-	// Get base branch from project config
-	baseBranch := proj.Config.Repo.GetBaseBranch()
+	// Base the work on --base, or the project's base branch
+	baseBranch := flagBaseBranch
+	if baseBranch == "" {
+		baseBranch = proj.Config.Repo.GetBaseBranch()
+	}
 
 	mainRepoPath := proj.MainRepoPath()
 	gitOps := git.NewOperations()
@@ -277,17 +369,35 @@ func runWorkCreate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Create work asynchronously (control plane handles worktree creation, git push, orchestrator spawn)
-	result, err := svc.CreateWorkAsyncWithOptions(ctx, workpkg.CreateWorkAsyncOptions{
+	remoteWork := proj.Config.Remote.Default
+	if cmd.Flags().Changed("remote") {
+		remoteWork = flagRemote
+	}
+
+	// Create work asynchronously (control plane handles worktree creation, git push, orchestrator spawn).
+	// A work whose control plane can't be started is removed again, unless --keep-partial.
+	var sessionResult *control.InitResult
+	result, err := svc.CreateAndStartWork(ctx, workpkg.CreateWorkAsyncOptions{
 		BranchName:        branchName,
 		BaseBranch:        baseBranch,
 		RootIssueID:       beadID,
 		Auto:              flagAutoRun,
 		UseExistingBranch: useExistingBranch,
 		BeadIDs:           expandedIssueIDs,
+		Remote:            remoteWork,
+	}, workpkg.StartWorkOptions{
+		Start: func(ctx context.Context) error {
+			var err error
+			sessionResult, err = control.EnsureControlPlane(ctx, proj)
+			return err
+		},
+		KeepPartial: flagKeepPartial,
 	})
 	if err != nil {
-		return fmt.Errorf("failed to create work: %w", err)
+		if result != nil {
+			return fmt.Errorf("work %s was created but not started: %w", result.WorkID, err)
+		}
+		return fmt.Errorf("work not created: %w", err)
 	}
 
 	fmt.Printf("\nCreated work: %s\n", result.WorkID)
@@ -296,6 +406,9 @@ func runWorkCreate(cmd *cobra.Command, args []string) error {
 	}
 	fmt.Printf("Branch: %s\n", result.BranchName)
 	fmt.Printf("Base Branch: %s\n", result.BaseBranch)
+	if remoteWork {
+		fmt.Printf("Host: %s\n", proj.Config.Remote.Host)
+	}
 
 	// Display beads
 	fmt.Printf("\nBeads (%d):\n", len(groupIssues))
@@ -303,11 +416,7 @@ func runWorkCreate(cmd *cobra.Command, args []string) error {
 		fmt.Printf("  - %s: %s\n", issue.ID, issue.Title)
 	}
 
-	// Ensure zellij session and control plane are running
-	sessionResult, err := control.EnsureControlPlane(ctx, proj)
-	if err != nil {
-		fmt.Printf("Warning: failed to ensure control plane: %v\n", err)
-	} else if sessionResult.SessionCreated {
+	if sessionResult.SessionCreated {
 		printSessionCreatedNotification(sessionResult.SessionName)
 	}
 
@@ -416,11 +525,19 @@ func runWorkAdd(cmd *cobra.Command, args []string) error {
 	}
 
 	// Add beads to work using WorkService (handles validation internally)
-	result, err := svc.AddBeads(ctx, workID, beadIDs)
+	result, err := svc.AddBeads(ctx, workID, beadIDs, flagAddForce)
 	if err != nil {
+		if errors.Is(err, coerrors.Conflict) {
+			return fmt.Errorf("%w (use --force to skip them)", err)
+		}
 		return err
 	}
 
+	for _, beadID := range beadIDs {
+		if owner, ok := result.Skipped[beadID]; ok {
+			fmt.Printf("Warning: skipped %s, already in active work %s\n", beadID, owner)
+		}
+	}
 	fmt.Printf("Added %d bead(s) to work %s\n", result.BeadsAdded, workID)
 	return nil
 }
@@ -562,12 +679,24 @@ func runWorkShow(cmd *cobra.Command, args []string) error {
 	// Display work details
 	fmt.Printf("Work: %s\n", work.ID)
 	fmt.Printf("Status: %s\n", work.Status)
+	if work.Paused {
+		fmt.Println("Paused: yes (run 'co work resume' to continue)")
+	}
 	if work.RootIssueID != "" {
 		fmt.Printf("Root Issue: %s\n", work.RootIssueID)
 	}
 	fmt.Printf("Branch: %s\n", work.BranchName)
 	fmt.Printf("Base Branch: %s\n", work.BaseBranch)
 	fmt.Printf("Worktree: %s\n", work.WorktreePath)
+	if len(work.SetupWarnings) > 0 {
+		fmt.Println("Worktree Setup Warnings:")
+		for _, warning := range work.SetupWarnings {
+			fmt.Println(indentLines(warning, "  "))
+		}
+	}
+	if work.Notes != "" {
+		fmt.Printf("Notes:\n%s\n", indentLines(work.Notes, "  "))
+	}
 
 	if work.PRURL != "" {
 		fmt.Printf("PR URL: %s\n", work.PRURL)
@@ -636,6 +765,16 @@ func runWorkDestroy(cmd *cobra.Command, args []string) error {
 	// Create WorkService for this operation
 	svc := workpkg.NewWorkService(proj)
 
+	if flagGlobalDryRun {
+		plan, err := svc.PlanDestroyWork(ctx, workID)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Would destroy work %s:\n", workID)
+		plan.Print(os.Stdout)
+		return nil
+	}
+
 	// Check if work has uncompleted tasks (for interactive confirmation)
 	tasks, err := proj.DB.GetWorkTasks(ctx, workID)
 	if err != nil {
@@ -668,57 +807,6 @@ func runWorkDestroy(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-// CreatePRTaskResult contains the result of creating a PR task.
-type CreatePRTaskResult struct {
-	TaskID string
-	// PRExists is true if a PR already exists for this work
-	PRExists bool
-	PRURL    string
-}
-
-// CreatePRTask creates a PR task for a work unit.
-// The work must be completed before a PR task can be created.
-// Returns an error if the work is not completed, or PRExists=true if a PR already exists.
-func CreatePRTask(ctx context.Context, proj *project.Project, workID string) (*CreatePRTaskResult, error) {
-	// Get work details
-	work, err := proj.DB.GetWork(ctx, workID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get work: %w", err)
-	}
-	if work == nil {
-		return nil, fmt.Errorf("work %s not found", workID)
-	}
-
-	// Check if work is completed
-	if work.Status != db.StatusCompleted {
-		return nil, fmt.Errorf("work %s is not completed (status: %s)", workID, work.Status)
-	}
-
-	// Check if PR already exists
-	if work.PRURL != "" {
-		return &CreatePRTaskResult{
-			PRExists: true,
-			PRURL:    work.PRURL,
-		}, nil
-	}
-
-	// Generate task ID for PR creation
-	prTaskNum, err := proj.DB.GetNextTaskNumber(ctx, workID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get next task number for PR: %w", err)
-	}
-	prTaskID := fmt.Sprintf("%s.%d", workID, prTaskNum)
-
-	// Create a PR creation task
-	if err := proj.DB.CreateTask(ctx, prTaskID, "pr", []string{}, 0, workID); err != nil {
-		return nil, fmt.Errorf("failed to create PR task: %w", err)
-	}
-
-	return &CreatePRTaskResult{
-		TaskID: prTaskID,
-	}, nil
-}
-
 func runWorkPR(cmd *cobra.Command, args []string) error {
 	// Find project
 	ctx := GetContext()
@@ -740,7 +828,7 @@ func runWorkPR(cmd *cobra.Command, args []string) error {
 	}
 
 	// Create PR task using the shared function
-	result, err := CreatePRTask(ctx, proj, workID)
+	result, err := workpkg.NewWorkService(proj).CreatePRTask(ctx, workID)
 	if err != nil {
 		return err
 	}
@@ -766,47 +854,14 @@ func runWorkPR(cmd *cobra.Command, args []string) error {
 		fmt.Printf("Closing root issue %s...\n", work.RootIssueID)
 		if err := beads.Close(ctx, work.RootIssueID, proj.BeadsPath()); err != nil {
 			fmt.Printf("Warning: failed to close root issue %s: %v\n", work.RootIssueID, err)
+		} else if err := workpkg.NewOrchestratorManager(proj.DB).TerminatePlanSessions(ctx, []string{work.RootIssueID}, proj.Config.Project.Name, os.Stdout); err != nil {
+			fmt.Printf("Warning: failed to end plan session of %s: %v\n", work.RootIssueID, err)
 		}
 	}
 
 	return nil
 }
 
-// CreateReviewTaskResult contains the result of creating a review task.
-type CreateReviewTaskResult struct {
-	TaskID string
-}
-
-// CreateReviewTask creates a review task for a work unit.
-// Review tasks examine code changes for quality and security issues.
-// Returns the task ID of the created review task.
-func CreateReviewTask(ctx context.Context, proj *project.Project, workID string) (*CreateReviewTaskResult, error) {
-	// Verify work exists
-	work, err := proj.DB.GetWork(ctx, workID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get work: %w", err)
-	}
-	if work == nil {
-		return nil, fmt.Errorf("work %s not found", workID)
-	}
-
-	// Generate task ID for review
-	reviewTaskNum, err := proj.DB.GetNextTaskNumber(ctx, workID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get next task number for review: %w", err)
-	}
-	reviewTaskID := fmt.Sprintf("%s.%d", workID, reviewTaskNum)
-
-	// Create the review task
-	if err := proj.DB.CreateTask(ctx, reviewTaskID, "review", []string{}, 0, workID); err != nil {
-		return nil, fmt.Errorf("failed to create review task: %w", err)
-	}
-
-	return &CreateReviewTaskResult{
-		TaskID: reviewTaskID,
-	}, nil
-}
-
 func runWorkReview(cmd *cobra.Command, args []string) error {
 	// Find project
 	ctx := GetContext()
@@ -837,6 +892,7 @@ func runWorkReview(cmd *cobra.Command, args []string) error {
 	}
 
 	// Run review-fix loop if --auto is set
+	svc := workpkg.NewWorkService(proj)
 	runner := claude.NewRunner()
 	maxIterations := proj.Config.Workflow.GetMaxReviewIterations()
 	for iteration := 0; ; iteration++ {
@@ -847,7 +903,8 @@ func runWorkReview(cmd *cobra.Command, args []string) error {
 		}
 
 		// Create a review task using the shared function
-		result, err := CreateReviewTask(ctx, proj, workID)
+		// CI failures are only worth a review once; later iterations review the fixes
+		result, err := svc.CreateReviewTask(ctx, workID, workpkg.CreateReviewTaskOptions{IncludeCIFailures: flagReviewCI && iteration == 0})
 		if err != nil {
 			return err
 		}
@@ -915,7 +972,7 @@ func runWorkReview(cmd *cobra.Command, args []string) error {
 			}
 			taskID := fmt.Sprintf("%s.%d", workID, nextNum)
 
-			if err := proj.DB.CreateTask(ctx, taskID, "implement", []string{b.ID}, 0, workID); err != nil {
+			if err := proj.DB.CreateTask(ctx, taskID, db.TaskTypeImplement, []string{b.ID}, 0, workID); err != nil {
 				return fmt.Errorf("failed to create fix task: %w", err)
 			}
 
@@ -1019,7 +1076,7 @@ func runWorkConsole(cmd *cobra.Command, args []string) error {
 
 	// Open console in the work's worktree
 	orchestratorMgr := workpkg.NewOrchestratorManager(proj.DB)
-	return orchestratorMgr.OpenConsole(ctx, workID, proj.Config.Project.Name, work.WorktreePath, work.Name, proj.Config.Hooks.Env, os.Stdout)
+	return orchestratorMgr.OpenConsole(ctx, workID, proj.Config.Project.Name, work.WorktreePath, work.RemoteHost, work.Name, project.MergeEnv(proj.Config.Hooks.Env, work.Env), os.Stdout)
 }
 
 func runWorkClaude(cmd *cobra.Command, args []string) error {
@@ -1058,7 +1115,7 @@ func runWorkClaude(cmd *cobra.Command, args []string) error {
 
 	// Open Claude Code session in the work's worktree
 	orchestratorMgr := workpkg.NewOrchestratorManager(proj.DB)
-	return orchestratorMgr.OpenClaudeSession(ctx, workID, proj.Config.Project.Name, work.WorktreePath, work.Name, proj.Config.Hooks.Env, proj.Config, os.Stdout)
+	return orchestratorMgr.OpenClaudeSession(ctx, workID, proj.Config.Project.Name, work.WorktreePath, work.RemoteHost, work.Name, project.MergeEnv(proj.Config.Hooks.Env, work.Env), proj.Config, os.Stdout)
 }
 
 func runWorkRestart(cmd *cobra.Command, args []string) error {
@@ -1100,6 +1157,133 @@ func runWorkRestart(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runWorkPause(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer proj.Close()
+
+	var workID string
+	if len(args) > 0 {
+		workID = args[0]
+	} else {
+		workID, err = getCurrentWork(proj)
+		if err != nil {
+			return fmt.Errorf("not in a work directory and no work ID specified")
+		}
+	}
+
+	svc := workpkg.NewWorkService(proj)
+	if err := svc.PauseWork(ctx, workID); err != nil {
+		return err
+	}
+
+	fmt.Printf("Work %s paused. A running task will finish, but no new tasks will start.\n", workID)
+	return nil
+}
+
+func runWorkResume(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer proj.Close()
+
+	var workID string
+	if len(args) > 0 {
+		workID = args[0]
+	} else {
+		workID, err = getCurrentWork(proj)
+		if err != nil {
+			return fmt.Errorf("not in a work directory and no work ID specified")
+		}
+	}
+
+	svc := workpkg.NewWorkService(proj)
+	spawned, err := svc.ResumeWork(ctx, workID, os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Work %s resumed.\n", workID)
+	if spawned {
+		fmt.Println("Orchestrator spawned in zellij tab.")
+	}
+	return nil
+}
+
+func runWorkParallel(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 0 {
+		return fmt.Errorf("invalid task count %q: must be a number >= 0", args[0])
+	}
+
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer proj.Close()
+
+	var workID string
+	if len(args) > 1 {
+		workID = args[1]
+	} else {
+		workID, err = getCurrentWork(proj)
+		if err != nil {
+			return fmt.Errorf("not in a work directory and no work ID specified")
+		}
+	}
+
+	if err := proj.DB.SetWorkMaxParallelTasks(ctx, workID, n); err != nil {
+		return err
+	}
+
+	if n == 0 {
+		fmt.Printf("Work %s runs up to %d task(s) at once (project setting).\n", workID, proj.Config.Workflow.GetMaxParallelTasks())
+	} else {
+		fmt.Printf("Work %s runs up to %d task(s) at once.\n", workID, n)
+	}
+	return nil
+}
+
+func runWorkTask(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer proj.Close()
+
+	var workID string
+	if len(args) > 0 {
+		workID = args[0]
+	} else {
+		workID, err = getCurrentWork(proj)
+		if err != nil {
+			return fmt.Errorf("not in a work directory and no work ID specified")
+		}
+	}
+
+	svc := workpkg.NewWorkService(proj)
+	result, err := svc.CreateCustomTask(ctx, workID, flagWorkTaskType, os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	if result.OrchestratorSpawned {
+		fmt.Println("Orchestrator spawned in zellij tab.")
+	}
+	return nil
+}
+
 // printSessionCreatedNotification displays a prominent notification when a new zellij session is created.
 func printSessionCreatedNotification(sessionName string) {
 	fmt.Println()
@@ -1131,22 +1315,26 @@ func runWorkComplete(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	work, err := proj.DB.GetWork(ctx, workID)
-	if err != nil {
-		return fmt.Errorf("failed to get work: %w", err)
-	}
-	if work == nil {
-		return fmt.Errorf("work %s not found", workID)
-	}
-
-	if work.Status != db.StatusIdle {
-		return fmt.Errorf("work %s is not in idle state (current status: %s)", workID, work.Status)
+	svc := workpkg.NewWorkService(proj)
+	opts := workpkg.CompleteWorkOptions{
+		KeepBeads:    flagCompleteKeepBeads,
+		KeepBranch:   flagCompleteKeepBranch,
+		KeepWorktree: flagCompleteKeepWorktree,
+		SkipPRCheck:  flagCompleteForce,
+	}
+	if flagGlobalDryRun {
+		plan, err := svc.PlanCompleteWork(ctx, workID)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Would complete work %s:\n", workID)
+		plan.Print(os.Stdout, opts)
+		return nil
 	}
-
-	if err := proj.DB.CompleteWork(ctx, workID, work.PRURL); err != nil {
-		return fmt.Errorf("failed to complete work: %w", err)
+	if err := svc.CompleteWork(ctx, workID, opts, os.Stdout); err != nil {
+		return err
 	}
 
-	fmt.Printf("Work %s marked as completed.\n", workID)
+	fmt.Printf("Work %s completed.\n", workID)
 	return nil
 }
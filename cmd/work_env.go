@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/newhook/co/internal/project"
+	"github.com/spf13/cobra"
+)
+
+var workEnvCmd = &cobra.Command{
+	Use:   "env [<id>] [KEY=VALUE...]",
+	Short: "Show or change a work's environment overrides",
+	Long: `Show or change the environment variables set for a single work. They are
+applied over [hooks] env when opening the work's console and Claude session
+and when its orchestrator starts, so a key set here wins over the project's.
+
+Without assignments the overrides are listed. KEY=VALUE sets a variable and
+--unset KEY removes one. A running orchestrator picks changes up when it is
+restarted.
+If no ID is provided, uses the work for the current directory context.`,
+	RunE: runWorkEnv,
+}
+
+var flagEnvUnset []string
+
+func init() {
+	workEnvCmd.Flags().StringArrayVar(&flagEnvUnset, "unset", nil, "remove the override of this variable (repeatable)")
+	workCmd.AddCommand(workEnvCmd)
+}
+
+func runWorkEnv(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer proj.Close()
+
+	// The ID is optional; everything else is an assignment
+	var workID string
+	if len(args) > 0 && !strings.Contains(args[0], "=") {
+		workID, args = args[0], args[1:]
+	} else {
+		workID, err = getCurrentWork(proj)
+		if err != nil {
+			return fmt.Errorf("not in a work directory and no work ID specified")
+		}
+	}
+	for _, arg := range args {
+		if _, _, err := project.ParseEnvVar(arg); err != nil {
+			return err
+		}
+	}
+
+	work, err := proj.DB.GetWork(ctx, workID)
+	if err != nil {
+		return fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return fmt.Errorf("work %s not found", workID)
+	}
+
+	if len(args) == 0 && len(flagEnvUnset) == 0 {
+		if len(work.Env) == 0 {
+			fmt.Printf("Work %s has no environment overrides.\n", workID)
+			return nil
+		}
+		for _, entry := range work.Env {
+			fmt.Println(entry)
+		}
+		return nil
+	}
+
+	env := project.MergeEnv(work.Env, args)
+	env = slices.DeleteFunc(slices.Clone(env), func(entry string) bool {
+		key, _, _ := project.ParseEnvVar(entry)
+		return slices.Contains(flagEnvUnset, key)
+	})
+	if err := proj.DB.SetWorkEnv(ctx, workID, env); err != nil {
+		return err
+	}
+	fmt.Printf("Work %s has %d environment override(s).\n", workID, len(env))
+	return nil
+}
@@ -66,7 +66,7 @@ func runWorkFeedback(cmd *cobra.Command, args []string) error {
 }
 
 // detectWork tries to detect the work from the current directory
-func detectWork(ctx context.Context, proj *project.Project, database *db.DB) (*db.Work, error) {
+func detectWork(ctx context.Context, proj *project.Project, database db.Store) (*db.Work, error) {
 	// Get current directory
 	cwd, err := os.Getwd()
 	if err != nil {
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/newhook/co/internal/project"
+	workpkg "github.com/newhook/co/internal/work"
+	"github.com/newhook/co/internal/worktree"
+	"github.com/spf13/cobra"
+)
+
+var workGCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Show worktree disk usage and remove build artifacts from finished works",
+	Long: `List every work's worktree by size and age, then offer to remove build
+artifacts (node_modules, target/, ...) from works that are completed or merged.
+
+Artifacts are matched by name against [gc] artifact_patterns in the project
+config. Only untracked and ignored paths are removed: anything git tracks,
+and .git, is never touched.`,
+	Args: cobra.NoArgs,
+	RunE: runWorkGC,
+}
+
+var (
+	flagGCDryRun bool
+	flagGCYes    bool
+)
+
+func init() {
+	workGCCmd.Flags().BoolVar(&flagGCDryRun, "dry-run", false, "list worktrees and artifacts without removing anything")
+	workGCCmd.Flags().BoolVarP(&flagGCYes, "yes", "y", false, "remove artifacts without asking")
+	workCmd.AddCommand(workGCCmd)
+}
+
+func runWorkGC(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return fmt.Errorf("not in a project directory: %w", err)
+	}
+	defer proj.Close()
+
+	svc := workpkg.NewWorkService(proj)
+	fmt.Println("Measuring worktrees...")
+	usages, err := svc.GatherWorktreeUsage(ctx)
+	if err != nil {
+		return err
+	}
+	if len(usages) == 0 {
+		fmt.Println("No worktrees on disk.")
+		return nil
+	}
+
+	now := time.Now()
+	var total int64
+	var collectable []worktree.Artifact
+	var reclaimable int64
+	fmt.Printf("\n%-12s %-10s %10s %6s  %s\n", "WORK", "STATUS", "SIZE", "AGE", "ARTIFACTS")
+	for _, u := range usages {
+		total += u.Usage.Bytes
+		artifacts := "-"
+		if len(u.Artifacts) > 0 {
+			artifacts = fmt.Sprintf("%d (%s)", len(u.Artifacts), worktree.FormatBytes(u.ArtifactBytes()))
+			collectable = append(collectable, u.Artifacts...)
+			reclaimable += u.ArtifactBytes()
+		}
+		fmt.Printf("%-12s %-10s %10s %6s  %s\n", u.Work.ID, u.Work.Status, u.Usage, formatAge(u.Age(now)), artifacts)
+	}
+	fmt.Printf("\nTotal: %s in %d worktree(s)\n", worktree.FormatBytes(total), len(usages))
+
+	if len(collectable) == 0 {
+		fmt.Println("No build artifacts to remove from completed works.")
+		return nil
+	}
+
+	fmt.Printf("\nBuild artifacts in completed works (%s):\n", worktree.FormatBytes(reclaimable))
+	for _, a := range collectable {
+		fmt.Printf("  %s (%s)\n", a.Path, a.Usage)
+	}
+	if flagGCDryRun {
+		return nil
+	}
+
+	if !flagGCYes {
+		fmt.Printf("Remove %d artifact(s)? [y/N]: ", len(collectable))
+		reader := bufio.NewReader(os.Stdin)
+		response, _ := reader.ReadString('\n')
+		response = strings.TrimSpace(strings.ToLower(response))
+		if response != "y" && response != "yes" {
+			fmt.Println("Aborted.")
+			return nil
+		}
+	}
+
+	freed, err := worktree.RemoveArtifacts(collectable)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Freed %s\n", worktree.FormatBytes(freed))
+	return nil
+}
+
+// formatAge formats a duration coarsely, e.g. "3d", "5h", "12m".
+func formatAge(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%dd", int(d/(24*time.Hour)))
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", int(d/time.Hour))
+	default:
+		return fmt.Sprintf("%dm", int(d/time.Minute))
+	}
+}
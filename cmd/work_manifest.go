@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/newhook/co/internal/control"
+	"github.com/newhook/co/internal/project"
+	workpkg "github.com/newhook/co/internal/work"
+	"github.com/spf13/cobra"
+)
+
+var workExportCmd = &cobra.Command{
+	Use:   "export [<id>]",
+	Short: "Write a work's branch and beads to a manifest file",
+	Long: `Write a manifest describing a work: its branch, name, notes and beads with
+their titles, as YAML. Hand the file to a teammate and 'co work import' creates
+the same work in their project. Tasks and progress aren't included.
+
+Without -o the manifest is printed.
+If no ID is provided, uses the work for the current directory context.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWorkExport,
+}
+
+var workImportCmd = &cobra.Command{
+	Use:   "import <manifest>",
+	Short: "Create a work from a manifest file",
+	Long: `Create a work from a manifest written by 'co work export' and assign its
+beads to it.
+
+Beads that don't exist in this project, are closed, or are already in another
+work are left out and listed; the work is created with the rest, unless none
+are left. A branch name that is already taken gets a numeric suffix.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWorkImport,
+}
+
+var flagExportOutput string
+
+func init() {
+	workExportCmd.Flags().StringVarP(&flagExportOutput, "output", "o", "", "write the manifest to this file")
+	workCmd.AddCommand(workExportCmd)
+	workCmd.AddCommand(workImportCmd)
+}
+
+func runWorkExport(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer proj.Close()
+
+	var workID string
+	if len(args) > 0 {
+		workID = args[0]
+	} else {
+		workID, err = getCurrentWork(proj)
+		if err != nil {
+			return fmt.Errorf("not in a work directory and no work ID specified")
+		}
+	}
+
+	manifest, err := workpkg.NewWorkService(proj).ExportWork(ctx, workID)
+	if err != nil {
+		return err
+	}
+	data, err := manifest.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if flagExportOutput == "" {
+		fmt.Print(string(data))
+		return nil
+	}
+	if err := os.WriteFile(flagExportOutput, data, 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	fmt.Printf("Exported %s (%d beads) to %s\n", workID, len(manifest.Beads), flagExportOutput)
+	return nil
+}
+
+func runWorkImport(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer proj.Close()
+
+	manifest, err := workpkg.ReadManifest(args[0])
+	if err != nil {
+		return err
+	}
+
+	result, err := workpkg.NewWorkService(proj).ImportWork(ctx, manifest)
+	if result != nil && result.Skipped() {
+		printSkippedManifestBeads(result)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to import work: %w", err)
+	}
+
+	fmt.Printf("\nCreated work: %s\n", result.WorkID)
+	if result.WorkerName != "" {
+		fmt.Printf("Worker: %s\n", result.WorkerName)
+	}
+	fmt.Printf("Branch: %s\n", result.BranchName)
+	if result.BranchName != manifest.Branch {
+		fmt.Printf("  (%s is taken)\n", manifest.Branch)
+	}
+	fmt.Printf("Base Branch: %s\n", result.BaseBranch)
+	fmt.Printf("\nBeads (%d of %d):\n", len(result.Added), len(manifest.Beads))
+	titles := make(map[string]string)
+	for _, b := range manifest.Beads {
+		titles[b.ID] = b.Title
+	}
+	for _, id := range result.Added {
+		fmt.Printf("  - %s: %s\n", id, titles[id])
+	}
+
+	// Ensure zellij session and control plane are running
+	sessionResult, err := control.EnsureControlPlane(ctx, proj)
+	if err != nil {
+		fmt.Printf("Warning: failed to ensure control plane: %v\n", err)
+	} else if sessionResult.SessionCreated {
+		printSessionCreatedNotification(sessionResult.SessionName)
+	}
+
+	fmt.Printf("\nThe control plane will create the worktree and start the orchestrator.\n")
+	return nil
+}
+
+// printSkippedManifestBeads lists the manifest's beads an import left out
+func printSkippedManifestBeads(result *workpkg.ImportWorkResult) {
+	fmt.Println("Skipped beads:")
+	for _, id := range result.Missing {
+		fmt.Printf("  - %s: not found\n", id)
+	}
+	for _, id := range result.Closed {
+		fmt.Printf("  - %s: closed\n", id)
+	}
+	taken := make([]string, 0, len(result.Taken))
+	for id := range result.Taken {
+		taken = append(taken, id)
+	}
+	sort.Strings(taken)
+	for _, id := range taken {
+		fmt.Printf("  - %s: already in %s\n", id, result.Taken[id])
+	}
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/newhook/co/internal/project"
+	"github.com/spf13/cobra"
+)
+
+var workNotesCmd = &cobra.Command{
+	Use:   "notes [<id>]",
+	Short: "Show or change a work's notes",
+	Long: `Show the free-form notes kept with a work, such as what it is waiting on or
+links to design docs. Notes show up in the TUI and in 'co work report'.
+
+With --set the notes are replaced by the given text (an empty string clears
+them); with --edit they are opened in $EDITOR.
+If no ID is provided, uses the work for the current directory context.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWorkNotes,
+}
+
+var (
+	flagNotesEdit bool
+	flagNotesSet  string
+)
+
+func init() {
+	workNotesCmd.Flags().BoolVar(&flagNotesEdit, "edit", false, "edit the notes in $EDITOR")
+	workNotesCmd.Flags().StringVar(&flagNotesSet, "set", "", "replace the notes with this text")
+	workNotesCmd.MarkFlagsMutuallyExclusive("edit", "set")
+	workCmd.AddCommand(workNotesCmd)
+}
+
+func runWorkNotes(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer proj.Close()
+
+	var workID string
+	if len(args) > 0 {
+		workID = args[0]
+	} else {
+		workID, err = getCurrentWork(proj)
+		if err != nil {
+			return fmt.Errorf("not in a work directory and no work ID specified")
+		}
+	}
+
+	work, err := proj.DB.GetWork(ctx, workID)
+	if err != nil {
+		return fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return fmt.Errorf("work %s not found", workID)
+	}
+
+	var notes string
+	switch {
+	case cmd.Flags().Changed("set"):
+		notes = flagNotesSet
+	case flagNotesEdit:
+		notes, err = editInEditor(ctx, work.Notes)
+		if err != nil {
+			return err
+		}
+	default:
+		if work.Notes == "" {
+			fmt.Printf("Work %s has no notes.\n", workID)
+			return nil
+		}
+		fmt.Println(strings.TrimRight(work.Notes, "\n"))
+		return nil
+	}
+
+	notes = strings.TrimSpace(notes)
+	if err := proj.DB.SetWorkNotes(ctx, workID, notes); err != nil {
+		return err
+	}
+	if notes == "" {
+		fmt.Printf("Cleared the notes of work %s.\n", workID)
+	} else {
+		fmt.Printf("Saved the notes of work %s.\n", workID)
+	}
+	return nil
+}
+
+// editInEditor opens text in $EDITOR (vi if unset) and returns what was saved
+func editInEditor(ctx context.Context, text string) (string, error) {
+	f, err := os.CreateTemp("", "co-notes-*.md")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(text); err != nil {
+		f.Close()
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	editor := strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{"vi"}
+	}
+	c := exec.CommandContext(ctx, editor[0], append(editor[1:], f.Name())...)
+	c.Stdin, c.Stdout, c.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := c.Run(); err != nil {
+		return "", fmt.Errorf("editor failed: %w", err)
+	}
+
+	data, err := os.ReadFile(f.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to read temp file: %w", err)
+	}
+	return string(data), nil
+}
+
+// indentLines prefixes every line of text with indent
+func indentLines(text, indent string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = indent + line
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/project"
+	"github.com/spf13/cobra"
+)
+
+var workReconcileCmd = &cobra.Command{
+	Use:   "reconcile [<id>]",
+	Short: "Correct a work's status from its tasks",
+	Long: `Set a work's status to the one its tasks imply, when they disagree:
+
+  any task processing                 processing
+  any task failed, none processing    failed
+  every task completed                idle
+  no tasks                            pending
+
+A work left processing by an orchestrator that died after its last task, or
+left pending while a task runs, is put right. Completed and merged works are
+never changed. The orchestrator and the TUI make the same correction when
+they see a mismatch; this runs it by hand.
+
+With --all every work is reconciled. If no ID is provided, uses the work for
+the current directory context.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runWorkReconcile,
+}
+
+var flagReconcileAll bool
+
+func init() {
+	workReconcileCmd.Flags().BoolVar(&flagReconcileAll, "all", false, "reconcile every work")
+	workCmd.AddCommand(workReconcileCmd)
+}
+
+func runWorkReconcile(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer proj.Close()
+
+	var corrections []*db.WorkStatusCorrection
+	if flagReconcileAll {
+		if len(args) > 0 {
+			return fmt.Errorf("--all doesn't take a work ID")
+		}
+		corrections, err = proj.DB.ReconcileWorkStatuses(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to reconcile works: %w", err)
+		}
+	} else {
+		var workID string
+		if len(args) > 0 {
+			workID = args[0]
+		} else {
+			workID, err = getCurrentWork(proj)
+			if err != nil {
+				return fmt.Errorf("not in a work directory and no work ID specified")
+			}
+		}
+		correction, err := proj.DB.ReconcileWorkStatus(ctx, workID)
+		if err != nil {
+			return fmt.Errorf("failed to reconcile work: %w", err)
+		}
+		if correction != nil {
+			corrections = append(corrections, correction)
+		}
+	}
+
+	if len(corrections) == 0 {
+		fmt.Println("Work statuses already match their tasks.")
+		return nil
+	}
+	for _, c := range corrections {
+		fmt.Printf("%s: %s -> %s\n", c.WorkID, c.From, c.To)
+	}
+	return nil
+}
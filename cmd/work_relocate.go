@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/newhook/co/internal/project"
+	workpkg "github.com/newhook/co/internal/work"
+	"github.com/spf13/cobra"
+)
+
+var workRelocateCmd = &cobra.Command{
+	Use:   "relocate <id> [--recreate|--path <dir>]",
+	Short: "Repair a work whose worktree directory is missing",
+	Long: `Repair a work whose recorded worktree no longer exists, for example after the
+repository was moved.
+
+--path records the directory the worktree was moved to. It must be a worktree of
+this repository with the work's branch checked out; git's links to it are
+repaired first.
+
+--recreate checks the work's branch out again at <project>/<id>/tree and runs the
+[worktree] setup. It refuses to use a directory that isn't empty. Uncommitted
+changes in the lost worktree are not recovered.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWorkRelocate,
+}
+
+var (
+	flagRelocateRecreate bool
+	flagRelocatePath     string
+)
+
+func init() {
+	workRelocateCmd.Flags().BoolVar(&flagRelocateRecreate, "recreate", false, "check the branch out again at the default location")
+	workRelocateCmd.Flags().StringVar(&flagRelocatePath, "path", "", "directory the worktree was moved to")
+	workRelocateCmd.MarkFlagsMutuallyExclusive("recreate", "path")
+	workRelocateCmd.MarkFlagsOneRequired("recreate", "path")
+	workCmd.AddCommand(workRelocateCmd)
+}
+
+func runWorkRelocate(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return err
+	}
+	defer proj.Close()
+
+	result, err := workpkg.NewWorkService(proj).RelocateWorktree(ctx, args[0], workpkg.RelocateOptions{
+		Recreate: flagRelocateRecreate,
+		Path:     flagRelocatePath,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to relocate worktree: %w", err)
+	}
+
+	if result.Recreated {
+		fmt.Printf("Recreated worktree for %s at %s\n", args[0], result.NewPath)
+	} else {
+		fmt.Printf("Worktree for %s is now %s\n", args[0], result.NewPath)
+	}
+	fmt.Printf("  (was %s)\n", result.OldPath)
+	for _, warning := range result.SetupWarnings {
+		fmt.Printf("Warning: %s\n", warning)
+	}
+	fmt.Printf("\nRun 'co run %s' to restart its orchestrator.\n", args[0])
+	return nil
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/newhook/co/internal/project"
+	workpkg "github.com/newhook/co/internal/work"
+	"github.com/spf13/cobra"
+)
+
+var workReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Print a markdown summary of recent work activity",
+	Long: `Print a markdown report of what each work did, for standups.
+
+For every work that is in progress or was completed in the window, the report
+lists its name, branch, and PR, the beads closed in the window, the tasks that
+finished in the window with their durations, and any failed tasks as blockers.`,
+	Args: cobra.NoArgs,
+	RunE: runWorkReport,
+}
+
+var (
+	flagReportSince time.Duration
+	flagReportWork  string
+)
+
+func init() {
+	workReportCmd.Flags().DurationVar(&flagReportSince, "since", workpkg.DefaultReportWindow, "how far back to report")
+	workReportCmd.Flags().StringVar(&flagReportWork, "work", "", "only report on this work")
+}
+
+func runWorkReport(cmd *cobra.Command, args []string) error {
+	ctx := GetContext()
+
+	proj, err := project.Find(ctx, "")
+	if err != nil {
+		return fmt.Errorf("not in a project directory: %w", err)
+	}
+	defer proj.Close()
+
+	now := time.Now()
+	since := now.Add(-flagReportSince)
+
+	svc := workpkg.NewWorkService(proj)
+	data, err := svc.GatherReportData(ctx, flagReportWork, since)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(workpkg.RenderReport(data, since, now))
+	return nil
+}
@@ -0,0 +1,196 @@
+// Package api serves a project's works and tasks as read-only JSON over a
+// local HTTP server, for editor integrations.
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/newhook/co/internal/beads/pubsub"
+	"github.com/newhook/co/internal/progress"
+	"github.com/newhook/co/internal/project"
+	trackingwatcher "github.com/newhook/co/internal/tracking/watcher"
+)
+
+// PortFile is the file under the project's config directory the port of a
+// running server is written to.
+const PortFile = "api.port"
+
+// keepAliveInterval is how often an idle event stream gets a comment, so
+// proxies and clients don't time it out.
+const keepAliveInterval = 15 * time.Second
+
+// Source loads the data the API serves.
+type Source interface {
+	// Works returns the progress of every work.
+	Works(ctx context.Context) ([]*progress.WorkProgress, error)
+	// Work returns the progress of a work, or nil if there is no such work.
+	Work(ctx context.Context, id string) (*progress.WorkProgress, error)
+}
+
+// ProjectSource reads a project's tracking and beads databases, the same
+// way the TUI does.
+type ProjectSource struct {
+	Proj *project.Project
+}
+
+// Works implements Source.
+func (s ProjectSource) Works(ctx context.Context) ([]*progress.WorkProgress, error) {
+	return progress.FetchAllWorksPollData(ctx, s.Proj)
+}
+
+// Work implements Source.
+func (s ProjectSource) Work(ctx context.Context, id string) (*progress.WorkProgress, error) {
+	work, err := s.Proj.DB.GetWork(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return nil, nil
+	}
+	return progress.FetchWorkProgress(ctx, s.Proj, work)
+}
+
+// Event is the data of a "changed" event on /events.
+type Event struct {
+	// Works lists the works that changed. It's empty when that isn't known,
+	// and clients should reload everything.
+	Works []string `json:"works,omitempty"`
+}
+
+// NewHandler returns the API's routes:
+//
+//	GET /works             every work, without tasks
+//	GET /works/{id}        a work with its issues and tasks
+//	GET /works/{id}/tasks  a work's tasks
+//	GET /events            a server-sent event stream of changes
+//
+// events may be nil, in which case /events is not served.
+func NewHandler(source Source, events pubsub.Subscriber[trackingwatcher.WatcherEvent]) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /works", func(w http.ResponseWriter, r *http.Request) {
+		works, err := source.Works(r.Context())
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, err)
+			return
+		}
+		out := make([]Work, 0, len(works))
+		for _, wp := range works {
+			out = append(out, NewWork(wp, false))
+		}
+		writeJSON(w, http.StatusOK, out)
+	})
+	mux.HandleFunc("GET /works/{id}", func(w http.ResponseWriter, r *http.Request) {
+		wp, ok := loadWork(w, r, source)
+		if ok {
+			writeJSON(w, http.StatusOK, NewWork(wp, true))
+		}
+	})
+	mux.HandleFunc("GET /works/{id}/tasks", func(w http.ResponseWriter, r *http.Request) {
+		wp, ok := loadWork(w, r, source)
+		if ok {
+			writeJSON(w, http.StatusOK, NewTasks(wp))
+		}
+	})
+	if events != nil {
+		mux.HandleFunc("GET /events", func(w http.ResponseWriter, r *http.Request) {
+			serveEvents(w, r, events)
+		})
+	}
+	return mux
+}
+
+// loadWork loads the work named in the request's path, writing the error
+// response when it can't
+func loadWork(w http.ResponseWriter, r *http.Request, source Source) (*progress.WorkProgress, bool) {
+	id := r.PathValue("id")
+	wp, err := source.Work(r.Context(), id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return nil, false
+	}
+	if wp == nil {
+		writeError(w, http.StatusNotFound, fmt.Errorf("work %s not found", id))
+		return nil, false
+	}
+	return wp, true
+}
+
+// serveEvents streams a "changed" event for each change to the tracking
+// database, and an "error" event when the watcher fails, until the client
+// goes away
+func serveEvents(w http.ResponseWriter, r *http.Request, events pubsub.Subscriber[trackingwatcher.WatcherEvent]) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("streaming is not supported"))
+		return
+	}
+	ctx := r.Context()
+	sub := events.Subscribe(ctx)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	// Lets clients know they are subscribed before anything changes
+	fmt.Fprint(w, ": connected\n\n")
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+		case evt, ok := <-sub:
+			if !ok {
+				return
+			}
+			switch evt.Payload.Type {
+			case trackingwatcher.DBChanged:
+				var data Event
+				if ids, ok := evt.Payload.WorkIDs(); ok {
+					if len(ids) == 0 {
+						// Only tables the API doesn't serve changed
+						continue
+					}
+					data.Works = ids
+				}
+				writeEvent(w, "changed", data)
+			case trackingwatcher.WatcherError:
+				writeEvent(w, "error", errorBody{Error: fmt.Sprint(evt.Payload.Error)})
+			default:
+				continue
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+type errorBody struct {
+	Error string `json:"error"`
+}
+
+func writeEvent(w http.ResponseWriter, name string, data any) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, body)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorBody{Error: err.Error()})
+}
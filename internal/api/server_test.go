@@ -0,0 +1,192 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/newhook/co/internal/beads/pubsub"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/progress"
+	"github.com/newhook/co/internal/project"
+	trackingwatcher "github.com/newhook/co/internal/tracking/watcher"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSource serves fixed works
+type fakeSource struct {
+	works []*progress.WorkProgress
+	err   error
+}
+
+func (s *fakeSource) Works(ctx context.Context) ([]*progress.WorkProgress, error) {
+	return s.works, s.err
+}
+
+func (s *fakeSource) Work(ctx context.Context, id string) (*progress.WorkProgress, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	for _, wp := range s.works {
+		if wp.Work.ID == id {
+			return wp, nil
+		}
+	}
+	return nil, nil
+}
+
+func testWorks() []*progress.WorkProgress {
+	created := time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC)
+	return []*progress.WorkProgress{
+		{
+			Work: &db.Work{ID: "w-abc", Name: "login", Status: db.StatusProcessing, BranchName: "feat/login", CreatedAt: created},
+			Tasks: []*progress.TaskProgress{
+				{
+					Task:  &db.Task{ID: "w-abc.1", TaskType: "implement", Status: db.StatusCompleted},
+					Beads: []progress.BeadProgress{{ID: "bd-1", Title: "Add form", Status: db.StatusCompleted, BeadStatus: "closed"}},
+					Runs:  []*db.TaskRun{{Model: "opus", Status: db.StatusCompleted, StartedAt: created}},
+					Artifacts: []project.TaskArtifact{
+						{Name: "notes.md"},
+					},
+				},
+				{
+					Task:      &db.Task{ID: "w-abc.2", TaskType: "implement", Status: db.StatusPending},
+					Beads:     []progress.BeadProgress{{ID: "bd-2", Title: "Wire OAuth", Status: db.StatusPending, BeadStatus: "open"}},
+					DependsOn: []string{"w-abc.1"},
+					Title:     "OAuth",
+				},
+			},
+			WorkBeads: []progress.BeadProgress{
+				{ID: "bd-1", Title: "Add form", BeadStatus: "closed", Priority: 1, IssueType: "task"},
+				{ID: "bd-2", Title: "Wire OAuth", BeadStatus: "open", Priority: 2, IssueType: "task"},
+			},
+		},
+		{Work: &db.Work{ID: "w-def", Status: db.StatusPending, CreatedAt: created}},
+	}
+}
+
+func getJSON(t *testing.T, srv *httptest.Server, path string, want int, v any) {
+	t.Helper()
+	resp, err := http.Get(srv.URL + path)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, want, resp.StatusCode)
+	require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(v))
+}
+
+func TestWorksEndpoints(t *testing.T) {
+	source := &fakeSource{works: testWorks()}
+	srv := httptest.NewServer(NewHandler(source, nil))
+	defer srv.Close()
+
+	var works []Work
+	getJSON(t, srv, "/works", http.StatusOK, &works)
+	require.Len(t, works, 2)
+	require.Equal(t, "w-abc", works[0].ID)
+	require.Equal(t, "feat/login", works[0].Branch)
+	require.Equal(t, TaskCounts{Pending: 1, Completed: 1}, works[0].TaskCounts)
+	require.Empty(t, works[0].Tasks, "the list leaves out tasks")
+	require.Empty(t, works[0].Issues)
+
+	var work Work
+	getJSON(t, srv, "/works/w-abc", http.StatusOK, &work)
+	require.Equal(t, "login", work.Name)
+	require.Len(t, work.Issues, 2)
+	require.Equal(t, Issue{ID: "bd-2", Title: "Wire OAuth", Status: "open", Priority: 2, Type: "task"}, work.Issues[1])
+	require.Len(t, work.Tasks, 2)
+
+	var tasks []Task
+	getJSON(t, srv, "/works/w-abc/tasks", http.StatusOK, &tasks)
+	require.Equal(t, work.Tasks, tasks, "the same shape as the work's tasks")
+	require.Equal(t, "w-abc.1", tasks[0].ID)
+	require.Equal(t, []string{"notes.md"}, tasks[0].Artifacts)
+	require.Equal(t, "opus", tasks[0].Runs[0].Model)
+	require.Equal(t, "closed", tasks[0].Issues[0].Status)
+	require.Equal(t, db.StatusCompleted, tasks[0].Issues[0].TaskStatus)
+	require.Equal(t, "OAuth", tasks[1].Title)
+	require.Equal(t, []string{"w-abc.1"}, tasks[1].DependsOn)
+
+	getJSON(t, srv, "/works/w-def/tasks", http.StatusOK, &tasks)
+	require.NotNil(t, tasks)
+	require.Empty(t, tasks, "a work without tasks has an empty list, not null")
+
+	var body errorBody
+	getJSON(t, srv, "/works/w-nope", http.StatusNotFound, &body)
+	require.Equal(t, "work w-nope not found", body.Error)
+	getJSON(t, srv, "/works/w-nope/tasks", http.StatusNotFound, &body)
+
+	source.err = errors.New("database is locked")
+	getJSON(t, srv, "/works", http.StatusInternalServerError, &body)
+	require.Equal(t, "database is locked", body.Error)
+	getJSON(t, srv, "/works/w-abc", http.StatusInternalServerError, &body)
+
+	// Read-only: other methods aren't routed
+	resp, err := http.Post(srv.URL+"/works", "application/json", strings.NewReader("{}"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	// /events is only served with a watcher
+	resp, err = http.Get(srv.URL + "/events")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestEventsEndpoint(t *testing.T) {
+	broker := pubsub.NewBroker[trackingwatcher.WatcherEvent]()
+	defer broker.Close()
+	srv := httptest.NewServer(NewHandler(&fakeSource{}, broker))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/events", nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	lines := bufio.NewScanner(resp.Body)
+	readEvent := func() []string {
+		t.Helper()
+		var event []string
+		for lines.Scan() {
+			if lines.Text() == "" {
+				return event
+			}
+			event = append(event, lines.Text())
+		}
+		require.NoError(t, lines.Err())
+		t.Fatal("event stream ended")
+		return nil
+	}
+	require.Equal(t, []string{": connected"}, readEvent())
+
+	broker.Publish(pubsub.UpdatedEvent, trackingwatcher.WatcherEvent{
+		Type: trackingwatcher.DBChanged,
+		Changes: []trackingwatcher.Change{
+			{Entity: trackingwatcher.EntityTask, ID: "w-abc.1", WorkID: "w-abc"},
+			{Entity: trackingwatcher.EntityWork, ID: "w-abc", WorkID: "w-abc"},
+		},
+	})
+	require.Equal(t, []string{"event: changed", `data: {"works":["w-abc"]}`}, readEvent())
+
+	// Changes to tables the API doesn't serve are skipped; unknown changes
+	// ask for a full reload
+	broker.Publish(pubsub.UpdatedEvent, trackingwatcher.WatcherEvent{Type: trackingwatcher.DBChanged, Changes: []trackingwatcher.Change{}})
+	broker.Publish(pubsub.UpdatedEvent, trackingwatcher.WatcherEvent{Type: trackingwatcher.DBChanged})
+	require.Equal(t, []string{"event: changed", `data: {}`}, readEvent())
+
+	broker.Publish(pubsub.UpdatedEvent, trackingwatcher.WatcherEvent{Type: trackingwatcher.WatcherError, Error: errors.New("watch failed")})
+	require.Equal(t, []string{"event: error", `data: {"error":"watch failed"}`}, readEvent())
+}
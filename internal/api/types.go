@@ -0,0 +1,179 @@
+package api
+
+import (
+	"time"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/progress"
+)
+
+// Work is the JSON form of a work. The list endpoint leaves out its tasks
+// and issues; TaskCounts summarizes them instead.
+type Work struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name,omitempty"`
+	Status         string     `json:"status"`
+	Branch         string     `json:"branch,omitempty"`
+	BaseBranch     string     `json:"base_branch,omitempty"`
+	WorktreePath   string     `json:"worktree_path,omitempty"`
+	RootIssue      string     `json:"root_issue,omitempty"`
+	PRURL          string     `json:"pr_url,omitempty"`
+	PRState        string     `json:"pr_state,omitempty"`
+	CIStatus       string     `json:"ci_status,omitempty"`
+	ApprovalStatus string     `json:"approval_status,omitempty"`
+	MergeableState string     `json:"mergeable_state,omitempty"`
+	Error          string     `json:"error,omitempty"`
+	Auto           bool       `json:"auto"`
+	Paused         bool       `json:"paused"`
+	CreatedAt      time.Time  `json:"created_at"`
+	StartedAt      *time.Time `json:"started_at,omitempty"`
+	CompletedAt    *time.Time `json:"completed_at,omitempty"`
+	TaskCounts     TaskCounts `json:"task_counts"`
+	Issues         []Issue    `json:"issues,omitempty"`
+	Tasks          []Task     `json:"tasks,omitempty"`
+}
+
+// TaskCounts counts a work's tasks by status.
+type TaskCounts struct {
+	Pending    int `json:"pending"`
+	Processing int `json:"processing"`
+	Completed  int `json:"completed"`
+	Failed     int `json:"failed"`
+}
+
+// Task is the JSON form of a task.
+type Task struct {
+	ID          string     `json:"id"`
+	Title       string     `json:"title,omitempty"`
+	Type        string     `json:"type"`
+	Status      string     `json:"status"`
+	FailureKind string     `json:"failure_kind,omitempty"`
+	Error       string     `json:"error,omitempty"`
+	DependsOn   []string   `json:"depends_on,omitempty"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	Issues      []Issue    `json:"issues"`
+	Runs        []Run      `json:"runs,omitempty"`
+	Artifacts   []string   `json:"artifacts,omitempty"`
+}
+
+// Issue is the JSON form of a bead in a work or task.
+type Issue struct {
+	ID     string `json:"id"`
+	Title  string `json:"title,omitempty"`
+	Status string `json:"status,omitempty"` // open, closed, ... from beads
+	// TaskStatus is how far the task it's in got with it; empty for the
+	// work's own list.
+	TaskStatus string   `json:"task_status,omitempty"`
+	Priority   int      `json:"priority"`
+	Type       string   `json:"type,omitempty"`
+	BlockedBy  []string `json:"blocked_by,omitempty"`
+}
+
+// Run is the JSON form of an attempt at a task.
+type Run struct {
+	Model         string     `json:"model,omitempty"`
+	Settings      string     `json:"settings,omitempty"`
+	ClaudeVersion string     `json:"claude_version,omitempty"`
+	Status        string     `json:"status"`
+	StartedAt     time.Time  `json:"started_at"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+}
+
+// NewWork converts a work's progress to its JSON form. With details, its
+// issues and tasks are included.
+func NewWork(wp *progress.WorkProgress, details bool) Work {
+	w := wp.Work
+	work := Work{
+		ID:             w.ID,
+		Name:           w.Name,
+		Status:         w.Status,
+		Branch:         w.BranchName,
+		BaseBranch:     w.BaseBranch,
+		WorktreePath:   w.WorktreePath,
+		RootIssue:      w.RootIssueID,
+		PRURL:          w.PRURL,
+		PRState:        w.PRState,
+		CIStatus:       w.CIStatus,
+		ApprovalStatus: w.ApprovalStatus,
+		MergeableState: w.MergeableState,
+		Error:          w.ErrorMessage,
+		Auto:           w.Auto,
+		Paused:         w.Paused,
+		CreatedAt:      w.CreatedAt,
+		StartedAt:      w.StartedAt,
+		CompletedAt:    w.CompletedAt,
+	}
+	for _, tp := range wp.Tasks {
+		switch tp.Task.Status {
+		case db.StatusProcessing:
+			work.TaskCounts.Processing++
+		case db.StatusCompleted:
+			work.TaskCounts.Completed++
+		case db.StatusFailed:
+			work.TaskCounts.Failed++
+		default:
+			work.TaskCounts.Pending++
+		}
+	}
+	if details {
+		for _, bp := range wp.WorkBeads {
+			work.Issues = append(work.Issues, newIssue(bp, false))
+		}
+		work.Tasks = NewTasks(wp)
+	}
+	return work
+}
+
+// NewTasks converts the tasks of a work's progress to their JSON form.
+func NewTasks(wp *progress.WorkProgress) []Task {
+	tasks := make([]Task, 0, len(wp.Tasks))
+	for _, tp := range wp.Tasks {
+		t := tp.Task
+		task := Task{
+			ID:          t.ID,
+			Title:       tp.Title,
+			Type:        t.TaskType,
+			Status:      t.Status,
+			FailureKind: t.FailureKind,
+			Error:       t.ErrorMessage,
+			DependsOn:   tp.DependsOn,
+			StartedAt:   t.StartedAt,
+			CompletedAt: t.CompletedAt,
+			Issues:      make([]Issue, 0, len(tp.Beads)),
+		}
+		for _, bp := range tp.Beads {
+			task.Issues = append(task.Issues, newIssue(bp, true))
+		}
+		for _, run := range tp.Runs {
+			task.Runs = append(task.Runs, Run{
+				Model:         run.Model,
+				Settings:      run.Settings,
+				ClaudeVersion: run.ClaudeVersion,
+				Status:        run.Status,
+				StartedAt:     run.StartedAt,
+				CompletedAt:   run.CompletedAt,
+			})
+		}
+		for _, artifact := range tp.Artifacts {
+			task.Artifacts = append(task.Artifacts, artifact.Name)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks
+}
+
+func newIssue(bp progress.BeadProgress, inTask bool) Issue {
+	issue := Issue{
+		ID:        bp.ID,
+		Title:     bp.Title,
+		Status:    bp.BeadStatus,
+		Priority:  bp.Priority,
+		Type:      bp.IssueType,
+		BlockedBy: bp.BlockedBy,
+	}
+	if inTask {
+		issue.TaskStatus = bp.Status
+	}
+	return issue
+}
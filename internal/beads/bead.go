@@ -26,7 +26,8 @@ type Bead struct {
 	ClosedAt           time.Time
 	CloseReason        string
 	ExternalRef        string
-	IsEpic             bool // derived from issue_type == "epic"
+	Labels             []string // from the labels table, sorted
+	IsEpic             bool     // derived from issue_type == "epic"
 }
 
 // BeadFromIssue converts a queries.Issue to a clean Bead.
@@ -24,15 +24,27 @@ var _ CLI = &BeadsCLIMock{}
 //			AddDependencyFunc: func(ctx context.Context, beadID string, dependsOnID string) error {
 //				panic("mock out the AddDependency method")
 //			},
+//			AddDependencyOfTypeFunc: func(ctx context.Context, beadID string, dependsOnID string, depType string) error {
+//				panic("mock out the AddDependencyOfType method")
+//			},
 //			AddLabelsFunc: func(ctx context.Context, beadID string, labels []string) error {
 //				panic("mock out the AddLabels method")
 //			},
 //			CloseFunc: func(ctx context.Context, beadID string) error {
 //				panic("mock out the Close method")
 //			},
+//			CloseManyFunc: func(ctx context.Context, beadIDs []string) error {
+//				panic("mock out the CloseMany method")
+//			},
 //			CreateFunc: func(ctx context.Context, opts CreateOptions) (string, error) {
 //				panic("mock out the Create method")
 //			},
+//			RemoveDependencyFunc: func(ctx context.Context, beadID string, dependsOnID string) error {
+//				panic("mock out the RemoveDependency method")
+//			},
+//			RemoveLabelsFunc: func(ctx context.Context, beadID string, labels []string) error {
+//				panic("mock out the RemoveLabels method")
+//			},
 //			ReopenFunc: func(ctx context.Context, beadID string) error {
 //				panic("mock out the Reopen method")
 //			},
@@ -55,15 +67,27 @@ type BeadsCLIMock struct {
 	// AddDependencyFunc mocks the AddDependency method.
 	AddDependencyFunc func(ctx context.Context, beadID string, dependsOnID string) error
 
+	// AddDependencyOfTypeFunc mocks the AddDependencyOfType method.
+	AddDependencyOfTypeFunc func(ctx context.Context, beadID string, dependsOnID string, depType string) error
+
 	// AddLabelsFunc mocks the AddLabels method.
 	AddLabelsFunc func(ctx context.Context, beadID string, labels []string) error
 
 	// CloseFunc mocks the Close method.
 	CloseFunc func(ctx context.Context, beadID string) error
 
+	// CloseManyFunc mocks the CloseMany method.
+	CloseManyFunc func(ctx context.Context, beadIDs []string) error
+
 	// CreateFunc mocks the Create method.
 	CreateFunc func(ctx context.Context, opts CreateOptions) (string, error)
 
+	// RemoveDependencyFunc mocks the RemoveDependency method.
+	RemoveDependencyFunc func(ctx context.Context, beadID string, dependsOnID string) error
+
+	// RemoveLabelsFunc mocks the RemoveLabels method.
+	RemoveLabelsFunc func(ctx context.Context, beadID string, labels []string) error
+
 	// ReopenFunc mocks the Reopen method.
 	ReopenFunc func(ctx context.Context, beadID string) error
 
@@ -93,6 +117,17 @@ type BeadsCLIMock struct {
 			// DependsOnID is the dependsOnID argument value.
 			DependsOnID string
 		}
+		// AddDependencyOfType holds details about calls to the AddDependencyOfType method.
+		AddDependencyOfType []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BeadID is the beadID argument value.
+			BeadID string
+			// DependsOnID is the dependsOnID argument value.
+			DependsOnID string
+			// DepType is the depType argument value.
+			DepType string
+		}
 		// AddLabels holds details about calls to the AddLabels method.
 		AddLabels []struct {
 			// Ctx is the ctx argument value.
@@ -109,6 +144,13 @@ type BeadsCLIMock struct {
 			// BeadID is the beadID argument value.
 			BeadID string
 		}
+		// CloseMany holds details about calls to the CloseMany method.
+		CloseMany []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BeadIDs is the beadIDs argument value.
+			BeadIDs []string
+		}
 		// Create holds details about calls to the Create method.
 		Create []struct {
 			// Ctx is the ctx argument value.
@@ -116,6 +158,24 @@ type BeadsCLIMock struct {
 			// Opts is the opts argument value.
 			Opts CreateOptions
 		}
+		// RemoveDependency holds details about calls to the RemoveDependency method.
+		RemoveDependency []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BeadID is the beadID argument value.
+			BeadID string
+			// DependsOnID is the dependsOnID argument value.
+			DependsOnID string
+		}
+		// RemoveLabels holds details about calls to the RemoveLabels method.
+		RemoveLabels []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BeadID is the beadID argument value.
+			BeadID string
+			// Labels is the labels argument value.
+			Labels []string
+		}
 		// Reopen holds details about calls to the Reopen method.
 		Reopen []struct {
 			// Ctx is the ctx argument value.
@@ -142,14 +202,18 @@ type BeadsCLIMock struct {
 			Opts UpdateOptions
 		}
 	}
-	lockAddComment     sync.RWMutex
-	lockAddDependency  sync.RWMutex
-	lockAddLabels      sync.RWMutex
-	lockClose          sync.RWMutex
-	lockCreate         sync.RWMutex
-	lockReopen         sync.RWMutex
-	lockSetExternalRef sync.RWMutex
-	lockUpdate         sync.RWMutex
+	lockAddComment          sync.RWMutex
+	lockAddDependency       sync.RWMutex
+	lockAddDependencyOfType sync.RWMutex
+	lockAddLabels           sync.RWMutex
+	lockClose               sync.RWMutex
+	lockCloseMany           sync.RWMutex
+	lockCreate              sync.RWMutex
+	lockRemoveDependency    sync.RWMutex
+	lockRemoveLabels        sync.RWMutex
+	lockReopen              sync.RWMutex
+	lockSetExternalRef      sync.RWMutex
+	lockUpdate              sync.RWMutex
 }
 
 // AddComment calls AddCommentFunc.
@@ -238,6 +302,53 @@ func (mock *BeadsCLIMock) AddDependencyCalls() []struct {
 	return calls
 }
 
+// AddDependencyOfType calls AddDependencyOfTypeFunc.
+func (mock *BeadsCLIMock) AddDependencyOfType(ctx context.Context, beadID string, dependsOnID string, depType string) error {
+	callInfo := struct {
+		Ctx         context.Context
+		BeadID      string
+		DependsOnID string
+		DepType     string
+	}{
+		Ctx:         ctx,
+		BeadID:      beadID,
+		DependsOnID: dependsOnID,
+		DepType:     depType,
+	}
+	mock.lockAddDependencyOfType.Lock()
+	mock.calls.AddDependencyOfType = append(mock.calls.AddDependencyOfType, callInfo)
+	mock.lockAddDependencyOfType.Unlock()
+	if mock.AddDependencyOfTypeFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.AddDependencyOfTypeFunc(ctx, beadID, dependsOnID, depType)
+}
+
+// AddDependencyOfTypeCalls gets all the calls that were made to AddDependencyOfType.
+// Check the length with:
+//
+//	len(mockedCLI.AddDependencyOfTypeCalls())
+func (mock *BeadsCLIMock) AddDependencyOfTypeCalls() []struct {
+	Ctx         context.Context
+	BeadID      string
+	DependsOnID string
+	DepType     string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		BeadID      string
+		DependsOnID string
+		DepType     string
+	}
+	mock.lockAddDependencyOfType.RLock()
+	calls = mock.calls.AddDependencyOfType
+	mock.lockAddDependencyOfType.RUnlock()
+	return calls
+}
+
 // AddLabels calls AddLabelsFunc.
 func (mock *BeadsCLIMock) AddLabels(ctx context.Context, beadID string, labels []string) error {
 	callInfo := struct {
@@ -320,6 +431,45 @@ func (mock *BeadsCLIMock) CloseCalls() []struct {
 	return calls
 }
 
+// CloseMany calls CloseManyFunc.
+func (mock *BeadsCLIMock) CloseMany(ctx context.Context, beadIDs []string) error {
+	callInfo := struct {
+		Ctx     context.Context
+		BeadIDs []string
+	}{
+		Ctx:     ctx,
+		BeadIDs: beadIDs,
+	}
+	mock.lockCloseMany.Lock()
+	mock.calls.CloseMany = append(mock.calls.CloseMany, callInfo)
+	mock.lockCloseMany.Unlock()
+	if mock.CloseManyFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.CloseManyFunc(ctx, beadIDs)
+}
+
+// CloseManyCalls gets all the calls that were made to CloseMany.
+// Check the length with:
+//
+//	len(mockedCLI.CloseManyCalls())
+func (mock *BeadsCLIMock) CloseManyCalls() []struct {
+	Ctx     context.Context
+	BeadIDs []string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		BeadIDs []string
+	}
+	mock.lockCloseMany.RLock()
+	calls = mock.calls.CloseMany
+	mock.lockCloseMany.RUnlock()
+	return calls
+}
+
 // Create calls CreateFunc.
 func (mock *BeadsCLIMock) Create(ctx context.Context, opts CreateOptions) (string, error) {
 	callInfo := struct {
@@ -360,6 +510,92 @@ func (mock *BeadsCLIMock) CreateCalls() []struct {
 	return calls
 }
 
+// RemoveDependency calls RemoveDependencyFunc.
+func (mock *BeadsCLIMock) RemoveDependency(ctx context.Context, beadID string, dependsOnID string) error {
+	callInfo := struct {
+		Ctx         context.Context
+		BeadID      string
+		DependsOnID string
+	}{
+		Ctx:         ctx,
+		BeadID:      beadID,
+		DependsOnID: dependsOnID,
+	}
+	mock.lockRemoveDependency.Lock()
+	mock.calls.RemoveDependency = append(mock.calls.RemoveDependency, callInfo)
+	mock.lockRemoveDependency.Unlock()
+	if mock.RemoveDependencyFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.RemoveDependencyFunc(ctx, beadID, dependsOnID)
+}
+
+// RemoveDependencyCalls gets all the calls that were made to RemoveDependency.
+// Check the length with:
+//
+//	len(mockedCLI.RemoveDependencyCalls())
+func (mock *BeadsCLIMock) RemoveDependencyCalls() []struct {
+	Ctx         context.Context
+	BeadID      string
+	DependsOnID string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		BeadID      string
+		DependsOnID string
+	}
+	mock.lockRemoveDependency.RLock()
+	calls = mock.calls.RemoveDependency
+	mock.lockRemoveDependency.RUnlock()
+	return calls
+}
+
+// RemoveLabels calls RemoveLabelsFunc.
+func (mock *BeadsCLIMock) RemoveLabels(ctx context.Context, beadID string, labels []string) error {
+	callInfo := struct {
+		Ctx    context.Context
+		BeadID string
+		Labels []string
+	}{
+		Ctx:    ctx,
+		BeadID: beadID,
+		Labels: labels,
+	}
+	mock.lockRemoveLabels.Lock()
+	mock.calls.RemoveLabels = append(mock.calls.RemoveLabels, callInfo)
+	mock.lockRemoveLabels.Unlock()
+	if mock.RemoveLabelsFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.RemoveLabelsFunc(ctx, beadID, labels)
+}
+
+// RemoveLabelsCalls gets all the calls that were made to RemoveLabels.
+// Check the length with:
+//
+//	len(mockedCLI.RemoveLabelsCalls())
+func (mock *BeadsCLIMock) RemoveLabelsCalls() []struct {
+	Ctx    context.Context
+	BeadID string
+	Labels []string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		BeadID string
+		Labels []string
+	}
+	mock.lockRemoveLabels.RLock()
+	calls = mock.calls.RemoveLabels
+	mock.lockRemoveLabels.RUnlock()
+	return calls
+}
+
 // Reopen calls ReopenFunc.
 func (mock *BeadsCLIMock) Reopen(ctx context.Context, beadID string) error {
 	callInfo := struct {
@@ -513,6 +749,9 @@ var _ Reader = &BeadsReaderMock{}
 //			ListBeadsFunc: func(ctx context.Context, status string) ([]Bead, error) {
 //				panic("mock out the ListBeads method")
 //			},
+//			ListLabelsFunc: func(ctx context.Context) ([]string, error) {
+//				panic("mock out the ListLabels method")
+//			},
 //		}
 //
 //		// use mockedReader in code that requires Reader
@@ -538,6 +777,9 @@ type BeadsReaderMock struct {
 	// ListBeadsFunc mocks the ListBeads method.
 	ListBeadsFunc func(ctx context.Context, status string) ([]Bead, error)
 
+	// ListLabelsFunc mocks the ListLabels method.
+	ListLabelsFunc func(ctx context.Context) ([]string, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// GetBead holds details about calls to the GetBead method.
@@ -580,6 +822,11 @@ type BeadsReaderMock struct {
 			// Status is the status argument value.
 			Status string
 		}
+		// ListLabels holds details about calls to the ListLabels method.
+		ListLabels []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 	}
 	lockGetBead                   sync.RWMutex
 	lockGetBeadWithChildren       sync.RWMutex
@@ -587,6 +834,7 @@ type BeadsReaderMock struct {
 	lockGetReadyBeads             sync.RWMutex
 	lockGetTransitiveDependencies sync.RWMutex
 	lockListBeads                 sync.RWMutex
+	lockListLabels                sync.RWMutex
 }
 
 // GetBead calls GetBeadFunc.
@@ -824,3 +1072,39 @@ func (mock *BeadsReaderMock) ListBeadsCalls() []struct {
 	mock.lockListBeads.RUnlock()
 	return calls
 }
+
+// ListLabels calls ListLabelsFunc.
+func (mock *BeadsReaderMock) ListLabels(ctx context.Context) ([]string, error) {
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListLabels.Lock()
+	mock.calls.ListLabels = append(mock.calls.ListLabels, callInfo)
+	mock.lockListLabels.Unlock()
+	if mock.ListLabelsFunc == nil {
+		var (
+			stringsOut []string
+			errOut     error
+		)
+		return stringsOut, errOut
+	}
+	return mock.ListLabelsFunc(ctx)
+}
+
+// ListLabelsCalls gets all the calls that were made to ListLabels.
+// Check the length with:
+//
+//	len(mockedReader.ListLabelsCalls())
+func (mock *BeadsReaderMock) ListLabelsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListLabels.RLock()
+	calls = mock.calls.ListLabels
+	mock.lockListLabels.RUnlock()
+	return calls
+}
@@ -0,0 +1,222 @@
+package beads
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ChecklistItem is a top-level item of a markdown list, to be created as a
+// bead.
+type ChecklistItem struct {
+	Title string
+	// Description holds the item's nested sub-items and paragraphs, dedented.
+	Description string
+	// Section is the text of the closest heading above the item, if any.
+	Section string
+	// Done is set for checked items: "- [x] item".
+	Done bool
+}
+
+var (
+	// checklistHeadingRe matches an ATX heading: "## Backend"
+	checklistHeadingRe = regexp.MustCompile(`^#{1,6}\s+(.*?)\s*#*\s*$`)
+	// checklistItemRe matches a list item with an optional checkbox:
+	// "- [ ] item", "* item", "1. item"
+	checklistItemRe = regexp.MustCompile(`^(?:[-*+]|\d+[.)])(?:\s+\[([ xX])\])?(?:\s+(.*))?$`)
+	// checklistFenceRe matches the start or end of a fenced code block
+	checklistFenceRe = regexp.MustCompile("^\\s*(```|~~~)")
+)
+
+// ParseChecklist turns the top-level list items of a markdown document into
+// beads to create. An item's text is its title; everything indented below it
+// is its description. Headings set the section of the items that follow.
+// Text that isn't part of a list, and lists inside code blocks, are ignored.
+func ParseChecklist(markdown string) []ChecklistItem {
+	var items []ChecklistItem
+	var section string
+	var current *ChecklistItem
+	var body []string
+	inFence := false
+
+	flush := func() {
+		if current == nil {
+			return
+		}
+		current.Description = dedent(body)
+		if current.Title != "" {
+			items = append(items, *current)
+		}
+		current = nil
+		body = nil
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n") {
+		line = strings.ReplaceAll(line, "\t", "    ")
+		indented := strings.HasPrefix(line, "  ")
+
+		// Code blocks belong to the item they are indented under, and are
+		// skipped elsewhere
+		if checklistFenceRe.MatchString(line) && !(current != nil && indented) {
+			inFence = !inFence
+			flush()
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		if current != nil && (indented || strings.TrimSpace(line) == "") {
+			body = append(body, line)
+			continue
+		}
+		trimmed := strings.TrimSpace(line)
+		if m := checklistHeadingRe.FindStringSubmatch(trimmed); m != nil && !indented {
+			flush()
+			section = m[1]
+			continue
+		}
+		if m := checklistItemRe.FindStringSubmatch(trimmed); m != nil && !indented {
+			flush()
+			current = &ChecklistItem{
+				Title:   strings.TrimSpace(m[2]),
+				Section: section,
+				Done:    m[1] == "x" || m[1] == "X",
+			}
+			continue
+		}
+		// Any other top-level text ends the list
+		flush()
+	}
+	flush()
+	return items
+}
+
+// dedent removes the indentation the lines share and the blank lines around
+// them
+func dedent(lines []string) string {
+	indent := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		n := len(line) - len(strings.TrimLeft(line, " "))
+		if indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		if len(line) >= indent && indent > 0 {
+			line = line[indent:]
+		}
+		out[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Trim(strings.Join(out, "\n"), "\n")
+}
+
+// SectionLabel turns a heading into a label: lowercase words joined by
+// dashes, so "Backend API" becomes backend-api.
+func SectionLabel(section string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(section) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		default:
+			dash = true
+		}
+	}
+	return b.String()
+}
+
+// ChecklistImportOptions controls how ImportChecklist creates beads.
+type ChecklistImportOptions struct {
+	Type     string // Type of the beads made from items
+	Priority int
+	// Epic, when set, is the title of a new epic every created bead goes under.
+	Epic string
+	// SectionEpics makes each heading an epic over its items instead of a
+	// label on them.
+	SectionEpics bool
+	// IncludeDone creates beads for checked items too.
+	IncludeDone bool
+}
+
+// ImportedBead is a bead ImportChecklist created.
+type ImportedBead struct {
+	ID     string
+	Title  string
+	Type   string
+	Parent string // The epic it was created under, if any
+}
+
+// ImportChecklist creates beads for checklist items, under the epics opts
+// asks for. It stops at the first bead that can't be created and returns the
+// beads created until then with the error.
+func ImportChecklist(ctx context.Context, cli CLI, items []ChecklistItem, opts ChecklistImportOptions) ([]ImportedBead, error) {
+	var created []ImportedBead
+	create := func(bead CreateOptions) (string, error) {
+		id, err := cli.Create(ctx, bead)
+		if err != nil {
+			return "", fmt.Errorf("failed to create %q: %w", bead.Title, err)
+		}
+		beadType := bead.Type
+		if bead.IsEpic {
+			beadType = "epic"
+		}
+		created = append(created, ImportedBead{ID: id, Title: bead.Title, Type: beadType, Parent: bead.Parent})
+		return id, nil
+	}
+
+	var root string
+	if opts.Epic != "" {
+		id, err := create(CreateOptions{Title: opts.Epic, IsEpic: true, Priority: opts.Priority})
+		if err != nil {
+			return created, err
+		}
+		root = id
+	}
+
+	sectionEpics := make(map[string]string)
+	for _, item := range items {
+		if item.Done && !opts.IncludeDone {
+			continue
+		}
+		bead := CreateOptions{
+			Title:       item.Title,
+			Type:        opts.Type,
+			Priority:    opts.Priority,
+			Description: item.Description,
+			Parent:      root,
+		}
+		switch {
+		case item.Section == "":
+		case opts.SectionEpics:
+			epic, ok := sectionEpics[item.Section]
+			if !ok {
+				id, err := create(CreateOptions{Title: item.Section, IsEpic: true, Priority: opts.Priority, Parent: root})
+				if err != nil {
+					return created, err
+				}
+				epic = id
+				sectionEpics[item.Section] = epic
+			}
+			bead.Parent = epic
+		default:
+			if label := SectionLabel(item.Section); label != "" {
+				bead.Labels = []string{label}
+			}
+		}
+		if _, err := create(bead); err != nil {
+			return created, err
+		}
+	}
+	return created, nil
+}
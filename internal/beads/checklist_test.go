@@ -0,0 +1,142 @@
+package beads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChecklist(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     []ChecklistItem
+	}{
+		{
+			name:     "checkboxes and plain bullets",
+			markdown: "- [ ] Add login form\n* Wire up OAuth\n+ [x] Pick a library\n1. Write docs\n2) Ship it\n",
+			want: []ChecklistItem{
+				{Title: "Add login form"},
+				{Title: "Wire up OAuth"},
+				{Title: "Pick a library", Done: true},
+				{Title: "Write docs"},
+				{Title: "Ship it"},
+			},
+		},
+		{
+			name: "nested items become the description",
+			markdown: "- [ ] Add login form\n" +
+				"  - [ ] email field\n" +
+				"    - validate format\n" +
+				"\n" +
+				"  Matches the mockups.\n" +
+				"- [ ] Next\n",
+			want: []ChecklistItem{
+				{Title: "Add login form", Description: "- [ ] email field\n  - validate format\n\nMatches the mockups."},
+				{Title: "Next"},
+			},
+		},
+		{
+			name: "headings set the section",
+			markdown: "# Plan\n\nIntro text is ignored.\n\n" +
+				"## Backend API\n- [ ] Add endpoint\n\n" +
+				"## Frontend ##\n- [ ] Add page\n- [ ] Add route\n",
+			want: []ChecklistItem{
+				{Title: "Add endpoint", Section: "Backend API"},
+				{Title: "Add page", Section: "Frontend"},
+				{Title: "Add route", Section: "Frontend"},
+			},
+		},
+		{
+			name: "code blocks",
+			markdown: "```\n- not an item\n```\n" +
+				"- [ ] Run the migration\n" +
+				"  ```sh\n" +
+				"  - flag\n" +
+				"  ```\n" +
+				"- [ ] Then this\n",
+			want: []ChecklistItem{
+				{Title: "Run the migration", Description: "```sh\n- flag\n```"},
+				{Title: "Then this"},
+			},
+		},
+		{
+			name:     "empty items and tabs",
+			markdown: "- [ ]\n-\n- Tabbed\n\t- child\n\r\n",
+			want: []ChecklistItem{
+				{Title: "Tabbed", Description: "- child"},
+			},
+		},
+		{
+			name:     "no list",
+			markdown: "Just a paragraph.\n\n---\n",
+			want:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ParseChecklist(tt.markdown))
+		})
+	}
+}
+
+func TestSectionLabel(t *testing.T) {
+	require.Equal(t, "backend-api", SectionLabel("Backend API"))
+	require.Equal(t, "phase-2-rollout", SectionLabel("  Phase 2: Rollout! "))
+	require.Empty(t, SectionLabel("🚀"))
+}
+
+func TestImportChecklist(t *testing.T) {
+	ctx := context.Background()
+	items := ParseChecklist("- [ ] Loose\n## Backend\n- [ ] Endpoint\n- [x] Done already\n## Frontend\n- [ ] Page\n")
+
+	newCLI := func() *BeadsCLIMock {
+		n := 0
+		return &BeadsCLIMock{CreateFunc: func(ctx context.Context, opts CreateOptions) (string, error) {
+			n++
+			return fmt.Sprintf("bd-%d", n), nil
+		}}
+	}
+
+	// Headings become labels
+	cli := newCLI()
+	created, err := ImportChecklist(ctx, cli, items, ChecklistImportOptions{Type: "task", Priority: 2})
+	require.NoError(t, err)
+	require.Len(t, created, 3, "checked items are skipped")
+	calls := cli.CreateCalls()
+	require.Empty(t, calls[0].Opts.Labels)
+	require.Equal(t, []string{"backend"}, calls[1].Opts.Labels)
+	require.Equal(t, "task", calls[1].Opts.Type)
+
+	// With an epic and section epics: epic > section epics > items
+	cli = newCLI()
+	created, err = ImportChecklist(ctx, cli, items, ChecklistImportOptions{Type: "task", Epic: "Login", SectionEpics: true, IncludeDone: true})
+	require.NoError(t, err)
+	require.Equal(t, []ImportedBead{
+		{ID: "bd-1", Title: "Login", Type: "epic"},
+		{ID: "bd-2", Title: "Loose", Type: "task", Parent: "bd-1"},
+		{ID: "bd-3", Title: "Backend", Type: "epic", Parent: "bd-1"},
+		{ID: "bd-4", Title: "Endpoint", Type: "task", Parent: "bd-3"},
+		{ID: "bd-5", Title: "Done already", Type: "task", Parent: "bd-3"},
+		{ID: "bd-6", Title: "Frontend", Type: "epic", Parent: "bd-1"},
+		{ID: "bd-7", Title: "Page", Type: "task", Parent: "bd-6"},
+	}, created)
+	for _, call := range cli.CreateCalls() {
+		require.Empty(t, call.Opts.Labels)
+	}
+
+	// A failure stops the import and reports what was created
+	cli = newCLI()
+	cli.CreateFunc = func(ctx context.Context, opts CreateOptions) (string, error) {
+		if opts.Title == "Endpoint" {
+			return "", errors.New("bd failed")
+		}
+		return "bd-" + opts.Title, nil
+	}
+	created, err = ImportChecklist(ctx, cli, items, ChecklistImportOptions{})
+	require.ErrorContains(t, err, `failed to create "Endpoint"`)
+	require.Len(t, created, 1)
+}
@@ -4,6 +4,7 @@ package beads
 
 import (
 	"context"
+	"os/exec"
 )
 
 // CLI defines the interface for bd command operations.
@@ -17,6 +18,8 @@ type CLI interface {
 	Create(ctx context.Context, opts CreateOptions) (string, error)
 	// Close closes a bead.
 	Close(ctx context.Context, beadID string) error
+	// CloseMany closes several beads in one operation.
+	CloseMany(ctx context.Context, beadIDs []string) error
 	// Reopen reopens a closed bead.
 	Reopen(ctx context.Context, beadID string) error
 	// Update updates a bead's fields.
@@ -25,10 +28,16 @@ type CLI interface {
 	AddComment(ctx context.Context, beadID, comment string) error
 	// AddLabels adds labels to a bead.
 	AddLabels(ctx context.Context, beadID string, labels []string) error
+	// RemoveLabels removes labels from a bead.
+	RemoveLabels(ctx context.Context, beadID string, labels []string) error
 	// SetExternalRef sets the external reference for a bead.
 	SetExternalRef(ctx context.Context, beadID, externalRef string) error
 	// AddDependency adds a dependency between two beads.
 	AddDependency(ctx context.Context, beadID, dependsOnID string) error
+	// AddDependencyOfType adds a dependency of the given type between two beads.
+	AddDependencyOfType(ctx context.Context, beadID, dependsOnID, depType string) error
+	// RemoveDependency removes a dependency between two beads.
+	RemoveDependency(ctx context.Context, beadID, dependsOnID string) error
 }
 
 // Reader defines the interface for reading beads from the database.
@@ -40,6 +49,8 @@ type Reader interface {
 	GetBeadsWithDeps(ctx context.Context, beadIDs []string) (*BeadsWithDepsResult, error)
 	// ListBeads lists all beads with optional status filter.
 	ListBeads(ctx context.Context, status string) ([]Bead, error)
+	// ListLabels returns every label in use, sorted.
+	ListLabels(ctx context.Context) ([]string, error)
 	// GetReadyBeads returns all open beads where all dependencies are satisfied.
 	GetReadyBeads(ctx context.Context) ([]Bead, error)
 	// GetTransitiveDependencies collects all transitive dependencies for a bead.
@@ -48,6 +59,14 @@ type Reader interface {
 	GetBeadWithChildren(ctx context.Context, id string) ([]Bead, error)
 }
 
+// CLIAvailable reports whether the bd binary can be found in PATH.
+// Reads go through the beads database directly, so only operations that
+// modify beads depend on it.
+func CLIAvailable() bool {
+	_, err := exec.LookPath("bd")
+	return err == nil
+}
+
 // cliImpl implements CLI using the bd command-line tool.
 type cliImpl struct {
 	beadsDir string
@@ -71,6 +90,11 @@ func (c *cliImpl) Close(ctx context.Context, beadID string) error {
 	return Close(ctx, beadID, c.beadsDir)
 }
 
+// CloseMany implements CLI.CloseMany.
+func (c *cliImpl) CloseMany(ctx context.Context, beadIDs []string) error {
+	return CloseMany(ctx, beadIDs, c.beadsDir)
+}
+
 // Reopen implements CLI.Reopen.
 func (c *cliImpl) Reopen(ctx context.Context, beadID string) error {
 	return Reopen(ctx, beadID, c.beadsDir)
@@ -91,6 +115,11 @@ func (c *cliImpl) AddLabels(ctx context.Context, beadID string, labels []string)
 	return AddLabels(ctx, beadID, c.beadsDir, labels)
 }
 
+// RemoveLabels implements CLI.RemoveLabels.
+func (c *cliImpl) RemoveLabels(ctx context.Context, beadID string, labels []string) error {
+	return RemoveLabels(ctx, beadID, c.beadsDir, labels)
+}
+
 // SetExternalRef implements CLI.SetExternalRef.
 func (c *cliImpl) SetExternalRef(ctx context.Context, beadID, externalRef string) error {
 	return SetExternalRef(ctx, beadID, externalRef, c.beadsDir)
@@ -101,5 +130,15 @@ func (c *cliImpl) AddDependency(ctx context.Context, beadID, dependsOnID string)
 	return AddDependency(ctx, beadID, dependsOnID, c.beadsDir)
 }
 
+// AddDependencyOfType implements CLI.AddDependencyOfType.
+func (c *cliImpl) AddDependencyOfType(ctx context.Context, beadID, dependsOnID, depType string) error {
+	return AddDependencyOfType(ctx, beadID, dependsOnID, depType, c.beadsDir)
+}
+
+// RemoveDependency implements CLI.RemoveDependency.
+func (c *cliImpl) RemoveDependency(ctx context.Context, beadID, dependsOnID string) error {
+	return RemoveDependency(ctx, beadID, dependsOnID, c.beadsDir)
+}
+
 // Compile-time check that Client implements Reader.
 var _ Reader = (*Client)(nil)
@@ -1,13 +1,16 @@
 package beads
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -161,7 +164,7 @@ func Create(ctx context.Context, beadsDir string, opts CreateOptions) (string, e
 	if opts.IsEpic {
 		beadType = "epic"
 	}
-	args := []string{"create", "--title=" + opts.Title, "--type=" + beadType, fmt.Sprintf("--priority=%d", opts.Priority)}
+	args := []string{"create", "--json", "--title=" + opts.Title, "--type=" + beadType, fmt.Sprintf("--priority=%d", opts.Priority)}
 	if opts.Description != "" {
 		args = append(args, "--description="+opts.Description)
 	}
@@ -193,31 +196,7 @@ func Create(ctx context.Context, beadsDir string, opts CreateOptions) (string, e
 
 	logging.Debug("bd create output", "output", string(output))
 
-	// Parse the bead ID from output
-	// bd create outputs multi-line text like "✓ Created issue: s-0o9\n   Title: ..."
-	// We need to extract just the bead ID (format: prefix-xxx)
-	beadID := ""
-	outputStr := string(output)
-	// Split by whitespace (including newlines) and find the bead ID
-	parts := strings.Fields(outputStr)
-	for _, p := range parts {
-		// Bead IDs are typically short alphanumeric with a dash (e.g., s-0o9, ac-123, bd-456)
-		if len(p) >= 3 && len(p) <= 20 && strings.Contains(p, "-") && !strings.HasPrefix(p, "-") {
-			// Check it looks like a bead ID (letters/numbers and dashes only)
-			isBeadID := true
-			for _, c := range p {
-				if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '-' && c != '.' {
-					isBeadID = false
-					break
-				}
-			}
-			if isBeadID {
-				beadID = p
-				break
-			}
-		}
-	}
-
+	beadID := parseCreatedBeadID(output)
 	if beadID == "" {
 		logging.Error("failed to parse bead ID from output", "output", string(output), "args", args)
 		return "", fmt.Errorf("failed to get created bead ID from output: %s", output)
@@ -227,6 +206,39 @@ func Create(ctx context.Context, beadsDir string, opts CreateOptions) (string, e
 	return beadID, nil
 }
 
+// createdLineRe matches the line bd create prints in text mode, such as
+// "✓ Created issue: proj-42" or "Created issue proj-a1b.2 (P2)".
+var createdLineRe = regexp.MustCompile(`(?i)created(?:\s+issue)?:?\s+([A-Za-z][A-Za-z0-9_]*-[A-Za-z0-9]+(?:\.[0-9]+)*)`)
+
+// beadIDRe matches a bead ID on its own: a prefix, a dash and a number or
+// hash, with optional .N suffixes for hierarchical children.
+var beadIDRe = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-[A-Za-z0-9]*[0-9][A-Za-z0-9]*(?:\.[0-9]+)*$`)
+
+// parseCreatedBeadID returns the ID of the bead bd create made, or empty if
+// output doesn't name one. It takes the JSON bd prints with --json, and for
+// versions that print text instead, the "Created issue" line or else the
+// first word shaped like a bead ID, whatever the project's prefix.
+func parseCreatedBeadID(output []byte) string {
+	// Warnings may come before the JSON, and some versions wrap it in an array
+	if i := bytes.IndexByte(output, '{'); i >= 0 {
+		var created struct {
+			ID string `json:"id"`
+		}
+		if err := json.NewDecoder(bytes.NewReader(output[i:])).Decode(&created); err == nil && created.ID != "" {
+			return created.ID
+		}
+	}
+	if m := createdLineRe.FindSubmatch(output); m != nil {
+		return string(m[1])
+	}
+	for _, word := range strings.Fields(string(output)) {
+		if beadIDRe.MatchString(word) {
+			return word
+		}
+	}
+	return ""
+}
+
 // Close closes a bead.
 func Close(ctx context.Context, beadID, beadsDir string) error {
 	cmd := bdCommand(ctx, beadsDir, "close", beadID)
@@ -236,6 +248,19 @@ func Close(ctx context.Context, beadID, beadsDir string) error {
 	return nil
 }
 
+// CloseMany closes several beads with a single bd invocation.
+func CloseMany(ctx context.Context, beadIDs []string, beadsDir string) error {
+	if len(beadIDs) == 0 {
+		return nil
+	}
+	args := append([]string{"close"}, beadIDs...)
+	cmd := bdCommand(ctx, beadsDir, args...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to close beads %s: %w\n%s", strings.Join(beadIDs, ", "), err, output)
+	}
+	return nil
+}
+
 // AddComment adds a comment to a bead.
 func AddComment(ctx context.Context, beadID, comment, beadsDir string) error {
 	cmd := bdCommand(ctx, beadsDir, "comments", "add", beadID, comment)
@@ -311,6 +336,24 @@ func AddLabels(ctx context.Context, beadID, beadsDir string, labels []string) er
 	return nil
 }
 
+// RemoveLabels removes labels from a bead.
+func RemoveLabels(ctx context.Context, beadID, beadsDir string, labels []string) error {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	args := []string{"update", beadID}
+	for _, label := range labels {
+		args = append(args, "--remove-label="+label)
+	}
+
+	cmd := bdCommand(ctx, beadsDir, args...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to remove labels from bead %s: %w\n%s", beadID, err, output)
+	}
+	return nil
+}
+
 // SetExternalRef sets the external reference for a bead.
 func SetExternalRef(ctx context.Context, beadID, externalRef, beadsDir string) error {
 	if externalRef == "" {
@@ -336,6 +379,25 @@ func AddDependency(ctx context.Context, beadID, dependsOnID, beadsDir string) er
 	return nil
 }
 
+// AddDependencyOfType adds a dependency of the given type, such as
+// parent-child or related, between two beads.
+func AddDependencyOfType(ctx context.Context, beadID, dependsOnID, depType, beadsDir string) error {
+	cmd := bdCommand(ctx, beadsDir, "dep", "add", beadID, dependsOnID, "--type="+depType)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to add %s dependency %s -> %s: %w\n%s", depType, beadID, dependsOnID, err, output)
+	}
+	return nil
+}
+
+// RemoveDependency removes the dependency of beadID on dependsOnID.
+func RemoveDependency(ctx context.Context, beadID, dependsOnID, beadsDir string) error {
+	cmd := bdCommand(ctx, beadsDir, "dep", "remove", beadID, dependsOnID)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to remove dependency %s -> %s: %w\n%s", beadID, dependsOnID, err, output)
+	}
+	return nil
+}
+
 // EditCommand returns an exec.Cmd for opening a bead in an editor.
 // This is meant to be used with tea.ExecProcess for interactive editing.
 func EditCommand(ctx context.Context, beadID, beadsDir string) *exec.Cmd {
@@ -396,6 +458,22 @@ func (r *BeadsWithDepsResult) GetBead(id string) *BeadWithDeps {
 	}
 }
 
+// OpenBlockers returns the IDs of the beads blocking id that aren't closed
+// yet, in dependency order. A bead with none is ready to work on; closed
+// beads never have any.
+func (r *BeadsWithDepsResult) OpenBlockers(id string) []string {
+	if bead, ok := r.Beads[id]; ok && bead.Status == StatusClosed {
+		return nil
+	}
+	var blockers []string
+	for _, dep := range r.Dependencies[id] {
+		if dep.Type == "blocks" && dep.Status != StatusClosed {
+			blockers = append(blockers, dep.DependsOnID)
+		}
+	}
+	return blockers
+}
+
 // ClientConfig holds configuration for the Client.
 type ClientConfig struct {
 	DBPath           string
@@ -495,6 +573,18 @@ func (c *Client) GetBeadsWithDeps(ctx context.Context, beadIDs []string) (*Beads
 		beadsMap[issue.ID] = BeadFromIssue(issue)
 	}
 
+	// Fetch labels
+	labels, err := c.queries.GetLabelsForIssues(ctx, beadIDs)
+	if err != nil {
+		return nil, fmt.Errorf("fetching labels: %w", err)
+	}
+	for _, l := range labels {
+		if bead, ok := beadsMap[l.IssueID]; ok {
+			bead.Labels = append(bead.Labels, l.Label)
+			beadsMap[l.IssueID] = bead
+		}
+	}
+
 	// Fetch dependencies
 	deps, err := c.queries.GetDependenciesForIssues(ctx, beadIDs)
 	if err != nil {
@@ -589,6 +679,15 @@ func (c *Client) ListBeads(ctx context.Context, status string) ([]Bead, error) {
 	return beads, nil
 }
 
+// ListLabels returns every label in use on a live bead, sorted.
+func (c *Client) ListLabels(ctx context.Context) ([]string, error) {
+	labels, err := c.queries.GetAllLabels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching labels: %w", err)
+	}
+	return labels, nil
+}
+
 // GetReadyBeads returns all open beads where all dependencies are satisfied.
 func (c *Client) GetReadyBeads(ctx context.Context) ([]Bead, error) {
 	// Get all open beads
@@ -613,17 +712,7 @@ func (c *Client) GetReadyBeads(ctx context.Context) ([]Bead, error) {
 		if !ok {
 			continue
 		}
-
-		// Check if all blocking dependencies are satisfied
-		isReady := true
-		for _, dep := range result.Dependencies[id] {
-			if dep.Type == "blocks" && dep.Status != StatusClosed {
-				isReady = false
-				break
-			}
-		}
-
-		if isReady {
+		if len(result.OpenBlockers(id)) == 0 {
 			ready = append(ready, bead)
 		}
 	}
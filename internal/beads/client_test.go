@@ -57,6 +57,35 @@ func TestBeadsWithDepsResult(t *testing.T) {
 }
 
 // TestDefaultClientConfig tests the default configuration.
+func TestBeadsWithDepsResult_OpenBlockers(t *testing.T) {
+	result := &BeadsWithDepsResult{
+		Beads: map[string]Bead{
+			"ready":   {ID: "ready", Status: StatusOpen},
+			"blocked": {ID: "blocked", Status: StatusOpen},
+			"done":    {ID: "done", Status: StatusClosed},
+		},
+		Dependencies: map[string][]Dependency{
+			"ready": {
+				{IssueID: "ready", DependsOnID: "closed-dep", Type: "blocks", Status: StatusClosed},
+				{IssueID: "ready", DependsOnID: "epic", Type: "parent-child", Status: StatusOpen},
+			},
+			"blocked": {
+				{IssueID: "blocked", DependsOnID: "dep-1", Type: "blocks", Status: StatusOpen},
+				{IssueID: "blocked", DependsOnID: "dep-2", Type: "blocks", Status: StatusInProgress},
+				{IssueID: "blocked", DependsOnID: "dep-3", Type: "blocks", Status: StatusClosed},
+			},
+			"done": {
+				{IssueID: "done", DependsOnID: "dep-1", Type: "blocks", Status: StatusOpen},
+			},
+		},
+	}
+
+	require.Empty(t, result.OpenBlockers("ready"))
+	require.Equal(t, []string{"dep-1", "dep-2"}, result.OpenBlockers("blocked"))
+	require.Empty(t, result.OpenBlockers("done"), "closed beads are never blocked")
+	require.Empty(t, result.OpenBlockers("missing"))
+}
+
 func TestDefaultClientConfig(t *testing.T) {
 	cfg := DefaultClientConfig("/path/to/db")
 
@@ -282,3 +311,27 @@ func TestBeadWithDepsStruct(t *testing.T) {
 	require.Len(t, beadWithDeps.Dependencies, 1)
 	require.Len(t, beadWithDeps.Dependents, 1)
 }
+
+// TestParseCreatedBeadID tests reading the new bead's ID from the output of
+// different bd versions, for any ID prefix.
+func TestParseCreatedBeadID(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   string
+	}{
+		{"json", `{"id":"proj-42","title":"Fix the login","status":"open"}`, "proj-42"},
+		{"json after a warning", "Warning: auto-import skipped\n{\"id\": \"my_app-a1b\", \"title\": \"x\"}\n", "my_app-a1b"},
+		{"json array", `[{"id":"co-7.1","title":"child"}]`, "co-7.1"},
+		{"text with colon", "✓ Created issue: s-0o9\n   Title: Add re-auth\n   Priority: P2\n", "s-0o9"},
+		{"text without colon", "Created issue proj-17 (P1)\n", "proj-17"},
+		{"hierarchical child", "✓ Created issue: ac-12.3\n", "ac-12.3"},
+		{"bare id after other dashed words", "auto-flush: done\nproj-99\n", "proj-99"},
+		{"no id", "Error: something went wrong\n", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, parseCreatedBeadID([]byte(tt.output)))
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package beads
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/newhook/co/internal/fuzzy"
+)
+
+// DefaultDuplicateThreshold is the title similarity, from 0 to 1, at which
+// two beads are offered as duplicates when [beads] dedupe_threshold isn't set.
+const DefaultDuplicateThreshold = 0.85
+
+// DuplicatePair is two beads whose titles are alike enough to be the same
+// issue. Survivor is the older of the two, the one suggested to keep.
+type DuplicatePair struct {
+	Survivor  Bead
+	Duplicate Bead
+	Score     float64 // Title similarity, from threshold to 1
+}
+
+// FindDuplicates compares the titles of every pair of beads and returns the
+// pairs scoring at least threshold, most alike first. Epics are left out,
+// since they are expected to share words with their children.
+func FindDuplicates(beads []Bead, threshold float64) []DuplicatePair {
+	candidates := make([]Bead, 0, len(beads))
+	for _, b := range beads {
+		if !b.IsEpic {
+			candidates = append(candidates, b)
+		}
+	}
+	words := make([]map[string]bool, len(candidates))
+	for i, b := range candidates {
+		words[i] = make(map[string]bool)
+		for _, t := range fuzzy.Tokens(b.Title) {
+			words[i][t] = true
+		}
+	}
+
+	var pairs []DuplicatePair
+	for i := range candidates {
+		for j := i + 1; j < len(candidates); j++ {
+			// Most pairs share no word at all; skip them before scoring
+			if !sharesWord(words[i], words[j]) {
+				continue
+			}
+			if pair, ok := newDuplicatePair(candidates[i], candidates[j], threshold); ok {
+				pairs = append(pairs, pair)
+			}
+		}
+	}
+	sortDuplicatePairs(pairs)
+	return pairs
+}
+
+// FindDuplicatesOf returns the beads whose titles score at least threshold
+// against bead's, most alike first. The pairs are oriented to keep bead.
+func FindDuplicatesOf(bead Bead, beads []Bead, threshold float64) []DuplicatePair {
+	var pairs []DuplicatePair
+	for _, other := range beads {
+		if other.ID == bead.ID || other.IsEpic {
+			continue
+		}
+		if score := fuzzy.TokenSetRatio(bead.Title, other.Title); score >= threshold {
+			pairs = append(pairs, DuplicatePair{Survivor: bead, Duplicate: other, Score: score})
+		}
+	}
+	sortDuplicatePairs(pairs)
+	return pairs
+}
+
+func newDuplicatePair(a, b Bead, threshold float64) (DuplicatePair, bool) {
+	score := fuzzy.TokenSetRatio(a.Title, b.Title)
+	if score < threshold {
+		return DuplicatePair{}, false
+	}
+	if b.CreatedAt.Before(a.CreatedAt) {
+		a, b = b, a
+	}
+	return DuplicatePair{Survivor: a, Duplicate: b, Score: score}, true
+}
+
+func sharesWord(a, b map[string]bool) bool {
+	for w := range a {
+		if b[w] {
+			return true
+		}
+	}
+	return false
+}
+
+func sortDuplicatePairs(pairs []DuplicatePair) {
+	slices.SortStableFunc(pairs, func(x, y DuplicatePair) int {
+		if c := cmp.Compare(y.Score, x.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(x.Survivor.ID+x.Duplicate.ID, y.Survivor.ID+y.Duplicate.ID)
+	})
+}
+
+// MergeDuplicate folds duplicate into survivor: the duplicate's description
+// is appended to the survivor's, its dependencies and dependents are moved
+// to the survivor, and it is closed with a comment pointing at the survivor.
+// Moving the duplicate's work and task assignments is up to the caller.
+func MergeDuplicate(ctx context.Context, cli CLI, survivor, duplicate *BeadWithDeps) error {
+	if survivor.ID == duplicate.ID {
+		return fmt.Errorf("can't merge %s into itself", survivor.ID)
+	}
+
+	if desc := strings.TrimSpace(duplicate.Description); desc != "" && !strings.Contains(survivor.Description, desc) {
+		merged := fmt.Sprintf("From duplicate %s (%s):\n\n%s", duplicate.ID, duplicate.Title, desc)
+		if current := strings.TrimSpace(survivor.Description); current != "" {
+			merged = current + "\n\n---\n\n" + merged
+		}
+		if err := cli.Update(ctx, survivor.ID, UpdateOptions{Description: merged}); err != nil {
+			return err
+		}
+	}
+
+	// What the duplicate depends on, the survivor now depends on
+	hasParent := false
+	has := make(map[string]bool)
+	for _, dep := range survivor.Dependencies {
+		has[dep.DependsOnID] = true
+		hasParent = hasParent || dep.Type == "parent-child"
+	}
+	for _, dep := range duplicate.Dependencies {
+		keep := dep.DependsOnID != survivor.ID && !has[dep.DependsOnID] &&
+			!(dep.Type == "parent-child" && hasParent)
+		if keep {
+			if err := cli.AddDependencyOfType(ctx, survivor.ID, dep.DependsOnID, dependencyType(dep.Type)); err != nil {
+				return err
+			}
+			has[dep.DependsOnID] = true
+		}
+		if err := cli.RemoveDependency(ctx, duplicate.ID, dep.DependsOnID); err != nil {
+			return err
+		}
+	}
+
+	// What depended on the duplicate now depends on the survivor
+	dependents := make(map[string]bool)
+	for _, dep := range survivor.Dependents {
+		dependents[dep.IssueID] = true
+	}
+	for _, dep := range duplicate.Dependents {
+		if dep.IssueID != survivor.ID && !dependents[dep.IssueID] {
+			if err := cli.AddDependencyOfType(ctx, dep.IssueID, survivor.ID, dependencyType(dep.Type)); err != nil {
+				return err
+			}
+			dependents[dep.IssueID] = true
+		}
+		if err := cli.RemoveDependency(ctx, dep.IssueID, duplicate.ID); err != nil {
+			return err
+		}
+	}
+
+	if err := cli.AddComment(ctx, duplicate.ID, "Duplicate of "+survivor.ID); err != nil {
+		return err
+	}
+	return cli.Close(ctx, duplicate.ID)
+}
+
+// dependencyType returns the type to recreate a dependency with; untyped
+// ones block
+func dependencyType(depType string) string {
+	if depType == "" {
+		return "blocks"
+	}
+	return depType
+}
@@ -0,0 +1,87 @@
+package beads
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	beads := []Bead{
+		{ID: "bd-2", Title: "App crashes when saving the settings", CreatedAt: day.Add(time.Hour)},
+		{ID: "bd-1", Title: "Crash when saving settings", CreatedAt: day},
+		{ID: "bd-3", Title: "Add dark mode", CreatedAt: day},
+		{ID: "bd-4", Title: "Dark mode: add", CreatedAt: day.Add(2 * time.Hour)},
+		{ID: "bd-5", Title: "Dark mode", IsEpic: true, CreatedAt: day},
+	}
+
+	pairs := FindDuplicates(beads, 0.8)
+	require.Len(t, pairs, 2, "epics are left out")
+	require.Equal(t, "bd-3", pairs[0].Survivor.ID, "the most alike come first")
+	require.Equal(t, "bd-4", pairs[0].Duplicate.ID)
+	require.Equal(t, 1.0, pairs[0].Score)
+	require.Equal(t, "bd-1", pairs[1].Survivor.ID, "the older bead survives")
+	require.Equal(t, "bd-2", pairs[1].Duplicate.ID)
+
+	require.Len(t, FindDuplicates(beads, 1), 1)
+
+	pairs = FindDuplicatesOf(beads[0], beads, 0.8)
+	require.Len(t, pairs, 1)
+	require.Equal(t, "bd-2", pairs[0].Survivor.ID, "the chosen bead is kept")
+	require.Equal(t, "bd-1", pairs[0].Duplicate.ID)
+}
+
+func TestMergeDuplicate(t *testing.T) {
+	ctx := context.Background()
+	survivor := &BeadWithDeps{
+		Bead:         &Bead{ID: "bd-1", Title: "Crash when saving", Description: "Seen on macOS."},
+		Dependencies: []Dependency{{IssueID: "bd-1", DependsOnID: "bd-9", Type: "blocks"}},
+		Dependents:   []Dependent{{IssueID: "bd-7", DependsOnID: "bd-1", Type: "blocks"}},
+	}
+	duplicate := &BeadWithDeps{
+		Bead: &Bead{ID: "bd-2", Title: "App crashes when saving", Description: "Also on Linux."},
+		Dependencies: []Dependency{
+			{IssueID: "bd-2", DependsOnID: "bd-9", Type: "blocks"},       // The survivor has it already
+			{IssueID: "bd-2", DependsOnID: "bd-8", Type: "parent-child"}, // Moves to the survivor
+			{IssueID: "bd-2", DependsOnID: "bd-1", Type: "related"},      // Would point at itself
+		},
+		Dependents: []Dependent{
+			{IssueID: "bd-7", DependsOnID: "bd-2", Type: "blocks"}, // Depends on the survivor already
+			{IssueID: "bd-6", DependsOnID: "bd-2"},
+		},
+	}
+
+	cli := &BeadsCLIMock{}
+	require.NoError(t, MergeDuplicate(ctx, cli, survivor, duplicate))
+
+	updates := cli.UpdateCalls()
+	require.Len(t, updates, 1)
+	require.Equal(t, "bd-1", updates[0].BeadID)
+	require.Equal(t, "Seen on macOS.\n\n---\n\nFrom duplicate bd-2 (App crashes when saving):\n\nAlso on Linux.", updates[0].Opts.Description)
+
+	var added [][3]string
+	for _, c := range cli.AddDependencyOfTypeCalls() {
+		added = append(added, [3]string{c.BeadID, c.DependsOnID, c.DepType})
+	}
+	require.Equal(t, [][3]string{{"bd-1", "bd-8", "parent-child"}, {"bd-6", "bd-1", "blocks"}}, added)
+
+	var removed [][2]string
+	for _, c := range cli.RemoveDependencyCalls() {
+		removed = append(removed, [2]string{c.BeadID, c.DependsOnID})
+	}
+	require.Equal(t, [][2]string{{"bd-2", "bd-9"}, {"bd-2", "bd-8"}, {"bd-2", "bd-1"}, {"bd-7", "bd-2"}, {"bd-6", "bd-2"}}, removed)
+
+	require.Equal(t, "Duplicate of bd-1", cli.AddCommentCalls()[0].Comment)
+	require.Equal(t, "bd-2", cli.CloseCalls()[0].BeadID)
+
+	// Without a description there's nothing to update
+	cli = &BeadsCLIMock{}
+	require.NoError(t, MergeDuplicate(ctx, cli, survivor, &BeadWithDeps{Bead: &Bead{ID: "bd-3"}}))
+	require.Empty(t, cli.UpdateCalls())
+	require.Len(t, cli.CloseCalls(), 1)
+
+	require.Error(t, MergeDuplicate(ctx, cli, survivor, survivor))
+}
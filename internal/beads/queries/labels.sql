@@ -0,0 +1,11 @@
+-- name: GetLabelsForIssues :many
+SELECT issue_id, label FROM labels
+WHERE issue_id IN (sqlc.slice('issue_ids'))
+ORDER BY issue_id, label;
+
+-- name: GetAllLabels :many
+SELECT DISTINCT l.label FROM labels l
+INNER JOIN issues i ON l.issue_id = i.id
+WHERE i.deleted_at IS NULL
+  AND i.status != 'tombstone'
+ORDER BY l.label;
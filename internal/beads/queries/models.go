@@ -75,3 +75,8 @@ type Issue struct {
 	DueAt              sql.NullTime    `json:"due_at"`
 	DeferUntil         sql.NullTime    `json:"defer_until"`
 }
+
+type Label struct {
+	IssueID string `json:"issue_id"`
+	Label   string `json:"label"`
+}
@@ -99,3 +99,12 @@ CREATE INDEX idx_dependencies_type ON dependencies(type);
 CREATE INDEX idx_dependencies_depends_on_type ON dependencies(depends_on_id, type);
 CREATE INDEX idx_dependencies_depends_on_type_issue ON dependencies(depends_on_id, type, issue_id);
 CREATE INDEX idx_dependencies_issue_type ON dependencies(issue_id, type);
+
+CREATE TABLE IF NOT EXISTS labels (
+    issue_id TEXT NOT NULL,
+    label TEXT NOT NULL,
+    PRIMARY KEY (issue_id, label),
+    FOREIGN KEY (issue_id) REFERENCES issues(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_labels_label ON labels(label);
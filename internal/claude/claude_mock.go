@@ -20,7 +20,7 @@ var _ Runner = &ClaudeRunnerMock{}
 //
 //		// make and configure a mocked Runner
 //		mockedRunner := &ClaudeRunnerMock{
-//			RunFunc: func(ctx context.Context, database *db.DB, taskID string, prompt string, workDir string, cfg *project.Config) error {
+//			RunFunc: func(ctx context.Context, database db.Store, taskID string, prompt string, workDir string, cfg *project.Config) error {
 //				panic("mock out the Run method")
 //			},
 //		}
@@ -31,7 +31,7 @@ var _ Runner = &ClaudeRunnerMock{}
 //	}
 type ClaudeRunnerMock struct {
 	// RunFunc mocks the Run method.
-	RunFunc func(ctx context.Context, database *db.DB, taskID string, prompt string, workDir string, cfg *project.Config) error
+	RunFunc func(ctx context.Context, database db.Store, taskID string, prompt string, workDir string, cfg *project.Config) error
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -40,7 +40,7 @@ type ClaudeRunnerMock struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 			// Database is the database argument value.
-			Database *db.DB
+			Database db.Store
 			// TaskID is the taskID argument value.
 			TaskID string
 			// Prompt is the prompt argument value.
@@ -55,10 +55,10 @@ type ClaudeRunnerMock struct {
 }
 
 // Run calls RunFunc.
-func (mock *ClaudeRunnerMock) Run(ctx context.Context, database *db.DB, taskID string, prompt string, workDir string, cfg *project.Config) error {
+func (mock *ClaudeRunnerMock) Run(ctx context.Context, database db.Store, taskID string, prompt string, workDir string, cfg *project.Config) error {
 	callInfo := struct {
 		Ctx      context.Context
-		Database *db.DB
+		Database db.Store
 		TaskID   string
 		Prompt   string
 		WorkDir  string
@@ -89,7 +89,7 @@ func (mock *ClaudeRunnerMock) Run(ctx context.Context, database *db.DB, taskID s
 //	len(mockedRunner.RunCalls())
 func (mock *ClaudeRunnerMock) RunCalls() []struct {
 	Ctx      context.Context
-	Database *db.DB
+	Database db.Store
 	TaskID   string
 	Prompt   string
 	WorkDir  string
@@ -97,7 +97,7 @@ func (mock *ClaudeRunnerMock) RunCalls() []struct {
 } {
 	var calls []struct {
 		Ctx      context.Context
-		Database *db.DB
+		Database db.Store
 		TaskID   string
 		Prompt   string
 		WorkDir  string
@@ -0,0 +1,80 @@
+package claude
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"text/template"
+
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/project"
+)
+
+// withEstimateGuide adds the scoring guide shared by the estimate prompts
+func withEstimateGuide(tmpl *template.Template) *template.Template {
+	return template.Must(tmpl.Parse(estimateGuideTemplateText))
+}
+
+// BuildBeadEstimatePrompt builds a prompt asking for the complexity of one
+// bead, given its content, with the scoring guide estimation tasks use.
+func BuildBeadEstimatePrompt(bead beads.Bead) string {
+	var buf bytes.Buffer
+	if err := estimateBeadTmpl.Execute(&buf, bead); err != nil {
+		// Fallback to simple string if template execution fails
+		return fmt.Sprintf("Estimate the complexity of issue %s: %s", bead.ID, bead.Title)
+	}
+	return buf.String()
+}
+
+// EstimateBead asks Claude for the complexity score (1-10) and context tokens
+// of a bead, the estimate an estimation task records, without a task or an
+// interactive session. Claude runs in workDir with read-only tools, so it can
+// look at the code the bead touches.
+func EstimateBead(ctx context.Context, bead beads.Bead, workDir string, cfg *project.Config) (score, tokens int, err error) {
+	args := []string{"--print", "--allowedTools", "Read,Grep,Glob"}
+	if cfg != nil && cfg.Claude.Model != "" {
+		args = append(args, "--model", cfg.Claude.Model)
+	}
+	args = append(args, BuildBeadEstimatePrompt(bead))
+
+	cmd := exec.CommandContext(ctx, "claude", args...)
+	cmd.Dir = workDir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return 0, 0, fmt.Errorf("failed to run claude: %w: %s", err, msg)
+		}
+		return 0, 0, fmt.Errorf("failed to run claude: %w", err)
+	}
+	return ParseEstimate(string(output))
+}
+
+// ParseEstimate reads the estimate from Claude's reply to the bead estimate
+// prompt: the last line holding a JSON object with a score and tokens.
+func ParseEstimate(output string) (score, tokens int, err error) {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := strings.Trim(strings.TrimSpace(lines[i]), "`")
+		if !strings.HasPrefix(line, "{") {
+			continue
+		}
+		var estimate struct {
+			Score  int `json:"score"`
+			Tokens int `json:"tokens"`
+		}
+		if err := json.Unmarshal([]byte(line), &estimate); err != nil {
+			continue
+		}
+		if err := db.ValidateComplexity(estimate.Score, estimate.Tokens); err != nil {
+			return 0, 0, fmt.Errorf("invalid estimate: %w", err)
+		}
+		return estimate.Score, estimate.Tokens, nil
+	}
+	return 0, 0, fmt.Errorf("no estimate found in Claude's reply")
+}
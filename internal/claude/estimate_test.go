@@ -0,0 +1,37 @@
+package claude
+
+import (
+	"testing"
+
+	"github.com/newhook/co/internal/beads"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildBeadEstimatePrompt(t *testing.T) {
+	prompt := BuildBeadEstimatePrompt(beads.Bead{ID: "bead-1", Title: "Fix login", Description: "The form loses input"})
+	require.Contains(t, prompt, "issue bead-1")
+	require.Contains(t, prompt, "Fix login")
+	require.Contains(t, prompt, "The form loses input")
+	require.Contains(t, prompt, "Complexity Scoring Guide")
+	require.Contains(t, prompt, `{"score": <complexity>, "tokens": <estimated-tokens>}`)
+
+	// The estimation task prompt shares the same guide
+	require.Contains(t, BuildEstimatePrompt("w-abc.1", []beads.Bead{{ID: "bead-1"}}), "Complexity Scoring Guide")
+}
+
+func TestParseEstimate(t *testing.T) {
+	score, tokens, err := ParseEstimate("The change touches two files.\n\n{\"score\": 3, \"tokens\": 15000}\n")
+	require.NoError(t, err)
+	require.Equal(t, 3, score)
+	require.Equal(t, 15000, tokens)
+
+	// The last object wins, even in a code span
+	score, _, err = ParseEstimate("Like {\"score\": 1, \"tokens\": 5000} but bigger:\n`{\"score\": 6, \"tokens\": 60000}`")
+	require.NoError(t, err)
+	require.Equal(t, 6, score)
+
+	_, _, err = ParseEstimate("I couldn't find the code.")
+	require.Error(t, err)
+	_, _, err = ParseEstimate(`{"score": 12, "tokens": 15000}`)
+	require.ErrorContains(t, err, "score must be between 1 and 10")
+}
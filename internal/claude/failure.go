@@ -0,0 +1,155 @@
+package claude
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/newhook/co/internal/db"
+)
+
+// ErrInterrupted is returned by Run when a signal stopped Claude before the
+// task finished.
+var ErrInterrupted = errors.New("interrupted")
+
+// rateLimitPattern matches the API errors Claude shows when it hits a rate or
+// usage limit.
+var rateLimitPattern = regexp.MustCompile(`(?i)usage limit reached|rate_limit_error|rate limit|API Error: 429|too many requests`)
+
+// nonAlphanumeric matches the characters Claude replaces in a session's
+// working directory to name its transcript directory.
+var nonAlphanumeric = regexp.MustCompile(`[^a-zA-Z0-9]`)
+
+// transcriptTailSize is how much of the end of a session transcript is read
+// when looking for the error that ended it.
+const transcriptTailSize = 64 << 10
+
+// FailureKind classifies an error that ended a task run into one of the
+// db.Failure* kinds. apiError is the last API error Claude showed during the
+// run, if any; a rate limit there wins over the way the run ended, since a
+// rate-limited Claude may sit waiting until the task times out.
+func FailureKind(err error, apiError string) string {
+	switch {
+	case apiError != "" && rateLimitPattern.MatchString(apiError):
+		return db.FailureRateLimited
+	case errors.Is(err, context.DeadlineExceeded):
+		return db.FailureTimeout
+	case errors.Is(err, context.Canceled), errors.Is(err, ErrInterrupted):
+		return db.FailureCancelled
+	default:
+		return db.FailureToolError
+	}
+}
+
+// transcriptPath returns where Claude keeps the transcript of session
+// sessionID run in workDir: <config dir>/projects/<workDir with every
+// character other than letters and digits replaced by ->/<sessionID>.jsonl.
+func transcriptPath(workDir, sessionID string) (string, error) {
+	configDir := os.Getenv("CLAUDE_CONFIG_DIR")
+	if configDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		configDir = filepath.Join(home, ".claude")
+	}
+	abs, err := filepath.Abs(workDir)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "projects", nonAlphanumeric.ReplaceAllString(abs, "-"), sessionID+".jsonl"), nil
+}
+
+// transcriptEntry is the part of a transcript line needed to find API errors.
+// Claude records them as assistant messages flagged isApiErrorMessage, or,
+// for usage limits, written by the "<synthetic>" model.
+type transcriptEntry struct {
+	Type              string `json:"type"`
+	IsAPIErrorMessage bool   `json:"isApiErrorMessage"`
+	Message           struct {
+		Model   string          `json:"model"`
+		Content json.RawMessage `json:"content"`
+	} `json:"message"`
+}
+
+// lastAPIError returns the text of the last API error in the transcript of
+// session sessionID, or "" if there is none or the transcript can't be read.
+// Only the end of the transcript is looked at.
+func lastAPIError(workDir, sessionID string) string {
+	path, err := transcriptPath(workDir, sessionID)
+	if err != nil {
+		return ""
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return ""
+	}
+	offset := max(info.Size()-transcriptTailSize, 0)
+	data, err := io.ReadAll(io.NewSectionReader(f, offset, info.Size()-offset))
+	if err != nil {
+		return ""
+	}
+	return lastAPIErrorIn(data, offset > 0)
+}
+
+// lastAPIErrorIn finds the last API error in transcript data. When partial
+// is set the data starts mid-line, and the first line is skipped.
+func lastAPIErrorIn(data []byte, partial bool) string {
+	if partial {
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			data = data[i+1:]
+		}
+	}
+	var last string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64<<10), transcriptTailSize+1)
+	for scanner.Scan() {
+		var entry transcriptEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		if entry.Type != "assistant" || (!entry.IsAPIErrorMessage && entry.Message.Model != "<synthetic>") {
+			continue
+		}
+		if text := contentText(entry.Message.Content); text != "" {
+			last = text
+		}
+	}
+	return last
+}
+
+// contentText returns the text of a message's content, which is either a
+// string or a list of blocks.
+func contentText(content json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(content, &s); err == nil {
+		return strings.TrimSpace(s)
+	}
+	var blocks []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(content, &blocks); err != nil {
+		return ""
+	}
+	var texts []string
+	for _, b := range blocks {
+		if b.Type == "text" && b.Text != "" {
+			texts = append(texts, b.Text)
+		}
+	}
+	return strings.TrimSpace(strings.Join(texts, "\n"))
+}
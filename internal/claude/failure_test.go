@@ -0,0 +1,52 @@
+package claude
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailureKind(t *testing.T) {
+	exitErr := errors.New("exit status 1")
+	require.Equal(t, db.FailureToolError, FailureKind(exitErr, ""))
+	require.Equal(t, db.FailureToolError, FailureKind(exitErr, "API Error: 500 Internal server error"))
+	require.Equal(t, db.FailureRateLimited, FailureKind(exitErr, `API Error: 429 {"type":"error","error":{"type":"rate_limit_error"}}`))
+	require.Equal(t, db.FailureRateLimited, FailureKind(context.DeadlineExceeded, "Claude AI usage limit reached|1760000000"))
+	require.Equal(t, db.FailureTimeout, FailureKind(fmt.Errorf("run: %w", context.DeadlineExceeded), ""))
+	require.Equal(t, db.FailureCancelled, FailureKind(context.Canceled, ""))
+	require.Equal(t, db.FailureCancelled, FailureKind(fmt.Errorf("%w by signal interrupt", ErrInterrupted), ""))
+}
+
+func TestLastAPIError(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("CLAUDE_CONFIG_DIR", configDir)
+	workDir := "/work/proj/w-abc/tree"
+
+	// No transcript
+	require.Empty(t, lastAPIError(workDir, "sess-1"))
+
+	lines := []string{
+		`{"type":"user","message":{"role":"user","content":"Implement rate limiting for the API"}}`,
+		`{"type":"assistant","message":{"model":"claude","content":[{"type":"text","text":"I'll add a rate limit middleware."}]}}`,
+		`{"type":"assistant","isApiErrorMessage":true,"message":{"model":"<synthetic>","content":[{"type":"text","text":"API Error: 500 Internal server error"}]}}`,
+		`not json`,
+		`{"type":"assistant","message":{"model":"<synthetic>","content":[{"type":"text","text":"Claude AI usage limit reached|1760000000"}]}}`,
+	}
+	dir := filepath.Join(configDir, "projects", "-work-proj-w-abc-tree")
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sess-1.jsonl"), []byte(strings.Join(lines, "\n")+"\n"), 0644))
+
+	// Only API errors count, so the task talking about rate limits doesn't
+	require.Equal(t, "Claude AI usage limit reached|1760000000", lastAPIError(workDir, "sess-1"))
+	require.Equal(t, "API Error: 500 Internal server error", lastAPIErrorIn([]byte(strings.Join(lines[:4], "\n")), false))
+
+	// Reading from the middle of a line skips it
+	require.Empty(t, lastAPIErrorIn([]byte(lines[2][10:]), true))
+}
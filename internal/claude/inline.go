@@ -9,12 +9,15 @@ import (
 	"os/exec"
 	"os/signal"
 	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/newhook/co/internal/beads/pubsub"
 	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/remote"
 	trackingwatcher "github.com/newhook/co/internal/tracking/watcher"
 )
 
@@ -22,11 +25,14 @@ import (
 // This abstraction enables testing without spawning the actual claude CLI.
 type Runner interface {
 	// Run executes Claude directly in the current terminal (fork/exec).
-	Run(ctx context.Context, database *db.DB, taskID string, prompt string, workDir string, cfg *project.Config) error
+	Run(ctx context.Context, database db.Store, taskID string, prompt string, workDir string, cfg *project.Config) error
 }
 
 // CLIRunner implements Runner using the claude CLI.
-type CLIRunner struct{}
+type CLIRunner struct {
+	// terminal receives Claude's output. Nil means os.Stdout and os.Stderr.
+	terminal *os.File
+}
 
 // Compile-time check that CLIRunner implements Runner.
 var _ Runner = (*CLIRunner)(nil)
@@ -36,8 +42,14 @@ func NewRunner() Runner {
 	return &CLIRunner{}
 }
 
+// NewTerminalRunner creates a Runner whose Claude sessions write to term
+// rather than os.Stdout, for callers that redirect os.Stdout.
+func NewTerminalRunner(term *os.File) Runner {
+	return &CLIRunner{terminal: term}
+}
+
 // Run implements Runner.Run.
-func (r *CLIRunner) Run(ctx context.Context, database *db.DB, taskID string, prompt string, workDir string, cfg *project.Config) error {
+func (r *CLIRunner) Run(ctx context.Context, database db.Store, taskID string, prompt string, workDir string, cfg *project.Config) error {
 	// Get task to verify it exists
 	task, err := database.GetTask(ctx, taskID)
 	if err != nil {
@@ -60,37 +72,162 @@ func (r *CLIRunner) Run(ctx context.Context, database *db.DB, taskID string, pro
 	if cfg != nil && cfg.Claude.ShouldSkipPermissions() {
 		claudeArgs = append(claudeArgs, "--dangerously-skip-permissions")
 	}
-	// Use configured model for log_analysis tasks
-	if task.TaskType == "log_analysis" && cfg != nil {
-		model := cfg.LogParser.GetModel()
-		if model != "" {
-			claudeArgs = append(claudeArgs, "--model", model)
+	model := taskModel(task, cfg)
+	if model != "" {
+		claudeArgs = append(claudeArgs, "--model", model)
+	}
+	// A known session ID lets a failed run's transcript be checked for the
+	// API error that ended it
+	sessionID := uuid.New().String()
+	claudeArgs = append(claudeArgs, "--session-id", sessionID, prompt)
+
+	// Derive project root from workDir (assumes workDir is <project>/<work-id>/tree/)
+	projectRoot := filepath.Dir(filepath.Dir(workDir))
+	var claudeCmd *exec.Cmd
+	work, err := database.GetWork(ctx, task.WorkID)
+	if err != nil {
+		return fmt.Errorf("failed to get work %s: %w", task.WorkID, err)
+	}
+	if work != nil && work.IsRemote() {
+		// workDir is on the host; a remote work's orchestrator runs from
+		// the project root
+		if projectRoot, err = os.Getwd(); err != nil {
+			return fmt.Errorf("failed to get project directory: %w", err)
+		}
+		reports, err := remote.ListenReports(remote.LocalSocketPath(taskID), projectRoot)
+		if err != nil {
+			return err
+		}
+		defer reports.Close()
+		go reports.Serve(ctx)
+		// The session doesn't inherit this process's environment on the host
+		var env []string
+		if cfg != nil {
+			env = append(project.MergeEnv(cfg.Hooks.Env, work.Env), cfg.Git.CommitEnv(work.ID, taskID)...)
 		}
+		fmt.Printf("Running Claude on %s in %s\n", work.RemoteHost, workDir)
+		claudeCmd = exec.CommandContext(ctx, "ssh", remoteClaudeArgs(work.RemoteHost, workDir, taskID, claudeArgs, env)...)
+	} else {
+		claudeCmd = exec.CommandContext(ctx, "claude", claudeArgs...)
+		claudeCmd.Dir = workDir
 	}
-	claudeArgs = append(claudeArgs, prompt)
-	claudeCmd := exec.CommandContext(ctx, "claude", claudeArgs...)
-	claudeCmd.Dir = workDir
 	claudeCmd.Stdin = os.Stdin
 	claudeCmd.Stdout = os.Stdout
 	claudeCmd.Stderr = os.Stderr
+	if r.terminal != nil {
+		claudeCmd.Stdout = r.terminal
+		claudeCmd.Stderr = r.terminal
+	}
+
+	// Record the attempt, so the task's history shows what each run used
+	if model == "" {
+		// claude falls back to ANTHROPIC_MODEL before its own default
+		model = os.Getenv("ANTHROPIC_MODEL")
+	}
+	runID, err := database.StartTaskRun(ctx, taskID, model, runSettings(cfg), claudeVersion(ctx))
+	if err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+	defer func() {
+		if runID == 0 {
+			return
+		}
+		status := db.StatusFailed
+		if task, err := database.GetTask(context.WithoutCancel(ctx), taskID); err == nil && task != nil {
+			status = task.Status
+		}
+		if err := database.FinishTaskRun(context.WithoutCancel(ctx), runID, status); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+		// A finished task keeps only its latest steps
+		if status == db.StatusCompleted || status == db.StatusFailed {
+			if err := database.PruneTaskSteps(context.WithoutCancel(ctx), taskID, db.TaskStepRetention); err != nil {
+				fmt.Printf("Warning: %v\n", err)
+			}
+		}
+	}()
 
 	// Start Claude
 	if err := claudeCmd.Start(); err != nil {
-		if dbErr := database.FailTask(ctx, taskID, fmt.Sprintf("failed to start Claude: %v", err)); dbErr != nil {
+		if dbErr := database.FailTaskWithKind(ctx, taskID, db.FailureToolError, fmt.Sprintf("failed to start Claude: %v", err)); dbErr != nil {
 			fmt.Printf("Warning: failed to mark task as failed: %v\n", dbErr)
 		}
 		return fmt.Errorf("failed to start Claude: %w", err)
 	}
 
+	// Report the session's steps. A remote session's transcript is on its
+	// host, so only the steps it reports with co task progress show.
+	tracker := &progressTracker{database: database, taskID: taskID}
+	tracker.record(ctx, StepPromptSent, "")
+	if work == nil || !work.IsRemote() {
+		if tracker.path, err = transcriptPath(workDir, sessionID); err == nil {
+			trackCtx, stopTracking := context.WithCancel(ctx)
+			tracked := make(chan struct{})
+			go trackProgress(trackCtx, tracker, tracked)
+			defer func() {
+				stopTracking()
+				<-tracked
+			}()
+		}
+	}
+
 	// Run the main monitoring loop
-	// Derive project root from workDir (assumes workDir is <project>/<work-id>/tree/)
-	projectRoot := filepath.Dir(filepath.Dir(workDir))
-	return monitorClaude(ctx, database, taskID, claudeCmd, startTime, projectRoot)
+	apiError := func() string { return lastAPIError(workDir, sessionID) }
+	return monitorClaude(ctx, database, taskID, claudeCmd, startTime, projectRoot, apiError)
+}
+
+// taskModel returns the model a task runs Claude with, or "" for Claude's
+// default
+func taskModel(task *db.Task, cfg *project.Config) string {
+	if cfg == nil {
+		return ""
+	}
+	if task.TaskType == db.TaskTypeLogAnalysis {
+		return cfg.LogParser.GetModel()
+	}
+	return cfg.Claude.Model
+}
+
+// runSettings describes the settings besides the model that change how a
+// task's Claude session works, for the task's run history
+func runSettings(cfg *project.Config) string {
+	var settings []string
+	if budget := os.Getenv("MAX_THINKING_TOKENS"); budget != "" {
+		settings = append(settings, "max_thinking_tokens="+budget)
+	}
+	if cfg != nil {
+		if cfg.Claude.TimeLimitMinutes > 0 {
+			settings = append(settings, fmt.Sprintf("time_limit=%dm", cfg.Claude.TimeLimitMinutes))
+		}
+		if !cfg.Claude.ShouldSkipPermissions() {
+			settings = append(settings, "permission_prompts")
+		}
+	}
+	return strings.Join(settings, ", ")
+}
+
+// claudeVersion returns the version of the claude CLI, like 2.0.14, or ""
+// when it can't be determined
+func claudeVersion(ctx context.Context) string {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, "claude", "--version").Output()
+	if err != nil {
+		return ""
+	}
+	// claude prints the version followed by the product name
+	fields := strings.Fields(string(out))
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
 }
 
 // monitorClaude handles the main event loop for monitoring Claude execution.
 // It watches for Claude exit, task completion in database, signals, and context cancellation.
-func monitorClaude(ctx context.Context, database *db.DB, taskID string, claudeCmd *exec.Cmd, startTime time.Time, projectRoot string) error {
+// apiError returns the last API error Claude showed, used to tell rate limits
+// from other failures.
+func monitorClaude(ctx context.Context, database db.Store, taskID string, claudeCmd *exec.Cmd, startTime time.Time, projectRoot string, apiError func() string) error {
 	// Set up signal handling
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
@@ -150,7 +287,7 @@ func monitorClaude(ctx context.Context, database *db.DB, taskID string, claudeCm
 		select {
 		case err := <-done:
 			// Claude exited on its own - no termination needed
-			return handleClaudeExit(ctx, database, taskID, err, startTime)
+			return handleClaudeExit(ctx, database, taskID, err, startTime, apiError)
 
 		case event, ok := <-watcherSub:
 			if !ok {
@@ -190,11 +327,20 @@ func monitorClaude(ctx context.Context, database *db.DB, taskID string, claudeCm
 				claudeCmd.Process.Signal(syscall.SIGTERM)
 			}
 			terminateGracefully(claudeCmd, done)
-			return fmt.Errorf("interrupted by signal %v", sig)
+			return fmt.Errorf("%w by signal %v", ErrInterrupted, sig)
 
 		case <-ctx.Done():
 			fmt.Println("\nContext cancelled, terminating Claude...")
 			terminateGracefully(claudeCmd, done)
+			// A rate-limited Claude waits for the limit to reset, so the
+			// timeout is often only the symptom
+			if msg := apiError(); FailureKind(ctx.Err(), msg) == db.FailureRateLimited {
+				// Use context.Background() since ctx is done
+				if dbErr := database.FailTaskWithKind(context.Background(), taskID, db.FailureRateLimited, "Claude hit a rate limit: "+msg); dbErr != nil {
+					fmt.Printf("Warning: failed to mark task as failed: %v\n", dbErr)
+				}
+				return fmt.Errorf("claude hit a rate limit: %s", msg)
+			}
 			return ctx.Err()
 		}
 	}
@@ -214,7 +360,7 @@ func terminateGracefully(cmd *exec.Cmd, done <-chan error) {
 }
 
 // handleClaudeExit processes Claude's exit and returns the appropriate result.
-func handleClaudeExit(ctx context.Context, database *db.DB, taskID string, exitErr error, startTime time.Time) error {
+func handleClaudeExit(ctx context.Context, database db.Store, taskID string, exitErr error, startTime time.Time, apiError func() string) error {
 	elapsed := time.Since(startTime)
 
 	if exitErr != nil {
@@ -225,7 +371,13 @@ func handleClaudeExit(ctx context.Context, database *db.DB, taskID string, exitE
 			return nil
 		}
 		// Actual error
-		if dbErr := database.FailTask(ctx, taskID, fmt.Sprintf("Claude exited with error: %v", exitErr)); dbErr != nil {
+		msg := apiError()
+		kind := FailureKind(exitErr, msg)
+		errorMessage := fmt.Sprintf("Claude exited with error: %v", exitErr)
+		if kind == db.FailureRateLimited {
+			errorMessage = "Claude hit a rate limit: " + msg
+		}
+		if dbErr := database.FailTaskWithKind(ctx, taskID, kind, errorMessage); dbErr != nil {
 			fmt.Printf("Warning: failed to mark task as failed: %v\n", dbErr)
 		}
 		return fmt.Errorf("claude exited with error: %w", exitErr)
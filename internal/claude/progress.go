@@ -0,0 +1,161 @@
+package claude
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/newhook/co/internal/db"
+)
+
+// Steps the orchestrator reports as a task's Claude session goes along. Other
+// steps come from co task progress.
+const (
+	StepPromptSent    = "prompt sent"
+	StepFirstResponse = "first response"
+	StepRunningTests  = "running tests"
+	StepCommitting    = "committing"
+)
+
+// progressPollInterval is how often a session's transcript is read for steps
+const progressPollInterval = 3 * time.Second
+
+// maxStepDetail bounds the detail stored with a step, such as a command line
+const maxStepDetail = 200
+
+// testCommandPattern matches the shell commands that run a test suite
+var testCommandPattern = regexp.MustCompile(`(?:^|[\s;&|(])(?:go test|(?:npm|pnpm|yarn|bun) (?:run )?test|pytest|cargo test|make test|mise run test|jest|vitest|rspec|mvn test|gradle test)\b`)
+
+// commitCommandPattern matches a git commit
+var commitCommandPattern = regexp.MustCompile(`\bgit\s+(?:-\S+\s+)*commit\b`)
+
+// transcriptToolUse is a content block of an assistant message, of which
+// only shell commands are looked at
+type transcriptToolUse struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Input struct {
+		Command string `json:"command"`
+	} `json:"input"`
+}
+
+// stepInLine returns the step a transcript line shows the session has
+// reached, or "" if it shows none. responded tells whether Claude has already
+// answered the prompt.
+func stepInLine(line []byte, responded bool) (step, detail string) {
+	var entry transcriptEntry
+	if err := json.Unmarshal(line, &entry); err != nil || entry.Type != "assistant" || entry.IsAPIErrorMessage {
+		return "", ""
+	}
+	var blocks []transcriptToolUse
+	_ = json.Unmarshal(entry.Message.Content, &blocks)
+	// The last command of a message is the one running now
+	for i := len(blocks) - 1; i >= 0; i-- {
+		b := blocks[i]
+		if b.Type != "tool_use" || b.Name != "Bash" {
+			continue
+		}
+		command := strings.TrimSpace(b.Input.Command)
+		switch {
+		case commitCommandPattern.MatchString(command):
+			return StepCommitting, ""
+		case testCommandPattern.MatchString(command):
+			first, _, _ := strings.Cut(command, "\n")
+			return StepRunningTests, truncateDetail(first)
+		}
+	}
+	if !responded {
+		return StepFirstResponse, ""
+	}
+	return "", ""
+}
+
+// truncateDetail shortens a step's detail to maxStepDetail bytes
+func truncateDetail(s string) string {
+	if len(s) <= maxStepDetail {
+		return s
+	}
+	return s[:maxStepDetail] + "..."
+}
+
+// progressTracker reports a task's steps as they show up in its session
+// transcript. Steps are only recorded when they change, so running the tests
+// twice in a row is one step.
+type progressTracker struct {
+	database  db.Store
+	taskID    string
+	path      string
+	offset    int64  // How far the transcript has been read
+	partial   []byte // A line read before it was finished
+	responded bool
+	last      string
+}
+
+// record stores a step unless it's the one recorded last
+func (t *progressTracker) record(ctx context.Context, step, detail string) {
+	if step == t.last {
+		return
+	}
+	t.last = step
+	if err := t.database.AddTaskStep(ctx, t.taskID, step, detail); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+}
+
+// poll reads what was added to the transcript since the last poll. The
+// transcript doesn't exist until Claude first writes to it.
+func (t *progressTracker) poll(ctx context.Context) {
+	f, err := os.Open(t.path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	if _, err := f.Seek(t.offset, io.SeekStart); err != nil {
+		return
+	}
+	data, err := io.ReadAll(f)
+	if err != nil || len(data) == 0 {
+		return
+	}
+	t.offset += int64(len(data))
+
+	data = append(t.partial, data...)
+	end := bytes.LastIndexByte(data, '\n')
+	if end < 0 {
+		t.partial = data
+		return
+	}
+	t.partial = append([]byte(nil), data[end+1:]...)
+	for _, line := range bytes.Split(data[:end], []byte("\n")) {
+		step, detail := stepInLine(line, t.responded)
+		if step == "" {
+			continue
+		}
+		t.responded = true
+		t.record(ctx, step, detail)
+	}
+}
+
+// trackProgress reports the steps of a task's session from its transcript
+// until ctx is done, then closes done.
+func trackProgress(ctx context.Context, t *progressTracker, done chan<- struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(progressPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// Catch what was written since the last tick
+			t.poll(context.WithoutCancel(ctx))
+			return
+		case <-ticker.C:
+			t.poll(ctx)
+		}
+	}
+}
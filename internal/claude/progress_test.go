@@ -0,0 +1,80 @@
+package claude
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepInLine(t *testing.T) {
+	bash := func(command string) []byte {
+		return []byte(`{"type":"assistant","message":{"content":[{"type":"text","text":"Running it"},{"type":"tool_use","name":"Bash","input":{"command":"` + command + `"}}]}}`)
+	}
+	text := []byte(`{"type":"assistant","message":{"content":[{"type":"text","text":"Looking at the code"}]}}`)
+
+	step, _ := stepInLine(text, false)
+	require.Equal(t, StepFirstResponse, step)
+	step, _ = stepInLine(text, true)
+	require.Empty(t, step)
+
+	step, detail := stepInLine(bash("cd api && go test ./... -run TestLimit"), true)
+	require.Equal(t, StepRunningTests, step)
+	require.Equal(t, "cd api && go test ./... -run TestLimit", detail)
+	step, _ = stepInLine(bash("npm run test"), false)
+	require.Equal(t, StepRunningTests, step)
+	step, _ = stepInLine(bash(`git add -A && git commit -m \"Add limit\"`), true)
+	require.Equal(t, StepCommitting, step)
+
+	// Commands that only mention tests, and other entries, aren't steps
+	step, _ = stepInLine(bash("ls internal/test"), true)
+	require.Empty(t, step)
+	step, _ = stepInLine([]byte(`{"type":"user","message":{"content":"go test"}}`), false)
+	require.Empty(t, step)
+	step, _ = stepInLine([]byte(`{"type":"assistant","isApiErrorMessage":true,"message":{"content":"API Error"}}`), false)
+	require.Empty(t, step)
+}
+
+func TestProgressTracker(t *testing.T) {
+	ctx := context.Background()
+	database, err := db.OpenPath(ctx, ":memory:")
+	require.NoError(t, err)
+	defer database.Close()
+	require.NoError(t, database.CreateWork(ctx, "w-abc", "", "/tmp/tree", "feat/abc", "main", "", false))
+	require.NoError(t, database.CreateTask(ctx, "w-abc.1", "implement", nil, 0, "w-abc"))
+
+	path := filepath.Join(t.TempDir(), "sess-1.jsonl")
+	tracker := &progressTracker{database: database, taskID: "w-abc.1", path: path}
+	tracker.record(ctx, StepPromptSent, "")
+
+	// No transcript yet
+	tracker.poll(ctx)
+
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+	write := func(s string) {
+		_, err := f.WriteString(s)
+		require.NoError(t, err)
+	}
+	write(`{"type":"assistant","message":{"content":[{"type":"text","text":"On it"}]}}` + "\n")
+	// A line still being written waits for the next poll
+	write(`{"type":"assistant","message":{"content":[{"type":"tool_use","name":"Bash",`)
+	tracker.poll(ctx)
+	write(`"input":{"command":"go test ./..."}}]}}` + "\n")
+	write(`{"type":"assistant","message":{"content":[{"type":"tool_use","name":"Bash","input":{"command":"go test ./internal/..."}}]}}` + "\n")
+	tracker.poll(ctx)
+
+	steps, err := database.GetTaskSteps(ctx, "w-abc.1")
+	require.NoError(t, err)
+	var names []string
+	for _, s := range steps {
+		names = append(names, s.Step)
+	}
+	// Running the tests again in a row is the same step
+	require.Equal(t, []string{StepPromptSent, StepFirstResponse, StepRunningTests}, names)
+	require.Equal(t, "go test ./...", steps[2].Detail)
+}
@@ -0,0 +1,30 @@
+package claude
+
+import (
+	"strings"
+
+	"github.com/newhook/co/internal/remote"
+)
+
+// remoteClaudeArgs returns the ssh arguments that run Claude with claudeArgs
+// in a remote work's worktree on host. ssh forwards the task's report socket
+// to the host, so the co complete Claude runs there reaches this
+// orchestrator's tracking database; env (KEY=value) is exported first.
+func remoteClaudeArgs(host, workDir, taskID string, claudeArgs, env []string) []string {
+	quoted := make([]string, len(claudeArgs))
+	for i, arg := range claudeArgs {
+		quoted[i] = remote.Quote(arg)
+	}
+	remoteSocket := remote.RemoteSocketPath(taskID)
+	env = append(env[:len(env):len(env)], remote.ReportSocketEnv+"="+remoteSocket)
+	command := remote.ExportCommand(env, "exec claude "+strings.Join(quoted, " "))
+
+	args := []string{
+		// Without the socket the task could never be completed
+		"-o", "ExitOnForwardFailure=yes",
+		// Replace the socket a killed session left behind
+		"-o", "StreamLocalBindUnlink=yes",
+		"-R", remoteSocket + ":" + remote.LocalSocketPath(taskID),
+	}
+	return append(args, remote.InteractiveArgs(host, workDir, command)...)
+}
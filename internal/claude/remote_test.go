@@ -0,0 +1,27 @@
+package claude
+
+import (
+	"testing"
+
+	"github.com/newhook/co/internal/remote"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteClaudeArgs(t *testing.T) {
+	args := remoteClaudeArgs("desktop", "~/co/proj/w-abc/tree", "w-abc.1",
+		[]string{"--session-id", "s-1", "it's done"}, []string{"CO_WORK_ID=w-abc"})
+
+	// The report socket is forwarded back to the orchestrator
+	require.Equal(t, []string{
+		"-o", "ExitOnForwardFailure=yes",
+		"-o", "StreamLocalBindUnlink=yes",
+		"-R", "/tmp/co-report-w-abc.1.sock:" + remote.LocalSocketPath("w-abc.1"),
+		"-t", "--", "desktop",
+	}, args[:len(args)-1])
+
+	// Claude runs in the worktree with the environment and socket exported,
+	// its arguments quoted for the remote shell
+	require.Equal(t,
+		`cd "$HOME"/'co/proj/w-abc/tree' && export CO_WORK_ID='w-abc' && export CO_REPORT_SOCKET='/tmp/co-report-w-abc.1.sock' && exec claude '--session-id' 's-1' 'it'"'"'s done'`,
+		args[len(args)-1])
+}
@@ -6,7 +6,9 @@ import (
 	_ "embed"
 	"fmt"
 	"io"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"text/template"
 
 	"github.com/newhook/co/internal/beads"
@@ -16,6 +18,12 @@ import (
 //go:embed templates/estimate.tmpl
 var estimateTemplateText string
 
+//go:embed templates/estimate_guide.tmpl
+var estimateGuideTemplateText string
+
+//go:embed templates/estimate_bead.tmpl
+var estimateBeadTemplateText string
+
 //go:embed templates/task.tmpl
 var taskTemplateText string
 
@@ -35,7 +43,8 @@ var planTemplateText string
 var logAnalysisTemplateText string
 
 var (
-	estimateTmpl            = template.Must(template.New("estimate").Parse(estimateTemplateText))
+	estimateTmpl            = withEstimateGuide(template.Must(template.New("estimate").Parse(estimateTemplateText)))
+	estimateBeadTmpl        = withEstimateGuide(template.Must(template.New("estimate_bead").Parse(estimateBeadTemplateText)))
 	taskTmpl                = template.Must(template.New("task").Parse(taskTemplateText))
 	prTmpl                  = template.Must(template.New("pr").Parse(prTemplateText))
 	reviewTmpl              = template.Must(template.New("review").Parse(reviewTemplateText))
@@ -118,20 +127,23 @@ func BuildPRPrompt(taskID string, workID string, branchName string, baseBranch s
 	return buf.String()
 }
 
-// BuildReviewPrompt builds a prompt for code review.
-func BuildReviewPrompt(taskID string, workID string, branchName string, baseBranch string, rootIssueID string) string {
+// BuildReviewPrompt builds a prompt for code review. ciFailures, when not
+// empty, describes the CI checks failing on the PR for the review to cover.
+func BuildReviewPrompt(taskID string, workID string, branchName string, baseBranch string, rootIssueID string, ciFailures string) string {
 	data := struct {
 		TaskID      string
 		WorkID      string
 		BranchName  string
 		BaseBranch  string
 		RootIssueID string
+		CIFailures  string
 	}{
 		TaskID:      taskID,
 		WorkID:      workID,
 		BranchName:  branchName,
 		BaseBranch:  baseBranch,
 		RootIssueID: rootIssueID,
+		CIFailures:  ciFailures,
 	}
 
 	var buf bytes.Buffer
@@ -207,17 +219,65 @@ func BuildLogAnalysisPrompt(params LogAnalysisParams) string {
 	return buf.String()
 }
 
+// CustomTaskParams contains the work context a custom task type's prompt
+// template is rendered with.
+type CustomTaskParams struct {
+	TaskID       string
+	TaskType     string
+	WorkID       string
+	WorkName     string
+	BranchName   string
+	BaseBranch   string
+	RootIssueID  string
+	PRURL        string
+	WorktreePath string
+	ArtifactDir  string // Where the task should write files it produces
+	BeadIDs      []string
+	Beads        []beads.Bead
+}
+
+// LoadCustomTaskTemplate reads and parses the prompt template for a custom task type.
+// Relative paths are resolved against the project root.
+func LoadCustomTaskTemplate(projectRoot, path string) (*template.Template, error) {
+	if path == "" {
+		return nil, fmt.Errorf("no prompt template configured")
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(projectRoot, path)
+	}
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read prompt template: %w", err)
+	}
+	tmpl, err := template.New(filepath.Base(path)).Parse(string(text))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse prompt template %s: %w", path, err)
+	}
+	return tmpl, nil
+}
+
+// BuildCustomTaskPrompt renders a custom task type's prompt template.
+// Unlike the built-in prompts there is no fallback: a template that doesn't
+// render is a configuration error the user needs to see.
+func BuildCustomTaskPrompt(tmpl *template.Template, params CustomTaskParams) (string, error) {
+	if params.BeadIDs == nil {
+		params.BeadIDs = getBeadIDs(params.Beads)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, params); err != nil {
+		return "", fmt.Errorf("failed to render prompt template: %w", err)
+	}
+	return buf.String(), nil
+}
+
 // RunPlanSession runs an interactive Claude session for planning an issue.
 // This launches Claude with the plan prompt and connects stdin/stdout/stderr
 // for interactive use. The config parameter controls Claude settings like --dangerously-skip-permissions.
-func RunPlanSession(ctx context.Context, beadID string, workDir string, stdin io.Reader, stdout, stderr io.Writer, cfg *project.Config) error {
-	prompt := BuildPlanPrompt(beadID)
-
-	var args []string
-	if cfg != nil && cfg.Claude.ShouldSkipPermissions() {
-		args = append(args, "--dangerously-skip-permissions")
-	}
-	args = append(args, prompt)
+// The conversation is started with the given Claude session ID so it can be
+// resumed later; with resume set, that earlier conversation is resumed instead
+// of sending the plan prompt again.
+func RunPlanSession(ctx context.Context, beadID, sessionID string, resume bool, workDir string, stdin io.Reader, stdout, stderr io.Writer, cfg *project.Config) error {
+	args := planSessionArgs(beadID, sessionID, resume, cfg)
 
 	cmd := exec.CommandContext(ctx, "claude", args...)
 	cmd.Dir = workDir
@@ -231,3 +291,18 @@ func RunPlanSession(ctx context.Context, beadID string, workDir string, stdin io
 
 	return nil
 }
+
+// planSessionArgs returns the claude arguments for a plan session.
+func planSessionArgs(beadID, sessionID string, resume bool, cfg *project.Config) []string {
+	var args []string
+	if cfg != nil && cfg.Claude.ShouldSkipPermissions() {
+		args = append(args, "--dangerously-skip-permissions")
+	}
+	if resume {
+		return append(args, "--resume", sessionID)
+	}
+	if sessionID != "" {
+		args = append(args, "--session-id", sessionID)
+	}
+	return append(args, BuildPlanPrompt(beadID))
+}
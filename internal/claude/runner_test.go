@@ -1,9 +1,14 @@
 package claude
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/project"
 	"github.com/stretchr/testify/require"
 )
 
@@ -181,3 +186,77 @@ func TestBuildLogAnalysisPromptBdCreateCommand(t *testing.T) {
 	// Check that it includes priority option
 	require.Contains(t, result, "--priority", "BuildLogAnalysisPrompt() missing --priority flag")
 }
+
+func TestBuildReviewPromptCIFailures(t *testing.T) {
+	prompt := BuildReviewPrompt("w-abc.3", "w-abc", "feat/x", "main", "", "")
+	require.NotContains(t, prompt, "CI checks are failing")
+
+	prompt = BuildReviewPrompt("w-abc.3", "w-abc", "feat/x", "main", "", "### CI / test\n--- FAIL: TestParse")
+	require.Contains(t, prompt, "CI checks are failing on this work's PR")
+	require.Contains(t, prompt, "### CI / test\n--- FAIL: TestParse")
+	require.Contains(t, prompt, "co complete w-abc.3")
+}
+
+func TestBuildCustomTaskPrompt(t *testing.T) {
+	root := t.TempDir()
+	tmplText := "Task {{.TaskID}} ({{.TaskType}}) on {{.BranchName}}\n{{range .BeadIDs}}- {{.}}\n{{end}}"
+	require.NoError(t, os.WriteFile(filepath.Join(root, "security.tmpl"), []byte(tmplText), 0644))
+
+	tmpl, err := LoadCustomTaskTemplate(root, "security.tmpl")
+	require.NoError(t, err)
+
+	prompt, err := BuildCustomTaskPrompt(tmpl, CustomTaskParams{
+		TaskID:     "w-abc.3",
+		TaskType:   "security-audit",
+		BranchName: "feat/abc",
+		Beads:      []beads.Bead{{ID: "bead-1"}, {ID: "bead-2"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Task w-abc.3 (security-audit) on feat/abc\n- bead-1\n- bead-2\n", prompt)
+}
+
+func TestLoadCustomTaskTemplateErrors(t *testing.T) {
+	root := t.TempDir()
+
+	_, err := LoadCustomTaskTemplate(root, "")
+	require.Error(t, err)
+
+	_, err = LoadCustomTaskTemplate(root, "missing.tmpl")
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, "bad.tmpl"), []byte("{{.TaskID"), 0644))
+	_, err = LoadCustomTaskTemplate(root, "bad.tmpl")
+	require.Error(t, err)
+
+	// Unknown fields only fail when rendering
+	require.NoError(t, os.WriteFile(filepath.Join(root, "typo.tmpl"), []byte("{{.TaskId}}"), 0644))
+	tmpl, err := LoadCustomTaskTemplate(root, filepath.Join(root, "typo.tmpl"))
+	require.NoError(t, err)
+	_, err = BuildCustomTaskPrompt(tmpl, CustomTaskParams{})
+	require.Error(t, err)
+}
+
+func TestPlanSessionArgs(t *testing.T) {
+	prompt := BuildPlanPrompt("bead-1")
+	skip := false
+	cfg := &project.Config{Claude: project.ClaudeConfig{SkipPermissions: &skip}}
+
+	require.Equal(t, []string{"--session-id", "abc", prompt}, planSessionArgs("bead-1", "abc", false, cfg))
+	require.Equal(t, []string{"--resume", "abc"}, planSessionArgs("bead-1", "abc", true, cfg), "a resumed conversation isn't sent the prompt again")
+	require.Equal(t, []string{"--dangerously-skip-permissions", "--resume", "abc"}, planSessionArgs("bead-1", "abc", true, &project.Config{}))
+}
+
+func TestTaskModelAndRunSettings(t *testing.T) {
+	cfg := &project.Config{
+		Claude:    project.ClaudeConfig{Model: "opus", TimeLimitMinutes: 30},
+		LogParser: project.LogParserConfig{Model: "sonnet"},
+	}
+	require.Equal(t, "opus", taskModel(&db.Task{TaskType: db.TaskTypeImplement}, cfg))
+	require.Equal(t, "sonnet", taskModel(&db.Task{TaskType: db.TaskTypeLogAnalysis}, cfg))
+	require.Empty(t, taskModel(&db.Task{TaskType: db.TaskTypeImplement}, nil))
+
+	t.Setenv("MAX_THINKING_TOKENS", "16000")
+	require.Equal(t, "max_thinking_tokens=16000, time_limit=30m", runSettings(cfg))
+	t.Setenv("MAX_THINKING_TOKENS", "")
+	require.Empty(t, runSettings(&project.Config{}))
+}
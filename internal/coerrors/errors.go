@@ -0,0 +1,71 @@
+// Package coerrors defines the error categories co's services return so
+// callers like the TUI can react to a failure without parsing its message.
+package coerrors
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kind is the category of an error. A Kind is itself an error so it can be
+// matched with errors.Is(err, coerrors.NotFound).
+type Kind string
+
+const (
+	// NotFound means the work, task or bead no longer exists
+	NotFound Kind = "not found"
+	// Conflict means the request clashes with the current state, e.g. a bead
+	// that is already assigned to a task
+	Conflict Kind = "conflict"
+	// ExternalTool means git, zellij, bd or another external command failed
+	ExternalTool Kind = "external tool"
+	// Validation means the input was rejected before anything was changed
+	Validation Kind = "validation"
+)
+
+func (k Kind) Error() string {
+	return string(k)
+}
+
+// Error is an error tagged with its Kind
+type Error struct {
+	Kind Kind
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is this error's Kind
+func (e *Error) Is(target error) bool {
+	kind, ok := target.(Kind)
+	return ok && kind == e.Kind
+}
+
+// Errorf formats an error like fmt.Errorf and tags it with kind
+func Errorf(kind Kind, format string, args ...any) error {
+	return &Error{Kind: kind, Err: fmt.Errorf(format, args...)}
+}
+
+// Wrap tags err with kind, keeping its message. It returns nil for a nil err.
+func Wrap(kind Kind, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Kind: kind, Err: err}
+}
+
+// KindOf returns the Kind of the outermost tagged error in err's chain, or ""
+// if there is none
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return ""
+}
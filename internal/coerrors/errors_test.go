@@ -0,0 +1,45 @@
+package coerrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorfMatchesKind(t *testing.T) {
+	err := Errorf(NotFound, "work %s not found", "w-abc")
+	require.EqualError(t, err, "work w-abc not found")
+	require.ErrorIs(t, err, NotFound)
+	require.NotErrorIs(t, err, Conflict)
+
+	wrapped := fmt.Errorf("failed to get work: %w", err)
+	require.ErrorIs(t, wrapped, NotFound)
+	require.Equal(t, NotFound, KindOf(wrapped))
+
+	var e *Error
+	require.ErrorAs(t, wrapped, &e)
+	require.Equal(t, NotFound, e.Kind)
+}
+
+func TestWrapKeepsCause(t *testing.T) {
+	cause := errors.New("exit status 128")
+	err := Wrap(ExternalTool, fmt.Errorf("failed to remove worktree: %w", cause))
+	require.EqualError(t, err, "failed to remove worktree: exit status 128")
+	require.ErrorIs(t, err, ExternalTool)
+	require.ErrorIs(t, err, cause)
+
+	require.NoError(t, Wrap(ExternalTool, nil))
+}
+
+func TestKindOf(t *testing.T) {
+	require.Equal(t, Kind(""), KindOf(nil))
+	require.Equal(t, Kind(""), KindOf(errors.New("plain")))
+	require.Equal(t, Validation, KindOf(Errorf(Validation, "no beads specified")))
+
+	// The outermost kind wins
+	inner := Errorf(NotFound, "bead b-1 not found")
+	require.Equal(t, ExternalTool, KindOf(Wrap(ExternalTool, inner)))
+	require.ErrorIs(t, Wrap(ExternalTool, inner), NotFound)
+}
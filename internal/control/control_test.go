@@ -14,6 +14,7 @@ import (
 	"github.com/newhook/co/internal/github"
 	"github.com/newhook/co/internal/mise"
 	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/remote"
 	"github.com/newhook/co/internal/worktree"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -60,6 +61,7 @@ type testMocks struct {
 	Spawner   *OrchestratorSpawnerMock
 	Destroyer *WorkDestroyerMock
 	GitHub    *github.GitHubClientMock
+	Remote    *remote.RemoteOperationsMock
 }
 
 // setupControlPlane creates a ControlPlane with all mocked dependencies.
@@ -71,6 +73,7 @@ func setupControlPlane() *testMocks {
 	spawnerMock := &OrchestratorSpawnerMock{}
 	destroyerMock := &WorkDestroyerMock{}
 	githubMock := &github.GitHubClientMock{}
+	remoteMock := &remote.RemoteOperationsMock{}
 
 	cp := control.NewControlPlaneWithDeps(
 		gitMock,
@@ -81,6 +84,7 @@ func setupControlPlane() *testMocks {
 		spawnerMock,
 		destroyerMock,
 		githubMock,
+		remoteMock,
 	)
 
 	return &testMocks{
@@ -91,11 +95,12 @@ func setupControlPlane() *testMocks {
 		Spawner:   spawnerMock,
 		Destroyer: destroyerMock,
 		GitHub:    githubMock,
+		Remote:    remoteMock,
 	}
 }
 
 // createTestWork creates a work record for testing with minimal required fields.
-func createTestWork(ctx context.Context, t *testing.T, database *db.DB, workID, branchName, rootIssueID string) {
+func createTestWork(ctx context.Context, t *testing.T, database db.Store, workID, branchName, rootIssueID string) {
 	t.Helper()
 	err := database.CreateWork(ctx, workID, workID, "", branchName, "main", rootIssueID, false)
 	require.NoError(t, err)
@@ -246,6 +251,26 @@ func TestHandleSpawnOrchestratorTask(t *testing.T) {
 		assert.Equal(t, "test-worker", calls[0].FriendlyName)
 	})
 
+	t.Run("runs a remote work's orchestrator from the project root", func(t *testing.T) {
+		mocks := setupControlPlane()
+
+		createTestWork(ctx, t, proj.DB, "w-spawn-remote", "spawn-remote-branch", "root-1")
+		require.NoError(t, proj.DB.SetWorkRemoteHost(ctx, "w-spawn-remote", "desktop"))
+		require.NoError(t, proj.DB.UpdateWorkWorktreePath(ctx, "w-spawn-remote", "~/co/test-project/w-spawn-remote/tree"))
+		defer proj.DB.DeleteWork(ctx, "w-spawn-remote")
+
+		task := &db.ScheduledTask{
+			ID:       "spawn-task-remote",
+			WorkID:   "w-spawn-remote",
+			TaskType: db.TaskTypeSpawnOrchestrator,
+		}
+		require.NoError(t, mocks.CP.HandleSpawnOrchestratorTask(ctx, proj, task))
+
+		calls := mocks.Spawner.SpawnWorkOrchestratorCalls()
+		require.Len(t, calls, 1)
+		assert.Equal(t, proj.Root, calls[0].WorkDir)
+	})
+
 	t.Run("succeeds when work deleted", func(t *testing.T) {
 		mocks := setupControlPlane()
 
@@ -387,7 +412,7 @@ func TestHandlePRFeedbackTask(t *testing.T) {
 	t.Run("processes feedback when PR exists", func(t *testing.T) {
 		mocks := setupControlPlane()
 
-		mocks.Feedback.ProcessPRFeedbackFunc = func(ctx context.Context, proj *project.Project, database *db.DB, workID string) (int, error) {
+		mocks.Feedback.ProcessPRFeedbackFunc = func(ctx context.Context, proj *project.Project, database db.Store, workID string) (int, error) {
 			return 3, nil // Created 3 beads
 		}
 
@@ -440,7 +465,7 @@ func TestHandlePRFeedbackTask(t *testing.T) {
 	t.Run("returns error when feedback processing fails", func(t *testing.T) {
 		mocks := setupControlPlane()
 
-		mocks.Feedback.ProcessPRFeedbackFunc = func(ctx context.Context, proj *project.Project, database *db.DB, workID string) (int, error) {
+		mocks.Feedback.ProcessPRFeedbackFunc = func(ctx context.Context, proj *project.Project, database db.Store, workID string) (int, error) {
 			return 0, errors.New("GitHub API error")
 		}
 
@@ -500,6 +525,7 @@ func TestNewControlPlane(t *testing.T) {
 	assert.NotNil(t, cp.FeedbackProcessor)
 	assert.NotNil(t, cp.OrchestratorSpawner)
 	assert.NotNil(t, cp.WorkDestroyer)
+	assert.NotNil(t, cp.Remote)
 }
 
 func TestDefaultOrchestratorSpawner(t *testing.T) {
@@ -593,6 +619,57 @@ func TestHandleCreateWorktreeTask(t *testing.T) {
 		// Should not try to create worktree since it already exists
 		assert.Len(t, mocks.Worktree.CreateCalls(), 0)
 	})
+
+	t.Run("creates a remote work's worktree on its host", func(t *testing.T) {
+		mocks := setupControlPlane()
+		mocks.Git.OriginURLFunc = func(ctx context.Context, repoPath string) (string, error) {
+			return "git@github.com:user/repo.git", nil
+		}
+		mocks.Remote.ProvisionWorktreeFunc = func(ctx context.Context, target remote.Target, spec remote.WorktreeSpec) (string, error) {
+			return target.WorktreePath(spec.WorkID), nil
+		}
+
+		createTestWork(ctx, t, proj.DB, "w-remote", "remote-branch", "root-1")
+		require.NoError(t, proj.DB.SetWorkRemoteHost(ctx, "w-remote", "desktop"))
+		defer proj.DB.DeleteWork(ctx, "w-remote")
+
+		task := &db.ScheduledTask{
+			ID:       "create-task-remote",
+			WorkID:   "w-remote",
+			TaskType: db.TaskTypeCreateWorktree,
+			Metadata: map[string]string{
+				"branch":      "remote-branch",
+				"base_branch": "main",
+			},
+		}
+		require.NoError(t, mocks.CP.HandleCreateWorktreeTask(ctx, proj, task))
+
+		calls := mocks.Remote.ProvisionWorktreeCalls()
+		require.Len(t, calls, 1)
+		assert.Equal(t, remote.Target{Host: "desktop", Root: "~/co/test-project"}, calls[0].Target)
+		assert.Equal(t, remote.WorktreeSpec{
+			WorkID:     "w-remote",
+			Branch:     "remote-branch",
+			BaseBranch: "main",
+			OriginURL:  "git@github.com:user/repo.git",
+			Hooks:      worktree.Hooks(worktree.SetupOptions{CommitHook: true, ProtectManaged: true}),
+		}, calls[0].Spec, "the worktree gets the same hooks as a local one")
+
+		// Nothing is created or pushed on this machine
+		assert.Empty(t, mocks.Worktree.CreateCalls())
+		assert.Empty(t, mocks.Git.PushSetUpstreamCalls())
+
+		work, err := proj.DB.GetWork(ctx, "w-remote")
+		require.NoError(t, err)
+		assert.Equal(t, "~/co/test-project/w-remote/tree", work.WorktreePath)
+
+		// The orchestrator spawn follows, as for a local work
+		next, err := proj.DB.GetNextScheduledTask(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, next)
+		assert.Equal(t, db.TaskTypeSpawnOrchestrator, next.TaskType)
+		assert.Equal(t, "w-remote", next.WorkID)
+	})
 }
 
 func TestScheduleDestroyWorktree(t *testing.T) {
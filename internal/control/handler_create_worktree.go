@@ -11,6 +11,8 @@ import (
 	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/logging"
 	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/remote"
+	"github.com/newhook/co/internal/worktree"
 )
 
 // HandleCreateWorktreeTask handles a scheduled worktree creation task
@@ -43,6 +45,14 @@ func (cp *ControlPlane) HandleCreateWorktreeTask(ctx context.Context, proj *proj
 		return nil
 	}
 
+	if work.IsRemote() {
+		if err := cp.provisionRemoteWorktree(ctx, proj, work, baseBranch, useExisting); err != nil {
+			return err
+		}
+		scheduleOrchestratorSpawn(ctx, proj, workID, workerName)
+		return nil
+	}
+
 	mainRepoPath := proj.MainRepoPath()
 	var branchExistsOnRemote bool
 
@@ -98,6 +108,10 @@ func (cp *ControlPlane) HandleCreateWorktreeTask(ctx context.Context, proj *proj
 			// Non-fatal, continue
 		}
 
+		// Copy untracked files and run the post-create hook, if configured.
+		// Failures are recorded on the work for the user rather than failing it.
+		setupWorktree(ctx, proj, workID, worktreePath)
+
 		// Update work with worktree path
 		if err := proj.DB.UpdateWorkWorktreePath(ctx, workID, worktreePath); err != nil {
 			return fmt.Errorf("failed to update work worktree path: %w", err)
@@ -118,13 +132,83 @@ func (cp *ControlPlane) HandleCreateWorktreeTask(ctx context.Context, proj *proj
 
 	logging.Info("Worktree created and pushed successfully", "work_id", workID)
 
-	// Schedule orchestrator spawn task
-	_, err = proj.DB.ScheduleTask(ctx, workID, db.TaskTypeSpawnOrchestrator, time.Now(), map[string]string{
+	scheduleOrchestratorSpawn(ctx, proj, workID, workerName)
+
+	return nil
+}
+
+// scheduleOrchestratorSpawn schedules the orchestrator spawn task that follows
+// a worktree's creation
+func scheduleOrchestratorSpawn(ctx context.Context, proj *project.Project, workID, workerName string) {
+	_, err := proj.DB.ScheduleTask(ctx, workID, db.TaskTypeSpawnOrchestrator, time.Now(), map[string]string{
 		"worker_name": workerName,
 	})
 	if err != nil {
 		logging.Warn("failed to schedule orchestrator spawn", "error", err, "work_id", workID)
 	}
+}
+
+// provisionRemoteWorktree creates a remote work's worktree on its host, which
+// also pushes a new branch. The worktree gets the same protection of the
+// co-managed paths and commit trailer hook as a local one; mise and the rest
+// of the [worktree] setup apply only to local worktrees.
+func (cp *ControlPlane) provisionRemoteWorktree(ctx context.Context, proj *project.Project, work *db.Work, baseBranch string, useExisting bool) error {
+	if work.WorktreePath != "" {
+		logging.Info("Remote worktree already exists, skipping creation", "work_id", work.ID, "path", work.WorktreePath)
+		return nil
+	}
 
+	// The host clones from the same origin when it has no clone yet
+	originURL, err := cp.Git.OriginURL(ctx, proj.MainRepoPath())
+	if err != nil {
+		return err
+	}
+	target := remote.TargetFor(proj.Config)
+	target.Host = work.RemoteHost
+	worktreePath, err := cp.Remote.ProvisionWorktree(ctx, target, remote.WorktreeSpec{
+		WorkID:      work.ID,
+		Branch:      work.BranchName,
+		BaseBranch:  baseBranch,
+		OriginURL:   originURL,
+		UseExisting: useExisting,
+		Hooks: worktree.Hooks(worktree.SetupOptions{
+			CommitHook:     proj.Config.Git.GetCommitTrailer(),
+			ProtectManaged: true,
+		}),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := proj.DB.UpdateWorkWorktreePath(ctx, work.ID, worktreePath); err != nil {
+		return fmt.Errorf("failed to update work worktree path: %w", err)
+	}
+	logging.Info("Remote worktree created", "work_id", work.ID, "host", target.Host, "path", worktreePath)
 	return nil
 }
+
+// setupWorktree applies the [worktree] config to a new worktree and stores
+// any warnings on the work.
+func setupWorktree(ctx context.Context, proj *project.Project, workID, worktreePath string) {
+	cfg := proj.Config.Worktree
+	commitHook := proj.Config.Git.GetCommitTrailer()
+	result := worktree.Setup(ctx, proj.MainRepoPath(), worktreePath, worktree.SetupOptions{
+		CopyFiles:      cfg.CopyFiles,
+		PostCreate:     cfg.PostCreate,
+		Env:            proj.Config.Hooks.Env,
+		CommitHook:     commitHook,
+		ProtectManaged: true,
+	})
+	if len(result.Copied) > 0 {
+		logging.Info("Copied files into worktree", "work_id", workID, "files", result.Copied)
+	}
+	if result.Output != "" {
+		logging.Info("post_create output", "work_id", workID, "output", result.Output)
+	}
+	for _, warning := range result.Warnings {
+		logging.Warn("worktree setup problem", "work_id", workID, "warning", warning)
+	}
+	if err := proj.DB.SetWorkSetupWarnings(ctx, workID, result.Warnings); err != nil {
+		logging.Warn("failed to store worktree setup warnings", "error", err, "work_id", workID)
+	}
+}
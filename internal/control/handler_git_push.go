@@ -17,6 +17,7 @@ func (cp *ControlPlane) HandleGitPushTask(ctx context.Context, proj *project.Pro
 	branch := task.Metadata["branch"]
 	dir := task.Metadata["dir"]
 
+	var remoteHost string
 	if branch == "" {
 		// Try to get from work
 		work, err := proj.DB.GetWork(ctx, workID)
@@ -25,15 +26,21 @@ func (cp *ControlPlane) HandleGitPushTask(ctx context.Context, proj *project.Pro
 		}
 		branch = work.BranchName
 		dir = work.WorktreePath
+		// A remote work's branch is pushed from its worktree on the host
+		remoteHost = work.RemoteHost
 	}
 
 	if branch == "" || dir == "" {
 		return fmt.Errorf("git push task missing branch or dir metadata")
 	}
 
-	logging.Info("Executing git push", "branch", branch, "dir", dir, "attempt", task.AttemptCount+1)
+	logging.Info("Executing git push", "branch", branch, "dir", dir, "host", remoteHost, "attempt", task.AttemptCount+1)
 
-	if err := cp.Git.PushSetUpstream(ctx, branch, dir); err != nil {
+	if remoteHost != "" {
+		if err := cp.Remote.PushSetUpstream(ctx, remoteHost, dir, branch); err != nil {
+			return err
+		}
+	} else if err := cp.Git.PushSetUpstream(ctx, branch, dir); err != nil {
 		return err
 	}
 
@@ -35,7 +35,7 @@ func (cp *ControlPlane) HandleSpawnOrchestratorTask(ctx context.Context, proj *p
 	}
 
 	// Spawn the orchestrator
-	if err := cp.OrchestratorSpawner.SpawnWorkOrchestrator(ctx, workID, proj.Config.Project.Name, work.WorktreePath, workerName, io.Discard); err != nil {
+	if err := cp.OrchestratorSpawner.SpawnWorkOrchestrator(ctx, workID, proj.Config.Project.Name, work.SessionDir(proj.Root), workerName, io.Discard); err != nil {
 		return fmt.Errorf("failed to spawn orchestrator: %w", err)
 	}
 
@@ -3,6 +3,7 @@ package control
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/newhook/co/internal/procmon"
 	"github.com/newhook/co/internal/project"
 	trackingwatcher "github.com/newhook/co/internal/tracking/watcher"
+	"github.com/newhook/co/internal/work"
 )
 
 // RunControlPlaneLoop runs the main control plane event loop with default dependencies.
@@ -55,6 +57,20 @@ func RunControlPlaneLoopWithControlPlane(ctx context.Context, proj *project.Proj
 	checkTimer := time.NewTimer(checkInterval)
 	defer checkTimer.Stop()
 
+	// Scheduled runs (co run --at) are started from the periodic check and on
+	// database changes, so a run that came due while asleep starts on wake
+	workSvc := work.NewWorkService(proj)
+	stagger := proj.Config.Scheduler.GetScheduledRunStagger()
+	var lastScheduledRun time.Time
+	startScheduledRuns := func() {
+		var err error
+		lastScheduledRun, err = workSvc.StartDueScheduledRuns(ctx, time.Now(), lastScheduledRun, stagger, os.Stdout)
+		if err != nil {
+			logging.Warn("failed to start scheduled runs", "error", err)
+		}
+	}
+	startScheduledRuns()
+
 	// Set up periodic cleanup timer for stale processes
 	cleanupInterval := 60 * time.Second
 	cleanupTimer := time.NewTimer(cleanupInterval)
@@ -77,12 +93,14 @@ func RunControlPlaneLoopWithControlPlane(ctx context.Context, proj *project.Proj
 			if event.Payload.Type == trackingwatcher.DBChanged {
 				logging.Debug("Database changed, checking scheduled tasks")
 				ProcessAllDueTasksWithControlPlane(ctx, proj, cp)
+				startScheduledRuns()
 			}
 
 		case <-checkTimer.C:
 			// Periodic check as a safety net
 			logging.Debug("Control plane periodic check")
 			ProcessAllDueTasksWithControlPlane(ctx, proj, cp)
+			startScheduledRuns()
 			checkTimer.Reset(checkInterval)
 
 		case <-cleanupTimer.C:
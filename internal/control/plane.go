@@ -12,6 +12,7 @@ import (
 	"github.com/newhook/co/internal/github"
 	"github.com/newhook/co/internal/mise"
 	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/remote"
 	"github.com/newhook/co/internal/work"
 	"github.com/newhook/co/internal/worktree"
 	"github.com/newhook/co/internal/zellij"
@@ -35,7 +36,7 @@ type DefaultOrchestratorSpawner struct {
 }
 
 // NewOrchestratorSpawner creates a new DefaultOrchestratorSpawner with the given database.
-func NewOrchestratorSpawner(database *db.DB) *DefaultOrchestratorSpawner {
+func NewOrchestratorSpawner(database db.Store) *DefaultOrchestratorSpawner {
 	return &DefaultOrchestratorSpawner{
 		orchestratorManager: work.NewOrchestratorManager(database),
 	}
@@ -74,6 +75,7 @@ type ControlPlane struct {
 	OrchestratorSpawner OrchestratorSpawner
 	WorkDestroyer       WorkDestroyer
 	GitHubClient        github.ClientInterface
+	Remote              remote.Operations
 }
 
 // NewControlPlane creates a new ControlPlane with default production dependencies.
@@ -87,6 +89,7 @@ func NewControlPlane(proj *project.Project) *ControlPlane {
 		OrchestratorSpawner: NewOrchestratorSpawner(proj.DB),
 		WorkDestroyer:       NewWorkDestroyer(proj),
 		GitHubClient:        github.NewClient(),
+		Remote:              remote.NewOperations(),
 	}
 }
 
@@ -100,6 +103,7 @@ func NewControlPlaneWithDeps(
 	orchestratorSpawner OrchestratorSpawner,
 	workDestroyer WorkDestroyer,
 	githubClient github.ClientInterface,
+	remoteOps remote.Operations,
 ) *ControlPlane {
 	return &ControlPlane{
 		Git:                 gitOps,
@@ -110,6 +114,7 @@ func NewControlPlaneWithDeps(
 		OrchestratorSpawner: orchestratorSpawner,
 		WorkDestroyer:       workDestroyer,
 		GitHubClient:        githubClient,
+		Remote:              remoteOps,
 	}
 }
 
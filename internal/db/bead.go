@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/newhook/co/internal/coerrors"
 	"github.com/newhook/co/internal/db/sqlc"
 )
 
@@ -93,7 +94,7 @@ func (db *DB) CompleteBead(ctx context.Context, id, prURL string) error {
 		return fmt.Errorf("failed to complete bead %s: %w", id, err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("bead %s not found", id)
+		return coerrors.Errorf(coerrors.NotFound, "bead %s not found", id)
 	}
 	return nil
 }
@@ -111,7 +112,7 @@ func (db *DB) FailBead(ctx context.Context, id, errMsg string) error {
 		return fmt.Errorf("failed to mark bead %s as failed: %w", id, err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("bead %s not found", id)
+		return coerrors.Errorf(coerrors.NotFound, "bead %s not found", id)
 	}
 	return nil
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/newhook/co/internal/db/sqlc"
 )
@@ -55,6 +57,58 @@ func (db *DB) GetAllCachedComplexity(ctx context.Context) (map[string]struct{ Sc
 	return result, nil
 }
 
+// ComplexityEstimate is a bead's cached complexity estimate, along with the
+// hash of the title and description it was made for.
+type ComplexityEstimate struct {
+	Score           int
+	Tokens          int
+	DescriptionHash string
+	EstimatedAt     time.Time
+}
+
+// Stale reports whether the bead changed since it was estimated, so the
+// estimate no longer describes it.
+func (e ComplexityEstimate) Stale(title, description string) bool {
+	return e.DescriptionHash != HashBead(title, description)
+}
+
+// ListComplexityEstimates returns every cached estimate by bead ID, whether
+// or not it still matches its bead.
+func (db *DB) ListComplexityEstimates(ctx context.Context) (map[string]ComplexityEstimate, error) {
+	rows, err := db.queries.ListComplexityEstimates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list complexity estimates: %w", err)
+	}
+	estimates := make(map[string]ComplexityEstimate, len(rows))
+	for _, row := range rows {
+		estimates[row.BeadID] = ComplexityEstimate{
+			Score:           int(row.ComplexityScore),
+			Tokens:          int(row.EstimatedTokens),
+			DescriptionHash: row.DescriptionHash,
+			EstimatedAt:     row.CreatedAt,
+		}
+	}
+	return estimates, nil
+}
+
+// ValidateComplexity checks an estimate is within the ranges estimates are
+// made in: a score of 1-10 and 5K-150K tokens (the context window is 200K).
+func ValidateComplexity(score, tokens int) error {
+	if score < 1 || score > 10 {
+		return fmt.Errorf("score must be between 1 and 10, got %d", score)
+	}
+	if tokens < 5000 || tokens > 150000 {
+		return fmt.Errorf("tokens must be between 5000 and 150000, got %d", tokens)
+	}
+	return nil
+}
+
+// HashBead returns the hash estimates of a bead are cached under: its title
+// and description, which are what its complexity depends on.
+func HashBead(title, description string) string {
+	return HashDescription(title + "\n" + description)
+}
+
 // HashDescription creates a SHA256 hash of a description string.
 func HashDescription(description string) string {
 	h := sha256.Sum256([]byte(description))
@@ -74,3 +128,155 @@ func (db *DB) AreAllBeadsEstimated(ctx context.Context, beadIDs []string) (bool,
 
 	return int(count) == len(beadIDs), nil
 }
+
+// ComplexityHistogramBuckets is the number of actual/budget histogram buckets.
+// Bucket n counts tasks that used n*25% up to (n+1)*25% of their budget; the
+// last bucket collects everything at 200% or more.
+const ComplexityHistogramBuckets = 9
+
+// maxComplexityOverruns caps the overrun list kept per work and for the project.
+const maxComplexityOverruns = 5
+
+// ComplexityTypeStats totals budget and actual complexity for one task type.
+type ComplexityTypeStats struct {
+	TaskType    string
+	TaskCount   int
+	TotalBudget int
+	TotalActual int
+}
+
+// AverageBudget returns the mean budget per task.
+func (s ComplexityTypeStats) AverageBudget() int {
+	if s.TaskCount == 0 {
+		return 0
+	}
+	return s.TotalBudget / s.TaskCount
+}
+
+// AverageActual returns the mean actual complexity per task.
+func (s ComplexityTypeStats) AverageActual() int {
+	if s.TaskCount == 0 {
+		return 0
+	}
+	return s.TotalActual / s.TaskCount
+}
+
+// ComplexityOverrun is a task that used more than its complexity budget.
+type ComplexityOverrun struct {
+	TaskID   string
+	WorkID   string
+	TaskType string
+	Budget   int
+	Actual   int
+}
+
+// Overrun returns how far the task went over budget.
+func (o ComplexityOverrun) Overrun() int {
+	return o.Actual - o.Budget
+}
+
+// ComplexityStats summarizes budget against actual complexity for a set of
+// tasks. Only tasks with both a budget and a recorded actual are counted.
+type ComplexityStats struct {
+	TaskCount   int
+	TotalBudget int
+	TotalActual int
+	ByType      []ComplexityTypeStats // sorted by task type
+	TopOverruns []ComplexityOverrun   // largest overrun first
+	Histogram   [ComplexityHistogramBuckets]int
+}
+
+// ComplexityReport holds complexity stats for the whole project and per work.
+type ComplexityReport struct {
+	Project ComplexityStats
+	Works   map[string]*ComplexityStats
+}
+
+// GetComplexityStats aggregates complexity budget against actual usage for
+// every work and for the project as a whole.
+func (db *DB) GetComplexityStats(ctx context.Context) (*ComplexityReport, error) {
+	rows, err := db.queries.GetComplexityStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get complexity stats: %w", err)
+	}
+
+	report := &ComplexityReport{Works: make(map[string]*ComplexityStats)}
+	projectTypes := make(map[string]*ComplexityTypeStats)
+	for _, row := range rows {
+		work, ok := report.Works[row.WorkID]
+		if !ok {
+			work = &ComplexityStats{}
+			report.Works[row.WorkID] = work
+		}
+
+		switch row.Kind {
+		case "type":
+			typeStats := ComplexityTypeStats{
+				TaskType:    row.TaskType,
+				TaskCount:   int(row.TaskCount),
+				TotalBudget: int(row.TotalBudget),
+				TotalActual: int(row.TotalActual),
+			}
+			work.addType(typeStats)
+
+			// The project view merges the same task type across works
+			project, ok := projectTypes[row.TaskType]
+			if !ok {
+				project = &ComplexityTypeStats{TaskType: row.TaskType}
+				projectTypes[row.TaskType] = project
+			}
+			project.TaskCount += typeStats.TaskCount
+			project.TotalBudget += typeStats.TotalBudget
+			project.TotalActual += typeStats.TotalActual
+		case "overrun":
+			overrun := ComplexityOverrun{
+				TaskID:   row.TaskID,
+				WorkID:   row.WorkID,
+				TaskType: row.TaskType,
+				Budget:   int(row.TotalBudget),
+				Actual:   int(row.TotalActual),
+			}
+			work.TopOverruns = append(work.TopOverruns, overrun)
+			report.Project.TopOverruns = append(report.Project.TopOverruns, overrun)
+		case "histogram":
+			if row.Bucket >= 0 && row.Bucket < ComplexityHistogramBuckets {
+				work.Histogram[row.Bucket] += int(row.TaskCount)
+				report.Project.Histogram[row.Bucket] += int(row.TaskCount)
+			}
+		}
+	}
+
+	for _, t := range projectTypes {
+		report.Project.addType(*t)
+	}
+
+	report.Project.sort()
+	for _, work := range report.Works {
+		work.sort()
+	}
+	return report, nil
+}
+
+// addType records a task type's totals in s.
+func (s *ComplexityStats) addType(t ComplexityTypeStats) {
+	s.TaskCount += t.TaskCount
+	s.TotalBudget += t.TotalBudget
+	s.TotalActual += t.TotalActual
+	s.ByType = append(s.ByType, t)
+}
+
+// sort orders types by name and overruns by size, keeping the largest overruns.
+func (s *ComplexityStats) sort() {
+	sort.Slice(s.ByType, func(i, j int) bool {
+		return s.ByType[i].TaskType < s.ByType[j].TaskType
+	})
+	sort.Slice(s.TopOverruns, func(i, j int) bool {
+		if s.TopOverruns[i].Overrun() != s.TopOverruns[j].Overrun() {
+			return s.TopOverruns[i].Overrun() > s.TopOverruns[j].Overrun()
+		}
+		return s.TopOverruns[i].TaskID < s.TopOverruns[j].TaskID
+	})
+	if len(s.TopOverruns) > maxComplexityOverruns {
+		s.TopOverruns = s.TopOverruns[:maxComplexityOverruns]
+	}
+}
@@ -0,0 +1,138 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// insertMeasuredTask creates a task with both a budget and a recorded actual complexity.
+func insertMeasuredTask(t *testing.T, db *DB, id, workID, taskType string, budget, actual int) {
+	t.Helper()
+	_, err := db.Exec(`
+		INSERT INTO tasks (id, status, task_type, complexity_budget, actual_complexity, work_id)
+		VALUES (?, 'completed', ?, ?, ?, ?)
+	`, id, taskType, budget, actual, workID)
+	require.NoError(t, err, "failed to insert task %s", id)
+}
+
+func TestGetComplexityStats_Empty(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+	workID := createTestWork(t, db)
+
+	// Tasks without a recorded actual are ignored
+	require.NoError(t, db.CreateTask(ctx, "task-1", "implement", nil, 100, workID))
+
+	report, err := db.GetComplexityStats(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, report.Works)
+	assert.Zero(t, report.Project.TaskCount)
+	assert.Empty(t, report.Project.ByType)
+	assert.Empty(t, report.Project.TopOverruns)
+}
+
+func TestGetComplexityStats(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+	workID := createTestWork(t, db)
+	require.NoError(t, db.CreateWork(ctx, "other-work", "", "/tmp/other", "feat/other", "main", "", false))
+
+	insertMeasuredTask(t, db, "test-work.1", workID, "implement", 100, 50)  // 50% -> bucket 2
+	insertMeasuredTask(t, db, "test-work.2", workID, "implement", 100, 250) // 250% -> bucket 8
+	insertMeasuredTask(t, db, "test-work.3", workID, "review", 40, 60)      // 150% -> bucket 6
+	insertMeasuredTask(t, db, "other-work.1", "other-work", "implement", 200, 210)
+
+	report, err := db.GetComplexityStats(ctx)
+	require.NoError(t, err)
+	require.Len(t, report.Works, 2)
+
+	work := report.Works[workID]
+	require.NotNil(t, work)
+	assert.Equal(t, 3, work.TaskCount)
+	assert.Equal(t, 240, work.TotalBudget)
+	assert.Equal(t, 360, work.TotalActual)
+	require.Len(t, work.ByType, 2)
+	assert.Equal(t, ComplexityTypeStats{TaskType: "implement", TaskCount: 2, TotalBudget: 200, TotalActual: 300}, work.ByType[0])
+	assert.Equal(t, "review", work.ByType[1].TaskType)
+	require.Len(t, work.TopOverruns, 2)
+	assert.Equal(t, "test-work.2", work.TopOverruns[0].TaskID)
+	assert.Equal(t, 150, work.TopOverruns[0].Overrun())
+	assert.Equal(t, "test-work.3", work.TopOverruns[1].TaskID)
+	assert.Equal(t, 1, work.Histogram[2])
+	assert.Equal(t, 1, work.Histogram[6])
+	assert.Equal(t, 1, work.Histogram[8])
+
+	project := report.Project
+	assert.Equal(t, 4, project.TaskCount)
+	assert.Equal(t, 440, project.TotalBudget)
+	assert.Equal(t, 570, project.TotalActual)
+	require.Len(t, project.ByType, 2)
+	assert.Equal(t, "implement", project.ByType[0].TaskType)
+	assert.Equal(t, 3, project.ByType[0].TaskCount)
+	assert.Equal(t, 133, project.ByType[0].AverageBudget())
+	assert.Equal(t, 170, project.ByType[0].AverageActual())
+	require.Len(t, project.TopOverruns, 3)
+	assert.Equal(t, []string{"test-work.2", "test-work.3", "other-work.1"},
+		[]string{project.TopOverruns[0].TaskID, project.TopOverruns[1].TaskID, project.TopOverruns[2].TaskID})
+	assert.Equal(t, 1, project.Histogram[4]) // 105%
+}
+
+func TestGetComplexityStats_TopOverrunsLimited(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+	workID := createTestWork(t, db)
+
+	for i, actual := range []int{110, 170, 120, 160, 130, 150, 140} {
+		insertMeasuredTask(t, db, "test-work."+string(rune('a'+i)), workID, "implement", 100, actual)
+	}
+
+	report, err := db.GetComplexityStats(ctx)
+	require.NoError(t, err)
+
+	work := report.Works[workID]
+	require.NotNil(t, work)
+	assert.Equal(t, 7, work.TaskCount)
+	require.Len(t, work.TopOverruns, 5)
+	var overruns []int
+	for _, o := range work.TopOverruns {
+		overruns = append(overruns, o.Overrun())
+	}
+	assert.Equal(t, []int{70, 60, 50, 40, 30}, overruns)
+	assert.Len(t, report.Project.TopOverruns, 5)
+}
+
+func TestListComplexityEstimates(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	require.NoError(t, db.CacheComplexity(ctx, "bead-1", HashBead("Fix login", "The form"), 4, 20000))
+
+	estimates, err := db.ListComplexityEstimates(ctx)
+	require.NoError(t, err)
+	require.Len(t, estimates, 1)
+	estimate := estimates["bead-1"]
+	assert.Equal(t, 4, estimate.Score)
+	assert.Equal(t, 20000, estimate.Tokens)
+	assert.False(t, estimate.EstimatedAt.IsZero())
+
+	// Editing either the title or the description makes the estimate stale
+	assert.False(t, estimate.Stale("Fix login", "The form"))
+	assert.True(t, estimate.Stale("Fix login", "The form and the API"))
+	assert.True(t, estimate.Stale("Fix signup", "The form"))
+}
+
+func TestValidateComplexity(t *testing.T) {
+	require.NoError(t, ValidateComplexity(1, 5000))
+	require.NoError(t, ValidateComplexity(10, 150000))
+	require.Error(t, ValidateComplexity(0, 20000))
+	require.Error(t, ValidateComplexity(11, 20000))
+	require.Error(t, ValidateComplexity(5, 4999))
+	require.Error(t, ValidateComplexity(5, 150001))
+}
@@ -20,6 +20,17 @@ const (
 	StatusMerged     = "merged"
 )
 
+// Task type constants for the built-in task types. Custom task types from
+// [workflow.task_types] use their configured name.
+const (
+	TaskTypeEstimate            = "estimate"
+	TaskTypeImplement           = "implement"
+	TaskTypeReview              = "review"
+	TaskTypePR                  = "pr"
+	TaskTypeUpdatePRDescription = "update-pr-description"
+	TaskTypeLogAnalysis         = "log_analysis"
+)
+
 // PR state constants
 const (
 	PRStateOpen   = "open"
@@ -41,6 +52,15 @@ const (
 	ApprovalStatusChangesRequested = "changes_requested"
 )
 
+// Failure kinds recorded on failed tasks
+const (
+	FailureRateLimited = "rate_limited" // Claude hit a rate or usage limit; retry later
+	FailureTimeout     = "timeout"      // The task ran past [claude] task_timeout_minutes
+	FailureToolError   = "tool_error"   // Claude couldn't start or exited with an error
+	FailureTaskError   = "task_error"   // Claude reported that the task itself failed
+	FailureCancelled   = "cancelled"    // The run was interrupted
+)
+
 // Mergeable state constants (from GitHub API mergeStateStatus)
 const (
 	MergeableStateClean    = "CLEAN"    // Ready to merge
@@ -383,8 +383,9 @@ func splitSQLStatements(sql string) []string {
 			}
 		}
 
-		// Handle statement separator
-		if !inString && !inLineComment && !inBlockComment && char == ';' {
+		// Handle statement separator. Inside a trigger's BEGIN ... END body
+		// it ends a statement of the body, not the CREATE TRIGGER.
+		if !inString && !inLineComment && !inBlockComment && char == ';' && !inTriggerBody(current.String()) {
 			stmt := strings.TrimSpace(current.String())
 			if stmt != "" {
 				statements = append(statements, stmt)
@@ -404,6 +405,46 @@ func splitSQLStatements(sql string) []string {
 	return statements
 }
 
+// inTriggerBody reports whether stmt is a CREATE TRIGGER whose body hasn't
+// reached its END yet
+func inTriggerBody(stmt string) bool {
+	fields := strings.Fields(strings.ToUpper(stripLeadingComments(stmt)))
+	if len(fields) < 2 || fields[0] != "CREATE" {
+		return false
+	}
+	i := 1
+	if fields[i] == "TEMP" || fields[i] == "TEMPORARY" {
+		i++
+	}
+	if i >= len(fields) || fields[i] != "TRIGGER" {
+		return false
+	}
+	return fields[len(fields)-1] != "END"
+}
+
+// stripLeadingComments drops the comments and whitespace before a statement
+func stripLeadingComments(stmt string) string {
+	for {
+		stmt = strings.TrimSpace(stmt)
+		switch {
+		case strings.HasPrefix(stmt, "--"):
+			i := strings.IndexByte(stmt, '\n')
+			if i < 0 {
+				return ""
+			}
+			stmt = stmt[i+1:]
+		case strings.HasPrefix(stmt, "/*"):
+			i := strings.Index(stmt, "*/")
+			if i < 0 {
+				return ""
+			}
+			stmt = stmt[i+2:]
+		default:
+			return stmt
+		}
+	}
+}
+
 // MigrationStatusContext returns the current migration status
 func MigrationStatusContext(ctx context.Context, db *sql.DB) ([]string, error) {
 	queries := sqlc.New(db)
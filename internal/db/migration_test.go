@@ -191,6 +191,25 @@ func TestSplitSQLStatements(t *testing.T) {
 			)`,
 			},
 		},
+		{
+			name: "Trigger body keeps its semicolons",
+			input: `-- Log changes
+			CREATE TRIGGER log_insert AFTER INSERT ON t1
+			BEGIN
+				INSERT INTO log (id) VALUES (NEW.id);
+				DELETE FROM log WHERE id < NEW.id - 10;
+			END;
+			CREATE TABLE t2 (id INT);`,
+			expected: []string{
+				`-- Log changes
+			CREATE TRIGGER log_insert AFTER INSERT ON t1
+			BEGIN
+				INSERT INTO log (id) VALUES (NEW.id);
+				DELETE FROM log WHERE id < NEW.id - 10;
+			END`,
+				"CREATE TABLE t2 (id INT)",
+			},
+		},
 		{
 			name:     "Empty input",
 			input:    "",
@@ -0,0 +1,7 @@
+-- +up
+-- Add paused flag so a work's orchestrator stops claiming new tasks
+ALTER TABLE works ADD COLUMN paused BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +down
+-- SQLite doesn't support DROP COLUMN directly, but we document the intent
+-- This requires recreating the table without the column
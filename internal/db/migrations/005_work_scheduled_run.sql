@@ -0,0 +1,7 @@
+-- +up
+-- When set, the control plane runs the work once this time has passed
+ALTER TABLE works ADD COLUMN scheduled_run_at DATETIME;
+
+-- +down
+-- SQLite doesn't support DROP COLUMN directly, but we document the intent
+-- This requires recreating the table without the column
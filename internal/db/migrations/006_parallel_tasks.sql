@@ -0,0 +1,10 @@
+-- +up
+-- Orchestrator that claimed a task, so concurrent orchestrators can't run the same task
+ALTER TABLE tasks ADD COLUMN claimed_by TEXT NOT NULL DEFAULT '';
+ALTER TABLE tasks ADD COLUMN claimed_at DATETIME;
+-- Per-work override of [workflow] max_parallel_tasks (0 = use the project setting)
+ALTER TABLE works ADD COLUMN max_parallel_tasks INTEGER NOT NULL DEFAULT 0;
+
+-- +down
+-- SQLite doesn't support DROP COLUMN directly, but we document the intent
+-- This requires recreating the table without the column
@@ -0,0 +1,7 @@
+-- +up
+-- Free-form notes about a work (status, links), kept across sessions
+ALTER TABLE works ADD COLUMN notes TEXT NOT NULL DEFAULT '';
+
+-- +down
+-- SQLite doesn't support DROP COLUMN directly, but we document the intent
+-- This requires recreating the table without the column
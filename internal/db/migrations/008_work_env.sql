@@ -0,0 +1,8 @@
+-- +up
+-- Per-work environment overrides as a JSON array of "KEY=value" strings,
+-- applied over [hooks] env for the work's sessions
+ALTER TABLE works ADD COLUMN env TEXT NOT NULL DEFAULT '';
+
+-- +down
+-- SQLite doesn't support DROP COLUMN directly, but we document the intent
+-- This requires recreating the table without the column
@@ -0,0 +1,7 @@
+-- +up
+-- Per-work override of [workflow] auto_pr: NULL follows the project setting
+ALTER TABLE works ADD COLUMN auto_pr BOOLEAN;
+
+-- +down
+-- SQLite doesn't support DROP COLUMN directly, but we document the intent
+-- This requires recreating the table without the column
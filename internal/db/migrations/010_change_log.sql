@@ -0,0 +1,133 @@
+-- +up
+-- Rows changed by each commit, so the tracking watcher can tell the TUI which
+-- works to reload instead of reloading all of them. Filled in by the triggers
+-- below and trimmed to the last 1000 entries.
+CREATE TABLE change_log (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    entity TEXT NOT NULL,             -- work, task or bead_assignment
+    entity_id TEXT NOT NULL,
+    work_id TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TRIGGER change_log_prune AFTER INSERT ON change_log
+BEGIN
+    DELETE FROM change_log WHERE id <= NEW.id - 1000;
+END;
+
+CREATE TRIGGER works_insert_change_log AFTER INSERT ON works
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('work', NEW.id, NEW.id);
+END;
+CREATE TRIGGER works_update_change_log AFTER UPDATE ON works
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('work', NEW.id, NEW.id);
+END;
+CREATE TRIGGER works_delete_change_log AFTER DELETE ON works
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('work', OLD.id, OLD.id);
+END;
+
+CREATE TRIGGER tasks_insert_change_log AFTER INSERT ON tasks
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('task', NEW.id, NEW.work_id);
+END;
+CREATE TRIGGER tasks_update_change_log AFTER UPDATE ON tasks
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('task', NEW.id, NEW.work_id);
+END;
+CREATE TRIGGER tasks_delete_change_log AFTER DELETE ON tasks
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('task', OLD.id, OLD.work_id);
+END;
+
+CREATE TRIGGER task_dependencies_insert_change_log AFTER INSERT ON task_dependencies
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('task', NEW.task_id, COALESCE((SELECT work_id FROM tasks WHERE id = NEW.task_id), ''));
+END;
+CREATE TRIGGER task_dependencies_update_change_log AFTER UPDATE ON task_dependencies
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('task', NEW.task_id, COALESCE((SELECT work_id FROM tasks WHERE id = NEW.task_id), ''));
+END;
+CREATE TRIGGER task_dependencies_delete_change_log AFTER DELETE ON task_dependencies
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('task', OLD.task_id, COALESCE((SELECT work_id FROM tasks WHERE id = OLD.task_id), ''));
+END;
+
+CREATE TRIGGER task_metadata_insert_change_log AFTER INSERT ON task_metadata
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('task', NEW.task_id, COALESCE((SELECT work_id FROM tasks WHERE id = NEW.task_id), ''));
+END;
+CREATE TRIGGER task_metadata_update_change_log AFTER UPDATE ON task_metadata
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('task', NEW.task_id, COALESCE((SELECT work_id FROM tasks WHERE id = NEW.task_id), ''));
+END;
+CREATE TRIGGER task_metadata_delete_change_log AFTER DELETE ON task_metadata
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('task', OLD.task_id, COALESCE((SELECT work_id FROM tasks WHERE id = OLD.task_id), ''));
+END;
+
+CREATE TRIGGER work_beads_insert_change_log AFTER INSERT ON work_beads
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('bead_assignment', NEW.bead_id, NEW.work_id);
+END;
+CREATE TRIGGER work_beads_update_change_log AFTER UPDATE ON work_beads
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('bead_assignment', NEW.bead_id, NEW.work_id);
+END;
+CREATE TRIGGER work_beads_delete_change_log AFTER DELETE ON work_beads
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('bead_assignment', OLD.bead_id, OLD.work_id);
+END;
+
+CREATE TRIGGER task_beads_insert_change_log AFTER INSERT ON task_beads
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('bead_assignment', NEW.bead_id, COALESCE((SELECT work_id FROM tasks WHERE id = NEW.task_id), ''));
+END;
+CREATE TRIGGER task_beads_update_change_log AFTER UPDATE ON task_beads
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('bead_assignment', NEW.bead_id, COALESCE((SELECT work_id FROM tasks WHERE id = NEW.task_id), ''));
+END;
+CREATE TRIGGER task_beads_delete_change_log AFTER DELETE ON task_beads
+BEGIN
+    INSERT INTO change_log (entity, entity_id, work_id)
+    VALUES ('bead_assignment', OLD.bead_id, COALESCE((SELECT work_id FROM tasks WHERE id = OLD.task_id), ''));
+END;
+
+-- +down
+DROP TRIGGER IF EXISTS works_insert_change_log;
+DROP TRIGGER IF EXISTS works_update_change_log;
+DROP TRIGGER IF EXISTS works_delete_change_log;
+DROP TRIGGER IF EXISTS tasks_insert_change_log;
+DROP TRIGGER IF EXISTS tasks_update_change_log;
+DROP TRIGGER IF EXISTS tasks_delete_change_log;
+DROP TRIGGER IF EXISTS task_dependencies_insert_change_log;
+DROP TRIGGER IF EXISTS task_dependencies_update_change_log;
+DROP TRIGGER IF EXISTS task_dependencies_delete_change_log;
+DROP TRIGGER IF EXISTS task_metadata_insert_change_log;
+DROP TRIGGER IF EXISTS task_metadata_update_change_log;
+DROP TRIGGER IF EXISTS task_metadata_delete_change_log;
+DROP TRIGGER IF EXISTS work_beads_insert_change_log;
+DROP TRIGGER IF EXISTS work_beads_update_change_log;
+DROP TRIGGER IF EXISTS work_beads_delete_change_log;
+DROP TRIGGER IF EXISTS task_beads_insert_change_log;
+DROP TRIGGER IF EXISTS task_beads_update_change_log;
+DROP TRIGGER IF EXISTS task_beads_delete_change_log;
+DROP TRIGGER IF EXISTS change_log_prune;
+DROP TABLE IF EXISTS change_log;
@@ -0,0 +1,7 @@
+-- +up
+-- Problems setting up the worktree ([worktree] copy_files, post_create), as a JSON array
+ALTER TABLE works ADD COLUMN setup_warnings TEXT NOT NULL DEFAULT '';
+
+-- +down
+-- SQLite doesn't support DROP COLUMN directly, but we document the intent
+-- This requires recreating the table without the column
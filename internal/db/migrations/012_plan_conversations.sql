@@ -0,0 +1,11 @@
+-- +up
+-- The Claude conversation behind each bead's plan session, kept after the
+-- session ends so `co plan` can resume it instead of starting over.
+CREATE TABLE plan_conversations (
+    bead_id TEXT PRIMARY KEY,
+    claude_session_id TEXT NOT NULL,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +down
+DROP TABLE IF EXISTS plan_conversations;
@@ -0,0 +1,9 @@
+-- +up
+-- Why a failed task failed: rate_limited, timeout, tool_error, task_error or
+-- cancelled. Empty for tasks that haven't failed, or failed before this was
+-- recorded.
+ALTER TABLE tasks ADD COLUMN failure_kind TEXT NOT NULL DEFAULT '';
+
+-- +down
+-- SQLite doesn't support DROP COLUMN directly, but we document the intent
+-- This requires recreating the table without the column
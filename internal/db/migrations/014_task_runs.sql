@@ -0,0 +1,21 @@
+-- +up
+-- One row per attempt at a task, with the Claude model, settings and CLI
+-- version it ran with. Resetting a task and running it again adds a row, so
+-- the history of attempts stays visible.
+CREATE TABLE task_runs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    task_id TEXT NOT NULL,
+    model TEXT NOT NULL DEFAULT '',
+    settings TEXT NOT NULL DEFAULT '',
+    claude_version TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'processing',
+    started_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at DATETIME,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_runs_task_id ON task_runs(task_id);
+
+-- +down
+DROP INDEX IF EXISTS idx_task_runs_task_id;
+DROP TABLE IF EXISTS task_runs;
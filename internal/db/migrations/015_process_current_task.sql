@@ -0,0 +1,8 @@
+-- +up
+-- The task an orchestrator is running, written with its heartbeat. Empty
+-- while it's idle or waiting.
+ALTER TABLE processes ADD COLUMN current_task TEXT NOT NULL DEFAULT '';
+
+-- +down
+-- SQLite doesn't support DROP COLUMN directly, but we document the intent
+-- This requires recreating the table without the column
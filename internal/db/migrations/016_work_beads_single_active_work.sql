@@ -0,0 +1,29 @@
+-- +up
+-- A bead can be in at most one active (not completed or merged) work, so two
+-- branches never work on the same issue
+CREATE TRIGGER work_beads_single_active_work_insert BEFORE INSERT ON work_beads
+WHEN EXISTS (
+    SELECT 1 FROM work_beads wb
+    JOIN works w ON w.id = wb.work_id
+    WHERE wb.bead_id = NEW.bead_id
+      AND wb.work_id != NEW.work_id
+      AND w.status NOT IN ('completed', 'merged')
+)
+BEGIN
+    SELECT RAISE(ABORT, 'bead is already in another active work');
+END;
+CREATE TRIGGER work_beads_single_active_work_update BEFORE UPDATE OF work_id, bead_id ON work_beads
+WHEN EXISTS (
+    SELECT 1 FROM work_beads wb
+    JOIN works w ON w.id = wb.work_id
+    WHERE wb.bead_id = NEW.bead_id
+      AND wb.work_id != NEW.work_id
+      AND w.status NOT IN ('completed', 'merged')
+)
+BEGIN
+    SELECT RAISE(ABORT, 'bead is already in another active work');
+END;
+
+-- +down
+DROP TRIGGER IF EXISTS work_beads_single_active_work_insert;
+DROP TRIGGER IF EXISTS work_beads_single_active_work_update;
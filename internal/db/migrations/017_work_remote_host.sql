@@ -0,0 +1,8 @@
+-- +up
+-- SSH host a remote work's worktree and Claude sessions are on; empty for
+-- works on this machine
+ALTER TABLE works ADD COLUMN remote_host TEXT NOT NULL DEFAULT '';
+
+-- +down
+-- SQLite doesn't support DROP COLUMN directly, but we document the intent
+-- This requires recreating the table without the column
@@ -0,0 +1,17 @@
+-- +up
+-- Steps a running task reports, like "running tests", so a long task shows
+-- more than a spinner. Finished tasks keep only their latest steps.
+CREATE TABLE task_progress (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    task_id TEXT NOT NULL,
+    step TEXT NOT NULL,
+    detail TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_progress_task_id ON task_progress(task_id);
+
+-- +down
+DROP INDEX IF EXISTS idx_task_progress_task_id;
+DROP TABLE IF EXISTS task_progress;
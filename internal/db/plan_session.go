@@ -162,6 +162,66 @@ func (db *DB) CleanupStalePlanSessions(ctx context.Context) error {
 	return nil
 }
 
+// GetPlanConversation returns the Claude session ID of the bead's last plan
+// conversation. Returns "" if the bead has none.
+func (db *DB) GetPlanConversation(ctx context.Context, beadID string) (string, error) {
+	var sessionID string
+	err := db.QueryRowContext(ctx, `
+		SELECT claude_session_id FROM plan_conversations WHERE bead_id = ?
+	`, beadID).Scan(&sessionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get plan conversation: %w", err)
+	}
+	return sessionID, nil
+}
+
+// SetPlanConversation records the Claude session ID of the bead's plan
+// conversation, replacing any earlier one.
+func (db *DB) SetPlanConversation(ctx context.Context, beadID, sessionID string) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT OR REPLACE INTO plan_conversations (bead_id, claude_session_id, updated_at)
+		VALUES (?, ?, CURRENT_TIMESTAMP)
+	`, beadID, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to set plan conversation: %w", err)
+	}
+	return nil
+}
+
+// ClearPlanConversation forgets the bead's plan conversation.
+func (db *DB) ClearPlanConversation(ctx context.Context, beadID string) error {
+	_, err := db.ExecContext(ctx, `
+		DELETE FROM plan_conversations WHERE bead_id = ?
+	`, beadID)
+	if err != nil {
+		return fmt.Errorf("failed to clear plan conversation: %w", err)
+	}
+	return nil
+}
+
+// GetBeadsWithPlanConversations returns a map of bead IDs that have a plan
+// conversation to resume.
+func (db *DB) GetBeadsWithPlanConversations(ctx context.Context) (map[string]bool, error) {
+	rows, err := db.QueryContext(ctx, `SELECT bead_id FROM plan_conversations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plan conversations: %w", err)
+	}
+	defer rows.Close()
+
+	result := make(map[string]bool)
+	for rows.Next() {
+		var beadID string
+		if err := rows.Scan(&beadID); err != nil {
+			return nil, err
+		}
+		result[beadID] = true
+	}
+	return result, rows.Err()
+}
+
 // isProcessAlive checks if a process with the given PID is still running.
 func isProcessAlive(pid int) bool {
 	process, err := os.FindProcess(pid)
@@ -0,0 +1,40 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanConversations(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	sessionID, err := db.GetPlanConversation(ctx, "bead-1")
+	require.NoError(t, err)
+	assert.Equal(t, "", sessionID, "no conversation yet")
+
+	require.NoError(t, db.SetPlanConversation(ctx, "bead-1", "first"))
+	require.NoError(t, db.SetPlanConversation(ctx, "bead-1", "second"))
+	require.NoError(t, db.SetPlanConversation(ctx, "bead-2", "other"))
+
+	sessionID, err = db.GetPlanConversation(ctx, "bead-1")
+	require.NoError(t, err)
+	assert.Equal(t, "second", sessionID, "a new conversation replaces the old one")
+
+	// Conversations outlive the plan session itself
+	require.NoError(t, db.RegisterPlanSession(ctx, "bead-1", "co-test", TabNameForBead("bead-1"), 1))
+	require.NoError(t, db.UnregisterPlanSession(ctx, "bead-1"))
+
+	resumable, err := db.GetBeadsWithPlanConversations(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"bead-1": true, "bead-2": true}, resumable)
+
+	require.NoError(t, db.ClearPlanConversation(ctx, "bead-1"))
+	sessionID, err = db.GetPlanConversation(ctx, "bead-1")
+	require.NoError(t, err)
+	assert.Equal(t, "", sessionID)
+}
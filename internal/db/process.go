@@ -32,8 +32,29 @@ type Process struct {
 	Hostname    string
 	Heartbeat   time.Time
 	StartedAt   time.Time
+	CurrentTask string // Task the orchestrator is running, empty when idle
 }
 
+// IsRunning reports whether the process still exists. Processes on other
+// machines can't be checked and are reported as not running.
+func (p *Process) IsRunning() bool {
+	hostname, _ := os.Hostname()
+	return p.Hostname == hostname && isProcessAlive(p.PID)
+}
+
+// OrchestratorHealth is what the heartbeat says about a work's orchestrator.
+type OrchestratorHealth int
+
+const (
+	// OrchestratorDown means no orchestrator process is running.
+	OrchestratorDown OrchestratorHealth = iota
+	// OrchestratorStale means the process exists but its heartbeat stopped;
+	// it's likely wedged.
+	OrchestratorStale
+	// OrchestratorRunning means the heartbeat is recent.
+	OrchestratorRunning
+)
+
 // RegisterProcess registers or updates a process in the database.
 func (db *DB) RegisterProcess(ctx context.Context, id, processType string, workID *string, pid int) error {
 	hostname, _ := os.Hostname()
@@ -65,6 +86,19 @@ func (db *DB) UpdateHeartbeat(ctx context.Context, id string) error {
 	return nil
 }
 
+// SetProcessCurrentTask records the task a process is running; an empty
+// taskID records that it's idle.
+func (db *DB) SetProcessCurrentTask(ctx context.Context, id, taskID string) error {
+	err := db.queries.SetProcessCurrentTask(ctx, sqlc.SetProcessCurrentTaskParams{
+		CurrentTask: taskID,
+		ID:          id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set current task: %w", err)
+	}
+	return nil
+}
+
 // UpdateHeartbeatWithTime updates the heartbeat timestamp for a process with an explicit time.
 // This is useful for testing where time needs to be controlled.
 func (db *DB) UpdateHeartbeatWithTime(ctx context.Context, id string, t time.Time) error {
@@ -93,6 +127,28 @@ func (db *DB) IsOrchestratorAlive(ctx context.Context, workID string, threshold
 	return alive == 1, nil
 }
 
+// GetOrchestratorHealth tells a work's orchestrator apart as running (recent
+// heartbeat), stale (its process exists but the heartbeat is older than
+// threshold) or down. It also returns the orchestrator's process record, if
+// there is one.
+func (db *DB) GetOrchestratorHealth(ctx context.Context, workID string, threshold time.Duration) (OrchestratorHealth, *Process, error) {
+	proc, err := db.GetOrchestratorProcess(ctx, workID)
+	if err != nil || proc == nil {
+		return OrchestratorDown, nil, err
+	}
+	alive, err := db.IsOrchestratorAlive(ctx, workID, threshold)
+	if err != nil {
+		return OrchestratorDown, proc, err
+	}
+	switch {
+	case alive:
+		return OrchestratorRunning, proc, nil
+	case proc.IsRunning():
+		return OrchestratorStale, proc, nil
+	}
+	return OrchestratorDown, proc, nil
+}
+
 // IsControlPlaneAlive checks if the control plane has a recent heartbeat.
 func (db *DB) IsControlPlaneAlive(ctx context.Context, threshold time.Duration) (bool, error) {
 	// Convert threshold to negative seconds for SQL datetime comparison
@@ -236,6 +292,7 @@ func processFromSqlc(p *sqlc.Process) *Process {
 		Hostname:    p.Hostname,
 		Heartbeat:   p.Heartbeat,
 		StartedAt:   p.StartedAt,
+		CurrentTask: p.CurrentTask,
 	}
 	if p.WorkID.Valid {
 		proc.WorkID = &p.WorkID.String
@@ -25,7 +25,15 @@ CREATE TABLE works (
     last_pr_poll_at DATETIME,
     has_unseen_pr_changes BOOLEAN NOT NULL DEFAULT FALSE,
     pr_state TEXT NOT NULL DEFAULT '',
-    mergeable_state TEXT NOT NULL DEFAULT ''
+    mergeable_state TEXT NOT NULL DEFAULT '',
+    paused BOOLEAN NOT NULL DEFAULT FALSE,
+    scheduled_run_at DATETIME,
+    max_parallel_tasks INTEGER NOT NULL DEFAULT 0,
+    notes TEXT NOT NULL DEFAULT '',
+    env TEXT NOT NULL DEFAULT '',
+    auto_pr BOOLEAN,
+    setup_warnings TEXT NOT NULL DEFAULT '',
+    remote_host TEXT NOT NULL DEFAULT ''
 );
 
 CREATE INDEX idx_works_status ON works(status);
@@ -65,12 +73,43 @@ CREATE TABLE tasks (
     created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
     spawned_at DATETIME,
     spawn_status TEXT NOT NULL DEFAULT '',
-    last_activity DATETIME
+    last_activity DATETIME,
+    claimed_by TEXT NOT NULL DEFAULT '',
+    claimed_at DATETIME,
+    failure_kind TEXT NOT NULL DEFAULT ''
 );
 
 CREATE INDEX idx_tasks_status ON tasks(status);
 CREATE INDEX idx_tasks_work_id ON tasks(work_id);
 
+-- Task runs: one row per attempt at a task, with the Claude model,
+-- settings and CLI version it ran with
+CREATE TABLE task_runs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    task_id TEXT NOT NULL,
+    model TEXT NOT NULL DEFAULT '',
+    settings TEXT NOT NULL DEFAULT '',
+    claude_version TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'processing',
+    started_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at DATETIME,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_runs_task_id ON task_runs(task_id);
+
+-- Task progress: steps a running task reports, like "running tests"
+CREATE TABLE task_progress (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    task_id TEXT NOT NULL,
+    step TEXT NOT NULL,
+    detail TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_progress_task_id ON task_progress(task_id);
+
 -- Task-beads junction table: links tasks to their beads
 CREATE TABLE task_beads (
     task_id TEXT NOT NULL,
@@ -152,6 +191,8 @@ CREATE TABLE work_beads (
 
 CREATE INDEX idx_work_beads_work_id ON work_beads(work_id);
 CREATE INDEX idx_work_beads_bead_id ON work_beads(bead_id);
+-- A bead is in at most one active (not completed or merged) work; triggers
+-- from migration 016 enforce it
 
 -- Schema migrations table: tracks applied database migrations
 CREATE TABLE schema_migrations (
@@ -172,6 +213,14 @@ CREATE TABLE plan_sessions (
 
 CREATE INDEX idx_plan_sessions_zellij_session ON plan_sessions(zellij_session);
 
+-- Plan conversations: the Claude session behind each bead's plan session,
+-- kept after the session ends so it can be resumed
+CREATE TABLE plan_conversations (
+    bead_id TEXT PRIMARY KEY,
+    claude_session_id TEXT NOT NULL,
+    updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
 -- PR Feedback table: tracks feedback from PRs (comments, CI failures, etc.)
 CREATE TABLE pr_feedback (
     id TEXT PRIMARY KEY,
@@ -232,7 +281,8 @@ CREATE TABLE processes (
     pid INTEGER NOT NULL,                  -- OS process ID
     hostname TEXT NOT NULL DEFAULT '',     -- machine hostname
     heartbeat DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    started_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+    started_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    current_task TEXT NOT NULL DEFAULT ''  -- task the orchestrator is running, if any
 );
 
 -- Index for looking up by type
@@ -251,3 +301,12 @@ CREATE UNIQUE INDEX idx_processes_unique_orchestrator ON processes(work_id)
 -- Unique partial index: only one control plane per project
 CREATE UNIQUE INDEX idx_processes_unique_control_plane ON processes(process_type)
     WHERE process_type = 'control_plane';
+
+-- Change log: rows changed in works, tasks and bead assignments, written by
+-- triggers (see migrations/010_change_log.sql) and read by the tracking watcher
+CREATE TABLE change_log (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    entity TEXT NOT NULL,
+    entity_id TEXT NOT NULL,
+    work_id TEXT NOT NULL DEFAULT ''
+);
@@ -89,6 +89,84 @@ func (q *Queries) GetAllCachedComplexity(ctx context.Context) ([]GetAllCachedCom
 	return items, nil
 }
 
+const getComplexityStats = `-- name: GetComplexityStats :many
+WITH scored AS (
+    SELECT id, work_id, task_type, complexity_budget, actual_complexity,
+           ROW_NUMBER() OVER (
+               PARTITION BY work_id
+               ORDER BY actual_complexity - complexity_budget DESC, id
+           ) AS overrun_rank
+    FROM tasks
+    WHERE complexity_budget > 0 AND actual_complexity > 0
+)
+SELECT CAST('type' AS TEXT) as kind,
+       work_id,
+       task_type,
+       CAST('' AS TEXT) as task_id,
+       CAST(0 AS INTEGER) as bucket,
+       COUNT(*) as task_count,
+       CAST(SUM(complexity_budget) AS INTEGER) as total_budget,
+       CAST(SUM(actual_complexity) AS INTEGER) as total_actual
+FROM scored
+GROUP BY work_id, task_type
+UNION ALL
+SELECT 'overrun', work_id, task_type, id, 0, 1, complexity_budget, actual_complexity
+FROM scored
+WHERE overrun_rank <= 5 AND actual_complexity > complexity_budget
+UNION ALL
+SELECT 'histogram', work_id, '', '', MIN(actual_complexity * 4 / complexity_budget, 8), COUNT(*), 0, 0
+FROM scored
+GROUP BY work_id, MIN(actual_complexity * 4 / complexity_budget, 8)
+`
+
+type GetComplexityStatsRow struct {
+	Kind        string `json:"kind"`
+	WorkID      string `json:"work_id"`
+	TaskType    string `json:"task_type"`
+	TaskID      string `json:"task_id"`
+	Bucket      int64  `json:"bucket"`
+	TaskCount   int64  `json:"task_count"`
+	TotalBudget int64  `json:"total_budget"`
+	TotalActual int64  `json:"total_actual"`
+}
+
+// Summarizes complexity budget against actual usage in a single pass.
+// Each row has a kind: 'type' rows total each work's task types, 'overrun'
+// rows are the five tasks per work that most exceeded budget, and 'histogram'
+// rows count tasks per actual/budget bucket (bucket n covers n*25% up to
+// (n+1)*25%; bucket 8 is 200% and above).
+func (q *Queries) GetComplexityStats(ctx context.Context) ([]GetComplexityStatsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getComplexityStats)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetComplexityStatsRow{}
+	for rows.Next() {
+		var i GetComplexityStatsRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.WorkID,
+			&i.TaskType,
+			&i.TaskID,
+			&i.Bucket,
+			&i.TaskCount,
+			&i.TotalBudget,
+			&i.TotalActual,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getCachedComplexity = `-- name: GetCachedComplexity :one
 SELECT complexity_score, estimated_tokens
 FROM complexity_cache
@@ -111,3 +189,38 @@ func (q *Queries) GetCachedComplexity(ctx context.Context, arg GetCachedComplexi
 	err := row.Scan(&i.ComplexityScore, &i.EstimatedTokens)
 	return i, err
 }
+
+const listComplexityEstimates = `-- name: ListComplexityEstimates :many
+SELECT bead_id, description_hash, complexity_score, estimated_tokens, created_at
+FROM complexity_cache
+ORDER BY bead_id
+`
+
+func (q *Queries) ListComplexityEstimates(ctx context.Context) ([]ComplexityCache, error) {
+	rows, err := q.db.QueryContext(ctx, listComplexityEstimates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ComplexityCache{}
+	for rows.Next() {
+		var i ComplexityCache
+		if err := rows.Scan(
+			&i.BeadID,
+			&i.DescriptionHash,
+			&i.ComplexityScore,
+			&i.EstimatedTokens,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -67,6 +67,7 @@ type Process struct {
 	Hostname    string         `json:"hostname"`
 	Heartbeat   time.Time      `json:"heartbeat"`
 	StartedAt   time.Time      `json:"started_at"`
+	CurrentTask string         `json:"current_task"`
 }
 
 type Scheduler struct {
@@ -108,6 +109,9 @@ type Task struct {
 	SpawnedAt        sql.NullTime `json:"spawned_at"`
 	SpawnStatus      string       `json:"spawn_status"`
 	LastActivity     sql.NullTime `json:"last_activity"`
+	ClaimedBy        string       `json:"claimed_by"`
+	ClaimedAt        sql.NullTime `json:"claimed_at"`
+	FailureKind      string       `json:"failure_kind"`
 }
 
 type TaskBead struct {
@@ -152,6 +156,14 @@ type Work struct {
 	HasUnseenPrChanges bool         `json:"has_unseen_pr_changes"`
 	PrState            string       `json:"pr_state"`
 	MergeableState     string       `json:"mergeable_state"`
+	Paused             bool         `json:"paused"`
+	ScheduledRunAt     sql.NullTime `json:"scheduled_run_at"`
+	MaxParallelTasks   int64        `json:"max_parallel_tasks"`
+	Notes              string       `json:"notes"`
+	Env                string       `json:"env"`
+	AutoPr             sql.NullBool `json:"auto_pr"`
+	SetupWarnings      string       `json:"setup_warnings"`
+	RemoteHost         string       `json:"remote_host"`
 }
 
 type WorkBead struct {
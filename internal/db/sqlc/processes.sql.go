@@ -49,7 +49,7 @@ func (q *Queries) DeleteStaleProcesses(ctx context.Context, dollar_1 sql.NullStr
 }
 
 const getAllProcesses = `-- name: GetAllProcesses :many
-SELECT id, process_type, work_id, pid, hostname, heartbeat, started_at FROM processes ORDER BY started_at DESC
+SELECT id, process_type, work_id, pid, hostname, heartbeat, started_at, current_task FROM processes ORDER BY started_at DESC
 `
 
 func (q *Queries) GetAllProcesses(ctx context.Context) ([]Process, error) {
@@ -69,6 +69,7 @@ func (q *Queries) GetAllProcesses(ctx context.Context) ([]Process, error) {
 			&i.Hostname,
 			&i.Heartbeat,
 			&i.StartedAt,
+			&i.CurrentTask,
 		); err != nil {
 			return nil, err
 		}
@@ -84,7 +85,7 @@ func (q *Queries) GetAllProcesses(ctx context.Context) ([]Process, error) {
 }
 
 const getControlPlaneProcess = `-- name: GetControlPlaneProcess :one
-SELECT id, process_type, work_id, pid, hostname, heartbeat, started_at FROM processes
+SELECT id, process_type, work_id, pid, hostname, heartbeat, started_at, current_task FROM processes
 WHERE process_type = 'control_plane'
 LIMIT 1
 `
@@ -100,12 +101,13 @@ func (q *Queries) GetControlPlaneProcess(ctx context.Context) (Process, error) {
 		&i.Hostname,
 		&i.Heartbeat,
 		&i.StartedAt,
+		&i.CurrentTask,
 	)
 	return i, err
 }
 
 const getOrchestratorProcess = `-- name: GetOrchestratorProcess :one
-SELECT id, process_type, work_id, pid, hostname, heartbeat, started_at FROM processes
+SELECT id, process_type, work_id, pid, hostname, heartbeat, started_at, current_task FROM processes
 WHERE work_id = ? AND process_type = 'orchestrator'
 LIMIT 1
 `
@@ -121,12 +123,13 @@ func (q *Queries) GetOrchestratorProcess(ctx context.Context, workID sql.NullStr
 		&i.Hostname,
 		&i.Heartbeat,
 		&i.StartedAt,
+		&i.CurrentTask,
 	)
 	return i, err
 }
 
 const getProcess = `-- name: GetProcess :one
-SELECT id, process_type, work_id, pid, hostname, heartbeat, started_at FROM processes WHERE id = ?
+SELECT id, process_type, work_id, pid, hostname, heartbeat, started_at, current_task FROM processes WHERE id = ?
 `
 
 func (q *Queries) GetProcess(ctx context.Context, id string) (Process, error) {
@@ -140,12 +143,13 @@ func (q *Queries) GetProcess(ctx context.Context, id string) (Process, error) {
 		&i.Hostname,
 		&i.Heartbeat,
 		&i.StartedAt,
+		&i.CurrentTask,
 	)
 	return i, err
 }
 
 const getProcessByWorkID = `-- name: GetProcessByWorkID :one
-SELECT id, process_type, work_id, pid, hostname, heartbeat, started_at FROM processes
+SELECT id, process_type, work_id, pid, hostname, heartbeat, started_at, current_task FROM processes
 WHERE work_id = ? AND process_type = 'orchestrator'
 LIMIT 1
 `
@@ -161,12 +165,13 @@ func (q *Queries) GetProcessByWorkID(ctx context.Context, workID sql.NullString)
 		&i.Hostname,
 		&i.Heartbeat,
 		&i.StartedAt,
+		&i.CurrentTask,
 	)
 	return i, err
 }
 
 const getStaleProcesses = `-- name: GetStaleProcesses :many
-SELECT id, process_type, work_id, pid, hostname, heartbeat, started_at FROM processes
+SELECT id, process_type, work_id, pid, hostname, heartbeat, started_at, current_task FROM processes
 WHERE datetime(heartbeat) < datetime('now', ? || ' seconds')
 `
 
@@ -187,6 +192,7 @@ func (q *Queries) GetStaleProcesses(ctx context.Context, dollar_1 sql.NullString
 			&i.Hostname,
 			&i.Heartbeat,
 			&i.StartedAt,
+			&i.CurrentTask,
 		); err != nil {
 			return nil, err
 		}
@@ -265,6 +271,22 @@ func (q *Queries) RegisterProcess(ctx context.Context, arg RegisterProcessParams
 	return err
 }
 
+const setProcessCurrentTask = `-- name: SetProcessCurrentTask :exec
+UPDATE processes
+SET current_task = ?
+WHERE id = ?
+`
+
+type SetProcessCurrentTaskParams struct {
+	CurrentTask string `json:"current_task"`
+	ID          string `json:"id"`
+}
+
+func (q *Queries) SetProcessCurrentTask(ctx context.Context, arg SetProcessCurrentTaskParams) error {
+	_, err := q.db.ExecContext(ctx, setProcessCurrentTask, arg.CurrentTask, arg.ID)
+	return err
+}
+
 const updateHeartbeat = `-- name: UpdateHeartbeat :exec
 UPDATE processes
 SET heartbeat = CURRENT_TIMESTAMP
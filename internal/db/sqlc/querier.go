@@ -16,6 +16,7 @@ type Querier interface {
 	AddWorkBead(ctx context.Context, arg AddWorkBeadParams) error
 	AddWorkBeadsBatch(ctx context.Context, arg AddWorkBeadsBatchParams) error
 	CacheComplexity(ctx context.Context, arg CacheComplexityParams) error
+	ClaimTask(ctx context.Context, arg ClaimTaskParams) (int64, error)
 	CompleteBead(ctx context.Context, arg CompleteBeadParams) (int64, error)
 	CompleteTask(ctx context.Context, arg CompleteTaskParams) (int64, error)
 	CompleteTaskBead(ctx context.Context, arg CompleteTaskBeadParams) (int64, error)
@@ -43,11 +44,13 @@ type Querier interface {
 	DeleteSchedulerForWork(ctx context.Context, workID string) (int64, error)
 	DeleteStaleProcesses(ctx context.Context, dollar_1 sql.NullString) error
 	DeleteTask(ctx context.Context, id string) (int64, error)
+	DeleteTaskBead(ctx context.Context, arg DeleteTaskBeadParams) (int64, error)
 	DeleteTaskBeadsByTask(ctx context.Context, taskID string) (int64, error)
 	DeleteTaskBeadsForWork(ctx context.Context, workID string) (int64, error)
 	DeleteTaskDependencies(ctx context.Context, taskID string) (int64, error)
 	DeleteTaskDependenciesForWork(ctx context.Context, workID string) (int64, error)
 	DeleteTaskDependency(ctx context.Context, arg DeleteTaskDependencyParams) (int64, error)
+	DeleteTaskDependents(ctx context.Context, dependsOnTaskID string) (int64, error)
 	DeleteTaskMetadata(ctx context.Context, arg DeleteTaskMetadataParams) (int64, error)
 	DeleteTasksForWork(ctx context.Context, workID string) (int64, error)
 	DeleteWork(ctx context.Context, id string) (int64, error)
@@ -68,7 +71,14 @@ type Querier interface {
 	GetAppliedMigrations(ctx context.Context) ([]string, error)
 	GetBead(ctx context.Context, id string) (Bead, error)
 	GetBeadStatus(ctx context.Context, id string) (string, error)
+	GetBeadTaskRuns(ctx context.Context, workID string) ([]GetBeadTaskRunsRow, error)
 	GetCachedComplexity(ctx context.Context, arg GetCachedComplexityParams) (GetCachedComplexityRow, error)
+	// Summarizes complexity budget against actual usage in a single pass.
+	// Each row has a kind: 'type' rows total each work's task types, 'overrun'
+	// rows are the five tasks per work that most exceeded budget, and 'histogram'
+	// rows count tasks per actual/budget bucket (bucket n covers n*25% up to
+	// (n+1)*25%; bucket 8 is 200% and above).
+	GetComplexityStats(ctx context.Context) ([]GetComplexityStatsRow, error)
 	GetControlPlaneProcess(ctx context.Context) (Process, error)
 	GetLastMigration(ctx context.Context) (string, error)
 	GetLastWorkID(ctx context.Context) (string, error)
@@ -94,10 +104,14 @@ type Querier interface {
 	GetTaskBeadsForWork(ctx context.Context, workID string) ([]TaskBead, error)
 	GetTaskBeadsWithStatus(ctx context.Context, taskID string) ([]TaskBead, error)
 	GetTaskByIdempotencyKey(ctx context.Context, idempotencyKey sql.NullString) (Scheduler, error)
+	GetTaskClaimant(ctx context.Context, id string) (string, error)
+	GetTaskClaimedAt(ctx context.Context, id string) (sql.NullTime, error)
 	GetTaskDependencies(ctx context.Context, taskID string) ([]string, error)
+	GetTaskDependenciesForWork(ctx context.Context, workID string) ([]GetTaskDependenciesForWorkRow, error)
 	GetTaskDependents(ctx context.Context, dependsOnTaskID string) ([]string, error)
 	GetTaskForBead(ctx context.Context, beadID string) (string, error)
 	GetTaskMetadata(ctx context.Context, arg GetTaskMetadataParams) (string, error)
+	GetTasksForBead(ctx context.Context, arg GetTasksForBeadParams) ([]GetTasksForBeadRow, error)
 	GetTasksWithActivity(ctx context.Context) ([]Task, error)
 	// Get bead IDs from PR feedback items that are not yet assigned to any task and not resolved/closed.
 	GetUnassignedFeedbackBeadIDs(ctx context.Context, workID string) ([]sql.NullString, error)
@@ -108,6 +122,7 @@ type Querier interface {
 	GetWorkBeads(ctx context.Context, workID string) ([]WorkBead, error)
 	GetWorkByDirectory(ctx context.Context, worktreePath string) (Work, error)
 	GetWorkTasks(ctx context.Context, workID string) ([]GetWorkTasksRow, error)
+	GetWorksDueToRun(ctx context.Context, scheduledRunAt sql.NullTime) ([]string, error)
 	GetWorksWithPRs(ctx context.Context) ([]Work, error)
 	GetWorksWithUnseenChanges(ctx context.Context) ([]Work, error)
 	HasExistingFeedback(ctx context.Context, arg HasExistingFeedbackParams) (int64, error)
@@ -122,6 +137,7 @@ type Querier interface {
 	IsOrchestratorAlive(ctx context.Context, arg IsOrchestratorAliveParams) (int64, error)
 	ListBeads(ctx context.Context) ([]Bead, error)
 	ListBeadsByStatus(ctx context.Context, status string) ([]Bead, error)
+	ListComplexityEstimates(ctx context.Context) ([]ComplexityCache, error)
 	ListMigrationVersions(ctx context.Context) ([]string, error)
 	ListMigrationsWithDetails(ctx context.Context) ([]ListMigrationsWithDetailsRow, error)
 	ListPRFeedback(ctx context.Context, workID string) ([]PrFeedback, error)
@@ -151,9 +167,18 @@ type Querier interface {
 	ResetTaskStatus(ctx context.Context, id string) (int64, error)
 	RestartWork(ctx context.Context, id string) (int64, error)
 	ResumeWork(ctx context.Context, id string) (int64, error)
+	SetProcessCurrentTask(ctx context.Context, arg SetProcessCurrentTaskParams) error
 	SetTaskMetadata(ctx context.Context, arg SetTaskMetadataParams) error
+	SetWorkAutoPR(ctx context.Context, arg SetWorkAutoPRParams) (int64, error)
+	SetWorkEnv(ctx context.Context, arg SetWorkEnvParams) (int64, error)
 	SetWorkHasUnseenPRChanges(ctx context.Context, arg SetWorkHasUnseenPRChangesParams) (int64, error)
+	SetWorkMaxParallelTasks(ctx context.Context, arg SetWorkMaxParallelTasksParams) (int64, error)
+	SetWorkNotes(ctx context.Context, arg SetWorkNotesParams) (int64, error)
 	SetWorkPRURL(ctx context.Context, arg SetWorkPRURLParams) (int64, error)
+	SetWorkPaused(ctx context.Context, arg SetWorkPausedParams) (int64, error)
+	SetWorkRemoteHost(ctx context.Context, arg SetWorkRemoteHostParams) (int64, error)
+	SetWorkScheduledRunAt(ctx context.Context, arg SetWorkScheduledRunAtParams) (int64, error)
+	SetWorkSetupWarnings(ctx context.Context, arg SetWorkSetupWarningsParams) (int64, error)
 	SpawnTask(ctx context.Context, arg SpawnTaskParams) (int64, error)
 	StartBead(ctx context.Context, arg StartBeadParams) error
 	StartTask(ctx context.Context, arg StartTaskParams) (int64, error)
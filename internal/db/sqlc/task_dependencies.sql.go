@@ -72,6 +72,19 @@ func (q *Queries) DeleteTaskDependency(ctx context.Context, arg DeleteTaskDepend
 	return result.RowsAffected()
 }
 
+const deleteTaskDependents = `-- name: DeleteTaskDependents :execrows
+DELETE FROM task_dependencies
+WHERE depends_on_task_id = ?
+`
+
+func (q *Queries) DeleteTaskDependents(ctx context.Context, dependsOnTaskID string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteTaskDependents, dependsOnTaskID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getReadyTasksForWork = `-- name: GetReadyTasksForWork :many
 SELECT t.id, t.status,
        COALESCE(t.task_type, 'implement') as task_type,
@@ -85,7 +98,8 @@ SELECT t.id, t.status,
        t.completed_at,
        t.created_at,
        t.spawned_at,
-       t.spawn_status
+       t.spawn_status,
+       t.failure_kind
 FROM tasks t
 INNER JOIN work_tasks wt ON t.id = wt.task_id
 WHERE wt.work_id = ?
@@ -114,6 +128,7 @@ type GetReadyTasksForWorkRow struct {
 	CreatedAt        time.Time    `json:"created_at"`
 	SpawnedAt        sql.NullTime `json:"spawned_at"`
 	SpawnStatus      string       `json:"spawn_status"`
+	FailureKind      string       `json:"failure_kind"`
 }
 
 func (q *Queries) GetReadyTasksForWork(ctx context.Context, workID string) ([]GetReadyTasksForWorkRow, error) {
@@ -140,6 +155,7 @@ func (q *Queries) GetReadyTasksForWork(ctx context.Context, workID string) ([]Ge
 			&i.CreatedAt,
 			&i.SpawnedAt,
 			&i.SpawnStatus,
+			&i.FailureKind,
 		); err != nil {
 			return nil, err
 		}
@@ -183,6 +199,42 @@ func (q *Queries) GetTaskDependencies(ctx context.Context, taskID string) ([]str
 	return items, nil
 }
 
+const getTaskDependenciesForWork = `-- name: GetTaskDependenciesForWork :many
+SELECT td.task_id, td.depends_on_task_id
+FROM task_dependencies td
+INNER JOIN work_tasks wt ON td.task_id = wt.task_id
+WHERE wt.work_id = ?
+ORDER BY td.task_id, td.depends_on_task_id
+`
+
+type GetTaskDependenciesForWorkRow struct {
+	TaskID          string `json:"task_id"`
+	DependsOnTaskID string `json:"depends_on_task_id"`
+}
+
+func (q *Queries) GetTaskDependenciesForWork(ctx context.Context, workID string) ([]GetTaskDependenciesForWorkRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTaskDependenciesForWork, workID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTaskDependenciesForWorkRow{}
+	for rows.Next() {
+		var i GetTaskDependenciesForWorkRow
+		if err := rows.Scan(&i.TaskID, &i.DependsOnTaskID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTaskDependents = `-- name: GetTaskDependents :many
 SELECT task_id
 FROM task_dependencies
@@ -11,6 +11,33 @@ import (
 	"time"
 )
 
+const claimTask = `-- name: ClaimTask :execrows
+UPDATE tasks
+SET claimed_by = ?,
+    claimed_at = ?
+WHERE id = ? AND status = 'pending' AND claimed_by = ?
+`
+
+type ClaimTaskParams struct {
+	ClaimedBy   string       `json:"claimed_by"`
+	ClaimedAt   sql.NullTime `json:"claimed_at"`
+	ID          string       `json:"id"`
+	ClaimedBy_2 string       `json:"claimed_by_2"`
+}
+
+func (q *Queries) ClaimTask(ctx context.Context, arg ClaimTaskParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, claimTask,
+		arg.ClaimedBy,
+		arg.ClaimedAt,
+		arg.ID,
+		arg.ClaimedBy_2,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const completeTask = `-- name: CompleteTask :execrows
 UPDATE tasks
 SET status = 'completed',
@@ -121,6 +148,24 @@ func (q *Queries) DeleteTask(ctx context.Context, id string) (int64, error) {
 	return result.RowsAffected()
 }
 
+const deleteTaskBead = `-- name: DeleteTaskBead :execrows
+DELETE FROM task_beads
+WHERE task_id = ? AND bead_id = ?
+`
+
+type DeleteTaskBeadParams struct {
+	TaskID string `json:"task_id"`
+	BeadID string `json:"bead_id"`
+}
+
+func (q *Queries) DeleteTaskBead(ctx context.Context, arg DeleteTaskBeadParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteTaskBead, arg.TaskID, arg.BeadID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteTaskBeadsByTask = `-- name: DeleteTaskBeadsByTask :execrows
 DELETE FROM task_beads
 WHERE task_id = ?
@@ -179,18 +224,25 @@ const failTask = `-- name: FailTask :execrows
 UPDATE tasks
 SET status = 'failed',
     error_message = ?,
+    failure_kind = ?,
     completed_at = ?
 WHERE id = ?
 `
 
 type FailTaskParams struct {
 	ErrorMessage string       `json:"error_message"`
+	FailureKind  string       `json:"failure_kind"`
 	CompletedAt  sql.NullTime `json:"completed_at"`
 	ID           string       `json:"id"`
 }
 
 func (q *Queries) FailTask(ctx context.Context, arg FailTaskParams) (int64, error) {
-	result, err := q.db.ExecContext(ctx, failTask, arg.ErrorMessage, arg.CompletedAt, arg.ID)
+	result, err := q.db.ExecContext(ctx, failTask,
+		arg.ErrorMessage,
+		arg.FailureKind,
+		arg.CompletedAt,
+		arg.ID,
+	)
 	if err != nil {
 		return 0, err
 	}
@@ -216,6 +268,50 @@ func (q *Queries) FailTaskBead(ctx context.Context, arg FailTaskBeadParams) (int
 	return result.RowsAffected()
 }
 
+const getBeadTaskRuns = `-- name: GetBeadTaskRuns :many
+SELECT tb.task_id, tb.bead_id, t.started_at, t.completed_at
+FROM task_beads tb
+JOIN tasks t ON tb.task_id = t.id
+WHERE t.started_at IS NOT NULL
+  AND (CAST(?1 AS TEXT) = '' OR t.work_id = ?1)
+ORDER BY tb.task_id, tb.bead_id
+`
+
+type GetBeadTaskRunsRow struct {
+	TaskID      string       `json:"task_id"`
+	BeadID      string       `json:"bead_id"`
+	StartedAt   sql.NullTime `json:"started_at"`
+	CompletedAt sql.NullTime `json:"completed_at"`
+}
+
+func (q *Queries) GetBeadTaskRuns(ctx context.Context, workID string) ([]GetBeadTaskRunsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getBeadTaskRuns, workID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetBeadTaskRunsRow{}
+	for rows.Next() {
+		var i GetBeadTaskRunsRow
+		if err := rows.Scan(
+			&i.TaskID,
+			&i.BeadID,
+			&i.StartedAt,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPRTaskForWork = `-- name: GetPRTaskForWork :one
 SELECT id, status,
        COALESCE(task_type, 'implement') as task_type,
@@ -229,7 +325,8 @@ SELECT id, status,
        completed_at,
        created_at,
        spawned_at,
-       spawn_status
+       spawn_status,
+       failure_kind
 FROM tasks
 WHERE work_id = ?
   AND task_type = 'pr'
@@ -253,6 +350,7 @@ type GetPRTaskForWorkRow struct {
 	CreatedAt        time.Time    `json:"created_at"`
 	SpawnedAt        sql.NullTime `json:"spawned_at"`
 	SpawnStatus      string       `json:"spawn_status"`
+	FailureKind      string       `json:"failure_kind"`
 }
 
 func (q *Queries) GetPRTaskForWork(ctx context.Context, workID string) (GetPRTaskForWorkRow, error) {
@@ -273,6 +371,7 @@ func (q *Queries) GetPRTaskForWork(ctx context.Context, workID string) (GetPRTas
 		&i.CreatedAt,
 		&i.SpawnedAt,
 		&i.SpawnStatus,
+		&i.FailureKind,
 	)
 	return i, err
 }
@@ -290,7 +389,8 @@ SELECT id, status,
        completed_at,
        created_at,
        spawned_at,
-       spawn_status
+       spawn_status,
+       failure_kind
 FROM tasks
 WHERE id = ?
 `
@@ -310,6 +410,7 @@ type GetTaskRow struct {
 	CreatedAt        time.Time    `json:"created_at"`
 	SpawnedAt        sql.NullTime `json:"spawned_at"`
 	SpawnStatus      string       `json:"spawn_status"`
+	FailureKind      string       `json:"failure_kind"`
 }
 
 func (q *Queries) GetTask(ctx context.Context, id string) (GetTaskRow, error) {
@@ -330,6 +431,7 @@ func (q *Queries) GetTask(ctx context.Context, id string) (GetTaskRow, error) {
 		&i.CreatedAt,
 		&i.SpawnedAt,
 		&i.SpawnStatus,
+		&i.FailureKind,
 	)
 	return i, err
 }
@@ -440,6 +542,32 @@ func (q *Queries) GetTaskBeadsWithStatus(ctx context.Context, taskID string) ([]
 	return items, nil
 }
 
+const getTaskClaimant = `-- name: GetTaskClaimant :one
+SELECT claimed_by
+FROM tasks
+WHERE id = ?
+`
+
+func (q *Queries) GetTaskClaimant(ctx context.Context, id string) (string, error) {
+	row := q.db.QueryRowContext(ctx, getTaskClaimant, id)
+	var claimed_by string
+	err := row.Scan(&claimed_by)
+	return claimed_by, err
+}
+
+const getTaskClaimedAt = `-- name: GetTaskClaimedAt :one
+SELECT claimed_at
+FROM tasks
+WHERE id = ?
+`
+
+func (q *Queries) GetTaskClaimedAt(ctx context.Context, id string) (sql.NullTime, error) {
+	row := q.db.QueryRowContext(ctx, getTaskClaimedAt, id)
+	var claimed_at sql.NullTime
+	err := row.Scan(&claimed_at)
+	return claimed_at, err
+}
+
 const getTaskForBead = `-- name: GetTaskForBead :one
 SELECT task_id
 FROM task_beads
@@ -453,6 +581,90 @@ func (q *Queries) GetTaskForBead(ctx context.Context, beadID string) (string, er
 	return task_id, err
 }
 
+const getTasksForBead = `-- name: GetTasksForBead :many
+SELECT t.id, t.status,
+       COALESCE(t.task_type, 'implement') as task_type,
+       t.complexity_budget,
+       t.actual_complexity,
+       t.work_id,
+       t.worktree_path,
+       t.pr_url,
+       t.error_message,
+       t.started_at,
+       t.completed_at,
+       t.created_at,
+       t.spawned_at,
+       t.spawn_status,
+       t.failure_kind
+FROM tasks t
+JOIN task_beads tb ON t.id = tb.task_id
+JOIN work_tasks wt ON t.id = wt.task_id
+WHERE wt.work_id = ? AND tb.bead_id = ?
+ORDER BY wt.position
+`
+
+type GetTasksForBeadParams struct {
+	WorkID string `json:"work_id"`
+	BeadID string `json:"bead_id"`
+}
+
+type GetTasksForBeadRow struct {
+	ID               string       `json:"id"`
+	Status           string       `json:"status"`
+	TaskType         string       `json:"task_type"`
+	ComplexityBudget int64        `json:"complexity_budget"`
+	ActualComplexity int64        `json:"actual_complexity"`
+	WorkID           string       `json:"work_id"`
+	WorktreePath     string       `json:"worktree_path"`
+	PrUrl            string       `json:"pr_url"`
+	ErrorMessage     string       `json:"error_message"`
+	StartedAt        sql.NullTime `json:"started_at"`
+	CompletedAt      sql.NullTime `json:"completed_at"`
+	CreatedAt        time.Time    `json:"created_at"`
+	SpawnedAt        sql.NullTime `json:"spawned_at"`
+	SpawnStatus      string       `json:"spawn_status"`
+	FailureKind      string       `json:"failure_kind"`
+}
+
+func (q *Queries) GetTasksForBead(ctx context.Context, arg GetTasksForBeadParams) ([]GetTasksForBeadRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTasksForBead, arg.WorkID, arg.BeadID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTasksForBeadRow{}
+	for rows.Next() {
+		var i GetTasksForBeadRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Status,
+			&i.TaskType,
+			&i.ComplexityBudget,
+			&i.ActualComplexity,
+			&i.WorkID,
+			&i.WorktreePath,
+			&i.PrUrl,
+			&i.ErrorMessage,
+			&i.StartedAt,
+			&i.CompletedAt,
+			&i.CreatedAt,
+			&i.SpawnedAt,
+			&i.SpawnStatus,
+			&i.FailureKind,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTasksWithActivity = `-- name: GetTasksWithActivity :many
 SELECT id, status,
        COALESCE(task_type, 'implement') as task_type,
@@ -525,7 +737,8 @@ SELECT id, status,
        completed_at,
        created_at,
        spawned_at,
-       spawn_status
+       spawn_status,
+       failure_kind
 FROM tasks
 ORDER BY created_at DESC
 `
@@ -545,6 +758,7 @@ type ListTasksRow struct {
 	CreatedAt        time.Time    `json:"created_at"`
 	SpawnedAt        sql.NullTime `json:"spawned_at"`
 	SpawnStatus      string       `json:"spawn_status"`
+	FailureKind      string       `json:"failure_kind"`
 }
 
 func (q *Queries) ListTasks(ctx context.Context) ([]ListTasksRow, error) {
@@ -571,6 +785,7 @@ func (q *Queries) ListTasks(ctx context.Context) ([]ListTasksRow, error) {
 			&i.CreatedAt,
 			&i.SpawnedAt,
 			&i.SpawnStatus,
+			&i.FailureKind,
 		); err != nil {
 			return nil, err
 		}
@@ -598,7 +813,8 @@ SELECT id, status,
        completed_at,
        created_at,
        spawned_at,
-       spawn_status
+       spawn_status,
+       failure_kind
 FROM tasks
 WHERE status = ?
 ORDER BY created_at DESC
@@ -619,6 +835,7 @@ type ListTasksByStatusRow struct {
 	CreatedAt        time.Time    `json:"created_at"`
 	SpawnedAt        sql.NullTime `json:"spawned_at"`
 	SpawnStatus      string       `json:"spawn_status"`
+	FailureKind      string       `json:"failure_kind"`
 }
 
 func (q *Queries) ListTasksByStatus(ctx context.Context, status string) ([]ListTasksByStatusRow, error) {
@@ -645,6 +862,7 @@ func (q *Queries) ListTasksByStatus(ctx context.Context, status string) ([]ListT
 			&i.CreatedAt,
 			&i.SpawnedAt,
 			&i.SpawnStatus,
+			&i.FailureKind,
 		); err != nil {
 			return nil, err
 		}
@@ -696,7 +914,10 @@ const resetTaskStatus = `-- name: ResetTaskStatus :execrows
 UPDATE tasks
 SET status = 'pending',
     started_at = NULL,
-    error_message = ''
+    error_message = '',
+    failure_kind = '',
+    claimed_by = '',
+    claimed_at = NULL
 WHERE id = ?
 `
 
@@ -173,7 +173,15 @@ SELECT id, status,
        last_pr_poll_at,
        has_unseen_pr_changes,
        pr_state,
-       mergeable_state
+       mergeable_state,
+       paused,
+       scheduled_run_at,
+       max_parallel_tasks,
+       notes,
+       env,
+       auto_pr,
+       setup_warnings,
+       remote_host
 FROM works
 WHERE id = ?
 `
@@ -204,6 +212,14 @@ func (q *Queries) GetWork(ctx context.Context, id string) (Work, error) {
 		&i.HasUnseenPrChanges,
 		&i.PrState,
 		&i.MergeableState,
+		&i.Paused,
+		&i.ScheduledRunAt,
+		&i.MaxParallelTasks,
+		&i.Notes,
+		&i.Env,
+		&i.AutoPr,
+		&i.SetupWarnings,
+		&i.RemoteHost,
 	)
 	return i, err
 }
@@ -229,7 +245,15 @@ SELECT id, status,
        last_pr_poll_at,
        has_unseen_pr_changes,
        pr_state,
-       mergeable_state
+       mergeable_state,
+       paused,
+       scheduled_run_at,
+       max_parallel_tasks,
+       notes,
+       env,
+       auto_pr,
+       setup_warnings,
+       remote_host
 FROM works
 WHERE worktree_path LIKE ?
 LIMIT 1
@@ -261,6 +285,14 @@ func (q *Queries) GetWorkByDirectory(ctx context.Context, worktreePath string) (
 		&i.HasUnseenPrChanges,
 		&i.PrState,
 		&i.MergeableState,
+		&i.Paused,
+		&i.ScheduledRunAt,
+		&i.MaxParallelTasks,
+		&i.Notes,
+		&i.Env,
+		&i.AutoPr,
+		&i.SetupWarnings,
+		&i.RemoteHost,
 	)
 	return i, err
 }
@@ -278,7 +310,8 @@ SELECT t.id, t.status,
        t.completed_at,
        t.created_at,
        t.spawned_at,
-       t.spawn_status
+       t.spawn_status,
+       t.failure_kind
 FROM tasks t
 JOIN work_tasks wt ON t.id = wt.task_id
 WHERE wt.work_id = ?
@@ -300,6 +333,7 @@ type GetWorkTasksRow struct {
 	CreatedAt        time.Time    `json:"created_at"`
 	SpawnedAt        sql.NullTime `json:"spawned_at"`
 	SpawnStatus      string       `json:"spawn_status"`
+	FailureKind      string       `json:"failure_kind"`
 }
 
 func (q *Queries) GetWorkTasks(ctx context.Context, workID string) ([]GetWorkTasksRow, error) {
@@ -326,6 +360,7 @@ func (q *Queries) GetWorkTasks(ctx context.Context, workID string) ([]GetWorkTas
 			&i.CreatedAt,
 			&i.SpawnedAt,
 			&i.SpawnStatus,
+			&i.FailureKind,
 		); err != nil {
 			return nil, err
 		}
@@ -340,6 +375,35 @@ func (q *Queries) GetWorkTasks(ctx context.Context, workID string) ([]GetWorkTas
 	return items, nil
 }
 
+const getWorksDueToRun = `-- name: GetWorksDueToRun :many
+SELECT id FROM works
+WHERE scheduled_run_at IS NOT NULL AND scheduled_run_at <= ?
+ORDER BY scheduled_run_at, created_at
+`
+
+func (q *Queries) GetWorksDueToRun(ctx context.Context, scheduledRunAt sql.NullTime) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getWorksDueToRun, scheduledRunAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getWorksWithPRs = `-- name: GetWorksWithPRs :many
 SELECT id, status,
        name,
@@ -361,7 +425,15 @@ SELECT id, status,
        last_pr_poll_at,
        has_unseen_pr_changes,
        pr_state,
-       mergeable_state
+       mergeable_state,
+       paused,
+       scheduled_run_at,
+       max_parallel_tasks,
+       notes,
+       env,
+       auto_pr,
+       setup_warnings,
+       remote_host
 FROM works
 WHERE pr_url != ''
 ORDER BY created_at DESC
@@ -399,6 +471,14 @@ func (q *Queries) GetWorksWithPRs(ctx context.Context) ([]Work, error) {
 			&i.HasUnseenPrChanges,
 			&i.PrState,
 			&i.MergeableState,
+			&i.Paused,
+			&i.ScheduledRunAt,
+			&i.MaxParallelTasks,
+			&i.Notes,
+			&i.Env,
+			&i.AutoPr,
+			&i.SetupWarnings,
+			&i.RemoteHost,
 		); err != nil {
 			return nil, err
 		}
@@ -434,7 +514,15 @@ SELECT id, status,
        last_pr_poll_at,
        has_unseen_pr_changes,
        pr_state,
-       mergeable_state
+       mergeable_state,
+       paused,
+       scheduled_run_at,
+       max_parallel_tasks,
+       notes,
+       env,
+       auto_pr,
+       setup_warnings,
+       remote_host
 FROM works
 WHERE has_unseen_pr_changes = TRUE
 ORDER BY created_at DESC
@@ -472,6 +560,14 @@ func (q *Queries) GetWorksWithUnseenChanges(ctx context.Context) ([]Work, error)
 			&i.HasUnseenPrChanges,
 			&i.PrState,
 			&i.MergeableState,
+			&i.Paused,
+			&i.ScheduledRunAt,
+			&i.MaxParallelTasks,
+			&i.Notes,
+			&i.Env,
+			&i.AutoPr,
+			&i.SetupWarnings,
+			&i.RemoteHost,
 		); err != nil {
 			return nil, err
 		}
@@ -552,7 +648,15 @@ SELECT id, status,
        last_pr_poll_at,
        has_unseen_pr_changes,
        pr_state,
-       mergeable_state
+       mergeable_state,
+       paused,
+       scheduled_run_at,
+       max_parallel_tasks,
+       notes,
+       env,
+       auto_pr,
+       setup_warnings,
+       remote_host
 FROM works
 ORDER BY created_at DESC
 `
@@ -589,6 +693,14 @@ func (q *Queries) ListWorks(ctx context.Context) ([]Work, error) {
 			&i.HasUnseenPrChanges,
 			&i.PrState,
 			&i.MergeableState,
+			&i.Paused,
+			&i.ScheduledRunAt,
+			&i.MaxParallelTasks,
+			&i.Notes,
+			&i.Env,
+			&i.AutoPr,
+			&i.SetupWarnings,
+			&i.RemoteHost,
 		); err != nil {
 			return nil, err
 		}
@@ -624,7 +736,15 @@ SELECT id, status,
        last_pr_poll_at,
        has_unseen_pr_changes,
        pr_state,
-       mergeable_state
+       mergeable_state,
+       paused,
+       scheduled_run_at,
+       max_parallel_tasks,
+       notes,
+       env,
+       auto_pr,
+       setup_warnings,
+       remote_host
 FROM works
 WHERE status = ?
 ORDER BY created_at DESC
@@ -662,6 +782,14 @@ func (q *Queries) ListWorksByStatus(ctx context.Context, status string) ([]Work,
 			&i.HasUnseenPrChanges,
 			&i.PrState,
 			&i.MergeableState,
+			&i.Paused,
+			&i.ScheduledRunAt,
+			&i.MaxParallelTasks,
+			&i.Notes,
+			&i.Env,
+			&i.AutoPr,
+			&i.SetupWarnings,
+			&i.RemoteHost,
 		); err != nil {
 			return nil, err
 		}
@@ -740,6 +868,82 @@ func (q *Queries) ResumeWork(ctx context.Context, id string) (int64, error) {
 	return result.RowsAffected()
 }
 
+const setWorkEnv = `-- name: SetWorkEnv :execrows
+UPDATE works
+SET env = ?
+WHERE id = ?
+`
+
+type SetWorkEnvParams struct {
+	Env string `json:"env"`
+	ID  string `json:"id"`
+}
+
+func (q *Queries) SetWorkEnv(ctx context.Context, arg SetWorkEnvParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setWorkEnv, arg.Env, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setWorkAutoPR = `-- name: SetWorkAutoPR :execrows
+UPDATE works
+SET auto_pr = ?
+WHERE id = ?
+`
+
+type SetWorkAutoPRParams struct {
+	AutoPr sql.NullBool `json:"auto_pr"`
+	ID     string       `json:"id"`
+}
+
+func (q *Queries) SetWorkAutoPR(ctx context.Context, arg SetWorkAutoPRParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setWorkAutoPR, arg.AutoPr, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setWorkRemoteHost = `-- name: SetWorkRemoteHost :execrows
+UPDATE works
+SET remote_host = ?
+WHERE id = ?
+`
+
+type SetWorkRemoteHostParams struct {
+	RemoteHost string `json:"remote_host"`
+	ID         string `json:"id"`
+}
+
+func (q *Queries) SetWorkRemoteHost(ctx context.Context, arg SetWorkRemoteHostParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setWorkRemoteHost, arg.RemoteHost, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setWorkSetupWarnings = `-- name: SetWorkSetupWarnings :execrows
+UPDATE works
+SET setup_warnings = ?
+WHERE id = ?
+`
+
+type SetWorkSetupWarningsParams struct {
+	SetupWarnings string `json:"setup_warnings"`
+	ID            string `json:"id"`
+}
+
+func (q *Queries) SetWorkSetupWarnings(ctx context.Context, arg SetWorkSetupWarningsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setWorkSetupWarnings, arg.SetupWarnings, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const setWorkHasUnseenPRChanges = `-- name: SetWorkHasUnseenPRChanges :execrows
 UPDATE works
 SET has_unseen_pr_changes = ?
@@ -759,6 +963,44 @@ func (q *Queries) SetWorkHasUnseenPRChanges(ctx context.Context, arg SetWorkHasU
 	return result.RowsAffected()
 }
 
+const setWorkMaxParallelTasks = `-- name: SetWorkMaxParallelTasks :execrows
+UPDATE works
+SET max_parallel_tasks = ?
+WHERE id = ?
+`
+
+type SetWorkMaxParallelTasksParams struct {
+	MaxParallelTasks int64  `json:"max_parallel_tasks"`
+	ID               string `json:"id"`
+}
+
+func (q *Queries) SetWorkMaxParallelTasks(ctx context.Context, arg SetWorkMaxParallelTasksParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setWorkMaxParallelTasks, arg.MaxParallelTasks, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setWorkNotes = `-- name: SetWorkNotes :execrows
+UPDATE works
+SET notes = ?
+WHERE id = ?
+`
+
+type SetWorkNotesParams struct {
+	Notes string `json:"notes"`
+	ID    string `json:"id"`
+}
+
+func (q *Queries) SetWorkNotes(ctx context.Context, arg SetWorkNotesParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setWorkNotes, arg.Notes, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const setWorkPRURL = `-- name: SetWorkPRURL :execrows
 UPDATE works
 SET pr_url = ?
@@ -778,6 +1020,44 @@ func (q *Queries) SetWorkPRURL(ctx context.Context, arg SetWorkPRURLParams) (int
 	return result.RowsAffected()
 }
 
+const setWorkPaused = `-- name: SetWorkPaused :execrows
+UPDATE works
+SET paused = ?
+WHERE id = ?
+`
+
+type SetWorkPausedParams struct {
+	Paused bool   `json:"paused"`
+	ID     string `json:"id"`
+}
+
+func (q *Queries) SetWorkPaused(ctx context.Context, arg SetWorkPausedParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setWorkPaused, arg.Paused, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const setWorkScheduledRunAt = `-- name: SetWorkScheduledRunAt :execrows
+UPDATE works
+SET scheduled_run_at = ?
+WHERE id = ?
+`
+
+type SetWorkScheduledRunAtParams struct {
+	ScheduledRunAt sql.NullTime `json:"scheduled_run_at"`
+	ID             string       `json:"id"`
+}
+
+func (q *Queries) SetWorkScheduledRunAt(ctx context.Context, arg SetWorkScheduledRunAtParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setWorkScheduledRunAt, arg.ScheduledRunAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const startWork = `-- name: StartWork :execrows
 UPDATE works
 SET status = 'processing',
@@ -0,0 +1,185 @@
+package db
+
+//go:generate moq -stub -out ../testutil/store_mock.go -pkg testutil . Store:StoreMock
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/newhook/co/internal/github"
+)
+
+// Store is the set of tracking database operations used outside this
+// package. *DB implements it; code that holds a Store can be unit tested
+// against testutil.StoreMock instead of a SQLite file.
+type Store interface {
+	// Close closes the underlying database connection.
+	Close() error
+	// QueryContext runs a raw query; the name generator uses it to find names in use.
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+
+	// Bead tracking
+	CompleteBead(ctx context.Context, id, prURL string) error
+	GetBead(ctx context.Context, id string) (*TrackedBead, error)
+	ListBeads(ctx context.Context, statusFilter string) ([]*TrackedBead, error)
+
+	// Complexity estimates
+	CacheComplexity(ctx context.Context, beadID, descHash string, score, tokens int) error
+	GetCachedComplexity(ctx context.Context, beadID, descHash string) (score, tokens int, found bool, err error)
+	AreAllBeadsEstimated(ctx context.Context, beadIDs []string) (bool, error)
+	ListComplexityEstimates(ctx context.Context) (map[string]ComplexityEstimate, error)
+	GetComplexityStats(ctx context.Context) (*ComplexityReport, error)
+
+	// Plan sessions
+	RegisterPlanSession(ctx context.Context, beadID, zellijSession, tabName string, pid int) error
+	UnregisterPlanSession(ctx context.Context, beadID string) error
+	IsPlanSessionRunning(ctx context.Context, beadID string) (bool, error)
+	GetBeadsWithActiveSessions(ctx context.Context, zellijSession string) (map[string]bool, error)
+	GetPlanConversation(ctx context.Context, beadID string) (string, error)
+	SetPlanConversation(ctx context.Context, beadID, sessionID string) error
+	ClearPlanConversation(ctx context.Context, beadID string) error
+	GetBeadsWithPlanConversations(ctx context.Context) (map[string]bool, error)
+
+	// PR feedback
+	CreatePRFeedbackFromParams(ctx context.Context, params CreatePRFeedbackParams) (*PRFeedback, error)
+	MarkFeedbackProcessed(ctx context.Context, feedbackID, beadID string) error
+	GetUnassignedFeedbackBeadIDs(ctx context.Context, workID string) ([]string, error)
+	HasExistingFeedback(ctx context.Context, workID, title string, sourceType github.SourceType, sourceName string) (bool, error)
+	HasExistingFeedbackBySourceID(ctx context.Context, workID, sourceID string) (bool, error)
+	GetFeedbackBySourceID(ctx context.Context, workID, sourceID string) (*PRFeedback, error)
+	GetUnresolvedFeedbackForWork(ctx context.Context, workID string) ([]PRFeedback, error)
+	GetUnresolvedFeedbackForBeads(ctx context.Context, beadIDs []string) ([]PRFeedback, error)
+	MarkFeedbackResolvedAndScheduleTasks(ctx context.Context, feedbackID string, tasks []ScheduledTaskParams) error
+
+	// Process heartbeats
+	RegisterProcess(ctx context.Context, id, processType string, workID *string, pid int) error
+	UpdateHeartbeatWithTime(ctx context.Context, id string, t time.Time) error
+	SetProcessCurrentTask(ctx context.Context, id, taskID string) error
+	IsOrchestratorAlive(ctx context.Context, workID string, threshold time.Duration) (bool, error)
+	GetOrchestratorHealth(ctx context.Context, workID string, threshold time.Duration) (OrchestratorHealth, *Process, error)
+	IsControlPlaneAlive(ctx context.Context, threshold time.Duration) (bool, error)
+	GetStaleProcesses(ctx context.Context, threshold time.Duration) ([]*Process, error)
+	CleanupStaleProcesses(ctx context.Context, threshold time.Duration) error
+	UnregisterProcess(ctx context.Context, id string) error
+	CleanupStaleControlPlane(ctx context.Context) error
+	CleanupStaleOrchestrator(ctx context.Context, workID string) error
+	GetOrchestratorProcess(ctx context.Context, workID string) (*Process, error)
+	GetControlPlaneProcess(ctx context.Context) (*Process, error)
+	GetAllProcesses(ctx context.Context) ([]*Process, error)
+
+	// Scheduled tasks
+	ScheduleTask(ctx context.Context, workID string, taskType string, scheduledAt time.Time, metadata map[string]string) (*ScheduledTask, error)
+	GetNextScheduledTask(ctx context.Context) (*ScheduledTask, error)
+	GetScheduledTasksForWork(ctx context.Context, workID string) ([]*ScheduledTask, error)
+	MarkTaskExecuting(ctx context.Context, taskID string) error
+	MarkTaskCompleted(ctx context.Context, taskID string) error
+	MarkTaskFailed(ctx context.Context, taskID string, errorMessage string) error
+	ScheduleOrUpdateTask(ctx context.Context, workID string, taskType string, scheduledAt time.Time) (*ScheduledTask, error)
+	TriggerTaskNow(ctx context.Context, workID string, taskType string) (*ScheduledTask, error)
+	ScheduleTaskWithRetry(ctx context.Context, workID, taskType string, scheduledAt time.Time, metadata map[string]string, idempotencyKey string, maxAttempts int) error
+	RescheduleWithBackoff(ctx context.Context, taskID string, errorMessage string) error
+	MarkTaskCompletedByIdempotencyKey(ctx context.Context, idempotencyKey string) error
+	ResetExecutingTasksToPending(ctx context.Context) (int64, error)
+	GetTaskByIdempotencyKey(ctx context.Context, idempotencyKey string) (*ScheduledTask, error)
+
+	// Tasks
+	CreateTask(ctx context.Context, id string, taskType string, beadIDs []string, complexityBudget int, workID string) error
+	CreateDependentTask(ctx context.Context, id string, taskType string, beadIDs []string, complexityBudget int, workID string, dependsOn []string, metadata map[string]string) error
+	StartTask(ctx context.Context, id string, worktreePath string) error
+	CompleteTask(ctx context.Context, id string, prURL string) error
+	FailTask(ctx context.Context, id string, errorMessage string) error
+	FailTaskWithKind(ctx context.Context, id string, kind string, errorMessage string) error
+	ResetTaskStatus(ctx context.Context, taskID string) error
+	ClaimTask(ctx context.Context, taskID, claimant, from string) (bool, error)
+	GetTaskClaimant(ctx context.Context, taskID string) (string, error)
+	GetTaskClaimedAt(ctx context.Context, taskID string) (*time.Time, error)
+	GetTask(ctx context.Context, id string) (*Task, error)
+	GetTaskBeads(ctx context.Context, taskID string) ([]string, error)
+	GetTaskForBead(ctx context.Context, beadID string) (string, error)
+	GetTasksForBead(ctx context.Context, workID, beadID string) ([]*Task, error)
+	RemoveTaskBead(ctx context.Context, taskID, beadID string) error
+	CompleteTaskBead(ctx context.Context, taskID, beadID string) error
+	GetTaskBeadStatus(ctx context.Context, taskID, beadID string) (string, error)
+	GetTaskBeadsForWork(ctx context.Context, workID string) ([]TaskBeadInfo, error)
+	GetBeadDurations(ctx context.Context, workID string) (map[string]time.Duration, error)
+	ListTasks(ctx context.Context, statusFilter string) ([]*Task, error)
+	DeleteTask(ctx context.Context, taskID string) error
+	ResetTaskBeadStatuses(ctx context.Context, taskID string) error
+	GetTaskBeadsWithStatus(ctx context.Context, taskID string) ([]TaskBeadInfo, error)
+	ResetTaskBeadStatus(ctx context.Context, taskID, beadID string) error
+	UpdateTaskActivity(ctx context.Context, taskID string, timestamp time.Time) error
+	CheckAndCompleteTask(ctx context.Context, taskID string, prURL string) (bool, error)
+	GetPRTaskForWork(ctx context.Context, workID string) (*Task, error)
+
+	// Task runs
+	StartTaskRun(ctx context.Context, taskID, model, settings, claudeVersion string) (int64, error)
+	FinishTaskRun(ctx context.Context, runID int64, status string) error
+	GetTaskRuns(ctx context.Context, taskID string) ([]*TaskRun, error)
+	GetTaskRunsForWork(ctx context.Context, workID string) (map[string][]*TaskRun, error)
+
+	// Task progress
+	AddTaskStep(ctx context.Context, taskID, step, detail string) error
+	GetTaskSteps(ctx context.Context, taskID string) ([]*TaskStep, error)
+	GetTaskStepsForWork(ctx context.Context, workID string) (map[string][]*TaskStep, error)
+	PruneTaskSteps(ctx context.Context, taskID string, keep int) error
+
+	// Task dependencies
+	AddTaskDependency(ctx context.Context, taskID, dependsOnTaskID string) error
+	GetTaskDependencies(ctx context.Context, taskID string) ([]string, error)
+	GetTaskDependenciesForWork(ctx context.Context, workID string) (map[string][]string, error)
+	GetTaskDependents(ctx context.Context, taskID string) ([]string, error)
+	GetReadyTasksForWork(ctx context.Context, workID string) ([]*Task, error)
+
+	// Task metadata
+	SetTaskMetadata(ctx context.Context, taskID, key, value string) error
+	GetTaskMetadata(ctx context.Context, taskID, key string) (string, error)
+	GetAllTaskMetadata(ctx context.Context, taskID string) (map[string]string, error)
+
+	// Works
+	CreateWork(ctx context.Context, id, name, worktreePath, branchName, baseBranch, rootIssueID string, auto bool) error
+	StartWork(ctx context.Context, id, zellijSession, zellijTab string) error
+	CompleteWork(ctx context.Context, id, prURL string) error
+	FailWork(ctx context.Context, id, errMsg string) error
+	IdleWorkWithPR(ctx context.Context, id, prURL string) error
+	SetWorkPRURLAndScheduleFeedback(ctx context.Context, id, prURL string, prFeedbackInterval, commentResolutionInterval time.Duration) error
+	RestartWork(ctx context.Context, id string) error
+	ResumeWork(ctx context.Context, id string) error
+	UpdateWorkWorktreePath(ctx context.Context, id, worktreePath string) error
+	GetWork(ctx context.Context, id string) (*Work, error)
+	ListWorks(ctx context.Context, statusFilter string) ([]*Work, error)
+	GetWorkTasks(ctx context.Context, workID string) ([]*Task, error)
+	GenerateWorkID(ctx context.Context, branchName string, projectName string) (string, error)
+	GetNextTaskNumber(ctx context.Context, workID string) (int, error)
+	DeleteWork(ctx context.Context, workID string) error
+	UpdateWorkPRStatus(ctx context.Context, id, ciStatus, approvalStatus, approvers, prState, mergeableState string) error
+	MergeWork(ctx context.Context, id string) error
+	SetWorkHasUnseenPRChanges(ctx context.Context, id string, hasChanges bool) error
+	SetWorkPaused(ctx context.Context, id string, paused bool) error
+	SetWorkMaxParallelTasks(ctx context.Context, id string, n int) error
+	SetWorkNotes(ctx context.Context, id, notes string) error
+	SetWorkEnv(ctx context.Context, id string, env []string) error
+	SetWorkAutoPR(ctx context.Context, id string, autoPR *bool) error
+	SetWorkRemoteHost(ctx context.Context, id, host string) error
+	SetWorkSetupWarnings(ctx context.Context, id string, warnings []string) error
+	SetWorkScheduledRunAt(ctx context.Context, id string, at *time.Time) error
+	GetWorksDueToRun(ctx context.Context, now time.Time) ([]string, error)
+	MarkWorkPRSeen(ctx context.Context, id string) error
+	AddBeadToWork(ctx context.Context, workID, beadID string) error
+	ReconcileWorkStatus(ctx context.Context, workID string) (*WorkStatusCorrection, error)
+	ReconcileWorkStatuses(ctx context.Context) ([]*WorkStatusCorrection, error)
+
+	// Work beads
+	AddWorkBeads(ctx context.Context, workID string, beadIDs []string) error
+	RemoveWorkBead(ctx context.Context, workID, beadID string) error
+	MoveWorkBead(ctx context.Context, fromWorkID, toWorkID, beadID string) error
+	TransferBeadAssignments(ctx context.Context, fromBeadID, toBeadID string) error
+	GetWorkBeads(ctx context.Context, workID string) ([]*WorkBead, error)
+	GetUnassignedWorkBeads(ctx context.Context, workID string) ([]*WorkBead, error)
+	IsBeadInTask(ctx context.Context, workID, beadID string) (bool, error)
+	GetAllAssignedBeads(ctx context.Context) (map[string]string, error)
+	GetActiveWorksForBeads(ctx context.Context, beadIDs []string) (map[string]string, error)
+}
+
+// Compile-time check that DB implements Store.
+var _ Store = (*DB)(nil)
@@ -5,8 +5,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/newhook/co/internal/coerrors"
 	"github.com/newhook/co/internal/db/sqlc"
 )
 
@@ -24,6 +28,7 @@ func taskRowToLocal(t *sqlc.GetTaskRow) *Task {
 		ErrorMessage:     t.ErrorMessage,
 		CreatedAt:        t.CreatedAt,
 		SpawnStatus:      t.SpawnStatus,
+		FailureKind:      t.FailureKind,
 	}
 	if t.StartedAt.Valid {
 		task.StartedAt = &t.StartedAt.Time
@@ -41,7 +46,7 @@ func taskRowToLocal(t *sqlc.GetTaskRow) *Task {
 func listTaskRowToLocal(id string, status string, taskType string, complexityBudget int64, actualComplexity int64,
 	workID string, worktreePath string, prURL string, errorMessage string,
 	startedAt sql.NullTime, completedAt sql.NullTime, createdAt time.Time,
-	spawnedAt sql.NullTime, spawnStatus string) *Task {
+	spawnedAt sql.NullTime, spawnStatus string, failureKind string) *Task {
 
 	task := &Task{
 		ID:               id,
@@ -55,6 +60,7 @@ func listTaskRowToLocal(id string, status string, taskType string, complexityBud
 		ErrorMessage:     errorMessage,
 		CreatedAt:        createdAt,
 		SpawnStatus:      spawnStatus,
+		FailureKind:      failureKind,
 	}
 	if startedAt.Valid {
 		task.StartedAt = &startedAt.Time
@@ -84,6 +90,7 @@ type Task struct {
 	CreatedAt        time.Time
 	SpawnedAt        *time.Time
 	SpawnStatus      string
+	FailureKind      string // Why the task failed (Failure* constants), if it did
 }
 
 // TaskBead represents a bead within a task.
@@ -95,6 +102,14 @@ type TaskBead struct {
 
 // CreateTask creates a new task with the given beads.
 func (db *DB) CreateTask(ctx context.Context, id string, taskType string, beadIDs []string, complexityBudget int, workID string) error {
+	return db.CreateDependentTask(ctx, id, taskType, beadIDs, complexityBudget, workID, nil, nil)
+}
+
+// CreateDependentTask creates a task as CreateTask does, along with the tasks
+// it waits for and its metadata, in one transaction. A task that must wait is
+// never left in the database without its dependencies, where the orchestrator
+// could run it early.
+func (db *DB) CreateDependentTask(ctx context.Context, id string, taskType string, beadIDs []string, complexityBudget int, workID string, dependsOn []string, metadata map[string]string) error {
 	// Use a transaction for atomicity
 	tx, err := db.Begin()
 	if err != nil {
@@ -144,6 +159,26 @@ func (db *DB) CreateTask(ctx context.Context, id string, taskType string, beadID
 		}
 	}
 
+	for _, dep := range dependsOn {
+		err = qtx.AddTaskDependency(ctx, sqlc.AddTaskDependencyParams{
+			TaskID:          id,
+			DependsOnTaskID: dep,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add task dependency %s -> %s: %w", id, dep, err)
+		}
+	}
+	for key, value := range metadata {
+		err = qtx.SetTaskMetadata(ctx, sqlc.SetTaskMetadataParams{
+			TaskID: id,
+			Key:    key,
+			Value:  value,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set metadata %s for task %s: %w", key, id, err)
+		}
+	}
+
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
@@ -162,11 +197,79 @@ func (db *DB) StartTask(ctx context.Context, id string, worktreePath string) err
 		return fmt.Errorf("failed to start task: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("task %s not found", id)
+		return coerrors.Errorf(coerrors.NotFound, "task %s not found", id)
 	}
 	return nil
 }
 
+// TaskClaimant identifies the current process as a task claimant, as
+// "<hostname>:<pid>".
+func TaskClaimant() string {
+	hostname, _ := os.Hostname()
+	return fmt.Sprintf("%s:%d", hostname, os.Getpid())
+}
+
+// IsClaimantAlive reports whether the process behind a TaskClaimant is still
+// running. Claimants on other hosts can't be checked and are assumed alive.
+func IsClaimantAlive(claimant string) bool {
+	host, pidStr, ok := strings.Cut(claimant, ":")
+	if !ok {
+		return false
+	}
+	if hostname, _ := os.Hostname(); host != hostname {
+		return true
+	}
+	pid, err := strconv.Atoi(pidStr)
+	if err != nil {
+		return false
+	}
+	return isProcessAlive(pid)
+}
+
+// ClaimTask atomically claims a pending task for claimant, provided it is
+// still claimed by from ("" for an unclaimed task). Returns false when the
+// task is no longer pending or another process claimed it first.
+func (db *DB) ClaimTask(ctx context.Context, taskID, claimant, from string) (bool, error) {
+	rows, err := db.queries.ClaimTask(ctx, sqlc.ClaimTaskParams{
+		ClaimedBy:   claimant,
+		ClaimedAt:   sql.NullTime{Time: time.Now(), Valid: true},
+		ID:          taskID,
+		ClaimedBy_2: from,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to claim task %s: %w", taskID, err)
+	}
+	return rows == 1, nil
+}
+
+// GetTaskClaimant returns the claimant of a task, or "" if it is unclaimed.
+func (db *DB) GetTaskClaimant(ctx context.Context, taskID string) (string, error) {
+	claimant, err := db.queries.GetTaskClaimant(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", coerrors.Errorf(coerrors.NotFound, "task %s not found", taskID)
+		}
+		return "", fmt.Errorf("failed to get claimant of task %s: %w", taskID, err)
+	}
+	return claimant, nil
+}
+
+// GetTaskClaimedAt returns when a task was last claimed, or nil if it is
+// unclaimed.
+func (db *DB) GetTaskClaimedAt(ctx context.Context, taskID string) (*time.Time, error) {
+	claimedAt, err := db.queries.GetTaskClaimedAt(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, coerrors.Errorf(coerrors.NotFound, "task %s not found", taskID)
+		}
+		return nil, fmt.Errorf("failed to get claim time of task %s: %w", taskID, err)
+	}
+	if !claimedAt.Valid {
+		return nil, nil
+	}
+	return &claimedAt.Time, nil
+}
+
 // CompleteTask marks a task as completed.
 func (db *DB) CompleteTask(ctx context.Context, id string, prURL string) error {
 	rows, err := db.queries.CompleteTask(ctx, sqlc.CompleteTaskParams{
@@ -178,15 +281,23 @@ func (db *DB) CompleteTask(ctx context.Context, id string, prURL string) error {
 		return fmt.Errorf("failed to complete task: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("task %s not found", id)
+		return coerrors.Errorf(coerrors.NotFound, "task %s not found", id)
 	}
 	return nil
 }
 
-// FailTask marks a task as failed with an error message.
+// FailTask marks a task as failed with an error message, as a failure of the
+// task itself (FailureTaskError).
 func (db *DB) FailTask(ctx context.Context, id string, errorMessage string) error {
+	return db.FailTaskWithKind(ctx, id, FailureTaskError, errorMessage)
+}
+
+// FailTaskWithKind marks a task as failed with an error message and the kind
+// of failure, one of the Failure* constants.
+func (db *DB) FailTaskWithKind(ctx context.Context, id string, kind string, errorMessage string) error {
 	rows, err := db.queries.FailTask(ctx, sqlc.FailTaskParams{
 		ErrorMessage: errorMessage,
+		FailureKind:  kind,
 		CompletedAt:  sql.NullTime{Time: time.Now(), Valid: true},
 		ID:           id,
 	})
@@ -194,19 +305,19 @@ func (db *DB) FailTask(ctx context.Context, id string, errorMessage string) erro
 		return fmt.Errorf("failed to fail task: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("task %s not found", id)
+		return coerrors.Errorf(coerrors.NotFound, "task %s not found", id)
 	}
 	return nil
 }
 
-// ResetTaskStatus resets a task status to pending.
+// ResetTaskStatus resets a task status to pending and releases its claim.
 func (db *DB) ResetTaskStatus(ctx context.Context, taskID string) error {
 	rows, err := db.queries.ResetTaskStatus(ctx, taskID)
 	if err != nil {
 		return fmt.Errorf("failed to reset task status: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("task %s not found", taskID)
+		return coerrors.Errorf(coerrors.NotFound, "task %s not found", taskID)
 	}
 	return nil
 }
@@ -244,6 +355,43 @@ func (db *DB) GetTaskForBead(ctx context.Context, beadID string) (string, error)
 	return taskID, nil
 }
 
+// GetTasksForBead returns the tasks of a work that include a bead, in work order.
+func (db *DB) GetTasksForBead(ctx context.Context, workID, beadID string) ([]*Task, error) {
+	tasks, err := db.queries.GetTasksForBead(ctx, sqlc.GetTasksForBeadParams{
+		WorkID: workID,
+		BeadID: beadID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks for bead %s: %w", beadID, err)
+	}
+
+	result := make([]*Task, len(tasks))
+	for i, t := range tasks {
+		result[i] = listTaskRowToLocal(
+			t.ID, t.Status, t.TaskType, t.ComplexityBudget, t.ActualComplexity,
+			t.WorkID, t.WorktreePath, t.PrUrl, t.ErrorMessage,
+			t.StartedAt, t.CompletedAt, t.CreatedAt,
+			t.SpawnedAt, t.SpawnStatus, t.FailureKind,
+		)
+	}
+	return result, nil
+}
+
+// RemoveTaskBead removes a bead from a task.
+func (db *DB) RemoveTaskBead(ctx context.Context, taskID, beadID string) error {
+	rows, err := db.queries.DeleteTaskBead(ctx, sqlc.DeleteTaskBeadParams{
+		TaskID: taskID,
+		BeadID: beadID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove bead %s from task %s: %w", beadID, taskID, err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("bead %s not found in task %s", beadID, taskID)
+	}
+	return nil
+}
+
 // CompleteTaskBead marks a specific bead within a task as completed.
 func (db *DB) CompleteTaskBead(ctx context.Context, taskID, beadID string) error {
 	rows, err := db.queries.CompleteTaskBead(ctx, sqlc.CompleteTaskBeadParams{
@@ -254,7 +402,7 @@ func (db *DB) CompleteTaskBead(ctx context.Context, taskID, beadID string) error
 		return fmt.Errorf("failed to complete task bead: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("task bead %s/%s not found", taskID, beadID)
+		return coerrors.Errorf(coerrors.NotFound, "task bead %s/%s not found", taskID, beadID)
 	}
 	return nil
 }
@@ -269,7 +417,7 @@ func (db *DB) FailTaskBead(ctx context.Context, taskID, beadID string) error {
 		return fmt.Errorf("failed to fail task bead: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("task bead %s/%s not found", taskID, beadID)
+		return coerrors.Errorf(coerrors.NotFound, "task bead %s/%s not found", taskID, beadID)
 	}
 	return nil
 }
@@ -291,7 +439,7 @@ func (db *DB) GetTaskBeadStatus(ctx context.Context, taskID, beadID string) (str
 	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return "", fmt.Errorf("task bead %s/%s not found", taskID, beadID)
+			return "", coerrors.Errorf(coerrors.NotFound, "task bead %s/%s not found", taskID, beadID)
 		}
 		return "", fmt.Errorf("failed to get task bead status: %w", err)
 	}
@@ -322,6 +470,59 @@ func (db *DB) GetTaskBeadsForWork(ctx context.Context, workID string) ([]TaskBea
 	return result, nil
 }
 
+// BeadTaskRun is one bead's membership in a task that has started.
+type BeadTaskRun struct {
+	TaskID      string
+	BeadID      string
+	StartedAt   time.Time
+	CompletedAt *time.Time // Nil while the task is still running
+}
+
+// GetBeadDurations returns how much task run time each bead has taken, across
+// the tasks of workID, or of every work when workID is empty. See
+// SplitTaskDurations for how a task's time is divided among its beads.
+func (db *DB) GetBeadDurations(ctx context.Context, workID string) (map[string]time.Duration, error) {
+	rows, err := db.queries.GetBeadTaskRuns(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bead task runs: %w", err)
+	}
+	runs := make([]BeadTaskRun, 0, len(rows))
+	for _, row := range rows {
+		run := BeadTaskRun{TaskID: row.TaskID, BeadID: row.BeadID, StartedAt: row.StartedAt.Time}
+		if row.CompletedAt.Valid {
+			run.CompletedAt = &row.CompletedAt.Time
+		}
+		runs = append(runs, run)
+	}
+	return SplitTaskDurations(runs, time.Now()), nil
+}
+
+// SplitTaskDurations totals task run time per bead. A task's duration runs
+// from StartedAt to CompletedAt, or to now while it's still running, and is
+// split evenly among the beads in the task: three beads in a 30 minute task
+// are charged 10 minutes each. A task that ran for no time, or whose clock
+// went backwards, charges nothing. Tasks are charged whatever their outcome,
+// since a failed run used the machine as much as a successful one.
+func SplitTaskDurations(runs []BeadTaskRun, now time.Time) map[string]time.Duration {
+	beadsPerTask := make(map[string]int)
+	for _, run := range runs {
+		beadsPerTask[run.TaskID]++
+	}
+	durations := make(map[string]time.Duration)
+	for _, run := range runs {
+		end := now
+		if run.CompletedAt != nil {
+			end = *run.CompletedAt
+		}
+		elapsed := end.Sub(run.StartedAt)
+		if elapsed <= 0 {
+			continue
+		}
+		durations[run.BeadID] += elapsed / time.Duration(beadsPerTask[run.TaskID])
+	}
+	return durations
+}
+
 // ListTasks returns all tasks.
 func (db *DB) ListTasks(ctx context.Context, statusFilter string) ([]*Task, error) {
 	var tasks []*Task
@@ -336,7 +537,7 @@ func (db *DB) ListTasks(ctx context.Context, statusFilter string) ([]*Task, erro
 				row.ID, row.Status, row.TaskType, row.ComplexityBudget, row.ActualComplexity,
 				row.WorkID, row.WorktreePath, row.PrUrl, row.ErrorMessage,
 				row.StartedAt, row.CompletedAt, row.CreatedAt,
-				row.SpawnedAt, row.SpawnStatus,
+				row.SpawnedAt, row.SpawnStatus, row.FailureKind,
 			))
 		}
 	} else {
@@ -349,7 +550,7 @@ func (db *DB) ListTasks(ctx context.Context, statusFilter string) ([]*Task, erro
 				row.ID, row.Status, row.TaskType, row.ComplexityBudget, row.ActualComplexity,
 				row.WorkID, row.WorktreePath, row.PrUrl, row.ErrorMessage,
 				row.StartedAt, row.CompletedAt, row.CreatedAt,
-				row.SpawnedAt, row.SpawnStatus,
+				row.SpawnedAt, row.SpawnStatus, row.FailureKind,
 			))
 		}
 	}
@@ -394,13 +595,25 @@ func (db *DB) DeleteTask(ctx context.Context, taskID string) error {
 		return fmt.Errorf("failed to delete task_beads for task %s: %w", taskID, err)
 	}
 
+	// Delete dependencies in both directions so no task waits on a deleted one
+	if _, err := qtx.DeleteTaskDependencies(ctx, taskID); err != nil {
+		return fmt.Errorf("failed to delete dependencies of task %s: %w", taskID, err)
+	}
+	if _, err := qtx.DeleteTaskDependents(ctx, taskID); err != nil {
+		return fmt.Errorf("failed to delete dependencies on task %s: %w", taskID, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM task_runs WHERE task_id = ?`, taskID); err != nil {
+		return fmt.Errorf("failed to delete runs of task %s: %w", taskID, err)
+	}
+
 	// Delete the task itself
 	rows, err := qtx.DeleteTask(ctx, taskID)
 	if err != nil {
 		return fmt.Errorf("failed to delete task %s: %w", taskID, err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("task %s not found", taskID)
+		return coerrors.Errorf(coerrors.NotFound, "task %s not found", taskID)
 	}
 
 	if err := tx.Commit(); err != nil {
@@ -446,7 +659,7 @@ func (db *DB) ResetTaskBeadStatus(ctx context.Context, taskID, beadID string) er
 		return fmt.Errorf("failed to reset task bead status: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("task bead %s/%s not found", taskID, beadID)
+		return coerrors.Errorf(coerrors.NotFound, "task bead %s/%s not found", taskID, beadID)
 	}
 	return nil
 }
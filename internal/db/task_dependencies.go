@@ -30,6 +30,20 @@ func (db *DB) GetTaskDependencies(ctx context.Context, taskID string) ([]string,
 	return deps, nil
 }
 
+// GetTaskDependenciesForWork returns the dependencies of every task in a work,
+// keyed by task ID. Tasks without dependencies are not included.
+func (db *DB) GetTaskDependenciesForWork(ctx context.Context, workID string) (map[string][]string, error) {
+	rows, err := db.queries.GetTaskDependenciesForWork(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task dependencies for work %s: %w", workID, err)
+	}
+	deps := make(map[string][]string)
+	for _, row := range rows {
+		deps[row.TaskID] = append(deps[row.TaskID], row.DependsOnTaskID)
+	}
+	return deps, nil
+}
+
 // GetTaskDependents returns the IDs of tasks that depend on the given task.
 func (db *DB) GetTaskDependents(ctx context.Context, taskID string) ([]string, error) {
 	deps, err := db.queries.GetTaskDependents(ctx, taskID)
@@ -53,7 +67,7 @@ func (db *DB) GetReadyTasksForWork(ctx context.Context, workID string) ([]*Task,
 			t.ID, t.Status, t.TaskType, t.ComplexityBudget, t.ActualComplexity,
 			t.WorkID, t.WorktreePath, t.PrUrl, t.ErrorMessage,
 			t.StartedAt, t.CompletedAt, t.CreatedAt,
-			t.SpawnedAt, t.SpawnStatus,
+			t.SpawnedAt, t.SpawnStatus, t.FailureKind,
 		)
 	}
 	return result, nil
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// TaskStepRetention is how many steps a finished task keeps; older ones are
+// pruned when its run ends.
+const TaskStepRetention = 20
+
+// TaskStep is a step a running task reported, like "running tests".
+type TaskStep struct {
+	ID        int64
+	TaskID    string
+	Step      string
+	Detail    string // Optional, such as the test command being run
+	CreatedAt time.Time
+}
+
+// AddTaskStep records a step of a task.
+func (db *DB) AddTaskStep(ctx context.Context, taskID, step, detail string) error {
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO task_progress (task_id, step, detail, created_at)
+		VALUES (?, ?, ?, ?)
+	`, taskID, step, detail, time.Now()); err != nil {
+		return fmt.Errorf("failed to record progress of task %s: %w", taskID, err)
+	}
+	return nil
+}
+
+// GetTaskSteps returns the steps of a task, oldest first.
+func (db *DB) GetTaskSteps(ctx context.Context, taskID string) ([]*TaskStep, error) {
+	steps, err := db.queryTaskSteps(ctx, `WHERE task_id = ?`, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get progress of task %s: %w", taskID, err)
+	}
+	return steps, nil
+}
+
+// GetTaskStepsForWork returns the steps of each task of a work, oldest
+// first, keyed by task ID.
+func (db *DB) GetTaskStepsForWork(ctx context.Context, workID string) (map[string][]*TaskStep, error) {
+	steps, err := db.queryTaskSteps(ctx, `WHERE task_id IN (SELECT id FROM tasks WHERE work_id = ?)`, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task progress for work %s: %w", workID, err)
+	}
+	result := make(map[string][]*TaskStep)
+	for _, step := range steps {
+		result[step.TaskID] = append(result[step.TaskID], step)
+	}
+	return result, nil
+}
+
+// PruneTaskSteps deletes all but the latest keep steps of a task.
+func (db *DB) PruneTaskSteps(ctx context.Context, taskID string, keep int) error {
+	if _, err := db.ExecContext(ctx, `
+		DELETE FROM task_progress
+		WHERE task_id = ? AND id NOT IN (
+			SELECT id FROM task_progress WHERE task_id = ? ORDER BY id DESC LIMIT ?
+		)
+	`, taskID, taskID, keep); err != nil {
+		return fmt.Errorf("failed to prune progress of task %s: %w", taskID, err)
+	}
+	return nil
+}
+
+func (db *DB) queryTaskSteps(ctx context.Context, where string, args ...any) ([]*TaskStep, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, task_id, step, detail, created_at
+		FROM task_progress `+where+`
+		ORDER BY id
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var steps []*TaskStep
+	for rows.Next() {
+		var step TaskStep
+		if err := rows.Scan(&step.ID, &step.TaskID, &step.Step, &step.Detail, &step.CreatedAt); err != nil {
+			return nil, err
+		}
+		steps = append(steps, &step)
+	}
+	return steps, rows.Err()
+}
@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskSteps(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+	workID := createTestWork(t, db)
+	require.NoError(t, db.CreateTask(ctx, "task-1", "implement", nil, 0, workID))
+	require.NoError(t, db.CreateTask(ctx, "task-2", "implement", nil, 0, workID))
+
+	require.NoError(t, db.AddTaskStep(ctx, "task-1", "prompt sent", ""))
+	require.NoError(t, db.AddTaskStep(ctx, "task-1", "running tests", "go test ./..."))
+	require.NoError(t, db.AddTaskStep(ctx, "task-2", "prompt sent", ""))
+
+	steps, err := db.GetTaskSteps(ctx, "task-1")
+	require.NoError(t, err)
+	require.Len(t, steps, 2)
+	assert.Equal(t, "prompt sent", steps[0].Step)
+	assert.Equal(t, "running tests", steps[1].Step)
+	assert.Equal(t, "go test ./...", steps[1].Detail)
+	assert.False(t, steps[1].CreatedAt.IsZero())
+
+	byTask, err := db.GetTaskStepsForWork(ctx, workID)
+	require.NoError(t, err)
+	assert.Len(t, byTask["task-1"], 2)
+	assert.Len(t, byTask["task-2"], 1)
+
+	// Pruning keeps the latest steps of that task only
+	for i := range 5 {
+		require.NoError(t, db.AddTaskStep(ctx, "task-1", fmt.Sprintf("step %d", i), ""))
+	}
+	require.NoError(t, db.PruneTaskSteps(ctx, "task-1", 3))
+	steps, err = db.GetTaskSteps(ctx, "task-1")
+	require.NoError(t, err)
+	require.Len(t, steps, 3)
+	assert.Equal(t, "step 2", steps[0].Step)
+	assert.Equal(t, "step 4", steps[2].Step)
+	steps, err = db.GetTaskSteps(ctx, "task-2")
+	require.NoError(t, err)
+	assert.Len(t, steps, 1)
+
+	// Steps go with their task
+	require.NoError(t, db.DeleteTask(ctx, "task-1"))
+	steps, err = db.GetTaskSteps(ctx, "task-1")
+	require.NoError(t, err)
+	assert.Empty(t, steps)
+}
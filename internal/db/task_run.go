@@ -0,0 +1,111 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TaskRun is one attempt at running a task, with the Claude model and
+// settings it ran with.
+type TaskRun struct {
+	ID            int64
+	TaskID        string
+	Model         string // Passed to claude --model; empty for claude's default
+	Settings      string // Other settings that change how Claude works, like its thinking budget
+	ClaudeVersion string // As reported by claude --version
+	Status        string // The task's status when the run ended; processing while it runs
+	StartedAt     time.Time
+	CompletedAt   *time.Time
+}
+
+// StartTaskRun records the start of an attempt at a task and returns its ID
+// for FinishTaskRun.
+func (db *DB) StartTaskRun(ctx context.Context, taskID, model, settings, claudeVersion string) (int64, error) {
+	result, err := db.ExecContext(ctx, `
+		INSERT INTO task_runs (task_id, model, settings, claude_version, status, started_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, taskID, model, settings, claudeVersion, StatusProcessing, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to record run of task %s: %w", taskID, err)
+	}
+	return result.LastInsertId()
+}
+
+// FinishTaskRun records how an attempt at a task ended.
+func (db *DB) FinishTaskRun(ctx context.Context, runID int64, status string) error {
+	if _, err := db.ExecContext(ctx, `
+		UPDATE task_runs SET status = ?, completed_at = ? WHERE id = ?
+	`, status, time.Now(), runID); err != nil {
+		return fmt.Errorf("failed to finish task run %d: %w", runID, err)
+	}
+	return nil
+}
+
+// GetTaskRuns returns the attempts at a task, oldest first.
+func (db *DB) GetTaskRuns(ctx context.Context, taskID string) ([]*TaskRun, error) {
+	runs, err := db.queryTaskRuns(ctx, `WHERE task_id = ?`, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get runs of task %s: %w", taskID, err)
+	}
+	return runs, nil
+}
+
+// GetTaskRunsForWork returns the attempts at each task of a work, oldest
+// first, keyed by task ID.
+func (db *DB) GetTaskRunsForWork(ctx context.Context, workID string) (map[string][]*TaskRun, error) {
+	runs, err := db.queryTaskRuns(ctx, `WHERE task_id IN (SELECT id FROM tasks WHERE work_id = ?)`, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task runs for work %s: %w", workID, err)
+	}
+	result := make(map[string][]*TaskRun)
+	for _, run := range runs {
+		result[run.TaskID] = append(result[run.TaskID], run)
+	}
+	return result, nil
+}
+
+func (db *DB) queryTaskRuns(ctx context.Context, where string, args ...any) ([]*TaskRun, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT id, task_id, model, settings, claude_version, status, started_at, completed_at
+		FROM task_runs `+where+`
+		ORDER BY id
+	`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var runs []*TaskRun
+	for rows.Next() {
+		var run TaskRun
+		var completedAt sql.NullTime
+		if err := rows.Scan(&run.ID, &run.TaskID, &run.Model, &run.Settings, &run.ClaudeVersion,
+			&run.Status, &run.StartedAt, &completedAt); err != nil {
+			return nil, err
+		}
+		if completedAt.Valid {
+			run.CompletedAt = &completedAt.Time
+		}
+		runs = append(runs, &run)
+	}
+	return runs, rows.Err()
+}
+
+// Summary describes the model, CLI version and settings of the run, like
+// "opus, claude 2.0.14, max_thinking_tokens=16000".
+func (r *TaskRun) Summary() string {
+	parts := []string{"default model"}
+	if r.Model != "" {
+		parts[0] = r.Model
+	}
+	if r.ClaudeVersion != "" {
+		parts = append(parts, "claude "+r.ClaudeVersion)
+	}
+	if r.Settings != "" {
+		parts = append(parts, r.Settings)
+	}
+	return strings.Join(parts, ", ")
+}
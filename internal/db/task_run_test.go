@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskRuns(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+	workID := createTestWork(t, db)
+	require.NoError(t, db.CreateTask(ctx, "task-1", "implement", nil, 0, workID))
+
+	// Each attempt gets its own row
+	first, err := db.StartTaskRun(ctx, "task-1", "sonnet", "", "2.0.14")
+	require.NoError(t, err)
+	require.NoError(t, db.FinishTaskRun(ctx, first, StatusFailed))
+	second, err := db.StartTaskRun(ctx, "task-1", "opus", "max_thinking_tokens=16000", "2.0.14")
+	require.NoError(t, err)
+
+	runs, err := db.GetTaskRuns(ctx, "task-1")
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, "sonnet", runs[0].Model)
+	assert.Equal(t, StatusFailed, runs[0].Status)
+	assert.NotNil(t, runs[0].CompletedAt)
+	assert.Equal(t, second, runs[1].ID)
+	assert.Equal(t, "max_thinking_tokens=16000", runs[1].Settings)
+	assert.Equal(t, StatusProcessing, runs[1].Status)
+	assert.Nil(t, runs[1].CompletedAt)
+
+	byTask, err := db.GetTaskRunsForWork(ctx, workID)
+	require.NoError(t, err)
+	assert.Len(t, byTask["task-1"], 2)
+
+	// Runs go with their task
+	require.NoError(t, db.DeleteTask(ctx, "task-1"))
+	runs, err = db.GetTaskRuns(ctx, "task-1")
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+}
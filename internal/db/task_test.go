@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -38,6 +39,33 @@ func TestCreateTask(t *testing.T) {
 	assert.Len(t, beads, 2, "expected 2 beads")
 }
 
+func TestCreateDependentTask(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+	workID := createTestWork(t, db)
+	require.NoError(t, db.CreateTask(ctx, "task-1", "implement", []string{"bead-1"}, 100, workID))
+
+	err := db.CreateDependentTask(ctx, "task-2", "review", nil, 0, workID, []string{"task-1"}, map[string]string{"ci_failures": "lint"})
+	require.NoError(t, err)
+	deps, err := db.GetTaskDependencies(ctx, "task-2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"task-1"}, deps)
+	value, err := db.GetTaskMetadata(ctx, "task-2", "ci_failures")
+	require.NoError(t, err)
+	assert.Equal(t, "lint", value)
+
+	// A dependency that can't be added leaves no task behind
+	err = db.CreateDependentTask(ctx, "task-3", "review", nil, 0, workID, []string{"task-1", "task-1"}, nil)
+	require.Error(t, err)
+	task, err := db.GetTask(ctx, "task-3")
+	require.NoError(t, err)
+	assert.Nil(t, task)
+	tasks, err := db.GetWorkTasks(ctx, workID)
+	require.NoError(t, err)
+	assert.Len(t, tasks, 2)
+}
+
 func TestStartTask(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -107,6 +135,35 @@ func TestFailTask(t *testing.T) {
 	assert.NotNil(t, task.CompletedAt, "expected CompletedAt to be set")
 }
 
+func TestFailTaskWithKind(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+	workID := createTestWork(t, db)
+
+	db.CreateTask(ctx, "task-1", "implement", []string{"bead-1"}, 100, workID)
+	db.StartTask(ctx, "task-1", "")
+	require.NoError(t, db.FailTaskWithKind(ctx, "task-1", FailureRateLimited, "Claude hit a rate limit"))
+
+	task, _ := db.GetTask(ctx, "task-1")
+	assert.Equal(t, StatusFailed, task.Status)
+	assert.Equal(t, FailureRateLimited, task.FailureKind)
+	tasks, err := db.GetWorkTasks(ctx, workID)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, FailureRateLimited, tasks[0].FailureKind)
+
+	// Resetting the task clears the kind along with the error
+	require.NoError(t, db.ResetTaskStatus(ctx, "task-1"))
+	task, _ = db.GetTask(ctx, "task-1")
+	assert.Empty(t, task.FailureKind)
+
+	// A failure the task reports itself is a task error
+	require.NoError(t, db.FailTask(ctx, "task-1", "tests don't pass"))
+	task, _ = db.GetTask(ctx, "task-1")
+	assert.Equal(t, FailureTaskError, task.FailureKind)
+}
+
 func TestFailTaskNotFound(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -340,6 +397,32 @@ func TestAddTaskDependency(t *testing.T) {
 	assert.Equal(t, "task-2", dependents[0])
 }
 
+func TestGetTaskDependenciesForWork(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	require.NoError(t, db.CreateWork(ctx, "work-1", "", "/tmp/worktree", "feat/test", "main", "root-issue-1", false))
+	require.NoError(t, db.CreateWork(ctx, "work-2", "", "/tmp/worktree2", "feat/other", "main", "root-issue-2", false))
+	for _, id := range []string{"task-1", "task-2", "task-3"} {
+		require.NoError(t, db.CreateTask(ctx, id, "implement", nil, 0, "work-1"))
+	}
+	require.NoError(t, db.CreateTask(ctx, "other-1", "implement", nil, 0, "work-2"))
+	require.NoError(t, db.CreateTask(ctx, "other-2", "implement", nil, 0, "work-2"))
+
+	require.NoError(t, db.AddTaskDependency(ctx, "task-3", "task-2"))
+	require.NoError(t, db.AddTaskDependency(ctx, "task-3", "task-1"))
+	require.NoError(t, db.AddTaskDependency(ctx, "task-2", "task-1"))
+	require.NoError(t, db.AddTaskDependency(ctx, "other-2", "other-1"))
+
+	deps, err := db.GetTaskDependenciesForWork(ctx, "work-1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{
+		"task-2": {"task-1"},
+		"task-3": {"task-1", "task-2"},
+	}, deps)
+}
+
 func TestGetReadyTasksForWork(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -687,3 +770,103 @@ func TestGetPRTaskForWork_MultipleWorks(t *testing.T) {
 	require.NoError(t, err)
 	assert.Nil(t, prTask, "expected nil for work-2 which has no PR task")
 }
+
+func TestClaimTask(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+	workID := createTestWork(t, db)
+
+	require.NoError(t, db.CreateTask(ctx, "task-1", "implement", []string{"bead-1"}, 10, workID))
+
+	ok, err := db.ClaimTask(ctx, "task-1", "host:1", "")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// A second unclaimed-only claim loses
+	ok, err = db.ClaimTask(ctx, "task-1", "host:2", "")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// The claim can be handed over by naming its holder
+	ok, err = db.ClaimTask(ctx, "task-1", "host:2", "host:1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	claimant, err := db.GetTaskClaimant(ctx, "task-1")
+	require.NoError(t, err)
+	assert.Equal(t, "host:2", claimant)
+
+	// Resetting the task releases the claim
+	require.NoError(t, db.ResetTaskStatus(ctx, "task-1"))
+	claimant, err = db.GetTaskClaimant(ctx, "task-1")
+	require.NoError(t, err)
+	assert.Empty(t, claimant)
+
+	// Only pending tasks can be claimed
+	require.NoError(t, db.StartTask(ctx, "task-1", ""))
+	ok, err = db.ClaimTask(ctx, "task-1", "host:3", "")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestSplitTaskDurations(t *testing.T) {
+	start := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		ts := start.Add(d)
+		return &ts
+	}
+	runs := []BeadTaskRun{
+		// 30 minutes over three beads: 10 minutes each
+		{TaskID: "t1", BeadID: "a", StartedAt: start, CompletedAt: at(30 * time.Minute)},
+		{TaskID: "t1", BeadID: "b", StartedAt: start, CompletedAt: at(30 * time.Minute)},
+		{TaskID: "t1", BeadID: "c", StartedAt: start, CompletedAt: at(30 * time.Minute)},
+		// A second, single-bead task adds its whole hour to a
+		{TaskID: "t2", BeadID: "a", StartedAt: start, CompletedAt: at(time.Hour)},
+		// A running task counts up to now, split between its two beads
+		{TaskID: "t3", BeadID: "b", StartedAt: *at(2 * time.Hour)},
+		{TaskID: "t3", BeadID: "d", StartedAt: *at(2 * time.Hour)},
+		// A task whose clock went backwards charges nothing
+		{TaskID: "t4", BeadID: "e", StartedAt: *at(time.Hour), CompletedAt: at(0)},
+	}
+
+	got := SplitTaskDurations(runs, start.Add(3*time.Hour))
+	assert.Equal(t, map[string]time.Duration{
+		"a": 70 * time.Minute,
+		"b": 40 * time.Minute,
+		"c": 10 * time.Minute,
+		"d": 30 * time.Minute,
+	}, got)
+	assert.Empty(t, SplitTaskDurations(nil, start))
+}
+
+func TestGetBeadDurations(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+	workID := createTestWork(t, db)
+	require.NoError(t, db.CreateWork(ctx, "other-work", "", "/tmp/other", "feat/other", "main", "", false))
+
+	require.NoError(t, db.CreateTask(ctx, "task-1", "implement", []string{"bead-1", "bead-2"}, 0, workID))
+	require.NoError(t, db.CreateTask(ctx, "task-2", "implement", []string{"bead-1"}, 0, workID))
+	require.NoError(t, db.CreateTask(ctx, "task-3", "implement", []string{"bead-3"}, 0, "other-work"))
+	require.NoError(t, db.CreateTask(ctx, "task-4", "implement", []string{"bead-4"}, 0, workID))
+
+	start := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	setRun := func(taskID string, d time.Duration) {
+		_, err := db.ExecContext(ctx, "UPDATE tasks SET started_at = ?, completed_at = ? WHERE id = ?", start, start.Add(d), taskID)
+		require.NoError(t, err)
+	}
+	setRun("task-1", 20*time.Minute)
+	setRun("task-2", 5*time.Minute)
+	setRun("task-3", time.Hour)
+	// task-4 never started
+
+	durations, err := db.GetBeadDurations(ctx, workID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]time.Duration{"bead-1": 15 * time.Minute, "bead-2": 10 * time.Minute}, durations)
+
+	durations, err = db.GetBeadDurations(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, durations["bead-3"], "every work is included without a work ID")
+	assert.Len(t, durations, 3)
+}
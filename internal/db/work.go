@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/newhook/co/internal/coerrors"
 	"github.com/newhook/co/internal/db/sqlc"
 )
 
@@ -24,6 +25,7 @@ func workToLocal(w *sqlc.Work) *Work {
 		ZellijSession:      w.ZellijSession,
 		ZellijTab:          w.ZellijTab,
 		WorktreePath:       w.WorktreePath,
+		RemoteHost:         w.RemoteHost,
 		BranchName:         w.BranchName,
 		BaseBranch:         w.BaseBranch,
 		RootIssueID:        w.RootIssueID,
@@ -37,6 +39,9 @@ func workToLocal(w *sqlc.Work) *Work {
 		HasUnseenPRChanges: w.HasUnseenPrChanges,
 		PRState:            w.PrState,
 		MergeableState:     w.MergeableState,
+		Paused:             w.Paused,
+		MaxParallelTasks:   int(w.MaxParallelTasks),
+		Notes:              w.Notes,
 	}
 	if w.StartedAt.Valid {
 		work.StartedAt = &w.StartedAt.Time
@@ -47,6 +52,19 @@ func workToLocal(w *sqlc.Work) *Work {
 	if w.LastPrPollAt.Valid {
 		work.LastPRPollAt = &w.LastPrPollAt.Time
 	}
+	if w.ScheduledRunAt.Valid {
+		work.ScheduledRunAt = &w.ScheduledRunAt.Time
+	}
+	if w.Env != "" {
+		// A column that doesn't parse is treated as having no overrides
+		_ = json.Unmarshal([]byte(w.Env), &work.Env)
+	}
+	if w.AutoPr.Valid {
+		work.AutoPR = &w.AutoPr.Bool
+	}
+	if w.SetupWarnings != "" {
+		_ = json.Unmarshal([]byte(w.SetupWarnings), &work.SetupWarnings)
+	}
 	return work
 }
 
@@ -67,13 +85,36 @@ type Work struct {
 	CompletedAt        *time.Time
 	CreatedAt          time.Time
 	Auto               bool
-	CIStatus           string     // pending, success, failure
-	ApprovalStatus     string     // pending, approved, changes_requested
-	Approvers          string     // JSON array of usernames
+	CIStatus           string // pending, success, failure
+	ApprovalStatus     string // pending, approved, changes_requested
+	Approvers          string // JSON array of usernames
 	LastPRPollAt       *time.Time
 	HasUnseenPRChanges bool
-	PRState            string // open, closed, merged
-	MergeableState     string // CLEAN, DIRTY, BLOCKED, BEHIND, DRAFT, UNSTABLE, UNKNOWN
+	PRState            string     // open, closed, merged
+	MergeableState     string     // CLEAN, DIRTY, BLOCKED, BEHIND, DRAFT, UNSTABLE, UNKNOWN
+	Paused             bool       // Orchestrator doesn't claim new tasks while set
+	ScheduledRunAt     *time.Time // Control plane runs the work once this passes
+	MaxParallelTasks   int        // Tasks the orchestrator runs at once; 0 uses [workflow] max_parallel_tasks
+	Notes              string     // Free-form notes kept with the work
+	Env                []string   // KEY=value overrides applied over [hooks] env for the work's sessions
+	AutoPR             *bool      // Overrides [workflow] auto_pr for this work; nil follows the project
+	SetupWarnings      []string   // Problems copying [worktree] copy_files or running post_create
+	RemoteHost         string     // SSH host the worktree is on, whose path WorktreePath is; "" for a local work
+}
+
+// IsRemote reports whether the work's worktree is on a remote host.
+func (w *Work) IsRemote() bool {
+	return w.RemoteHost != ""
+}
+
+// SessionDir returns the local directory the work's orchestrator and task
+// tabs run in: the worktree, or the project root for a remote work, whose
+// worktree isn't on this machine.
+func (w *Work) SessionDir(projectRoot string) string {
+	if w.IsRemote() {
+		return projectRoot
+	}
+	return w.WorktreePath
 }
 
 // CreateWork creates a new work unit.
@@ -200,7 +241,7 @@ func (db *DB) StartWork(ctx context.Context, id, zellijSession, zellijTab string
 		return fmt.Errorf("failed to start work %s: %w", id, err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("work %s not found", id)
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
 	}
 	return nil
 }
@@ -217,7 +258,7 @@ func (db *DB) CompleteWork(ctx context.Context, id, prURL string) error {
 		return fmt.Errorf("failed to complete work %s: %w", id, err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("work %s not found", id)
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
 	}
 	return nil
 }
@@ -245,7 +286,7 @@ func (db *DB) CompleteWorkAndScheduleFeedback(ctx context.Context, id, prURL str
 		return fmt.Errorf("failed to complete work %s: %w", id, err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("work %s not found", id)
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
 	}
 
 	// If PR URL provided, schedule feedback polling tasks (if not already scheduled)
@@ -311,7 +352,7 @@ func (db *DB) FailWork(ctx context.Context, id, errMsg string) error {
 		return fmt.Errorf("failed to mark work %s as failed: %w", id, err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("work %s not found", id)
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
 	}
 	return nil
 }
@@ -474,7 +515,7 @@ func (db *DB) UpdateWorkWorktreePath(ctx context.Context, id, worktreePath strin
 		return fmt.Errorf("failed to update work worktree path: %w", err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("work %s not found", id)
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
 	}
 	return nil
 }
@@ -527,7 +568,7 @@ func (db *DB) GetLastWorkID(ctx context.Context) (string, error) {
 // GetWorkByDirectory returns the work that has a worktree path matching the pattern.
 func (db *DB) GetWorkByDirectory(ctx context.Context, pathPattern string) (*Work, error) {
 	work, err := db.queries.GetWorkByDirectory(ctx, pathPattern)
-  if errors.Is(err, sql.ErrNoRows) {
+	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
 	if err != nil {
@@ -562,7 +603,7 @@ func (db *DB) GetWorkTasks(ctx context.Context, workID string) ([]*Task, error)
 			t.ID, t.Status, t.TaskType, t.ComplexityBudget, t.ActualComplexity,
 			t.WorkID, t.WorktreePath, t.PrUrl, t.ErrorMessage,
 			t.StartedAt, t.CompletedAt, t.CreatedAt,
-			t.SpawnedAt, t.SpawnStatus,
+			t.SpawnedAt, t.SpawnStatus, t.FailureKind,
 		)
 	}
 	return result, nil
@@ -673,7 +714,7 @@ func (db *DB) GetNextTaskNumber(ctx context.Context, workID string) (int, error)
 // DeleteWork deletes a work and all associated records.
 // This includes:
 // - Task beads associations for all tasks in the work
-// - Tasks belonging to the work
+// - Tasks belonging to the work, and their runs
 // - Work-task relationships
 // - Work-bead associations
 // - Scheduled tasks for this work
@@ -698,6 +739,11 @@ func (db *DB) DeleteWork(ctx context.Context, workID string) error {
 		return fmt.Errorf("failed to delete work tasks for work %s: %w", workID, err)
 	}
 
+	// Delete the runs of those tasks
+	if _, err := tx.ExecContext(ctx, `DELETE FROM task_runs WHERE task_id IN (SELECT id FROM tasks WHERE work_id = ?)`, workID); err != nil {
+		return fmt.Errorf("failed to delete task runs for work %s: %w", workID, err)
+	}
+
 	// Delete all tasks belonging to this work
 	if _, err := qtx.DeleteTasksForWork(ctx, workID); err != nil {
 		return fmt.Errorf("failed to delete tasks for work %s: %w", workID, err)
@@ -719,7 +765,7 @@ func (db *DB) DeleteWork(ctx context.Context, workID string) error {
 		return fmt.Errorf("failed to delete work %s: %w", workID, err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("work %s not found", workID)
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
 	}
 
 	// Commit the transaction
@@ -750,7 +796,7 @@ func (db *DB) UpdateWorkPRStatus(ctx context.Context, id, ciStatus, approvalStat
 		return fmt.Errorf("failed to update PR status for work %s: %w", id, err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("work %s not found", id)
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
 	}
 	return nil
 }
@@ -766,7 +812,7 @@ func (db *DB) MergeWork(ctx context.Context, id string) error {
 		return fmt.Errorf("failed to merge work %s: %w", id, err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("work %s not found", id)
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
 	}
 	return nil
 }
@@ -781,11 +827,171 @@ func (db *DB) SetWorkHasUnseenPRChanges(ctx context.Context, id string, hasChang
 		return fmt.Errorf("failed to set unseen PR changes for work %s: %w", id, err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("work %s not found", id)
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
+	}
+	return nil
+}
+
+// SetWorkPaused pauses or resumes a work. A paused work's orchestrator lets
+// the in-flight task finish but doesn't start new ones.
+func (db *DB) SetWorkPaused(ctx context.Context, id string, paused bool) error {
+	rows, err := db.queries.SetWorkPaused(ctx, sqlc.SetWorkPausedParams{
+		Paused: paused,
+		ID:     id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set paused for work %s: %w", id, err)
+	}
+	if rows == 0 {
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
+	}
+	return nil
+}
+
+// SetWorkMaxParallelTasks sets how many tasks a work's orchestrator runs at
+// once. 0 falls back to the project's [workflow] max_parallel_tasks.
+func (db *DB) SetWorkMaxParallelTasks(ctx context.Context, id string, n int) error {
+	rows, err := db.queries.SetWorkMaxParallelTasks(ctx, sqlc.SetWorkMaxParallelTasksParams{
+		MaxParallelTasks: int64(n),
+		ID:               id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set max parallel tasks for work %s: %w", id, err)
+	}
+	if rows == 0 {
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
 	}
 	return nil
 }
 
+// SetWorkAutoPR sets whether the work's orchestrator creates the PR task on
+// its own once all tasks are done. A nil value follows [workflow] auto_pr.
+func (db *DB) SetWorkAutoPR(ctx context.Context, id string, autoPR *bool) error {
+	var value sql.NullBool
+	if autoPR != nil {
+		value = sql.NullBool{Bool: *autoPR, Valid: true}
+	}
+	rows, err := db.queries.SetWorkAutoPR(ctx, sqlc.SetWorkAutoPRParams{
+		AutoPr: value,
+		ID:     id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set auto-PR for work %s: %w", id, err)
+	}
+	if rows == 0 {
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
+	}
+	return nil
+}
+
+// SetWorkRemoteHost marks a work as remote, on the given SSH host. It's set
+// when the work is created, before its worktree is.
+func (db *DB) SetWorkRemoteHost(ctx context.Context, id, host string) error {
+	rows, err := db.queries.SetWorkRemoteHost(ctx, sqlc.SetWorkRemoteHostParams{
+		RemoteHost: host,
+		ID:         id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set remote host for work %s: %w", id, err)
+	}
+	if rows == 0 {
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
+	}
+	return nil
+}
+
+// SetWorkSetupWarnings records the problems setting up the work's worktree.
+// An empty list clears them.
+func (db *DB) SetWorkSetupWarnings(ctx context.Context, id string, warnings []string) error {
+	var encoded string
+	if len(warnings) > 0 {
+		data, err := json.Marshal(warnings)
+		if err != nil {
+			return fmt.Errorf("failed to encode setup warnings for work %s: %w", id, err)
+		}
+		encoded = string(data)
+	}
+	rows, err := db.queries.SetWorkSetupWarnings(ctx, sqlc.SetWorkSetupWarningsParams{
+		SetupWarnings: encoded,
+		ID:            id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set setup warnings for work %s: %w", id, err)
+	}
+	if rows == 0 {
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
+	}
+	return nil
+}
+
+// SetWorkNotes replaces a work's notes. Empty notes clear them.
+func (db *DB) SetWorkNotes(ctx context.Context, id, notes string) error {
+	rows, err := db.queries.SetWorkNotes(ctx, sqlc.SetWorkNotesParams{
+		Notes: notes,
+		ID:    id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set notes for work %s: %w", id, err)
+	}
+	if rows == 0 {
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
+	}
+	return nil
+}
+
+// SetWorkEnv replaces a work's environment overrides. An empty list clears them.
+func (db *DB) SetWorkEnv(ctx context.Context, id string, env []string) error {
+	var encoded string
+	if len(env) > 0 {
+		data, err := json.Marshal(env)
+		if err != nil {
+			return fmt.Errorf("failed to encode env for work %s: %w", id, err)
+		}
+		encoded = string(data)
+	}
+	rows, err := db.queries.SetWorkEnv(ctx, sqlc.SetWorkEnvParams{
+		Env: encoded,
+		ID:  id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set env for work %s: %w", id, err)
+	}
+	if rows == 0 {
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
+	}
+	return nil
+}
+
+// SetWorkScheduledRunAt schedules a work to be run by the control plane once
+// at has passed. A nil at cancels the scheduled run.
+func (db *DB) SetWorkScheduledRunAt(ctx context.Context, id string, at *time.Time) error {
+	var scheduledRunAt sql.NullTime
+	if at != nil {
+		scheduledRunAt = sql.NullTime{Time: *at, Valid: true}
+	}
+	rows, err := db.queries.SetWorkScheduledRunAt(ctx, sqlc.SetWorkScheduledRunAtParams{
+		ScheduledRunAt: scheduledRunAt,
+		ID:             id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set scheduled run for work %s: %w", id, err)
+	}
+	if rows == 0 {
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
+	}
+	return nil
+}
+
+// GetWorksDueToRun returns the IDs of works whose scheduled run time is at or
+// before now, earliest first.
+func (db *DB) GetWorksDueToRun(ctx context.Context, now time.Time) ([]string, error) {
+	ids, err := db.queries.GetWorksDueToRun(ctx, sql.NullTime{Time: now, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get works due to run: %w", err)
+	}
+	return ids, nil
+}
+
 // MarkWorkPRSeen marks the PR changes as seen for a work.
 func (db *DB) MarkWorkPRSeen(ctx context.Context, id string) error {
 	rows, err := db.queries.MarkWorkPRSeen(ctx, id)
@@ -793,7 +999,7 @@ func (db *DB) MarkWorkPRSeen(ctx context.Context, id string) error {
 		return fmt.Errorf("failed to mark PR changes as seen for work %s: %w", id, err)
 	}
 	if rows == 0 {
-		return fmt.Errorf("work %s not found", id)
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", id)
 	}
 	return nil
 }
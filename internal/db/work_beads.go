@@ -2,10 +2,13 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/newhook/co/internal/coerrors"
 	"github.com/newhook/co/internal/db/sqlc"
 )
 
@@ -118,6 +121,108 @@ func (db *DB) RemoveWorkBead(ctx context.Context, workID, beadID string) error {
 	return nil
 }
 
+// MoveWorkBead moves a bead from one work to another in a single transaction,
+// appending it to the target work's beads. A bead that a task of the source
+// work already includes can't be moved.
+func (db *DB) MoveWorkBead(ctx context.Context, fromWorkID, toWorkID, beadID string) error {
+	if fromWorkID == toWorkID {
+		return fmt.Errorf("bead %s is already in work %s", beadID, toWorkID)
+	}
+
+	tx, err := db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	qtx := db.queries.WithTx(tx)
+
+	tasks, err := qtx.GetTasksForBead(ctx, sqlc.GetTasksForBeadParams{
+		WorkID: fromWorkID,
+		BeadID: beadID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get tasks for bead %s: %w", beadID, err)
+	}
+	if len(tasks) > 0 {
+		taskIDs := make([]string, len(tasks))
+		for i, t := range tasks {
+			taskIDs[i] = t.ID
+		}
+		return fmt.Errorf("bead %s cannot be moved: it is in task %s of work %s", beadID, strings.Join(taskIDs, ", "), fromWorkID)
+	}
+
+	if _, err := qtx.GetWork(ctx, toWorkID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return coerrors.Errorf(coerrors.NotFound, "work %s not found", toWorkID)
+		}
+		return fmt.Errorf("failed to get work %s: %w", toWorkID, err)
+	}
+
+	rows, err := qtx.RemoveWorkBead(ctx, sqlc.RemoveWorkBeadParams{
+		WorkID: fromWorkID,
+		BeadID: beadID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove bead %s from work %s: %w", beadID, fromWorkID, err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("bead %s not found in work %s", beadID, fromWorkID)
+	}
+
+	maxPos, err := qtx.GetMaxWorkBeadPosition(ctx, toWorkID)
+	if err != nil {
+		return fmt.Errorf("failed to get max position for work %s: %w", toWorkID, err)
+	}
+	err = qtx.AddWorkBead(ctx, sqlc.AddWorkBeadParams{
+		WorkID:   toWorkID,
+		BeadID:   beadID,
+		Position: maxPos + 1,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add bead %s to work %s: %w", beadID, toWorkID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// TransferBeadAssignments moves every work and task assignment of
+// fromBeadID to toBeadID, as when the first is merged into the second as a
+// duplicate. Where toBeadID is already in the same work or task, or in
+// another active work, fromBeadID's assignment is dropped.
+func (db *DB) TransferBeadAssignments(ctx context.Context, fromBeadID, toBeadID string) error {
+	tx, err := db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"work_beads", "task_beads"} {
+		query := `UPDATE OR IGNORE ` + table + ` SET bead_id = ? WHERE bead_id = ?`
+		args := []any{toBeadID, fromBeadID}
+		if table == "work_beads" {
+			// toBeadID can't join a second active work
+			query += ` AND NOT EXISTS (SELECT 1 FROM work_beads wb JOIN works w ON w.id = wb.work_id
+				WHERE wb.bead_id = ? AND wb.work_id != work_beads.work_id AND w.status NOT IN (?, ?))`
+			args = append(args, toBeadID, StatusCompleted, StatusMerged)
+		}
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to move %s of bead %s: %w", table, fromBeadID, err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+table+` WHERE bead_id = ?`, fromBeadID); err != nil {
+			return fmt.Errorf("failed to remove %s of bead %s: %w", table, fromBeadID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
 // GetWorkBeads returns all beads assigned to a work.
 func (db *DB) GetWorkBeads(ctx context.Context, workID string) ([]*WorkBead, error) {
 	beads, err := db.queries.GetWorkBeads(ctx, workID)
@@ -182,3 +287,41 @@ func (db *DB) GetAllAssignedBeads(ctx context.Context) (map[string]string, error
 	}
 	return result, nil
 }
+
+// GetActiveWorksForBeads returns the active work, one that isn't completed or
+// merged, each of beadIDs is in, keyed by bead ID. Beads in no active work
+// are left out. A bead is in at most one active work at a time.
+func (db *DB) GetActiveWorksForBeads(ctx context.Context, beadIDs []string) (map[string]string, error) {
+	result := make(map[string]string)
+	if len(beadIDs) == 0 {
+		return result, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(beadIDs)), ",")
+	args := make([]any, 0, len(beadIDs)+2)
+	for _, id := range beadIDs {
+		args = append(args, id)
+	}
+	args = append(args, StatusCompleted, StatusMerged)
+	rows, err := db.DB.QueryContext(ctx, `SELECT wb.bead_id, wb.work_id FROM work_beads wb
+		JOIN works w ON w.id = wb.work_id
+		WHERE wb.bead_id IN (`+placeholders+`) AND w.status NOT IN (?, ?)
+		ORDER BY wb.created_at`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active works for beads: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var beadID, workID string
+		if err := rows.Scan(&beadID, &workID); err != nil {
+			return nil, fmt.Errorf("failed to scan bead work: %w", err)
+		}
+		if _, ok := result[beadID]; !ok {
+			result[beadID] = workID
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get active works for beads: %w", err)
+	}
+	return result, nil
+}
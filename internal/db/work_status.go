@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WorkStatusCorrection records a work whose status didn't match its tasks
+// and was set to the status they imply.
+type WorkStatusCorrection struct {
+	WorkID string
+	From   string
+	To     string
+}
+
+// ExpectedWorkStatus returns the status a work's tasks imply, given its
+// current status:
+//
+//   - any task processing: processing
+//   - any task failed, none processing: failed
+//   - every task completed: idle, waiting for more tasks
+//   - no tasks: pending
+//
+// Tasks still pending leave a pending, processing or idle work as it is,
+// since the orchestrator moves it along when it starts them; a failed work
+// whose failures have all been reset goes back to processing. Completed and
+// merged works are never changed: those statuses are set by the user, not by
+// the tasks.
+func ExpectedWorkStatus(current string, tasks []*Task) string {
+	if current == StatusCompleted || current == StatusMerged {
+		return current
+	}
+	var processing, failed, completed int
+	for _, t := range tasks {
+		switch t.Status {
+		case StatusProcessing:
+			processing++
+		case StatusFailed:
+			failed++
+		case StatusCompleted:
+			completed++
+		}
+	}
+	switch {
+	case len(tasks) == 0:
+		return StatusPending
+	case processing > 0:
+		return StatusProcessing
+	case failed > 0:
+		return StatusFailed
+	case completed == len(tasks):
+		return StatusIdle
+	case current == StatusFailed, current == StatusPending && completed > 0:
+		return StatusProcessing
+	default:
+		return current
+	}
+}
+
+// ReconcileWorkStatus sets a work's status to the one its tasks imply, when
+// they differ. It returns the correction made, or nil when the status was
+// already right. The update only applies if the status hasn't changed since
+// it was read, so it never overrides an orchestrator that moved first.
+func (db *DB) ReconcileWorkStatus(ctx context.Context, workID string) (*WorkStatusCorrection, error) {
+	work, err := db.GetWork(ctx, workID)
+	if err != nil {
+		return nil, err
+	}
+	if work == nil {
+		return nil, fmt.Errorf("work %s not found", workID)
+	}
+	tasks, err := db.GetWorkTasks(ctx, workID)
+	if err != nil {
+		return nil, err
+	}
+	expected := ExpectedWorkStatus(work.Status, tasks)
+	if expected == work.Status {
+		return nil, nil
+	}
+
+	var query string
+	args := []any{expected}
+	switch expected {
+	case StatusFailed:
+		failed := 0
+		for _, t := range tasks {
+			if t.Status == StatusFailed {
+				failed++
+			}
+		}
+		query = `UPDATE works SET status = ?, error_message = ?, completed_at = ? WHERE id = ? AND status = ?`
+		args = append(args, fmt.Sprintf("%d task(s) failed", failed), nullTime(time.Now()))
+	case StatusProcessing:
+		query = `UPDATE works SET status = ?, error_message = '', started_at = COALESCE(started_at, ?) WHERE id = ? AND status = ?`
+		args = append(args, nullTime(time.Now()))
+	case StatusIdle:
+		// As when the orchestrator goes idle, a PR opened by a task is recorded
+		var prURL string
+		for _, t := range tasks {
+			if t.TaskType == TaskTypePR && t.Status == StatusCompleted && t.PRURL != "" {
+				prURL = t.PRURL
+				break
+			}
+		}
+		query = `UPDATE works SET status = ?, error_message = '', pr_url = CASE WHEN pr_url = '' THEN ? ELSE pr_url END WHERE id = ? AND status = ?`
+		args = append(args, prURL)
+	default:
+		query = `UPDATE works SET status = ?, error_message = '' WHERE id = ? AND status = ?`
+	}
+	args = append(args, workID, work.Status)
+	result, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to correct status of work %s: %w", workID, err)
+	}
+	if rows, err := result.RowsAffected(); err != nil || rows == 0 {
+		// Something else changed the status first
+		return nil, err
+	}
+	return &WorkStatusCorrection{WorkID: workID, From: work.Status, To: expected}, nil
+}
+
+// ReconcileWorkStatuses reconciles every work that isn't completed or
+// merged, returning the corrections made.
+func (db *DB) ReconcileWorkStatuses(ctx context.Context) ([]*WorkStatusCorrection, error) {
+	works, err := db.ListWorks(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	var corrections []*WorkStatusCorrection
+	for _, work := range works {
+		if work.Status == StatusCompleted || work.Status == StatusMerged {
+			continue
+		}
+		correction, err := db.ReconcileWorkStatus(ctx, work.ID)
+		if err != nil {
+			return corrections, err
+		}
+		if correction != nil {
+			corrections = append(corrections, correction)
+		}
+	}
+	return corrections, nil
+}
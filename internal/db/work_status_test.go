@@ -0,0 +1,98 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectedWorkStatus(t *testing.T) {
+	tasks := func(statuses ...string) []*Task {
+		var out []*Task
+		for _, s := range statuses {
+			out = append(out, &Task{Status: s})
+		}
+		return out
+	}
+
+	tests := []struct {
+		name    string
+		current string
+		tasks   []*Task
+		want    string
+	}{
+		{"no tasks", StatusProcessing, nil, StatusPending},
+		{"task processing on a pending work", StatusPending, tasks(StatusCompleted, StatusProcessing), StatusProcessing},
+		{"task processing on an idle work", StatusIdle, tasks(StatusProcessing), StatusProcessing},
+		{"failure with nothing processing", StatusProcessing, tasks(StatusCompleted, StatusFailed, StatusPending), StatusFailed},
+		{"failure while another task processes", StatusFailed, tasks(StatusFailed, StatusProcessing), StatusProcessing},
+		{"all completed", StatusProcessing, tasks(StatusCompleted, StatusCompleted), StatusIdle},
+		{"failures reset", StatusFailed, tasks(StatusCompleted, StatusPending), StatusProcessing},
+		{"started but not marked", StatusPending, tasks(StatusCompleted, StatusPending), StatusProcessing},
+		{"waiting to start", StatusPending, tasks(StatusPending), StatusPending},
+		{"pending tasks on a processing work", StatusProcessing, tasks(StatusPending), StatusProcessing},
+		{"new tasks on an idle work", StatusIdle, tasks(StatusCompleted, StatusPending), StatusIdle},
+		{"completed works are left alone", StatusCompleted, tasks(StatusProcessing), StatusCompleted},
+		{"merged works are left alone", StatusMerged, nil, StatusMerged},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ExpectedWorkStatus(tt.current, tt.tasks))
+		})
+	}
+}
+
+func TestReconcileWorkStatus(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+	workID := createTestWork(t, db)
+
+	require.NoError(t, db.CreateTask(ctx, "task-1", TaskTypeImplement, []string{"bead-1"}, 10, workID))
+	require.NoError(t, db.CreateTask(ctx, "task-2", TaskTypePR, nil, 0, workID))
+	require.NoError(t, db.StartWork(ctx, workID, "", ""))
+
+	correction, err := db.ReconcileWorkStatus(ctx, workID)
+	require.NoError(t, err)
+	require.Nil(t, correction, "pending tasks on a processing work are expected")
+
+	// The orchestrator died after the last task completed
+	require.NoError(t, db.CompleteTask(ctx, "task-1", ""))
+	require.NoError(t, db.CompleteTask(ctx, "task-2", "https://github.com/example/pr/1"))
+	correction, err = db.ReconcileWorkStatus(ctx, workID)
+	require.NoError(t, err)
+	require.Equal(t, &WorkStatusCorrection{WorkID: workID, From: StatusProcessing, To: StatusIdle}, correction)
+	work, err := db.GetWork(ctx, workID)
+	require.NoError(t, err)
+	require.Equal(t, StatusIdle, work.Status)
+	require.Equal(t, "https://github.com/example/pr/1", work.PRURL, "the PR task's URL is recorded")
+
+	correction, err = db.ReconcileWorkStatus(ctx, workID)
+	require.NoError(t, err)
+	require.Nil(t, correction)
+
+	// Completed works keep their status
+	require.NoError(t, db.CompleteWork(ctx, workID, ""))
+	corrections, err := db.ReconcileWorkStatuses(ctx)
+	require.NoError(t, err)
+	require.Empty(t, corrections)
+}
+
+func TestReconcileWorkStatusesFailedWork(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+	workID := createTestWork(t, db)
+
+	require.NoError(t, db.CreateTask(ctx, "task-1", TaskTypeImplement, []string{"bead-1"}, 10, workID))
+	require.NoError(t, db.StartTask(ctx, "task-1", ""))
+	require.NoError(t, db.FailTask(ctx, "task-1", "boom"))
+
+	corrections, err := db.ReconcileWorkStatuses(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []*WorkStatusCorrection{{WorkID: workID, From: StatusPending, To: StatusFailed}}, corrections)
+	work, err := db.GetWork(ctx, workID)
+	require.NoError(t, err)
+	require.Equal(t, "1 task(s) failed", work.ErrorMessage)
+}
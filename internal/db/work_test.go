@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/newhook/co/internal/coerrors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -79,6 +80,7 @@ func TestDeleteWorkNotFound(t *testing.T) {
 	// Try to delete a non-existent work
 	err := db.DeleteWork(ctx, "w-nonexistent")
 	assert.Error(t, err, "Expected error when deleting non-existent work")
+	assert.ErrorIs(t, err, coerrors.NotFound)
 }
 
 func TestAddWorkBeads(t *testing.T) {
@@ -120,6 +122,112 @@ func TestAddWorkBeadsDuplicateError(t *testing.T) {
 	assert.Contains(t, err.Error(), "bead-2")
 }
 
+func TestMoveWorkBead(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	require.NoError(t, db.CreateWork(ctx, "w-from", "", "/tmp/from", "feature/from", "main", "", false))
+	require.NoError(t, db.CreateWork(ctx, "w-to", "", "/tmp/to", "feature/to", "main", "", false))
+	require.NoError(t, db.AddWorkBeads(ctx, "w-from", []string{"bead-1", "bead-2"}))
+	require.NoError(t, db.AddWorkBeads(ctx, "w-to", []string{"bead-3"}))
+
+	require.NoError(t, db.MoveWorkBead(ctx, "w-from", "w-to", "bead-1"))
+
+	from, err := db.GetWorkBeads(ctx, "w-from")
+	require.NoError(t, err)
+	require.Len(t, from, 1)
+	assert.Equal(t, "bead-2", from[0].BeadID)
+	to, err := db.GetWorkBeads(ctx, "w-to")
+	require.NoError(t, err)
+	require.Len(t, to, 2)
+	assert.Equal(t, "bead-1", to[1].BeadID, "moved bead goes last")
+
+	// A bead in one of the source work's tasks stays put
+	require.NoError(t, db.CreateTask(ctx, "w-from.task", "implement", []string{"bead-2"}, 10, "w-from"))
+	err = db.MoveWorkBead(ctx, "w-from", "w-to", "bead-2")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "w-from.task")
+	from, err = db.GetWorkBeads(ctx, "w-from")
+	require.NoError(t, err)
+	assert.Len(t, from, 1)
+
+	// Unknown target and missing bead leave both works unchanged
+	require.Error(t, db.MoveWorkBead(ctx, "w-to", "w-missing", "bead-3"))
+	require.Error(t, db.MoveWorkBead(ctx, "w-from", "w-to", "bead-9"))
+	to, err = db.GetWorkBeads(ctx, "w-to")
+	require.NoError(t, err)
+	assert.Len(t, to, 2)
+}
+
+func TestTransferBeadAssignments(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	require.NoError(t, db.CreateWork(ctx, "w-a", "", "/tmp/a", "feature/a", "main", "", false))
+	require.NoError(t, db.CreateWork(ctx, "w-b", "", "/tmp/b", "feature/b", "main", "", false))
+	require.NoError(t, db.AddWorkBeads(ctx, "w-a", []string{"bead-dup", "bead-2"}))
+	require.NoError(t, db.AddWorkBeads(ctx, "w-b", []string{"bead-dup2", "bead-keep"}))
+	require.NoError(t, db.CreateTask(ctx, "w-a.1", "implement", []string{"bead-dup"}, 10, "w-a"))
+	require.NoError(t, db.CreateTask(ctx, "w-b.1", "implement", []string{"bead-dup2", "bead-keep"}, 10, "w-b"))
+
+	require.NoError(t, db.TransferBeadAssignments(ctx, "bead-dup", "bead-new"))
+	require.NoError(t, db.TransferBeadAssignments(ctx, "bead-dup2", "bead-keep"))
+
+	assigned, err := db.GetAllAssignedBeads(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, assigned, "bead-dup")
+	assert.NotContains(t, assigned, "bead-dup2")
+	a, err := db.GetWorkBeads(ctx, "w-a")
+	require.NoError(t, err)
+	require.Len(t, a, 2)
+	assert.Equal(t, "bead-new", a[0].BeadID, "the survivor takes the duplicate's place")
+	b, err := db.GetWorkBeads(ctx, "w-b")
+	require.NoError(t, err)
+	assert.Len(t, b, 1, "the survivor was already in the work")
+
+	beads, err := db.GetTaskBeads(ctx, "w-a.1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bead-new"}, beads)
+	beads, err = db.GetTaskBeads(ctx, "w-b.1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bead-keep"}, beads)
+
+	// A survivor in another active work stays there alone
+	require.NoError(t, db.TransferBeadAssignments(ctx, "bead-2", "bead-keep"))
+	a, err = db.GetWorkBeads(ctx, "w-a")
+	require.NoError(t, err)
+	require.Len(t, a, 1)
+	assert.Equal(t, "bead-new", a[0].BeadID)
+}
+
+func TestWorkBeadsSingleActiveWork(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	require.NoError(t, db.CreateWork(ctx, "w-a", "", "/tmp/a", "feature/a", "main", "", false))
+	require.NoError(t, db.CreateWork(ctx, "w-b", "", "/tmp/b", "feature/b", "main", "", false))
+	require.NoError(t, db.AddWorkBeads(ctx, "w-a", []string{"bead-1", "bead-2"}))
+
+	owners, err := db.GetActiveWorksForBeads(ctx, []string{"bead-1", "bead-3"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"bead-1": "w-a"}, owners)
+
+	// The schema refuses a second active work
+	err = db.AddWorkBeads(ctx, "w-b", []string{"bead-1"})
+	require.ErrorContains(t, err, "already in another active work")
+	require.Error(t, db.AddWorkBead(ctx, "w-b", "bead-2", 0))
+
+	// Once the first work is done the bead can be worked on again
+	require.NoError(t, db.CompleteWork(ctx, "w-a", ""))
+	owners, err = db.GetActiveWorksForBeads(ctx, []string{"bead-1"})
+	require.NoError(t, err)
+	assert.Empty(t, owners)
+	require.NoError(t, db.AddWorkBeads(ctx, "w-b", []string{"bead-1"}))
+}
+
 func TestAddWorkBeadsEmptyList(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -191,6 +299,137 @@ func TestWorkRootIssueIDEmpty(t *testing.T) {
 	assert.Equal(t, "", work.RootIssueID)
 }
 
+func TestSetWorkNotes(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	err := db.CreateWork(ctx, "w-test", "", "/tmp/tree", "feature/test", "main", "", false)
+	require.NoError(t, err)
+
+	// New works have no notes
+	work, err := db.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Equal(t, "", work.Notes)
+
+	notes := "Waiting on design review\nhttps://example.com/spec"
+	require.NoError(t, db.SetWorkNotes(ctx, "w-test", notes))
+	work, err = db.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Equal(t, notes, work.Notes)
+
+	require.NoError(t, db.SetWorkNotes(ctx, "w-test", ""))
+	work, err = db.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Equal(t, "", work.Notes)
+
+	require.Error(t, db.SetWorkNotes(ctx, "w-missing", notes))
+}
+
+func TestSetWorkSetupWarnings(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	err := db.CreateWork(ctx, "w-test", "", "/tmp/tree", "feature/test", "main", "", false)
+	require.NoError(t, err)
+
+	work, err := db.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Empty(t, work.SetupWarnings)
+
+	warnings := []string{"copy .env: not found", "post_create \"npm install\" failed: exit status 1\nnpm ERR!"}
+	require.NoError(t, db.SetWorkSetupWarnings(ctx, "w-test", warnings))
+	work, err = db.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Equal(t, warnings, work.SetupWarnings)
+
+	require.NoError(t, db.SetWorkSetupWarnings(ctx, "w-test", nil))
+	work, err = db.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Empty(t, work.SetupWarnings)
+
+	require.Error(t, db.SetWorkSetupWarnings(ctx, "w-missing", warnings))
+}
+
+func TestSetWorkEnv(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	err := db.CreateWork(ctx, "w-test", "", "/tmp/tree", "feature/test", "main", "", false)
+	require.NoError(t, err)
+
+	work, err := db.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Empty(t, work.Env)
+
+	env := []string{"DATABASE_URL=postgres://localhost/feature_db", "API_KEY=a=b"}
+	require.NoError(t, db.SetWorkEnv(ctx, "w-test", env))
+	work, err = db.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Equal(t, env, work.Env)
+
+	require.NoError(t, db.SetWorkEnv(ctx, "w-test", nil))
+	work, err = db.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Empty(t, work.Env)
+
+	require.Error(t, db.SetWorkEnv(ctx, "w-missing", env))
+}
+
+func TestSetWorkRemoteHost(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	err := db.CreateWork(ctx, "w-test", "", "", "feature/test", "main", "", false)
+	require.NoError(t, err)
+
+	work, err := db.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.False(t, work.IsRemote())
+
+	require.NoError(t, db.SetWorkRemoteHost(ctx, "w-test", "desktop"))
+	require.NoError(t, db.UpdateWorkWorktreePath(ctx, "w-test", "~/co/proj/w-test/tree"))
+	work, err = db.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.True(t, work.IsRemote())
+	assert.Equal(t, "desktop", work.RemoteHost)
+	// The orchestrator of a remote work runs locally, from the project
+	assert.Equal(t, "/proj", work.SessionDir("/proj"))
+
+	require.Error(t, db.SetWorkRemoteHost(ctx, "w-missing", "desktop"))
+}
+
+func TestSetWorkAutoPR(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	err := db.CreateWork(ctx, "w-test", "", "/tmp/tree", "feature/test", "main", "", false)
+	require.NoError(t, err)
+
+	work, err := db.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Nil(t, work.AutoPR)
+
+	for _, want := range []bool{true, false} {
+		require.NoError(t, db.SetWorkAutoPR(ctx, "w-test", &want))
+		work, err = db.GetWork(ctx, "w-test")
+		require.NoError(t, err)
+		require.NotNil(t, work.AutoPR)
+		assert.Equal(t, want, *work.AutoPR)
+	}
+
+	require.NoError(t, db.SetWorkAutoPR(ctx, "w-test", nil))
+	work, err = db.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Nil(t, work.AutoPR)
+
+	require.ErrorIs(t, db.SetWorkAutoPR(ctx, "w-missing", nil), coerrors.NotFound)
+}
+
 func TestListWorksWithRootIssueID(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
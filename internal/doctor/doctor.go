@@ -0,0 +1,80 @@
+// Package doctor checks that the tools co depends on are installed.
+package doctor
+
+import (
+	"fmt"
+	"io"
+	"os/exec"
+)
+
+// Tool is an external command co runs.
+type Tool struct {
+	Name    string
+	Purpose string
+	ViaMise bool // Installed by 'mise install' in the project, so it may be missing before one exists
+}
+
+// Tools lists the tools co depends on. git and mise are needed to create a
+// project; mise installs the rest from the project's .mise.toml.
+var Tools = []Tool{
+	{Name: "git", Purpose: "worktrees and branches"},
+	{Name: "mise", Purpose: "installs the tools below"},
+	{Name: "bd", Purpose: "beads issue tracking", ViaMise: true},
+	{Name: "claude", Purpose: "runs tasks", ViaMise: true},
+	{Name: "gh", Purpose: "pull requests and CI status", ViaMise: true},
+	{Name: "zellij", Purpose: "tabs for orchestrators and tasks", ViaMise: true},
+}
+
+// Result is the outcome of checking a tool.
+type Result struct {
+	Tool Tool
+	Path string // Where the tool was found; empty when missing
+}
+
+// Found reports whether the tool is in PATH.
+func (r Result) Found() bool {
+	return r.Path != ""
+}
+
+// Check looks each tool up with lookPath (exec.LookPath outside tests).
+func Check(tools []Tool, lookPath func(string) (string, error)) []Result {
+	results := make([]Result, len(tools))
+	for i, tool := range tools {
+		results[i].Tool = tool
+		if path, err := lookPath(tool.Name); err == nil {
+			results[i].Path = path
+		}
+	}
+	return results
+}
+
+// CheckTools checks Tools against the current PATH.
+func CheckTools() []Result {
+	return Check(Tools, exec.LookPath)
+}
+
+// Missing returns the tools that weren't found and mise doesn't install.
+// A project can't be created until they are.
+func Missing(results []Result) []Tool {
+	var missing []Tool
+	for _, r := range results {
+		if !r.Found() && !r.Tool.ViaMise {
+			missing = append(missing, r.Tool)
+		}
+	}
+	return missing
+}
+
+// Report writes one line per result.
+func Report(w io.Writer, results []Result) {
+	for _, r := range results {
+		switch {
+		case r.Found():
+			fmt.Fprintf(w, "  ✓ %-7s %s\n", r.Tool.Name, r.Path)
+		case r.Tool.ViaMise:
+			fmt.Fprintf(w, "  - %-7s not in PATH; 'mise install' in the project installs it (%s)\n", r.Tool.Name, r.Tool.Purpose)
+		default:
+			fmt.Fprintf(w, "  ✗ %-7s not in PATH (%s)\n", r.Tool.Name, r.Tool.Purpose)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package doctor
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	installed := map[string]string{"git": "/usr/bin/git", "bd": "/opt/bin/bd"}
+	lookPath := func(name string) (string, error) {
+		if path, ok := installed[name]; ok {
+			return path, nil
+		}
+		return "", errors.New("not found")
+	}
+
+	results := Check(Tools, lookPath)
+	require.Len(t, results, len(Tools))
+	require.True(t, results[0].Found())
+	require.Equal(t, "/usr/bin/git", results[0].Path)
+
+	// Only tools mise doesn't install block project creation
+	missing := Missing(results)
+	require.Len(t, missing, 1)
+	require.Equal(t, "mise", missing[0].Name)
+
+	var out bytes.Buffer
+	Report(&out, results)
+	require.Contains(t, out.String(), "✓ git")
+	require.Contains(t, out.String(), "✗ mise")
+	require.Contains(t, out.String(), "- zellij")
+}
@@ -14,19 +14,19 @@ import (
 // processPRFeedbackQuiet processes PR feedback without outputting to stdout.
 // This is used by the scheduler to avoid interfering with the TUI.
 // Returns the number of beads created and any error.
-func processPRFeedbackQuiet(ctx context.Context, proj *project.Project, database *db.DB, workID string) (int, error) {
+func processPRFeedbackQuiet(ctx context.Context, proj *project.Project, database db.Store, workID string) (int, error) {
 	return processPRFeedbackInternal(ctx, proj, database, workID, true)
 }
 
 // ProcessPRFeedback processes PR feedback for a work and creates beads.
 // This is an internal function that can be called directly.
 // Returns the number of beads created and any error.
-func ProcessPRFeedback(ctx context.Context, proj *project.Project, database *db.DB, workID string) (int, error) {
+func ProcessPRFeedback(ctx context.Context, proj *project.Project, database db.Store, workID string) (int, error) {
 	return processPRFeedbackInternal(ctx, proj, database, workID, false)
 }
 
 // processPRFeedbackInternal is the actual implementation with output control
-func processPRFeedbackInternal(ctx context.Context, proj *project.Project, database *db.DB, workID string, quiet bool) (int, error) {
+func processPRFeedbackInternal(ctx context.Context, proj *project.Project, database db.Store, workID string, quiet bool) (int, error) {
 	// Get work details
 	work, err := database.GetWork(ctx, workID)
 	if err != nil {
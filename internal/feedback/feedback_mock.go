@@ -20,7 +20,7 @@ var _ Processor = &FeedbackProcessorMock{}
 //
 //		// make and configure a mocked Processor
 //		mockedProcessor := &FeedbackProcessorMock{
-//			ProcessPRFeedbackFunc: func(ctx context.Context, proj *project.Project, database *db.DB, workID string) (int, error) {
+//			ProcessPRFeedbackFunc: func(ctx context.Context, proj *project.Project, database db.Store, workID string) (int, error) {
 //				panic("mock out the ProcessPRFeedback method")
 //			},
 //		}
@@ -31,7 +31,7 @@ var _ Processor = &FeedbackProcessorMock{}
 //	}
 type FeedbackProcessorMock struct {
 	// ProcessPRFeedbackFunc mocks the ProcessPRFeedback method.
-	ProcessPRFeedbackFunc func(ctx context.Context, proj *project.Project, database *db.DB, workID string) (int, error)
+	ProcessPRFeedbackFunc func(ctx context.Context, proj *project.Project, database db.Store, workID string) (int, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -42,7 +42,7 @@ type FeedbackProcessorMock struct {
 			// Proj is the proj argument value.
 			Proj *project.Project
 			// Database is the database argument value.
-			Database *db.DB
+			Database db.Store
 			// WorkID is the workID argument value.
 			WorkID string
 		}
@@ -51,11 +51,11 @@ type FeedbackProcessorMock struct {
 }
 
 // ProcessPRFeedback calls ProcessPRFeedbackFunc.
-func (mock *FeedbackProcessorMock) ProcessPRFeedback(ctx context.Context, proj *project.Project, database *db.DB, workID string) (int, error) {
+func (mock *FeedbackProcessorMock) ProcessPRFeedback(ctx context.Context, proj *project.Project, database db.Store, workID string) (int, error) {
 	callInfo := struct {
 		Ctx      context.Context
 		Proj     *project.Project
-		Database *db.DB
+		Database db.Store
 		WorkID   string
 	}{
 		Ctx:      ctx,
@@ -83,13 +83,13 @@ func (mock *FeedbackProcessorMock) ProcessPRFeedback(ctx context.Context, proj *
 func (mock *FeedbackProcessorMock) ProcessPRFeedbackCalls() []struct {
 	Ctx      context.Context
 	Proj     *project.Project
-	Database *db.DB
+	Database db.Store
 	WorkID   string
 } {
 	var calls []struct {
 		Ctx      context.Context
 		Proj     *project.Project
-		Database *db.DB
+		Database db.Store
 		WorkID   string
 	}
 	mock.lockProcessPRFeedback.RLock()
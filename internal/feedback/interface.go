@@ -14,7 +14,7 @@ import (
 type Processor interface {
 	// ProcessPRFeedback processes PR feedback for a work and creates beads.
 	// Returns the number of beads created and any error.
-	ProcessPRFeedback(ctx context.Context, proj *project.Project, database *db.DB, workID string) (int, error)
+	ProcessPRFeedback(ctx context.Context, proj *project.Project, database db.Store, workID string) (int, error)
 }
 
 // DefaultProcessor implements Processor using the actual feedback processing logic.
@@ -29,6 +29,6 @@ func NewProcessor() Processor {
 }
 
 // ProcessPRFeedback implements Processor.ProcessPRFeedback.
-func (p *DefaultProcessor) ProcessPRFeedback(ctx context.Context, proj *project.Project, database *db.DB, workID string) (int, error) {
+func (p *DefaultProcessor) ProcessPRFeedback(ctx context.Context, proj *project.Project, database db.Store, workID string) (int, error) {
 	return processPRFeedbackQuiet(ctx, proj, database, workID)
 }
@@ -213,7 +213,7 @@ func (p *FeedbackProcessor) createLogAnalysisTask(ctx context.Context, workflow
 	taskID := fmt.Sprintf("%s.%d", p.workID, taskNum)
 
 	// Create the task (no beads - Claude will create them)
-	if err := p.proj.DB.CreateTask(ctx, taskID, "log_analysis", nil, 0, p.workID); err != nil {
+	if err := p.proj.DB.CreateTask(ctx, taskID, db.TaskTypeLogAnalysis, nil, 0, p.workID); err != nil {
 		return "", fmt.Errorf("failed to create log_analysis task: %w", err)
 	}
 
@@ -259,7 +259,7 @@ func (p *FeedbackProcessor) findExistingLogAnalysisTaskByJobID(ctx context.Conte
 	// Check each log_analysis task for matching job_id metadata
 	// Any status counts - same job_id means same CI run, same logs
 	for _, task := range tasks {
-		if task.TaskType != "log_analysis" {
+		if task.TaskType != db.TaskTypeLogAnalysis {
 			continue
 		}
 
@@ -63,7 +63,7 @@ func CheckAndResolveComments(ctx context.Context, proj *project.Project, workID
 // It checks the provided beads and posts resolution comments for any associated
 // unresolved feedback items. Uses the transactional outbox pattern: atomically marks
 // feedback as resolved and schedules comment tasks, then attempts optimistic execution.
-func ResolveFeedbackForBeads(ctx context.Context, database *db.DB, beadClient *beads.Client, workID string, closedBeadIDs []string) error {
+func ResolveFeedbackForBeads(ctx context.Context, database db.Store, beadClient *beads.Client, workID string, closedBeadIDs []string) error {
 	if len(closedBeadIDs) == 0 {
 		return nil
 	}
@@ -107,7 +107,7 @@ func ResolveFeedbackForBeads(ctx context.Context, database *db.DB, beadClient *b
 
 // resolveFeedbackItem handles the resolution of a single feedback item.
 // It schedules GitHub comment/thread resolution tasks and attempts optimistic execution.
-func resolveFeedbackItem(ctx context.Context, database *db.DB, workID string, fb db.PRFeedback, closeReason string) error {
+func resolveFeedbackItem(ctx context.Context, database db.Store, workID string, fb db.PRFeedback, closeReason string) error {
 	// Construct resolution message
 	resolutionMessage := fmt.Sprintf("✅ Resolved in work %s (issue %s)", workID, *fb.BeadID)
 	if closeReason != "" {
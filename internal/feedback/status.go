@@ -163,7 +163,7 @@ func ApproversFromJSON(jsonStr string) []string {
 
 // UpdatePRStatusIfChanged compares the new PR status with the stored status
 // and updates the database if anything changed. Returns true if status changed.
-func UpdatePRStatusIfChanged(ctx context.Context, database *db.DB, work *db.Work, newStatus *PRStatusInfo, quiet bool) bool {
+func UpdatePRStatusIfChanged(ctx context.Context, database db.Store, work *db.Work, newStatus *PRStatusInfo, quiet bool) bool {
 	// Get current approvers from work (stored as JSON)
 	currentApprovers := ApproversFromJSON(work.Approvers)
 
@@ -0,0 +1,194 @@
+// Package fuzzy scores how well a typed query matches a piece of text, in the
+// style of fzf: the query's characters must appear in the text in order, and
+// matches that are contiguous or start at word boundaries score higher.
+// It also scores how alike two texts are word for word, to find duplicates.
+package fuzzy
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Scoring weights, loosely modelled on fzf's v1 algorithm.
+const (
+	scoreMatch        = 16 // Every matched character
+	bonusBoundary     = 8  // Match at the start of the text or after a separator
+	bonusCamelCase    = 7  // Match on an upper case letter after a lower case one
+	bonusConsecutive  = 5  // Match right after the previous matched character
+	bonusFirstChar    = 2  // Multiplier for the boundary bonus of a term's first character
+	penaltyGapStart   = 3  // First skipped character between two matches
+	penaltyGapExtends = 1  // Each further skipped character
+)
+
+// Match is the result of a successful match.
+type Match struct {
+	Score     int
+	Positions []int // Indexes of the matched runes in the text, ascending
+}
+
+// Find matches query against text, ignoring case. Whitespace separates the
+// query into terms which must all match, in any order; the score is the sum of
+// the terms' scores. An exact substring is always preferred to a scattered
+// match. ok is false if a term doesn't match or the query is empty.
+func Find(text, query string) (m Match, ok bool) {
+	terms := strings.Fields(query)
+	if len(terms) == 0 {
+		return Match{}, false
+	}
+	runes := []rune(text)
+	lower := []rune(strings.ToLower(text))
+	if len(lower) != len(runes) {
+		// A few runes change length when lowered; fold them one at a time
+		// so indexes keep lining up with text
+		lower = make([]rune, len(runes))
+		for i, r := range runes {
+			lower[i] = unicode.ToLower(r)
+		}
+	}
+
+	seen := make(map[int]bool)
+	for _, term := range terms {
+		score, positions, ok := matchTerm(runes, lower, []rune(strings.ToLower(term)))
+		if !ok {
+			return Match{}, false
+		}
+		m.Score += score
+		for _, p := range positions {
+			if !seen[p] {
+				seen[p] = true
+				m.Positions = append(m.Positions, p)
+			}
+		}
+	}
+	slices.Sort(m.Positions)
+	return m, true
+}
+
+// Contains reports whether every term of query occurs in text as a substring,
+// ignoring case. It is the cheap check to make before calling Find on long
+// text where scattered matches would mostly be noise.
+func Contains(text, query string) bool {
+	terms := strings.Fields(query)
+	if len(terms) == 0 {
+		return false
+	}
+	text = strings.ToLower(text)
+	for _, term := range terms {
+		if !strings.Contains(text, strings.ToLower(term)) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchTerm finds the best placement of a single lower case term in text
+func matchTerm(runes, lower, term []rune) (int, []int, bool) {
+	if len(term) == 0 || len(term) > len(lower) {
+		return 0, nil, false
+	}
+
+	// Fast path: the best scoring exact occurrence
+	best, bestStart := -1, -1
+	for start := indexRunes(lower, term, 0); start >= 0; start = indexRunes(lower, term, start+1) {
+		if s := scoreRun(runes, start, len(term)); s > best {
+			best, bestStart = s, start
+		}
+	}
+	if bestStart >= 0 {
+		positions := make([]int, len(term))
+		for i := range positions {
+			positions[i] = bestStart + i
+		}
+		return best, positions, true
+	}
+
+	// Forward pass: find where the earliest complete match ends
+	ti, end := 0, -1
+	for i, r := range lower {
+		if r == term[ti] {
+			ti++
+			if ti == len(term) {
+				end = i
+				break
+			}
+		}
+	}
+	if end < 0 {
+		return 0, nil, false
+	}
+
+	// Backward pass from that end, which yields the shortest window that
+	// contains the match
+	positions := make([]int, len(term))
+	ti = len(term) - 1
+	for i := end; i >= 0 && ti >= 0; i-- {
+		if lower[i] == term[ti] {
+			positions[ti] = i
+			ti--
+		}
+	}
+	return score(runes, positions), positions, true
+}
+
+// scoreRun scores n contiguous matched runes starting at start
+func scoreRun(runes []rune, start, n int) int {
+	s := scoreMatch*n + bonusAt(runes, start)*bonusFirstChar
+	for i := start + 1; i < start+n; i++ {
+		s += max(bonusConsecutive, bonusAt(runes, i))
+	}
+	return s
+}
+
+// score scores the matched rune positions, which must be ascending
+func score(runes []rune, positions []int) int {
+	s := 0
+	for i, p := range positions {
+		s += scoreMatch
+		switch {
+		case i == 0:
+			s += bonusAt(runes, p) * bonusFirstChar
+		case p == positions[i-1]+1:
+			s += max(bonusConsecutive, bonusAt(runes, p))
+		default:
+			gap := p - positions[i-1] - 1
+			s += bonusAt(runes, p) - penaltyGapStart - penaltyGapExtends*(gap-1)
+		}
+	}
+	return s
+}
+
+// bonusAt returns the bonus for matching the rune at index i
+func bonusAt(runes []rune, i int) int {
+	if i == 0 {
+		return bonusBoundary
+	}
+	prev, cur := runes[i-1], runes[i]
+	switch {
+	case !unicode.IsLetter(prev) && !unicode.IsDigit(prev):
+		return bonusBoundary
+	case unicode.IsLower(prev) && unicode.IsUpper(cur):
+		return bonusCamelCase
+	case !unicode.IsDigit(prev) && unicode.IsDigit(cur):
+		return bonusCamelCase
+	}
+	return 0
+}
+
+// indexRunes returns the index of the first occurrence of sub in s at or
+// after from, or -1
+func indexRunes(s, sub []rune, from int) int {
+	for i := from; i+len(sub) <= len(s); i++ {
+		if s[i] != sub[0] {
+			continue
+		}
+		j := 1
+		for j < len(sub) && s[i+j] == sub[j] {
+			j++
+		}
+		if j == len(sub) {
+			return i
+		}
+	}
+	return -1
+}
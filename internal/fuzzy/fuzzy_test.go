@@ -0,0 +1,112 @@
+package fuzzy
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		query     string
+		ok        bool
+		positions []int
+	}{
+		{name: "substring", text: "Fix login flow", query: "login", ok: true, positions: []int{4, 5, 6, 7, 8}},
+		{name: "ignores case", text: "Fix Login flow", query: "LOGIN", ok: true, positions: []int{4, 5, 6, 7, 8}},
+		{name: "scattered", text: "Fix login flow", query: "fxfw", ok: true, positions: []int{0, 2, 10, 13}},
+		{name: "out of order", text: "Fix login flow", query: "nigol", ok: false},
+		{name: "missing character", text: "Fix login flow", query: "loginz", ok: false},
+		{name: "empty query", text: "Fix login flow", query: "  ", ok: false},
+		{name: "terms in any order", text: "Fix login flow", query: "flow fix", ok: true, positions: []int{0, 1, 2, 10, 11, 12, 13}},
+		{name: "every term must match", text: "Fix login flow", query: "flow zzz", ok: false},
+		{name: "positions are runes", text: "修复 login 页面", query: "login", ok: true, positions: []int{3, 4, 5, 6, 7}},
+		{name: "tightest window", text: "a xa-c", query: "ac", ok: true, positions: []int{3, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, ok := Find(tt.text, tt.query)
+			require.Equal(t, tt.ok, ok)
+			if tt.ok {
+				require.Equal(t, tt.positions, m.Positions)
+				require.Positive(t, m.Score)
+			}
+		})
+	}
+}
+
+func TestFindPrefersBetterMatches(t *testing.T) {
+	score := func(text, query string) int {
+		t.Helper()
+		m, ok := Find(text, query)
+		require.True(t, ok, "%q should match %q", query, text)
+		return m.Score
+	}
+
+	// Contiguous beats scattered
+	require.Greater(t, score("auth token", "auth"), score("a user theme", "auth"))
+	// A word start beats the middle of a word
+	require.Greater(t, score("log rotation", "log"), score("catalog", "log"))
+	// Fewer skipped characters beat more
+	require.Greater(t, score("ab", "ab"), score("a--b", "ab"))
+	require.Greater(t, score("a-b", "ab"), score("a-------b", "ab"))
+	// The best exact occurrence is used
+	m, _ := Find("catalog log", "log")
+	require.Equal(t, []int{8, 9, 10}, m.Positions)
+}
+
+func TestContains(t *testing.T) {
+	require.True(t, Contains("Rotate the Logs daily", "logs rotate"))
+	require.False(t, Contains("Rotate the Logs daily", "rtl"))
+	require.False(t, Contains("anything", ""))
+}
+
+func BenchmarkFind(b *testing.B) {
+	titles := make([]string, 1000)
+	for i := range titles {
+		titles[i] = fmt.Sprintf("bd-%04d Improve the %s handling for request number %d", i, strings.Repeat("session ", i%4), i)
+	}
+	for _, query := range []string{"session", "imprh", "zzz"} {
+		b.Run(query, func(b *testing.B) {
+			for b.Loop() {
+				for _, title := range titles {
+					Find(title, query)
+				}
+			}
+		})
+	}
+}
+
+func TestTokens(t *testing.T) {
+	require.Equal(t, []string{"fix", "login", "flow", "v2"}, Tokens("Fix: login-flow (v2)!"))
+	require.Empty(t, Tokens(" -- "))
+}
+
+func TestTokenSetRatio(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		min, max float64
+	}{
+		{name: "identical", a: "Fix login flow", b: "Fix login flow", min: 1, max: 1},
+		{name: "case, punctuation and order", a: "Fix the login flow", b: "login flow: fix THE", min: 1, max: 1},
+		{name: "repeated words", a: "fix fix login", b: "fix login", min: 1, max: 1},
+		{name: "one contains the other", a: "Login flow", b: "Fix the login flow on mobile", min: 1, max: 1},
+		{name: "near duplicate", a: "Crash when saving settings", b: "App crashes when saving the settings", min: 0.75, max: 0.95},
+		{name: "little in common", a: "Add dark mode", b: "Crash when saving settings in dark weather", min: 0.2, max: 0.6},
+		{name: "nothing in common", a: "Add dark mode", b: "Fix login flow", min: 0, max: 0},
+		{name: "empty", a: "", b: "Fix login flow", min: 0, max: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TokenSetRatio(tt.a, tt.b)
+			require.GreaterOrEqual(t, got, tt.min)
+			require.LessOrEqual(t, got, tt.max)
+			require.Equal(t, got, TokenSetRatio(tt.b, tt.a), "symmetric")
+		})
+	}
+}
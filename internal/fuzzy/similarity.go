@@ -0,0 +1,75 @@
+package fuzzy
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Tokens normalizes text into its words: lower case runs of letters and
+// digits, with punctuation and other symbols as separators.
+func Tokens(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// TokenSetRatio scores how alike two texts are from 0 to 1, ignoring case,
+// punctuation, word order and repeated words. Words the texts share are
+// compared with each text's remaining words, so one text containing all of
+// the other's words scores 1. Texts without words in common score 0.
+func TokenSetRatio(a, b string) float64 {
+	setA, setB := tokenSet(a), tokenSet(b)
+	var common, onlyA, onlyB []string
+	for _, t := range setA {
+		if _, ok := slices.BinarySearch(setB, t); ok {
+			common = append(common, t)
+		} else {
+			onlyA = append(onlyA, t)
+		}
+	}
+	if len(common) == 0 {
+		return 0
+	}
+	for _, t := range setB {
+		if _, ok := slices.BinarySearch(setA, t); !ok {
+			onlyB = append(onlyB, t)
+		}
+	}
+
+	base := strings.Join(common, " ")
+	withA := strings.TrimSpace(base + " " + strings.Join(onlyA, " "))
+	withB := strings.TrimSpace(base + " " + strings.Join(onlyB, " "))
+	return max(ratio(base, withA), ratio(base, withB), ratio(withA, withB))
+}
+
+// tokenSet returns the distinct words of text, sorted
+func tokenSet(text string) []string {
+	tokens := Tokens(text)
+	slices.Sort(tokens)
+	return slices.Compact(tokens)
+}
+
+// ratio scores two strings from 0 to 1 by the characters they share in
+// order: twice their longest common subsequence over their total length
+func ratio(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	total := len(ra) + len(rb)
+	if total == 0 {
+		return 1
+	}
+	// One row of the LCS table at a time
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for i := range ra {
+		for j := range rb {
+			if ra[i] == rb[j] {
+				cur[j+1] = prev[j] + 1
+			} else {
+				cur[j+1] = max(prev[j+1], cur[j])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return float64(2*prev[len(rb)]) / float64(total)
+}
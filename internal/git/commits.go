@@ -0,0 +1,58 @@
+package git
+
+import "strings"
+
+// CountBeadCommits attributes commit messages to the given bead IDs and returns
+// the number of commits that mention each bead. A commit mentioning several
+// beads counts once for each of them. Beads with no commits are omitted.
+func CountBeadCommits(messages []string, beadIDs []string) map[string]int {
+	counts := make(map[string]int)
+	for _, msg := range messages {
+		for _, id := range beadIDs {
+			if mentionsBead(msg, id) {
+				counts[id]++
+			}
+		}
+	}
+	return counts
+}
+
+// mentionsBead reports whether message references beadID as a whole token.
+// Matching is case-insensitive, and a hierarchical child ID (ac-1.2) does not
+// count as a mention of its parent (ac-1).
+func mentionsBead(message, beadID string) bool {
+	if beadID == "" {
+		return false
+	}
+	msg := strings.ToLower(message)
+	id := strings.ToLower(beadID)
+
+	for start := 0; ; {
+		idx := strings.Index(msg[start:], id)
+		if idx < 0 {
+			return false
+		}
+		idx += start
+		end := idx + len(id)
+
+		before := idx == 0 || !isBeadIDChar(msg[idx-1])
+		after := end == len(msg) || !isBeadIDChar(msg[end])
+		// A trailing period is sentence punctuation unless it starts a child suffix
+		if end < len(msg) && msg[end] == '.' {
+			after = end+1 == len(msg) || !isDigit(msg[end+1])
+		}
+		if before && after {
+			return true
+		}
+		start = idx + 1
+	}
+}
+
+// isBeadIDChar reports whether c can appear inside a bead ID.
+func isBeadIDChar(c byte) bool {
+	return isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '-' || c == '_'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,85 @@
+package git_test
+
+import (
+	"testing"
+
+	"github.com/newhook/co/internal/git"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountBeadCommits(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []string
+		beadIDs  []string
+		want     map[string]int
+	}{
+		{
+			name:     "no commits",
+			messages: nil,
+			beadIDs:  []string{"bd-42"},
+			want:     map[string]int{},
+		},
+		{
+			name:     "subject mention",
+			messages: []string{"Fix login redirect (bd-42)"},
+			beadIDs:  []string{"bd-42"},
+			want:     map[string]int{"bd-42": 1},
+		},
+		{
+			name:     "body mention",
+			messages: []string{"Refactor session store\n\nCloses bd-42."},
+			beadIDs:  []string{"bd-42"},
+			want:     map[string]int{"bd-42": 1},
+		},
+		{
+			name:     "bracketed prefix",
+			messages: []string{"[bd-42] Add tests", "[bd-42] Address review"},
+			beadIDs:  []string{"bd-42"},
+			want:     map[string]int{"bd-42": 2},
+		},
+		{
+			name:     "case insensitive",
+			messages: []string{"BD-42: tidy up"},
+			beadIDs:  []string{"bd-42"},
+			want:     map[string]int{"bd-42": 1},
+		},
+		{
+			name:     "longer ID is not a mention",
+			messages: []string{"Fix bd-421", "Fix xbd-42", "Fix bd-42a"},
+			beadIDs:  []string{"bd-42"},
+			want:     map[string]int{},
+		},
+		{
+			name:     "child ID is not a parent mention",
+			messages: []string{"Implement ac-1.2"},
+			beadIDs:  []string{"ac-1", "ac-1.2"},
+			want:     map[string]int{"ac-1.2": 1},
+		},
+		{
+			name:     "later occurrence matches after a miss",
+			messages: []string{"bd-421 follow-up for bd-42"},
+			beadIDs:  []string{"bd-42"},
+			want:     map[string]int{"bd-42": 1},
+		},
+		{
+			name:     "commit counts once per bead",
+			messages: []string{"bd-1 and bd-2: shared fix, see bd-1"},
+			beadIDs:  []string{"bd-1", "bd-2", "bd-3"},
+			want:     map[string]int{"bd-1": 1, "bd-2": 1},
+		},
+		{
+			name:     "empty bead ID ignored",
+			messages: []string{"anything"},
+			beadIDs:  []string{""},
+			want:     map[string]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := git.CountBeadCommits(tt.messages, tt.beadIDs)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// DiffFileStat is the line count summary of one changed file.
+type DiffFileStat struct {
+	Path    string
+	Added   int
+	Deleted int
+	// Binary is set for binary files, which have no line counts.
+	Binary bool
+}
+
+// DiffStat summarizes the changes on a branch since its merge-base.
+type DiffStat struct {
+	MergeBase string
+	Files     []DiffFileStat
+}
+
+// Totals returns the number of added and deleted lines across all files.
+func (d *DiffStat) Totals() (added, deleted int) {
+	for _, f := range d.Files {
+		added += f.Added
+		deleted += f.Deleted
+	}
+	return added, deleted
+}
+
+// FileDiff is the unified diff of a single file.
+type FileDiff struct {
+	Path string
+	// Text is the unified diff, empty when TooLarge is set.
+	Text string
+	// TooLarge is set when the diff exceeded the requested size limit.
+	TooLarge bool
+}
+
+// ParseNumstat parses the output of git diff --numstat.
+func ParseNumstat(output string) []DiffFileStat {
+	var files []DiffFileStat
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.SplitN(line, "\t", 3)
+		if len(parts) != 3 || parts[2] == "" {
+			continue
+		}
+		stat := DiffFileStat{Path: parts[2]}
+		if parts[0] == "-" && parts[1] == "-" {
+			stat.Binary = true
+		} else {
+			stat.Added, _ = strconv.Atoi(parts[0])
+			stat.Deleted, _ = strconv.Atoi(parts[1])
+		}
+		files = append(files, stat)
+	}
+	return files
+}
+
+// DiffStat implements Operations.DiffStat.
+func (c *CLIOperations) DiffStat(ctx context.Context, repoPath, baseBranch string) (*DiffStat, error) {
+	mergeBase, err := findMergeBase(ctx, repoPath, baseBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	// Renames are reported as delete + add so every path can be diffed on its own
+	cmd := exec.CommandContext(ctx, "git", "diff", "--numstat", "--no-renames", mergeBase+"...HEAD")
+	cmd.Dir = repoPath
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get diff stat: %w", err)
+	}
+	return &DiffStat{MergeBase: mergeBase, Files: ParseNumstat(string(output))}, nil
+}
+
+// DiffFile implements Operations.DiffFile.
+func (c *CLIOperations) DiffFile(ctx context.Context, repoPath, baseBranch, path string, maxBytes int) (*FileDiff, error) {
+	mergeBase, err := findMergeBase(ctx, repoPath, baseBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cancelling stops git early when the diff turns out to be too large
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "git", "diff", "--no-color", "--no-renames", mergeBase+"...HEAD", "--", path)
+	cmd.Dir = repoPath
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s: %w", path, err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to diff %s: %w", path, err)
+	}
+
+	data, readErr := io.ReadAll(io.LimitReader(stdout, int64(maxBytes)+1))
+	if len(data) > maxBytes {
+		cancel()
+		_ = cmd.Wait()
+		return &FileDiff{Path: path, TooLarge: true}, nil
+	}
+	if err := cmd.Wait(); err != nil {
+		return nil, fmt.Errorf("failed to diff %s: %w", path, err)
+	}
+	if readErr != nil {
+		return nil, fmt.Errorf("failed to read diff of %s: %w", path, readErr)
+	}
+	return &FileDiff{Path: path, Text: string(data)}, nil
+}
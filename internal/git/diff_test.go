@@ -0,0 +1,90 @@
+package git_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/newhook/co/internal/git"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNumstat(t *testing.T) {
+	files := git.ParseNumstat("12\t3\tcmd/main.go\n-\t-\tassets/logo.png\n0\t7\tdocs/old name.md\n\n")
+	assert.Equal(t, []git.DiffFileStat{
+		{Path: "cmd/main.go", Added: 12, Deleted: 3},
+		{Path: "assets/logo.png", Binary: true},
+		{Path: "docs/old name.md", Deleted: 7},
+	}, files)
+
+	stat := &git.DiffStat{Files: files}
+	added, deleted := stat.Totals()
+	assert.Equal(t, 12, added)
+	assert.Equal(t, 10, deleted)
+
+	assert.Empty(t, git.ParseNumstat(""))
+}
+
+// runGit runs a git command in dir, failing the test on error
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+	)
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %v: %s", args, output)
+}
+
+func TestDiffAgainstBaseBranch(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	runGit(t, dir, "init", "-q", "-b", "main")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("one\ntwo\n"), 0644))
+	runGit(t, dir, "add", ".")
+	runGit(t, dir, "commit", "-q", "-m", "initial")
+
+	runGit(t, dir, "checkout", "-q", "-b", "feature")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("one\n2\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "big.txt"), []byte(strings.Repeat("line\n", 1000)), 0644))
+	runGit(t, dir, "add", ".")
+	runGit(t, dir, "commit", "-q", "-m", "change")
+
+	// Commits on the base branch after the fork don't show up
+	runGit(t, dir, "checkout", "-q", "main")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "main-only.txt"), []byte("x\n"), 0644))
+	runGit(t, dir, "add", ".")
+	runGit(t, dir, "commit", "-q", "-m", "main work")
+	runGit(t, dir, "checkout", "-q", "feature")
+
+	ops := git.NewOperations()
+	stat, err := ops.DiffStat(ctx, dir, "main")
+	require.NoError(t, err)
+	assert.Equal(t, []git.DiffFileStat{
+		{Path: "a.txt", Added: 1, Deleted: 1},
+		{Path: "big.txt", Added: 1000},
+	}, stat.Files)
+
+	diff, err := ops.DiffFile(ctx, dir, "main", "a.txt", 4096)
+	require.NoError(t, err)
+	assert.False(t, diff.TooLarge)
+	assert.Contains(t, diff.Text, "-two\n+2\n")
+
+	diff, err = ops.DiffFile(ctx, dir, "main", "big.txt", 1024)
+	require.NoError(t, err)
+	assert.True(t, diff.TooLarge)
+	assert.Empty(t, diff.Text)
+
+	_, err = ops.DiffStat(ctx, dir, "missing")
+	require.Error(t, err)
+}
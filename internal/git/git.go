@@ -4,6 +4,7 @@ package git
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os/exec"
 	"strings"
@@ -20,6 +21,8 @@ type Operations interface {
 	Clone(ctx context.Context, source, dest string) error
 	// FetchBranch fetches a specific branch from origin.
 	FetchBranch(ctx context.Context, repoPath, branch string) error
+	// OriginURL returns the URL of the repository's origin remote.
+	OriginURL(ctx context.Context, repoPath string) (string, error)
 	// FetchPRRef fetches a PR's head ref and creates/updates a local branch.
 	// This handles both same-repo PRs and fork PRs via GitHub's pull/<n>/head refs.
 	FetchPRRef(ctx context.Context, repoPath string, prNumber int, localBranch string) error
@@ -29,6 +32,29 @@ type Operations interface {
 	ValidateExistingBranch(ctx context.Context, repoPath, branchName string) (existsLocal, existsRemote bool, err error)
 	// ListBranches returns a deduplicated list of all branches (local and remote).
 	ListBranches(ctx context.Context, repoPath string) ([]string, error)
+	// DeleteBranch force-deletes a local branch.
+	DeleteBranch(ctx context.Context, repoPath, branchName string) error
+	// CommitMessagesSince returns the full messages of commits on HEAD since its
+	// merge-base with baseBranch, newest first. A branch that hasn't diverged
+	// yet returns an empty slice.
+	CommitMessagesSince(ctx context.Context, repoPath, baseBranch string) ([]string, error)
+	// DiffStat summarizes the changes on HEAD since its merge-base with
+	// baseBranch, one entry per changed file.
+	DiffStat(ctx context.Context, repoPath, baseBranch string) (*DiffStat, error)
+	// DiffFile returns the unified diff of a single file on HEAD since its
+	// merge-base with baseBranch. Diffs larger than maxBytes are not returned;
+	// the result is marked TooLarge instead.
+	DiffFile(ctx context.Context, repoPath, baseBranch, path string, maxBytes int) (*FileDiff, error)
+	// CheckRemoteBranch compares a pushed branch against origin: whether it
+	// has been deleted there, or is fully merged into origin's baseBranch.
+	// Branches that were never pushed report neither. Contacts the remote.
+	CheckRemoteBranch(ctx context.Context, repoPath, branch, baseBranch string) (*RemoteBranchStatus, error)
+}
+
+// RemoteBranchStatus describes a pushed branch as seen from origin.
+type RemoteBranchStatus struct {
+	Deleted bool // The branch no longer exists on origin
+	Merged  bool // Every commit on the branch is in origin's base branch
 }
 
 // CLIOperations implements Operations using the git CLI.
@@ -85,6 +111,17 @@ func (c *CLIOperations) FetchBranch(ctx context.Context, repoPath, branch string
 	return nil
 }
 
+// OriginURL implements Operations.OriginURL.
+func (c *CLIOperations) OriginURL(ctx context.Context, repoPath string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", "remote", "get-url", "origin")
+	cmd.Dir = repoPath
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to get origin URL: %w", err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
 // FetchPRRef implements Operations.FetchPRRef.
 // This fetches a PR's head ref using GitHub's special refs/pull/<n>/head ref
 // and creates or updates a local branch pointing to it.
@@ -193,3 +230,99 @@ func (c *CLIOperations) ListBranches(ctx context.Context, repoPath string) ([]st
 
 	return branches, nil
 }
+
+// DeleteBranch implements Operations.DeleteBranch.
+// Uses -D because squash-merged branches are never fully merged from git's view.
+func (c *CLIOperations) DeleteBranch(ctx context.Context, repoPath, branchName string) error {
+	cmd := exec.CommandContext(ctx, "git", "branch", "-D", branchName)
+	cmd.Dir = repoPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to delete branch %s: %w\n%s", branchName, err, output)
+	}
+	return nil
+}
+
+// CheckRemoteBranch implements Operations.CheckRemoteBranch.
+func (c *CLIOperations) CheckRemoteBranch(ctx context.Context, repoPath, branch, baseBranch string) (*RemoteBranchStatus, error) {
+	status := &RemoteBranchStatus{}
+
+	// Without a remote-tracking ref the branch was never pushed, so there is
+	// nothing on origin to compare against
+	cmd := exec.CommandContext(ctx, "git", "show-ref", "--verify", "--quiet", "refs/remotes/origin/"+branch)
+	cmd.Dir = repoPath
+	if cmd.Run() != nil {
+		return status, nil
+	}
+
+	// ls-remote exits 2 when no ref matches
+	cmd = exec.CommandContext(ctx, "git", "ls-remote", "--exit-code", "origin", "refs/heads/"+branch)
+	cmd.Dir = repoPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 2 {
+			status.Deleted = true
+			return status, nil
+		}
+		return nil, fmt.Errorf("failed to query origin for branch %s: %w\n%s", branch, err, output)
+	}
+
+	if err := c.FetchBranch(ctx, repoPath, baseBranch); err != nil {
+		return nil, err
+	}
+
+	// Prefer the local branch so unpushed commits keep it from counting as merged
+	ref := "refs/remotes/origin/" + branch
+	cmd = exec.CommandContext(ctx, "git", "show-ref", "--verify", "--quiet", "refs/heads/"+branch)
+	cmd.Dir = repoPath
+	if cmd.Run() == nil {
+		ref = "refs/heads/" + branch
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "rev-list", "--count", "refs/remotes/origin/"+baseBranch+".."+ref)
+	cmd.Dir = repoPath
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare %s with %s: %w", branch, baseBranch, err)
+	}
+	status.Merged = strings.TrimSpace(string(output)) == "0"
+	return status, nil
+}
+
+// CommitMessagesSince implements Operations.CommitMessagesSince.
+func (c *CLIOperations) CommitMessagesSince(ctx context.Context, repoPath, baseBranch string) ([]string, error) {
+	mergeBase, err := findMergeBase(ctx, repoPath, baseBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	// Separate messages with NUL since commit bodies may contain blank lines
+	cmd := exec.CommandContext(ctx, "git", "log", "--format=%B%x00", mergeBase+"..HEAD")
+	cmd.Dir = repoPath
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit log: %w", err)
+	}
+
+	messages := []string{}
+	for _, msg := range strings.Split(string(output), "\x00") {
+		msg = strings.TrimSpace(msg)
+		if msg != "" {
+			messages = append(messages, msg)
+		}
+	}
+	return messages, nil
+}
+
+// findMergeBase returns the merge-base of HEAD and baseBranch. It prefers the
+// local base branch, falling back to the remote-tracking ref for worktrees
+// where the base was never checked out locally.
+func findMergeBase(ctx context.Context, repoPath, baseBranch string) (string, error) {
+	for _, ref := range []string{baseBranch, "origin/" + baseBranch} {
+		cmd := exec.CommandContext(ctx, "git", "merge-base", "HEAD", ref)
+		cmd.Dir = repoPath
+		if output, err := cmd.Output(); err == nil {
+			return strings.TrimSpace(string(output)), nil
+		}
+	}
+	return "", fmt.Errorf("failed to find merge-base with %s", baseBranch)
+}
@@ -21,9 +21,24 @@ var _ Operations = &GitOperationsMock{}
 //			BranchExistsFunc: func(ctx context.Context, repoPath string, branchName string) bool {
 //				panic("mock out the BranchExists method")
 //			},
+//			CheckRemoteBranchFunc: func(ctx context.Context, repoPath string, branch string, baseBranch string) (*RemoteBranchStatus, error) {
+//				panic("mock out the CheckRemoteBranch method")
+//			},
 //			CloneFunc: func(ctx context.Context, source string, dest string) error {
 //				panic("mock out the Clone method")
 //			},
+//			CommitMessagesSinceFunc: func(ctx context.Context, repoPath string, baseBranch string) ([]string, error) {
+//				panic("mock out the CommitMessagesSince method")
+//			},
+//			DeleteBranchFunc: func(ctx context.Context, repoPath string, branchName string) error {
+//				panic("mock out the DeleteBranch method")
+//			},
+//			DiffFileFunc: func(ctx context.Context, repoPath string, baseBranch string, path string, maxBytes int) (*FileDiff, error) {
+//				panic("mock out the DiffFile method")
+//			},
+//			DiffStatFunc: func(ctx context.Context, repoPath string, baseBranch string) (*DiffStat, error) {
+//				panic("mock out the DiffStat method")
+//			},
 //			FetchBranchFunc: func(ctx context.Context, repoPath string, branch string) error {
 //				panic("mock out the FetchBranch method")
 //			},
@@ -33,6 +48,9 @@ var _ Operations = &GitOperationsMock{}
 //			ListBranchesFunc: func(ctx context.Context, repoPath string) ([]string, error) {
 //				panic("mock out the ListBranches method")
 //			},
+//			OriginURLFunc: func(ctx context.Context, repoPath string) (string, error) {
+//				panic("mock out the OriginURL method")
+//			},
 //			PullFunc: func(ctx context.Context, dir string) error {
 //				panic("mock out the Pull method")
 //			},
@@ -52,9 +70,24 @@ type GitOperationsMock struct {
 	// BranchExistsFunc mocks the BranchExists method.
 	BranchExistsFunc func(ctx context.Context, repoPath string, branchName string) bool
 
+	// CheckRemoteBranchFunc mocks the CheckRemoteBranch method.
+	CheckRemoteBranchFunc func(ctx context.Context, repoPath string, branch string, baseBranch string) (*RemoteBranchStatus, error)
+
 	// CloneFunc mocks the Clone method.
 	CloneFunc func(ctx context.Context, source string, dest string) error
 
+	// CommitMessagesSinceFunc mocks the CommitMessagesSince method.
+	CommitMessagesSinceFunc func(ctx context.Context, repoPath string, baseBranch string) ([]string, error)
+
+	// DeleteBranchFunc mocks the DeleteBranch method.
+	DeleteBranchFunc func(ctx context.Context, repoPath string, branchName string) error
+
+	// DiffFileFunc mocks the DiffFile method.
+	DiffFileFunc func(ctx context.Context, repoPath string, baseBranch string, path string, maxBytes int) (*FileDiff, error)
+
+	// DiffStatFunc mocks the DiffStat method.
+	DiffStatFunc func(ctx context.Context, repoPath string, baseBranch string) (*DiffStat, error)
+
 	// FetchBranchFunc mocks the FetchBranch method.
 	FetchBranchFunc func(ctx context.Context, repoPath string, branch string) error
 
@@ -64,6 +97,9 @@ type GitOperationsMock struct {
 	// ListBranchesFunc mocks the ListBranches method.
 	ListBranchesFunc func(ctx context.Context, repoPath string) ([]string, error)
 
+	// OriginURLFunc mocks the OriginURL method.
+	OriginURLFunc func(ctx context.Context, repoPath string) (string, error)
+
 	// PullFunc mocks the Pull method.
 	PullFunc func(ctx context.Context, dir string) error
 
@@ -84,6 +120,17 @@ type GitOperationsMock struct {
 			// BranchName is the branchName argument value.
 			BranchName string
 		}
+		// CheckRemoteBranch holds details about calls to the CheckRemoteBranch method.
+		CheckRemoteBranch []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RepoPath is the repoPath argument value.
+			RepoPath string
+			// Branch is the branch argument value.
+			Branch string
+			// BaseBranch is the baseBranch argument value.
+			BaseBranch string
+		}
 		// Clone holds details about calls to the Clone method.
 		Clone []struct {
 			// Ctx is the ctx argument value.
@@ -93,6 +140,46 @@ type GitOperationsMock struct {
 			// Dest is the dest argument value.
 			Dest string
 		}
+		// CommitMessagesSince holds details about calls to the CommitMessagesSince method.
+		CommitMessagesSince []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RepoPath is the repoPath argument value.
+			RepoPath string
+			// BaseBranch is the baseBranch argument value.
+			BaseBranch string
+		}
+		// DeleteBranch holds details about calls to the DeleteBranch method.
+		DeleteBranch []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RepoPath is the repoPath argument value.
+			RepoPath string
+			// BranchName is the branchName argument value.
+			BranchName string
+		}
+		// DiffFile holds details about calls to the DiffFile method.
+		DiffFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RepoPath is the repoPath argument value.
+			RepoPath string
+			// BaseBranch is the baseBranch argument value.
+			BaseBranch string
+			// Path is the path argument value.
+			Path string
+			// MaxBytes is the maxBytes argument value.
+			MaxBytes int
+		}
+		// DiffStat holds details about calls to the DiffStat method.
+		DiffStat []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RepoPath is the repoPath argument value.
+			RepoPath string
+			// BaseBranch is the baseBranch argument value.
+			BaseBranch string
+		}
 		// FetchBranch holds details about calls to the FetchBranch method.
 		FetchBranch []struct {
 			// Ctx is the ctx argument value.
@@ -120,6 +207,13 @@ type GitOperationsMock struct {
 			// RepoPath is the repoPath argument value.
 			RepoPath string
 		}
+		// OriginURL holds details about calls to the OriginURL method.
+		OriginURL []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RepoPath is the repoPath argument value.
+			RepoPath string
+		}
 		// Pull holds details about calls to the Pull method.
 		Pull []struct {
 			// Ctx is the ctx argument value.
@@ -147,10 +241,16 @@ type GitOperationsMock struct {
 		}
 	}
 	lockBranchExists           sync.RWMutex
+	lockCheckRemoteBranch      sync.RWMutex
 	lockClone                  sync.RWMutex
+	lockCommitMessagesSince    sync.RWMutex
+	lockDeleteBranch           sync.RWMutex
+	lockDiffFile               sync.RWMutex
+	lockDiffStat               sync.RWMutex
 	lockFetchBranch            sync.RWMutex
 	lockFetchPRRef             sync.RWMutex
 	lockListBranches           sync.RWMutex
+	lockOriginURL              sync.RWMutex
 	lockPull                   sync.RWMutex
 	lockPushSetUpstream        sync.RWMutex
 	lockValidateExistingBranch sync.RWMutex
@@ -199,6 +299,54 @@ func (mock *GitOperationsMock) BranchExistsCalls() []struct {
 	return calls
 }
 
+// CheckRemoteBranch calls CheckRemoteBranchFunc.
+func (mock *GitOperationsMock) CheckRemoteBranch(ctx context.Context, repoPath string, branch string, baseBranch string) (*RemoteBranchStatus, error) {
+	callInfo := struct {
+		Ctx        context.Context
+		RepoPath   string
+		Branch     string
+		BaseBranch string
+	}{
+		Ctx:        ctx,
+		RepoPath:   repoPath,
+		Branch:     branch,
+		BaseBranch: baseBranch,
+	}
+	mock.lockCheckRemoteBranch.Lock()
+	mock.calls.CheckRemoteBranch = append(mock.calls.CheckRemoteBranch, callInfo)
+	mock.lockCheckRemoteBranch.Unlock()
+	if mock.CheckRemoteBranchFunc == nil {
+		var (
+			remoteBranchStatusOut *RemoteBranchStatus
+			errOut                error
+		)
+		return remoteBranchStatusOut, errOut
+	}
+	return mock.CheckRemoteBranchFunc(ctx, repoPath, branch, baseBranch)
+}
+
+// CheckRemoteBranchCalls gets all the calls that were made to CheckRemoteBranch.
+// Check the length with:
+//
+//	len(mockedOperations.CheckRemoteBranchCalls())
+func (mock *GitOperationsMock) CheckRemoteBranchCalls() []struct {
+	Ctx        context.Context
+	RepoPath   string
+	Branch     string
+	BaseBranch string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		RepoPath   string
+		Branch     string
+		BaseBranch string
+	}
+	mock.lockCheckRemoteBranch.RLock()
+	calls = mock.calls.CheckRemoteBranch
+	mock.lockCheckRemoteBranch.RUnlock()
+	return calls
+}
+
 // Clone calls CloneFunc.
 func (mock *GitOperationsMock) Clone(ctx context.Context, source string, dest string) error {
 	callInfo := struct {
@@ -242,6 +390,189 @@ func (mock *GitOperationsMock) CloneCalls() []struct {
 	return calls
 }
 
+// CommitMessagesSince calls CommitMessagesSinceFunc.
+func (mock *GitOperationsMock) CommitMessagesSince(ctx context.Context, repoPath string, baseBranch string) ([]string, error) {
+	callInfo := struct {
+		Ctx        context.Context
+		RepoPath   string
+		BaseBranch string
+	}{
+		Ctx:        ctx,
+		RepoPath:   repoPath,
+		BaseBranch: baseBranch,
+	}
+	mock.lockCommitMessagesSince.Lock()
+	mock.calls.CommitMessagesSince = append(mock.calls.CommitMessagesSince, callInfo)
+	mock.lockCommitMessagesSince.Unlock()
+	if mock.CommitMessagesSinceFunc == nil {
+		var (
+			stringsOut []string
+			errOut     error
+		)
+		return stringsOut, errOut
+	}
+	return mock.CommitMessagesSinceFunc(ctx, repoPath, baseBranch)
+}
+
+// CommitMessagesSinceCalls gets all the calls that were made to CommitMessagesSince.
+// Check the length with:
+//
+//	len(mockedOperations.CommitMessagesSinceCalls())
+func (mock *GitOperationsMock) CommitMessagesSinceCalls() []struct {
+	Ctx        context.Context
+	RepoPath   string
+	BaseBranch string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		RepoPath   string
+		BaseBranch string
+	}
+	mock.lockCommitMessagesSince.RLock()
+	calls = mock.calls.CommitMessagesSince
+	mock.lockCommitMessagesSince.RUnlock()
+	return calls
+}
+
+// DeleteBranch calls DeleteBranchFunc.
+func (mock *GitOperationsMock) DeleteBranch(ctx context.Context, repoPath string, branchName string) error {
+	callInfo := struct {
+		Ctx        context.Context
+		RepoPath   string
+		BranchName string
+	}{
+		Ctx:        ctx,
+		RepoPath:   repoPath,
+		BranchName: branchName,
+	}
+	mock.lockDeleteBranch.Lock()
+	mock.calls.DeleteBranch = append(mock.calls.DeleteBranch, callInfo)
+	mock.lockDeleteBranch.Unlock()
+	if mock.DeleteBranchFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.DeleteBranchFunc(ctx, repoPath, branchName)
+}
+
+// DeleteBranchCalls gets all the calls that were made to DeleteBranch.
+// Check the length with:
+//
+//	len(mockedOperations.DeleteBranchCalls())
+func (mock *GitOperationsMock) DeleteBranchCalls() []struct {
+	Ctx        context.Context
+	RepoPath   string
+	BranchName string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		RepoPath   string
+		BranchName string
+	}
+	mock.lockDeleteBranch.RLock()
+	calls = mock.calls.DeleteBranch
+	mock.lockDeleteBranch.RUnlock()
+	return calls
+}
+
+// DiffFile calls DiffFileFunc.
+func (mock *GitOperationsMock) DiffFile(ctx context.Context, repoPath string, baseBranch string, path string, maxBytes int) (*FileDiff, error) {
+	callInfo := struct {
+		Ctx        context.Context
+		RepoPath   string
+		BaseBranch string
+		Path       string
+		MaxBytes   int
+	}{
+		Ctx:        ctx,
+		RepoPath:   repoPath,
+		BaseBranch: baseBranch,
+		Path:       path,
+		MaxBytes:   maxBytes,
+	}
+	mock.lockDiffFile.Lock()
+	mock.calls.DiffFile = append(mock.calls.DiffFile, callInfo)
+	mock.lockDiffFile.Unlock()
+	if mock.DiffFileFunc == nil {
+		var (
+			fileDiffOut *FileDiff
+			errOut      error
+		)
+		return fileDiffOut, errOut
+	}
+	return mock.DiffFileFunc(ctx, repoPath, baseBranch, path, maxBytes)
+}
+
+// DiffFileCalls gets all the calls that were made to DiffFile.
+// Check the length with:
+//
+//	len(mockedOperations.DiffFileCalls())
+func (mock *GitOperationsMock) DiffFileCalls() []struct {
+	Ctx        context.Context
+	RepoPath   string
+	BaseBranch string
+	Path       string
+	MaxBytes   int
+} {
+	var calls []struct {
+		Ctx        context.Context
+		RepoPath   string
+		BaseBranch string
+		Path       string
+		MaxBytes   int
+	}
+	mock.lockDiffFile.RLock()
+	calls = mock.calls.DiffFile
+	mock.lockDiffFile.RUnlock()
+	return calls
+}
+
+// DiffStat calls DiffStatFunc.
+func (mock *GitOperationsMock) DiffStat(ctx context.Context, repoPath string, baseBranch string) (*DiffStat, error) {
+	callInfo := struct {
+		Ctx        context.Context
+		RepoPath   string
+		BaseBranch string
+	}{
+		Ctx:        ctx,
+		RepoPath:   repoPath,
+		BaseBranch: baseBranch,
+	}
+	mock.lockDiffStat.Lock()
+	mock.calls.DiffStat = append(mock.calls.DiffStat, callInfo)
+	mock.lockDiffStat.Unlock()
+	if mock.DiffStatFunc == nil {
+		var (
+			diffStatOut *DiffStat
+			errOut      error
+		)
+		return diffStatOut, errOut
+	}
+	return mock.DiffStatFunc(ctx, repoPath, baseBranch)
+}
+
+// DiffStatCalls gets all the calls that were made to DiffStat.
+// Check the length with:
+//
+//	len(mockedOperations.DiffStatCalls())
+func (mock *GitOperationsMock) DiffStatCalls() []struct {
+	Ctx        context.Context
+	RepoPath   string
+	BaseBranch string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		RepoPath   string
+		BaseBranch string
+	}
+	mock.lockDiffStat.RLock()
+	calls = mock.calls.DiffStat
+	mock.lockDiffStat.RUnlock()
+	return calls
+}
+
 // FetchBranch calls FetchBranchFunc.
 func (mock *GitOperationsMock) FetchBranch(ctx context.Context, repoPath string, branch string) error {
 	callInfo := struct {
@@ -372,6 +703,46 @@ func (mock *GitOperationsMock) ListBranchesCalls() []struct {
 	return calls
 }
 
+// OriginURL calls OriginURLFunc.
+func (mock *GitOperationsMock) OriginURL(ctx context.Context, repoPath string) (string, error) {
+	callInfo := struct {
+		Ctx      context.Context
+		RepoPath string
+	}{
+		Ctx:      ctx,
+		RepoPath: repoPath,
+	}
+	mock.lockOriginURL.Lock()
+	mock.calls.OriginURL = append(mock.calls.OriginURL, callInfo)
+	mock.lockOriginURL.Unlock()
+	if mock.OriginURLFunc == nil {
+		var (
+			sOut   string
+			errOut error
+		)
+		return sOut, errOut
+	}
+	return mock.OriginURLFunc(ctx, repoPath)
+}
+
+// OriginURLCalls gets all the calls that were made to OriginURL.
+// Check the length with:
+//
+//	len(mockedOperations.OriginURLCalls())
+func (mock *GitOperationsMock) OriginURLCalls() []struct {
+	Ctx      context.Context
+	RepoPath string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		RepoPath string
+	}
+	mock.lockOriginURL.RLock()
+	calls = mock.calls.OriginURL
+	mock.lockOriginURL.RUnlock()
+	return calls
+}
+
 // Pull calls PullFunc.
 func (mock *GitOperationsMock) Pull(ctx context.Context, dir string) error {
 	callInfo := struct {
@@ -1,9 +1,14 @@
 package git_test
 
 import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 
 	"github.com/newhook/co/internal/git"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -11,3 +16,51 @@ func TestNewOperations(t *testing.T) {
 	ops := git.NewOperations()
 	require.NotNil(t, ops, "NewOperations returned nil")
 }
+
+func TestCheckRemoteBranch(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	ctx := context.Background()
+	origin := t.TempDir()
+	dir := t.TempDir()
+
+	runGit(t, origin, "init", "-q", "--bare", "-b", "main")
+	runGit(t, dir, "init", "-q", "-b", "main")
+	runGit(t, dir, "remote", "add", "origin", origin)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("one\n"), 0644))
+	runGit(t, dir, "add", ".")
+	runGit(t, dir, "commit", "-q", "-m", "initial")
+	runGit(t, dir, "push", "-q", "origin", "main")
+
+	commitOn := func(branch, file string) {
+		runGit(t, dir, "checkout", "-q", "-b", branch, "main")
+		require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte(branch+"\n"), 0644))
+		runGit(t, dir, "add", ".")
+		runGit(t, dir, "commit", "-q", "-m", branch)
+		runGit(t, dir, "checkout", "-q", "main")
+	}
+	commitOn("unpushed", "u.txt")
+	commitOn("open", "o.txt")
+	commitOn("merged", "m.txt")
+	commitOn("deleted", "d.txt")
+	runGit(t, dir, "push", "-q", "origin", "open", "merged", "deleted")
+
+	// Merge one branch on the remote's main and delete another there
+	runGit(t, dir, "merge", "-q", "--no-edit", "merged")
+	runGit(t, dir, "push", "-q", "origin", "main")
+	runGit(t, origin, "branch", "-D", "deleted")
+
+	ops := git.NewOperations()
+	tests := map[string]git.RemoteBranchStatus{
+		"unpushed": {},
+		"open":     {},
+		"merged":   {Merged: true},
+		"deleted":  {Deleted: true},
+	}
+	for branch, want := range tests {
+		status, err := ops.CheckRemoteBranch(ctx, dir, branch, "main")
+		require.NoError(t, err, branch)
+		assert.Equal(t, want, *status, branch)
+	}
+}
@@ -0,0 +1,145 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/newhook/co/internal/logging"
+)
+
+// PRCheck is a check run on a PR's head commit, as reported by gh pr checks.
+type PRCheck struct {
+	Name     string `json:"name"`
+	State    string `json:"state"`
+	Bucket   string `json:"bucket"` // pass, fail, pending, skipping or cancel
+	Workflow string `json:"workflow"`
+	Link     string `json:"link"`
+}
+
+// Failed reports whether the check failed.
+func (c PRCheck) Failed() bool {
+	return c.Bucket == "fail"
+}
+
+// FailingChecks returns the checks that failed.
+func FailingChecks(checks []PRCheck) []PRCheck {
+	var failing []PRCheck
+	for _, check := range checks {
+		if check.Failed() {
+			failing = append(failing, check)
+		}
+	}
+	return failing
+}
+
+// GetPRChecks fetches the checks of a PR. With requiredOnly, only the checks
+// branch protection requires are returned.
+func (c *Client) GetPRChecks(ctx context.Context, prURL string, requiredOnly bool) ([]PRCheck, error) {
+	prNumber, repo, err := parsePRURL(prURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse PR URL: %w", err)
+	}
+
+	args := []string{"pr", "checks", prNumber, "--repo", repo, "--json", "name,state,bucket,workflow,link"}
+	if requiredOnly {
+		args = append(args, "--required")
+	}
+	cmd := exec.CommandContext(ctx, "gh", args...)
+	// gh exits non-zero while checks fail or are pending, but still prints them
+	output, err := cmd.Output()
+	if err != nil && len(strings.TrimSpace(string(output))) == 0 {
+		stderr := ""
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			stderr = string(exitErr.Stderr)
+		}
+		if strings.Contains(stderr, "no checks reported") || strings.Contains(stderr, "no required checks") {
+			return nil, nil
+		}
+		logging.Error("gh pr checks failed", "error", err, "stderr", stderr, "repo", repo, "prNumber", prNumber)
+		return nil, fmt.Errorf("gh pr checks failed: %w", err)
+	}
+
+	var checks []PRCheck
+	if err := json.Unmarshal(output, &checks); err != nil {
+		return nil, fmt.Errorf("failed to parse PR checks: %w", err)
+	}
+	return checks, nil
+}
+
+// checkJobLinkRe matches the link of a GitHub Actions check:
+// https://github.com/owner/repo/actions/runs/<run>/job/<job>
+var checkJobLinkRe = regexp.MustCompile(`/actions/runs/\d+/job/(\d+)`)
+
+// JobIDFromCheckLink returns the Actions job ID of a check's link, or 0 when
+// the check doesn't come from GitHub Actions.
+func JobIDFromCheckLink(link string) int64 {
+	m := checkJobLinkRe.FindStringSubmatch(link)
+	if m == nil {
+		return 0
+	}
+	id, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return id
+}
+
+// ChecksCache caches the checks of PRs for a while, so callers that look
+// often, like the orchestrator loop, don't call gh every time.
+type ChecksCache struct {
+	client ClientInterface
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]checksCacheEntry
+}
+
+type checksCacheEntry struct {
+	checks    []PRCheck
+	fetchedAt time.Time
+}
+
+// NewChecksCache creates a cache of PR checks fetched with client.
+func NewChecksCache(client ClientInterface, ttl time.Duration) *ChecksCache {
+	return &ChecksCache{
+		client:  client,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]checksCacheEntry),
+	}
+}
+
+// Get returns the checks of a PR, fetching them when the cached ones are
+// older than the cache's TTL. Errors aren't cached.
+func (c *ChecksCache) Get(ctx context.Context, prURL string, requiredOnly bool) ([]PRCheck, error) {
+	key := prURL
+	if requiredOnly {
+		key += "#required"
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && c.now().Sub(entry.fetchedAt) < c.ttl {
+		return entry.checks, nil
+	}
+
+	checks, err := c.client.GetPRChecks(ctx, prURL, requiredOnly)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.entries[key] = checksCacheEntry{checks: checks, fetchedAt: c.now()}
+	c.mu.Unlock()
+	return checks, nil
+}
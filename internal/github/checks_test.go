@@ -0,0 +1,69 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobIDFromCheckLink(t *testing.T) {
+	require.Equal(t, int64(456), JobIDFromCheckLink("https://github.com/owner/repo/actions/runs/123/job/456"))
+	require.Equal(t, int64(0), JobIDFromCheckLink("https://ci.example.com/builds/9"))
+	require.Equal(t, int64(0), JobIDFromCheckLink(""))
+}
+
+func TestFailingChecks(t *testing.T) {
+	checks := []PRCheck{
+		{Name: "lint", Bucket: "fail"},
+		{Name: "build", Bucket: "pass"},
+		{Name: "test", Bucket: "fail"},
+		{Name: "deploy", Bucket: "pending"},
+	}
+	failing := FailingChecks(checks)
+	require.Len(t, failing, 2)
+	require.Equal(t, "lint", failing[0].Name)
+	require.Equal(t, "test", failing[1].Name)
+	require.Empty(t, FailingChecks(nil))
+}
+
+func TestChecksCache(t *testing.T) {
+	ctx := context.Background()
+	fetchErr := error(nil)
+	mock := &GitHubClientMock{
+		GetPRChecksFunc: func(ctx context.Context, prURL string, requiredOnly bool) ([]PRCheck, error) {
+			if fetchErr != nil {
+				return nil, fetchErr
+			}
+			return []PRCheck{{Name: prURL, Bucket: "fail"}}, nil
+		},
+	}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache := NewChecksCache(mock, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	const pr = "https://github.com/owner/repo/pull/1"
+	checks, err := cache.Get(ctx, pr, true)
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	_, err = cache.Get(ctx, pr, true)
+	require.NoError(t, err)
+	require.Len(t, mock.GetPRChecksCalls(), 1)
+
+	// All checks are cached apart from the required ones
+	_, err = cache.Get(ctx, pr, false)
+	require.NoError(t, err)
+	require.Len(t, mock.GetPRChecksCalls(), 2)
+
+	// Expired entries are fetched again, and errors aren't cached
+	now = now.Add(2 * time.Minute)
+	fetchErr = errors.New("gh down")
+	_, err = cache.Get(ctx, pr, true)
+	require.Error(t, err)
+	fetchErr = nil
+	_, err = cache.Get(ctx, pr, true)
+	require.NoError(t, err)
+	require.Len(t, mock.GetPRChecksCalls(), 4)
+}
@@ -31,6 +31,9 @@ type ClientInterface interface {
 	PostReviewReply(ctx context.Context, prURL string, reviewCommentID int, body string) error
 	// ResolveReviewThread resolves a review thread containing the specified comment.
 	ResolveReviewThread(ctx context.Context, prURL string, commentID int) error
+	// GetPRChecks fetches the checks of a PR, only the required ones with
+	// requiredOnly.
+	GetPRChecks(ctx context.Context, prURL string, requiredOnly bool) ([]PRCheck, error)
 	// GetJobLogs fetches the logs for a specific job.
 	GetJobLogs(ctx context.Context, repo string, jobID int64) (string, error)
 	// WatchWorkflowRun blocks until a workflow run completes.
@@ -21,6 +21,9 @@ var _ ClientInterface = &GitHubClientMock{}
 //			GetJobLogsFunc: func(ctx context.Context, repo string, jobID int64) (string, error) {
 //				panic("mock out the GetJobLogs method")
 //			},
+//			GetPRChecksFunc: func(ctx context.Context, prURL string, requiredOnly bool) ([]PRCheck, error) {
+//				panic("mock out the GetPRChecks method")
+//			},
 //			GetPRMetadataFunc: func(ctx context.Context, prURLOrNumber string, repo string) (*PRMetadata, error) {
 //				panic("mock out the GetPRMetadata method")
 //			},
@@ -52,6 +55,9 @@ type GitHubClientMock struct {
 	// GetJobLogsFunc mocks the GetJobLogs method.
 	GetJobLogsFunc func(ctx context.Context, repo string, jobID int64) (string, error)
 
+	// GetPRChecksFunc mocks the GetPRChecks method.
+	GetPRChecksFunc func(ctx context.Context, prURL string, requiredOnly bool) ([]PRCheck, error)
+
 	// GetPRMetadataFunc mocks the GetPRMetadata method.
 	GetPRMetadataFunc func(ctx context.Context, prURLOrNumber string, repo string) (*PRMetadata, error)
 
@@ -84,6 +90,15 @@ type GitHubClientMock struct {
 			// JobID is the jobID argument value.
 			JobID int64
 		}
+		// GetPRChecks holds details about calls to the GetPRChecks method.
+		GetPRChecks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PrURL is the prURL argument value.
+			PrURL string
+			// RequiredOnly is the requiredOnly argument value.
+			RequiredOnly bool
+		}
 		// GetPRMetadata holds details about calls to the GetPRMetadata method.
 		GetPRMetadata []struct {
 			// Ctx is the ctx argument value.
@@ -151,6 +166,7 @@ type GitHubClientMock struct {
 		}
 	}
 	lockGetJobLogs          sync.RWMutex
+	lockGetPRChecks         sync.RWMutex
 	lockGetPRMetadata       sync.RWMutex
 	lockGetPRStatus         sync.RWMutex
 	lockPostPRComment       sync.RWMutex
@@ -204,6 +220,50 @@ func (mock *GitHubClientMock) GetJobLogsCalls() []struct {
 	return calls
 }
 
+// GetPRChecks calls GetPRChecksFunc.
+func (mock *GitHubClientMock) GetPRChecks(ctx context.Context, prURL string, requiredOnly bool) ([]PRCheck, error) {
+	callInfo := struct {
+		Ctx          context.Context
+		PrURL        string
+		RequiredOnly bool
+	}{
+		Ctx:          ctx,
+		PrURL:        prURL,
+		RequiredOnly: requiredOnly,
+	}
+	mock.lockGetPRChecks.Lock()
+	mock.calls.GetPRChecks = append(mock.calls.GetPRChecks, callInfo)
+	mock.lockGetPRChecks.Unlock()
+	if mock.GetPRChecksFunc == nil {
+		var (
+			pRChecksOut []PRCheck
+			errOut      error
+		)
+		return pRChecksOut, errOut
+	}
+	return mock.GetPRChecksFunc(ctx, prURL, requiredOnly)
+}
+
+// GetPRChecksCalls gets all the calls that were made to GetPRChecks.
+// Check the length with:
+//
+//	len(mockedClientInterface.GetPRChecksCalls())
+func (mock *GitHubClientMock) GetPRChecksCalls() []struct {
+	Ctx          context.Context
+	PrURL        string
+	RequiredOnly bool
+} {
+	var calls []struct {
+		Ctx          context.Context
+		PrURL        string
+		RequiredOnly bool
+	}
+	mock.lockGetPRChecks.RLock()
+	calls = mock.calls.GetPRChecks
+	mock.lockGetPRChecks.RUnlock()
+	return calls
+}
+
 // GetPRMetadata calls GetPRMetadataFunc.
 func (mock *GitHubClientMock) GetPRMetadata(ctx context.Context, prURLOrNumber string, repo string) (*PRMetadata, error) {
 	callInfo := struct {
@@ -0,0 +1,47 @@
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "test.log")
+	r, err := OpenRotating(path, 10)
+	require.NoError(t, err)
+
+	_, err = r.Write([]byte("123456\n"))
+	require.NoError(t, err)
+	_, err = r.Write([]byte("abc\n"))
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "abc\n", string(data))
+	data, err = os.ReadFile(path + ".1")
+	require.NoError(t, err)
+	require.Equal(t, "123456\n", string(data))
+
+	// Reopening appends, counting what's already there
+	r, err = OpenRotating(path, 10)
+	require.NoError(t, err)
+	_, err = r.Write([]byte("de\n"))
+	require.NoError(t, err)
+	_, err = r.Write([]byte("fghij\n"))
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "fghij\n", string(data))
+	data, err = os.ReadFile(path + ".1")
+	require.NoError(t, err)
+	require.Equal(t, "abc\nde\n", string(data))
+
+	_, err = r.Write([]byte("x"))
+	require.ErrorIs(t, err, os.ErrClosed)
+}
@@ -0,0 +1,57 @@
+// Package managed lists the paths co writes for its own use, which must never
+// end up in a work's commits. The same list feeds the .git/info/exclude
+// entries a new worktree gets and the pre-commit check on task commits.
+package managed
+
+import (
+	"path"
+	"strings"
+)
+
+// Patterns are the co-managed paths, in .gitignore syntax. A pattern ending
+// in "/" names a directory anywhere in the tree; any other pattern is matched
+// against file names.
+var Patterns = []string{
+	".co/",           // Project config, tracking database, logs and task artifacts
+	"tui-draft.json", // Text of an open TUI dialog
+	"tui-state.json", // TUI state kept between sessions
+	"tui-debug.log",  // TUI debug log
+}
+
+// Match reports whether a repository-relative path, with forward slashes,
+// is co-managed.
+func Match(p string) bool {
+	p = strings.TrimPrefix(p, "./")
+	parts := strings.Split(strings.TrimSuffix(p, "/"), "/")
+	for _, pattern := range Patterns {
+		if dir, ok := strings.CutSuffix(pattern, "/"); ok {
+			// Every component but the last is a directory; so is the last
+			// when p ends in a slash
+			dirs := parts[:len(parts)-1]
+			if strings.HasSuffix(p, "/") {
+				dirs = parts
+			}
+			for _, part := range dirs {
+				if matched, _ := path.Match(dir, part); matched {
+					return true
+				}
+			}
+			continue
+		}
+		if matched, _ := path.Match(pattern, parts[len(parts)-1]); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter returns the paths that are co-managed, in order.
+func Filter(paths []string) []string {
+	var matched []string
+	for _, p := range paths {
+		if Match(p) {
+			matched = append(matched, p)
+		}
+	}
+	return matched
+}
@@ -0,0 +1,42 @@
+package managed
+
+import (
+	"testing"
+
+	"github.com/newhook/co/internal/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch(t *testing.T) {
+	for _, p := range []string{
+		".co/config.toml",
+		".co/tasks/w-abc/w-abc.1/review.md",
+		"./.co/tracking.db",
+		"sub/.co/logs/orchestrator-w-abc.log",
+		".co/",
+		"tui-draft.json",
+		"docs/tui-state.json",
+	} {
+		require.True(t, Match(p), p)
+	}
+	for _, p := range []string{
+		"main.go",
+		".co",
+		".config/co.toml",
+		"docs/.co.md",
+		"tui-draft.json.go",
+		"internal/co/tui.go",
+	} {
+		require.False(t, Match(p), p)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	require.Equal(t, []string{".co/tracking.db", "tui-state.json"},
+		Filter([]string{"main.go", ".co/tracking.db", "README.md", "tui-state.json"}))
+	require.Empty(t, Filter([]string{"main.go"}))
+}
+
+func TestPatternsCoverConfigDir(t *testing.T) {
+	require.Contains(t, Patterns, project.ConfigDir+"/")
+}
@@ -0,0 +1,57 @@
+// Package notify sends desktop notifications, falling back to a terminal
+// bell where no notifier is available.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+)
+
+// bell is where the fallback terminal bell is written.
+var bell io.Writer = os.Stderr
+
+// Send shows a desktop notification with the given title and message:
+// osascript on macOS, notify-send on Linux. When neither is available the
+// terminal bell is rung instead.
+func Send(ctx context.Context, title, message string) error {
+	args := command(runtime.GOOS, title, message, exec.LookPath)
+	if args == nil {
+		if _, err := io.WriteString(bell, "\a"); err != nil {
+			return fmt.Errorf("failed to ring terminal bell: %w", err)
+		}
+		return nil
+	}
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to send notification: %w\n%s", err, output)
+	}
+	return nil
+}
+
+// command returns the notifier command line for goos, or nil when there is
+// no notifier to run.
+func command(goos, title, message string, lookPath func(string) (string, error)) []string {
+	switch goos {
+	case "darwin":
+		if _, err := lookPath("osascript"); err != nil {
+			return nil
+		}
+		// Pass the text as arguments so quotes in it can't break the script
+		return []string{"osascript",
+			"-e", "on run argv",
+			"-e", "display notification (item 2 of argv) with title (item 1 of argv)",
+			"-e", "end run",
+			title, message,
+		}
+	case "linux":
+		if _, err := lookPath("notify-send"); err != nil {
+			return nil
+		}
+		return []string{"notify-send", "--app-name=co", title, message}
+	}
+	return nil
+}
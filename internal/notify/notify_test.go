@@ -0,0 +1,39 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func found(string) (string, error)   { return "/usr/bin/x", nil }
+func missing(string) (string, error) { return "", errors.New("not found") }
+
+func TestCommand(t *testing.T) {
+	args := command("darwin", `Work "a"`, "Task w-abc.1 failed", found)
+	require.NotEmpty(t, args)
+	assert.Equal(t, "osascript", args[0])
+	assert.Equal(t, []string{`Work "a"`, "Task w-abc.1 failed"}, args[len(args)-2:])
+
+	assert.Equal(t, []string{"notify-send", "--app-name=co", "t", "m"}, command("linux", "t", "m", found))
+
+	assert.Nil(t, command("linux", "t", "m", missing))
+	assert.Nil(t, command("darwin", "t", "m", missing))
+	assert.Nil(t, command("windows", "t", "m", found))
+}
+
+func TestSendFallsBackToBell(t *testing.T) {
+	var buf bytes.Buffer
+	old := bell
+	bell = &buf
+	defer func() { bell = old }()
+
+	// With nothing on PATH there's no notifier on any platform
+	t.Setenv("PATH", t.TempDir())
+	require.NoError(t, Send(context.Background(), "title", "message"))
+	assert.Equal(t, "\a", buf.String())
+}
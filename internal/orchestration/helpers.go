@@ -3,9 +3,13 @@ package orchestration
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/github"
+	"github.com/newhook/co/internal/logging"
+	"github.com/newhook/co/internal/project"
 )
 
 // SpinnerFrames for animated waiting display
@@ -34,7 +38,7 @@ func SpinnerWait(msg string, duration time.Duration) {
 }
 
 // CountReviewIterations counts how many review iterations have been done for a work.
-func CountReviewIterations(ctx context.Context, database *db.DB, workID string) int {
+func CountReviewIterations(ctx context.Context, database db.Store, workID string) int {
 	tasks, err := database.GetWorkTasks(ctx, workID)
 	if err != nil {
 		return 0
@@ -42,9 +46,135 @@ func CountReviewIterations(ctx context.Context, database *db.DB, workID string)
 
 	count := 0
 	for _, t := range tasks {
-		if t.TaskType == "review" {
+		if t.TaskType == db.TaskTypeReview {
 			count++
 		}
 	}
 	return count
 }
+
+// MaxParallelTasks returns how many tasks a work's orchestrator runs at once:
+// the work's own setting when it has one, the project's otherwise.
+func MaxParallelTasks(cfg *project.Config, work *db.Work) int {
+	if work.MaxParallelTasks > 0 {
+		return work.MaxParallelTasks
+	}
+	return cfg.Workflow.GetMaxParallelTasks()
+}
+
+// AutoPR reports whether a work's orchestrator creates the PR task on its
+// own: the work's own setting when it has one, the project's otherwise.
+func AutoPR(cfg *project.Config, work *db.Work) bool {
+	if work.AutoPR != nil {
+		return *work.AutoPR
+	}
+	return cfg.Workflow.AutoPR
+}
+
+// CreateAutoPRTask creates the PR task for a work whose tasks are all
+// completed. Nothing is created for a work that already has a PR, has no
+// implement task, or already has a pending, processing or completed PR task,
+// so an orchestrator that restarts doesn't create a second one. It returns
+// the new task's ID, or "" when nothing was created.
+func CreateAutoPRTask(ctx context.Context, database db.Store, work *db.Work, tasks []*db.Task) (string, error) {
+	if work.PRURL != "" {
+		return "", nil
+	}
+	hasImplement := false
+	for _, t := range tasks {
+		if t.TaskType == db.TaskTypeImplement {
+			hasImplement = true
+			break
+		}
+	}
+	if !hasImplement {
+		return "", nil
+	}
+
+	existing, err := database.GetPRTaskForWork(ctx, work.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to check for existing PR task: %w", err)
+	}
+	if existing != nil {
+		return "", nil
+	}
+
+	taskNum, err := database.GetNextTaskNumber(ctx, work.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get next task number for PR: %w", err)
+	}
+	taskID := fmt.Sprintf("%s.%d", work.ID, taskNum)
+	if err := database.CreateTask(ctx, taskID, db.TaskTypePR, nil, 0, work.ID); err != nil {
+		return "", fmt.Errorf("failed to create PR task: %w", err)
+	}
+	return taskID, nil
+}
+
+// HoldPRTasks takes the pr and update-pr-description tasks out of the ready
+// tasks while required checks on the work's PR fail, unless [workflow]
+// hold_pr_on_failing_checks is off. It returns the tasks that can start and
+// the names of the failing checks. Checks that can't be fetched hold nothing
+// back.
+func HoldPRTasks(ctx context.Context, cfg *project.Config, checks *github.ChecksCache, work *db.Work, ready []*db.Task) ([]*db.Task, []string) {
+	if work.PRURL == "" || !cfg.Workflow.GetHoldPROnFailingChecks() {
+		return ready, nil
+	}
+	isPRTask := func(t *db.Task) bool {
+		return t.TaskType == db.TaskTypePR || t.TaskType == db.TaskTypeUpdatePRDescription
+	}
+	if !slices.ContainsFunc(ready, isPRTask) {
+		return ready, nil
+	}
+
+	required, err := checks.Get(ctx, work.PRURL, true)
+	if err != nil {
+		logging.Warn("failed to fetch required PR checks", "work_id", work.ID, "error", err)
+		return ready, nil
+	}
+	var failing []string
+	for _, check := range github.FailingChecks(required) {
+		failing = append(failing, check.Name)
+	}
+	if len(failing) == 0 {
+		return ready, nil
+	}
+
+	var runnable []*db.Task
+	for _, t := range ready {
+		if !isPRTask(t) {
+			runnable = append(runnable, t)
+		}
+	}
+	return runnable, failing
+}
+
+// ClaimReadyTasks claims up to n of the ready tasks for claimant, in order.
+// Tasks another live process claimed first are skipped. A claim left behind by
+// a process that died before starting its task is released, so the task is
+// picked up on a later pass.
+func ClaimReadyTasks(ctx context.Context, database db.Store, ready []*db.Task, claimant string, n int) ([]*db.Task, error) {
+	var claimed []*db.Task
+	for _, t := range ready {
+		if len(claimed) >= n {
+			break
+		}
+		ok, err := database.ClaimTask(ctx, t.ID, claimant, "")
+		if err != nil {
+			return claimed, err
+		}
+		if ok {
+			claimed = append(claimed, t)
+			continue
+		}
+		holder, err := database.GetTaskClaimant(ctx, t.ID)
+		if err != nil {
+			return claimed, err
+		}
+		if holder != "" && !db.IsClaimantAlive(holder) {
+			if err := database.ResetTaskStatus(ctx, t.ID); err != nil {
+				return claimed, fmt.Errorf("failed to release claim on task %s: %w", t.ID, err)
+			}
+		}
+	}
+	return claimed, nil
+}
@@ -2,9 +2,14 @@ package orchestration
 
 import (
 	"context"
+	"os"
+	"os/exec"
+	"strconv"
 	"testing"
 
 	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/github"
+	"github.com/newhook/co/internal/project"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -126,3 +131,158 @@ func TestSpinnerFrames(t *testing.T) {
 		assert.NotEmpty(t, frame, "SpinnerFrame[%d] should not be empty", i)
 	}
 }
+
+func TestMaxParallelTasks(t *testing.T) {
+	cfg := &project.Config{}
+	work := &db.Work{}
+	assert.Equal(t, 1, MaxParallelTasks(cfg, work))
+
+	three := 3
+	cfg.Workflow.MaxParallelTasks = &three
+	assert.Equal(t, 3, MaxParallelTasks(cfg, work))
+
+	// The work's own setting wins over the project's
+	work.MaxParallelTasks = 2
+	assert.Equal(t, 2, MaxParallelTasks(cfg, work))
+}
+
+func TestAutoPR(t *testing.T) {
+	cfg := &project.Config{}
+	work := &db.Work{}
+	assert.False(t, AutoPR(cfg, work))
+
+	cfg.Workflow.AutoPR = true
+	assert.True(t, AutoPR(cfg, work))
+
+	// The work's own setting wins over the project's
+	off := false
+	work.AutoPR = &off
+	assert.False(t, AutoPR(cfg, work))
+}
+
+func TestHoldPRTasks(t *testing.T) {
+	ctx := context.Background()
+	var required []github.PRCheck
+	mock := &github.GitHubClientMock{
+		GetPRChecksFunc: func(ctx context.Context, prURL string, requiredOnly bool) ([]github.PRCheck, error) {
+			require.True(t, requiredOnly)
+			return required, nil
+		},
+	}
+	checks := github.NewChecksCache(mock, 0)
+	cfg := &project.Config{}
+	work := &db.Work{ID: "w-1", PRURL: "https://github.com/owner/repo/pull/1"}
+	review := &db.Task{ID: "w-1.1", TaskType: db.TaskTypeReview}
+	update := &db.Task{ID: "w-1.2", TaskType: db.TaskTypeUpdatePRDescription}
+
+	// Without a PR task ready, checks aren't fetched
+	runnable, failing := HoldPRTasks(ctx, cfg, checks, work, []*db.Task{review})
+	assert.Equal(t, []*db.Task{review}, runnable)
+	assert.Empty(t, failing)
+	assert.Empty(t, mock.GetPRChecksCalls())
+
+	required = []github.PRCheck{{Name: "build", Bucket: "pass"}, {Name: "lint", Bucket: "fail"}}
+	runnable, failing = HoldPRTasks(ctx, cfg, checks, work, []*db.Task{review, update})
+	assert.Equal(t, []*db.Task{review}, runnable)
+	assert.Equal(t, []string{"lint"}, failing)
+
+	required = []github.PRCheck{{Name: "lint", Bucket: "pass"}}
+	runnable, failing = HoldPRTasks(ctx, cfg, checks, work, []*db.Task{update})
+	assert.Equal(t, []*db.Task{update}, runnable)
+	assert.Empty(t, failing)
+
+	// Turned off, failing checks hold nothing back
+	required = []github.PRCheck{{Name: "lint", Bucket: "fail"}}
+	off := false
+	cfg.Workflow.HoldPROnFailingChecks = &off
+	runnable, _ = HoldPRTasks(ctx, cfg, checks, work, []*db.Task{update})
+	assert.Equal(t, []*db.Task{update}, runnable)
+}
+
+func TestCreateAutoPRTask(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	createTestWork(ctx, t, database, "w-auto", "auto-branch")
+	work, err := database.GetWork(ctx, "w-auto")
+	require.NoError(t, err)
+
+	// A work without an implement task gets no PR
+	require.NoError(t, database.CreateTask(ctx, "w-auto.estimate", "estimate", []string{"bead-1"}, 0, "w-auto"))
+	tasks, err := database.GetWorkTasks(ctx, "w-auto")
+	require.NoError(t, err)
+	taskID, err := CreateAutoPRTask(ctx, database, work, tasks)
+	require.NoError(t, err)
+	assert.Empty(t, taskID)
+
+	require.NoError(t, database.CreateTask(ctx, "w-auto.implement", "implement", []string{"bead-1"}, 0, "w-auto"))
+	tasks, err = database.GetWorkTasks(ctx, "w-auto")
+	require.NoError(t, err)
+	taskID, err = CreateAutoPRTask(ctx, database, work, tasks)
+	require.NoError(t, err)
+	require.NotEmpty(t, taskID)
+	task, err := database.GetTask(ctx, taskID)
+	require.NoError(t, err)
+	assert.Equal(t, "pr", task.TaskType)
+
+	// A restarted orchestrator finds the PR task and creates no second one
+	tasks, err = database.GetWorkTasks(ctx, "w-auto")
+	require.NoError(t, err)
+	taskID, err = CreateAutoPRTask(ctx, database, work, tasks)
+	require.NoError(t, err)
+	assert.Empty(t, taskID)
+
+	// Neither does a work that already has a PR
+	createTestWork(ctx, t, database, "w-has-pr", "has-pr-branch")
+	require.NoError(t, database.CreateTask(ctx, "w-has-pr.1", "implement", []string{"bead-2"}, 0, "w-has-pr"))
+	work, err = database.GetWork(ctx, "w-has-pr")
+	require.NoError(t, err)
+	work.PRURL = "https://github.com/org/repo/pull/1"
+	tasks, err = database.GetWorkTasks(ctx, "w-has-pr")
+	require.NoError(t, err)
+	taskID, err = CreateAutoPRTask(ctx, database, work, tasks)
+	require.NoError(t, err)
+	assert.Empty(t, taskID)
+}
+
+func TestClaimReadyTasks(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	createTestWork(ctx, t, database, "w-claim", "claim-branch")
+	for _, id := range []string{"w-claim.1", "w-claim.2", "w-claim.3", "w-claim.4"} {
+		require.NoError(t, database.CreateTask(ctx, id, "implement", []string{id + "-bead"}, 10, "w-claim"))
+	}
+
+	// A live process holds task 1; a process that has exited holds task 2
+	ok, err := database.ClaimTask(ctx, "w-claim.1", db.TaskClaimant(), "")
+	require.NoError(t, err)
+	require.True(t, ok)
+	exited := exec.Command("true")
+	require.NoError(t, exited.Run())
+	hostname, _ := os.Hostname()
+	ok, err = database.ClaimTask(ctx, "w-claim.2", hostname+":"+strconv.Itoa(exited.Process.Pid), "")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ready, err := database.GetReadyTasksForWork(ctx, "w-claim")
+	require.NoError(t, err)
+	claimed, err := ClaimReadyTasks(ctx, database, ready, "orchestrator:1", 2)
+	require.NoError(t, err)
+
+	var ids []string
+	for _, task := range claimed {
+		ids = append(ids, task.ID)
+	}
+	assert.Equal(t, []string{"w-claim.3", "w-claim.4"}, ids)
+
+	// The dead claim was released for a later pass; the live one was kept
+	claimant, err := database.GetTaskClaimant(ctx, "w-claim.2")
+	require.NoError(t, err)
+	assert.Empty(t, claimant)
+	claimant, err = database.GetTaskClaimant(ctx, "w-claim.1")
+	require.NoError(t, err)
+	assert.Equal(t, db.TaskClaimant(), claimant)
+}
@@ -0,0 +1,72 @@
+package orchestration
+
+import (
+	"github.com/newhook/co/internal/db"
+)
+
+// ReadyTasks returns the pending tasks whose dependencies have all completed,
+// in the order given. tasks are a work's tasks in position order and deps
+// maps a task ID to the IDs of the tasks it depends on. Dependencies on tasks
+// outside tasks don't hold a task back. The orchestrator starts the first of
+// them, or the first few when it runs tasks in parallel.
+func ReadyTasks(tasks []*db.Task, deps map[string][]string) []*db.Task {
+	status := make(map[string]string, len(tasks))
+	for _, t := range tasks {
+		status[t.ID] = t.Status
+	}
+	return readyTasks(tasks, deps, status)
+}
+
+// TaskQueue returns the pending tasks in the order the orchestrator will
+// start them, one at a time: the first is the next task to run, and each
+// one after it follows once the tasks before it have completed. Tasks being
+// processed are assumed to complete. Pending tasks that wait on a failed
+// task are left out, since they can't start until it is reset.
+func TaskQueue(tasks []*db.Task, deps map[string][]string) []*db.Task {
+	status := make(map[string]string, len(tasks))
+	for _, t := range tasks {
+		status[t.ID] = t.Status
+	}
+
+	var queue []*db.Task
+	for {
+		ready := readyTasks(tasks, deps, status)
+		if len(ready) == 0 {
+			// Nothing can start until the running tasks finish
+			finished := false
+			for id, s := range status {
+				if s == db.StatusProcessing {
+					status[id] = db.StatusCompleted
+					finished = true
+				}
+			}
+			if !finished {
+				return queue
+			}
+			continue
+		}
+		next := ready[0]
+		queue = append(queue, next)
+		status[next.ID] = db.StatusCompleted
+	}
+}
+
+func readyTasks(tasks []*db.Task, deps map[string][]string, status map[string]string) []*db.Task {
+	var ready []*db.Task
+	for _, t := range tasks {
+		if status[t.ID] != db.StatusPending {
+			continue
+		}
+		blocked := false
+		for _, dep := range deps[t.ID] {
+			if s, ok := status[dep]; ok && s != db.StatusCompleted {
+				blocked = true
+				break
+			}
+		}
+		if !blocked {
+			ready = append(ready, t)
+		}
+	}
+	return ready
+}
@@ -0,0 +1,58 @@
+package orchestration
+
+import (
+	"testing"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/stretchr/testify/assert"
+)
+
+func taskIDs(tasks []*db.Task) []string {
+	var ids []string
+	for _, t := range tasks {
+		ids = append(ids, t.ID)
+	}
+	return ids
+}
+
+func TestReadyTasks(t *testing.T) {
+	tasks := []*db.Task{
+		{ID: "w.1", Status: db.StatusCompleted},
+		{ID: "w.2", Status: db.StatusProcessing},
+		{ID: "w.3", Status: db.StatusPending},
+		{ID: "w.4", Status: db.StatusPending},
+		{ID: "w.5", Status: db.StatusPending},
+	}
+	deps := map[string][]string{
+		"w.3": {"w.2"},
+		"w.4": {"w.1", "w-other.1"}, // Tasks of other works don't hold it back
+	}
+	assert.Equal(t, []string{"w.4", "w.5"}, taskIDs(ReadyTasks(tasks, deps)))
+	assert.Empty(t, ReadyTasks(tasks[:3], deps))
+}
+
+func TestTaskQueue(t *testing.T) {
+	tasks := []*db.Task{
+		{ID: "w.1", Status: db.StatusProcessing},
+		{ID: "w.2", Status: db.StatusPending},
+		{ID: "w.3", Status: db.StatusPending},
+		{ID: "w.4", Status: db.StatusPending},
+		{ID: "w.5", Status: db.StatusFailed},
+		{ID: "w.6", Status: db.StatusPending},
+	}
+	deps := map[string][]string{
+		"w.2": {"w.1"},
+		"w.3": {"w.4"},
+		"w.6": {"w.5"},
+	}
+
+	// w.4 is the only task ready now; w.3 follows it before w.2, which waits
+	// on the running task. w.6 waits on a failed task and isn't queued.
+	queue := TaskQueue(tasks, deps)
+	assert.Equal(t, []string{"w.4", "w.3", "w.2"}, taskIDs(queue))
+	assert.Equal(t, ReadyTasks(tasks, deps)[0], queue[0], "the head of the queue is what the orchestrator starts")
+
+	// The statuses passed in are left alone
+	assert.Equal(t, db.StatusProcessing, tasks[0].Status)
+	assert.Empty(t, TaskQueue(tasks[4:], deps))
+}
@@ -3,6 +3,7 @@ package orchestration
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/newhook/co/internal/beads"
 	"github.com/newhook/co/internal/db"
@@ -14,7 +15,9 @@ import (
 // This is called when the orchestrator starts and finds tasks that were
 // marked as processing from a previous run. When the orchestrator is killed
 // while a task is running - the Claude process is also killed, but the task
-// remains marked as processing in the database.
+// remains marked as processing in the database. Tasks whose claimant is still
+// alive run in their own tab and are left alone; claims on pending tasks
+// left by dead processes are released.
 //
 // This function preserves partial bead progress by checking the actual bead
 // status in beads.jsonl before resetting. Beads that are already closed are
@@ -28,45 +31,118 @@ func ResetStuckProcessingTasks(ctx context.Context, proj *project.Project, workI
 
 	resetCount := 0
 	for _, t := range tasks {
-		if t.Status == db.StatusProcessing {
-			fmt.Printf("Resetting stuck task %s from processing to pending...\n", t.ID)
+		if t.Status != db.StatusPending && t.Status != db.StatusProcessing {
+			continue
+		}
+		// A task claimed by a live process is still running in its own tab
+		claimant, err := proj.DB.GetTaskClaimant(ctx, t.ID)
+		if err != nil {
+			return err
+		}
+		if claimant != "" && db.IsClaimantAlive(claimant) {
+			if t.Status == db.StatusProcessing {
+				fmt.Printf("Task %s is still running (%s), leaving it\n", t.ID, claimant)
+			}
+			continue
+		}
+		if t.Status == db.StatusPending {
+			// Claimed by a process that died before starting it
+			if claimant != "" {
+				if err := proj.DB.ResetTaskStatus(ctx, t.ID); err != nil {
+					return fmt.Errorf("failed to release claim on task %s: %w", t.ID, err)
+				}
+			}
+			continue
+		}
+		if err := resetProcessingTask(ctx, proj, t.ID, workID, "orchestrator startup"); err != nil {
+			return err
+		}
+		resetCount++
+	}
+
+	if resetCount > 0 {
+		fmt.Printf("Reset %d stuck task(s)\n", resetCount)
+	}
+
+	return nil
+}
+
+// ReclaimStaleTasks resets the processing tasks of a work whose claimant has
+// died, or whose claim is older than staleAfter, back to pending. The
+// orchestrator runs it on every pass of its scheduling loop so a task whose
+// tab was closed doesn't hold a parallelism slot for the rest of the run.
+// Returns the number of tasks reset.
+func ReclaimStaleTasks(ctx context.Context, proj *project.Project, workID string, staleAfter time.Duration) (int, error) {
+	tasks, err := proj.DB.GetWorkTasks(ctx, workID)
+	if err != nil {
+		return 0, err
+	}
 
-			// Preserve partial bead progress by checking actual bead status
-			preservedCount, resetBeadCount, err := ResetTaskBeadsWithProgress(ctx, proj, t.ID, workID)
+	resetCount := 0
+	for _, t := range tasks {
+		if t.Status != db.StatusProcessing {
+			continue
+		}
+		claimant, err := proj.DB.GetTaskClaimant(ctx, t.ID)
+		if err != nil {
+			return resetCount, err
+		}
+		if claimant == "" {
+			continue
+		}
+		if db.IsClaimantAlive(claimant) {
+			claimedAt, err := proj.DB.GetTaskClaimedAt(ctx, t.ID)
 			if err != nil {
-				return fmt.Errorf("failed to reset task beads for %s: %w", t.ID, err)
+				return resetCount, err
 			}
-
-			if preservedCount > 0 {
-				fmt.Printf("  Preserved %d already-completed bead(s), reset %d bead(s)\n", preservedCount, resetBeadCount)
-				logging.Info("preserved partial bead progress during task reset",
-					"task_id", t.ID,
-					"preserved_count", preservedCount,
-					"reset_count", resetBeadCount,
-				)
+			if claimedAt == nil || time.Since(*claimedAt) <= staleAfter {
+				continue
 			}
+			fmt.Printf("Task %s has been claimed by %s for over %v, reclaiming it\n", t.ID, claimant, staleAfter)
+		} else {
+			fmt.Printf("Task %s was claimed by %s, which is no longer running, reclaiming it\n", t.ID, claimant)
+		}
+		if err := resetProcessingTask(ctx, proj, t.ID, workID, "stale claim"); err != nil {
+			return resetCount, err
+		}
+		resetCount++
+	}
+	return resetCount, nil
+}
 
-			if err := proj.DB.ResetTaskStatus(ctx, t.ID); err != nil {
-				return fmt.Errorf("failed to reset task %s: %w", t.ID, err)
-			}
+// resetProcessingTask resets a processing task and its unfinished beads back
+// to pending; reason says why, for the log.
+func resetProcessingTask(ctx context.Context, proj *project.Project, taskID, workID, reason string) error {
+	fmt.Printf("Resetting stuck task %s from processing to pending...\n", taskID)
 
-			// Log task reset event
-			logging.Debug("task reset from processing to pending on orchestrator startup",
-				"event_type", "task_reset",
-				"task_id", t.ID,
-				"work_id", workID,
-				"preserved_beads", preservedCount,
-				"reset_beads", resetBeadCount,
-			)
+	// Preserve partial bead progress by checking actual bead status
+	preservedCount, resetBeadCount, err := ResetTaskBeadsWithProgress(ctx, proj, taskID, workID)
+	if err != nil {
+		return fmt.Errorf("failed to reset task beads for %s: %w", taskID, err)
+	}
 
-			resetCount++
-		}
+	if preservedCount > 0 {
+		fmt.Printf("  Preserved %d already-completed bead(s), reset %d bead(s)\n", preservedCount, resetBeadCount)
+		logging.Info("preserved partial bead progress during task reset",
+			"task_id", taskID,
+			"preserved_count", preservedCount,
+			"reset_count", resetBeadCount,
+		)
 	}
 
-	if resetCount > 0 {
-		fmt.Printf("Reset %d stuck task(s)\n", resetCount)
+	if err := proj.DB.ResetTaskStatus(ctx, taskID); err != nil {
+		return fmt.Errorf("failed to reset task %s: %w", taskID, err)
 	}
 
+	// Log task reset event
+	logging.Debug("task reset from processing to pending",
+		"event_type", "task_reset",
+		"task_id", taskID,
+		"work_id", workID,
+		"reason", reason,
+		"preserved_beads", preservedCount,
+		"reset_beads", resetBeadCount,
+	)
 	return nil
 }
 
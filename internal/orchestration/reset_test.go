@@ -0,0 +1,65 @@
+package orchestration
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/project"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReclaimStaleTasks(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup := setupTestDB(t)
+	defer cleanup()
+	proj := &project.Project{DB: database}
+
+	createTestWork(ctx, t, database, "w-stale", "stale-branch")
+	exited := exec.Command("true")
+	require.NoError(t, exited.Run())
+	hostname, _ := os.Hostname()
+	claimants := map[string]string{
+		"w-stale.1": db.TaskClaimant(),
+		"w-stale.2": hostname + ":" + strconv.Itoa(exited.Process.Pid),
+		"w-stale.3": "",
+	}
+	for id, claimant := range claimants {
+		require.NoError(t, database.CreateTask(ctx, id, "implement", nil, 10, "w-stale"))
+		if claimant != "" {
+			ok, err := database.ClaimTask(ctx, id, claimant, "")
+			require.NoError(t, err)
+			require.True(t, ok)
+		}
+		require.NoError(t, database.StartTask(ctx, id, "/tmp/tree"))
+	}
+
+	status := func(id string) string {
+		task, err := database.GetTask(ctx, id)
+		require.NoError(t, err)
+		return task.Status
+	}
+
+	// Only the task whose claimant exited gives up its slot
+	n, err := ReclaimStaleTasks(ctx, proj, "w-stale", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, db.StatusProcessing, status("w-stale.1"))
+	assert.Equal(t, db.StatusPending, status("w-stale.2"))
+	assert.Equal(t, db.StatusProcessing, status("w-stale.3"))
+
+	// A live claim older than staleAfter is reclaimed too
+	n, err = ReclaimStaleTasks(ctx, proj, "w-stale", 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, db.StatusPending, status("w-stale.1"))
+	claimant, err := database.GetTaskClaimant(ctx, "w-stale.1")
+	require.NoError(t, err)
+	assert.Empty(t, claimant)
+	assert.Equal(t, db.StatusProcessing, status("w-stale.3"))
+}
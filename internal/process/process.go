@@ -5,8 +5,14 @@ package process
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
 	"strings"
+	"syscall"
+	"time"
 )
 
 // ProcessLister provides an interface for listing processes.
@@ -75,3 +81,58 @@ func KillProcessWith(ctx context.Context, pattern string, lister ProcessLister,
 
 	return killer.KillByPattern(ctx, pattern)
 }
+
+// TerminatePID asks the process with the given PID to exit, and kills it if
+// it's still running after grace. A process that's already gone is not an
+// error.
+func TerminatePID(pid int, grace time.Duration) error {
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return nil
+	}
+	if err := proc.Signal(syscall.SIGTERM); err != nil {
+		// Already gone
+		return nil
+	}
+	for deadline := time.Now().Add(grace); time.Now().Before(deadline); {
+		if proc.Signal(syscall.Signal(0)) != nil {
+			return nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	if err := proc.Signal(syscall.SIGKILL); err != nil && proc.Signal(syscall.Signal(0)) == nil {
+		return fmt.Errorf("failed to kill process %d: %w", pid, err)
+	}
+	return nil
+}
+
+// IsPIDAlive reports whether a process with the given PID is running.
+func IsPIDAlive(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	proc, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	// On Unix FindProcess always succeeds; signal 0 checks the process exists
+	return proc.Signal(syscall.Signal(0)) == nil
+}
+
+// CommandLine returns the command line of the process with the given PID, as
+// ps shows it, or "" if there is no such process.
+func CommandLine(ctx context.Context, pid int) (string, error) {
+	if pid <= 0 {
+		return "", nil
+	}
+	output, err := exec.CommandContext(ctx, "ps", "-o", "command=", "-p", strconv.Itoa(pid)).Output()
+	if err != nil {
+		// ps exits 1 when no process has the PID
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to look up process %d: %w", pid, err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
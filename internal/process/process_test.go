@@ -3,6 +3,7 @@ package process_test
 import (
 	"context"
 	"errors"
+	"os/exec"
 	"testing"
 
 	"github.com/newhook/co/internal/process"
@@ -178,3 +179,22 @@ func TestKillProcessWith_ListerError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to get process list")
 	assert.Empty(t, killer.KillByPatternCalls())
 }
+
+func TestCommandLine(t *testing.T) {
+	ctx := context.Background()
+	cmd := exec.Command("sleep", "30")
+	require.NoError(t, cmd.Start())
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	cmdline, err := process.CommandLine(ctx, cmd.Process.Pid)
+	require.NoError(t, err)
+	assert.Equal(t, "sleep 30", cmdline)
+
+	// A PID no process can have
+	cmdline, err = process.CommandLine(ctx, 1<<30)
+	require.NoError(t, err)
+	assert.Empty(t, cmdline)
+}
@@ -14,9 +14,14 @@ import (
 	"github.com/newhook/co/internal/logging"
 )
 
+// ProgressWindow is how long an orchestrator's loop may go without calling
+// Progress before its heartbeat stops. The loop waits at most 10s between
+// iterations.
+const ProgressWindow = time.Minute
+
 // Manager handles process registration, heartbeat updates, and cleanup.
 type Manager struct {
-	db        *db.DB
+	db        db.Store
 	id        string
 	procType  string
 	workID    *string
@@ -28,10 +33,15 @@ type Manager struct {
 	stopCh    chan struct{}
 	stoppedCh chan struct{}
 	triggerCh chan chan error // For testing: trigger immediate heartbeat
+
+	// progressDeadline is when an orchestrator's heartbeat stops unless its
+	// loop calls Progress again; zero for a control plane, which always beats
+	progressDeadline time.Time
+	stalled          bool // The heartbeat stopped for want of progress
 }
 
 // NewManager creates a new process manager.
-func NewManager(database *db.DB, heartbeatInterval time.Duration) *Manager {
+func NewManager(database db.Store, heartbeatInterval time.Duration) *Manager {
 	if heartbeatInterval <= 0 {
 		heartbeatInterval = db.DefaultHeartbeatInterval
 	}
@@ -100,6 +110,7 @@ func (m *Manager) RegisterOrchestrator(ctx context.Context, workID string) error
 	m.id = uuid.New().String()
 	m.procType = db.ProcessTypeOrchestrator
 	m.workID = &workID
+	m.progressDeadline = m.nowFunc().Add(ProgressWindow)
 
 	if err := m.db.RegisterProcess(ctx, m.id, m.procType, &workID, os.Getpid()); err != nil {
 		return fmt.Errorf("failed to register orchestrator: %w", err)
@@ -127,17 +138,57 @@ func (m *Manager) startHeartbeat() {
 			case <-m.stopCh:
 				return
 			case <-ticker.C:
-				if err := m.db.UpdateHeartbeatWithTime(context.Background(), m.id, m.nowFunc()); err != nil {
+				if err := m.beat(); err != nil {
 					logging.Warn("failed to update heartbeat", "id", m.id, "error", err)
 				}
 			case resultCh := <-m.triggerCh:
-				err := m.db.UpdateHeartbeatWithTime(context.Background(), m.id, m.nowFunc())
-				resultCh <- err
+				resultCh <- m.beat()
 			}
 		}
 	}()
 }
 
+// beat updates the heartbeat, unless an orchestrator's loop has stopped
+// making progress: the process is still there, but its heartbeat going
+// stale is what shows it's wedged.
+func (m *Manager) beat() error {
+	now := m.nowFunc()
+	m.mu.Lock()
+	deadline := m.progressDeadline
+	stalled := !deadline.IsZero() && now.After(deadline)
+	logStall := stalled && !m.stalled
+	m.stalled = stalled
+	m.mu.Unlock()
+	if stalled {
+		if logStall {
+			logging.Warn("orchestrator loop made no progress, heartbeat stopped", "id", m.id, "deadline", deadline)
+		}
+		return nil
+	}
+	return m.db.UpdateHeartbeatWithTime(context.Background(), m.id, now)
+}
+
+// Progress records that an orchestrator's loop made progress and expects it
+// to again within d, or to finish what it's starting, such as a task, in
+// that time. The heartbeat is only updated while that holds.
+func (m *Manager) Progress(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.progressDeadline = m.nowFunc().Add(d)
+}
+
+// SetCurrentTask records the task this process is running alongside its
+// heartbeat; an empty taskID records that it's idle.
+func (m *Manager) SetCurrentTask(ctx context.Context, taskID string) error {
+	m.mu.Lock()
+	running, id := m.running, m.id
+	m.mu.Unlock()
+	if !running {
+		return fmt.Errorf("manager not running")
+	}
+	return m.db.SetProcessCurrentTask(ctx, id, taskID)
+}
+
 // TriggerHeartbeat forces an immediate heartbeat update and waits for it to complete.
 // This is primarily for testing purposes to avoid time-based sleeps.
 func (m *Manager) TriggerHeartbeat() error {
@@ -187,6 +238,8 @@ func (m *Manager) IsControlPlaneAlive(ctx context.Context) (bool, error) {
 }
 
 // CleanupStaleProcessRecords removes database records for processes with stale heartbeats.
+// Records of processes that still exist are kept: they're likely wedged, and
+// the record is what lets them be shown as such and restarted by PID.
 // This should be called periodically by a cleanup routine.
 func (m *Manager) CleanupStaleProcessRecords(ctx context.Context) error {
 	staleProcs, err := m.db.GetStaleProcesses(ctx, db.DefaultStalenessThreshold)
@@ -195,15 +248,26 @@ func (m *Manager) CleanupStaleProcessRecords(ctx context.Context) error {
 	}
 
 	for _, p := range staleProcs {
+		if p.IsRunning() {
+			logging.Warn("process is running but its heartbeat is stale",
+				"id", p.ID,
+				"type", p.ProcessType,
+				"workID", p.WorkID,
+				"pid", p.PID,
+				"lastHeartbeat", p.Heartbeat)
+			continue
+		}
 		logging.Info("cleaning up stale process",
 			"id", p.ID,
 			"type", p.ProcessType,
 			"workID", p.WorkID,
 			"pid", p.PID,
 			"lastHeartbeat", p.Heartbeat)
+		if err := m.db.UnregisterProcess(ctx, p.ID); err != nil {
+			return err
+		}
 	}
-
-	return m.db.CleanupStaleProcesses(ctx, db.DefaultStalenessThreshold)
+	return nil
 }
 
 // GetOrchestratorProcess retrieves the orchestrator process for a work ID.
@@ -2,6 +2,7 @@ package procmon
 
 import (
 	"context"
+	"os"
 	"testing"
 	"time"
 
@@ -157,6 +158,42 @@ func TestHeartbeatUpdates(t *testing.T) {
 		"heartbeat should be set to mock time")
 }
 
+func TestOrchestratorHeartbeatFollowsProgress(t *testing.T) {
+	database, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	m := NewManager(database, time.Hour)
+	defer m.Stop()
+	now := time.Date(2099, 1, 1, 12, 0, 0, 0, time.UTC)
+	m.SetNowFunc(func() time.Time { return now })
+	require.NoError(t, m.RegisterOrchestrator(ctx, "work-123"))
+
+	heartbeat := func() time.Time {
+		proc, err := database.GetOrchestratorProcess(ctx, "work-123")
+		require.NoError(t, err)
+		return proc.Heartbeat
+	}
+
+	// Within the window of registering, the heartbeat is kept up
+	now = now.Add(ProgressWindow / 2)
+	require.NoError(t, m.TriggerHeartbeat())
+	assert.Equal(t, now, heartbeat())
+
+	// A loop that stops coming round stops the heartbeat, though the
+	// process and its ticker live on
+	beaten := now
+	now = now.Add(ProgressWindow)
+	require.NoError(t, m.TriggerHeartbeat())
+	assert.Equal(t, beaten, heartbeat())
+
+	// Progress starts it again
+	m.Progress(ProgressWindow)
+	now = now.Add(time.Second)
+	require.NoError(t, m.TriggerHeartbeat())
+	assert.Equal(t, now, heartbeat())
+}
+
 func TestIsOrchestratorAlive(t *testing.T) {
 	database, cleanup := setupTestDB(t)
 	defer cleanup()
@@ -213,7 +250,8 @@ func TestCleanupStaleProcessRecords(t *testing.T) {
 	// Register a process directly in the database with an old heartbeat
 	// to simulate a stale process
 	workID := "stale-work"
-	err := database.RegisterProcess(ctx, "stale-id", db.ProcessTypeOrchestrator, &workID, 12345)
+	// PID no process can have, so the record is of a process that is gone
+	err := database.RegisterProcess(ctx, "stale-id", db.ProcessTypeOrchestrator, &workID, 1<<30)
 	require.NoError(t, err)
 
 	// Set heartbeat to 60 seconds ago to simulate a stale process
@@ -319,3 +357,44 @@ func TestGetAllProcesses(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, procs, 3, "expected 3 processes (1 control plane + 2 orchestrators)")
 }
+
+func TestOrchestratorHealth(t *testing.T) {
+	database, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	health, proc, err := database.GetOrchestratorHealth(ctx, "work-123", db.DefaultStalenessThreshold)
+	require.NoError(t, err)
+	assert.Equal(t, db.OrchestratorDown, health)
+	assert.Nil(t, proc)
+
+	m := NewManager(database, time.Hour)
+	require.NoError(t, m.RegisterOrchestrator(ctx, "work-123"))
+	require.NoError(t, m.SetCurrentTask(ctx, "work-123.2"))
+
+	health, proc, err = database.GetOrchestratorHealth(ctx, "work-123", db.DefaultStalenessThreshold)
+	require.NoError(t, err)
+	assert.Equal(t, db.OrchestratorRunning, health)
+	assert.Equal(t, os.Getpid(), proc.PID)
+	assert.Equal(t, "work-123.2", proc.CurrentTask)
+
+	// The heartbeat stops while the process lives on: wedged, and kept by cleanup
+	require.NoError(t, database.UpdateHeartbeatWithTime(ctx, proc.ID, time.Now().Add(-time.Minute)))
+	health, _, err = database.GetOrchestratorHealth(ctx, "work-123", db.DefaultStalenessThreshold)
+	require.NoError(t, err)
+	assert.Equal(t, db.OrchestratorStale, health)
+	require.NoError(t, m.CleanupStaleProcessRecords(ctx))
+	proc, err = database.GetOrchestratorProcess(ctx, "work-123")
+	require.NoError(t, err)
+	require.NotNil(t, proc, "a wedged process keeps its record")
+
+	// Once the process is gone the orchestrator is down
+	m.Stop()
+	workID := "work-456"
+	require.NoError(t, database.RegisterProcess(ctx, "gone-id", db.ProcessTypeOrchestrator, &workID, 1<<30))
+	require.NoError(t, database.UpdateHeartbeatWithTime(ctx, "gone-id", time.Now().Add(-time.Minute)))
+	health, proc, err = database.GetOrchestratorHealth(ctx, workID, db.DefaultStalenessThreshold)
+	require.NoError(t, err)
+	assert.Equal(t, db.OrchestratorDown, health)
+	assert.NotNil(t, proc)
+}
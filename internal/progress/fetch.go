@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 
 	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/orchestration"
 	"github.com/newhook/co/internal/project"
 )
 
@@ -39,7 +41,27 @@ func FetchTaskPollData(ctx context.Context, proj *project.Project, taskID string
 		return nil, fmt.Errorf("failed to get beads: %w", err)
 	}
 
-	tp := &TaskProgress{Task: task}
+	dependsOn, err := proj.DB.GetTaskDependencies(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task dependencies: %w", err)
+	}
+
+	title, err := proj.DB.GetTaskMetadata(ctx, taskID, "title")
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := proj.DB.GetTaskRuns(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	steps, err := proj.DB.GetTaskSteps(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	tp := &TaskProgress{Task: task, DependsOn: dependsOn, Title: title, Runs: runs, Steps: steps}
 	for _, beadID := range beadIDs {
 		status, err := proj.DB.GetTaskBeadStatus(ctx, taskID, beadID)
 		if err != nil {
@@ -53,6 +75,7 @@ func FetchTaskPollData(ctx context.Context, proj *project.Project, taskID string
 			bp.Title = bead.Title
 			bp.Description = bead.Description
 			bp.BeadStatus = bead.Status
+			bp.BlockedBy = beadsResult.OpenBlockers(bp.ID)
 		}
 		tp.Beads = append(tp.Beads, bp)
 	}
@@ -101,6 +124,10 @@ func FetchAllWorksPollData(ctx context.Context, proj *project.Project) ([]*WorkP
 // FetchWorkProgress fetches progress data for a single work
 func FetchWorkProgress(ctx context.Context, proj *project.Project, work *db.Work) (*WorkProgress, error) {
 	wp := &WorkProgress{Work: work}
+	if work.WorktreePath != "" && !work.IsRemote() {
+		info, err := os.Stat(work.WorktreePath)
+		wp.WorktreeMissing = err != nil || !info.IsDir()
+	}
 
 	tasks, err := proj.DB.GetWorkTasks(ctx, work.ID)
 	if err != nil {
@@ -151,6 +178,21 @@ func FetchWorkProgress(ctx context.Context, proj *project.Project, work *db.Work
 		return nil, fmt.Errorf("failed to get beads: %w", err)
 	}
 
+	taskDeps, err := proj.DB.GetTaskDependenciesForWork(ctx, work.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task dependencies: %w", err)
+	}
+
+	taskRuns, err := proj.DB.GetTaskRunsForWork(ctx, work.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskSteps, err := proj.DB.GetTaskStepsForWork(ctx, work.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build a map of task ID -> beads for efficient lookup
 	taskBeadsMap := make(map[string][]db.TaskBeadInfo)
 	for _, tb := range allTaskBeads {
@@ -158,7 +200,13 @@ func FetchWorkProgress(ctx context.Context, proj *project.Project, work *db.Work
 	}
 
 	for _, task := range tasks {
-		tp := &TaskProgress{Task: task}
+		title, err := proj.DB.GetTaskMetadata(ctx, task.ID, "title")
+		if err != nil {
+			return nil, err
+		}
+		tp := &TaskProgress{Task: task, DependsOn: taskDeps[task.ID], Title: title, Runs: taskRuns[task.ID], Steps: taskSteps[task.ID]}
+		// Unreadable artifacts just aren't listed; they don't affect progress
+		tp.Artifacts, _ = proj.ListTaskArtifacts(work.ID, task.ID)
 		for _, tb := range taskBeadsMap[task.ID] {
 			status := tb.Status
 			if status == "" {
@@ -169,12 +217,22 @@ func FetchWorkProgress(ctx context.Context, proj *project.Project, work *db.Work
 				bp.Title = bead.Title
 				bp.Description = bead.Description
 				bp.BeadStatus = bead.Status
+				bp.BlockedBy = beadsResult.OpenBlockers(bp.ID)
 			}
 			tp.Beads = append(tp.Beads, bp)
 		}
 		wp.Tasks = append(wp.Tasks, tp)
 	}
 
+	// Number the pending tasks in the order the orchestrator will run them
+	for i, queued := range orchestration.TaskQueue(tasks, taskDeps) {
+		for _, tp := range wp.Tasks {
+			if tp.Task.ID == queued.ID {
+				tp.QueuePosition = i + 1
+			}
+		}
+	}
+
 	// Populate work beads
 	for _, wb := range allWorkBeads {
 		bp := BeadProgress{ID: wb.BeadID}
@@ -182,6 +240,7 @@ func FetchWorkProgress(ctx context.Context, proj *project.Project, work *db.Work
 			bp.Title = bead.Title
 			bp.Description = bead.Description
 			bp.BeadStatus = bead.Status
+			bp.BlockedBy = beadsResult.OpenBlockers(bp.ID)
 			bp.Priority = bead.Priority
 			bp.IssueType = bead.Type
 		}
@@ -206,6 +265,7 @@ func FetchWorkProgress(ctx context.Context, proj *project.Project, work *db.Work
 					BeadStatus:  rootBead.Status,
 					Priority:    rootBead.Priority,
 					IssueType:   rootBead.Type,
+					BlockedBy:   beadsResult.OpenBlockers(rootBead.ID),
 				}
 				// Prepend root issue so it appears first
 				wp.WorkBeads = append([]BeadProgress{bp}, wp.WorkBeads...)
@@ -224,6 +284,7 @@ func FetchWorkProgress(ctx context.Context, proj *project.Project, work *db.Work
 			bp.Title = bead.Title
 			bp.Description = bead.Description
 			bp.BeadStatus = bead.Status
+			bp.BlockedBy = beadsResult.OpenBlockers(bp.ID)
 			bp.Priority = bead.Priority
 			bp.IssueType = bead.Type
 		}
@@ -2,6 +2,7 @@ package progress
 
 import (
 	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/project"
 )
 
 // WorkProgress holds progress info for a work unit.
@@ -13,6 +14,7 @@ type WorkProgress struct {
 	UnassignedBeadCount int
 	FeedbackCount       int      // count of unresolved PR feedback items
 	FeedbackBeadIDs     []string // bead IDs from unassigned PR feedback
+	WorktreeMissing     bool     // WorktreePath is set but the directory is gone, e.g. the repo was moved
 
 	// PR status fields (populated from work record)
 	CIStatus           string   // pending, success, failure
@@ -26,6 +28,19 @@ type WorkProgress struct {
 type TaskProgress struct {
 	Task  *db.Task
 	Beads []BeadProgress
+	// DependsOn holds the IDs of tasks that must complete before this one runs.
+	DependsOn []string
+	// Title is the task's "title" metadata, set when a reviewed plan names it.
+	Title string
+	// Artifacts are the files the task wrote to its artifact directory.
+	Artifacts []project.TaskArtifact
+	// Runs are the attempts at the task, oldest first.
+	Runs []*db.TaskRun
+	// Steps are the steps the task reported, oldest first.
+	Steps []*db.TaskStep
+	// QueuePosition is where a pending task stands in the orchestrator's
+	// queue, 1 being the next to run. It is 0 for tasks that aren't queued.
+	QueuePosition int
 }
 
 // BeadProgress holds progress info for a bead.
@@ -37,4 +52,14 @@ type BeadProgress struct {
 	BeadStatus  string // status from beads (open/closed)
 	Priority    int
 	IssueType   string
+	BlockedBy   []string // open beads blocking this one; empty when it's ready to work on
+}
+
+// LatestStep returns the step the task reported last, or nil if it hasn't
+// reported any.
+func (t *TaskProgress) LatestStep() *db.TaskStep {
+	if len(t.Steps) == 0 {
+		return nil
+	}
+	return t.Steps[len(t.Steps)-1]
 }
@@ -0,0 +1,72 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// TasksDir is the directory under ConfigDir that holds each task's artifacts.
+const TasksDir = "tasks"
+
+// ArtifactDirEnv is the environment variable that tells a task's Claude
+// session where to write its artifacts.
+const ArtifactDirEnv = "CO_ARTIFACT_DIR"
+
+// TaskArtifact is a file a task produced, such as a review report or a plan.
+type TaskArtifact struct {
+	Name    string // Path relative to the task's artifact directory, with forward slashes
+	Path    string // Absolute path
+	Size    int64
+	ModTime time.Time
+}
+
+// TaskArtifactDir returns the directory a task's artifacts are written to:
+// .co/tasks/<work-id>/<task-id> in the project.
+func (p *Project) TaskArtifactDir(workID, taskID string) string {
+	return filepath.Join(p.Root, ConfigDir, TasksDir, workID, taskID)
+}
+
+// ListTaskArtifacts returns the files under a task's artifact directory,
+// sorted by name. A task that hasn't written any has none.
+func (p *Project) ListTaskArtifacts(workID, taskID string) ([]TaskArtifact, error) {
+	dir := p.TaskArtifactDir(workID, taskID)
+	var artifacts []TaskArtifact
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		artifacts = append(artifacts, TaskArtifact{
+			Name:    filepath.ToSlash(rel),
+			Path:    path,
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list artifacts of task %s: %w", taskID, err)
+	}
+	sort.Slice(artifacts, func(i, j int) bool {
+		return artifacts[i].Name < artifacts[j].Name
+	})
+	return artifacts, nil
+}
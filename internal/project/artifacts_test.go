@@ -0,0 +1,32 @@
+package project
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTaskArtifacts(t *testing.T) {
+	p := &Project{Root: t.TempDir()}
+	dir := p.TaskArtifactDir("w-abc", "w-abc.2")
+	require.Equal(t, filepath.Join(p.Root, ".co", "tasks", "w-abc", "w-abc.2"), dir)
+
+	artifacts, err := p.ListTaskArtifacts("w-abc", "w-abc.2")
+	require.NoError(t, err, "a task without artifacts has none")
+	require.Empty(t, artifacts)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "logs"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "review.md"), []byte("# Review\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "logs", "run.txt"), []byte("ok"), 0644))
+
+	artifacts, err = p.ListTaskArtifacts("w-abc", "w-abc.2")
+	require.NoError(t, err)
+	require.Len(t, artifacts, 2)
+	require.Equal(t, "logs/run.txt", artifacts[0].Name)
+	require.Equal(t, int64(2), artifacts[0].Size)
+	require.Equal(t, "review.md", artifacts[1].Name)
+	require.Equal(t, filepath.Join(dir, "review.md"), artifacts[1].Path)
+	require.False(t, artifacts[1].ModTime.IsZero())
+}
@@ -5,11 +5,16 @@ import (
 	_ "embed"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"text/template"
 	"time"
+	"unicode"
 
 	"github.com/BurntSushi/toml"
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/logging"
 )
 
 //go:embed templates/config.tmpl
@@ -27,6 +32,139 @@ type Config struct {
 	Scheduler SchedulerConfig `toml:"scheduler"`
 	Zellij    ZellijConfig    `toml:"zellij"`
 	LogParser LogParserConfig `toml:"log_parser"`
+	TUI       TUIConfig       `toml:"tui"`
+	GC        GCConfig        `toml:"gc"`
+	Worktree  WorktreeConfig  `toml:"worktree"`
+	Git       GitConfig       `toml:"git"`
+	Remote    RemoteConfig    `toml:"remote"`
+}
+
+// TUIConfig contains TUI display configuration.
+type TUIConfig struct {
+	// Theme selects the TUI color theme.
+	// Valid values: "auto", "dark", "light", "mono"
+	// Defaults to "auto" when not specified.
+	Theme string `toml:"theme"`
+
+	// Icons selects the glyphs statuses are marked with.
+	// Valid values: "unicode", "ascii", "nerd" (needs a Nerd Font)
+	// Defaults to "unicode" when not specified.
+	Icons string `toml:"icons"`
+
+	// NotifyOnComplete sends a desktop notification when the TUI sees a task complete.
+	// Defaults to false.
+	NotifyOnComplete bool `toml:"notify_on_complete"`
+
+	// NotifyOnFail sends a desktop notification when the TUI sees a task fail.
+	// Defaults to false.
+	NotifyOnFail bool `toml:"notify_on_fail"`
+
+	// WorkRefresh is how often the TUI rechecks orchestrator health, and
+	// reloads the works when the tracking watcher isn't running. A duration
+	// such as "2s", at least 500ms. Defaults to "5s".
+	WorkRefresh string `toml:"work_refresh"`
+
+	// PlanRefresh is how often the TUI reloads the issues when the beads
+	// watcher isn't running. A duration such as "10s", at least 500ms.
+	// Defaults to "5s".
+	PlanRefresh string `toml:"plan_refresh"`
+
+	// WatcherEnabled turns the database watchers off when false, so the TUI
+	// polls at WorkRefresh and PlanRefresh instead of reloading on change.
+	// Defaults to true.
+	WatcherEnabled *bool `toml:"watcher_enabled"`
+
+	// DoubleClick is the longest gap between two clicks on the same cell
+	// that still counts as a double-click. A duration such as "300ms".
+	// Defaults to "400ms".
+	DoubleClick string `toml:"double_click"`
+
+	// ConfirmQuit asks before quitting while tasks are processing.
+	// Defaults to true.
+	ConfirmQuit *bool `toml:"confirm_quit"`
+}
+
+// DefaultTUIRefresh is the TUI refresh interval used when none is configured.
+const DefaultTUIRefresh = 5 * time.Second
+
+// MinTUIRefresh is the shortest TUI refresh interval accepted.
+const MinTUIRefresh = 500 * time.Millisecond
+
+// GetWorkRefresh returns the work refresh interval.
+// Defaults to 5 seconds when not specified or invalid.
+func (t *TUIConfig) GetWorkRefresh() time.Duration {
+	return parseTUIRefresh("work_refresh", t.WorkRefresh)
+}
+
+// GetPlanRefresh returns the issue refresh interval.
+// Defaults to 5 seconds when not specified or invalid.
+func (t *TUIConfig) GetPlanRefresh() time.Duration {
+	return parseTUIRefresh("plan_refresh", t.PlanRefresh)
+}
+
+// IsWatcherEnabled returns true if the TUI should watch the databases for changes.
+// Defaults to true when not explicitly configured.
+func (t *TUIConfig) IsWatcherEnabled() bool {
+	if t.WatcherEnabled == nil {
+		return true
+	}
+	return *t.WatcherEnabled
+}
+
+// IsConfirmQuit returns true if quitting while tasks are processing should be confirmed.
+// Defaults to true when not explicitly configured.
+func (t *TUIConfig) IsConfirmQuit() bool {
+	if t.ConfirmQuit == nil {
+		return true
+	}
+	return *t.ConfirmQuit
+}
+
+// parseTUIRefresh parses a [tui] refresh interval. A value that isn't a
+// duration or is below MinTUIRefresh is logged and replaced by the default,
+// so a typo in the config doesn't keep the TUI from starting.
+func parseTUIRefresh(key, value string) time.Duration {
+	if value == "" {
+		return DefaultTUIRefresh
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		logging.Warn("invalid tui refresh interval, using the default", "key", key, "value", value, "default", DefaultTUIRefresh, "error", err)
+		return DefaultTUIRefresh
+	}
+	if d < MinTUIRefresh {
+		logging.Warn("tui refresh interval is below the minimum, using the default", "key", key, "value", value, "minimum", MinTUIRefresh, "default", DefaultTUIRefresh)
+		return DefaultTUIRefresh
+	}
+	return d
+}
+
+// DefaultDoubleClick is the double-click window used when none is configured.
+const DefaultDoubleClick = 400 * time.Millisecond
+
+// GetDoubleClick returns the double-click window.
+// Defaults to 400ms when not specified or invalid.
+func (t *TUIConfig) GetDoubleClick() time.Duration {
+	if t.DoubleClick == "" {
+		return DefaultDoubleClick
+	}
+	d, err := time.ParseDuration(t.DoubleClick)
+	if err != nil || d <= 0 {
+		logging.Warn("invalid tui double_click, using the default", "value", t.DoubleClick, "default", DefaultDoubleClick, "error", err)
+		return DefaultDoubleClick
+	}
+	return d
+}
+
+// ShouldNotify reports whether a task reaching status should send a notification.
+func (t *TUIConfig) ShouldNotify(status string) bool {
+	switch status {
+	case db.StatusCompleted:
+		return t.NotifyOnComplete
+	case db.StatusFailed:
+		return t.NotifyOnFail
+	}
+	return false
 }
 
 // LogParserConfig contains log parser configuration.
@@ -76,6 +214,11 @@ type ClaudeConfig struct {
 	// TaskTimeoutMinutes controls the maximum execution time for a task in minutes.
 	// Defaults to 60 minutes when not specified.
 	TaskTimeoutMinutes *int `toml:"task_timeout_minutes"`
+
+	// Model is passed to claude --model for tasks, e.g. "opus" or a full
+	// model name. When omitted Claude picks its default model.
+	// log_analysis tasks use [log_parser] model instead.
+	Model string `toml:"model"`
 }
 
 // ShouldSkipPermissions returns true if Claude should run with --dangerously-skip-permissions.
@@ -161,6 +304,77 @@ type WorkflowConfig struct {
 	// MaxReviewIterations limits the number of review/fix cycles.
 	// Defaults to 2 when not specified.
 	MaxReviewIterations *int `toml:"max_review_iterations"`
+
+	// MaxParallelTasks is how many ready tasks a work's orchestrator runs at
+	// once. Defaults to 1 when not specified.
+	MaxParallelTasks *int `toml:"max_parallel_tasks"`
+
+	// AutoPR makes a work's orchestrator create the PR task on its own once
+	// all of the work's tasks are completed. Defaults to false.
+	AutoPR bool `toml:"auto_pr"`
+
+	// HoldPROnFailingChecks keeps a work's orchestrator from starting pr and
+	// update-pr-description tasks while required checks on the work's PR
+	// fail. Defaults to true when not specified.
+	HoldPROnFailingChecks *bool `toml:"hold_pr_on_failing_checks"`
+
+	// TaskTypes defines custom task types, keyed by name, that can be created
+	// with 'co work task --type <name>'.
+	TaskTypes map[string]TaskTypeConfig `toml:"task_types"`
+}
+
+// TaskTypeConfig configures a custom task type under [workflow.task_types.<name>].
+type TaskTypeConfig struct {
+	// Prompt is the path to the prompt template, relative to the project root.
+	// It is rendered with Go text/template syntax against the work's context.
+	Prompt string `toml:"prompt"`
+	// NeedsBeads passes the work's beads to the template and refuses to
+	// create the task for a work without beads.
+	NeedsBeads bool `toml:"needs_beads"`
+	// MaxIterations caps how many tasks of this type a work can have.
+	// 0 means no limit.
+	MaxIterations int `toml:"max_iterations"`
+	// Glyph is shown before tasks of this type in the TUI's task lists.
+	// Empty uses a generic glyph.
+	Glyph string `toml:"glyph"`
+}
+
+// BuiltinTaskTypes are the task types co handles itself. Custom task types
+// cannot reuse these names.
+var BuiltinTaskTypes = []string{db.TaskTypeEstimate, db.TaskTypeImplement, db.TaskTypeReview, db.TaskTypePR, db.TaskTypeUpdatePRDescription, db.TaskTypeLogAnalysis}
+
+// GetTaskType returns the custom task type with the given name.
+func (w *WorkflowConfig) GetTaskType(name string) (TaskTypeConfig, bool) {
+	if slices.Contains(BuiltinTaskTypes, name) {
+		return TaskTypeConfig{}, false
+	}
+	tt, ok := w.TaskTypes[name]
+	return tt, ok
+}
+
+// TaskTypeNames returns the names of the custom task types, sorted.
+// Entries that shadow a built-in task type are left out.
+func (w *WorkflowConfig) TaskTypeNames() []string {
+	names := make([]string, 0, len(w.TaskTypes))
+	for name := range w.TaskTypes {
+		if !slices.Contains(BuiltinTaskTypes, name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// TaskTypeGlyphs returns the glyphs configured for custom task types, keyed
+// by task type name.
+func (w *WorkflowConfig) TaskTypeGlyphs() map[string]string {
+	glyphs := make(map[string]string)
+	for _, name := range w.TaskTypeNames() {
+		if glyph := w.TaskTypes[name].Glyph; glyph != "" {
+			glyphs[name] = glyph
+		}
+	}
+	return glyphs
 }
 
 // GetMaxReviewIterations returns the configured max review iterations or 2 if not specified.
@@ -171,6 +385,23 @@ func (w *WorkflowConfig) GetMaxReviewIterations() int {
 	return *w.MaxReviewIterations
 }
 
+// GetMaxParallelTasks returns the configured max parallel tasks or 1 if not specified.
+func (w *WorkflowConfig) GetMaxParallelTasks() int {
+	if w.MaxParallelTasks == nil || *w.MaxParallelTasks < 1 {
+		return 1
+	}
+	return *w.MaxParallelTasks
+}
+
+// GetHoldPROnFailingChecks returns whether PR tasks wait for failing
+// required checks, true if not specified.
+func (w *WorkflowConfig) GetHoldPROnFailingChecks() bool {
+	if w.HoldPROnFailingChecks == nil {
+		return true
+	}
+	return *w.HoldPROnFailingChecks
+}
+
 // SchedulerConfig contains scheduler timing configuration.
 type SchedulerConfig struct {
 	// PRFeedbackIntervalMinutes is the interval between PR feedback checks.
@@ -188,6 +419,19 @@ type SchedulerConfig struct {
 	// ActivityUpdateSeconds is the interval for updating task activity timestamps.
 	// Defaults to 30 seconds when not specified.
 	ActivityUpdateSeconds *int `toml:"activity_update_seconds"`
+
+	// ScheduledRunStaggerSeconds is the minimum gap between scheduled runs
+	// (co run --at) that come due together. Defaults to 120 seconds.
+	ScheduledRunStaggerSeconds *int `toml:"scheduled_run_stagger_seconds"`
+
+	// RunAllStaggerSeconds is the delay between orchestrator spawns when
+	// several works are run at once (co run --all). Defaults to 30 seconds.
+	RunAllStaggerSeconds *int `toml:"run_all_stagger_seconds"`
+
+	// RunAllMaxFailures stops co run --all from starting the remaining works
+	// once this many have failed to start in a row. Defaults to 3; 0 never
+	// stops.
+	RunAllMaxFailures *int `toml:"run_all_max_failures"`
 }
 
 // GetPRFeedbackInterval returns the PR feedback check interval.
@@ -226,6 +470,33 @@ func (s *SchedulerConfig) GetActivityUpdateInterval() time.Duration {
 	return 30 * time.Second
 }
 
+// GetScheduledRunStagger returns the minimum gap between scheduled runs.
+// Defaults to 120 seconds when not specified.
+func (s *SchedulerConfig) GetScheduledRunStagger() time.Duration {
+	if s.ScheduledRunStaggerSeconds != nil && *s.ScheduledRunStaggerSeconds >= 0 {
+		return time.Duration(*s.ScheduledRunStaggerSeconds) * time.Second
+	}
+	return 120 * time.Second
+}
+
+// GetRunAllStagger returns the delay between orchestrator spawns of a bulk
+// run. Defaults to 30 seconds when not specified.
+func (s *SchedulerConfig) GetRunAllStagger() time.Duration {
+	if s.RunAllStaggerSeconds != nil && *s.RunAllStaggerSeconds >= 0 {
+		return time.Duration(*s.RunAllStaggerSeconds) * time.Second
+	}
+	return 30 * time.Second
+}
+
+// GetRunAllMaxFailures returns how many works may fail to start in a row
+// before a bulk run stops. Defaults to 3 when not specified.
+func (s *SchedulerConfig) GetRunAllMaxFailures() int {
+	if s.RunAllMaxFailures != nil && *s.RunAllMaxFailures >= 0 {
+		return *s.RunAllMaxFailures
+	}
+	return 3
+}
+
 // ZellijConfig contains zellij tab management configuration.
 type ZellijConfig struct {
 	// KillTabsOnDestroy controls whether to automatically kill zellij tabs
@@ -234,12 +505,146 @@ type ZellijConfig struct {
 	KillTabsOnDestroy *bool `toml:"kill_tabs_on_destroy"`
 }
 
+// GCConfig contains worktree cleanup configuration for `co work gc`.
+type GCConfig struct {
+	// ArtifactPatterns are the build artifacts `co work gc` offers to remove
+	// from completed works' worktrees. Patterns match file and directory names
+	// (filepath.Match syntax); a trailing "/" matches directories only.
+	// Defaults to ["node_modules/", "target/"] when not specified.
+	ArtifactPatterns []string `toml:"artifact_patterns"`
+}
+
+// GetArtifactPatterns returns the configured artifact patterns, or the defaults.
+func (g *GCConfig) GetArtifactPatterns() []string {
+	if len(g.ArtifactPatterns) == 0 {
+		return []string{"node_modules/", "target/"}
+	}
+	return g.ArtifactPatterns
+}
+
+// WorktreeConfig contains setup applied to each new work's worktree.
+type WorktreeConfig struct {
+	// CopyFiles are files or directories, relative to the repository root,
+	// copied from the main repository into each new worktree. Use it for
+	// untracked config such as ".env" that git doesn't check out.
+	CopyFiles []string `toml:"copy_files"`
+
+	// PostCreate is a shell command run in each new worktree once it's
+	// created and the files are copied. CO_WORKTREE_PATH and
+	// CO_MAIN_REPO_PATH are set in its environment.
+	PostCreate string `toml:"post_create"`
+}
+
+// GitConfig contains the git identity of the commits orchestrated tasks make.
+type GitConfig struct {
+	// BotName and BotEmail are the author and committer of commits made by
+	// tasks, in place of the user's own git identity.
+	BotName  string `toml:"bot_name"`
+	BotEmail string `toml:"bot_email"`
+
+	// SigningKey signs the commits made by tasks; it is passed to git as
+	// user.signingkey, with commit.gpgsign turned on.
+	SigningKey string `toml:"signing_key"`
+
+	// CommitTrailer adds a "Co-Orchestrated-By: co <work-id>/<task-id>"
+	// trailer to commits made by tasks, through a prepare-commit-msg hook
+	// installed when a work's worktree is created. Defaults to true.
+	CommitTrailer *bool `toml:"commit_trailer"`
+}
+
+// GetCommitTrailer returns whether task commits get the Co-Orchestrated-By
+// trailer, true if not specified.
+func (g *GitConfig) GetCommitTrailer() bool {
+	if g.CommitTrailer == nil {
+		return true
+	}
+	return *g.CommitTrailer
+}
+
+// RemoteConfig names the machine remote works run on. A remote work's
+// worktree is created on the host over SSH and its Claude sessions run
+// there, while the tracking database and the orchestrator stay local.
+type RemoteConfig struct {
+	// Host is the SSH destination, such as "desktop" from ~/.ssh/config or
+	// "user@desktop.local". co and claude must be on the PATH of a
+	// non-interactive shell there.
+	Host string `toml:"host"`
+
+	// Root is the directory on the host that holds the clone of the
+	// repository ("main") and the works' worktrees, laid out like the
+	// project directory. Defaults to "~/co/<project name>".
+	Root string `toml:"root"`
+
+	// Default creates new works on the host unless --remote=false is given.
+	// Defaults to false, so only works created with --remote are remote.
+	Default bool `toml:"default"`
+}
+
+// Enabled returns whether a remote host is configured.
+func (r *RemoteConfig) Enabled() bool {
+	return r.Host != ""
+}
+
+// CheckHost returns why Host can't be used as an SSH destination, or nil.
+// ssh would read a host starting with "-" as one of its options.
+func (r *RemoteConfig) CheckHost() error {
+	switch {
+	case strings.HasPrefix(r.Host, "-"):
+		return fmt.Errorf("host %q must not start with \"-\"", r.Host)
+	case strings.ContainsFunc(r.Host, unicode.IsSpace):
+		return fmt.Errorf("host %q must not contain whitespace", r.Host)
+	}
+	return nil
+}
+
+// GetRoot returns the directory on the host that holds the project.
+func (r *RemoteConfig) GetRoot(projectName string) string {
+	if r.Root != "" {
+		return r.Root
+	}
+	return "~/co/" + projectName
+}
+
 // BeadsConfig contains beads path configuration.
 type BeadsConfig struct {
 	// Path to beads directory (relative to project root)
 	// "main/.beads" = beads in repository (synced with git)
 	// ".co/.beads" = project-local beads (standalone, not synced)
 	Path string `toml:"path"`
+
+	// Templates are description templates keyed by bead type, configured
+	// under [beads.templates.<type>].
+	Templates map[string]BeadTemplateConfig `toml:"templates"`
+
+	// DedupeThreshold is the title similarity, from 0 to 1, at which two
+	// beads are offered as duplicates. 0 uses beads.DefaultDuplicateThreshold.
+	DedupeThreshold float64 `toml:"dedupe_threshold"`
+}
+
+// GetDedupeThreshold returns the title similarity at which beads are offered
+// as duplicates, falling back to the default when unset or out of range.
+func (c *BeadsConfig) GetDedupeThreshold() float64 {
+	if c.DedupeThreshold <= 0 || c.DedupeThreshold > 1 {
+		return beads.DefaultDuplicateThreshold
+	}
+	return c.DedupeThreshold
+}
+
+// BeadTemplateConfig configures the description template of a bead type.
+type BeadTemplateConfig struct {
+	// Description pre-fills new beads of the type. {{title}} and {{type}}
+	// are replaced with the bead's title and type.
+	Description string `toml:"description"`
+}
+
+// DescriptionTemplate returns the description template for a bead type with
+// its placeholders filled in, or "" if the type has none.
+func (b *BeadsConfig) DescriptionTemplate(beadType, title string) string {
+	tmpl, ok := b.Templates[beadType]
+	if !ok {
+		return ""
+	}
+	return strings.NewReplacer("{{title}}", title, "{{type}}", beadType).Replace(tmpl.Description)
 }
 
 // ShouldKillTabsOnDestroy returns true if zellij tabs should be killed when work is destroyed.
@@ -289,7 +694,9 @@ type configTemplateData struct {
 	RepoType    string
 	RepoSource  string
 	RepoPath    string
+	BaseBranch  string
 	BeadsPath   string
+	HooksEnv    []string
 }
 
 // tomlString formats a string for TOML output with proper escaping.
@@ -313,12 +720,14 @@ var configTemplate = template.Must(template.New("config").Funcs(template.FuncMap
 // This includes the actual project values plus commented-out examples for optional sections.
 func (c *Config) GenerateDocumentedConfig() string {
 	data := configTemplateData{
-		ProjectName:   c.Project.Name,
-		CreatedAt:     c.Project.CreatedAt.Format(time.RFC3339),
-		RepoType:      c.Repo.Type,
-		RepoSource:    c.Repo.Source,
-		RepoPath:      c.Repo.Path,
-		BeadsPath: c.Beads.Path,
+		ProjectName: c.Project.Name,
+		CreatedAt:   c.Project.CreatedAt.Format(time.RFC3339),
+		RepoType:    c.Repo.Type,
+		RepoSource:  c.Repo.Source,
+		RepoPath:    c.Repo.Path,
+		BaseBranch:  c.Repo.BaseBranch,
+		BeadsPath:   c.Beads.Path,
+		HooksEnv:    c.Hooks.Env,
 	}
 
 	var buf bytes.Buffer
@@ -63,6 +63,20 @@ func TestGeneratedConfigRoundTrip(t *testing.T) {
 	require.Equal(t, original.Repo.Path, loaded.Repo.Path)
 }
 
+func TestGeneratedConfigWithInitSettings(t *testing.T) {
+	original := &Config{
+		Project: ProjectConfig{Name: "test-project", CreatedAt: time.Now()},
+		Repo:    RepoConfig{Type: "local", Source: "/src/repo", Path: "main", BaseBranch: "develop"},
+		Hooks:   HooksConfig{Env: []string{"CLAUDE_CODE_USE_VERTEX=1", `QUOTED="x"`}},
+	}
+
+	var loaded Config
+	_, err := toml.Decode(original.GenerateDocumentedConfig(), &loaded)
+	require.NoError(t, err)
+	require.Equal(t, "develop", loaded.Repo.GetBaseBranch())
+	require.Equal(t, original.Hooks.Env, loaded.Hooks.Env)
+}
+
 func TestGeneratedConfigWithSpecialCharacters(t *testing.T) {
 	// Test with special characters that could break TOML
 	cfg := &Config{
@@ -282,3 +296,146 @@ use_claude = true
 		})
 	}
 }
+
+func TestWorkflowTaskTypesFromTOML(t *testing.T) {
+	tomlContent := `
+[project]
+name = "test"
+
+[workflow.task_types.security-audit]
+prompt = ".co/prompts/security-audit.tmpl"
+max_iterations = 1
+
+[workflow.task_types.changelog]
+prompt = ".co/prompts/changelog.tmpl"
+needs_beads = true
+
+[workflow.task_types.review]
+prompt = ".co/prompts/review.tmpl"
+`
+	var cfg Config
+	_, err := toml.Decode(tomlContent, &cfg)
+	require.NoError(t, err)
+
+	// Built-in names are never treated as custom types
+	require.Equal(t, []string{"changelog", "security-audit"}, cfg.Workflow.TaskTypeNames())
+	_, ok := cfg.Workflow.GetTaskType("review")
+	require.False(t, ok)
+
+	audit, ok := cfg.Workflow.GetTaskType("security-audit")
+	require.True(t, ok)
+	require.Equal(t, TaskTypeConfig{Prompt: ".co/prompts/security-audit.tmpl", MaxIterations: 1}, audit)
+
+	changelog, ok := cfg.Workflow.GetTaskType("changelog")
+	require.True(t, ok)
+	require.True(t, changelog.NeedsBeads)
+}
+
+func TestTUINotifyFromTOML(t *testing.T) {
+	var cfg Config
+	_, err := toml.Decode("[tui]\nnotify_on_fail = true\n", &cfg)
+	require.NoError(t, err)
+
+	require.True(t, cfg.TUI.ShouldNotify("failed"))
+	require.False(t, cfg.TUI.ShouldNotify("completed"))
+	require.False(t, cfg.TUI.ShouldNotify("processing"))
+}
+
+func TestTUIRefreshFromTOML(t *testing.T) {
+	var cfg Config
+	require.Equal(t, DefaultTUIRefresh, cfg.TUI.GetWorkRefresh())
+	require.Equal(t, DefaultTUIRefresh, cfg.TUI.GetPlanRefresh())
+	require.True(t, cfg.TUI.IsWatcherEnabled())
+	require.True(t, cfg.TUI.IsConfirmQuit())
+
+	_, err := toml.Decode("[tui]\nwork_refresh = \"2s\"\nplan_refresh = \"750ms\"\nwatcher_enabled = false\nconfirm_quit = false\n", &cfg)
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Second, cfg.TUI.GetWorkRefresh())
+	require.Equal(t, 750*time.Millisecond, cfg.TUI.GetPlanRefresh())
+	require.False(t, cfg.TUI.IsWatcherEnabled())
+	require.False(t, cfg.TUI.IsConfirmQuit())
+
+	// Invalid or too short intervals fall back to the default
+	for _, value := range []string{"fast", "5", "100ms", "-1s"} {
+		cfg.TUI.WorkRefresh = value
+		require.Equal(t, DefaultTUIRefresh, cfg.TUI.GetWorkRefresh(), value)
+	}
+}
+
+func TestBeadDescriptionTemplatesFromTOML(t *testing.T) {
+	tomlContent := `
+[beads]
+path = ".co/.beads"
+
+[beads.templates.bug]
+description = """
+## Steps to reproduce
+{{title}}
+
+## Expected
+
+## Actual
+"""
+
+[beads.templates.feature]
+description = "A {{type}}: {{title}}"
+`
+	var cfg Config
+	_, err := toml.Decode(tomlContent, &cfg)
+	require.NoError(t, err)
+
+	require.Equal(t, "## Steps to reproduce\nCrash on save\n\n## Expected\n\n## Actual\n", cfg.Beads.DescriptionTemplate("bug", "Crash on save"))
+	require.Equal(t, "A feature: Dark mode", cfg.Beads.DescriptionTemplate("feature", "Dark mode"))
+	require.Empty(t, cfg.Beads.DescriptionTemplate("task", "Anything"))
+}
+
+func TestMaxParallelTasksFromTOML(t *testing.T) {
+	var cfg Config
+	require.Equal(t, 1, cfg.Workflow.GetMaxParallelTasks())
+
+	_, err := toml.Decode("[workflow]\nmax_parallel_tasks = 3\n", &cfg)
+	require.NoError(t, err)
+	require.Equal(t, 3, cfg.Workflow.GetMaxParallelTasks())
+
+	_, err = toml.Decode("[workflow]\nmax_parallel_tasks = 0\n", &cfg)
+	require.NoError(t, err)
+	require.Equal(t, 1, cfg.Workflow.GetMaxParallelTasks())
+}
+
+func TestAutoPRFromTOML(t *testing.T) {
+	var cfg Config
+	require.False(t, cfg.Workflow.AutoPR)
+
+	_, err := toml.Decode("[workflow]\nauto_pr = true\n", &cfg)
+	require.NoError(t, err)
+	require.True(t, cfg.Workflow.AutoPR)
+}
+
+func TestHoldPROnFailingChecksFromTOML(t *testing.T) {
+	var cfg Config
+	require.True(t, cfg.Workflow.GetHoldPROnFailingChecks())
+
+	_, err := toml.Decode("[workflow]\nhold_pr_on_failing_checks = false\n", &cfg)
+	require.NoError(t, err)
+	require.False(t, cfg.Workflow.GetHoldPROnFailingChecks())
+}
+
+func TestWorktreeConfigFromTOML(t *testing.T) {
+	var cfg Config
+	_, err := toml.Decode("[worktree]\ncopy_files = [\".env\", \"config/local.yaml\"]\npost_create = \"npm install\"\n", &cfg)
+	require.NoError(t, err)
+	require.Equal(t, []string{".env", "config/local.yaml"}, cfg.Worktree.CopyFiles)
+	require.Equal(t, "npm install", cfg.Worktree.PostCreate)
+}
+
+func TestDoubleClickFromTOML(t *testing.T) {
+	var cfg Config
+	require.Equal(t, DefaultDoubleClick, cfg.TUI.GetDoubleClick())
+
+	_, err := toml.Decode("[tui]\ndouble_click = \"250ms\"\n", &cfg)
+	require.NoError(t, err)
+	require.Equal(t, 250*time.Millisecond, cfg.TUI.GetDoubleClick())
+
+	cfg.TUI.DoubleClick = "soon"
+	require.Equal(t, DefaultDoubleClick, cfg.TUI.GetDoubleClick())
+}
@@ -0,0 +1,370 @@
+package project
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// ConfigProblem is one thing wrong with a config file.
+type ConfigProblem struct {
+	Key     string // Dotted key the problem is at; empty for syntax errors
+	Line    int    // Line in the file, starting at 1; 0 if unknown
+	Message string
+}
+
+// String formats the problem as "line N: key: message".
+func (p ConfigProblem) String() string {
+	var b strings.Builder
+	if p.Line > 0 {
+		fmt.Fprintf(&b, "line %d: ", p.Line)
+	}
+	if p.Key != "" {
+		b.WriteString(p.Key + ": ")
+	}
+	b.WriteString(p.Message)
+	return b.String()
+}
+
+// ConfigError collects every problem found in a config file, so they can be
+// fixed in one pass instead of one failed command at a time.
+type ConfigError struct {
+	Path     string
+	Problems []ConfigProblem
+}
+
+func (e *ConfigError) Error() string {
+	var b strings.Builder
+	if len(e.Problems) == 1 {
+		b.WriteString("found 1 problem:")
+	} else {
+		fmt.Fprintf(&b, "found %d problems:", len(e.Problems))
+	}
+	for _, p := range e.Problems {
+		b.WriteString("\n  " + p.String())
+	}
+	return b.String()
+}
+
+// IsSyntax reports whether the file couldn't be parsed as TOML at all, in
+// which case no config could be loaded from it.
+func (e *ConfigError) IsSyntax() bool {
+	return len(e.Problems) == 1 && e.Problems[0].Key == ""
+}
+
+// ValidateConfig reads a config.toml file and checks it against the schema:
+// every known key must have the right type, and keys under known sections
+// must exist. Problems are returned together as a *ConfigError.
+//
+// Unless the file has a syntax error, the config is returned alongside the
+// error, decoded from the keys that were valid, so callers can keep going
+// with defaults in place of the broken keys.
+func ValidateConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	var raw map[string]any
+	if _, err := toml.Decode(string(data), &raw); err != nil {
+		var parseErr toml.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, &ConfigError{Path: path, Problems: []ConfigProblem{{
+				Line:    parseErr.Position.Line,
+				Message: parseErr.Message,
+			}}}
+		}
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	v := &configValidator{lines: keyLines(string(data))}
+	v.checkTable(raw, reflect.TypeOf(Config{}), nil)
+
+	// Decode what's left once the bad entries are gone
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(raw); err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+	var cfg Config
+	if _, err := toml.Decode(buf.String(), &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	// A value can have the right type and still be unusable
+	if err := cfg.Remote.CheckHost(); err != nil {
+		v.add([]string{"remote", "host"}, "%v", err)
+		cfg.Remote.Host = ""
+	}
+
+	if len(v.problems) == 0 {
+		return &cfg, nil
+	}
+	sort.SliceStable(v.problems, func(i, j int) bool {
+		return v.problems[i].Line < v.problems[j].Line
+	})
+	return &cfg, &ConfigError{Path: path, Problems: v.problems}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// configValidator walks a decoded config file alongside the Config type,
+// recording problems and deleting the entries they're about.
+type configValidator struct {
+	lines    map[string]int // Dotted key -> line it's defined on
+	problems []ConfigProblem
+}
+
+func (v *configValidator) add(path []string, format string, args ...any) {
+	key := strings.Join(path, ".")
+	v.problems = append(v.problems, ConfigProblem{
+		Key:     key,
+		Line:    v.lines[key],
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// checkTable checks a table against a struct type. At the top level unknown
+// sections are left alone; only keys inside known sections must exist.
+func (v *configValidator) checkTable(table map[string]any, t reflect.Type, path []string) {
+	fields := map[string]reflect.Type{}
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("toml"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[name] = t.Field(i).Type
+		names = append(names, name)
+	}
+
+	keys := make([]string, 0, len(table))
+	for key := range table {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		keyPath := append(append([]string(nil), path...), key)
+		fieldType, ok := fields[key]
+		if !ok {
+			if len(path) == 0 {
+				continue
+			}
+			if suggestion := closestName(key, names); suggestion != "" {
+				v.add(keyPath, "unknown key (did you mean %q?)", suggestion)
+			} else {
+				v.add(keyPath, "unknown key")
+			}
+			delete(table, key)
+			continue
+		}
+		if !v.checkValue(table[key], fieldType, keyPath) {
+			delete(table, key)
+		}
+	}
+}
+
+// checkValue checks a value against the Go type it decodes into. It returns
+// false, after recording the problem, if the value must be dropped.
+func (v *configValidator) checkValue(value any, t reflect.Type, path []string) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if !matchesType(value, t) {
+		v.add(path, "expected %s, got %s", describeType(t), describeValue(value))
+		return false
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if t != timeType {
+			v.checkTable(value.(map[string]any), t, path)
+		}
+	case reflect.Map:
+		entries := value.(map[string]any)
+		for name, entry := range entries {
+			if !v.checkValue(entry, t.Elem(), append(append([]string(nil), path...), name)) {
+				delete(entries, name)
+			}
+		}
+	case reflect.Slice:
+		for _, elem := range value.([]any) {
+			if !matchesType(elem, t.Elem()) {
+				v.add(path, "expected %s, got an array containing %s", describeType(t), describeValue(elem))
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matchesType reports whether a decoded TOML value has the shape t needs.
+// Elements of arrays and tables are checked by the caller.
+func matchesType(value any, t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == timeType {
+		_, ok := value.(time.Time)
+		return ok
+	}
+	switch t.Kind() {
+	case reflect.String:
+		_, ok := value.(string)
+		return ok
+	case reflect.Bool:
+		_, ok := value.(bool)
+		return ok
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		_, ok := value.(int64)
+		return ok
+	case reflect.Float32, reflect.Float64:
+		switch value.(type) {
+		case float64, int64:
+			return true
+		}
+		return false
+	case reflect.Slice:
+		_, ok := value.([]any)
+		return ok
+	case reflect.Map, reflect.Struct:
+		_, ok := value.(map[string]any)
+		return ok
+	}
+	return true
+}
+
+// describeType names what a value of type t looks like in TOML.
+func describeType(t reflect.Type) string {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == timeType {
+		return "a datetime"
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "a string"
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "an integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Slice:
+		return "an array of " + strings.TrimPrefix(strings.TrimPrefix(describeType(t.Elem()), "an "), "a ") + "s"
+	case reflect.Map, reflect.Struct:
+		return "a table"
+	}
+	return t.String()
+}
+
+// describeValue names the TOML type of a decoded value.
+func describeValue(value any) string {
+	switch value.(type) {
+	case string:
+		return "a string"
+	case bool:
+		return "a boolean"
+	case int64:
+		return "an integer"
+	case float64:
+		return "a float"
+	case time.Time:
+		return "a datetime"
+	case []any:
+		return "an array"
+	case []map[string]any:
+		return "an array of tables"
+	case map[string]any:
+		return "a table"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// closestName returns the name closest to key, if it's close enough to be a
+// likely typo.
+func closestName(key string, names []string) string {
+	best, bestDist := "", 0
+	for _, name := range names {
+		d := editDistance(key, name)
+		if best == "" || d < bestDist {
+			best, bestDist = name, d
+		}
+	}
+	if best == "" || bestDist > 3 || bestDist >= len(key) {
+		return ""
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// keyLines maps each dotted key and table header in a TOML document to the
+// line it first appears on. The parser doesn't expose key positions, so this
+// reads the lines itself; it's only used to point at problems.
+func keyLines(text string) map[string]int {
+	lines := map[string]int{}
+	var table []string
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		var path []string
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "["):
+			header := strings.TrimLeft(line, "[")
+			end := strings.Index(header, "]")
+			if end < 0 {
+				continue
+			}
+			table = splitKey(header[:end])
+			path = table
+		default:
+			eq := strings.Index(line, "=")
+			if eq <= 0 {
+				continue
+			}
+			path = append(append([]string(nil), table...), splitKey(line[:eq])...)
+		}
+		key := strings.Join(path, ".")
+		if _, ok := lines[key]; !ok {
+			lines[key] = i + 1
+		}
+	}
+	return lines
+}
+
+// splitKey splits a dotted TOML key into its parts, dropping quotes.
+func splitKey(key string) []string {
+	parts := strings.Split(key, ".")
+	for i, part := range parts {
+		parts[i] = strings.Trim(strings.TrimSpace(part), `"'`)
+	}
+	return parts
+}
@@ -0,0 +1,117 @@
+package project
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), ConfigFile)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestValidateConfig_Valid(t *testing.T) {
+	cfg := &Config{
+		Project: ProjectConfig{Name: "demo", CreatedAt: time.Date(2026, 1, 26, 10, 30, 0, 0, time.UTC)},
+		Repo:    RepoConfig{Type: "local", Source: "/src", Path: "main"},
+	}
+	path := writeConfig(t, cfg.GenerateDocumentedConfig()+`
+[workflow.task_types.docs]
+prompt = "Write docs"
+max_iterations = 2
+
+[beads.templates.bug]
+description = "Steps: {{title}}"
+`)
+
+	loaded, err := ValidateConfig(path)
+	require.NoError(t, err, "the generated config passes its own schema")
+	require.Equal(t, "demo", loaded.Project.Name)
+	require.Equal(t, 2, loaded.Workflow.TaskTypes["docs"].MaxIterations)
+}
+
+func TestValidateConfig_CollectsProblems(t *testing.T) {
+	path := writeConfig(t, `[project]
+name = "demo"
+
+[workflow]
+max_review_iterations = "3"
+auto_pr = true
+max_paralel_tasks = 4
+
+[worktree]
+copy_files = [".env", 2]
+
+[workflow.task_types.docs]
+promt = "Write docs"
+
+[plugins]
+anything = "goes"
+`)
+
+	cfg, err := ValidateConfig(path)
+	var cfgErr *ConfigError
+	require.True(t, errors.As(err, &cfgErr))
+	require.False(t, cfgErr.IsSyntax())
+	require.Equal(t, []ConfigProblem{
+		{Key: "workflow.max_review_iterations", Line: 5, Message: "expected an integer, got a string"},
+		{Key: "workflow.max_paralel_tasks", Line: 7, Message: `unknown key (did you mean "max_parallel_tasks"?)`},
+		{Key: "worktree.copy_files", Line: 10, Message: "expected an array of strings, got an array containing an integer"},
+		{Key: "workflow.task_types.docs.promt", Line: 13, Message: `unknown key (did you mean "prompt"?)`},
+	}, cfgErr.Problems)
+	require.Contains(t, cfgErr.Error(), "found 4 problems:\n  line 5: workflow.max_review_iterations: expected an integer")
+
+	// The valid keys are still loaded, with defaults in place of the bad ones
+	require.NotNil(t, cfg)
+	require.Equal(t, "demo", cfg.Project.Name)
+	require.True(t, cfg.Workflow.AutoPR)
+	require.Nil(t, cfg.Workflow.MaxReviewIterations)
+	require.Empty(t, cfg.Worktree.CopyFiles)
+}
+
+func TestValidateConfig_SyntaxError(t *testing.T) {
+	path := writeConfig(t, "[project]\nname = \"demo\"\nbroken =\n")
+
+	cfg, err := ValidateConfig(path)
+	require.Nil(t, cfg)
+	var cfgErr *ConfigError
+	require.True(t, errors.As(err, &cfgErr))
+	require.True(t, cfgErr.IsSyntax())
+	require.Equal(t, 3, cfgErr.Problems[0].Line)
+}
+
+func TestValidateConfig_RemoteHost(t *testing.T) {
+	path := writeConfig(t, "[remote]\nhost = \"-oProxyCommand=sh\"\nroot = \"/srv/co\"\n")
+
+	cfg, err := ValidateConfig(path)
+	var cfgErr *ConfigError
+	require.True(t, errors.As(err, &cfgErr))
+	require.Equal(t, []ConfigProblem{
+		{Key: "remote.host", Line: 2, Message: `host "-oProxyCommand=sh" must not start with "-"`},
+	}, cfgErr.Problems)
+	require.False(t, cfg.Remote.Enabled(), "the host is dropped rather than passed to ssh")
+	require.Equal(t, "/srv/co", cfg.Remote.Root)
+}
+
+func TestRecheckConfig(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, ConfigDir), 0755))
+	proj := &Project{Root: root, Config: &Config{}}
+	require.NoError(t, os.WriteFile(proj.ConfigPath(), []byte("[workflow]\nauto_pr = \"yes\"\n"), 0600))
+
+	require.NoError(t, proj.RecheckConfig())
+	require.NotNil(t, proj.ConfigErr)
+	require.Len(t, proj.ConfigErr.Problems, 1)
+
+	require.NoError(t, os.WriteFile(proj.ConfigPath(), []byte("[workflow]\nauto_pr = true\n"), 0600))
+	require.NoError(t, proj.RecheckConfig())
+	require.Nil(t, proj.ConfigErr)
+	require.True(t, proj.Config.Workflow.AutoPR)
+}
@@ -0,0 +1,100 @@
+package project
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WorkIDEnv and TaskIDEnv name the work and task a Claude session runs for.
+// The prepare-commit-msg hook reads them to add its trailer.
+const (
+	WorkIDEnv = "CO_WORK_ID"
+	TaskIDEnv = "CO_TASK_ID"
+)
+
+// CommitEnv returns the environment a task runs with so the commits it makes
+// carry the orchestrator's identity: the work and task IDs, plus the [git]
+// bot identity and signing key when they are configured.
+func (g *GitConfig) CommitEnv(workID, taskID string) []string {
+	env := []string{WorkIDEnv + "=" + workID, TaskIDEnv + "=" + taskID}
+	if g.BotName != "" {
+		env = append(env, "GIT_AUTHOR_NAME="+g.BotName, "GIT_COMMITTER_NAME="+g.BotName)
+	}
+	if g.BotEmail != "" {
+		env = append(env, "GIT_AUTHOR_EMAIL="+g.BotEmail, "GIT_COMMITTER_EMAIL="+g.BotEmail)
+	}
+	if g.SigningKey != "" {
+		env = append(env,
+			"GIT_CONFIG_COUNT=2",
+			"GIT_CONFIG_KEY_0=user.signingkey", "GIT_CONFIG_VALUE_0="+g.SigningKey,
+			"GIT_CONFIG_KEY_1=commit.gpgsign", "GIT_CONFIG_VALUE_1=true",
+		)
+	}
+	return env
+}
+
+// ParseEnvVar splits a "KEY=value" entry as used by [hooks] env and work env
+// overrides. The key must be non-empty and free of whitespace; the value may
+// be empty and may contain '='.
+func ParseEnvVar(entry string) (key, value string, err error) {
+	key, value, ok := strings.Cut(entry, "=")
+	if !ok || key == "" || strings.ContainsAny(key, " \t\n") {
+		return "", "", fmt.Errorf("invalid environment variable %q: expected KEY=value", entry)
+	}
+	return key, value, nil
+}
+
+// MergeEnv applies a work's env overrides over the project's [hooks] env. An
+// override replaces the project entry with the same key where it stands, so
+// entries that refer to it with $KEY still see the new value; overrides of
+// new keys follow in their own order. When a key is overridden more than once
+// the last one wins. Malformed entries are kept as they are.
+func MergeEnv(base, overrides []string) []string {
+	if len(overrides) == 0 {
+		return base
+	}
+
+	last := make(map[string]string)
+	var added []string
+	for _, entry := range overrides {
+		key, _, err := ParseEnvVar(entry)
+		if err != nil {
+			continue
+		}
+		if _, seen := last[key]; !seen {
+			added = append(added, key)
+		}
+		last[key] = entry
+	}
+
+	merged := make([]string, 0, len(base)+len(added))
+	used := make(map[string]bool)
+	for _, entry := range base {
+		key, _, err := ParseEnvVar(entry)
+		if override, ok := last[key]; err == nil && ok {
+			if !used[key] {
+				merged = append(merged, override)
+				used[key] = true
+			}
+			continue
+		}
+		merged = append(merged, entry)
+	}
+	for _, key := range added {
+		if !used[key] {
+			merged = append(merged, last[key])
+		}
+	}
+	return merged
+}
+
+// EnvKeys returns the keys of KEY=value entries, in order
+func EnvKeys(env []string) []string {
+	keys := make([]string, 0, len(env))
+	for _, entry := range env {
+		if key, _, err := ParseEnvVar(entry); err == nil {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,123 @@
+package project
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/newhook/co/internal/worktree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEnvVar(t *testing.T) {
+	key, value, err := ParseEnvVar("URL=postgres://host/db?a=b")
+	require.NoError(t, err)
+	assert.Equal(t, "URL", key)
+	assert.Equal(t, "postgres://host/db?a=b", value)
+
+	key, value, err = ParseEnvVar("EMPTY=")
+	require.NoError(t, err)
+	assert.Equal(t, "EMPTY", key)
+	assert.Equal(t, "", value)
+
+	for _, entry := range []string{"", "NOVALUE", "=value", "MY KEY=value"} {
+		_, _, err := ParseEnvVar(entry)
+		assert.Error(t, err, entry)
+	}
+}
+
+func TestMergeEnv(t *testing.T) {
+	tests := []struct {
+		name      string
+		base      []string
+		overrides []string
+		want      []string
+	}{
+		{
+			name: "no overrides",
+			base: []string{"A=1", "B=2"},
+			want: []string{"A=1", "B=2"},
+		},
+		{
+			name:      "no base",
+			overrides: []string{"A=1"},
+			want:      []string{"A=1"},
+		},
+		{
+			name:      "override replaces in place",
+			base:      []string{"A=1", "B=2", "C=$B/x"},
+			overrides: []string{"B=9"},
+			want:      []string{"A=1", "B=9", "C=$B/x"},
+		},
+		{
+			name:      "new keys follow in order",
+			base:      []string{"A=1"},
+			overrides: []string{"Z=26", "A=2", "Y=25"},
+			want:      []string{"A=2", "Z=26", "Y=25"},
+		},
+		{
+			name:      "last override wins",
+			base:      []string{"A=1"},
+			overrides: []string{"A=2", "B=1", "A=3", "B=2"},
+			want:      []string{"A=3", "B=2"},
+		},
+		{
+			name:      "duplicate base keys collapse to the override",
+			base:      []string{"A=1", "A=2"},
+			overrides: []string{"A=3"},
+			want:      []string{"A=3"},
+		},
+		{
+			name:      "malformed entries",
+			base:      []string{"junk", "A=1"},
+			overrides: []string{"also junk", "A=2"},
+			want:      []string{"junk", "A=2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MergeEnv(tt.base, tt.overrides))
+		})
+	}
+}
+
+func TestEnvKeys(t *testing.T) {
+	assert.Equal(t, []string{"A", "B"}, EnvKeys([]string{"A=1", "junk", "B="}))
+}
+
+func TestCommitEnv(t *testing.T) {
+	g := &GitConfig{}
+	assert.Equal(t, []string{"CO_WORK_ID=w-abc", "CO_TASK_ID=w-abc.1"}, g.CommitEnv("w-abc", "w-abc.1"))
+
+	g = &GitConfig{BotName: "co bot", BotEmail: "bot@example.com", SigningKey: "ABC123"}
+	env := g.CommitEnv("w-abc", "w-abc.1")
+	assert.Contains(t, env, "GIT_CONFIG_KEY_0=user.signingkey")
+	assert.Contains(t, env, "GIT_CONFIG_VALUE_0=ABC123")
+	assert.Contains(t, env, "GIT_CONFIG_VALUE_1=true")
+
+	// A commit made with the environment carries the bot identity and the trailer
+	ctx := context.Background()
+	repo := t.TempDir()
+	git := func(env []string, args ...string) string {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=Someone", "-c", "user.email=someone@example.com"}, args...)...)
+		cmd.Dir = repo
+		cmd.Env = append(os.Environ(), env...)
+		output, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(output))
+		return strings.TrimSpace(string(output))
+	}
+	git(nil, "init", "-q")
+	require.NoError(t, worktree.InstallCommitHook(ctx, repo))
+
+	g.SigningKey = "" // No key to sign with here
+	git(g.CommitEnv("w-abc", "w-abc.1"), "commit", "-q", "--allow-empty", "-m", "Add it")
+	assert.Equal(t, "co bot <bot@example.com> / co bot <bot@example.com>", git(nil, "log", "-1", "--format=%an <%ae> / %cn <%ce>"))
+	assert.Equal(t, "co w-abc/w-abc.1", git(nil, "log", "-1", "--format=%(trailers:key=Co-Orchestrated-By,valueonly)"))
+
+	assert.True(t, (&GitConfig{}).GetCommitTrailer())
+	off := false
+	assert.False(t, (&GitConfig{CommitTrailer: &off}).GetCommitTrailer())
+}
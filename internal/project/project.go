@@ -52,57 +52,85 @@ func FormatTabName(prefix, workID, friendlyName string) string {
 type Project struct {
 	Root   string        // Project directory path
 	Config *Config       // Parsed config.toml
-	DB     *db.DB        // Tracking database (lazy loaded)
+	DB     db.Store      // Tracking database (lazy loaded)
 	Beads  *beads.Client // Beads database client (for issue tracking)
+
+	// ConfigErr lists the problems in config.toml when the project was opened
+	// with FindLenient. Config then holds defaults in place of the broken keys.
+	ConfigErr *ConfigError
 }
 
 // Find finds a project from a flag value or current directory.
 // If flagValue is non-empty, uses that path; otherwise uses cwd.
+// It fails if config.toml has any problem, listing all of them.
 func Find(ctx context.Context, flagValue string) (*Project, error) {
+	return findFrom(ctx, flagValue, false)
+}
+
+// FindLenient is like Find, but opens the project even when config.toml has
+// problems other than a syntax error, recording them in ConfigErr. It's for
+// the TUI, which shows the problems instead of refusing to start.
+func FindLenient(ctx context.Context, flagValue string) (*Project, error) {
+	return findFrom(ctx, flagValue, true)
+}
+
+func findFrom(ctx context.Context, flagValue string, lenient bool) (*Project, error) {
 	if flagValue != "" {
-		return find(ctx, flagValue)
+		return find(ctx, flagValue, lenient)
 	}
 	cwd, err := os.Getwd()
 	if err != nil {
 		return nil, err
 	}
-	return find(ctx, cwd)
+	return find(ctx, cwd, lenient)
 }
 
 // find walks up from startDir looking for a .co/ directory.
 // Returns the project if found, or an error if not found.
-func find(ctx context.Context, startDir string) (*Project, error) {
+func find(ctx context.Context, startDir string, lenient bool) (*Project, error) {
+	root, err := FindRoot(startDir)
+	if err != nil {
+		return nil, err
+	}
+	return load(ctx, root, lenient)
+}
+
+// FindRoot walks up from startDir to the directory holding .co/config.toml,
+// without opening the project.
+func FindRoot(startDir string) (string, error) {
 	dir, err := filepath.Abs(startDir)
 	if err != nil {
-		return nil, fmt.Errorf("failed to resolve path: %w", err)
+		return "", fmt.Errorf("failed to resolve path: %w", err)
 	}
 
 	for {
 		configPath := filepath.Join(dir, ConfigDir, ConfigFile)
 		if _, err := os.Stat(configPath); err == nil {
-			return load(ctx, dir)
+			return dir, nil
 		}
 
 		parent := filepath.Dir(dir)
 		if parent == dir {
 			// Reached filesystem root
-			return nil, fmt.Errorf("no project found (no %s directory)", ConfigDir)
+			return "", fmt.Errorf("no project found (no %s directory)", ConfigDir)
 		}
 		dir = parent
 	}
 }
 
 // load loads a project from the given root directory.
-func load(ctx context.Context, root string) (*Project, error) {
+func load(ctx context.Context, root string, lenient bool) (*Project, error) {
 	configPath := filepath.Join(root, ConfigDir, ConfigFile)
-	cfg, err := LoadConfig(configPath)
-	if err != nil {
+	cfg, err := ValidateConfig(configPath)
+	var cfgErr *ConfigError
+	if err != nil && !(lenient && cfg != nil && errors.As(err, &cfgErr)) {
 		return nil, fmt.Errorf("failed to load config from %s: %w", configPath, err)
 	}
 
 	proj := &Project{
-		Root:   root,
-		Config: cfg,
+		Root:      root,
+		Config:    cfg,
+		ConfigErr: cfgErr,
 	}
 
 	// Open the database automatically
@@ -129,12 +157,27 @@ func load(ctx context.Context, root string) (*Project, error) {
 		logging.Warn("failed to initialize logging", "error", err)
 	}
 
+	// Record the project so the TUI project switcher can offer it
+	if registryPath, err := RegistryPath(); err == nil {
+		if err := RecordInRegistry(registryPath, proj); err != nil {
+			logging.Debug("failed to update project registry", "error", err)
+		}
+	}
+
 	return proj, nil
 }
 
+// CreateOptions are the settings 'co init' asks for when creating a project.
+// Zero values fall back to the defaults of 'co proj create'.
+type CreateOptions struct {
+	Name       string   // Project name; defaults to the directory name
+	BaseBranch string   // Base branch for work branches; "main" when empty
+	HooksEnv   []string // KEY=value pairs for [hooks] env
+}
+
 // Create initializes a new project at the given directory.
 // repoSource can be a local path (symlinked) or GitHub URL (cloned).
-func Create(ctx context.Context, dir, repoSource string) (*Project, error) {
+func Create(ctx context.Context, dir, repoSource string, opts CreateOptions) (*Project, error) {
 	absDir, err := filepath.Abs(dir)
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve path: %w", err)
@@ -164,15 +207,23 @@ func Create(ctx context.Context, dir, repoSource string) (*Project, error) {
 	setupMise(absDir, mainPath)
 
 	// 4. Create config (before beads init, so config exists)
+	name := opts.Name
+	if name == "" {
+		name = filepath.Base(absDir)
+	}
 	cfg := &Config{
 		Project: ProjectConfig{
-			Name:      filepath.Base(absDir),
+			Name:      name,
 			CreatedAt: time.Now(),
 		},
 		Repo: RepoConfig{
-			Type:   repoType,
-			Source: repoSource,
-			Path:   MainDir,
+			Type:       repoType,
+			Source:     repoSource,
+			Path:       MainDir,
+			BaseBranch: opts.BaseBranch,
+		},
+		Hooks: HooksConfig{
+			Env: opts.HooksEnv,
 		},
 		// Beads path will be set after setupBeads
 	}
@@ -360,11 +411,53 @@ func (p *Project) BeadsPath() string {
 	return filepath.Join(p.Root, p.Config.Beads.Path)
 }
 
+// TrackingDBWritable reports whether the tracking database can be opened for
+// writing. It is false when the file or the mount it lives on is read-only.
+func (p *Project) TrackingDBWritable() bool {
+	f, err := os.OpenFile(filepath.Join(p.Root, ConfigDir, TrackingDB), os.O_WRONLY, 0)
+	if err != nil {
+		return false
+	}
+	_ = f.Close()
+	return true
+}
+
+// ConfigPath returns the path to the project's config.toml.
+func (p *Project) ConfigPath() string {
+	return filepath.Join(p.Root, ConfigDir, ConfigFile)
+}
+
+// RecheckConfig validates config.toml again, e.g. after the user edited it.
+// Whatever could be loaded replaces Config, and ConfigErr is updated to the
+// problems that remain, or nil once there are none. A syntax error leaves
+// Config as it was.
+func (p *Project) RecheckConfig() error {
+	cfg, err := ValidateConfig(p.ConfigPath())
+	var cfgErr *ConfigError
+	if err != nil && !errors.As(err, &cfgErr) {
+		return err
+	}
+	if cfg != nil {
+		p.Config = cfg
+	}
+	p.ConfigErr = cfgErr
+	return nil
+}
+
 // WorktreePath returns the path where a task's worktree should be created.
 func (p *Project) WorktreePath(taskID string) string {
 	return filepath.Join(p.Root, taskID)
 }
 
+// LogsDir is the directory under ConfigDir that holds orchestrator logs.
+const LogsDir = "logs"
+
+// OrchestratorLogPath returns the file a work's orchestrator writes its
+// output to: .co/logs/orchestrator-<work-id>.log in the project.
+func (p *Project) OrchestratorLogPath(workID string) string {
+	return filepath.Join(p.Root, ConfigDir, LogsDir, "orchestrator-"+workID+".log")
+}
+
 // Close closes any open resources (database and beads client).
 func (p *Project) Close() error {
 	var errs []error
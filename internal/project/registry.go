@@ -0,0 +1,127 @@
+package project
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// RegistryFile is the name of the file listing every project co has opened.
+const RegistryFile = "projects.json"
+
+// RegistryEntry is a project recorded in the project registry.
+type RegistryEntry struct {
+	Name       string    `json:"name"`
+	Root       string    `json:"root"`
+	LastOpened time.Time `json:"last_opened"`
+}
+
+// RegistryPath returns the path of the project registry,
+// $XDG_CONFIG_HOME/co/projects.json (default ~/.config/co/projects.json).
+func RegistryPath() (string, error) {
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to find home directory: %w", err)
+		}
+		configHome = filepath.Join(home, ".config")
+	}
+	return filepath.Join(configHome, "co", RegistryFile), nil
+}
+
+// LoadRegistry reads the project registry at path, most recently opened first.
+// A missing registry is treated as empty.
+func LoadRegistry(path string) ([]RegistryEntry, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read project registry: %w", err)
+	}
+
+	var entries []RegistryEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse project registry %s: %w", path, err)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].LastOpened.After(entries[j].LastOpened)
+	})
+	return entries, nil
+}
+
+// RecordInRegistry adds the project to the registry at path, or refreshes its
+// name and last-opened time if it's already there.
+func RecordInRegistry(path string, proj *Project) error {
+	entries, err := LoadRegistry(path)
+	if err != nil {
+		return err
+	}
+
+	entry := RegistryEntry{Name: proj.Config.Project.Name, Root: proj.Root, LastOpened: time.Now()}
+	found := false
+	for i := range entries {
+		if entries[i].Root == proj.Root {
+			entries[i] = entry
+			found = true
+			break
+		}
+	}
+	if !found {
+		entries = append(entries, entry)
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode project registry: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create registry directory: %w", err)
+	}
+
+	// Write through a temp file so concurrent co processes never see a partial registry
+	tmp, err := os.CreateTemp(filepath.Dir(path), RegistryFile+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write project registry: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write project registry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write project registry: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write project registry: %w", err)
+	}
+	return nil
+}
+
+// KnownProjects returns the registered projects that still exist on disk,
+// most recently opened first.
+func KnownProjects() ([]RegistryEntry, error) {
+	path, err := RegistryPath()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := LoadRegistry(path)
+	if err != nil {
+		return nil, err
+	}
+
+	known := entries[:0]
+	for _, e := range entries {
+		if _, err := os.Stat(filepath.Join(e.Root, ConfigDir, ConfigFile)); err == nil {
+			known = append(known, e)
+		}
+	}
+	return known, nil
+}
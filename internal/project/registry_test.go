@@ -0,0 +1,64 @@
+package project
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordInRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "co", RegistryFile)
+
+	entries, err := LoadRegistry(path)
+	require.NoError(t, err, "missing registry is empty")
+	require.Empty(t, entries)
+
+	alpha := &Project{Root: "/projects/alpha", Config: &Config{Project: ProjectConfig{Name: "alpha"}}}
+	beta := &Project{Root: "/projects/beta", Config: &Config{Project: ProjectConfig{Name: "beta"}}}
+	require.NoError(t, RecordInRegistry(path, alpha))
+	time.Sleep(time.Millisecond)
+	require.NoError(t, RecordInRegistry(path, beta))
+
+	entries, err = LoadRegistry(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "beta", entries[0].Name, "most recently opened first")
+
+	// Reopening a project refreshes its entry instead of adding another
+	time.Sleep(time.Millisecond)
+	alpha.Config.Project.Name = "alpha-renamed"
+	require.NoError(t, RecordInRegistry(path, alpha))
+	entries, err = LoadRegistry(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "alpha-renamed", entries[0].Name)
+	require.Equal(t, "/projects/alpha", entries[0].Root)
+}
+
+func TestKnownProjectsSkipsMissing(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+
+	existing := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(existing, ConfigDir), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(existing, ConfigDir, ConfigFile), nil, 0644))
+
+	path, err := RegistryPath()
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(configHome, "co", RegistryFile), path)
+
+	for _, p := range []*Project{
+		{Root: existing, Config: &Config{Project: ProjectConfig{Name: "existing"}}},
+		{Root: filepath.Join(existing, "gone"), Config: &Config{Project: ProjectConfig{Name: "gone"}}},
+	} {
+		require.NoError(t, RecordInRegistry(path, p))
+	}
+
+	known, err := KnownProjects()
+	require.NoError(t, err)
+	require.Len(t, known, 1)
+	require.Equal(t, "existing", known[0].Name)
+}
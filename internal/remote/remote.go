@@ -0,0 +1,235 @@
+// Package remote runs works on another machine over SSH.
+//
+// A remote work's worktree is created on the host configured under [remote],
+// and its Claude sessions run there, while the tracking database and the
+// orchestrator stay on this machine. A Claude session reports on its task
+// with co commands (co complete, co estimate, co task progress); on the host
+// those are forwarded back through a Unix socket that ssh forwards from the
+// host to the orchestrator, which runs them here against the tracking
+// database.
+//
+// Trust model: co trusts the host as much as this machine. It runs ssh with
+// the user's own keys and config, and whatever the host sends back through
+// the report socket is run here as the user, limited to the co subcommands
+// in reportCommands and to the project the orchestrator belongs to. ssh
+// creates the socket on the host readable by the remote user only, so anyone
+// who can use it can already run commands as that user on the host. No
+// credentials are copied to the host; git there pushes with its own.
+package remote
+
+//go:generate moq -stub -out remote_mock.go . Operations:RemoteOperationsMock
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/newhook/co/internal/managed"
+	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/worktree"
+)
+
+// Target is a project's directory on a remote host.
+type Target struct {
+	Host string // SSH destination
+	Root string // Directory on the host holding "main" and the worktrees
+}
+
+// TargetFor returns where the project's remote works go, from its [remote]
+// config.
+func TargetFor(cfg *project.Config) Target {
+	return Target{Host: cfg.Remote.Host, Root: cfg.Remote.GetRoot(cfg.Project.Name)}
+}
+
+// MainRepoPath returns the path of the repository clone on the host.
+func (t Target) MainRepoPath() string {
+	return path.Join(t.Root, "main")
+}
+
+// WorktreePath returns the path of a work's worktree on the host, laid out
+// as a local work's is under the project directory.
+func (t Target) WorktreePath(workID string) string {
+	return path.Join(t.Root, workID, "tree")
+}
+
+// WorktreeSpec describes the worktree to create for a work.
+type WorktreeSpec struct {
+	WorkID      string
+	Branch      string
+	BaseBranch  string
+	OriginURL   string // Cloned from when the host has no clone yet
+	UseExisting bool   // Check out Branch as it is, rather than create it from BaseBranch
+	// Hooks are installed into the hooks directory the worktree uses, as
+	// worktree.Setup installs them for a local worktree.
+	Hooks []worktree.Hook
+}
+
+// Operations runs git on a remote host over SSH.
+type Operations interface {
+	// ProvisionWorktree creates a work's worktree on the host, cloning the
+	// repository there first if needed, and pushes a new branch. It returns
+	// the worktree's path on the host.
+	ProvisionWorktree(ctx context.Context, target Target, spec WorktreeSpec) (string, error)
+	// RemoveWorktree removes a work's worktree and directory from the host.
+	RemoveWorktree(ctx context.Context, target Target, workID string) error
+	// DeleteBranch force-deletes a branch from the host's clone, if it has it.
+	DeleteBranch(ctx context.Context, target Target, branch string) error
+	// PushSetUpstream pushes a branch from a worktree on the host and sets
+	// upstream tracking.
+	PushSetUpstream(ctx context.Context, host, dir, branch string) error
+}
+
+// SSHOperations implements Operations with the ssh CLI.
+type SSHOperations struct{}
+
+// Compile-time check that SSHOperations implements Operations.
+var _ Operations = (*SSHOperations)(nil)
+
+// NewOperations creates a new Operations implementation using the ssh CLI.
+func NewOperations() Operations {
+	return &SSHOperations{}
+}
+
+// ProvisionWorktree implements Operations.ProvisionWorktree.
+func (s *SSHOperations) ProvisionWorktree(ctx context.Context, target Target, spec WorktreeSpec) (string, error) {
+	if _, err := Run(ctx, target.Host, provisionScript(target, spec)); err != nil {
+		return "", fmt.Errorf("failed to create worktree on %s: %w", target.Host, err)
+	}
+	return target.WorktreePath(spec.WorkID), nil
+}
+
+// RemoveWorktree implements Operations.RemoveWorktree.
+func (s *SSHOperations) RemoveWorktree(ctx context.Context, target Target, workID string) error {
+	if _, err := Run(ctx, target.Host, removeScript(target, workID)); err != nil {
+		return fmt.Errorf("failed to remove worktree on %s: %w", target.Host, err)
+	}
+	return nil
+}
+
+// DeleteBranch implements Operations.DeleteBranch.
+func (s *SSHOperations) DeleteBranch(ctx context.Context, target Target, branch string) error {
+	if _, err := Run(ctx, target.Host, deleteBranchScript(target, branch)); err != nil {
+		return fmt.Errorf("failed to delete branch %s on %s: %w", branch, target.Host, err)
+	}
+	return nil
+}
+
+// PushSetUpstream implements Operations.PushSetUpstream.
+func (s *SSHOperations) PushSetUpstream(ctx context.Context, host, dir, branch string) error {
+	script := fmt.Sprintf("git -C %s push --set-upstream origin %s", ShellPath(dir), Quote(branch))
+	if _, err := Run(ctx, host, script); err != nil {
+		return fmt.Errorf("failed to push %s on %s: %w", branch, host, err)
+	}
+	return nil
+}
+
+// provisionScript returns the shell script that creates a work's worktree on
+// the host, adds the co-managed paths to its info/exclude and installs the
+// spec's hooks. It's idempotent, so a creation retried after a failed push
+// reuses the clone and the worktree.
+func provisionScript(target Target, spec WorktreeSpec) string {
+	main := ShellPath(target.MainRepoPath())
+	tree := ShellPath(target.WorktreePath(spec.WorkID))
+	lines := []string{
+		"set -e",
+		fmt.Sprintf("if [ ! -d %s/.git ]; then mkdir -p %s && git clone %s %s; fi", main, ShellPath(target.Root), Quote(spec.OriginURL), main),
+		fmt.Sprintf("mkdir -p %s", ShellPath(path.Join(target.Root, spec.WorkID))),
+	}
+	if spec.UseExisting {
+		lines = append(lines,
+			// The branch may only exist on this machine's side, already pushed
+			fmt.Sprintf("git -C %s fetch origin %s || true", main, Quote(spec.Branch)),
+			fmt.Sprintf("[ -d %s ] || git -C %s worktree add %s %s", tree, main, tree, Quote(spec.Branch)),
+		)
+	} else {
+		lines = append(lines,
+			fmt.Sprintf("git -C %s fetch origin %s", main, Quote(spec.BaseBranch)),
+			fmt.Sprintf("[ -d %s ] || git -C %s worktree add -b %s %s %s", tree, main, Quote(spec.Branch), tree, Quote("origin/"+spec.BaseBranch)),
+			fmt.Sprintf("git -C %s push --set-upstream origin %s", tree, Quote(spec.Branch)),
+		)
+	}
+	// Keep co's own files out of the work's commits, as for a local worktree
+	lines = append(lines, fmt.Sprintf(`exclude=$(cd %s && git rev-parse --path-format=absolute --git-path info/exclude) && mkdir -p "$(dirname "$exclude")"`, tree))
+	for _, pattern := range managed.Patterns {
+		lines = append(lines, fmt.Sprintf(`grep -qxF %s "$exclude" 2>/dev/null || echo %s >> "$exclude"`, Quote(pattern), Quote(pattern)))
+	}
+	if len(spec.Hooks) > 0 {
+		lines = append(lines, fmt.Sprintf(`hooks=$(cd %s && git rev-parse --path-format=absolute --git-path hooks) && mkdir -p "$hooks"`, tree))
+	}
+	for _, hook := range spec.Hooks {
+		// A hook of the user's own is left alone, as it is locally
+		lines = append(lines,
+			fmt.Sprintf(`hook="$hooks"/%s`, Quote(hook.Name)),
+			fmt.Sprintf(`if [ ! -e "$hook" ] || grep -qF %s "$hook"; then printf '%%s' %s > "$hook" && chmod 755 "$hook"; else echo "co: $hook already exists, not replaced" >&2; fi`, Quote(hook.Marker), Quote(hook.Script)),
+		)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// removeScript returns the shell script that removes a work's worktree and
+// directory from the host.
+func removeScript(target Target, workID string) string {
+	return strings.Join([]string{
+		fmt.Sprintf("git -C %s worktree remove --force %s || true", ShellPath(target.MainRepoPath()), ShellPath(target.WorktreePath(workID))),
+		fmt.Sprintf("rm -rf %s", ShellPath(path.Join(target.Root, workID))),
+	}, "\n")
+}
+
+// deleteBranchScript returns the shell script that deletes a branch from the
+// host's clone. A branch the clone never had is left alone.
+func deleteBranchScript(target Target, branch string) string {
+	main := ShellPath(target.MainRepoPath())
+	ref := Quote("refs/heads/" + branch)
+	return fmt.Sprintf("if git -C %s show-ref --verify --quiet %s; then git -C %s branch -D %s; fi", main, ref, main, Quote(branch))
+}
+
+// Run runs a shell script on the host and returns its combined output.
+// BatchMode keeps ssh from prompting, since no one may be there to answer.
+// "--" ends ssh's options, so the host is never read as one.
+func Run(ctx context.Context, host, script string) (string, error) {
+	cmd := exec.CommandContext(ctx, "ssh", "-o", "BatchMode=yes", "--", host, script)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(output), fmt.Errorf("%w\n%s", err, strings.TrimSpace(string(output)))
+	}
+	return string(output), nil
+}
+
+// InteractiveArgs returns the ssh arguments that run command in dir on the
+// host, in a terminal, for a zellij tab. They end ssh's options, so they
+// must come after any others.
+func InteractiveArgs(host, dir, command string) []string {
+	return []string{"-t", "--", host, fmt.Sprintf("cd %s && %s", ShellPath(dir), command)}
+}
+
+// Quote quotes s for a POSIX shell.
+func Quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
+
+// ShellPath quotes a path on the host for a POSIX shell, leaving a leading
+// "~/" to be expanded to the remote user's home.
+func ShellPath(p string) string {
+	if p == "~" {
+		return `"$HOME"`
+	}
+	if rest, ok := strings.CutPrefix(p, "~/"); ok {
+		return `"$HOME"/` + Quote(rest)
+	}
+	return Quote(p)
+}
+
+// ExportCommand prefixes command with exports of env, KEY=value entries.
+func ExportCommand(env []string, command string) string {
+	var parts []string
+	for _, entry := range env {
+		key, value, ok := strings.Cut(entry, "=")
+		if !ok || key == "" {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("export %s=%s", key, Quote(value)))
+	}
+	return strings.Join(append(parts, command), " && ")
+}
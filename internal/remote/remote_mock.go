@@ -0,0 +1,274 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package remote
+
+import (
+	"context"
+	"sync"
+)
+
+// Ensure, that RemoteOperationsMock does implement Operations.
+// If this is not the case, regenerate this file with moq.
+var _ Operations = &RemoteOperationsMock{}
+
+// RemoteOperationsMock is a mock implementation of Operations.
+//
+//	func TestSomethingThatUsesOperations(t *testing.T) {
+//
+//		// make and configure a mocked Operations
+//		mockedOperations := &RemoteOperationsMock{
+//			DeleteBranchFunc: func(ctx context.Context, target Target, branch string) error {
+//				panic("mock out the DeleteBranch method")
+//			},
+//			ProvisionWorktreeFunc: func(ctx context.Context, target Target, spec WorktreeSpec) (string, error) {
+//				panic("mock out the ProvisionWorktree method")
+//			},
+//			PushSetUpstreamFunc: func(ctx context.Context, host string, dir string, branch string) error {
+//				panic("mock out the PushSetUpstream method")
+//			},
+//			RemoveWorktreeFunc: func(ctx context.Context, target Target, workID string) error {
+//				panic("mock out the RemoveWorktree method")
+//			},
+//		}
+//
+//		// use mockedOperations in code that requires Operations
+//		// and then make assertions.
+//
+//	}
+type RemoteOperationsMock struct {
+	// DeleteBranchFunc mocks the DeleteBranch method.
+	DeleteBranchFunc func(ctx context.Context, target Target, branch string) error
+
+	// ProvisionWorktreeFunc mocks the ProvisionWorktree method.
+	ProvisionWorktreeFunc func(ctx context.Context, target Target, spec WorktreeSpec) (string, error)
+
+	// PushSetUpstreamFunc mocks the PushSetUpstream method.
+	PushSetUpstreamFunc func(ctx context.Context, host string, dir string, branch string) error
+
+	// RemoveWorktreeFunc mocks the RemoveWorktree method.
+	RemoveWorktreeFunc func(ctx context.Context, target Target, workID string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteBranch holds details about calls to the DeleteBranch method.
+		DeleteBranch []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Target is the target argument value.
+			Target Target
+			// Branch is the branch argument value.
+			Branch string
+		}
+		// ProvisionWorktree holds details about calls to the ProvisionWorktree method.
+		ProvisionWorktree []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Target is the target argument value.
+			Target Target
+			// Spec is the spec argument value.
+			Spec WorktreeSpec
+		}
+		// PushSetUpstream holds details about calls to the PushSetUpstream method.
+		PushSetUpstream []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Host is the host argument value.
+			Host string
+			// Dir is the dir argument value.
+			Dir string
+			// Branch is the branch argument value.
+			Branch string
+		}
+		// RemoveWorktree holds details about calls to the RemoveWorktree method.
+		RemoveWorktree []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Target is the target argument value.
+			Target Target
+			// WorkID is the workID argument value.
+			WorkID string
+		}
+	}
+	lockDeleteBranch      sync.RWMutex
+	lockProvisionWorktree sync.RWMutex
+	lockPushSetUpstream   sync.RWMutex
+	lockRemoveWorktree    sync.RWMutex
+}
+
+// DeleteBranch calls DeleteBranchFunc.
+func (mock *RemoteOperationsMock) DeleteBranch(ctx context.Context, target Target, branch string) error {
+	callInfo := struct {
+		Ctx    context.Context
+		Target Target
+		Branch string
+	}{
+		Ctx:    ctx,
+		Target: target,
+		Branch: branch,
+	}
+	mock.lockDeleteBranch.Lock()
+	mock.calls.DeleteBranch = append(mock.calls.DeleteBranch, callInfo)
+	mock.lockDeleteBranch.Unlock()
+	if mock.DeleteBranchFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.DeleteBranchFunc(ctx, target, branch)
+}
+
+// DeleteBranchCalls gets all the calls that were made to DeleteBranch.
+// Check the length with:
+//
+//	len(mockedOperations.DeleteBranchCalls())
+func (mock *RemoteOperationsMock) DeleteBranchCalls() []struct {
+	Ctx    context.Context
+	Target Target
+	Branch string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Target Target
+		Branch string
+	}
+	mock.lockDeleteBranch.RLock()
+	calls = mock.calls.DeleteBranch
+	mock.lockDeleteBranch.RUnlock()
+	return calls
+}
+
+// ProvisionWorktree calls ProvisionWorktreeFunc.
+func (mock *RemoteOperationsMock) ProvisionWorktree(ctx context.Context, target Target, spec WorktreeSpec) (string, error) {
+	callInfo := struct {
+		Ctx    context.Context
+		Target Target
+		Spec   WorktreeSpec
+	}{
+		Ctx:    ctx,
+		Target: target,
+		Spec:   spec,
+	}
+	mock.lockProvisionWorktree.Lock()
+	mock.calls.ProvisionWorktree = append(mock.calls.ProvisionWorktree, callInfo)
+	mock.lockProvisionWorktree.Unlock()
+	if mock.ProvisionWorktreeFunc == nil {
+		var (
+			sOut   string
+			errOut error
+		)
+		return sOut, errOut
+	}
+	return mock.ProvisionWorktreeFunc(ctx, target, spec)
+}
+
+// ProvisionWorktreeCalls gets all the calls that were made to ProvisionWorktree.
+// Check the length with:
+//
+//	len(mockedOperations.ProvisionWorktreeCalls())
+func (mock *RemoteOperationsMock) ProvisionWorktreeCalls() []struct {
+	Ctx    context.Context
+	Target Target
+	Spec   WorktreeSpec
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Target Target
+		Spec   WorktreeSpec
+	}
+	mock.lockProvisionWorktree.RLock()
+	calls = mock.calls.ProvisionWorktree
+	mock.lockProvisionWorktree.RUnlock()
+	return calls
+}
+
+// PushSetUpstream calls PushSetUpstreamFunc.
+func (mock *RemoteOperationsMock) PushSetUpstream(ctx context.Context, host string, dir string, branch string) error {
+	callInfo := struct {
+		Ctx    context.Context
+		Host   string
+		Dir    string
+		Branch string
+	}{
+		Ctx:    ctx,
+		Host:   host,
+		Dir:    dir,
+		Branch: branch,
+	}
+	mock.lockPushSetUpstream.Lock()
+	mock.calls.PushSetUpstream = append(mock.calls.PushSetUpstream, callInfo)
+	mock.lockPushSetUpstream.Unlock()
+	if mock.PushSetUpstreamFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.PushSetUpstreamFunc(ctx, host, dir, branch)
+}
+
+// PushSetUpstreamCalls gets all the calls that were made to PushSetUpstream.
+// Check the length with:
+//
+//	len(mockedOperations.PushSetUpstreamCalls())
+func (mock *RemoteOperationsMock) PushSetUpstreamCalls() []struct {
+	Ctx    context.Context
+	Host   string
+	Dir    string
+	Branch string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Host   string
+		Dir    string
+		Branch string
+	}
+	mock.lockPushSetUpstream.RLock()
+	calls = mock.calls.PushSetUpstream
+	mock.lockPushSetUpstream.RUnlock()
+	return calls
+}
+
+// RemoveWorktree calls RemoveWorktreeFunc.
+func (mock *RemoteOperationsMock) RemoveWorktree(ctx context.Context, target Target, workID string) error {
+	callInfo := struct {
+		Ctx    context.Context
+		Target Target
+		WorkID string
+	}{
+		Ctx:    ctx,
+		Target: target,
+		WorkID: workID,
+	}
+	mock.lockRemoveWorktree.Lock()
+	mock.calls.RemoveWorktree = append(mock.calls.RemoveWorktree, callInfo)
+	mock.lockRemoveWorktree.Unlock()
+	if mock.RemoveWorktreeFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.RemoveWorktreeFunc(ctx, target, workID)
+}
+
+// RemoveWorktreeCalls gets all the calls that were made to RemoveWorktree.
+// Check the length with:
+//
+//	len(mockedOperations.RemoveWorktreeCalls())
+func (mock *RemoteOperationsMock) RemoveWorktreeCalls() []struct {
+	Ctx    context.Context
+	Target Target
+	WorkID string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Target Target
+		WorkID string
+	}
+	mock.lockRemoveWorktree.RLock()
+	calls = mock.calls.RemoveWorktree
+	mock.lockRemoveWorktree.RUnlock()
+	return calls
+}
@@ -0,0 +1,133 @@
+package remote
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/worktree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuote(t *testing.T) {
+	require.Equal(t, `'plain'`, Quote("plain"))
+	require.Equal(t, `'it'"'"'s'`, Quote("it's"))
+	require.Equal(t, `'$HOME; rm -rf /'`, Quote("$HOME; rm -rf /"))
+
+	require.Equal(t, `"$HOME"/'co/my proj'`, ShellPath("~/co/my proj"))
+	require.Equal(t, `"$HOME"`, ShellPath("~"))
+	require.Equal(t, `'/srv/co'`, ShellPath("/srv/co"))
+	require.Equal(t, `'~user/co'`, ShellPath("~user/co"))
+}
+
+func TestTargetFor(t *testing.T) {
+	cfg := &project.Config{}
+	cfg.Project.Name = "shop"
+	cfg.Remote.Host = "desktop"
+	target := TargetFor(cfg)
+	require.Equal(t, Target{Host: "desktop", Root: "~/co/shop"}, target)
+	require.Equal(t, "~/co/shop/main", target.MainRepoPath())
+	require.Equal(t, "~/co/shop/w-abc/tree", target.WorktreePath("w-abc"))
+
+	cfg.Remote.Root = "/srv/co/shop"
+	require.Equal(t, "/srv/co/shop/w-abc/tree", TargetFor(cfg).WorktreePath("w-abc"))
+}
+
+func TestExportCommand(t *testing.T) {
+	require.Equal(t, "claude", ExportCommand(nil, "claude"))
+	require.Equal(t, `export A='1' && export B='x y' && claude`, ExportCommand([]string{"A=1", "B=x y", "bad"}, "claude"))
+}
+
+// git runs git in dir, failing the test on error
+func git(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=t", "GIT_AUTHOR_EMAIL=t@t", "GIT_COMMITTER_NAME=t", "GIT_COMMITTER_EMAIL=t@t")
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+}
+
+// runScript runs a script as the host's shell would
+func runScript(t *testing.T, script string) {
+	t.Helper()
+	output, err := exec.Command("sh", "-c", script).CombinedOutput()
+	require.NoError(t, err, string(output))
+}
+
+func TestProvisionAndRemoveScripts(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	// A bare repository stands in for origin, and a directory for the host
+	origin := filepath.Join(t.TempDir(), "origin.git")
+	seed := t.TempDir()
+	git(t, seed, "init", "-q", "-b", "main")
+	git(t, seed, "commit", "-q", "--allow-empty", "-m", "initial")
+	git(t, seed, "clone", "-q", "--bare", seed, origin)
+
+	target := Target{Host: "local", Root: filepath.Join(t.TempDir(), "my project")}
+	hooks := worktree.Hooks(worktree.SetupOptions{CommitHook: true, ProtectManaged: true})
+	spec := WorktreeSpec{WorkID: "w-abc", Branch: "feat/abc", BaseBranch: "main", OriginURL: origin, Hooks: hooks}
+
+	// The first work clones the repository; later ones reuse the clone
+	runScript(t, provisionScript(target, spec))
+	require.DirExists(t, filepath.Join(target.MainRepoPath(), ".git"))
+	require.FileExists(t, filepath.Join(target.WorktreePath("w-abc"), ".git"))
+	git(t, origin, "rev-parse", "--verify", "refs/heads/feat/abc")
+
+	spec.WorkID, spec.Branch = "w-def", "feat/def"
+	runScript(t, provisionScript(target, spec))
+	require.FileExists(t, filepath.Join(target.WorktreePath("w-def"), ".git"))
+	exclude, err := os.ReadFile(filepath.Join(target.MainRepoPath(), ".git", "info", "exclude"))
+	require.NoError(t, err)
+	require.Equal(t, 1, strings.Count(string(exclude), "\n.co/\n"), "provisioning again doesn't add the patterns twice")
+
+	// The hooks are installed where the worktrees share them, and replaced,
+	// rather than added to, when provisioning again
+	require.Len(t, hooks, 2)
+	for _, hook := range hooks {
+		data, err := os.ReadFile(filepath.Join(target.MainRepoPath(), ".git", "hooks", hook.Name))
+		require.NoError(t, err)
+		require.Equal(t, hook.Script, string(data))
+	}
+
+	// An existing branch is checked out as it is
+	spec.WorkID, spec.UseExisting = "w-ghi", true
+	runScript(t, removeScript(target, "w-def"))
+	require.NoDirExists(t, filepath.Join(target.Root, "w-def"))
+	runScript(t, provisionScript(target, spec))
+	require.FileExists(t, filepath.Join(target.WorktreePath("w-ghi"), ".git"))
+
+	// Removing twice is harmless
+	runScript(t, removeScript(target, "w-abc"))
+	runScript(t, removeScript(target, "w-abc"))
+	require.NoDirExists(t, filepath.Join(target.Root, "w-abc"))
+
+	// The branch of a removed worktree can be deleted, and one the clone
+	// never had is skipped
+	runScript(t, deleteBranchScript(target, "feat/abc"))
+	runScript(t, deleteBranchScript(target, "feat/abc"))
+	err = exec.Command("git", "-C", target.MainRepoPath(), "show-ref", "--verify", "--quiet", "refs/heads/feat/abc").Run()
+	require.Error(t, err, "the branch is gone")
+}
+
+func TestRunEndsSSHOptions(t *testing.T) {
+	// A stand-in ssh prints the arguments it was given
+	bin := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "ssh"), []byte("#!/bin/sh\nprintf '%s\\n' \"$@\"\n"), 0755))
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	output, err := Run(context.Background(), "-oProxyCommand=sh", "true")
+	require.NoError(t, err)
+	require.Equal(t, "-o\nBatchMode=yes\n--\n-oProxyCommand=sh\ntrue\n", output, "the host comes after the end of ssh's options")
+	require.Equal(t, []string{"-t", "--", "desktop", `cd '/w' && true`}, InteractiveArgs("desktop", "/w", "true"))
+}
+
+func TestNewOperations(t *testing.T) {
+	_, ok := NewOperations().(*SSHOperations)
+	require.True(t, ok)
+}
@@ -0,0 +1,172 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/newhook/co/internal/logging"
+)
+
+// ReportSocketEnv names the socket a remote session's co commands are
+// forwarded through. It's set only in sessions on a remote host.
+const ReportSocketEnv = "CO_REPORT_SOCKET"
+
+// reportCommands are the co subcommands a remote session may run here. They
+// are the ones task prompts tell Claude to run to report progress.
+var reportCommands = map[string]bool{
+	"complete":      true,
+	"estimate":      true,
+	"task progress": true,
+}
+
+// reportable reports whether a forwarded command line runs one of
+// reportCommands
+func reportable(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	if reportCommands[args[0]] {
+		return true
+	}
+	return len(args) > 1 && reportCommands[args[0]+" "+args[1]]
+}
+
+// reportTimeout bounds one forwarded command
+const reportTimeout = 2 * time.Minute
+
+// reportRequest is a co command line forwarded from the host
+type reportRequest struct {
+	Args []string `json:"args"`
+}
+
+// reportResponse is the outcome of running a forwarded command here
+type reportResponse struct {
+	Output   string `json:"output"`
+	ExitCode int    `json:"exit_code"`
+}
+
+// ReportServer runs the co commands remote sessions forward to it, in the
+// project directory, for as long as a task's session runs.
+type ReportServer struct {
+	listener net.Listener
+	dir      string
+	// run runs co with args in dir; a field so tests don't need a co binary
+	run func(ctx context.Context, dir string, args []string) (output []byte, exitCode int, err error)
+}
+
+// LocalSocketPath returns the socket a task's report server listens on.
+// It's under the temp directory rather than the project, since socket paths
+// are limited to about 100 bytes.
+func LocalSocketPath(taskID string) string {
+	return filepath.Join(os.TempDir(), fmt.Sprintf("co-report-%d-%s.sock", os.Getpid(), taskID))
+}
+
+// RemoteSocketPath returns the socket ssh forwards to the report server on
+// the host.
+func RemoteSocketPath(taskID string) string {
+	return fmt.Sprintf("/tmp/co-report-%s.sock", taskID)
+}
+
+// ListenReports starts a report server on socketPath for the project at dir.
+func ListenReports(socketPath, dir string) (*ReportServer, error) {
+	_ = os.Remove(socketPath) // Left over from a crashed run
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on report socket: %w", err)
+	}
+	return &ReportServer{listener: listener, dir: dir, run: runCo}, nil
+}
+
+// Serve answers forwarded commands until the server is closed.
+func (s *ReportServer) Serve(ctx context.Context) {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				logging.Warn("report server stopped", "error", err)
+			}
+			return
+		}
+		go s.handle(ctx, conn)
+	}
+}
+
+// Close stops the server and removes its socket.
+func (s *ReportServer) Close() error {
+	return s.listener.Close()
+}
+
+// handle runs one forwarded command and writes back its outcome
+func (s *ReportServer) handle(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+	var req reportRequest
+	if err := json.NewDecoder(conn).Decode(&req); err != nil {
+		logging.Warn("invalid report request", "error", err)
+		return
+	}
+	resp := s.respond(ctx, req)
+	if err := json.NewEncoder(conn).Encode(resp); err != nil {
+		logging.Warn("failed to answer report request", "error", err)
+	}
+}
+
+// respond runs a forwarded command if it's one a session may run
+func (s *ReportServer) respond(ctx context.Context, req reportRequest) reportResponse {
+	if !reportable(req.Args) {
+		logging.Warn("refused forwarded command", "args", req.Args)
+		return reportResponse{Output: "co: only co complete, co estimate and co task progress can be run from a remote session\n", ExitCode: 1}
+	}
+	ctx, cancel := context.WithTimeout(ctx, reportTimeout)
+	defer cancel()
+	logging.Info("running forwarded command", "args", req.Args)
+	output, exitCode, err := s.run(ctx, s.dir, req.Args)
+	if err != nil {
+		return reportResponse{Output: string(output) + err.Error() + "\n", ExitCode: 1}
+	}
+	return reportResponse{Output: string(output), ExitCode: exitCode}
+}
+
+// runCo runs this co binary with args in dir
+func runCo(ctx context.Context, dir string, args []string) ([]byte, int, error) {
+	self, err := os.Executable()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to find co: %w", err)
+	}
+	cmd := exec.CommandContext(ctx, self, args...)
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return output, exitErr.ExitCode(), nil
+	}
+	return output, 0, err
+}
+
+// Forward sends a co command line to the report server behind socketPath,
+// writes its output to out and returns its exit code.
+func Forward(socketPath string, args []string, out io.Writer) (int, error) {
+	conn, err := net.DialTimeout("unix", socketPath, 5*time.Second)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reach the co orchestrator through %s: %w", socketPath, err)
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(reportTimeout + 10*time.Second))
+
+	if err := json.NewEncoder(conn).Encode(reportRequest{Args: args}); err != nil {
+		return 0, fmt.Errorf("failed to forward command: %w", err)
+	}
+	var resp reportResponse
+	if err := json.NewDecoder(conn).Decode(&resp); err != nil {
+		return 0, fmt.Errorf("failed to read forwarded command's result: %w", err)
+	}
+	_, _ = io.WriteString(out, resp.Output)
+	return resp.ExitCode, nil
+}
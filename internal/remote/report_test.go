@@ -0,0 +1,64 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportForwarding(t *testing.T) {
+	dir := t.TempDir()
+	socket := LocalSocketPath("w-test.1")
+	server, err := ListenReports(socket, dir)
+	require.NoError(t, err)
+	defer server.Close()
+
+	var ran [][]string
+	server.run = func(ctx context.Context, runDir string, args []string) ([]byte, int, error) {
+		require.Equal(t, dir, runDir)
+		ran = append(ran, args)
+		if args[1] == "bad-task" {
+			return []byte("Error: task bad-task not found\n"), 1, nil
+		}
+		return []byte("Task marked complete\n"), 0, nil
+	}
+	go server.Serve(context.Background())
+
+	var out bytes.Buffer
+	code, err := Forward(socket, []string{"complete", "w-abc.1", "--pr", "https://example.com/pr/1"}, &out)
+	require.NoError(t, err)
+	require.Equal(t, 0, code)
+	require.Equal(t, "Task marked complete\n", out.String())
+
+	// The exit code comes back with the output
+	out.Reset()
+	code, err = Forward(socket, []string{"complete", "bad-task"}, &out)
+	require.NoError(t, err)
+	require.Equal(t, 1, code)
+	require.Contains(t, out.String(), "not found")
+
+	// Only the reporting commands are run
+	out.Reset()
+	code, err = Forward(socket, []string{"work", "destroy", "w-abc"}, &out)
+	require.NoError(t, err)
+	require.Equal(t, 1, code)
+	require.Contains(t, out.String(), "only co complete, co estimate and co task progress")
+	code, err = Forward(socket, []string{"task", "delete", "w-abc.1"}, &out)
+	require.NoError(t, err)
+	require.Equal(t, 1, code)
+	require.Len(t, ran, 2)
+
+	// Progress steps are a reporting command too
+	out.Reset()
+	code, err = Forward(socket, []string{"task", "progress", "w-abc.1", "--step", "running tests"}, &out)
+	require.NoError(t, err)
+	require.Equal(t, 0, code)
+	require.Len(t, ran, 3)
+
+	// Once the server is gone, forwarding says so
+	require.NoError(t, server.Close())
+	_, err = Forward(socket, []string{"complete", "w-abc.1"}, &out)
+	require.ErrorContains(t, err, "failed to reach the co orchestrator")
+}
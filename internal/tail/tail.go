@@ -0,0 +1,284 @@
+// Package tail follows a growing text file like tail -F. It keeps the last
+// lines of the file, picks up lines as they are appended, and starts over on
+// a file that is truncated or replaced, e.g. by log rotation. A file that
+// doesn't exist yet is picked up once it is created.
+package tail
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// Config holds tailer configuration options.
+type Config struct {
+	Path     string
+	MaxLines int // Lines kept; older lines are dropped
+	// PollInterval is how often the file is checked in case fsnotify misses
+	// a change or can't watch the directory, e.g. because it doesn't exist yet.
+	PollInterval time.Duration
+}
+
+// DefaultConfig returns sensible defaults for tailing path.
+func DefaultConfig(path string) Config {
+	return Config{
+		Path:         path,
+		MaxLines:     500,
+		PollInterval: time.Second,
+	}
+}
+
+// Tailer follows a file. Its methods are safe to call from any goroutine.
+type Tailer struct {
+	cfg       Config
+	fsWatcher *fsnotify.Watcher // Nil when the directory can't be watched
+	updates   chan struct{}
+	done      chan struct{}
+	wg        sync.WaitGroup
+
+	mu     sync.Mutex
+	lines  []string
+	exists bool
+
+	// Read state, only touched by the loop goroutine once started
+	file      *os.File
+	info      os.FileInfo
+	offset    int64
+	partial   string // Text after the last newline, not a line yet
+	skipFirst bool   // Reading started mid-line; drop text up to the first newline
+}
+
+// New creates a tailer. Nothing is read until Start.
+func New(cfg Config) *Tailer {
+	if cfg.MaxLines < 1 {
+		cfg.MaxLines = DefaultConfig(cfg.Path).MaxLines
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultConfig(cfg.Path).PollInterval
+	}
+	return &Tailer{
+		cfg:     cfg,
+		updates: make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+}
+
+// Start reads the end of the file, if it exists, and begins following it.
+func (t *Tailer) Start() {
+	t.refresh()
+
+	// Without fsnotify the poll still picks up changes, just later
+	if fsw, err := fsnotify.NewWatcher(); err == nil {
+		if err := fsw.Add(filepath.Dir(t.cfg.Path)); err == nil {
+			t.fsWatcher = fsw
+		} else {
+			_ = fsw.Close()
+		}
+	}
+
+	t.wg.Add(1)
+	go t.loop()
+}
+
+// Stop stops following the file and closes the Updates channel.
+func (t *Tailer) Stop() {
+	close(t.done)
+	t.wg.Wait()
+	if t.fsWatcher != nil {
+		_ = t.fsWatcher.Close()
+	}
+	if t.file != nil {
+		_ = t.file.Close()
+	}
+	close(t.updates)
+}
+
+// Updates receives a value whenever the lines change or the file appears or
+// disappears. Changes that happen before the value is received are
+// coalesced into it. The channel is closed by Stop.
+func (t *Tailer) Updates() <-chan struct{} {
+	return t.updates
+}
+
+// Lines returns the last lines of the file, oldest first. Lines read before
+// the file was rotated are kept.
+func (t *Tailer) Lines() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]string(nil), t.lines...)
+}
+
+// Exists reports whether the file existed when it was last checked.
+func (t *Tailer) Exists() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.exists
+}
+
+// Path returns the path of the file being followed.
+func (t *Tailer) Path() string {
+	return t.cfg.Path
+}
+
+func (t *Tailer) loop() {
+	defer t.wg.Done()
+
+	ticker := time.NewTicker(t.cfg.PollInterval)
+	defer ticker.Stop()
+
+	var events <-chan fsnotify.Event
+	var errs <-chan error
+	if t.fsWatcher != nil {
+		events = t.fsWatcher.Events
+		errs = t.fsWatcher.Errors
+	}
+	base := filepath.Base(t.cfg.Path)
+
+	for {
+		select {
+		case <-t.done:
+			return
+		case event, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			if filepath.Base(event.Name) == base {
+				t.refresh()
+			}
+		case _, ok := <-errs:
+			if !ok {
+				errs = nil
+			}
+			// The poll covers anything fsnotify misses
+		case <-ticker.C:
+			t.refresh()
+		}
+	}
+}
+
+// refresh reads whatever changed since the last refresh and signals Updates
+// if anything did.
+func (t *Tailer) refresh() {
+	if t.read() {
+		select {
+		case t.updates <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// read catches up with the file and reports whether anything changed.
+func (t *Tailer) read() bool {
+	info, err := os.Stat(t.cfg.Path)
+	if err != nil {
+		// Gone, e.g. rotated away and not recreated yet: the rest of the old
+		// file is still read, then the new one is opened once it appears
+		changed := t.drain()
+		if t.file != nil {
+			_ = t.file.Close()
+			t.file, t.info = nil, nil
+		}
+		return t.setExists(false) || changed
+	}
+
+	changed := t.setExists(true)
+	if t.file == nil || !os.SameFile(info, t.info) || info.Size() < t.offset {
+		if t.file != nil && os.SameFile(info, t.info) {
+			// Truncated in place: the old content is gone
+			t.partial = ""
+		} else {
+			changed = t.drain() || changed
+		}
+		f, err := os.Open(t.cfg.Path)
+		if err != nil {
+			return changed
+		}
+		if t.file != nil {
+			_ = t.file.Close()
+		}
+		t.file, t.info, t.offset = f, info, 0
+		if t.partial != "" {
+			t.appendLines([]string{t.partial})
+			t.partial = ""
+			changed = true
+		}
+
+		// Only the end of a big file can end up in the kept lines
+		if start := info.Size() - int64(t.cfg.MaxLines)*512; start > 0 {
+			t.offset = start
+			t.skipFirst = true
+		}
+	}
+	t.info = info
+	return t.readNew(info.Size()) || changed
+}
+
+// drain reads what was appended to the open file before it was rotated.
+func (t *Tailer) drain() bool {
+	if t.file == nil {
+		return false
+	}
+	info, err := t.file.Stat()
+	if err != nil {
+		return false
+	}
+	return t.readNew(info.Size())
+}
+
+// readNew reads the open file from the offset up to size.
+func (t *Tailer) readNew(size int64) bool {
+	if size <= t.offset {
+		return false
+	}
+	buf := make([]byte, size-t.offset)
+	n, err := t.file.ReadAt(buf, t.offset)
+	if err != nil && err != io.EOF {
+		return false
+	}
+	t.offset += int64(n)
+
+	text := t.partial + string(buf[:n])
+	if t.skipFirst {
+		i := strings.IndexByte(text, '\n')
+		if i < 0 {
+			t.partial = ""
+			return false
+		}
+		text = text[i+1:]
+		t.skipFirst = false
+	}
+	parts := strings.Split(text, "\n")
+	t.partial = parts[len(parts)-1]
+	lines := parts[:len(parts)-1]
+	for i, line := range lines {
+		lines[i] = strings.TrimSuffix(line, "\r")
+	}
+	if len(lines) == 0 {
+		return false
+	}
+	t.appendLines(lines)
+	return true
+}
+
+func (t *Tailer) appendLines(lines []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lines = append(t.lines, lines...)
+	if over := len(t.lines) - t.cfg.MaxLines; over > 0 {
+		t.lines = append([]string(nil), t.lines[over:]...)
+	}
+}
+
+func (t *Tailer) setExists(exists bool) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	changed := t.exists != exists
+	t.exists = exists
+	return changed
+}
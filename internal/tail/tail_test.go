@@ -0,0 +1,144 @@
+package tail_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/newhook/co/internal/tail"
+)
+
+// startTailer starts a tailer on path with a short poll interval
+func startTailer(t *testing.T, path string, maxLines int) *tail.Tailer {
+	t.Helper()
+	tailer := tail.New(tail.Config{Path: path, MaxLines: maxLines, PollInterval: 20 * time.Millisecond})
+	tailer.Start()
+	t.Cleanup(tailer.Stop)
+	return tailer
+}
+
+// requireLines waits until the tailer holds exactly want
+func requireLines(t *testing.T, tailer *tail.Tailer, want ...string) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		got := tailer.Lines()
+		return strings.Join(got, "\n") == strings.Join(want, "\n") && len(got) == len(want)
+	}, 2*time.Second, 10*time.Millisecond, "got %q, want %q", tailer.Lines(), want)
+}
+
+func appendFile(t *testing.T, path, text string) {
+	t.Helper()
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString(text)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func TestTailer_ReadsExistingAndAppendedLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	require.NoError(t, os.WriteFile(path, []byte("one\ntwo\n"), 0o644))
+
+	tailer := startTailer(t, path, 10)
+	require.True(t, tailer.Exists())
+	require.Equal(t, []string{"one", "two"}, tailer.Lines())
+
+	// A partial line waits for its newline
+	appendFile(t, path, "thr")
+	time.Sleep(100 * time.Millisecond)
+	require.Equal(t, []string{"one", "two"}, tailer.Lines())
+
+	appendFile(t, path, "ee\r\nfour\n")
+	requireLines(t, tailer, "one", "two", "three", "four")
+
+	select {
+	case <-tailer.Updates():
+	case <-time.After(time.Second):
+		require.Fail(t, "expected an update")
+	}
+}
+
+func TestTailer_KeepsLastLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	var b strings.Builder
+	for i := 1; i <= 20; i++ {
+		fmt.Fprintf(&b, "line %d\n", i)
+	}
+	require.NoError(t, os.WriteFile(path, []byte(b.String()), 0o644))
+
+	tailer := startTailer(t, path, 3)
+	require.Equal(t, []string{"line 18", "line 19", "line 20"}, tailer.Lines())
+
+	appendFile(t, path, "line 21\n")
+	requireLines(t, tailer, "line 19", "line 20", "line 21")
+}
+
+func TestTailer_StartsNearTheEndOfBigFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	long := strings.Repeat("x", 600)
+	var b strings.Builder
+	for i := 1; i <= 10; i++ {
+		fmt.Fprintf(&b, "%d %s\n", i, long)
+	}
+	require.NoError(t, os.WriteFile(path, []byte(b.String()), 0o644))
+
+	// Only the last 2*512 bytes are read, and the line cut in half is dropped
+	tailer := startTailer(t, path, 2)
+	lines := tailer.Lines()
+	require.Len(t, lines, 1)
+	require.True(t, strings.HasPrefix(lines[0], "10 x"))
+}
+
+func TestTailer_WaitsForMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "app.log")
+
+	// The directory doesn't exist either, so only the poll can see the file
+	tailer := startTailer(t, path, 10)
+	require.False(t, tailer.Exists())
+	require.Empty(t, tailer.Lines())
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	appendFile(t, path, "started\n")
+	requireLines(t, tailer, "started")
+	require.True(t, tailer.Exists())
+}
+
+func TestTailer_FollowsRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	require.NoError(t, os.WriteFile(path, []byte("old 1\n"), 0o644))
+	tailer := startTailer(t, path, 10)
+
+	// Lines written just before the rename are still read from the old file
+	appendFile(t, path, "old 2\n")
+	require.NoError(t, os.Rename(path, path+".1"))
+	appendFile(t, path, "new 1\n")
+	requireLines(t, tailer, "old 1", "old 2", "new 1")
+
+	appendFile(t, path, "new 2\n")
+	requireLines(t, tailer, "old 1", "old 2", "new 1", "new 2")
+}
+
+func TestTailer_FollowsTruncation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	require.NoError(t, os.WriteFile(path, []byte("before 1\nbefore 2\n"), 0o644))
+	tailer := startTailer(t, path, 10)
+	require.Equal(t, []string{"before 1", "before 2"}, tailer.Lines())
+
+	require.NoError(t, os.WriteFile(path, []byte("after\n"), 0o644))
+	requireLines(t, tailer, "before 1", "before 2", "after")
+}
+
+func TestTailer_StopClosesUpdates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	tailer := tail.New(tail.DefaultConfig(path))
+	tailer.Start()
+	tailer.Stop()
+
+	_, ok := <-tailer.Updates()
+	require.False(t, ok)
+}
@@ -11,14 +11,14 @@ import (
 
 // LLMEstimator uses Claude Code via estimate tasks to estimate bead complexity.
 type LLMEstimator struct {
-	database    *db.DB
+	database    db.Store
 	workDir     string
 	projectName string
 	workID      string // Work context for estimation tasks
 }
 
 // NewLLMEstimator creates a new LLM-based complexity estimator.
-func NewLLMEstimator(database *db.DB, workDir, projectName, workID string) *LLMEstimator {
+func NewLLMEstimator(database db.Store, workDir, projectName, workID string) *LLMEstimator {
 	return &LLMEstimator{
 		database:    database,
 		workDir:     workDir,
@@ -32,8 +32,7 @@ func NewLLMEstimator(database *db.DB, workDir, projectName, workID string) *LLME
 // Returns (0, 0, nil) if the bead needs estimation but an estimation task was spawned.
 func (e *LLMEstimator) Estimate(ctx context.Context, bead beads.Bead) (score int, tokens int, err error) {
 	// Calculate description hash for caching
-	fullDescription := bead.Title + "\n" + bead.Description
-	descHash := db.HashDescription(fullDescription)
+	descHash := db.HashBead(bead.Title, bead.Description)
 
 	// Check cache first
 	if e.database != nil {
@@ -95,8 +94,7 @@ func (e *LLMEstimator) EstimateBatch(ctx context.Context, beadList []beads.Bead,
 	} else {
 		// Normal flow: filter out cached beads
 		for _, bead := range beadList {
-			fullDescription := bead.Title + "\n" + bead.Description
-			descHash := db.HashDescription(fullDescription)
+			descHash := db.HashBead(bead.Title, bead.Description)
 			_, _, found, _ := e.database.GetCachedComplexity(ctx, bead.ID, descHash)
 			if !found {
 				uncachedBeads = append(uncachedBeads, bead)
@@ -116,3 +114,24 @@ func (e *LLMEstimator) EstimateBatch(ctx context.Context, beadList []beads.Bead,
 	return nil, fmt.Errorf("missing complexity estimates for %d bead(s): %s. Use 'co run --auto' to run estimation through the orchestrator",
 		len(result.UncachedIDs), strings.Join(result.UncachedIDs, ", "))
 }
+
+// BeadEstimator estimates one bead's complexity score (1-10) and context
+// tokens from its content.
+type BeadEstimator func(ctx context.Context, bead beads.Bead) (score, tokens int, err error)
+
+// EstimateAndCache estimates a bead with estimate and caches the result
+// under the bead's current hash, where estimation tasks cache theirs, so the
+// orchestrator finds it when the bead is planned into a work.
+func EstimateAndCache(ctx context.Context, database db.Store, bead beads.Bead, estimate BeadEstimator) (score, tokens int, err error) {
+	score, tokens, err = estimate(ctx, bead)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to estimate %s: %w", bead.ID, err)
+	}
+	if err := db.ValidateComplexity(score, tokens); err != nil {
+		return 0, 0, fmt.Errorf("invalid estimate for %s: %w", bead.ID, err)
+	}
+	if err := database.CacheComplexity(ctx, bead.ID, db.HashBead(bead.Title, bead.Description), score, tokens); err != nil {
+		return 0, 0, err
+	}
+	return score, tokens, nil
+}
@@ -2,8 +2,10 @@ package task
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/newhook/co/internal/beads"
 	"github.com/newhook/co/internal/db"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -101,3 +103,36 @@ func TestNewLLMEstimator(t *testing.T) {
 // Note: Testing the actual Estimate and EstimateBatch functions would require
 // a running Claude Code instance, which is beyond the scope of unit tests.
 // The caching behavior is tested via the database methods above.
+
+func TestEstimateAndCache(t *testing.T) {
+	database, cleanup := setupTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+	bead := beads.Bead{ID: "bead-1", Title: "Fix login", Description: "The form loses input"}
+
+	score, tokens, err := EstimateAndCache(ctx, database, bead, func(ctx context.Context, b beads.Bead) (int, int, error) {
+		return 4, 20000, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 4, score)
+	assert.Equal(t, 20000, tokens)
+
+	// Cached under the hash the orchestrator's estimation looks up
+	score, _, found, err := database.GetCachedComplexity(ctx, "bead-1", db.HashBead(bead.Title, bead.Description))
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, 4, score)
+
+	// Failed and out of range estimates aren't cached
+	_, _, err = EstimateAndCache(ctx, database, beads.Bead{ID: "bead-2"}, func(ctx context.Context, b beads.Bead) (int, int, error) {
+		return 0, 0, errors.New("claude not found")
+	})
+	require.ErrorContains(t, err, "claude not found")
+	_, _, err = EstimateAndCache(ctx, database, beads.Bead{ID: "bead-3"}, func(ctx context.Context, b beads.Bead) (int, int, error) {
+		return 11, 20000, nil
+	})
+	require.Error(t, err)
+	estimates, err := database.ListComplexityEstimates(ctx)
+	require.NoError(t, err)
+	assert.Len(t, estimates, 1)
+}
@@ -82,6 +82,7 @@ func binPackBeads(sortedBeads []beads.Bead, estimates map[string]beadEstimate, g
 				Beads:           []beads.Bead{},
 				Complexity:      0,
 				EstimatedTokens: 0,
+				BeadComplexity:  map[string]int{},
 				Status:          StatusPending,
 			})
 		}
@@ -90,6 +91,7 @@ func binPackBeads(sortedBeads []beads.Bead, estimates map[string]beadEstimate, g
 		tasks[taskIdx].BeadIDs = append(tasks[taskIdx].BeadIDs, bead.ID)
 		tasks[taskIdx].Beads = append(tasks[taskIdx].Beads, bead)
 		tasks[taskIdx].Complexity += est.score
+		tasks[taskIdx].BeadComplexity[bead.ID] = est.score
 		tasks[taskIdx].EstimatedTokens += est.tokens
 		assigned[bead.ID] = taskIdx
 	}
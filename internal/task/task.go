@@ -18,12 +18,13 @@ const (
 
 // Task represents a virtual task - a group of beads to be processed together.
 type Task struct {
-	ID              string       // Unique task identifier
-	BeadIDs         []string     // IDs of beads in this task
-	Beads           []beads.Bead // Full bead information
-	Complexity      int          // Sum of bead complexity scores
-	EstimatedTokens int          // Sum of estimated tokens for all beads
-	Status          string       // pending, processing, completed, failed
+	ID              string         // Unique task identifier
+	BeadIDs         []string       // IDs of beads in this task
+	Beads           []beads.Bead   // Full bead information
+	Complexity      int            // Sum of bead complexity scores
+	EstimatedTokens int            // Sum of estimated tokens for all beads
+	BeadComplexity  map[string]int // Complexity score of each bead, by bead ID
+	Status          string         // pending, processing, completed, failed
 }
 
 // Planner creates task groupings from a list of beads.
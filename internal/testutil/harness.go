@@ -10,8 +10,10 @@ import (
 	"github.com/newhook/co/internal/beads"
 	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/git"
+	"github.com/newhook/co/internal/github"
 	"github.com/newhook/co/internal/names"
 	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/remote"
 	"github.com/newhook/co/internal/task"
 	"github.com/newhook/co/internal/work"
 	"github.com/newhook/co/internal/worktree"
@@ -24,11 +26,13 @@ type TestHarness struct {
 	T                   *testing.T
 	DB                  *db.DB
 	Git                 *git.GitOperationsMock
+	GitHub              *github.GitHubClientMock
 	Worktree            *worktree.WorktreeOperationsMock
 	Beads               *beads.BeadsCLIMock
 	BeadsReader         *beads.BeadsReaderMock
 	OrchestratorManager *work.OrchestratorManagerMock
 	NameGenerator       *names.GeneratorMock
+	Remote              *remote.RemoteOperationsMock
 	TaskPlanner         *task.PlannerMock
 	WorkService         *work.WorkService
 	Config              *project.Config
@@ -51,12 +55,14 @@ func NewTestHarness(t *testing.T) *TestHarness {
 
 	// Create mocks with default no-op/success behavior
 	gitMock := &git.GitOperationsMock{}
+	githubMock := &github.GitHubClientMock{}
 	worktreeMock := &worktree.WorktreeOperationsMock{}
 	beadsMock := &beads.BeadsCLIMock{}
 	beadsReaderMock := &beads.BeadsReaderMock{}
 	orchestratorMock := &work.OrchestratorManagerMock{}
 	nameGenMock := &names.GeneratorMock{}
 	taskPlannerMock := &task.PlannerMock{}
+	remoteMock := &remote.RemoteOperationsMock{}
 
 	// Create test config
 	config := &project.Config{
@@ -78,11 +84,13 @@ func NewTestHarness(t *testing.T) *TestHarness {
 		T:                   t,
 		DB:                  testDB,
 		Git:                 gitMock,
+		GitHub:              githubMock,
 		Worktree:            worktreeMock,
 		Beads:               beadsMock,
 		BeadsReader:         beadsReaderMock,
 		OrchestratorManager: orchestratorMock,
 		NameGenerator:       nameGenMock,
+		Remote:              remoteMock,
 		TaskPlanner:         taskPlannerMock,
 		Config:              config,
 		beadStore:           make(map[string]*beads.Bead),
@@ -94,11 +102,13 @@ func NewTestHarness(t *testing.T) *TestHarness {
 		DB:                  testDB,
 		Git:                 gitMock,
 		Worktree:            worktreeMock,
+		GitHubClient:        githubMock,
 		BeadsReader:         beadsReaderMock,
 		BeadsCLI:            beadsMock,
 		OrchestratorManager: orchestratorMock,
 		TaskPlanner:         taskPlannerMock,
 		NameGenerator:       nameGenMock,
+		Remote:              remoteMock,
 		Config:              config,
 		ProjectRoot:         "/test/project",
 		MainRepoPath:        "/test/project/main",
@@ -133,6 +143,9 @@ func (h *TestHarness) configureDefaultMocks() {
 	h.Git.PushSetUpstreamFunc = func(ctx context.Context, branch string, dir string) error {
 		return nil
 	}
+	h.Git.CheckRemoteBranchFunc = func(ctx context.Context, repoPath string, branch string, baseBranch string) (*git.RemoteBranchStatus, error) {
+		return &git.RemoteBranchStatus{}, nil // pushed branch still open on the remote
+	}
 
 	// Worktree defaults: worktree doesn't exist, creation succeeds
 	h.Worktree.ExistsPathFunc = func(worktreePath string) bool {
@@ -392,7 +405,7 @@ func (h *TestHarness) CreateTask(taskID, workID string, beadIDs []string) *db.Ta
 	h.T.Helper()
 	ctx := context.Background()
 
-	err := h.DB.CreateTask(ctx, taskID, "implement", beadIDs, 10, workID)
+	err := h.DB.CreateTask(ctx, taskID, db.TaskTypeImplement, beadIDs, 10, workID)
 	require.NoError(h.T, err, "failed to create task")
 
 	task, err := h.DB.GetTask(ctx, taskID)
@@ -497,7 +510,7 @@ func (h *TestHarness) CreateReviewTask(taskID, workID string) *db.Task {
 		_, _ = h.DB.GetNextTaskNumber(ctx, workID)
 	}
 
-	err := h.DB.CreateTask(ctx, taskID, "review", nil, 0, workID)
+	err := h.DB.CreateTask(ctx, taskID, db.TaskTypeReview, nil, 0, workID)
 	require.NoError(h.T, err, "failed to create review task")
 
 	task, err := h.DB.GetTask(ctx, taskID)
@@ -569,7 +582,7 @@ func (h *TestHarness) CountReviewIterations(workID string) int {
 
 	count := 0
 	for _, task := range tasks {
-		if task.TaskType == "review" && task.Status == db.StatusCompleted {
+		if task.TaskType == db.TaskTypeReview && task.Status == db.StatusCompleted {
 			count++
 		}
 	}
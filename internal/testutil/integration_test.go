@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/coerrors"
 	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/github"
 	"github.com/newhook/co/internal/task"
@@ -720,7 +721,7 @@ func TestWorkService_AddBeadsToExistingWork(t *testing.T) {
 	h.CreateBead("bead-3", "Additional bead 3")
 
 	// Add beads to work using WorkService
-	addResult, err := h.WorkService.AddBeads(ctx, workID, []string{"bead-2", "bead-3"})
+	addResult, err := h.WorkService.AddBeads(ctx, workID, []string{"bead-2", "bead-3"}, false)
 	require.NoError(t, err)
 	assert.Equal(t, 2, addResult.BeadsAdded)
 
@@ -738,6 +739,34 @@ func TestWorkService_AddBeadsToExistingWork(t *testing.T) {
 	assert.True(t, beadIDs["bead-3"])
 }
 
+func TestWorkService_AddBeadsInAnotherWork(t *testing.T) {
+	h := NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.CreateWork("w-a", "feat/a")
+	h.CreateWork("w-b", "feat/b")
+	h.AddBeadToWork("w-a", "bead-1")
+
+	// A bead another active work has is refused
+	_, err := h.WorkService.AddBeads(ctx, "w-b", []string{"bead-1", "bead-2"}, false)
+	require.ErrorIs(t, err, coerrors.Conflict)
+	assert.ErrorContains(t, err, "bead-1 (w-a)")
+	workBeads, err := h.DB.GetWorkBeads(ctx, "w-b")
+	require.NoError(t, err)
+	assert.Empty(t, workBeads, "nothing is added")
+
+	// With force it's skipped and the rest are added
+	addResult, err := h.WorkService.AddBeads(ctx, "w-b", []string{"bead-1", "bead-2"}, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, addResult.BeadsAdded)
+	assert.Equal(t, map[string]string{"bead-1": "w-a"}, addResult.Skipped)
+	workBeads, err = h.DB.GetWorkBeads(ctx, "w-b")
+	require.NoError(t, err)
+	require.Len(t, workBeads, 1)
+	assert.Equal(t, "bead-2", workBeads[0].BeadID)
+}
+
 func TestWorkService_RemoveBeadsFromWork(t *testing.T) {
 	h := NewTestHarness(t)
 	defer h.Cleanup()
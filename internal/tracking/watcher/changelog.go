@@ -0,0 +1,116 @@
+package watcher
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	_ "github.com/ncruces/go-sqlite3/driver"
+	_ "github.com/ncruces/go-sqlite3/embed"
+)
+
+// Entity is the kind of row a Change is about.
+type Entity string
+
+const (
+	// EntityWork is a row of the works table.
+	EntityWork Entity = "work"
+	// EntityTask is a task, its dependencies or its metadata.
+	EntityTask Entity = "task"
+	// EntityBeadAssignment is a bead assigned to a work or a task.
+	EntityBeadAssignment Entity = "bead_assignment"
+)
+
+// Change is a row changed in the tracking database, as recorded in its
+// change_log table.
+type Change struct {
+	Entity Entity
+	ID     string // Work, task or bead ID
+	WorkID string // Work the row belongs to, empty if it couldn't be told
+}
+
+// changeLog reads the entries the tracking database's triggers add to
+// change_log. It keeps one read-only connection open, since SQLite's
+// data_version only reports commits made by other connections.
+type changeLog struct {
+	db          *sql.DB
+	primed      bool  // dataVersion and lastID have been read
+	dataVersion int64 // data_version at the last read
+	lastID      int64 // Highest change_log ID seen
+}
+
+// openChangeLog opens a read-only connection to the database at dbPath
+func openChangeLog(dbPath string) (*changeLog, error) {
+	db, err := sql.Open("sqlite3", "file:"+dbPath+"?mode=ro&_pragma=busy_timeout(1000)")
+	if err != nil {
+		return nil, fmt.Errorf("opening change log: %w", err)
+	}
+	db.SetMaxOpenConns(1)
+	c := &changeLog{db: db}
+	// A database without the change log yet is primed on the first read
+	_, _, _ = c.read(context.Background())
+	return c, nil
+}
+
+// close closes the connection.
+func (c *changeLog) close() error {
+	return c.db.Close()
+}
+
+// read returns the changes committed since the previous read. changed is
+// false when nothing has been committed since. The returned changes are nil
+// when they can't be told, e.g. before the first read or when entries were
+// trimmed before they were seen; the caller then has to assume anything
+// changed.
+func (c *changeLog) read(ctx context.Context) (changes []Change, changed bool, err error) {
+	var version int64
+	if err := c.db.QueryRowContext(ctx, "PRAGMA data_version").Scan(&version); err != nil {
+		c.primed = false
+		return nil, true, fmt.Errorf("reading data_version: %w", err)
+	}
+	if c.primed && version == c.dataVersion {
+		return nil, false, nil
+	}
+
+	var minID, maxID sql.NullInt64
+	if err := c.db.QueryRowContext(ctx, "SELECT MIN(id), MAX(id) FROM change_log").Scan(&minID, &maxID); err != nil {
+		c.primed = false
+		return nil, true, fmt.Errorf("reading change log bounds: %w", err)
+	}
+	wasPrimed, lastID := c.primed, c.lastID
+	c.primed, c.dataVersion, c.lastID = true, version, maxID.Int64
+	switch {
+	case !wasPrimed:
+		return nil, true, nil
+	case maxID.Int64 < lastID:
+		// The log was recreated, e.g. the database was replaced
+		return nil, true, nil
+	case maxID.Int64 > lastID && minID.Int64 > lastID+1:
+		// Entries were trimmed before they were seen
+		return nil, true, nil
+	}
+
+	rows, err := c.db.QueryContext(ctx,
+		"SELECT entity, entity_id, work_id FROM change_log WHERE id > ? AND id <= ? ORDER BY id", lastID, maxID.Int64)
+	if err != nil {
+		return nil, true, fmt.Errorf("reading change log: %w", err)
+	}
+	defer rows.Close()
+
+	changes = []Change{}
+	seen := make(map[Change]bool)
+	for rows.Next() {
+		var change Change
+		if err := rows.Scan(&change.Entity, &change.ID, &change.WorkID); err != nil {
+			return nil, true, fmt.Errorf("reading change log: %w", err)
+		}
+		if !seen[change] {
+			seen[change] = true
+			changes = append(changes, change)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, true, fmt.Errorf("reading change log: %w", err)
+	}
+	return changes, true, nil
+}
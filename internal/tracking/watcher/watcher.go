@@ -2,6 +2,7 @@
 package watcher
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"time"
@@ -25,6 +26,30 @@ const (
 type WatcherEvent struct {
 	Type  WatcherEventType
 	Error error // Non-nil for WatcherError events
+	// Changes lists the rows changed since the previous DBChanged event. It is
+	// nil when they aren't known, e.g. for a database without the change log,
+	// and empty when the commits only touched tables the log doesn't cover.
+	Changes []Change
+}
+
+// WorkIDs returns the works the event's changes belong to, in order. ok is
+// false when the changes aren't known or one of them isn't tied to a work, so
+// everything has to be reloaded.
+func (e WatcherEvent) WorkIDs() (ids []string, ok bool) {
+	if e.Changes == nil {
+		return nil, false
+	}
+	seen := make(map[string]bool)
+	for _, c := range e.Changes {
+		if c.WorkID == "" {
+			return nil, false
+		}
+		if !seen[c.WorkID] {
+			seen[c.WorkID] = true
+			ids = append(ids, c.WorkID)
+		}
+	}
+	return ids, true
 }
 
 // Watcher monitors the tracking database for changes and publishes events via broker.
@@ -34,6 +59,7 @@ type Watcher struct {
 	debounce  time.Duration
 	done      chan struct{}
 	broker    *pubsub.Broker[WatcherEvent]
+	changes   *changeLog // Nil if the change log couldn't be opened
 }
 
 // Config holds watcher configuration options.
@@ -75,6 +101,11 @@ func (w *Watcher) Start() error {
 		return fmt.Errorf("watching directory %s: %w", dir, err)
 	}
 
+	// Without the change log, events just don't say what changed
+	if changes, err := openChangeLog(w.dbPath); err == nil {
+		w.changes = changes
+	}
+
 	go w.loop()
 
 	return nil
@@ -87,7 +118,11 @@ func (w *Watcher) Start() error {
 func (w *Watcher) Stop() error {
 	close(w.done)
 	w.broker.Close() // Close broker first to notify subscribers
-	return w.fsWatcher.Close()
+	err := w.fsWatcher.Close()
+	if w.changes != nil {
+		_ = w.changes.close()
+	}
+	return err
 }
 
 // Broker returns the pub/sub broker for subscribing to watcher events.
@@ -138,11 +173,14 @@ func (w *Watcher) loop() {
 			return nil
 		}():
 			if pending {
-				// Publish DBChanged event to broker (non-blocking by design)
-				w.broker.Publish(pubsub.UpdatedEvent, WatcherEvent{
-					Type: DBChanged,
-				})
 				pending = false
+				evt, changed := w.changedEvent()
+				if !changed {
+					// Nothing was committed, e.g. a WAL checkpoint
+					continue
+				}
+				// Publish DBChanged event to broker (non-blocking by design)
+				w.broker.Publish(pubsub.UpdatedEvent, evt)
 			}
 
 		case err, ok := <-w.fsWatcher.Errors:
@@ -164,6 +202,22 @@ func (w *Watcher) loop() {
 	}
 }
 
+// changedEvent builds the DBChanged event for the writes seen, with the
+// changed rows when the change log can tell them. changed is false when the
+// change log shows nothing was committed.
+func (w *Watcher) changedEvent() (WatcherEvent, bool) {
+	evt := WatcherEvent{Type: DBChanged}
+	if w.changes == nil {
+		return evt, true
+	}
+	changes, changed, err := w.changes.read(context.Background())
+	if err != nil {
+		return evt, true
+	}
+	evt.Changes = changes
+	return evt, changed
+}
+
 // isRelevantEvent checks if the event should trigger a refresh.
 func (w *Watcher) isRelevantEvent(event fsnotify.Event) bool {
 	// Only care about write or create operations (WAL file may be created fresh)
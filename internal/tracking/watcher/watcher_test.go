@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/newhook/co/internal/beads/pubsub"
+	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/tracking/watcher"
 )
 
@@ -166,6 +167,7 @@ func TestWatcher_WatchesWALFile(t *testing.T) {
 	select {
 	case evt := <-sub:
 		require.Equal(t, watcher.DBChanged, evt.Payload.Type, "expected DBChanged event for WAL write")
+		require.Nil(t, evt.Payload.Changes, "a file that isn't a database has no change log")
 	case <-time.After(200 * time.Millisecond):
 		require.Fail(t, "expected notification for WAL file write")
 	}
@@ -263,3 +265,73 @@ func TestWatcher_MultipleWrites(t *testing.T) {
 		require.Fail(t, "expected second notification but got timeout")
 	}
 }
+
+// nextChanges waits for the next DBChanged event and returns its changes
+func nextChanges(t *testing.T, sub <-chan pubsub.Event[watcher.WatcherEvent]) []watcher.Change {
+	t.Helper()
+	select {
+	case evt := <-sub:
+		require.Equal(t, watcher.DBChanged, evt.Payload.Type)
+		require.NotNil(t, evt.Payload.Changes, "expected the event to list the changed rows")
+		return evt.Payload.Changes
+	case <-time.After(2 * time.Second):
+		require.Fail(t, "expected a DBChanged event")
+		return nil
+	}
+}
+
+func TestWatcher_ReportsChangedRows(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dbPath := filepath.Join(t.TempDir(), "tracking.db")
+	database, err := db.OpenPath(ctx, dbPath)
+	require.NoError(t, err)
+	defer database.Close()
+	require.NoError(t, database.CreateWork(ctx, "w-a", "", "", "feat/a", "main", "", false))
+	require.NoError(t, database.CreateWork(ctx, "w-b", "", "", "feat/b", "main", "", false))
+
+	w, err := watcher.New(watcher.Config{
+		DBPath:      dbPath,
+		DebounceDur: 50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer func() { _ = w.Stop() }()
+	sub := w.Broker().Subscribe(ctx)
+	require.NoError(t, w.Start())
+
+	// Creating a task logs the task and its bead assignment
+	require.NoError(t, database.CreateTask(ctx, "w-a.1", "implement", []string{"bead-1"}, 0, "w-a"))
+	changes := nextChanges(t, sub)
+	require.Contains(t, changes, watcher.Change{Entity: watcher.EntityTask, ID: "w-a.1", WorkID: "w-a"})
+	require.Contains(t, changes, watcher.Change{Entity: watcher.EntityBeadAssignment, ID: "bead-1", WorkID: "w-a"})
+	ids, ok := watcher.WatcherEvent{Changes: changes}.WorkIDs()
+	require.True(t, ok)
+	require.Equal(t, []string{"w-a"}, ids, "works created before the watcher started aren't reported")
+
+	// A later event only carries what changed since the previous one
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, database.SetWorkNotes(ctx, "w-b", "waiting on review"))
+	changes = nextChanges(t, sub)
+	require.Equal(t, []watcher.Change{{Entity: watcher.EntityWork, ID: "w-b", WorkID: "w-b"}}, changes)
+}
+
+func TestWatcherEvent_WorkIDs(t *testing.T) {
+	_, ok := watcher.WatcherEvent{Type: watcher.DBChanged}.WorkIDs()
+	require.False(t, ok, "unknown changes need a full reload")
+
+	ids, ok := watcher.WatcherEvent{Changes: []watcher.Change{}}.WorkIDs()
+	require.True(t, ok)
+	require.Empty(t, ids)
+
+	ids, ok = watcher.WatcherEvent{Changes: []watcher.Change{
+		{Entity: watcher.EntityTask, ID: "w-b.1", WorkID: "w-b"},
+		{Entity: watcher.EntityWork, ID: "w-a", WorkID: "w-a"},
+		{Entity: watcher.EntityBeadAssignment, ID: "bead-1", WorkID: "w-b"},
+	}}.WorkIDs()
+	require.True(t, ok)
+	require.Equal(t, []string{"w-b", "w-a"}, ids)
+
+	_, ok = watcher.WatcherEvent{Changes: []watcher.Change{{Entity: watcher.EntityTask, ID: "t-1"}}}.WorkIDs()
+	require.False(t, ok, "a change without a work needs a full reload")
+}
@@ -0,0 +1,305 @@
+package tui
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/worktree"
+)
+
+// artifactMaxBytes caps how much of an artifact the viewer loads
+const artifactMaxBytes = 256 * 1024
+
+// artifactView is the overlay listing a task's artifacts. Enter shows the
+// selected one in a scrollable viewer, rendered when it is markdown.
+type artifactView struct {
+	theme     *Theme
+	taskID    string
+	artifacts []project.TaskArtifact
+	selected  int
+
+	// Viewer; file is nil while the list is shown
+	file        *project.TaskArtifact
+	text        string // Loaded content of file
+	note        string // Why text is incomplete or missing (binary, truncated)
+	renderWidth int    // Width text was last rendered at
+	err         error
+	viewport    viewport.Model
+}
+
+// artifactEditorClosedMsg is sent when the editor opened on an artifact exits
+type artifactEditorClosedMsg struct {
+	err error
+}
+
+// newArtifactView creates an artifact browser for a task
+func newArtifactView(theme *Theme, taskID string, artifacts []project.TaskArtifact) *artifactView {
+	vp := viewport.New(80, 20)
+	vp.MouseWheelEnabled = false
+	return &artifactView{
+		theme:     theme,
+		taskID:    taskID,
+		artifacts: artifacts,
+		viewport:  vp,
+	}
+}
+
+// selectedArtifact returns the highlighted artifact, or nil if there are none
+func (v *artifactView) selectedArtifact() *project.TaskArtifact {
+	if v.selected < 0 || v.selected >= len(v.artifacts) {
+		return nil
+	}
+	return &v.artifacts[v.selected]
+}
+
+// load reads an artifact into the viewer
+func (v *artifactView) load(a *project.TaskArtifact) {
+	v.file = a
+	v.text, v.note, v.err = "", "", nil
+	v.renderWidth = 0
+
+	f, err := os.Open(a.Path)
+	if err != nil {
+		v.err = err
+		return
+	}
+	defer f.Close()
+	data, err := io.ReadAll(io.LimitReader(f, artifactMaxBytes+1))
+	if err != nil {
+		v.err = err
+		return
+	}
+	if bytes.IndexByte(data[:min(len(data), 8000)], 0) >= 0 {
+		v.note = fmt.Sprintf("Binary file (%s); press E to open it in your editor", worktree.FormatBytes(a.Size))
+		return
+	}
+	if len(data) > artifactMaxBytes {
+		data = data[:artifactMaxBytes]
+		v.note = fmt.Sprintf("Showing the first %d KB of %s", artifactMaxBytes/1024, worktree.FormatBytes(a.Size))
+	}
+	v.text = string(data)
+	v.viewport.GotoTop()
+}
+
+// reload re-reads the open artifact, after it was edited
+func (v *artifactView) reload() {
+	if v.file != nil {
+		offset := v.viewport.YOffset
+		v.load(v.file)
+		v.viewport.SetYOffset(offset)
+	}
+}
+
+// Update handles a key press. It returns a command to run and whether the
+// browser should be closed.
+func (v *artifactView) Update(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "esc", "q":
+		if v.file != nil {
+			v.file = nil
+			v.err = nil
+			return nil, false
+		}
+		return nil, true
+	case "E":
+		a := v.file
+		if a == nil {
+			a = v.selectedArtifact()
+		}
+		if a == nil {
+			return nil, false
+		}
+		return tea.ExecProcess(editorCommand(a.Path), func(err error) tea.Msg {
+			return artifactEditorClosedMsg{err: err}
+		}), false
+	}
+
+	if v.file != nil {
+		var cmd tea.Cmd
+		v.viewport, cmd = v.viewport.Update(msg)
+		return cmd, false
+	}
+
+	switch msg.String() {
+	case "j", "down":
+		v.selected = min(v.selected+1, len(v.artifacts)-1)
+	case "k", "up":
+		v.selected = max(v.selected-1, 0)
+	case "enter":
+		if a := v.selectedArtifact(); a != nil {
+			v.load(a)
+		}
+	}
+	return nil, false
+}
+
+// editorCommand builds the command that opens path in $EDITOR (vi if unset)
+func editorCommand(path string) *exec.Cmd {
+	editor := strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{"vi"}
+	}
+	return exec.Command(editor[0], append(editor[1:], path)...)
+}
+
+// render returns the overlay content sized to fit width x height
+func (v *artifactView) render(width, height int) string {
+	frameW, frameH := v.theme.Dialog.GetFrameSize()
+	innerWidth := max(width-frameW, 20)
+	innerHeight := max(height-frameH, 5)
+
+	var lines []string
+	header := fmt.Sprintf("Artifacts of %s", v.taskID)
+	if v.file != nil {
+		header = fmt.Sprintf("%s — %s", v.file.Name, v.taskID)
+	}
+	lines = append(lines, v.theme.Title.Render(header), "")
+
+	// Rows left for the body after the header and the footer
+	bodyHeight := max(innerHeight-4, 1)
+
+	switch {
+	case v.err != nil:
+		lines = append(lines, v.theme.Error.Render(fmt.Sprintf("Error: %v", v.err)))
+	case v.file != nil:
+		if v.note != "" {
+			lines = append(lines, v.theme.Dim.Render(v.note))
+			bodyHeight--
+		}
+		if v.renderWidth != innerWidth {
+			content := v.text
+			if strings.EqualFold(filepath.Ext(v.file.Name), ".md") {
+				content = renderMarkdown(v.theme, content, innerWidth)
+			} else {
+				content = wrapText(content, innerWidth)
+			}
+			v.viewport.SetContent(content)
+			v.renderWidth = innerWidth
+		}
+		v.viewport.Width = innerWidth
+		v.viewport.Height = max(bodyHeight, 1)
+		lines = append(lines, strings.Split(v.viewport.View(), "\n")...)
+	case len(v.artifacts) == 0:
+		lines = append(lines, "This task hasn't written any artifacts.")
+	default:
+		lines = append(lines, v.renderList(innerWidth, bodyHeight)...)
+	}
+
+	lines = append(lines, "")
+	if v.file != nil {
+		lines = append(lines, v.theme.styleHotkeys("[j/k] Scroll  [E] Edit  [Esc] Back to artifacts"))
+	} else {
+		lines = append(lines, v.theme.styleHotkeys("[j/k] Navigate  [Enter] View  [E] Edit  [Esc] Close"))
+	}
+
+	for i, line := range lines {
+		lines[i] = ansi.Truncate(line, innerWidth, "…")
+	}
+	return v.theme.Dialog.Width(innerWidth + v.theme.Dialog.GetHorizontalPadding()).Render(strings.Join(lines, "\n"))
+}
+
+// renderList returns the artifact rows, scrolled so the selection stays visible
+func (v *artifactView) renderList(width, height int) []string {
+	start := 0
+	if v.selected >= height {
+		start = v.selected - height + 1
+	}
+	end := min(start+height, len(v.artifacts))
+
+	nameWidth := 0
+	for _, a := range v.artifacts[start:end] {
+		nameWidth = max(nameWidth, ansi.StringWidth(a.Name))
+	}
+	nameWidth = min(nameWidth, max(width-26, 10))
+
+	rows := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		a := v.artifacts[i]
+		name := ansi.Truncate(a.Name, nameWidth, "…")
+		name += strings.Repeat(" ", nameWidth-ansi.StringWidth(name))
+		info := v.theme.Dim.Render(fmt.Sprintf("%9s  %s", worktree.FormatBytes(a.Size), a.ModTime.Format("Jan 2 15:04")))
+		row := fmt.Sprintf("  %s  %s", name, info)
+		if i == v.selected {
+			row = v.theme.Selected.Render(fmt.Sprintf("▸ %s  ", name)) + info
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+var (
+	markdownBold = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+	markdownCode = regexp.MustCompile("`([^`]+)`")
+)
+
+// renderMarkdown styles the markdown agents write (headings, lists, quotes,
+// code, bold) for the terminal, wrapped to width
+func renderMarkdown(theme *Theme, text string, width int) string {
+	heading := lipgloss.NewStyle().Bold(true).Foreground(theme.AccentColor)
+	bold := lipgloss.NewStyle().Bold(true)
+	inline := func(s string) string {
+		s = markdownBold.ReplaceAllStringFunc(s, func(m string) string {
+			return bold.Render(markdownBold.FindStringSubmatch(m)[1])
+		})
+		return markdownCode.ReplaceAllStringFunc(s, func(m string) string {
+			return theme.Value.Render(markdownCode.FindStringSubmatch(m)[1])
+		})
+	}
+	// indented wraps s to the width left after prefix and indents the
+	// continuation lines to line up with the first
+	indented := func(prefix, s string) string {
+		wrapped := strings.Split(wrapText(s, max(width-ansi.StringWidth(prefix), 10)), "\n")
+		pad := strings.Repeat(" ", ansi.StringWidth(prefix))
+		for i := range wrapped {
+			if i == 0 {
+				wrapped[i] = prefix + inline(wrapped[i])
+			} else {
+				wrapped[i] = pad + inline(wrapped[i])
+			}
+		}
+		return strings.Join(wrapped, "\n")
+	}
+
+	var out []string
+	inCode := false
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			out = append(out, theme.Dim.Render("  "+line))
+			continue
+		}
+
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		switch {
+		case trimmed == "":
+			out = append(out, "")
+		case strings.HasPrefix(trimmed, "#"):
+			title := strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
+			out = append(out, heading.Render(wrapText(title, width)))
+		case trimmed == "---" || trimmed == "***" || trimmed == "___":
+			out = append(out, theme.Dim.Render(strings.Repeat("─", width)))
+		case strings.HasPrefix(trimmed, "- "), strings.HasPrefix(trimmed, "* "), strings.HasPrefix(trimmed, "+ "):
+			out = append(out, indented(indent+"• ", trimmed[2:]))
+		case strings.HasPrefix(trimmed, ">"):
+			out = append(out, theme.Dim.Render(indented("│ ", strings.TrimSpace(strings.TrimPrefix(trimmed, ">")))))
+		default:
+			out = append(out, indented(indent, trimmed))
+		}
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/beads"
+)
+
+// checklistImportDialog is the dialog a markdown checklist is pasted into to
+// create an issue for each of its items, like co bead import
+type checklistImportDialog struct {
+	theme        *Theme
+	textarea     textarea.Model
+	sectionEpics bool // Headings become epics over their items rather than labels
+}
+
+// newChecklistImportDialog creates an empty checklist import dialog
+func newChecklistImportDialog(theme *Theme) *checklistImportDialog {
+	ta := textarea.New()
+	ta.Placeholder = "## Backend\n- [ ] Add the endpoint\n  - validate input"
+	ta.CharLimit = 0
+	ta.ShowLineNumbers = false
+	ta.Focus()
+	return &checklistImportDialog{theme: theme, textarea: ta}
+}
+
+// Update handles a key press. It returns a command to run, whether the
+// dialog should be closed, and whether its issues should be created.
+func (d *checklistImportDialog) Update(msg tea.KeyMsg) (tea.Cmd, bool, bool) {
+	switch msg.String() {
+	case "esc":
+		return nil, true, false
+	case "ctrl+s":
+		if len(d.pending()) == 0 {
+			return nil, false, false
+		}
+		return nil, true, true
+	case "ctrl+t":
+		d.sectionEpics = !d.sectionEpics
+		return nil, false, false
+	}
+	var cmd tea.Cmd
+	d.textarea, cmd = d.textarea.Update(msg)
+	return cmd, false, false
+}
+
+// Items returns the checklist's items
+func (d *checklistImportDialog) Items() []beads.ChecklistItem {
+	return beads.ParseChecklist(d.textarea.Value())
+}
+
+// pending returns the items issues will be created for; checked ones are
+// skipped
+func (d *checklistImportDialog) pending() []beads.ChecklistItem {
+	var pending []beads.ChecklistItem
+	for _, item := range d.Items() {
+		if !item.Done {
+			pending = append(pending, item)
+		}
+	}
+	return pending
+}
+
+// render returns the dialog content sized to fit width x height
+func (d *checklistImportDialog) render(width, height int) string {
+	frameW, frameH := d.theme.Dialog.GetFrameSize()
+	innerWidth := min(max(width-frameW, 20), 80)
+	innerHeight := max(height-frameH, 8)
+
+	d.textarea.SetWidth(innerWidth)
+	d.textarea.SetHeight(min(max(innerHeight-6, 3), 15))
+
+	pending := d.pending()
+	sections := make(map[string]bool)
+	for _, item := range pending {
+		if item.Section != "" {
+			sections[item.Section] = true
+		}
+	}
+	summary := fmt.Sprintf("%d issue(s) to create", len(pending))
+	if len(sections) > 0 {
+		mode := "labels"
+		if d.sectionEpics {
+			mode = "epics"
+		}
+		summary += fmt.Sprintf(", %d heading(s) as %s", len(sections), mode)
+	}
+	if skipped := len(d.Items()) - len(pending); skipped > 0 {
+		summary += fmt.Sprintf(", %d checked item(s) skipped", skipped)
+	}
+
+	toggle := "[Ctrl+T] Headings as epics"
+	if d.sectionEpics {
+		toggle = "[Ctrl+T] Headings as labels"
+	}
+	lines := []string{
+		d.theme.Title.Render("Import issues from a checklist"),
+		d.theme.Dim.Render("Paste a markdown list; each top-level item becomes an issue"),
+		"",
+		d.textarea.View(),
+		"",
+		d.theme.Dim.Render(summary),
+		ansi.Truncate(d.theme.styleHotkeys("[Ctrl+S] Create  "+toggle+"  [Esc] Cancel"), innerWidth, "…"),
+	}
+	return d.theme.Dialog.Width(innerWidth + d.theme.Dialog.GetHorizontalPadding()).Render(strings.Join(lines, "\n"))
+}
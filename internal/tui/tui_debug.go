@@ -0,0 +1,71 @@
+package tui
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/logging"
+	"github.com/newhook/co/internal/project"
+)
+
+// tuiDebugEnv turns on the TUI debug event log when set to anything but
+// empty
+const tuiDebugEnv = "CO_DEBUG_TUI"
+
+// tuiDebugLogFile is the debug event log, under .co/logs
+const tuiDebugLogFile = "tui-debug.log"
+
+// maxTUIDebugLogSize is the size the debug event log is rotated at
+const maxTUIDebugLogSize = 1 << 20
+
+var (
+	debugMu     sync.Mutex
+	debugLogger *slog.Logger
+)
+
+// startDebugEvents opens the debug event log of a project when CO_DEBUG_TUI
+// is set. The returned function closes it again.
+func startDebugEvents(projectRoot string) func() {
+	if os.Getenv(tuiDebugEnv) == "" || projectRoot == "" {
+		return func() {}
+	}
+	f, err := logging.OpenRotating(filepath.Join(projectRoot, project.ConfigDir, project.LogsDir, tuiDebugLogFile), maxTUIDebugLogSize)
+	if err != nil {
+		logging.Warn("failed to open TUI debug log", "error", err)
+		return func() {}
+	}
+	debugMu.Lock()
+	debugLogger = slog.New(slog.NewJSONHandler(f, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	debugMu.Unlock()
+	return func() {
+		debugMu.Lock()
+		defer debugMu.Unlock()
+		debugLogger = nil
+		f.Close()
+	}
+}
+
+// debugEvent records an event in the debug event log, if it's on. Models
+// use it to trace what they do with input; attrs must never carry text the
+// user typed.
+func debugEvent(event string, attrs ...any) {
+	debugMu.Lock()
+	logger := debugLogger
+	debugMu.Unlock()
+	if logger == nil {
+		return
+	}
+	logger.Debug(event, attrs...)
+}
+
+// keyAttrs describes a key press for the debug event log without what was
+// typed: runes are reported by count only.
+func keyAttrs(msg tea.KeyMsg) []any {
+	if msg.Type == tea.KeyRunes {
+		return []any{"key", "runes", "count", len(msg.Runes), "alt", msg.Alt}
+	}
+	return []any{"key", msg.String()}
+}
@@ -0,0 +1,98 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/beads"
+)
+
+// dedupeDialog lists the issues whose titles are alike enough to the
+// selected one to be duplicates, for one of them to be merged with it
+type dedupeDialog struct {
+	theme  *Theme
+	bead   beads.Bead
+	pairs  []beads.DuplicatePair // Oriented to keep bead
+	cursor int
+}
+
+// newDedupeDialog finds the duplicates of bead among the open issues
+func newDedupeDialog(theme *Theme, bead beads.Bead, items []beadItem, threshold float64) *dedupeDialog {
+	var open []beads.Bead
+	for _, item := range items {
+		if item.Status != beads.StatusClosed {
+			open = append(open, *item.Bead)
+		}
+	}
+	return &dedupeDialog{theme: theme, bead: bead, pairs: beads.FindDuplicatesOf(bead, open, threshold)}
+}
+
+// Update handles a key press. It returns whether the dialog should be
+// closed, and the survivor and duplicate of the merge to make, if any.
+func (d *dedupeDialog) Update(msg tea.KeyMsg) (bool, string, string) {
+	switch msg.String() {
+	case "esc", "q":
+		return true, "", ""
+	case "j", "down":
+		if d.cursor < len(d.pairs)-1 {
+			d.cursor++
+		}
+	case "k", "up":
+		if d.cursor > 0 {
+			d.cursor--
+		}
+	case "enter", "y":
+		if len(d.pairs) > 0 {
+			pair := d.pairs[d.cursor]
+			return true, pair.Survivor.ID, pair.Duplicate.ID
+		}
+	case "s":
+		// Keep the candidate and merge the selected issue into it
+		if len(d.pairs) > 0 {
+			pair := d.pairs[d.cursor]
+			return true, pair.Duplicate.ID, pair.Survivor.ID
+		}
+	}
+	return false, "", ""
+}
+
+// render returns the dialog content sized to fit width x height
+func (d *dedupeDialog) render(width, height int) string {
+	frameW, frameH := d.theme.Dialog.GetFrameSize()
+	innerWidth := min(max(width-frameW, 30), 90)
+	innerHeight := max(height-frameH, 8)
+
+	lines := []string{
+		d.theme.Title.Render("Likely duplicates of " + d.bead.ID),
+		d.theme.Dim.Render(ansi.Truncate(d.bead.Title, innerWidth, "…")),
+		"",
+	}
+	if len(d.pairs) == 0 {
+		lines = append(lines, d.theme.Dim.Render("No open issue has a title alike enough"), "",
+			d.theme.styleHotkeys("[Esc] Close"))
+		return d.theme.Dialog.Width(innerWidth + d.theme.Dialog.GetHorizontalPadding()).Render(strings.Join(lines, "\n"))
+	}
+
+	// Leave room for the header and hotkeys
+	shown := max(innerHeight-6, 1)
+	start := max(0, min(d.cursor-shown+1, len(d.pairs)-shown))
+	for i := start; i < min(len(d.pairs), start+shown); i++ {
+		pair := d.pairs[i]
+		line := fmt.Sprintf("%3.0f%%  %-12s %s", pair.Score*100, pair.Duplicate.ID, pair.Duplicate.Title)
+		line = ansi.Truncate(line, innerWidth-2, "…")
+		if i == d.cursor {
+			lines = append(lines, d.theme.Selected.Render("▶ "+line))
+		} else {
+			lines = append(lines, "  "+line)
+		}
+	}
+
+	pair := d.pairs[d.cursor]
+	lines = append(lines, "",
+		d.theme.Dim.Render(fmt.Sprintf("Merging closes %s as a duplicate and moves its description, dependencies and work assignments to %s", pair.Duplicate.ID, pair.Survivor.ID)),
+		ansi.Truncate(d.theme.styleHotkeys("[Enter] Merge into "+pair.Survivor.ID+"  [s] Keep "+pair.Duplicate.ID+" instead  [Esc] Cancel"), innerWidth, "…"),
+	)
+	return d.theme.Dialog.Width(innerWidth + d.theme.Dialog.GetHorizontalPadding()).Render(strings.Join(lines, "\n"))
+}
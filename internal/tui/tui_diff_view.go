@@ -0,0 +1,287 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/git"
+)
+
+// diffMaxBytes caps how much of a single file's diff the diff viewer loads
+const diffMaxBytes = 256 * 1024
+
+// diffView is the overlay listing a work's changes against its base branch.
+// Enter on a file drills into that file's unified diff.
+type diffView struct {
+	theme        *Theme
+	workID       string
+	worktreePath string
+	baseBranch   string
+
+	stat     *git.DiffStat
+	selected int
+	loading  bool
+	err      error
+
+	// File drill-down; file is nil while the stat list is shown
+	file        *git.FileDiff
+	fileLoading bool
+	fileSeq     uint64 // Counts file loads, so only the latest one is shown
+	viewport    viewport.Model
+}
+
+// diffStatLoadedMsg carries the changed files of a work for the diff viewer
+type diffStatLoadedMsg struct {
+	workID string
+	stat   *git.DiffStat
+	err    error
+}
+
+// diffFileLoadedMsg carries one file's unified diff for the diff viewer
+type diffFileLoadedMsg struct {
+	workID string
+	seq    uint64 // The diffView.fileSeq of the load
+	diff   *git.FileDiff
+	err    error
+}
+
+// newDiffView creates a diff viewer for a work's worktree
+func newDiffView(theme *Theme, workID, worktreePath, baseBranch string) *diffView {
+	vp := viewport.New(80, 20)
+	vp.MouseWheelEnabled = false
+	return &diffView{
+		theme:        theme,
+		workID:       workID,
+		worktreePath: worktreePath,
+		baseBranch:   baseBranch,
+		loading:      true,
+		viewport:     vp,
+	}
+}
+
+// loadStat runs the diff stat for the work in the background
+func (v *diffView) loadStat(ctx context.Context, ops git.Operations) tea.Cmd {
+	workID, dir, base := v.workID, v.worktreePath, v.baseBranch
+	return func() tea.Msg {
+		stat, err := ops.DiffStat(ctx, dir, base)
+		return diffStatLoadedMsg{workID: workID, stat: stat, err: err}
+	}
+}
+
+// loadFile runs the unified diff of one file in the background. Loads
+// started before it are superseded.
+func (v *diffView) loadFile(ctx context.Context, ops git.Operations, path string) tea.Cmd {
+	v.fileSeq++
+	workID, dir, base, seq := v.workID, v.worktreePath, v.baseBranch, v.fileSeq
+	return func() tea.Msg {
+		diff, err := ops.DiffFile(ctx, dir, base, path, diffMaxBytes)
+		return diffFileLoadedMsg{workID: workID, seq: seq, diff: diff, err: err}
+	}
+}
+
+// awaits reports whether msg is the file diff the viewer is waiting for:
+// the latest one asked for, while the user hasn't backed out of it
+func (v *diffView) awaits(msg diffFileLoadedMsg) bool {
+	return v.workID == msg.workID && v.fileLoading && v.fileSeq == msg.seq
+}
+
+// selectedFile returns the highlighted file, or nil if there are no changes
+func (v *diffView) selectedFile() *git.DiffFileStat {
+	if v.stat == nil || v.selected < 0 || v.selected >= len(v.stat.Files) {
+		return nil
+	}
+	return &v.stat.Files[v.selected]
+}
+
+// setStat stores a loaded diff stat, keeping the selection on the same file when it still changed
+func (v *diffView) setStat(msg diffStatLoadedMsg) {
+	v.loading = false
+	v.err = msg.err
+	if msg.err != nil {
+		return
+	}
+	prev := v.selectedFile()
+	v.stat = msg.stat
+	v.selected = 0
+	if prev != nil {
+		for i, f := range v.stat.Files {
+			if f.Path == prev.Path {
+				v.selected = i
+				break
+			}
+		}
+	}
+}
+
+// setFile stores a loaded file diff and shows it in the viewport
+func (v *diffView) setFile(msg diffFileLoadedMsg) {
+	v.fileLoading = false
+	v.err = msg.err
+	if msg.err != nil {
+		return
+	}
+	v.file = msg.diff
+	if v.file.TooLarge {
+		v.viewport.SetContent(v.theme.Dim.Render(fmt.Sprintf("File too large to display (diff exceeds %d KB)", diffMaxBytes/1024)))
+	} else {
+		v.viewport.SetContent(v.colorize(v.file.Text))
+	}
+	v.viewport.GotoTop()
+}
+
+// colorize styles added, removed and hunk header lines of a unified diff
+func (v *diffView) colorize(text string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			lines[i] = v.theme.Dim.Render(line)
+		case strings.HasPrefix(line, "+"):
+			lines[i] = v.theme.DiffAdded.Render(line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = v.theme.DiffRemoved.Render(line)
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = v.theme.DiffHunk.Render(line)
+		case strings.HasPrefix(line, "diff "), strings.HasPrefix(line, "index "):
+			lines[i] = v.theme.Dim.Render(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Update handles a key press. It returns a command to run and whether the
+// viewer should be closed.
+func (v *diffView) Update(ctx context.Context, ops git.Operations, msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "esc", "q":
+		if v.file != nil || v.fileLoading {
+			v.file = nil
+			v.fileLoading = false
+			v.err = nil
+			return nil, false
+		}
+		return nil, true
+	case "r":
+		// Pick up new commits on the branch
+		v.loading = true
+		v.err = nil
+		cmds := []tea.Cmd{v.loadStat(ctx, ops)}
+		if v.file != nil {
+			v.fileLoading = true
+			cmds = append(cmds, v.loadFile(ctx, ops, v.file.Path))
+		}
+		return tea.Batch(cmds...), false
+	}
+
+	if v.file != nil {
+		var cmd tea.Cmd
+		v.viewport, cmd = v.viewport.Update(msg)
+		return cmd, false
+	}
+
+	switch msg.String() {
+	case "j", "down":
+		if v.stat != nil {
+			v.selected = min(v.selected+1, len(v.stat.Files)-1)
+		}
+	case "k", "up":
+		v.selected = max(v.selected-1, 0)
+	case "enter":
+		f := v.selectedFile()
+		if f == nil || f.Binary {
+			return nil, false
+		}
+		v.fileLoading = true
+		v.err = nil
+		return v.loadFile(ctx, ops, f.Path), false
+	}
+	return nil, false
+}
+
+// render returns the overlay content sized to fit width x height
+func (v *diffView) render(width, height int) string {
+	frameW, frameH := v.theme.Dialog.GetFrameSize()
+	innerWidth := max(width-frameW, 20)
+	innerHeight := max(height-frameH, 5)
+
+	var lines []string
+	header := fmt.Sprintf("Changes in %s vs %s", v.workID, v.baseBranch)
+	if v.file != nil {
+		header = fmt.Sprintf("%s — %s", v.file.Path, v.workID)
+	}
+	if v.loading || v.fileLoading {
+		header += "  " + v.theme.Dim.Render("loading…")
+	}
+	lines = append(lines, v.theme.Title.Render(header), "")
+
+	// Rows left for the body after the header and the footer
+	bodyHeight := max(innerHeight-4, 1)
+
+	switch {
+	case v.err != nil:
+		lines = append(lines, v.theme.Error.Render(fmt.Sprintf("Error: %v", v.err)))
+	case v.file != nil:
+		v.viewport.Width = innerWidth
+		v.viewport.Height = bodyHeight
+		lines = append(lines, strings.Split(v.viewport.View(), "\n")...)
+	case v.stat == nil:
+		lines = append(lines, "Loading changes...")
+	case len(v.stat.Files) == 0:
+		lines = append(lines, "No changes since the branch left "+v.baseBranch+".")
+	default:
+		lines = append(lines, v.renderStat(innerWidth, bodyHeight-1)...)
+		added, deleted := v.stat.Totals()
+		lines = append(lines, v.theme.Dim.Render(fmt.Sprintf("%d files changed, %d insertions(+), %d deletions(-)", len(v.stat.Files), added, deleted)))
+	}
+
+	lines = append(lines, "")
+	if v.file != nil {
+		lines = append(lines, v.theme.styleHotkeys("[j/k] Scroll  [r] Refresh  [Esc] Back to files"))
+	} else {
+		lines = append(lines, v.theme.styleHotkeys("[j/k] Navigate  [Enter] Open diff  [r] Refresh  [Esc] Close"))
+	}
+
+	for i, line := range lines {
+		lines[i] = ansi.Truncate(line, innerWidth, "…")
+	}
+	return v.theme.Dialog.Width(innerWidth + v.theme.Dialog.GetHorizontalPadding()).Render(strings.Join(lines, "\n"))
+}
+
+// renderStat returns the file list rows, scrolled so the selection stays visible
+func (v *diffView) renderStat(width, height int) []string {
+	files := v.stat.Files
+	start := 0
+	if v.selected >= height {
+		start = v.selected - height + 1
+	}
+	end := min(start+height, len(files))
+
+	pathWidth := 0
+	for _, f := range files[start:end] {
+		pathWidth = max(pathWidth, ansi.StringWidth(f.Path))
+	}
+	pathWidth = min(pathWidth, max(width-20, 10))
+
+	rows := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		f := files[i]
+		path := ansi.Truncate(f.Path, pathWidth, "…")
+		path += strings.Repeat(" ", pathWidth-ansi.StringWidth(path))
+		var counts string
+		if f.Binary {
+			counts = v.theme.Dim.Render("binary")
+		} else {
+			counts = v.theme.DiffAdded.Render(fmt.Sprintf("+%d", f.Added)) + " " + v.theme.DiffRemoved.Render(fmt.Sprintf("-%d", f.Deleted))
+		}
+		row := fmt.Sprintf("  %s  %s", path, counts)
+		if i == v.selected {
+			row = v.theme.Selected.Render(fmt.Sprintf("▸ %s  ", path)) + counts
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
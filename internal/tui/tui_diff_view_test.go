@@ -0,0 +1,73 @@
+package tui
+
+import (
+	"context"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/git"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffView(t *testing.T) {
+	ctx := context.Background()
+	ops := &git.GitOperationsMock{
+		DiffStatFunc: func(ctx context.Context, repoPath, baseBranch string) (*git.DiffStat, error) {
+			return &git.DiffStat{Files: []git.DiffFileStat{
+				{Path: "main.go", Added: 3, Deleted: 1},
+				{Path: "huge.json", Added: 90000},
+				{Path: "logo.png", Binary: true},
+			}}, nil
+		},
+		DiffFileFunc: func(ctx context.Context, repoPath, baseBranch, path string, maxBytes int) (*git.FileDiff, error) {
+			if path == "huge.json" {
+				return &git.FileDiff{Path: path, TooLarge: true}, nil
+			}
+			return &git.FileDiff{Path: path, Text: "@@ -1 +1 @@\n-old line\n+new line\n"}, nil
+		},
+	}
+	key := func(s string) tea.KeyMsg {
+		switch s {
+		case "enter":
+			return tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			return tea.KeyMsg{Type: tea.KeyEsc}
+		}
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+	}
+
+	v := newDiffView(DarkTheme(), "w-abc", "/tree", "main")
+	v.setStat(v.loadStat(ctx, ops)().(diffStatLoadedMsg))
+	view := v.render(100, 30)
+	require.Contains(t, view, "main.go")
+	require.Contains(t, view, "3 files changed, 90003 insertions(+), 1 deletions(-)")
+
+	// Enter drills into the selected file
+	cmd, done := v.Update(ctx, ops, key("enter"))
+	require.False(t, done)
+	v.setFile(cmd().(diffFileLoadedMsg))
+	require.Contains(t, v.render(100, 30), "+new line")
+
+	// Esc returns to the file list, and the huge file shows a notice instead of its diff
+	_, done = v.Update(ctx, ops, key("esc"))
+	require.False(t, done)
+	v.Update(ctx, ops, key("j"))
+	cmd, _ = v.Update(ctx, ops, key("enter"))
+	v.setFile(cmd().(diffFileLoadedMsg))
+	require.Contains(t, v.render(100, 30), "File too large to display")
+
+	// Binary files have no diff to open
+	v.Update(ctx, ops, key("esc"))
+	v.Update(ctx, ops, key("j"))
+	cmd, _ = v.Update(ctx, ops, key("enter"))
+	require.Nil(t, cmd)
+
+	// Refresh keeps the selection on the same file
+	cmd, _ = v.Update(ctx, ops, key("r"))
+	v.setStat(cmd().(diffStatLoadedMsg))
+	require.Equal(t, "logo.png", v.selectedFile().Path)
+	require.Len(t, ops.DiffStatCalls(), 2)
+
+	_, done = v.Update(ctx, ops, key("esc"))
+	require.True(t, done)
+}
@@ -0,0 +1,219 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/newhook/co/internal/db"
+)
+
+// Icon set names accepted by ResolveIcons
+const (
+	IconsUnicode = "unicode"
+	IconsASCII   = "ascii"
+	IconsNerd    = "nerd"
+)
+
+// IconSet holds the glyphs the TUI marks statuses with. Icons are picked
+// once at startup alongside the theme, and every status mark goes through
+// Theme.statusGlyph, Theme.statusIcon or these fields, so a set swaps them
+// all. Within a set the status glyphs share a display width, keeping
+// columns aligned whichever one a row shows.
+type IconSet struct {
+	Name string
+
+	Pending    string
+	Processing string
+	Completed  string
+	Failed     string
+	Blocked    string // Issues with the blocked status
+	Waiting    string // Issues held back by open blockers
+	Deferred   string
+	Dead       string // Works whose orchestrator has died
+	Unknown    string
+	Selected   string // Issues picked for a multi-issue action
+	Activity   string // Works that changed since they were last viewed
+
+	// TaskTypes are the glyphs of the built-in task types, and CustomTask
+	// the glyph of custom types that didn't configure one
+	TaskTypes  map[string]string
+	CustomTask string
+
+	Spinner     spinner.Spinner // Loading and refreshing in the status bar
+	MiniSpinner spinner.Spinner // Running works in the tabs bar
+}
+
+// UnicodeIcons returns the default icon set of small Unicode shapes.
+func UnicodeIcons() IconSet {
+	return IconSet{
+		Name:       IconsUnicode,
+		Pending:    "○",
+		Processing: "●",
+		Completed:  "✓",
+		Failed:     "✗",
+		Blocked:    "◐",
+		Waiting:    "⛔",
+		Deferred:   "❄",
+		Dead:       "☠",
+		Unknown:    "?",
+		Selected:   "●",
+		Activity:   "●",
+		TaskTypes: map[string]string{
+			db.TaskTypeImplement:           "⚙",
+			db.TaskTypeEstimate:            "Σ",
+			db.TaskTypeReview:              "R",
+			db.TaskTypePR:                  "↑",
+			db.TaskTypeUpdatePRDescription: "✎",
+			db.TaskTypeLogAnalysis:         "≡",
+		},
+		CustomTask:  "◆",
+		Spinner:     spinner.Dot,
+		MiniSpinner: spinner.MiniDot,
+	}
+}
+
+// asciiSpinner turns in brackets, so it is as wide as the other ASCII icons
+var asciiSpinner = spinner.Spinner{
+	Frames: []string{"[|]", "[/]", "[-]", "[\\]"},
+	FPS:    time.Second / 8,
+}
+
+// ASCIIIcons returns an icon set of plain ASCII for fonts without the
+// Unicode shapes. Every status glyph is three columns wide and tells the
+// statuses apart by its letter or sign alone, without relying on color.
+func ASCIIIcons() IconSet {
+	return IconSet{
+		Name:       IconsASCII,
+		Pending:    "[ ]",
+		Processing: "[*]",
+		Completed:  "[x]",
+		Failed:     "[!]",
+		Blocked:    "[#]",
+		Waiting:    "[=]",
+		Deferred:   "[z]",
+		Dead:       "[X]",
+		Unknown:    "[?]",
+		Selected:   "[+]",
+		Activity:   "[~]",
+		TaskTypes: map[string]string{
+			db.TaskTypeImplement:           "I",
+			db.TaskTypeEstimate:            "E",
+			db.TaskTypeReview:              "R",
+			db.TaskTypePR:                  "P",
+			db.TaskTypeUpdatePRDescription: "U",
+			db.TaskTypeLogAnalysis:         "L",
+		},
+		CustomTask:  "C",
+		Spinner:     asciiSpinner,
+		MiniSpinner: asciiSpinner,
+	}
+}
+
+// NerdIcons returns an icon set drawn from a Nerd Font's Font Awesome
+// glyphs. It needs a patched font to render.
+func NerdIcons() IconSet {
+	return IconSet{
+		Name:       IconsNerd,
+		Pending:    "\uf10c", // circle-o
+		Processing: "\uf192", // dot-circle-o
+		Completed:  "\uf00c", // check
+		Failed:     "\uf00d", // times
+		Blocked:    "\uf05e", // ban
+		Waiting:    "\uf023", // lock
+		Deferred:   "\uf2dc", // snowflake-o
+		Dead:       "\uf1e2", // bomb
+		Unknown:    "\uf128", // question
+		Selected:   "\uf058", // check-circle
+		Activity:   "\uf111", // circle
+		TaskTypes: map[string]string{
+			db.TaskTypeImplement:           "\uf013", // cog
+			db.TaskTypeEstimate:            "\uf1ec", // calculator
+			db.TaskTypeReview:              "\uf06e", // eye
+			db.TaskTypePR:                  "\uf126", // code-fork
+			db.TaskTypeUpdatePRDescription: "\uf040", // pencil
+			db.TaskTypeLogAnalysis:         "\uf03a", // list
+		},
+		CustomTask:  "\uf005", // star
+		Spinner:     spinner.Dot,
+		MiniSpinner: spinner.MiniDot,
+	}
+}
+
+// builtinIconSets maps icon set names to their constructors
+var builtinIconSets = map[string]func() IconSet{
+	IconsUnicode: UnicodeIcons,
+	IconsASCII:   ASCIIIcons,
+	IconsNerd:    NerdIcons,
+}
+
+// IconSetNames returns the accepted icon set names.
+func IconSetNames() []string {
+	var names []string
+	for name := range builtinIconSets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ResolveIcons returns the icon set with the given name. An empty name picks
+// the unicode set.
+func ResolveIcons(name string) (IconSet, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return UnicodeIcons(), nil
+	}
+	if newFn, ok := builtinIconSets[name]; ok {
+		return newFn(), nil
+	}
+	return IconSet{}, fmt.Errorf("unknown icon set %q (valid: %s)", name, strings.Join(IconSetNames(), ", "))
+}
+
+// statusGlyph returns the unstyled glyph for a task, work or issue status,
+// for rows that style the whole line themselves
+func (t *Theme) statusGlyph(status string) string {
+	switch status {
+	// Internal db statuses
+	case db.StatusPending:
+		return t.Icons.Pending
+	case db.StatusProcessing:
+		return t.Icons.Processing
+	case db.StatusCompleted:
+		return t.Icons.Completed
+	case db.StatusFailed:
+		return t.Icons.Failed
+	// Bead statuses from bd CLI
+	case "open":
+		return t.Icons.Pending
+	case "in_progress":
+		return t.Icons.Processing
+	case "blocked":
+		return t.Icons.Blocked
+	case "deferred":
+		return t.Icons.Deferred
+	case "closed":
+		return t.Icons.Completed
+	default:
+		return t.Icons.Unknown
+	}
+}
+
+// statusIcon returns the glyph for a status, colored by the status
+func (t *Theme) statusIcon(status string) string {
+	glyph := t.statusGlyph(status)
+	switch status {
+	case db.StatusPending, "open", "deferred":
+		return t.StatusPending.Render(glyph)
+	case db.StatusProcessing, "in_progress":
+		return t.StatusProcessing.Render(glyph)
+	case db.StatusCompleted, "closed":
+		return t.StatusCompleted.Render(glyph)
+	case db.StatusFailed, "blocked":
+		return t.StatusFailed.Render(glyph)
+	default:
+		return glyph
+	}
+}
@@ -0,0 +1,94 @@
+package tui
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/progress"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveIcons(t *testing.T) {
+	for name, want := range map[string]string{"": IconsUnicode, "ascii": IconsASCII, " Nerd ": IconsNerd} {
+		icons, err := ResolveIcons(name)
+		require.NoError(t, err)
+		require.Equal(t, want, icons.Name)
+	}
+
+	_, err := ResolveIcons("emoji")
+	require.ErrorContains(t, err, "unknown icon set")
+}
+
+func TestASCIIIconsShareWidth(t *testing.T) {
+	icons := ASCIIIcons()
+	glyphs := []string{
+		icons.Pending, icons.Processing, icons.Completed, icons.Failed, icons.Blocked,
+		icons.Waiting, icons.Deferred, icons.Dead, icons.Unknown, icons.Selected, icons.Activity,
+	}
+	glyphs = append(glyphs, icons.Spinner.Frames...)
+	glyphs = append(glyphs, icons.MiniSpinner.Frames...)
+	seen := make(map[string]bool)
+	for _, glyph := range glyphs {
+		require.Equal(t, 3, ansi.StringWidth(glyph), "glyph %q", glyph)
+		for _, r := range glyph {
+			require.Less(t, r, rune(128), "glyph %q is not ASCII", glyph)
+		}
+		seen[glyph] = true
+	}
+
+	theme := DarkTheme()
+	theme.Icons = icons
+	statuses := []string{"pending", "processing", "completed", "failed", "open", "in_progress", "blocked", "deferred", "closed"}
+	for _, status := range statuses {
+		require.True(t, seen[theme.statusGlyph(status)], "status %s", status)
+	}
+	require.NotEqual(t, theme.statusGlyph("pending"), theme.statusGlyph("processing"), "statuses differ without color")
+	require.NotEqual(t, theme.statusGlyph("completed"), theme.statusGlyph("failed"), "statuses differ without color")
+}
+
+func TestWorkOverviewUsesIconSet(t *testing.T) {
+	theme := DarkTheme()
+	theme.Icons = ASCIIIcons()
+	p := NewWorkOverviewPanel(theme)
+	p.SetFocusedWork(&progress.WorkProgress{
+		Work: &db.Work{ID: "w-abc"},
+		Tasks: []*progress.TaskProgress{
+			{Task: &db.Task{ID: "w-abc.1", TaskType: db.TaskTypeImplement, Status: db.StatusCompleted}},
+			{Task: &db.Task{ID: "w-abc.2", TaskType: db.TaskTypeReview, Status: db.StatusFailed}},
+		},
+	})
+
+	require.Contains(t, p.renderTaskLine(0, 80), "[x] I w-abc.1")
+	require.Contains(t, p.renderTaskLine(1, 80), "[!] R w-abc.2")
+	require.NotContains(t, p.renderTaskLine(0, 80), "✓")
+}
+
+// TestStatusGlyphsComeFromIconSet keeps status glyphs out of rendering
+// code: they must come from the theme's icon set, through statusIcon,
+// statusGlyph or Theme.Icons, or the ascii and nerd sets would miss them.
+func TestStatusGlyphsComeFromIconSet(t *testing.T) {
+	unicode := UnicodeIcons()
+	glyphs := []string{
+		unicode.Pending, unicode.Processing, unicode.Completed, unicode.Failed,
+		unicode.Blocked, unicode.Waiting, unicode.Deferred, unicode.Dead,
+	}
+
+	entries, err := os.ReadDir(".")
+	require.NoError(t, err)
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || name == "tui_icons.go" {
+			continue
+		}
+		data, err := os.ReadFile(name)
+		require.NoError(t, err)
+		for i, line := range strings.Split(string(data), "\n") {
+			for _, glyph := range glyphs {
+				require.NotContains(t, line, glyph, "%s:%d hardcodes a status glyph; use the theme's icon set", name, i+1)
+			}
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package tui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/logging"
+	"github.com/newhook/co/internal/process"
+	"github.com/newhook/co/internal/project"
+)
+
+// Two TUIs open on one project would both write the state and draft files
+// and both correct work statuses. The first to open takes a lease on
+// .co/tui.lock and is the primary; the others run as secondaries that leave
+// those writes to it, until its lease goes stale and one of them takes over.
+const (
+	// tuiLeaseFile is the lease file, under .co/
+	tuiLeaseFile = "tui.lock"
+	// leaseRefresh is how often the primary renews its lease, and a
+	// secondary checks whether it can take over
+	leaseRefresh = 10 * time.Second
+	// leaseTTL is how long a lease lasts without being renewed. A primary
+	// that hasn't renewed it for this long is taken to be gone, even if its
+	// PID is still in use or can't be checked from this host.
+	leaseTTL = 30 * time.Second
+)
+
+// instanceLease is the content of the lease file
+type instanceLease struct {
+	PID       int       `json:"pid"`
+	Host      string    `json:"host"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// sameInstance reports whether two leases were taken by the same process
+func (l instanceLease) sameInstance(other instanceLease) bool {
+	return l.PID == other.PID && l.Host == other.Host
+}
+
+// stale reports whether a lease can be taken over: its process is gone, or
+// it hasn't been renewed within leaseTTL. alive checks a PID on this host.
+func (l instanceLease) stale(self instanceLease, now time.Time, alive func(pid int) bool) bool {
+	if l.Host == self.Host && !alive(l.PID) {
+		return true
+	}
+	return now.Sub(l.UpdatedAt) > leaseTTL
+}
+
+// instanceLeaser takes and renews the lease for one TUI. now and alive are
+// fields so tests can run takeovers on a fake clock.
+type instanceLeaser struct {
+	path  string
+	self  instanceLease
+	now   func() time.Time
+	alive func(pid int) bool
+}
+
+// newInstanceLeaser returns a leaser for this process on the project at root
+func newInstanceLeaser(root string) *instanceLeaser {
+	hostname, _ := os.Hostname()
+	return &instanceLeaser{
+		path:  filepath.Join(root, project.ConfigDir, tuiLeaseFile),
+		self:  instanceLease{PID: os.Getpid(), Host: hostname},
+		now:   time.Now,
+		alive: process.IsPIDAlive,
+	}
+}
+
+// claim takes the lease if it's free or stale, or renews it if this TUI
+// already holds it. It returns the lease as it stands afterwards and whether
+// this TUI is the primary. Two TUIs taking over at once can both write the
+// file; reading it back settles which of them won.
+func (l *instanceLeaser) claim() (instanceLease, bool, error) {
+	now := l.now()
+	holder, err := readLease(l.path)
+	if err != nil {
+		return instanceLease{}, false, err
+	}
+	if holder != nil && !holder.sameInstance(l.self) && !holder.stale(l.self, now, l.alive) {
+		return *holder, false, nil
+	}
+
+	lease := l.self
+	lease.UpdatedAt = now
+	if err := writeLease(l.path, lease); err != nil {
+		return instanceLease{}, false, err
+	}
+	holder, err = readLease(l.path)
+	if err != nil {
+		return instanceLease{}, false, err
+	}
+	if holder == nil {
+		return instanceLease{}, false, fmt.Errorf("lease file %s disappeared", l.path)
+	}
+	return *holder, holder.sameInstance(l.self), nil
+}
+
+// release removes the lease if this TUI holds it
+func (l *instanceLeaser) release() error {
+	holder, err := readLease(l.path)
+	if err != nil || holder == nil || !holder.sameInstance(l.self) {
+		return err
+	}
+	if err := os.Remove(l.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove TUI lease: %w", err)
+	}
+	return nil
+}
+
+// readLease reads the lease file, returning nil if there is none. A file that
+// can't be parsed is treated as no lease, so it gets replaced.
+func readLease(path string) (*instanceLease, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read TUI lease: %w", err)
+	}
+	var lease instanceLease
+	if err := json.Unmarshal(data, &lease); err != nil {
+		logging.Debug("readLease ignored invalid lease file", "error", err)
+		return nil, nil
+	}
+	return &lease, nil
+}
+
+// writeLease replaces the lease file in one step, so a reader never sees it
+// half written
+func writeLease(path string, lease instanceLease) error {
+	data, err := json.Marshal(lease)
+	if err != nil {
+		return fmt.Errorf("failed to encode TUI lease: %w", err)
+	}
+	tmp := fmt.Sprintf("%s.%d.tmp", path, lease.PID)
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write TUI lease: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to write TUI lease: %w", err)
+	}
+	return nil
+}
+
+// leaseCheckedMsg carries the outcome of claiming the lease
+type leaseCheckedMsg struct {
+	root    string // Project the lease is for, so a switched-away model's ticks are dropped
+	holder  instanceLease
+	primary bool
+	err     error
+}
+
+// claimLease claims the lease in the background
+func (m *planModel) claimLease() tea.Cmd {
+	if m.leaser == nil {
+		return nil
+	}
+	leaser, root := m.leaser, m.proj.Root
+	return func() tea.Msg {
+		holder, primary, err := leaser.claim()
+		return leaseCheckedMsg{root: root, holder: holder, primary: primary, err: err}
+	}
+}
+
+// scheduleLeaseRefresh claims the lease again after leaseRefresh
+func (m *planModel) scheduleLeaseRefresh() tea.Cmd {
+	if m.leaser == nil {
+		return nil
+	}
+	return tea.Tick(leaseRefresh, func(time.Time) tea.Msg { return leaseRefreshMsg{root: m.proj.Root} })
+}
+
+// leaseRefreshMsg triggers renewing the lease, or trying to take it over
+type leaseRefreshMsg struct {
+	root string
+}
+
+// handleLeaseChecked applies a background claim of the lease
+func (m *planModel) handleLeaseChecked(msg leaseCheckedMsg) tea.Cmd {
+	if msg.root != m.proj.Root {
+		return nil
+	}
+	m.applyLease(msg.holder, msg.primary, msg.err)
+	return m.scheduleLeaseRefresh()
+}
+
+// applyLease switches between primary and secondary as the lease changes
+// hands
+func (m *planModel) applyLease(holder instanceLease, primary bool, err error) {
+	if err != nil {
+		// Without a lease file to go by, act as the primary as before there was one
+		logging.Debug("claimLease failed", "error", err)
+		m.primaryPID = 0
+		return
+	}
+	switch {
+	case primary && m.primaryPID != 0:
+		logging.Info("took over as the primary TUI", "previous_pid", m.primaryPID)
+		m.primaryPID = 0
+		m.statusMessage = "Took over as the primary co tui"
+		m.statusIsError = false
+		// What this TUI marked as seen while it was a secondary is kept now
+		m.saveState()
+	case !primary:
+		if m.primaryPID == 0 {
+			logging.Info("running as a secondary TUI", "primary_pid", holder.PID)
+		}
+		m.primaryPID = holder.PID
+	}
+}
+
+// secondary reports whether another TUI holds the lease, so this one leaves
+// the state files and automatic corrections to it
+func (m *planModel) secondary() bool {
+	return m.primaryPID != 0
+}
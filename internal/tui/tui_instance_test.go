@@ -0,0 +1,166 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a settable clock for the leasers under test
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+// testLeaser returns a leaser for pid on host, sharing a lease file, clock
+// and set of live PIDs with the other leasers of a test
+func testLeaser(path string, pid int, host string, clock *fakeClock, alive map[int]bool) *instanceLeaser {
+	return &instanceLeaser{
+		path:  path,
+		self:  instanceLease{PID: pid, Host: host},
+		now:   clock.Now,
+		alive: func(pid int) bool { return alive[pid] },
+	}
+}
+
+func TestInstanceLeaseTakeover(t *testing.T) {
+	path := filepath.Join(t.TempDir(), tuiLeaseFile)
+	clock := &fakeClock{now: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	alive := map[int]bool{100: true, 200: true}
+	first := testLeaser(path, 100, "box", clock, alive)
+	second := testLeaser(path, 200, "box", clock, alive)
+
+	// The first TUI takes the free lease; the second runs as a secondary
+	holder, primary, err := first.claim()
+	require.NoError(t, err)
+	require.True(t, primary)
+	require.Equal(t, 100, holder.PID)
+	holder, primary, err = second.claim()
+	require.NoError(t, err)
+	require.False(t, primary)
+	require.Equal(t, 100, holder.PID)
+
+	// Renewing keeps the lease alive past the TTL
+	for range 5 {
+		clock.Advance(leaseRefresh)
+		_, primary, err = first.claim()
+		require.NoError(t, err)
+		require.True(t, primary)
+		_, primary, err = second.claim()
+		require.NoError(t, err)
+		require.False(t, primary)
+	}
+
+	// A primary that died is taken over at once
+	alive[100] = false
+	holder, primary, err = second.claim()
+	require.NoError(t, err)
+	require.True(t, primary)
+	require.Equal(t, 200, holder.PID)
+	require.Equal(t, clock.Now(), holder.UpdatedAt.UTC())
+
+	// The old PID coming back (reused) doesn't take the lease back
+	alive[100] = true
+	_, primary, err = first.claim()
+	require.NoError(t, err)
+	require.False(t, primary)
+}
+
+func TestInstanceLeaseExpires(t *testing.T) {
+	path := filepath.Join(t.TempDir(), tuiLeaseFile)
+	clock := &fakeClock{now: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	alive := map[int]bool{100: true, 200: true}
+	// A primary on another host can't be checked by PID, only by its renewals
+	remote := testLeaser(path, 100, "other-box", clock, alive)
+	local := testLeaser(path, 200, "box", clock, alive)
+
+	_, primary, err := remote.claim()
+	require.NoError(t, err)
+	require.True(t, primary)
+
+	clock.Advance(leaseTTL)
+	_, primary, err = local.claim()
+	require.NoError(t, err)
+	require.False(t, primary, "a lease renewed within the TTL holds")
+
+	clock.Advance(time.Second)
+	_, primary, err = local.claim()
+	require.NoError(t, err)
+	require.True(t, primary, "an expired lease is taken over")
+
+	// The old primary finds it lost the lease when it next renews
+	_, primary, err = remote.claim()
+	require.NoError(t, err)
+	require.False(t, primary)
+}
+
+func TestInstanceLeaseRelease(t *testing.T) {
+	path := filepath.Join(t.TempDir(), tuiLeaseFile)
+	clock := &fakeClock{now: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	alive := map[int]bool{100: true, 200: true}
+	first := testLeaser(path, 100, "box", clock, alive)
+	second := testLeaser(path, 200, "box", clock, alive)
+
+	_, _, err := first.claim()
+	require.NoError(t, err)
+
+	// Only the holder removes the lease
+	require.NoError(t, second.release())
+	require.FileExists(t, path)
+	require.NoError(t, first.release())
+	require.NoFileExists(t, path)
+
+	_, primary, err := second.claim()
+	require.NoError(t, err)
+	require.True(t, primary)
+
+	// A garbled lease file is replaced
+	require.NoError(t, os.WriteFile(path, []byte("not json"), 0644))
+	_, primary, err = first.claim()
+	require.NoError(t, err)
+	require.True(t, primary)
+}
+
+func TestPlanFlowSecondaryTUI(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+	require.NoError(t, os.Mkdir(filepath.Join(m.proj.Root, project.ConfigDir), 0755))
+	clock := &fakeClock{now: time.Now()}
+	alive := map[int]bool{100: true, 200: true}
+	path := filepath.Join(m.proj.Root, project.ConfigDir, tuiLeaseFile)
+	primary := testLeaser(path, 100, "box", clock, alive)
+	_, _, err := primary.claim()
+	require.NoError(t, err)
+
+	m.leaser = testLeaser(path, 200, "box", clock, alive)
+	m.applyLease(m.leaser.claim())
+	require.True(t, m.secondary())
+
+	// A secondary leaves the state file to the primary and says so
+	m.markAllWorksSeen()
+	_, found := loadTUIState(m.proj.Root)
+	require.False(t, found)
+	require.Nil(t, m.reconcileWorkStatuses(nil, nil))
+	view := ansi.Strip(m.View())
+	require.True(t, strings.Contains(view, "another co tui is primary (pid 100)"), view)
+
+	// Once the primary is gone, the next refresh takes over
+	alive[100] = false
+	m.Update(m.claimLease()())
+	require.False(t, m.secondary())
+	require.Equal(t, "Took over as the primary co tui", m.statusMessage)
+	_, found = loadTUIState(m.proj.Root)
+	require.True(t, found, "the state file is written once primary")
+}
@@ -9,22 +9,22 @@ import (
 // TestZoneMarking verifies that panels create proper zone prefixes for bubblezone
 func TestZoneMarking(t *testing.T) {
 	t.Run("status bar has zone prefix", func(t *testing.T) {
-		sb := NewStatusBar()
+		sb := NewStatusBar(DarkTheme())
 		assert.NotEmpty(t, sb.zonePrefix, "StatusBar should have a zone prefix")
 	})
 
 	t.Run("issues panel has zone prefix", func(t *testing.T) {
-		ip := NewIssuesPanel()
+		ip := NewIssuesPanel(DarkTheme())
 		assert.NotEmpty(t, ip.zonePrefix, "IssuesPanel should have a zone prefix")
 	})
 
 	t.Run("work tabs bar has zone prefix", func(t *testing.T) {
-		wtb := NewWorkTabsBar()
+		wtb := NewWorkTabsBar(DarkTheme())
 		assert.NotEmpty(t, wtb.zonePrefix, "WorkTabsBar should have a zone prefix")
 	})
 
 	t.Run("work overview panel has zone prefix", func(t *testing.T) {
-		wop := NewWorkOverviewPanel()
+		wop := NewWorkOverviewPanel(DarkTheme())
 		assert.NotEmpty(t, wop.zonePrefix, "WorkOverviewPanel should have a zone prefix")
 	})
 }
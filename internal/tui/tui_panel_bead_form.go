@@ -2,7 +2,10 @@ package tui
 
 import (
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
@@ -10,6 +13,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	zone "github.com/lrstanley/bubblezone"
 	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/fuzzy"
 )
 
 // BeadFormMode indicates which mode the form is in
@@ -45,13 +49,33 @@ type BeadFormResult struct {
 	Description string
 	BeadType    string
 	Priority    int
-	Status      string // Only used in edit mode
-	EditBeadID  string // Non-empty when editing
-	ParentID    string // Non-empty when adding child
+	Status      string   // Only used in edit mode
+	PrevStatus  string   // Status the bead had when editing started
+	Labels      []string // Only used in edit mode
+	PrevLabels  []string // Labels the bead had when editing started
+	EditBeadID  string   // Non-empty when editing
+	ParentID    string   // Non-empty when adding child
+	BlockedBy   []string // Beads the new bead depends on; create and add-child modes only
 }
 
+// beadCandidate is an existing bead offered as a blocked-by completion
+type beadCandidate struct {
+	ID    string
+	Title string
+
+	// Set on suggestions: how well they matched and which characters
+	score        int
+	idMatches    []int
+	titleMatches []int
+}
+
+// maxBlockedBySuggestions caps how many completions the blocked-by field lists
+const maxBlockedBySuggestions = 5
+
 // BeadFormPanel renders the bead create/edit form.
 type BeadFormPanel struct {
+	theme *Theme
+
 	// Dimensions
 	width  int
 	height int
@@ -65,19 +89,28 @@ type BeadFormPanel struct {
 	parentID   string
 
 	// Form state (owned directly)
-	titleInput   textinput.Model
-	descTextarea textarea.Model
-	beadType     int
-	priority     int
-	status       int // Index into beadStatuses
-	focusIdx     int
+	titleInput     textinput.Model
+	descTextarea   textarea.Model
+	labelsInput    textinput.Model // Comma separated, edit mode only
+	blockedByInput textinput.Model // Comma separated bead IDs, create and add-child modes only
+	candidates     []beadCandidate
+	prevLabels     []string
+	prevStatus     string
+	beadType       int
+	priority       int
+	status         int // Index into beadStatuses
+	focusIdx       int
+
+	// Per-type description templates, applied when the type changes
+	descTemplate func(beadType, title string) string
+	templateFill string // Description last filled in from a template, to tell it from user input
 
 	// Mouse state
 	hoveredButton string
 }
 
 // NewBeadFormPanel creates a new BeadFormPanel
-func NewBeadFormPanel() *BeadFormPanel {
+func NewBeadFormPanel(theme *Theme) *BeadFormPanel {
 	titleInput := textinput.New()
 	titleInput.Placeholder = "Enter title..."
 	titleInput.CharLimit = 100
@@ -89,15 +122,44 @@ func NewBeadFormPanel() *BeadFormPanel {
 	descTextarea.SetWidth(60)
 	descTextarea.SetHeight(4)
 
+	labelsInput := textinput.New()
+	labelsInput.Placeholder = "label1, label2"
+	labelsInput.CharLimit = 200
+	labelsInput.Width = 40
+
+	blockedByInput := textinput.New()
+	blockedByInput.Placeholder = "bd-12, bd-34 (optional)"
+	blockedByInput.CharLimit = 200
+	blockedByInput.Width = 40
+
 	return &BeadFormPanel{
-		width:        60,
-		height:       20,
-		priority:     2,
-		titleInput:   titleInput,
-		descTextarea: descTextarea,
+		theme:          theme,
+		width:          60,
+		height:         20,
+		priority:       2,
+		titleInput:     titleInput,
+		descTextarea:   descTextarea,
+		labelsInput:    labelsInput,
+		blockedByInput: blockedByInput,
 	}
 }
 
+// beadFormIndices are the focus positions of the form elements. Status and
+// labels only exist in edit mode and blocked-by only when creating, so
+// everything after them shifts with the mode.
+type beadFormIndices struct {
+	status, labels, blockedBy, desc, ok, cancel int
+}
+
+func (p *BeadFormPanel) indices() beadFormIndices {
+	if p.mode == BeadFormModeEdit {
+		// title(0) -> type(1) -> priority(2) -> status(3) -> labels(4) -> description(5) -> ok(6) -> cancel(7)
+		return beadFormIndices{status: 3, labels: 4, blockedBy: -1, desc: 5, ok: 6, cancel: 7}
+	}
+	// title(0) -> type(1) -> priority(2) -> blocked by(3) -> description(4) -> ok(5) -> cancel(6)
+	return beadFormIndices{status: -1, labels: -1, blockedBy: 3, desc: 4, ok: 5, cancel: 6}
+}
+
 // Init initializes the panel and returns any initial command
 func (p *BeadFormPanel) Init() tea.Cmd {
 	p.titleInput.Focus()
@@ -109,6 +171,11 @@ func (p *BeadFormPanel) Reset() {
 	p.titleInput.Reset()
 	p.titleInput.Focus()
 	p.descTextarea.Reset()
+	p.labelsInput.Reset()
+	p.blockedByInput.Reset()
+	p.prevLabels = nil
+	p.prevStatus = ""
+	p.templateFill = ""
 	p.beadType = 0
 	p.priority = 2
 	p.focusIdx = 0
@@ -118,13 +185,17 @@ func (p *BeadFormPanel) Reset() {
 }
 
 // SetEditMode configures the form for editing an existing bead
-func (p *BeadFormPanel) SetEditMode(beadID, title, description, beadType string, priority int, status string) {
+func (p *BeadFormPanel) SetEditMode(beadID, title, description, beadType string, priority int, status string, labels []string) {
 	p.mode = BeadFormModeEdit
 	p.editBeadID = beadID
 	p.parentID = ""
 	p.titleInput.SetValue(title)
 	p.titleInput.Focus()
 	p.descTextarea.SetValue(description)
+	p.labelsInput.SetValue(strings.Join(labels, ", "))
+	p.labelsInput.Blur()
+	p.prevLabels = labels
+	p.prevStatus = status
 	// Find the type index
 	p.beadType = 0
 	for i, t := range beadTypes {
@@ -145,6 +216,103 @@ func (p *BeadFormPanel) SetEditMode(beadID, title, description, beadType string,
 	p.focusIdx = 0
 }
 
+// SetBeadCandidates sets the beads offered as completions in the blocked-by field
+func (p *BeadFormPanel) SetBeadCandidates(items []beadItem) {
+	p.candidates = p.candidates[:0]
+	for _, item := range items {
+		p.candidates = append(p.candidates, beadCandidate{ID: item.ID, Title: item.Title})
+	}
+}
+
+// blockedBySuggestions returns the candidates matching the ID being typed in
+// the blocked-by field, skipping the parent and IDs already listed.
+func (p *BeadFormPanel) blockedBySuggestions() []beadCandidate {
+	value := p.blockedByInput.Value()
+	token := strings.TrimSpace(value[strings.LastIndex(value, ",")+1:])
+	if token == "" {
+		return nil
+	}
+	listed := parseLabels(value)
+	var prefix, matched []beadCandidate
+	for _, c := range p.candidates {
+		if c.ID == p.parentID || (c.ID != token && slices.Contains(listed, c.ID)) {
+			continue
+		}
+		if strings.HasPrefix(strings.ToLower(c.ID), strings.ToLower(token)) {
+			c.idMatches = make([]int, utf8.RuneCountInString(token))
+			for i := range c.idMatches {
+				c.idMatches[i] = i
+			}
+			prefix = append(prefix, c)
+			continue
+		}
+		if m, ok := fuzzy.Find(c.ID, token); ok {
+			c.score, c.idMatches = m.Score*searchWeightID, m.Positions
+		}
+		if m, ok := fuzzy.Find(c.Title, token); ok {
+			c.score, c.titleMatches = max(c.score, m.Score*searchWeightTitle), m.Positions
+		}
+		if c.score > 0 {
+			matched = append(matched, c)
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].score > matched[j].score
+	})
+	return append(prefix, matched...)
+}
+
+// completeBlockedBy replaces the ID being typed with the best suggestion.
+// It returns false when there is nothing to complete.
+func (p *BeadFormPanel) completeBlockedBy() bool {
+	suggestions := p.blockedBySuggestions()
+	if len(suggestions) == 0 {
+		return false
+	}
+	value := p.blockedByInput.Value()
+	i := strings.LastIndex(value, ",")
+	if strings.TrimSpace(value[i+1:]) == suggestions[0].ID {
+		return false
+	}
+	head := ""
+	if i >= 0 {
+		head = value[:i+1] + " "
+	}
+	p.blockedByInput.SetValue(head + suggestions[0].ID + ", ")
+	p.blockedByInput.CursorEnd()
+	return true
+}
+
+// fuzzyMatch reports whether the characters of each word of query appear in
+// s in order, ignoring case.
+func fuzzyMatch(s, query string) bool {
+	if strings.TrimSpace(query) == "" {
+		return true
+	}
+	_, ok := fuzzy.Find(s, query)
+	return ok
+}
+
+// SetDescriptionTemplates sets the lookup for per-type description templates.
+// It returns the filled-in template of a type, or "" if the type has none.
+func (p *BeadFormPanel) SetDescriptionTemplates(lookup func(beadType, title string) string) {
+	p.descTemplate = lookup
+}
+
+// applyDescriptionTemplate pre-fills the description with the selected type's
+// template. A description the user typed is never replaced; one that is still
+// the previous type's untouched template is.
+func (p *BeadFormPanel) applyDescriptionTemplate() {
+	if p.mode == BeadFormModeEdit || p.descTemplate == nil {
+		return
+	}
+	if current := p.descTextarea.Value(); current != "" && current != p.templateFill {
+		return
+	}
+	p.descTextarea.SetValue(p.descTemplate(beadTypes[p.beadType], strings.TrimSpace(p.titleInput.Value())))
+	p.templateFill = p.descTextarea.Value()
+}
+
 // SetAddChildMode configures the form for adding a child bead
 func (p *BeadFormPanel) SetAddChildMode(parentID string) {
 	p.Reset()
@@ -152,34 +320,62 @@ func (p *BeadFormPanel) SetAddChildMode(parentID string) {
 	p.parentID = parentID
 }
 
+// RestoreDraft fills the form with values saved from it earlier by GetResult,
+// in the mode they were saved in
+func (p *BeadFormPanel) RestoreDraft(r BeadFormResult) {
+	if r.EditBeadID != "" {
+		p.SetEditMode(r.EditBeadID, r.Title, r.Description, r.BeadType, r.Priority, r.Status, r.Labels)
+		// Changes are worked out against the bead as it was when editing started
+		p.prevLabels = r.PrevLabels
+		p.prevStatus = r.PrevStatus
+		return
+	}
+	if r.ParentID != "" {
+		p.SetAddChildMode(r.ParentID)
+	} else {
+		p.Reset()
+	}
+	p.titleInput.SetValue(r.Title)
+	p.descTextarea.SetValue(r.Description)
+	p.blockedByInput.SetValue(strings.Join(r.BlockedBy, ", "))
+	for i, t := range beadTypes {
+		if t == r.BeadType {
+			p.beadType = i
+			break
+		}
+	}
+	p.priority = r.Priority
+}
+
 // Update handles key events and returns an action
 func (p *BeadFormPanel) Update(msg tea.KeyMsg) (tea.Cmd, BeadFormAction) {
 	// Check escape/cancel keys
 	if msg.Type == tea.KeyEsc || msg.String() == "esc" {
-		p.titleInput.Blur()
-		p.descTextarea.Blur()
+		p.Blur()
 		return nil, BeadFormActionCancel
 	}
 
-	// Focus indices:
-	// Create/AddChild mode: title(0) -> type(1) -> priority(2) -> description(3) -> ok(4) -> cancel(5)
-	// Edit mode: title(0) -> type(1) -> priority(2) -> status(3) -> description(4) -> ok(5) -> cancel(6)
-	maxFocusIdx := 5
-	descIdx := 3
-	okIdx := 4
-	cancelIdx := 5
-	if p.mode == BeadFormModeEdit {
-		maxFocusIdx = 6
-		descIdx = 4
-		okIdx = 5
-		cancelIdx = 6
-	}
+	idx := p.indices()
+	maxFocusIdx := idx.cancel
+	labelsIdx := idx.labels
+	blockedByIdx := idx.blockedBy
+	descIdx := idx.desc
+	okIdx := idx.ok
+	cancelIdx := idx.cancel
 
-	// Tab cycles between elements
+	// Tab cycles between elements, after completing a partly typed blocked-by ID
 	if msg.Type == tea.KeyTab || msg.String() == "tab" {
+		if p.focusIdx == blockedByIdx && p.completeBlockedBy() {
+			return nil, BeadFormActionNone
+		}
+
 		// Leave current focus
 		if p.focusIdx == 0 {
 			p.titleInput.Blur()
+		} else if p.focusIdx == labelsIdx {
+			p.labelsInput.Blur()
+		} else if p.focusIdx == blockedByIdx {
+			p.blockedByInput.Blur()
 		} else if p.focusIdx == descIdx {
 			p.descTextarea.Blur()
 		}
@@ -189,6 +385,10 @@ func (p *BeadFormPanel) Update(msg tea.KeyMsg) (tea.Cmd, BeadFormAction) {
 		// Enter new focus
 		if p.focusIdx == 0 {
 			p.titleInput.Focus()
+		} else if p.focusIdx == labelsIdx {
+			p.labelsInput.Focus()
+		} else if p.focusIdx == blockedByIdx {
+			p.blockedByInput.Focus()
 		} else if p.focusIdx == descIdx {
 			p.descTextarea.Focus()
 		}
@@ -200,6 +400,10 @@ func (p *BeadFormPanel) Update(msg tea.KeyMsg) (tea.Cmd, BeadFormAction) {
 		// Leave current focus
 		if p.focusIdx == 0 {
 			p.titleInput.Blur()
+		} else if p.focusIdx == labelsIdx {
+			p.labelsInput.Blur()
+		} else if p.focusIdx == blockedByIdx {
+			p.blockedByInput.Blur()
 		} else if p.focusIdx == descIdx {
 			p.descTextarea.Blur()
 		}
@@ -212,6 +416,10 @@ func (p *BeadFormPanel) Update(msg tea.KeyMsg) (tea.Cmd, BeadFormAction) {
 		// Enter new focus
 		if p.focusIdx == 0 {
 			p.titleInput.Focus()
+		} else if p.focusIdx == labelsIdx {
+			p.labelsInput.Focus()
+		} else if p.focusIdx == blockedByIdx {
+			p.blockedByInput.Focus()
 		} else if p.focusIdx == descIdx {
 			p.descTextarea.Focus()
 		}
@@ -221,8 +429,8 @@ func (p *BeadFormPanel) Update(msg tea.KeyMsg) (tea.Cmd, BeadFormAction) {
 	// Enter key handling depends on focused element
 	if msg.String() == "enter" {
 		switch p.focusIdx {
-		case 0, 1, 2, 3: // Title, type, priority, or status - submit form (if not on description)
-			if p.focusIdx != descIdx {
+		case 0, 1, 2, 3, 4: // Title, type, priority, status, labels, or blocked by - submit form (if not on description)
+			if p.focusIdx != descIdx && p.focusIdx != okIdx && p.focusIdx != cancelIdx {
 				title := strings.TrimSpace(p.titleInput.Value())
 				if title != "" {
 					return nil, BeadFormActionSubmit
@@ -238,8 +446,7 @@ func (p *BeadFormPanel) Update(msg tea.KeyMsg) (tea.Cmd, BeadFormAction) {
 			return nil, BeadFormActionNone
 		}
 		if p.focusIdx == cancelIdx { // Cancel button - cancel form
-			p.titleInput.Blur()
-			p.descTextarea.Blur()
+			p.Blur()
 			return nil, BeadFormActionCancel
 		}
 		// For description textarea, Enter adds a newline (handled below)
@@ -255,11 +462,7 @@ func (p *BeadFormPanel) Update(msg tea.KeyMsg) (tea.Cmd, BeadFormAction) {
 	}
 
 	// Handle input based on focused element
-	// In edit mode, status is at index 3, otherwise description is at index 3
-	statusIdx := -1 // Not available in create/add-child modes
-	if p.mode == BeadFormModeEdit {
-		statusIdx = 3
-	}
+	statusIdx := idx.status // -1 in create/add-child modes
 
 	switch p.focusIdx {
 	case 0: // Title input
@@ -277,6 +480,7 @@ func (p *BeadFormPanel) Update(msg tea.KeyMsg) (tea.Cmd, BeadFormAction) {
 				p.beadType = len(beadTypes) - 1
 			}
 		}
+		p.applyDescriptionTemplate()
 		return nil, BeadFormActionNone
 
 	case 2: // Priority
@@ -308,6 +512,20 @@ func (p *BeadFormPanel) Update(msg tea.KeyMsg) (tea.Cmd, BeadFormAction) {
 			return nil, BeadFormActionNone
 		}
 
+		if p.focusIdx == labelsIdx {
+			// Labels input (edit mode only)
+			var cmd tea.Cmd
+			p.labelsInput, cmd = p.labelsInput.Update(msg)
+			return cmd, BeadFormActionNone
+		}
+
+		if p.focusIdx == blockedByIdx {
+			// Blocked-by input (create and add-child modes only)
+			var cmd tea.Cmd
+			p.blockedByInput, cmd = p.blockedByInput.Update(msg)
+			return cmd, BeadFormActionNone
+		}
+
 		if p.focusIdx == descIdx {
 			// Description textarea
 			var cmd tea.Cmd
@@ -329,8 +547,7 @@ func (p *BeadFormPanel) Update(msg tea.KeyMsg) (tea.Cmd, BeadFormAction) {
 		if p.focusIdx == cancelIdx {
 			// Cancel button - Space can also activate it
 			if msg.String() == " " {
-				p.titleInput.Blur()
-				p.descTextarea.Blur()
+				p.Blur()
 				return nil, BeadFormActionCancel
 			}
 			return nil, BeadFormActionNone
@@ -348,8 +565,12 @@ func (p *BeadFormPanel) GetResult() BeadFormResult {
 		BeadType:    beadTypes[p.beadType],
 		Priority:    p.priority,
 		Status:      beadStatuses[p.status],
+		Labels:      parseLabels(p.labelsInput.Value()),
+		PrevLabels:  p.prevLabels,
+		PrevStatus:  p.prevStatus,
 		EditBeadID:  p.editBeadID,
 		ParentID:    p.parentID,
+		BlockedBy:   parseLabels(p.blockedByInput.Value()),
 	}
 }
 
@@ -362,6 +583,8 @@ func (p *BeadFormPanel) GetMode() BeadFormMode {
 func (p *BeadFormPanel) Blur() {
 	p.titleInput.Blur()
 	p.descTextarea.Blur()
+	p.labelsInput.Blur()
+	p.blockedByInput.Blur()
 }
 
 // SetSize updates the panel dimensions
@@ -414,24 +637,21 @@ func (p *BeadFormPanel) Render(visibleLines int) string {
 		inputWidth = 20
 	}
 	p.titleInput.Width = inputWidth
+	p.labelsInput.Width = inputWidth
+	p.blockedByInput.Width = inputWidth
 	p.descTextarea.SetWidth(inputWidth)
 	// Calculate dynamic height for description textarea
-	descHeight := max(visibleLines-12, 4)
-	p.descTextarea.SetHeight(descHeight)
-
-	// Calculate dynamic focus indices based on mode
-	// Create/AddChild mode: title(0) -> type(1) -> priority(2) -> description(3) -> ok(4) -> cancel(5)
-	// Edit mode: title(0) -> type(1) -> priority(2) -> status(3) -> description(4) -> ok(5) -> cancel(6)
-	statusIdx := -1
-	descIdx := 3
-	okIdx := 4
-	cancelIdx := 5
+	descHeight := max(visibleLines-15, 4)
 	if p.mode == BeadFormModeEdit {
-		statusIdx = 3
-		descIdx = 4
-		okIdx = 5
-		cancelIdx = 6
+		descHeight = max(visibleLines-15, 4)
 	}
+	p.descTextarea.SetHeight(descHeight)
+
+	idx := p.indices()
+	statusIdx := idx.status
+	descIdx := idx.desc
+	okIdx := idx.ok
+	cancelIdx := idx.cancel
 
 	typeFocused := p.focusIdx == 1
 	priorityFocused := p.focusIdx == 2
@@ -442,16 +662,16 @@ func (p *BeadFormPanel) Render(visibleLines int) string {
 	currentType := beadTypes[p.beadType]
 	var typeDisplay string
 	if typeFocused {
-		typeDisplay = fmt.Sprintf("< %s >", tuiValueStyle.Render(currentType))
+		typeDisplay = fmt.Sprintf("< %s >", p.theme.Value.Render(currentType))
 	} else {
-		typeDisplay = typeFeatureStyle.Render(currentType)
+		typeDisplay = p.theme.TypeFeature.Render(currentType)
 	}
 
 	// Priority display
 	priorityLabels := []string{"P0 (critical)", "P1 (high)", "P2 (medium)", "P3 (low)", "P4 (backlog)"}
 	var priorityDisplay string
 	if priorityFocused {
-		priorityDisplay = fmt.Sprintf("< %s >", tuiValueStyle.Render(priorityLabels[p.priority]))
+		priorityDisplay = fmt.Sprintf("< %s >", p.theme.Value.Render(priorityLabels[p.priority]))
 	} else {
 		priorityDisplay = priorityLabels[p.priority]
 	}
@@ -461,7 +681,7 @@ func (p *BeadFormPanel) Render(visibleLines int) string {
 	if p.mode == BeadFormModeEdit {
 		currentStatus := beadStatuses[p.status]
 		if statusFocused {
-			statusDisplay = fmt.Sprintf("< %s >", tuiValueStyle.Render(currentStatus))
+			statusDisplay = fmt.Sprintf("< %s >", p.theme.Value.Render(currentStatus))
 		} else {
 			statusDisplay = currentStatus
 		}
@@ -472,36 +692,44 @@ func (p *BeadFormPanel) Render(visibleLines int) string {
 	typeLabel := "Type:"
 	priorityLabel := "Priority:"
 	statusLabel := "Status:"
+	labelsLabel := "Labels:"
+	blockedByLabel := "Blocked by:"
 	descLabel := "Description:"
 	if p.focusIdx == 0 {
-		titleLabel = tuiValueStyle.Render("Title:") + " (editing)"
+		titleLabel = p.theme.Value.Render("Title:") + " (editing)"
 	}
 	if typeFocused {
-		typeLabel = tuiValueStyle.Render("Type:") + " (j/k)"
+		typeLabel = p.theme.Value.Render("Type:") + " (j/k)"
 	}
 	if priorityFocused {
-		priorityLabel = tuiValueStyle.Render("Priority:") + " (j/k)"
+		priorityLabel = p.theme.Value.Render("Priority:") + " (j/k)"
 	}
 	if statusFocused {
-		statusLabel = tuiValueStyle.Render("Status:") + " (j/k)"
+		statusLabel = p.theme.Value.Render("Status:") + " (j/k)"
+	}
+	if p.focusIdx == idx.labels {
+		labelsLabel = p.theme.Value.Render("Labels:") + " (comma separated)"
+	}
+	if p.focusIdx == idx.blockedBy {
+		blockedByLabel = p.theme.Value.Render("Blocked by:") + " (comma separated, Tab completes)"
 	}
 	if descFocused {
-		descLabel = tuiValueStyle.Render("Description:") + " (optional)"
+		descLabel = p.theme.Value.Render("Description:") + " (optional)"
 	}
 
 	// Determine mode and render appropriate header
 	var header string
 	switch p.mode {
 	case BeadFormModeEdit:
-		header = "Edit Issue " + issueIDStyle.Render(p.editBeadID)
+		header = "Edit Issue " + p.theme.IssueID.Render(p.editBeadID)
 	case BeadFormModeAddChild:
 		// Include parent on same line to save vertical space
-		header = "Add Child to " + tuiValueStyle.Render(p.parentID)
+		header = "Add Child to " + p.theme.Value.Render(p.parentID)
 	default:
 		header = "Create New Issue"
 	}
 
-	content.WriteString(tuiLabelStyle.Render(header))
+	content.WriteString(p.theme.Label.Render(header))
 	content.WriteString("\n")
 
 	// Render form fields
@@ -515,10 +743,31 @@ func (p *BeadFormPanel) Render(visibleLines int) string {
 	content.WriteString(priorityLabel + " " + priorityDisplay)
 	content.WriteString("\n")
 
-	// Show status field only in edit mode
+	// Show status and labels fields only in edit mode
 	if p.mode == BeadFormModeEdit {
 		content.WriteString(statusLabel + " " + statusDisplay)
+		content.WriteString("\n\n")
+		content.WriteString(labelsLabel)
+		content.WriteString("\n")
+		content.WriteString(p.labelsInput.View())
+		content.WriteString("\n")
+	} else {
 		content.WriteString("\n")
+		content.WriteString(blockedByLabel)
+		content.WriteString("\n")
+		content.WriteString(p.blockedByInput.View())
+		content.WriteString("\n")
+		if p.focusIdx == idx.blockedBy {
+			suggestions := p.blockedBySuggestions()
+			for i, c := range suggestions {
+				if i == maxBlockedBySuggestions {
+					content.WriteString(p.theme.Dim.Render(fmt.Sprintf("  ... %d more", len(suggestions)-i)) + "\n")
+					break
+				}
+				content.WriteString(p.theme.Dim.Render("  ") + p.theme.highlightMatches(c.ID, c.idMatches, p.theme.Dim) +
+					p.theme.Dim.Render(" ") + p.theme.highlightMatches(c.Title, c.titleMatches, p.theme.Dim) + "\n")
+			}
+		}
 	}
 
 	content.WriteString("\n")
@@ -530,12 +779,12 @@ func (p *BeadFormPanel) Render(visibleLines int) string {
 	// Render Ok and Cancel buttons with zone markers for click detection
 	okFocused := p.focusIdx == okIdx
 	cancelFocused := p.focusIdx == cancelIdx
-	okButton := zone.Mark("dialog-ok", styleButtonWithHover("  Ok  ", p.hoveredButton == "ok" || okFocused))
-	cancelButton := zone.Mark("dialog-cancel", styleButtonWithHover("Cancel", p.hoveredButton == "cancel" || cancelFocused))
+	okButton := zone.Mark("dialog-ok", p.theme.styleButtonWithHover("  Ok  ", p.hoveredButton == "ok" || okFocused))
+	cancelButton := zone.Mark("dialog-cancel", p.theme.styleButtonWithHover("Cancel", p.hoveredButton == "cancel" || cancelFocused))
 
 	content.WriteString(okButton + "  " + cancelButton)
 	content.WriteString("\n")
-	content.WriteString(tuiDimStyle.Render("[Tab] Next  [Enter/Space] Select"))
+	content.WriteString(p.theme.Dim.Render("[Tab] Next  [Enter/Space] Select"))
 
 	return content.String()
 }
@@ -544,9 +793,9 @@ func (p *BeadFormPanel) Render(visibleLines int) string {
 func (p *BeadFormPanel) RenderWithPanel(contentHeight int) string {
 	panelContent := p.Render(contentHeight - 3)
 
-	panelStyle := tuiPanelStyle.Width(p.width).Height(contentHeight - 2)
+	panelStyle := p.theme.Panel.Width(p.width).Height(contentHeight - 2)
 	if p.focused {
-		panelStyle = panelStyle.BorderForeground(lipgloss.Color("214"))
+		panelStyle = panelStyle.BorderForeground(p.theme.AccentColor)
 	}
 
 	// Determine title based on mode
@@ -560,7 +809,7 @@ func (p *BeadFormPanel) RenderWithPanel(contentHeight int) string {
 		title = "Create Issue"
 	}
 
-	result := panelStyle.Render(tuiTitleStyle.Render(title) + "\n" + panelContent)
+	result := panelStyle.Render(p.theme.Title.Render(title) + "\n" + panelContent)
 
 	// If the result is taller than expected (due to lipgloss wrapping), fix it
 	// by removing extra lines from the INNER content while preserving borders and title
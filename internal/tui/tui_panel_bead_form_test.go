@@ -0,0 +1,123 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	require.True(t, fuzzyMatch("Fix login page", "flp"))
+	require.True(t, fuzzyMatch("bead-12", "B12"))
+	require.False(t, fuzzyMatch("bead-12", "21"))
+	require.True(t, fuzzyMatch("anything", ""))
+}
+
+func TestBeadFormBlockedBy(t *testing.T) {
+	p := NewBeadFormPanel(DarkTheme())
+	p.SetAddChildMode("bead-1")
+	p.SetBeadCandidates([]beadItem{
+		testBeadItem("bead-1", "Fix login", "open", 2, "task"),
+		testBeadItem("bead-2", "Add logout", "open", 2, "task"),
+		testBeadItem("bead-3", "Write docs", "open", 2, "task"),
+	})
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Child")})
+
+	// title -> type -> priority -> blocked by
+	for range 3 {
+		p.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+
+	// The parent is never offered; titles match fuzzily
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("bead")})
+	require.Equal(t, []string{"bead-2", "bead-3"}, suggestionIDs(p.blockedBySuggestions()))
+	require.Contains(t, p.Render(30), "Add logout")
+
+	// Tab completes the typed ID, then the next one skips IDs already listed
+	p.Update(tea.KeyMsg{Type: tea.KeyTab})
+	require.Equal(t, "bead-2, ", p.blockedByInput.Value())
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("wd")})
+	p.Update(tea.KeyMsg{Type: tea.KeyTab})
+	require.Equal(t, "bead-2, bead-3, ", p.blockedByInput.Value())
+
+	result := p.GetResult()
+	require.Equal(t, "bead-1", result.ParentID)
+	require.Equal(t, []string{"bead-2", "bead-3"}, result.BlockedBy)
+
+	// With nothing left to complete Tab moves on to the description
+	p.Update(tea.KeyMsg{Type: tea.KeyTab})
+	require.Equal(t, p.indices().desc, p.focusIdx)
+
+	// Resetting clears the field
+	p.Reset()
+	require.Empty(t, p.GetResult().BlockedBy)
+}
+
+func TestBeadFormBlockedByRanksMatches(t *testing.T) {
+	p := NewBeadFormPanel(DarkTheme())
+	p.SetBeadCandidates([]beadItem{
+		testBeadItem("bead-1", "Schedule the recache job", "open", 2, "task"),
+		testBeadItem("bead-2", "Cache invalidation", "open", 2, "task"),
+		testBeadItem("bead-3", "Write docs", "open", 2, "task"),
+	})
+	for range 3 {
+		p.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+
+	// A match at the start of a word ranks above one inside a word, and
+	// the matched characters are recorded for highlighting
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("cache")})
+	suggestions := p.blockedBySuggestions()
+	require.Equal(t, []string{"bead-2", "bead-1"}, suggestionIDs(suggestions))
+	require.Equal(t, []int{0, 1, 2, 3, 4}, suggestions[0].titleMatches)
+}
+
+// suggestionIDs returns the IDs of blocked-by suggestions
+func suggestionIDs(suggestions []beadCandidate) []string {
+	var ids []string
+	for _, c := range suggestions {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+func TestBeadFormDescriptionTemplates(t *testing.T) {
+	p := NewBeadFormPanel(DarkTheme())
+	p.SetDescriptionTemplates(func(beadType, title string) string {
+		switch beadType {
+		case "bug":
+			return "Repro: " + title
+		case "feature":
+			return "Acceptance criteria:"
+		}
+		return ""
+	})
+	p.Reset()
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Crash")})
+	p.Update(tea.KeyMsg{Type: tea.KeyTab})
+
+	// task -> bug fills in the bug template with the title
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	require.Equal(t, "Repro: Crash", p.descTextarea.Value())
+
+	// An untouched template follows the type, back to none for task
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	require.Equal(t, "Acceptance criteria:", p.descTextarea.Value())
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")})
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")})
+	require.Empty(t, p.descTextarea.Value())
+
+	// Once edited, changing the type keeps the user's text
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	p.descTextarea.SetValue("Repro: Crash on save")
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	require.Equal(t, "feature", p.GetResult().BeadType)
+	require.Equal(t, "Repro: Crash on save", p.descTextarea.Value())
+
+	// Editing an existing bead never applies templates
+	p.SetEditMode("bead-1", "Old", "", "task", 2, "open", nil)
+	p.Update(tea.KeyMsg{Type: tea.KeyTab})
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	require.Empty(t, p.descTextarea.Value())
+}
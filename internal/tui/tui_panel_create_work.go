@@ -3,13 +3,33 @@ package tui
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 	zone "github.com/lrstanley/bubblezone"
+	"github.com/newhook/co/internal/work"
 )
 
+// branchCheckDelay is how long typing in the branch name has to pause before
+// the name is checked
+const branchCheckDelay = 300 * time.Millisecond
+
+// branchCheckTickMsg fires once typing in the branch name has paused
+type branchCheckTickMsg struct {
+	seq int
+}
+
+// branchCheckedMsg carries the result of checking the typed branch name
+type branchCheckedMsg struct {
+	seq   int
+	check *work.BranchCheck
+	err   error
+}
+
 // CreateWorkAction represents an action result from the panel
 type CreateWorkAction int
 
@@ -18,17 +38,23 @@ const (
 	CreateWorkActionCancel
 	CreateWorkActionExecute
 	CreateWorkActionAuto
+	CreateWorkActionImport
 )
 
 // CreateWorkResult contains form values when submitted
 type CreateWorkResult struct {
 	BranchName        string
+	BaseBranch        string // Branch the work branches from and is diffed and merged against
 	BeadID            string
 	UseExistingBranch bool
+	AdditionalBeadIDs []string // Further beads added to the work once it exists
+	FocusOnCreate     bool     // Zoom into the work once it is created
 }
 
 // CreateWorkPanel renders the work creation form.
 type CreateWorkPanel struct {
+	theme *Theme
+
 	// Dimensions
 	width  int
 	height int
@@ -37,35 +63,66 @@ type CreateWorkPanel struct {
 	focused bool
 
 	// Form state (owned directly)
-	beadID      string
-	branchInput textinput.Model
-	fieldIdx    int // 0=mode toggle, 1=branch input/selector, 2=buttons
-	buttonIdx   int // 0=Execute, 1=Auto, 2=Cancel
+	beadID            string
+	additionalBeadIDs []string
+	focusOnCreate     bool
+	branchInput       textinput.Model
+	baseInput         textinput.Model // Base branch, completed from the repo's branches
+	defaultBase       string          // [repo] base_branch, filled in on reset
+	fieldIdx          int             // 0=mode toggle, 1=branch input/selector, 2=base branch, 3=buttons
+	buttonIdx         int             // 0=Execute, 1=Auto, 2=Cancel
 
 	// Branch mode selection
-	useExistingBranch   bool     // true = select existing branch, false = create new
-	branches            []string // all available branches
-	filteredBranches    []string // branches matching filter
-	branchFilter        string   // current filter text
-	selectedBranchIdx   int      // selected index in filteredBranches
-	branchScrollOffset  int      // scroll offset for branch list
-	maxVisibleBranches  int      // max branches visible at once
+	useExistingBranch  bool     // true = select existing branch, false = create new
+	branches           []string // all available branches
+	filteredBranches   []string // branches matching filter
+	branchFilter       string   // current filter text
+	selectedBranchIdx  int      // selected index in filteredBranches
+	branchScrollOffset int      // scroll offset for branch list
+	maxVisibleBranches int      // max branches visible at once
+
+	// Validation of the new branch name, redone once typing pauses
+	branchCheckSeq int               // bumped on every edit; stale checks are dropped
+	branchCheck    *work.BranchCheck // result for the current name, nil until checked
+
+	// Why creating the work was rejected, until the form is edited
+	err string
+
+	// Import from a manifest file instead of the form above
+	importMode    bool
+	manifestInput textinput.Model
 
 	// Mouse state
 	hoveredButton string
 }
 
 // NewCreateWorkPanel creates a new CreateWorkPanel
-func NewCreateWorkPanel() *CreateWorkPanel {
+func NewCreateWorkPanel(theme *Theme) *CreateWorkPanel {
 	branchInput := textinput.New()
 	branchInput.Placeholder = "Branch name..."
 	branchInput.CharLimit = 100
 	branchInput.Width = 60
 
+	// Branch names complete with → (tab moves between fields)
+	baseInput := textinput.New()
+	baseInput.Placeholder = "main"
+	baseInput.CharLimit = 100
+	baseInput.Width = 60
+	baseInput.ShowSuggestions = true
+	baseInput.KeyMap.AcceptSuggestion = key.NewBinding(key.WithKeys("right", "ctrl+f"))
+
+	manifestInput := textinput.New()
+	manifestInput.Placeholder = "work.yaml"
+	manifestInput.CharLimit = 500
+	manifestInput.Width = 60
+
 	return &CreateWorkPanel{
+		theme:              theme,
 		width:              60,
 		height:             20,
 		branchInput:        branchInput,
+		baseInput:          baseInput,
+		manifestInput:      manifestInput,
 		maxVisibleBranches: 8,
 	}
 }
@@ -73,14 +130,72 @@ func NewCreateWorkPanel() *CreateWorkPanel {
 // Init initializes the panel and returns any initial command
 func (p *CreateWorkPanel) Init() tea.Cmd {
 	p.branchInput.Focus()
-	return textinput.Blink
+	return tea.Batch(textinput.Blink, p.scheduleBranchCheck())
+}
+
+// scheduleBranchCheck discards the current check and schedules a new one for
+// when typing pauses
+func (p *CreateWorkPanel) scheduleBranchCheck() tea.Cmd {
+	p.branchCheckSeq++
+	p.branchCheck = nil
+	seq := p.branchCheckSeq
+	return tea.Tick(branchCheckDelay, func(time.Time) tea.Msg {
+		return branchCheckTickMsg{seq: seq}
+	})
+}
+
+// BranchCheckDue returns the branch name to check if seq is still the latest
+// scheduled check, or ok=false if the name changed since
+func (p *CreateWorkPanel) BranchCheckDue(seq int) (name string, ok bool) {
+	if seq != p.branchCheckSeq || p.useExistingBranch {
+		return "", false
+	}
+	return p.branchInput.Value(), true
+}
+
+// SetBranchCheck records the result of check seq, unless the name changed since
+func (p *CreateWorkPanel) SetBranchCheck(seq int, check *work.BranchCheck) {
+	if seq == p.branchCheckSeq {
+		p.branchCheck = check
+	}
+}
+
+// BranchProblem describes why the new branch name can't be submitted as is,
+// or returns "" if it can (or hasn't been checked yet)
+func (p *CreateWorkPanel) BranchProblem() string {
+	check := p.branchCheck
+	if p.useExistingBranch || check == nil {
+		return ""
+	}
+	if check.Name == "" {
+		return fmt.Sprintf("Invalid branch name: %v", check.Invalid)
+	}
+	if !check.Taken() {
+		return ""
+	}
+	var options []string
+	if check.Suggestion != "" {
+		options = append(options, "ctrl+s to use "+check.Suggestion)
+	}
+	if check.CanAttach() {
+		options = append(options, "ctrl+x to attach to it")
+	}
+	problem := fmt.Sprintf("Branch %s is taken", check.Name)
+	if len(options) > 0 {
+		problem += ": " + strings.Join(options, ", ")
+	}
+	return problem
 }
 
 // Reset resets the form to initial state
 func (p *CreateWorkPanel) Reset(beadID string, branchName string) {
 	p.beadID = beadID
+	p.additionalBeadIDs = nil
+	p.focusOnCreate = false
 	p.branchInput.SetValue(branchName)
 	p.branchInput.Focus()
+	p.baseInput.SetValue(p.defaultBase)
+	p.baseInput.Blur()
 	p.fieldIdx = 0
 	p.buttonIdx = 0
 
@@ -91,11 +206,36 @@ func (p *CreateWorkPanel) Reset(beadID string, branchName string) {
 	p.branchFilter = ""
 	p.selectedBranchIdx = 0
 	p.branchScrollOffset = 0
+	p.branchCheck = nil
+	p.err = ""
+
+	p.importMode = false
+	p.manifestInput.SetValue("")
+	p.manifestInput.Blur()
+}
+
+// ResetForBeads resets the form to create a work from several beads. The first
+// bead becomes the work's root issue and the work is focused once created.
+func (p *CreateWorkPanel) ResetForBeads(beadIDs []string, branchName string) {
+	if len(beadIDs) == 0 {
+		return
+	}
+	p.Reset(beadIDs[0], branchName)
+	p.additionalBeadIDs = beadIDs[1:]
+	p.focusOnCreate = true
+}
+
+// SetDefaultBase sets the base branch the form starts with
+func (p *CreateWorkPanel) SetDefaultBase(base string) {
+	p.defaultBase = base
+	p.baseInput.SetValue(base)
 }
 
-// SetBranches sets the available branches for selection
+// SetBranches sets the available branches for selection and base branch
+// completion
 func (p *CreateWorkPanel) SetBranches(branches []string) {
 	p.branches = branches
+	p.baseInput.SetSuggestions(branches)
 	p.applyBranchFilter()
 }
 
@@ -120,16 +260,50 @@ func (p *CreateWorkPanel) applyBranchFilter() {
 	p.branchScrollOffset = 0
 }
 
+// SetError shows why creating the work was rejected, until the next key
+func (p *CreateWorkPanel) SetError(err string) {
+	p.err = err
+}
+
 // Update handles key events and returns an action
 func (p *CreateWorkPanel) Update(msg tea.KeyMsg) (tea.Cmd, CreateWorkAction) {
+	p.err = ""
 	if msg.Type == tea.KeyEsc {
 		p.branchInput.Blur()
+		p.baseInput.Blur()
+		p.manifestInput.Blur()
 		return nil, CreateWorkActionCancel
 	}
 
-	// Tab cycles between mode(0), branch(1), buttons(2)
+	// ctrl+o switches between the form and importing a manifest file
+	if msg.String() == "ctrl+o" {
+		p.importMode = !p.importMode
+		if p.importMode {
+			p.branchInput.Blur()
+			p.baseInput.Blur()
+			p.manifestInput.Focus()
+			return textinput.Blink, CreateWorkActionNone
+		}
+		p.manifestInput.Blur()
+		p.updateFocus()
+		return nil, CreateWorkActionNone
+	}
+	if p.importMode {
+		if msg.Type == tea.KeyEnter {
+			if p.ManifestPath() == "" {
+				return nil, CreateWorkActionNone
+			}
+			p.manifestInput.Blur()
+			return nil, CreateWorkActionImport
+		}
+		var cmd tea.Cmd
+		p.manifestInput, cmd = p.manifestInput.Update(msg)
+		return cmd, CreateWorkActionNone
+	}
+
+	// Tab cycles between mode(0), branch(1), base(2), buttons(3)
 	if msg.Type == tea.KeyTab {
-		p.fieldIdx = (p.fieldIdx + 1) % 3
+		p.fieldIdx = (p.fieldIdx + 1) % 4
 		p.updateFocus()
 		return nil, CreateWorkActionNone
 	}
@@ -138,12 +312,31 @@ func (p *CreateWorkPanel) Update(msg tea.KeyMsg) (tea.Cmd, CreateWorkAction) {
 	if msg.Type == tea.KeyShiftTab {
 		p.fieldIdx--
 		if p.fieldIdx < 0 {
-			p.fieldIdx = 2
+			p.fieldIdx = 3
 		}
 		p.updateFocus()
 		return nil, CreateWorkActionNone
 	}
 
+	// Resolve a taken branch name: append a numeric suffix, or switch to the
+	// existing branch
+	if !p.useExistingBranch && p.branchCheck != nil {
+		switch msg.String() {
+		case "ctrl+s":
+			if p.branchCheck.Suggestion != "" {
+				p.branchInput.SetValue(p.branchCheck.Suggestion)
+				p.branchInput.CursorEnd()
+				return p.scheduleBranchCheck(), CreateWorkActionNone
+			}
+			return nil, CreateWorkActionNone
+		case "ctrl+x":
+			if p.branchCheck.CanAttach() {
+				p.selectExistingBranch(p.branchCheck.Name)
+			}
+			return nil, CreateWorkActionNone
+		}
+	}
+
 	// Handle input based on focused field
 	var cmd tea.Cmd
 	switch p.fieldIdx {
@@ -154,14 +347,23 @@ func (p *CreateWorkPanel) Update(msg tea.KeyMsg) (tea.Cmd, CreateWorkAction) {
 			// Reset filter when switching modes
 			p.branchFilter = ""
 			p.applyBranchFilter()
+			if !p.useExistingBranch && p.branchCheck == nil {
+				cmd = p.scheduleBranchCheck()
+			}
 		}
 	case 1: // Branch input or selector
 		if p.useExistingBranch {
 			p.updateBranchSelector(msg)
 		} else {
+			before := p.branchInput.Value()
 			p.branchInput, cmd = p.branchInput.Update(msg)
+			if p.branchInput.Value() != before {
+				cmd = tea.Batch(cmd, p.scheduleBranchCheck())
+			}
 		}
-	case 2: // Buttons
+	case 2: // Base branch
+		p.baseInput, cmd = p.baseInput.Update(msg)
+	case 3: // Buttons
 		switch msg.String() {
 		case "k", "up":
 			p.buttonIdx--
@@ -190,6 +392,31 @@ func (p *CreateWorkPanel) Update(msg tea.KeyMsg) (tea.Cmd, CreateWorkAction) {
 	return cmd, CreateWorkActionNone
 }
 
+// selectExistingBranch switches to existing branch mode with branch selected
+func (p *CreateWorkPanel) selectExistingBranch(branch string) {
+	p.useExistingBranch = true
+	p.branchFilter = ""
+	p.applyBranchFilter()
+	idx := -1
+	for i, b := range p.filteredBranches {
+		if b == branch {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		// Not loaded yet (or the list failed to load)
+		p.SetBranches(append([]string{branch}, p.branches...))
+		idx = 0
+	}
+	p.selectedBranchIdx = idx
+	if idx >= p.maxVisibleBranches {
+		p.branchScrollOffset = idx - p.maxVisibleBranches + 1
+	}
+	p.fieldIdx = 1
+	p.updateFocus()
+}
+
 // updateFocus updates focus state based on current field index
 func (p *CreateWorkPanel) updateFocus() {
 	if p.fieldIdx == 1 && !p.useExistingBranch {
@@ -197,6 +424,11 @@ func (p *CreateWorkPanel) updateFocus() {
 	} else {
 		p.branchInput.Blur()
 	}
+	if p.fieldIdx == 2 {
+		p.baseInput.Focus()
+	} else {
+		p.baseInput.Blur()
+	}
 }
 
 // updateBranchSelector handles key events for the branch selector
@@ -244,15 +476,35 @@ func (p *CreateWorkPanel) getSelectedBranchName() string {
 	return strings.TrimSpace(p.branchInput.Value())
 }
 
+// getBaseBranch returns the base branch typed in the form; empty means the
+// configured one
+func (p *CreateWorkPanel) getBaseBranch() string {
+	return strings.TrimSpace(p.baseInput.Value())
+}
+
 // GetResult returns the current form values
 func (p *CreateWorkPanel) GetResult() CreateWorkResult {
 	return CreateWorkResult{
 		BranchName:        p.getSelectedBranchName(),
+		BaseBranch:        p.getBaseBranch(),
 		BeadID:            p.beadID,
 		UseExistingBranch: p.useExistingBranch,
+		AdditionalBeadIDs: p.additionalBeadIDs,
+		FocusOnCreate:     p.focusOnCreate,
 	}
 }
 
+// IsImporting reports whether the panel is asking for a manifest file
+// instead of showing the form
+func (p *CreateWorkPanel) IsImporting() bool {
+	return p.importMode
+}
+
+// ManifestPath returns the manifest file typed in import mode
+func (p *CreateWorkPanel) ManifestPath() string {
+	return strings.TrimSpace(p.manifestInput.Value())
+}
+
 // GetBeadID returns the bead ID for this work
 func (p *CreateWorkPanel) GetBeadID() string {
 	return p.beadID
@@ -261,6 +513,7 @@ func (p *CreateWorkPanel) GetBeadID() string {
 // Blur removes focus from the input
 func (p *CreateWorkPanel) Blur() {
 	p.branchInput.Blur()
+	p.baseInput.Blur()
 }
 
 // SetSize updates the panel dimensions
@@ -300,31 +553,43 @@ func (p *CreateWorkPanel) Render() string {
 	var content strings.Builder
 
 	// Panel header
-	content.WriteString(tuiSuccessStyle.Render("Create Work"))
+	content.WriteString(p.theme.Success.Render("Create Work"))
 	content.WriteString("\n\n")
 
+	if p.importMode {
+		content.WriteString(p.renderImport())
+		return content.String()
+	}
+
 	// Show bead info
-	beadInfo := fmt.Sprintf("Creating work from issue: %s", issueIDStyle.Render(p.beadID))
+	beadInfo := fmt.Sprintf("Creating work from issue: %s", p.theme.IssueID.Render(p.beadID))
+	if len(p.additionalBeadIDs) > 0 {
+		beadInfo += p.theme.Dim.Render(fmt.Sprintf(" (+ %s)", strings.Join(p.additionalBeadIDs, ", ")))
+	}
 	content.WriteString(beadInfo)
 	content.WriteString("\n\n")
+	if p.err != "" {
+		content.WriteString(p.theme.Error.Render(p.theme.Icons.Failed + " " + p.err))
+		content.WriteString("\n\n")
+	}
 
 	// Mode toggle
 	var modeLabel string
 	if p.fieldIdx == 0 {
-		modeLabel = tuiSuccessStyle.Render("Branch mode:") + " " + tuiDimStyle.Render("(press Enter/Space to toggle)")
+		modeLabel = p.theme.Success.Render("Branch mode:") + " " + p.theme.Dim.Render("(press Enter/Space to toggle)")
 	} else {
-		modeLabel = tuiLabelStyle.Render("Branch mode:")
+		modeLabel = p.theme.Label.Render("Branch mode:")
 	}
 	content.WriteString(modeLabel)
 	content.WriteString("\n")
 
 	// Mode options
-	newBranchStyle := tuiDimStyle
-	existingBranchStyle := tuiDimStyle
+	newBranchStyle := p.theme.Dim
+	existingBranchStyle := p.theme.Dim
 	if !p.useExistingBranch {
-		newBranchStyle = tuiSelectedStyle
+		newBranchStyle = p.theme.Selected
 	} else {
-		existingBranchStyle = tuiSelectedStyle
+		existingBranchStyle = p.theme.Selected
 	}
 	content.WriteString("  " + newBranchStyle.Render("[New branch]") + "  " + existingBranchStyle.Render("[Existing branch]"))
 	content.WriteString("\n\n")
@@ -334,25 +599,25 @@ func (p *CreateWorkPanel) Render() string {
 		// Existing branch selector
 		var branchLabel string
 		if p.fieldIdx == 1 {
-			branchLabel = tuiSuccessStyle.Render("Select branch:") + " " + tuiDimStyle.Render("(type to filter, j/k to navigate)")
+			branchLabel = p.theme.Success.Render("Select branch:") + " " + p.theme.Dim.Render("(type to filter, j/k to navigate)")
 		} else {
-			branchLabel = tuiLabelStyle.Render("Select branch:")
+			branchLabel = p.theme.Label.Render("Select branch:")
 		}
 		content.WriteString(branchLabel)
 		content.WriteString("\n")
 
 		// Show filter if active
 		if p.branchFilter != "" {
-			content.WriteString(tuiDimStyle.Render("Filter: ") + p.branchFilter + tuiDimStyle.Render("_"))
+			content.WriteString(p.theme.Dim.Render("Filter: ") + p.branchFilter + p.theme.Dim.Render("_"))
 			content.WriteString("\n")
 		}
 
 		// Show branches
 		if len(p.filteredBranches) == 0 {
 			if len(p.branches) == 0 {
-				content.WriteString(tuiDimStyle.Render("  (loading branches...)"))
+				content.WriteString(p.theme.Dim.Render("  (loading branches...)"))
 			} else {
-				content.WriteString(tuiDimStyle.Render("  (no matching branches)"))
+				content.WriteString(p.theme.Dim.Render("  (no matching branches)"))
 			}
 			content.WriteString("\n")
 		} else {
@@ -364,34 +629,31 @@ func (p *CreateWorkPanel) Render() string {
 
 			// Show scroll indicator if needed
 			if p.branchScrollOffset > 0 {
-				content.WriteString(tuiDimStyle.Render("  ↑ (more above)"))
+				content.WriteString(p.theme.Dim.Render("  ↑ (more above)"))
 				content.WriteString("\n")
 			}
 
 			for i := p.branchScrollOffset; i < endIdx; i++ {
 				branch := p.filteredBranches[i]
 				prefix := "  "
-				style := tuiDimStyle
+				style := p.theme.Dim
 				if i == p.selectedBranchIdx {
 					prefix = "> "
 					if p.fieldIdx == 1 {
-						style = tuiSelectedStyle
+						style = p.theme.Selected
 					} else {
-						style = tuiLabelStyle
+						style = p.theme.Label
 					}
 				}
 				// Truncate long branch names
-				displayBranch := branch
-				if len(displayBranch) > 50 {
-					displayBranch = displayBranch[:47] + "..."
-				}
+				displayBranch := ansi.Truncate(branch, 50, "...")
 				content.WriteString(prefix + style.Render(displayBranch))
 				content.WriteString("\n")
 			}
 
 			// Show scroll indicator if needed
 			if endIdx < len(p.filteredBranches) {
-				content.WriteString(tuiDimStyle.Render("  ↓ (more below)"))
+				content.WriteString(p.theme.Dim.Render("  ↓ (more below)"))
 				content.WriteString("\n")
 			}
 		}
@@ -400,53 +662,65 @@ func (p *CreateWorkPanel) Render() string {
 		// New branch name input
 		var branchLabel string
 		if p.fieldIdx == 1 {
-			branchLabel = tuiSuccessStyle.Render("Branch name:") + " " + tuiDimStyle.Render("(editing)")
+			branchLabel = p.theme.Success.Render("Branch name:") + " " + p.theme.Dim.Render("(editing)")
 		} else {
-			branchLabel = tuiLabelStyle.Render("Branch name:")
+			branchLabel = p.theme.Label.Render("Branch name:")
 		}
 		content.WriteString(branchLabel)
 		content.WriteString("\n")
 		content.WriteString(p.branchInput.View())
-		content.WriteString("\n\n")
+		content.WriteString("\n")
+		content.WriteString(p.renderBranchCheck())
+		content.WriteString("\n")
+	}
+
+	// Base branch input
+	if p.fieldIdx == 2 {
+		content.WriteString(p.theme.Success.Render("Base branch:") + " " + p.theme.Dim.Render("(→ completes a branch name)"))
+	} else {
+		content.WriteString(p.theme.Label.Render("Base branch:"))
 	}
+	content.WriteString("\n")
+	content.WriteString(p.baseInput.View())
+	content.WriteString("\n\n")
 
 	// Action buttons
 	content.WriteString("Actions:\n")
 
 	// Execute button
-	executeStyle := tuiDimStyle
+	executeStyle := p.theme.Dim
 	executePrefix := "  "
-	if p.fieldIdx == 2 && p.buttonIdx == 0 {
-		executeStyle = tuiSelectedStyle
+	if p.fieldIdx == 3 && p.buttonIdx == 0 {
+		executeStyle = p.theme.Selected
 		executePrefix = "> "
 	} else if p.hoveredButton == "execute" {
-		executeStyle = tuiSuccessStyle
+		executeStyle = p.theme.Success
 	}
 	executeButtonText := executePrefix + "Execute"
 	content.WriteString("  " + zone.Mark("dialog-execute", executeStyle.Render(executeButtonText)))
 	content.WriteString(" - Create work and spawn orchestrator\n")
 
 	// Auto button
-	autoStyle := tuiDimStyle
+	autoStyle := p.theme.Dim
 	autoPrefix := "  "
-	if p.fieldIdx == 2 && p.buttonIdx == 1 {
-		autoStyle = tuiSelectedStyle
+	if p.fieldIdx == 3 && p.buttonIdx == 1 {
+		autoStyle = p.theme.Selected
 		autoPrefix = "> "
 	} else if p.hoveredButton == "auto" {
-		autoStyle = tuiSuccessStyle
+		autoStyle = p.theme.Success
 	}
 	autoButtonText := autoPrefix + "Auto"
 	content.WriteString("  " + zone.Mark("dialog-auto", autoStyle.Render(autoButtonText)))
 	content.WriteString(" - Create work with automated workflow\n")
 
 	// Cancel button
-	cancelStyle := tuiDimStyle
+	cancelStyle := p.theme.Dim
 	cancelPrefix := "  "
-	if p.fieldIdx == 2 && p.buttonIdx == 2 {
-		cancelStyle = tuiSelectedStyle
+	if p.fieldIdx == 3 && p.buttonIdx == 2 {
+		cancelStyle = p.theme.Selected
 		cancelPrefix = "> "
 	} else if p.hoveredButton == "cancel" {
-		cancelStyle = tuiSuccessStyle
+		cancelStyle = p.theme.Success
 	}
 	cancelButtonText := cancelPrefix + "Cancel"
 	content.WriteString("  " + zone.Mark("dialog-cancel", cancelStyle.Render(cancelButtonText)))
@@ -458,23 +732,90 @@ func (p *CreateWorkPanel) Render() string {
 	if p.useExistingBranch && p.fieldIdx == 1 {
 		helpText = "Navigation: [Tab/Shift+Tab] Switch field  [j/k] Navigate  [type] Filter  [Backspace] Clear filter  [Esc] Cancel"
 	} else {
-		helpText = "Navigation: [Tab/Shift+Tab] Switch field  [j/k] Select button  [Enter] Confirm  [Esc] Cancel"
+		helpText = "Navigation: [Tab/Shift+Tab] Switch field  [j/k] Select button  [Enter] Confirm  [ctrl+o] Import from file  [Esc] Cancel"
 	}
-	content.WriteString(tuiDimStyle.Render(helpText))
+	content.WriteString(p.theme.Dim.Render(helpText))
 
 	return content.String()
 }
 
+// renderImport renders the import-from-file form
+func (p *CreateWorkPanel) renderImport() string {
+	var content strings.Builder
+	content.WriteString("Import a work from a manifest written by 'co work export'.\n")
+	content.WriteString(p.theme.Dim.Render("Beads that are missing, closed or already in a work are left out."))
+	content.WriteString("\n\n")
+	content.WriteString(p.theme.Success.Render("Manifest file:") + " " + p.theme.Dim.Render("(relative to the project root)"))
+	content.WriteString("\n")
+	content.WriteString(p.manifestInput.View())
+	content.WriteString("\n\n")
+	content.WriteString(p.theme.Dim.Render("Navigation: [Enter] Import  [ctrl+o] Back to the form  [Esc] Cancel"))
+	return content.String()
+}
+
+// renderBranchCheck renders the validation result for the new branch name,
+// one line per problem
+func (p *CreateWorkPanel) renderBranchCheck() string {
+	check := p.branchCheck
+	if check == nil {
+		return ""
+	}
+	var lines []string
+	if check.Invalid != nil {
+		line := p.theme.Icons.Failed + " " + check.Invalid.Error()
+		if check.Name != "" {
+			line += "; will be created as " + check.Name
+		}
+		lines = append(lines, p.theme.Error.Render(line))
+	}
+	if check.Name == "" {
+		return strings.Join(lines, "\n") + "\n"
+	}
+
+	var where []string
+	if check.ExistsLocal {
+		where = append(where, "locally")
+	}
+	if check.ExistsRemote {
+		where = append(where, "on origin")
+	}
+	if len(where) > 0 {
+		lines = append(lines, p.theme.Error.Render(fmt.Sprintf("%s Branch %s already exists %s", p.theme.Icons.Failed, check.Name, strings.Join(where, " and "))))
+	}
+	if check.WorktreePath != "" {
+		lines = append(lines, p.theme.Error.Render(p.theme.Icons.Failed+" Checked out in worktree "+check.WorktreePath))
+	}
+	if check.WorkID != "" {
+		lines = append(lines, p.theme.Error.Render(p.theme.Icons.Failed+" Used by work "+check.WorkID))
+	}
+
+	if check.Taken() {
+		var options []string
+		if check.Suggestion != "" {
+			options = append(options, "[ctrl+s] Use "+check.Suggestion)
+		}
+		if check.CanAttach() {
+			options = append(options, "[ctrl+x] Attach to existing branch")
+		}
+		if len(options) > 0 {
+			lines = append(lines, p.theme.Dim.Render(strings.Join(options, "  ")))
+		}
+	} else if check.Invalid == nil {
+		lines = append(lines, p.theme.Success.Render(p.theme.Icons.Completed+" Branch name available"))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
 // RenderWithPanel returns the panel with border styling
 func (p *CreateWorkPanel) RenderWithPanel(contentHeight int) string {
 	panelContent := p.Render()
 
-	panelStyle := tuiPanelStyle.Width(p.width).Height(contentHeight - 2)
+	panelStyle := p.theme.Panel.Width(p.width).Height(contentHeight - 2)
 	if p.focused {
-		panelStyle = panelStyle.BorderForeground(lipgloss.Color("214"))
+		panelStyle = panelStyle.BorderForeground(p.theme.AccentColor)
 	}
 
-	result := panelStyle.Render(tuiTitleStyle.Render("Create Work") + "\n" + panelContent)
+	result := panelStyle.Render(p.theme.Title.Render("Create Work") + "\n" + panelContent)
 
 	// If the result is taller than expected (due to lipgloss wrapping), fix it
 	// by removing extra lines from the INNER content while preserving borders and title
@@ -3,17 +3,20 @@ package tui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/x/ansi"
-	"github.com/muesli/reflow/wordwrap"
+	"github.com/newhook/co/internal/db"
 )
 
 // Panel padding: tuiPanelStyle has Padding(0, 1) = 2 chars horizontal padding total
 
 // IssueDetailsPanel renders issue details for the focused bead.
 type IssueDetailsPanel struct {
+	theme *Theme
+
 	// Dimensions
 	width  int
 	height int
@@ -27,17 +30,23 @@ type IssueDetailsPanel struct {
 	// Data (set by coordinator)
 	focusedBead      *beadItem
 	hasActiveSession bool
+	planResumable    bool                 // The bead has a plan conversation to resume
 	childBeadMap     map[string]*beadItem // For looking up child status
+	commitCount      int                  // Commits on the assigned work's branch mentioning this bead
+	timeSpent        time.Duration        // Task run time this bead has taken
+	estimate         db.ComplexityEstimate
+	estimating       bool // An estimate of this bead is running
 }
 
 // NewIssueDetailsPanel creates a new IssueDetailsPanel
-func NewIssueDetailsPanel() *IssueDetailsPanel {
+func NewIssueDetailsPanel(theme *Theme) *IssueDetailsPanel {
 	vp := viewport.New(60, 20) // Initial size, will be updated
 	// Mouse wheel events are handled at the top level (planModel.handleMouseWheel)
 	// to ensure only the panel under the cursor scrolls
 	vp.MouseWheelEnabled = false
 
 	return &IssueDetailsPanel{
+		theme:        theme,
 		width:        60,
 		height:       20,
 		viewport:     vp,
@@ -88,6 +97,28 @@ func (p *IssueDetailsPanel) SetData(focusedBead *beadItem, hasActiveSession bool
 	}
 }
 
+// SetPlanResumable sets whether the focused bead's plan conversation can be resumed
+func (p *IssueDetailsPanel) SetPlanResumable(resumable bool) {
+	p.planResumable = resumable
+}
+
+// SetCommitCount sets the number of work branch commits attributed to the focused bead
+func (p *IssueDetailsPanel) SetCommitCount(n int) {
+	p.commitCount = n
+}
+
+// SetEstimate sets the focused bead's cached complexity estimate, zero when
+// it has none, and whether it's being estimated
+func (p *IssueDetailsPanel) SetEstimate(estimate db.ComplexityEstimate, estimating bool) {
+	p.estimate = estimate
+	p.estimating = estimating
+}
+
+// SetTimeSpent sets the task run time the focused bead has taken
+func (p *IssueDetailsPanel) SetTimeSpent(d time.Duration) {
+	p.timeSpent = d
+}
+
 // ScrollUp scrolls the content up (shows earlier content)
 func (p *IssueDetailsPanel) ScrollUp() {
 	p.viewport.ScrollUp(1)
@@ -127,18 +158,18 @@ func (p *IssueDetailsPanel) Render() string {
 func (p *IssueDetailsPanel) RenderWithPanel(contentHeight int) string {
 	detailsContent := p.Render()
 
-	panelStyle := tuiPanelStyle.Width(p.width).Height(contentHeight - 2)
+	panelStyle := p.theme.Panel.Width(p.width).Height(contentHeight - 2)
 	if p.focused {
-		panelStyle = panelStyle.BorderForeground(lipgloss.Color("214"))
+		panelStyle = panelStyle.BorderForeground(p.theme.AccentColor)
 	}
 
-	return panelStyle.Render(tuiTitleStyle.Render("Details") + "\n" + detailsContent)
+	return panelStyle.Render(p.theme.Title.Render("Details") + "\n" + detailsContent)
 }
 
 // renderFullIssueContent renders all content without line limits
 func (p *IssueDetailsPanel) renderFullIssueContent() string {
 	if p.focusedBead == nil {
-		return tuiDimStyle.Render("No issue selected")
+		return p.theme.Dim.Render("No issue selected")
 	}
 
 	var content strings.Builder
@@ -149,24 +180,30 @@ func (p *IssueDetailsPanel) renderFullIssueContent() string {
 
 	// Build header line - may need truncation to fit
 	var header strings.Builder
-	header.WriteString(tuiLabelStyle.Render("ID: "))
-	header.WriteString(tuiValueStyle.Render(bead.ID))
+	header.WriteString(p.theme.Label.Render("ID: "))
+	header.WriteString(p.theme.Value.Render(bead.ID))
 	header.WriteString("  ")
-	header.WriteString(tuiLabelStyle.Render("Type: "))
-	header.WriteString(tuiValueStyle.Render(bead.Type))
+	header.WriteString(p.theme.Label.Render("Type: "))
+	header.WriteString(p.theme.Value.Render(bead.Type))
 	header.WriteString("  ")
-	header.WriteString(tuiLabelStyle.Render("P"))
-	header.WriteString(tuiValueStyle.Render(fmt.Sprintf("%d", bead.Priority)))
+	header.WriteString(p.theme.Label.Render("P"))
+	header.WriteString(p.theme.Value.Render(fmt.Sprintf("%d", bead.Priority)))
 	header.WriteString("  ")
-	header.WriteString(tuiLabelStyle.Render("Status: "))
-	header.WriteString(tuiValueStyle.Render(bead.Status))
+	header.WriteString(p.theme.Label.Render("Status: "))
+	header.WriteString(p.theme.Value.Render(bead.Status))
 	if p.hasActiveSession {
 		header.WriteString("  ")
-		header.WriteString(tuiSuccessStyle.Render("[Session Active]"))
+		header.WriteString(p.theme.Success.Render("[Session Active]"))
+	} else if p.planResumable {
+		header.WriteString("  ")
+		header.WriteString(p.theme.Dim.Render("[Plan Resumable]"))
 	}
 	if bead.assignedWorkID != "" {
 		header.WriteString("  ")
-		header.WriteString(tuiDimStyle.Render("Work: " + bead.assignedWorkID))
+		header.WriteString(p.theme.Dim.Render("Work: " + bead.assignedWorkID))
+		if commits := formatCommitCount(p.commitCount); commits != "" {
+			header.WriteString(p.theme.Dim.Render(" · " + commits))
+		}
 	}
 
 	// Truncate header to fit inner width
@@ -182,31 +219,62 @@ func (p *IssueDetailsPanel) renderFullIssueContent() string {
 	if lipgloss.Width(titleStr) > innerWidth {
 		titleStr = ansi.Truncate(titleStr, innerWidth, "...")
 	}
-	content.WriteString(tuiValueStyle.Render(titleStr))
+	content.WriteString(p.theme.Value.Render(titleStr))
+
+	if len(bead.Labels) > 0 {
+		labelsStr := "Labels: " + strings.Join(bead.Labels, ", ")
+		if lipgloss.Width(labelsStr) > innerWidth {
+			labelsStr = ansi.Truncate(labelsStr, innerWidth, "...")
+		}
+		content.WriteString("\n")
+		content.WriteString(p.theme.Dim.Render(labelsStr))
+	}
+
+	if spent := formatBeadTime(p.timeSpent); spent != "" {
+		content.WriteString("\n")
+		content.WriteString(p.theme.Label.Render("Time: "))
+		content.WriteString(p.theme.Value.Render(spent))
+		content.WriteString(p.theme.Dim.Render(" across task runs"))
+	}
+
+	switch {
+	case p.estimating:
+		content.WriteString("\n")
+		content.WriteString(p.theme.Label.Render("Estimate: "))
+		content.WriteString(p.theme.Dim.Render("estimating..."))
+	case p.estimate.Score > 0:
+		content.WriteString("\n")
+		content.WriteString(p.theme.Label.Render("Estimate: "))
+		content.WriteString(p.theme.Value.Render(fmt.Sprintf("complexity %d/10", p.estimate.Score)))
+		content.WriteString(p.theme.Dim.Render(" · " + formatEstimateTokens(p.estimate.Tokens)))
+		if p.estimate.Stale(bead.Title, bead.Description) {
+			content.WriteString(p.theme.Dim.Render(" (stale: issue changed, $ re-estimates)"))
+		}
+	}
 
 	// Show full description
 	if bead.Description != "" {
 		content.WriteString("\n\n")
 		// Word wrap description to fit within inner width
-		wrapped := wordwrap.String(bead.Description, innerWidth)
-		content.WriteString(tuiDimStyle.Render(wrapped))
+		wrapped := wrapText(bead.Description, innerWidth)
+		content.WriteString(p.theme.Dim.Render(wrapped))
 	}
 
 	// Show all children (issues blocked by this one)
 	if len(bead.children) > 0 {
 		content.WriteString("\n\n")
-		content.WriteString(tuiLabelStyle.Render("Blocks:"))
+		content.WriteString(p.theme.Label.Render("Blocks:"))
 
 		// Show all children with status
 		for _, childID := range bead.children {
 			var childLine string
 			if child, ok := p.childBeadMap[childID]; ok {
 				childLine = fmt.Sprintf("\n  %s %s %s",
-					statusIcon(child.Status),
-					issueIDStyle.Render(child.ID),
+					p.theme.statusIcon(child.Status),
+					p.theme.IssueID.Render(child.ID),
 					child.Title)
 			} else {
-				childLine = fmt.Sprintf("\n  ? %s", issueIDStyle.Render(childID))
+				childLine = fmt.Sprintf("\n  ? %s", p.theme.IssueID.Render(childID))
 			}
 			// Truncate to fit inner width
 			if lipgloss.Width(childLine)-1 > innerWidth {
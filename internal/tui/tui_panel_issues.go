@@ -4,15 +4,20 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/x/ansi"
 	zone "github.com/lrstanley/bubblezone"
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/db"
 )
 
 // IssuesPanel renders the issues list with filtering, tree structure, and selection.
 type IssuesPanel struct {
+	theme *Theme
+
 	// Dimensions
 	width  int
 	height int
@@ -27,6 +32,10 @@ type IssuesPanel struct {
 	expanded       bool
 	selectedBeads  map[string]bool
 	activeSessions map[string]bool
+	resumablePlans map[string]bool // Beads whose plan conversation can be resumed
+	estimates      map[string]db.ComplexityEstimate
+	estimating     map[string]bool // Beads with an estimate running
+	counts         beadCounts      // Issues per status filter
 	newBeads       map[string]time.Time
 	hoveredIssue   int
 
@@ -38,8 +47,9 @@ type IssuesPanel struct {
 }
 
 // NewIssuesPanel creates a new IssuesPanel
-func NewIssuesPanel() *IssuesPanel {
+func NewIssuesPanel(theme *Theme) *IssuesPanel {
 	return &IssuesPanel{
+		theme:          theme,
 		width:          40,
 		height:         20,
 		selectedBeads:  make(map[string]bool),
@@ -85,6 +95,40 @@ func (p *IssuesPanel) SetData(
 	p.newBeads = newBeads
 }
 
+// SetResumablePlans sets the beads with a plan conversation to resume
+func (p *IssuesPanel) SetResumablePlans(resumable map[string]bool) {
+	p.resumablePlans = resumable
+}
+
+// SetEstimates sets the complexity estimates shown in the expanded view, and
+// the beads being estimated
+func (p *IssuesPanel) SetEstimates(estimates map[string]db.ComplexityEstimate, estimating map[string]bool) {
+	p.estimates = estimates
+	p.estimating = estimating
+}
+
+// estimateTag returns the complexity shown after a bead's type in the
+// expanded view: " ~5" for a score of 5, " ~5?" once the bead has changed
+// since it was estimated, and " ~…" while it's being estimated.
+func (p *IssuesPanel) estimateTag(bead *beadItem) string {
+	if p.estimating[bead.ID] {
+		return " ~…"
+	}
+	estimate, ok := p.estimates[bead.ID]
+	if !ok {
+		return ""
+	}
+	if estimate.Stale(bead.Title, bead.Description) {
+		return fmt.Sprintf(" ~%d?", estimate.Score)
+	}
+	return fmt.Sprintf(" ~%d", estimate.Score)
+}
+
+// SetCounts sets how many issues each status filter shows
+func (p *IssuesPanel) SetCounts(counts beadCounts) {
+	p.counts = counts
+}
+
 // SetWorkContext updates work-related display state
 func (p *IssuesPanel) SetWorkContext(focusedWorkID string) {
 	p.focusedWorkID = focusedWorkID
@@ -103,6 +147,7 @@ func (p *IssuesPanel) GetHoveredIssue() int {
 // Render returns the issues panel content (without border/panel styling)
 func (p *IssuesPanel) Render(visibleLines int) string {
 	var filterInfo string
+	var statusLine string
 
 	// When task or children filter is active, show simplified filter info
 	// (status filter is not applied in these modes)
@@ -117,8 +162,9 @@ func (p *IssuesPanel) Render(visibleLines int) string {
 			filterInfo += fmt.Sprintf(" | Search: %s", p.filters.searchText)
 		}
 	} else {
-		// Normal filter display
-		filterInfo = fmt.Sprintf("Filter: %s | Sort: %s", p.filters.status, p.filters.sortBy)
+		// Normal filter display, led by the count behind each status filter
+		statusLine = p.renderStatusCounts()
+		filterInfo = fmt.Sprintf(" | Sort: %s", p.filters.sortBy)
 		if p.filters.searchText != "" {
 			filterInfo += fmt.Sprintf(" | Search: %s", p.filters.searchText)
 		}
@@ -128,11 +174,12 @@ func (p *IssuesPanel) Render(visibleLines int) string {
 	}
 
 	var content strings.Builder
-	content.WriteString(tuiDimStyle.Render(filterInfo))
+	content.WriteString(statusLine)
+	content.WriteString(p.theme.Dim.Render(filterInfo))
 	content.WriteString("\n")
 
 	if len(p.beadItems) == 0 {
-		content.WriteString(tuiDimStyle.Render("No issues found"))
+		content.WriteString(p.theme.Dim.Render("No issues found"))
 	} else {
 		visibleItems := max(visibleLines-1, 1) // -1 for filter line
 
@@ -155,6 +202,39 @@ func (p *IssuesPanel) Render(visibleLines int) string {
 	return content.String()
 }
 
+// renderStatusCounts renders the issue count of each status filter, like
+// "open 42 | ready 7 | closed 188", with the active filter highlighted. A
+// filter without a bucket of its own, such as all, leads the line.
+func (p *IssuesPanel) renderStatusCounts() string {
+	buckets := []struct {
+		status string
+		count  int
+	}{
+		{beads.StatusOpen, p.counts.open},
+		{"ready", p.counts.ready},
+		{beads.StatusClosed, p.counts.closed},
+	}
+	var parts []string
+	active := false
+	for _, b := range buckets {
+		text := fmt.Sprintf("%s %d", b.status, b.count)
+		if b.status == p.filters.status {
+			parts = append(parts, p.theme.Hotkey.Render(text))
+			active = true
+		} else {
+			parts = append(parts, p.theme.Dim.Render(text))
+		}
+	}
+	if !active {
+		text := p.filters.status
+		if text == "" || text == "all" {
+			text = fmt.Sprintf("all %d", p.counts.open+p.counts.closed)
+		}
+		parts = append([]string{p.theme.Hotkey.Render(text)}, parts...)
+	}
+	return strings.Join(parts, p.theme.Dim.Render(" | "))
+}
+
 // RenderWithPanel returns the issues panel with border styling
 func (p *IssuesPanel) RenderWithPanel(contentHeight int) string {
 	issuesContentLines := contentHeight - 3 // -3 for border (2) + title (1)
@@ -163,12 +243,12 @@ func (p *IssuesPanel) RenderWithPanel(contentHeight int) string {
 	// Ensure content is exactly the right number of lines to prevent layout overflow
 	issuesContent = padOrTruncateLinesIssues(issuesContent, issuesContentLines)
 
-	panelStyle := tuiPanelStyle.Width(p.width).Height(contentHeight - 2)
+	panelStyle := p.theme.Panel.Width(p.width).Height(contentHeight - 2)
 	if p.focused {
-		panelStyle = panelStyle.BorderForeground(lipgloss.Color("214"))
+		panelStyle = panelStyle.BorderForeground(p.theme.AccentColor)
 	}
 
-	result := panelStyle.Render(tuiTitleStyle.Render("Issues") + "\n" + issuesContent)
+	result := panelStyle.Render(p.theme.Title.Render("Issues") + "\n" + issuesContent)
 
 	// If the result is taller than expected (due to lipgloss wrapping), fix it
 	// by removing extra lines from the INNER content while preserving borders and title
@@ -230,83 +310,89 @@ func padOrTruncateLinesIssues(content string, targetLines int) string {
 
 // renderBeadLine renders a single bead line
 func (p *IssuesPanel) renderBeadLine(i int, bead beadItem) string {
-	icon := statusIcon(bead.Status)
+	icon := p.theme.statusIcon(bead.Status)
 
 	// Selection indicator for multi-select
 	var selectionIndicator string
 	if p.selectedBeads[bead.ID] {
-		selectionIndicator = tuiSelectedCheckStyle.Render("●") + " "
+		selectionIndicator = p.theme.SelectedCheck.Render(p.theme.Icons.Selected) + " "
 	}
 
-	// Session indicator - compact "P" (processing) shown after status icon
+	// Session indicator - compact "P" (processing) shown after status icon,
+	// or a dim "p" when the plan session has ended but can be resumed
 	var sessionIndicator string
 	if p.activeSessions[bead.ID] {
-		sessionIndicator = tuiSuccessStyle.Render("P")
+		sessionIndicator = p.theme.Success.Render("P")
+	} else if p.resumablePlans[bead.ID] {
+		sessionIndicator = p.theme.Dim.Render("p")
 	}
 
 	// Work assignment indicator
 	var workIndicator string
 	if bead.assignedWorkID != "" {
-		workIndicator = tuiDimStyle.Render("["+bead.assignedWorkID+"]") + " "
+		workIndicator = p.theme.Dim.Render("["+bead.assignedWorkID+"]") + " "
 	}
 
 	// Tree indentation with connector lines (styled dim)
 	var treePrefix string
 	if bead.treeDepth > 0 && bead.treePrefixPattern != "" {
-		treePrefix = issueTreeStyle.Render(bead.treePrefixPattern)
+		treePrefix = p.theme.IssueTree.Render(bead.treePrefixPattern)
 	}
 
-	// Styled issue ID
-	styledID := issueIDStyle.Render(bead.ID)
+	// Styled issue ID, with the characters a search matched highlighted
+	styledID := p.theme.highlightMatches(bead.ID, bead.idMatches, p.theme.IssueID)
 
 	// Short type indicator with color
 	var styledType string
 	switch bead.Type {
 	case "task":
-		styledType = typeTaskStyle.Render("T")
+		styledType = p.theme.TypeTask.Render("T")
 	case "bug":
-		styledType = typeBugStyle.Render("B")
+		styledType = p.theme.TypeBug.Render("B")
 	case "feature":
-		styledType = typeFeatureStyle.Render("F")
+		styledType = p.theme.TypeFeature.Render("F")
 	case "epic":
-		styledType = typeEpicStyle.Render("E")
+		styledType = p.theme.TypeEpic.Render("E")
 	case "chore":
-		styledType = typeChoreStyle.Render("C")
+		styledType = p.theme.TypeChore.Render("C")
 	case "merge-request":
-		styledType = typeDefaultStyle.Render("M")
+		styledType = p.theme.TypeDefault.Render("M")
 	case "molecule":
-		styledType = typeDefaultStyle.Render("m")
+		styledType = p.theme.TypeDefault.Render("m")
 	case "gate":
-		styledType = typeDefaultStyle.Render("G")
+		styledType = p.theme.TypeDefault.Render("G")
 	case "agent":
-		styledType = typeDefaultStyle.Render("A")
+		styledType = p.theme.TypeDefault.Render("A")
 	case "role":
-		styledType = typeDefaultStyle.Render("R")
+		styledType = p.theme.TypeDefault.Render("R")
 	case "rig":
-		styledType = typeDefaultStyle.Render("r")
+		styledType = p.theme.TypeDefault.Render("r")
 	case "convoy":
-		styledType = typeDefaultStyle.Render("c")
+		styledType = p.theme.TypeDefault.Render("c")
 	case "event":
-		styledType = typeDefaultStyle.Render("v")
+		styledType = p.theme.TypeDefault.Render("v")
 	default:
-		styledType = typeDefaultStyle.Render("?")
+		styledType = p.theme.TypeDefault.Render("?")
 	}
 
 	// Calculate available width and truncate title if needed
 	availableWidth := p.width - 4 // Account for panel padding/borders
 
 	// Calculate prefix length for normal display
+	iconWidth := ansi.StringWidth(p.theme.statusGlyph(bead.Status))
 	var prefixLen int
+	var estimateTag string
 	if p.expanded {
-		prefixLen = 3 + len(bead.ID) + 1 + 3 + len(bead.Type) + 3 // icon + ID + space + [P# type] + spaces
+		estimateTag = p.estimateTag(&bead)
+		prefixLen = iconWidth + 2 + ansi.StringWidth(bead.ID) + 1 + 3 + ansi.StringWidth(bead.Type) + ansi.StringWidth(estimateTag) + 3 // icon + ID + space + [P# type ~N] + spaces
 	} else {
-		prefixLen = 3 + len(bead.ID) + 3 // icon + ID + type letter + spaces
+		prefixLen = iconWidth + 2 + ansi.StringWidth(bead.ID) + 3 // icon + ID + type letter + spaces
 	}
 	if bead.assignedWorkID != "" {
-		prefixLen += len(bead.assignedWorkID) + 3 // [work-id] + space
+		prefixLen += ansi.StringWidth(bead.assignedWorkID) + 3 // [work-id] + space
 	}
 	if bead.treeDepth > 0 {
-		prefixLen += len(bead.treePrefixPattern)
+		prefixLen += ansi.StringWidth(bead.treePrefixPattern)
 	}
 
 	// Truncate title to fit on one line
@@ -316,13 +402,22 @@ func (p *IssuesPanel) renderBeadLine(i int, bead beadItem) string {
 		maxTitleLen = 10
 	}
 	title = ansi.Truncate(title, maxTitleLen, "...")
+	styledTitle := title
+	if len(bead.titleMatches) > 0 {
+		// Only highlight the part of the title left after truncation
+		shown := utf8.RuneCountInString(title)
+		if title != bead.Title {
+			shown -= 3
+		}
+		styledTitle = p.theme.highlightMatches(title, clipPositions(bead.titleMatches, shown), lipgloss.NewStyle())
+	}
 
 	// Build styled line for normal display
 	var line string
 	if p.expanded {
-		line = fmt.Sprintf("%s%s%s%s %s [P%d %s] %s%s", selectionIndicator, treePrefix, workIndicator, icon, styledID, bead.Priority, bead.Type, sessionIndicator, title)
+		line = fmt.Sprintf("%s%s%s%s %s [P%d %s%s] %s%s", selectionIndicator, treePrefix, workIndicator, icon, styledID, bead.Priority, bead.Type, estimateTag, sessionIndicator, styledTitle)
 	} else {
-		line = fmt.Sprintf("%s%s%s%s %s %s%s %s", selectionIndicator, treePrefix, workIndicator, icon, styledID, styledType, sessionIndicator, title)
+		line = fmt.Sprintf("%s%s%s%s %s %s%s %s", selectionIndicator, treePrefix, workIndicator, icon, styledID, styledType, sessionIndicator, styledTitle)
 	}
 
 	// For selected/hovered lines, build plain text version to avoid ANSI code conflicts
@@ -347,13 +442,15 @@ func (p *IssuesPanel) renderBeadLine(i int, bead beadItem) string {
 		// Build selection indicator (plain text)
 		var plainSelectionIndicator string
 		if p.selectedBeads[bead.ID] {
-			plainSelectionIndicator = "● "
+			plainSelectionIndicator = p.theme.Icons.Selected + " "
 		}
 
 		// Build session indicator (plain text)
 		var plainSessionIndicator string
 		if p.activeSessions[bead.ID] {
 			plainSessionIndicator = "P"
+		} else if p.resumablePlans[bead.ID] {
+			plainSessionIndicator = "p"
 		}
 
 		// Build work indicator (plain text)
@@ -371,9 +468,9 @@ func (p *IssuesPanel) renderBeadLine(i int, bead beadItem) string {
 		// Build plain text line without any styling
 		var plainLine string
 		if p.expanded {
-			plainLine = fmt.Sprintf("%s%s%s%s %s [P%d %s] %s%s", plainSelectionIndicator, plainTreePrefix, plainWorkIndicator, icon, bead.ID, bead.Priority, bead.Type, plainSessionIndicator, title)
+			plainLine = fmt.Sprintf("%s%s%s%s %s [P%d %s%s] %s%s", plainSelectionIndicator, plainTreePrefix, plainWorkIndicator, p.theme.statusGlyph(bead.Status), bead.ID, bead.Priority, bead.Type, estimateTag, plainSessionIndicator, title)
 		} else {
-			plainLine = fmt.Sprintf("%s%s%s%s %s %s%s %s", plainSelectionIndicator, plainTreePrefix, plainWorkIndicator, icon, bead.ID, typeLetter, plainSessionIndicator, title)
+			plainLine = fmt.Sprintf("%s%s%s%s %s %s%s %s", plainSelectionIndicator, plainTreePrefix, plainWorkIndicator, p.theme.statusGlyph(bead.Status), bead.ID, typeLetter, plainSessionIndicator, title)
 		}
 
 		// Pad to fill width
@@ -385,42 +482,30 @@ func (p *IssuesPanel) renderBeadLine(i int, bead beadItem) string {
 		if i == p.cursor {
 			// Use yellow background for newly created beads
 			if _, isNew := p.newBeads[bead.ID]; isNew {
-				newSelectedStyle := lipgloss.NewStyle().
-					Bold(true).
-					Foreground(lipgloss.Color("0")).
-					Background(lipgloss.Color("226"))
-				return newSelectedStyle.Render(plainLine)
+				return p.theme.NewBeadSelected.Render(plainLine)
 			}
-			return tuiSelectedStyle.Render(plainLine)
+			return p.theme.Selected.Render(plainLine)
 		}
 
 		// Hover style
 		if _, isNew := p.newBeads[bead.ID]; isNew {
-			newHoverStyle := lipgloss.NewStyle().
-				Foreground(lipgloss.Color("0")).
-				Background(lipgloss.Color("228")).
-				Bold(true)
-			return newHoverStyle.Render(plainLine)
+			return p.theme.NewBeadHover.Render(plainLine)
 		}
-		hoverStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("255")).
-			Background(lipgloss.Color("240")).
-			Bold(true)
-		return hoverStyle.Render(plainLine)
+		return p.theme.Hover.Render(plainLine)
 	}
 
 	// Style closed parent beads with dim style
 	if bead.isClosedParent {
-		return tuiDimStyle.Render(line)
+		return p.theme.Dim.Render(line)
 	}
 
 	// Style new beads - apply yellow only to the title
 	if _, isNew := p.newBeads[bead.ID]; isNew {
-		yellowTitle := tuiNewBeadStyle.Render(title)
+		yellowTitle := p.theme.NewBead.Render(title)
 
 		var newLine string
 		if p.expanded {
-			newLine = fmt.Sprintf("%s%s%s%s %s [P%d %s] %s%s", selectionIndicator, treePrefix, workIndicator, icon, styledID, bead.Priority, bead.Type, sessionIndicator, yellowTitle)
+			newLine = fmt.Sprintf("%s%s%s%s %s [P%d %s%s] %s%s", selectionIndicator, treePrefix, workIndicator, icon, styledID, bead.Priority, bead.Type, estimateTag, sessionIndicator, yellowTitle)
 		} else {
 			newLine = fmt.Sprintf("%s%s%s%s %s %s%s %s", selectionIndicator, treePrefix, workIndicator, icon, styledID, styledType, sessionIndicator, yellowTitle)
 		}
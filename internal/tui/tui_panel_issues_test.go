@@ -0,0 +1,50 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/beads"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssuesPanelStatusCounts(t *testing.T) {
+	p := NewIssuesPanel(DarkTheme())
+	p.SetCounts(beadCounts{open: 42, ready: 7, closed: 188})
+
+	p.filters = beadFilters{status: "ready", sortBy: "default"}
+	require.Contains(t, p.renderStatusCounts(), p.theme.Hotkey.Render("ready 7"))
+	require.Contains(t, ansi.Strip(p.Render(5)), "open 42 | ready 7 | closed 188 | Sort: default")
+
+	// A filter without a bucket of its own leads the line
+	p.filters.status = "all"
+	require.Equal(t, "all 230 | open 42 | ready 7 | closed 188", ansi.Strip(p.renderStatusCounts()))
+	p.filters.status = beads.StatusInProgress
+	require.Equal(t, "in_progress | open 42 | ready 7 | closed 188", ansi.Strip(p.renderStatusCounts()))
+}
+
+func TestMatchesStatusFilter(t *testing.T) {
+	open := beads.Bead{ID: "bead-1", Status: beads.StatusOpen}
+	inProgress := beads.Bead{ID: "bead-2", Status: beads.StatusInProgress}
+	closed := beads.Bead{ID: "bead-3", Status: beads.StatusClosed}
+	ready := map[string]bool{"bead-1": true}
+
+	for _, tc := range []struct {
+		status string
+		want   []bool // open, in progress, closed
+	}{
+		{"", []bool{true, true, true}},
+		{"all", []bool{true, true, true}},
+		{beads.StatusOpen, []bool{true, true, false}},
+		{"ready", []bool{true, false, false}},
+		{beads.StatusClosed, []bool{false, false, true}},
+		{beads.StatusInProgress, []bool{false, true, false}},
+	} {
+		got := []bool{
+			matchesStatusFilter(open, tc.status, ready),
+			matchesStatusFilter(inProgress, tc.status, ready),
+			matchesStatusFilter(closed, tc.status, ready),
+		}
+		require.Equal(t, tc.want, got, tc.status)
+	}
+}
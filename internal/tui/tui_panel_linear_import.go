@@ -30,6 +30,8 @@ type LinearImportResult struct {
 
 // LinearImportPanel renders the Linear import form.
 type LinearImportPanel struct {
+	theme *Theme
+
 	// Dimensions
 	width  int
 	height int
@@ -51,7 +53,7 @@ type LinearImportPanel struct {
 }
 
 // NewLinearImportPanel creates a new LinearImportPanel
-func NewLinearImportPanel() *LinearImportPanel {
+func NewLinearImportPanel(theme *Theme) *LinearImportPanel {
 	input := textarea.New()
 	input.Placeholder = "Enter Linear issue IDs or URLs (one per line)..."
 	input.CharLimit = 2000
@@ -59,6 +61,7 @@ func NewLinearImportPanel() *LinearImportPanel {
 	input.SetHeight(4)
 
 	return &LinearImportPanel{
+		theme:    theme,
 		width:    60,
 		height:   20,
 		maxDepth: 2,
@@ -263,19 +266,19 @@ func (p *LinearImportPanel) Render() string {
 	maxDepthLabel := "Max Dependency Depth:"
 
 	if p.focusIdx == 0 {
-		issueIDsLabel = tuiValueStyle.Render("Issue IDs/URLs:") + " (one per line, Ctrl+Enter to submit)"
+		issueIDsLabel = p.theme.Value.Render("Issue IDs/URLs:") + " (one per line, Ctrl+Enter to submit)"
 	}
 	if p.focusIdx == 1 {
-		createDepsLabel = tuiValueStyle.Render("Create Dependencies:") + " (space to toggle)"
+		createDepsLabel = p.theme.Value.Render("Create Dependencies:") + " (space to toggle)"
 	}
 	if p.focusIdx == 2 {
-		updateLabel = tuiValueStyle.Render("Update Existing:") + " (space to toggle)"
+		updateLabel = p.theme.Value.Render("Update Existing:") + " (space to toggle)"
 	}
 	if p.focusIdx == 3 {
-		dryRunLabel = tuiValueStyle.Render("Dry Run:") + " (space to toggle)"
+		dryRunLabel = p.theme.Value.Render("Dry Run:") + " (space to toggle)"
 	}
 	if p.focusIdx == 4 {
-		maxDepthLabel = tuiValueStyle.Render("Max Dependency Depth:") + " (+/- adjust)"
+		maxDepthLabel = p.theme.Value.Render("Max Dependency Depth:") + " (+/- adjust)"
 	}
 
 	// Checkbox display
@@ -292,7 +295,7 @@ func (p *LinearImportPanel) Render() string {
 		dryRunCheck = "x"
 	}
 
-	content.WriteString(tuiLabelStyle.Render("Import from Linear (Bulk)"))
+	content.WriteString(p.theme.Label.Render("Import from Linear (Bulk)"))
 	content.WriteString("\n\n")
 	content.WriteString(issueIDsLabel)
 	content.WriteString("\n")
@@ -304,7 +307,7 @@ func (p *LinearImportPanel) Render() string {
 	content.WriteString("\n")
 	content.WriteString(dryRunLabel + " [" + dryRunCheck + "]")
 	content.WriteString("\n\n")
-	content.WriteString(maxDepthLabel + " " + tuiValueStyle.Render(fmt.Sprintf("%d", p.maxDepth)))
+	content.WriteString(maxDepthLabel + " " + p.theme.Value.Render(fmt.Sprintf("%d", p.maxDepth)))
 	content.WriteString("\n\n")
 
 	// Render Ok and Cancel buttons
@@ -313,26 +316,26 @@ func (p *LinearImportPanel) Render() string {
 	focusHint := ""
 
 	if p.focusIdx == 5 {
-		okLabel = tuiValueStyle.Render("[ Ok ]")
-		focusHint = tuiDimStyle.Render(" (press Enter to import)")
+		okLabel = p.theme.Value.Render("[ Ok ]")
+		focusHint = p.theme.Dim.Render(" (press Enter to import)")
 	} else {
-		okLabel = styleButtonWithHover("  Ok  ", p.hoveredButton == "ok")
+		okLabel = p.theme.styleButtonWithHover("  Ok  ", p.hoveredButton == "ok")
 	}
 
 	if p.focusIdx == 6 {
-		cancelLabel = tuiValueStyle.Render("[Cancel]")
-		focusHint = tuiDimStyle.Render(" (press Enter to cancel)")
+		cancelLabel = p.theme.Value.Render("[Cancel]")
+		focusHint = p.theme.Dim.Render(" (press Enter to cancel)")
 	} else {
-		cancelLabel = styleButtonWithHover("Cancel", p.hoveredButton == "cancel")
+		cancelLabel = p.theme.styleButtonWithHover("Cancel", p.hoveredButton == "cancel")
 	}
 
 	content.WriteString(zone.Mark("dialog-ok", okLabel) + "  " + zone.Mark("dialog-cancel", cancelLabel) + focusHint)
 	content.WriteString("\n")
 
 	if p.importing {
-		content.WriteString(tuiDimStyle.Render("Importing..."))
+		content.WriteString(p.theme.Dim.Render("Importing..."))
 	} else {
-		content.WriteString(tuiDimStyle.Render("[Tab] Next field  [Enter] Activate"))
+		content.WriteString(p.theme.Dim.Render("[Tab] Next field  [Enter] Activate"))
 	}
 
 	return content.String()
@@ -342,12 +345,12 @@ func (p *LinearImportPanel) Render() string {
 func (p *LinearImportPanel) RenderWithPanel(contentHeight int) string {
 	panelContent := p.Render()
 
-	panelStyle := tuiPanelStyle.Width(p.width).Height(contentHeight - 2)
+	panelStyle := p.theme.Panel.Width(p.width).Height(contentHeight - 2)
 	if p.focused {
-		panelStyle = panelStyle.BorderForeground(lipgloss.Color("214"))
+		panelStyle = panelStyle.BorderForeground(p.theme.AccentColor)
 	}
 
-	result := panelStyle.Render(tuiTitleStyle.Render("Linear Import") + "\n" + panelContent)
+	result := panelStyle.Render(p.theme.Title.Render("Linear Import") + "\n" + panelContent)
 
 	// If the result is taller than expected (due to lipgloss wrapping), fix it
 	// by removing extra lines from the INNER content while preserving borders and title
@@ -28,6 +28,8 @@ type PRImportResult struct {
 
 // PRImportPanel renders the PR import form.
 type PRImportPanel struct {
+	theme *Theme
+
 	// Dimensions
 	width  int
 	height int
@@ -50,13 +52,14 @@ type PRImportPanel struct {
 }
 
 // NewPRImportPanel creates a new PRImportPanel
-func NewPRImportPanel() *PRImportPanel {
+func NewPRImportPanel(theme *Theme) *PRImportPanel {
 	input := textinput.New()
 	input.Placeholder = "https://github.com/owner/repo/pull/123"
 	input.CharLimit = 500
 	input.Width = 60
 
 	return &PRImportPanel{
+		theme:  theme,
 		width:  60,
 		height: 20,
 		input:  input,
@@ -219,13 +222,13 @@ func (p *PRImportPanel) Render() string {
 	prURLLabel := "PR URL:"
 	if p.focusIdx == 0 {
 		if p.prMetadata != nil {
-			prURLLabel = tuiValueStyle.Render("PR URL:") + " (Enter to import)"
+			prURLLabel = p.theme.Value.Render("PR URL:") + " (Enter to import)"
 		} else {
-			prURLLabel = tuiValueStyle.Render("PR URL:") + " (Enter to load preview)"
+			prURLLabel = p.theme.Value.Render("PR URL:") + " (Enter to load preview)"
 		}
 	}
 
-	content.WriteString(tuiLabelStyle.Render("Import from GitHub PR"))
+	content.WriteString(p.theme.Label.Render("Import from GitHub PR"))
 	content.WriteString("\n\n")
 	content.WriteString(prURLLabel)
 	content.WriteString("\n")
@@ -234,17 +237,17 @@ func (p *PRImportPanel) Render() string {
 
 	// Show PR preview if available
 	if p.previewing {
-		content.WriteString(tuiDimStyle.Render("Loading PR details..."))
+		content.WriteString(p.theme.Dim.Render("Loading PR details..."))
 		content.WriteString("\n\n")
 	} else if p.previewErr != nil {
-		content.WriteString(tuiErrorStyle.Render(fmt.Sprintf("Error: %v", p.previewErr)))
+		content.WriteString(p.theme.Error.Render(fmt.Sprintf("Error: %v", p.previewErr)))
 		content.WriteString("\n\n")
 	} else if p.prMetadata != nil {
-		content.WriteString(tuiLabelStyle.Render("PR Preview:"))
+		content.WriteString(p.theme.Label.Render("PR Preview:"))
 		content.WriteString("\n")
-		content.WriteString(fmt.Sprintf("  #%d: %s\n", p.prMetadata.Number, tuiValueStyle.Render(p.prMetadata.Title)))
+		content.WriteString(fmt.Sprintf("  #%d: %s\n", p.prMetadata.Number, p.theme.Value.Render(p.prMetadata.Title)))
 		content.WriteString(fmt.Sprintf("  Author: %s\n", p.prMetadata.Author))
-		content.WriteString(fmt.Sprintf("  State: %s\n", formatPRState(p.prMetadata.State)))
+		content.WriteString(fmt.Sprintf("  State: %s\n", p.formatPRState(p.prMetadata.State)))
 		content.WriteString(fmt.Sprintf("  Branch: %s -> %s\n", p.prMetadata.HeadRefName, p.prMetadata.BaseRefName))
 		if len(p.prMetadata.Labels) > 0 {
 			content.WriteString(fmt.Sprintf("  Labels: %s\n", strings.Join(p.prMetadata.Labels, ", ")))
@@ -257,40 +260,40 @@ func (p *PRImportPanel) Render() string {
 	focusHint := ""
 
 	if p.focusIdx == 1 {
-		importLabel = tuiValueStyle.Render("[Import]")
-		focusHint = tuiDimStyle.Render(" (press Enter)")
+		importLabel = p.theme.Value.Render("[Import]")
+		focusHint = p.theme.Dim.Render(" (press Enter)")
 	} else {
-		importLabel = styleButtonWithHover("Import", p.hoveredButton == "import")
+		importLabel = p.theme.styleButtonWithHover("Import", p.hoveredButton == "import")
 	}
 
 	if p.focusIdx == 2 {
-		cancelLabel = tuiValueStyle.Render("[Cancel]")
-		focusHint = tuiDimStyle.Render(" (press Enter)")
+		cancelLabel = p.theme.Value.Render("[Cancel]")
+		focusHint = p.theme.Dim.Render(" (press Enter)")
 	} else {
-		cancelLabel = styleButtonWithHover("Cancel", p.hoveredButton == "cancel")
+		cancelLabel = p.theme.styleButtonWithHover("Cancel", p.hoveredButton == "cancel")
 	}
 
 	content.WriteString(zone.Mark("dialog-import", importLabel) + "  " + zone.Mark("dialog-cancel", cancelLabel) + focusHint)
 	content.WriteString("\n")
 
 	if p.importing {
-		content.WriteString(tuiDimStyle.Render("Importing..."))
+		content.WriteString(p.theme.Dim.Render("Importing..."))
 	} else {
-		content.WriteString(tuiDimStyle.Render("[Tab] Next field  [Esc] Cancel"))
+		content.WriteString(p.theme.Dim.Render("[Tab] Next field  [Esc] Cancel"))
 	}
 
 	return content.String()
 }
 
 // formatPRState formats the PR state with appropriate styling
-func formatPRState(state string) string {
+func (p *PRImportPanel) formatPRState(state string) string {
 	switch state {
 	case "OPEN":
-		return tuiSuccessStyle.Render("OPEN")
+		return p.theme.Success.Render("OPEN")
 	case "CLOSED":
-		return tuiErrorStyle.Render("CLOSED")
+		return p.theme.Error.Render("CLOSED")
 	case "MERGED":
-		return lipgloss.NewStyle().Foreground(lipgloss.Color("141")).Render("MERGED")
+		return lipgloss.NewStyle().Foreground(p.theme.HighlightColor).Render("MERGED")
 	default:
 		return state
 	}
@@ -300,12 +303,12 @@ func formatPRState(state string) string {
 func (p *PRImportPanel) RenderWithPanel(contentHeight int) string {
 	panelContent := p.Render()
 
-	panelStyle := tuiPanelStyle.Width(p.width).Height(contentHeight - 2)
+	panelStyle := p.theme.Panel.Width(p.width).Height(contentHeight - 2)
 	if p.focused {
-		panelStyle = panelStyle.BorderForeground(lipgloss.Color("214"))
+		panelStyle = panelStyle.BorderForeground(p.theme.AccentColor)
 	}
 
-	result := panelStyle.Render(tuiTitleStyle.Render("Import PR") + "\n" + panelContent)
+	result := panelStyle.Render(p.theme.Title.Render("Import PR") + "\n" + panelContent)
 
 	// If the result is taller than expected (due to lipgloss wrapping), fix it
 	if lipgloss.Height(result) > contentHeight {
@@ -12,17 +12,18 @@ import (
 	zone "github.com/lrstanley/bubblezone"
 )
 
-// StatusBarContext indicates which panel the status bar should show commands for
-type StatusBarContext int
-
-const (
-	StatusBarContextIssues StatusBarContext = iota
-	StatusBarContextWorkDetail
-)
+// statusCommand is a clickable button on the status bar
+type statusCommand struct {
+	key    string // Key the button presses
+	label  string // Button text, e.g. "[n]New"
+	dimmed bool   // Shown but unavailable
+}
 
 // StatusBar is the status bar panel at the bottom of the TUI.
 // It renders command buttons, status messages, and handles hover/click detection.
 type StatusBar struct {
+	theme *Theme
+
 	// Dimensions
 	width int
 
@@ -32,9 +33,17 @@ type StatusBar struct {
 	loading       bool
 	lastUpdate    time.Time
 	spinner       spinner.Model
-
-	// Context determines which commands to show
-	context StatusBarContext
+	beadsDisabled bool   // bd is missing, so bead-editing commands are unavailable
+	refreshing    bool   // a manual refresh is in flight
+	updateFlash   bool   // new data just arrived; highlight the last-update time
+	worktreeUsage string // combined worktree disk usage, empty until measured
+	problemWorks  int    // works with failed tasks or dead orchestrators
+	readOnly      bool   // read-only mode, so changes are disabled
+	primaryPID    int    // PID of the primary TUI when this one is a secondary
+	configInvalid bool   // config.toml has problems, so changes are disabled
+
+	// Buttons for the active panel (set by coordinator)
+	commands []statusCommand
 
 	// Mouse state
 	hoveredButton string
@@ -43,21 +52,18 @@ type StatusBar struct {
 	zonePrefix string
 
 	// Data providers (set by coordinator)
-	getBeadItems            func() []beadItem
-	getBeadsCursor          func() int
-	getActiveSessions       func() map[string]bool
-	getViewMode             func() ViewMode
-	getTextInput            func() string
-	isFailedTaskSelected    func() bool
+	getViewMode  func() ViewMode
+	getTextInput func() string
 }
 
 // NewStatusBar creates a new StatusBar panel
-func NewStatusBar() *StatusBar {
+func NewStatusBar(theme *Theme) *StatusBar {
 	s := spinner.New()
-	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	s.Spinner = theme.Icons.Spinner
+	s.Style = theme.Spinner
 
 	return &StatusBar{
+		theme:      theme,
 		width:      80,
 		spinner:    s,
 		zonePrefix: zone.NewPrefix(),
@@ -71,24 +77,13 @@ func (s *StatusBar) SetSize(width int) {
 
 // SetDataProviders sets the functions to get data from the coordinator
 func (s *StatusBar) SetDataProviders(
-	getBeadItems func() []beadItem,
-	getBeadsCursor func() int,
-	getActiveSessions func() map[string]bool,
 	getViewMode func() ViewMode,
 	getTextInput func() string,
 ) {
-	s.getBeadItems = getBeadItems
-	s.getBeadsCursor = getBeadsCursor
-	s.getActiveSessions = getActiveSessions
 	s.getViewMode = getViewMode
 	s.getTextInput = getTextInput
 }
 
-// SetFailedTaskSelectedProvider sets the provider for checking if a failed task is selected
-func (s *StatusBar) SetFailedTaskSelectedProvider(isFailedTaskSelected func() bool) {
-	s.isFailedTaskSelected = isFailedTaskSelected
-}
-
 // SetStatus updates the status message
 func (s *StatusBar) SetStatus(message string, isError bool) {
 	// Strip newlines - status bar is single line only
@@ -108,14 +103,55 @@ func (s *StatusBar) SetLastUpdate(t time.Time) {
 	s.lastUpdate = t
 }
 
+// SetRefreshing shows the refreshing indicator while a manual refresh is in flight
+func (s *StatusBar) SetRefreshing(refreshing bool) {
+	s.refreshing = refreshing
+}
+
+// SetUpdateFlash highlights the last-update time after new data arrives
+func (s *StatusBar) SetUpdateFlash(flash bool) {
+	s.updateFlash = flash
+}
+
+// SetBeadsDisabled marks bead-editing commands as unavailable
+func (s *StatusBar) SetBeadsDisabled(disabled bool) {
+	s.beadsDisabled = disabled
+}
+
+// SetWorktreeUsage sets the formatted combined disk usage of all worktrees ("" if not measured)
+func (s *StatusBar) SetWorktreeUsage(usage string) {
+	s.worktreeUsage = usage
+}
+
+// SetProblemWorks sets how many works need attention, shown as a badge
+func (s *StatusBar) SetProblemWorks(count int) {
+	s.problemWorks = count
+}
+
+// SetPrimaryPID shows which TUI is the primary while this one is a
+// secondary, or nothing for 0
+func (s *StatusBar) SetPrimaryPID(pid int) {
+	s.primaryPID = pid
+}
+
+// SetReadOnly shows or hides the READ-ONLY badge
+func (s *StatusBar) SetReadOnly(readOnly bool) {
+	s.readOnly = readOnly
+}
+
+// SetConfigInvalid shows or hides the BAD CONFIG badge
+func (s *StatusBar) SetConfigInvalid(invalid bool) {
+	s.configInvalid = invalid
+}
+
 // SetHoveredButton updates which button is hovered
 func (s *StatusBar) SetHoveredButton(button string) {
 	s.hoveredButton = button
 }
 
-// SetContext updates the status bar context (which panel's commands to show)
-func (s *StatusBar) SetContext(ctx StatusBarContext) {
-	s.context = ctx
+// SetCommands sets the buttons for the active panel
+func (s *StatusBar) SetCommands(commands []statusCommand) {
+	s.commands = commands
 }
 
 // GetHoveredButton returns which button is currently hovered
@@ -123,6 +159,11 @@ func (s *StatusBar) GetHoveredButton() string {
 	return s.hoveredButton
 }
 
+// SpinnerTick starts the status bar spinner
+func (s *StatusBar) SpinnerTick() tea.Cmd {
+	return s.spinner.Tick
+}
+
 // UpdateSpinner updates the spinner animation
 func (s *StatusBar) UpdateSpinner(msg tea.Msg) tea.Cmd {
 	var cmd tea.Cmd
@@ -139,20 +180,32 @@ func (s *StatusBar) Render() string {
 		if s.getTextInput != nil {
 			searchInput = s.getTextInput()
 		}
-		hint := tuiDimStyle.Render("  [Enter]Search  [Esc]Cancel")
-		return tuiStatusBarStyle.Width(s.width).Render(searchPrompt + searchInput + hint)
+		hint := s.theme.Dim.Render("  [Enter]Search  [Esc]Cancel")
+		return s.theme.StatusBar.Width(s.width).Render(searchPrompt + searchInput + hint)
 	}
 
-	var commands string
-	var commandsPlain string
+	commands, commandsPlain := s.renderCommands()
 
-	switch s.context {
-	case StatusBarContextWorkDetail:
-		// Work detail commands
-		commands, commandsPlain = s.renderWorkDetailCommands()
-	default:
-		// Issues commands (default)
-		commands, commandsPlain = s.renderIssuesCommands()
+	// The read-only, secondary and problem badges stay up whatever the status shows, so it's counted
+	// with the commands when sizing the status
+	badge, badgePlain := "", ""
+	if s.readOnly {
+		badgePlain = "READ-ONLY  "
+		badge = lipgloss.NewStyle().Bold(true).Foreground(s.theme.WarningColor).Render(badgePlain)
+	}
+	if s.primaryPID != 0 {
+		secondary := fmt.Sprintf("another co tui is primary (pid %d)  ", s.primaryPID)
+		badgePlain += secondary
+		badge += s.theme.Dim.Render(secondary)
+	}
+	if s.configInvalid {
+		badgePlain += "BAD CONFIG  "
+		badge += lipgloss.NewStyle().Bold(true).Foreground(s.theme.ErrorColor).Render("BAD CONFIG  ")
+	}
+	if s.problemWorks > 0 {
+		problems := fmt.Sprintf("⚠ %d  ", s.problemWorks)
+		badgePlain += problems
+		badge += s.theme.Error.Render(problems)
 	}
 
 	// Status on the right
@@ -161,16 +214,29 @@ func (s *StatusBar) Render() string {
 	if s.statusMessage != "" {
 		statusPlain = s.statusMessage
 		if s.statusIsError {
-			status = tuiErrorStyle.Render(s.statusMessage)
+			status = s.theme.Error.Render(s.statusMessage)
 		} else {
-			status = tuiSuccessStyle.Render(s.statusMessage)
+			status = s.theme.Success.Render(s.statusMessage)
 		}
+	} else if s.refreshing {
+		statusPlain = "refreshing…"
+		status = s.spinner.View() + " refreshing…"
 	} else if s.loading {
 		statusPlain = "Loading..."
 		status = s.spinner.View() + " Loading..."
+	} else if s.beadsDisabled {
+		statusPlain = bdMissingMessage
+		status = s.theme.Error.Render(statusPlain)
 	} else {
 		statusPlain = fmt.Sprintf("Updated: %s", s.lastUpdate.Format("15:04:05"))
-		status = tuiDimStyle.Render(statusPlain)
+		if s.worktreeUsage != "" {
+			statusPlain += fmt.Sprintf("  Worktrees: %s", s.worktreeUsage)
+		}
+		if s.updateFlash {
+			status = s.theme.Success.Render(statusPlain)
+		} else {
+			status = s.theme.Dim.Render(statusPlain)
+		}
 	}
 
 	// Calculate available space for status message and truncate if needed
@@ -178,7 +244,7 @@ func (s *StatusBar) Render() string {
 	// Content = commands + minPadding + status
 	minPadding := 2
 	innerWidth := s.width - 2
-	commandsWidth := ansi.StringWidth(commandsPlain)
+	commandsWidth := ansi.StringWidth(commandsPlain) + ansi.StringWidth(badgePlain)
 	statusWidth := ansi.StringWidth(statusPlain)
 
 	// Available width for status = inner width minus commands and minimum padding
@@ -195,14 +261,14 @@ func (s *StatusBar) Render() string {
 			truncatedPlain := ansi.Truncate(statusPlain, availableWidth, "...")
 			statusPlain = truncatedPlain
 			statusWidth = ansi.StringWidth(statusPlain)
-			if s.statusIsError {
-				status = tuiErrorStyle.Render(truncatedPlain)
+			if s.statusIsError || (s.statusMessage == "" && !s.loading && s.beadsDisabled) {
+				status = s.theme.Error.Render(truncatedPlain)
 			} else if s.loading {
 				status = s.spinner.View() + " Loading..."
 			} else if s.statusMessage != "" {
-				status = tuiSuccessStyle.Render(truncatedPlain)
+				status = s.theme.Success.Render(truncatedPlain)
 			} else {
-				status = tuiDimStyle.Render(truncatedPlain)
+				status = s.theme.Dim.Render(truncatedPlain)
 			}
 		}
 	}
@@ -210,99 +276,31 @@ func (s *StatusBar) Render() string {
 	// Build bar with commands left, status right
 	// Padding fills the remaining space
 	padding := max(innerWidth-commandsWidth-statusWidth, minPadding)
-	return tuiStatusBarStyle.Width(s.width).Render(commands + strings.Repeat(" ", padding) + status)
+	return s.theme.StatusBar.Width(s.width).Render(commands + strings.Repeat(" ", padding) + badge + status)
 }
 
-// renderIssuesCommands returns commands for the issues panel
-func (s *StatusBar) renderIssuesCommands() (string, string) {
-	// Show p action based on session state
-	pAction := "[p]Plan"
-	if s.getBeadItems != nil && s.getBeadsCursor != nil && s.getActiveSessions != nil {
-		beadItems := s.getBeadItems()
-		cursor := s.getBeadsCursor()
-		activeSessions := s.getActiveSessions()
-		if len(beadItems) > 0 && cursor < len(beadItems) {
-			beadID := beadItems[cursor].ID
-			if activeSessions[beadID] {
-				pAction = "[p]Resume"
-			}
+// renderCommands returns the buttons on the left of the bar, styled and plain
+func (s *StatusBar) renderCommands() (string, string) {
+	buttons := make([]string, 0, len(s.commands))
+	labels := make([]string, 0, len(s.commands))
+	for _, c := range s.commands {
+		var button string
+		if c.dimmed {
+			button = s.theme.Dim.Render(c.label)
+		} else {
+			button = s.theme.styleButtonWithHover(c.label, s.hoveredButton == c.key)
 		}
+		buttons = append(buttons, zone.Mark(s.zonePrefix+c.key, button))
+		labels = append(labels, c.label)
 	}
-
-	// Commands on the left with hover effects - wrap each with zone.Mark
-	nButton := zone.Mark(s.zonePrefix+"n", styleButtonWithHover("[n]New", s.hoveredButton == "n"))
-	eButton := zone.Mark(s.zonePrefix+"e", styleButtonWithHover("[e]Edit", s.hoveredButton == "e"))
-	aButton := zone.Mark(s.zonePrefix+"a", styleButtonWithHover("[a]Child", s.hoveredButton == "a"))
-	xButton := zone.Mark(s.zonePrefix+"x", styleButtonWithHover("[x]Close", s.hoveredButton == "x"))
-	wButton := zone.Mark(s.zonePrefix+"w", styleButtonWithHover("[w]Work", s.hoveredButton == "w"))
-	AButton := zone.Mark(s.zonePrefix+"A", styleButtonWithHover("[A]dd", s.hoveredButton == "A"))
-	iButton := zone.Mark(s.zonePrefix+"i", styleButtonWithHover("[i]Import", s.hoveredButton == "i"))
-	pButton := zone.Mark(s.zonePrefix+"p", styleButtonWithHover(pAction, s.hoveredButton == "p"))
-	helpButton := zone.Mark(s.zonePrefix+"?", styleButtonWithHover("[?]Help", s.hoveredButton == "?"))
-
-	commands := nButton + " " + eButton + " " + aButton + " " + xButton + " " + wButton + " " + AButton + " " + iButton + " " + pButton + " " + helpButton
-	commandsPlain := fmt.Sprintf("[n]New [e]Edit [a]Child [x]Close [w]Work [A]dd [i]Import %s [?]Help", pAction)
-
-	return commands, commandsPlain
-}
-
-// renderWorkDetailCommands returns commands for the work detail panel
-func (s *StatusBar) renderWorkDetailCommands() (string, string) {
-	// Work detail specific commands - wrap each with zone.Mark
-	tButton := zone.Mark(s.zonePrefix+"t", styleButtonWithHover("[t]erminal", s.hoveredButton == "t"))
-	cButton := zone.Mark(s.zonePrefix+"c", styleButtonWithHover("[c]laude", s.hoveredButton == "c"))
-	rButton := zone.Mark(s.zonePrefix+"r", styleButtonWithHover("[r]un", s.hoveredButton == "r"))
-	oButton := zone.Mark(s.zonePrefix+"o", styleButtonWithHover("[o]rch", s.hoveredButton == "o"))
-	vButton := zone.Mark(s.zonePrefix+"v", styleButtonWithHover("[v]review", s.hoveredButton == "v"))
-	pButton := zone.Mark(s.zonePrefix+"p", styleButtonWithHover("[p]r", s.hoveredButton == "p"))
-	fButton := zone.Mark(s.zonePrefix+"f", styleButtonWithHover("[f]eedback", s.hoveredButton == "f"))
-	dButton := zone.Mark(s.zonePrefix+"d", styleButtonWithHover("[d]estroy", s.hoveredButton == "d"))
-	escButton := zone.Mark(s.zonePrefix+"esc", styleButtonWithHover("[Esc]Deselect", s.hoveredButton == "esc"))
-	helpButton := zone.Mark(s.zonePrefix+"?", styleButtonWithHover("[?]Help", s.hoveredButton == "?"))
-
-	// Check if a failed task is selected to conditionally show reset button
-	showReset := s.isFailedTaskSelected != nil && s.isFailedTaskSelected()
-
-	var commands, commandsPlain string
-	if showReset {
-		xButton := zone.Mark(s.zonePrefix+"x", styleButtonWithHover("[x]Reset", s.hoveredButton == "x"))
-		commands = tButton + " " + cButton + " " + rButton + " " + oButton + " " + vButton + " " + pButton + " " + fButton + " " + xButton + " " + dButton + " " + escButton + " " + helpButton
-		commandsPlain = "[t]erminal [c]laude [r]un [o]rch [v]review [p]r [f]eedback [x]Reset [d]estroy [Esc]Deselect [?]Help"
-	} else {
-		commands = tButton + " " + cButton + " " + rButton + " " + oButton + " " + vButton + " " + pButton + " " + fButton + " " + dButton + " " + escButton + " " + helpButton
-		commandsPlain = "[t]erminal [c]laude [r]un [o]rch [v]review [p]r [f]eedback [d]estroy [Esc]Deselect [?]Help"
-	}
-
-	return commands, commandsPlain
+	return strings.Join(buttons, " "), strings.Join(labels, " ")
 }
 
 // DetectButton determines which button is at the mouse position using bubblezone
 func (s *StatusBar) DetectButton(msg tea.MouseMsg) string {
-	switch s.context {
-	case StatusBarContextWorkDetail:
-		return s.detectWorkDetailButton(msg)
-	default:
-		return s.detectIssuesButton(msg)
-	}
-}
-
-// detectIssuesButton detects button clicks for the issues panel using bubblezone
-func (s *StatusBar) detectIssuesButton(msg tea.MouseMsg) string {
-	buttons := []string{"n", "e", "a", "x", "w", "A", "i", "p", "?"}
-	for _, btn := range buttons {
-		if zone.Get(s.zonePrefix + btn).InBounds(msg) {
-			return btn
-		}
-	}
-	return ""
-}
-
-// detectWorkDetailButton detects button clicks for the work detail panel using bubblezone
-func (s *StatusBar) detectWorkDetailButton(msg tea.MouseMsg) string {
-	buttons := []string{"t", "c", "r", "o", "v", "p", "f", "x", "d", "esc", "?"}
-	for _, btn := range buttons {
-		if zone.Get(s.zonePrefix + btn).InBounds(msg) {
-			return btn
+	for _, c := range s.commands {
+		if zone.Get(s.zonePrefix + c.key).InBounds(msg) {
+			return c.key
 		}
 	}
 	return ""
@@ -1,8 +1,11 @@
 package tui
 
 import (
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/progress"
 )
 
@@ -24,15 +27,38 @@ const (
 	WorkDetailActionDestroy                              // Destroy work (d)
 	WorkDetailActionAddChildIssue                        // Add child issue to root issue (a)
 	WorkDetailActionResetTask                            // Reset failed task (x)
+	WorkDetailActionComplete                             // Clean up and complete merged work (m)
+	WorkDetailActionReport                               // Generate a markdown report for the work (R)
+	WorkDetailActionDiff                                 // Show the work's diff against its base branch (D)
+	WorkDetailActionTogglePause                          // Pause or resume the work's orchestration (z)
+	WorkDetailActionCustomTask                           // Pick a custom task type to create (T)
+	WorkDetailActionCleanupStale                         // Clean up a work whose branch is merged or deleted (C)
+	WorkDetailActionCancelSchedule                       // Cancel the work's scheduled run (u)
+	WorkDetailActionReviewPlan                           // Preview the LLM task grouping and edit it before running (g)
+	WorkDetailActionRemoveBead                           // Remove the selected unassigned bead from the work (x)
+	WorkDetailActionMoveBead                             // Move the selected unassigned bead to another work (b)
+	WorkDetailActionRunTask                              // Run the selected pending task now (!)
+	WorkDetailActionArtifacts                            // Browse the selected task's artifacts (enter)
+	WorkDetailActionNotes                                // Edit the work's notes (N)
+	WorkDetailActionEnv                                  // Edit the work's environment overrides (E)
+	WorkDetailActionToggleAutoPR                         // Turn auto-PR on or off for the work (O)
+	WorkDetailActionToggleLog                            // Show or hide the orchestrator log pane (L)
+	WorkDetailActionRetryRateLimited                     // Reset all tasks that failed on a rate limit (X)
+	WorkDetailActionRelocate                             // Repair a work whose worktree directory is missing (H)
+	WorkDetailActionTimeline                             // Chart when the work's tasks ran (V)
+	WorkDetailActionToggleFold                           // Fold or expand the completed tasks (Z)
 )
 
 // WorkDetailsPanel is a coordinator that manages the work detail sub-panels.
 // It handles layout, keyboard/mouse events, and coordinates which right panel to show.
 type WorkDetailsPanel struct {
+	theme *Theme
+
 	// Dimensions
 	width       int
 	height      int
 	columnRatio float64 // Ratio of left column width (0.0-1.0), synced with issues panel
+	maximized   bool    // Only the focused column is shown, at full width
 
 	// Focus state
 	leftPanelFocused  bool
@@ -42,20 +68,24 @@ type WorkDetailsPanel struct {
 	overviewPanel *WorkOverviewPanel // Left panel: work info + tasks list
 	summaryPanel  *WorkSummaryPanel  // Right panel: work overview (when root selected)
 	taskPanel     *WorkTaskPanel     // Right panel: task/bead details
+	logPanel      *WorkLogPanel      // Third column: orchestrator log, when showLog is set
+	showLog       bool
 
 	// Data reference (shared with sub-panels)
 	focusedWork *progress.WorkProgress
 }
 
 // NewWorkDetailsPanel creates a new WorkDetailsPanel coordinator
-func NewWorkDetailsPanel() *WorkDetailsPanel {
+func NewWorkDetailsPanel(theme *Theme) *WorkDetailsPanel {
 	return &WorkDetailsPanel{
+		theme:         theme,
 		width:         80,
 		height:        20,
 		columnRatio:   0.4, // Default 40/60 split to match issues panel
-		overviewPanel: NewWorkOverviewPanel(),
-		summaryPanel:  NewWorkSummaryPanel(),
-		taskPanel:     NewWorkTaskPanel(),
+		overviewPanel: NewWorkOverviewPanel(theme),
+		summaryPanel:  NewWorkSummaryPanel(theme),
+		taskPanel:     NewWorkTaskPanel(theme),
+		logPanel:      NewWorkLogPanel(theme),
 	}
 }
 
@@ -65,9 +95,7 @@ func (p *WorkDetailsPanel) SetSize(width, height int) {
 	p.height = height
 
 	// Calculate column widths using the same formula as render
-	totalContentWidth := width - 4
-	leftWidth := int(float64(totalContentWidth) * p.columnRatio)
-	rightWidth := totalContentWidth - leftWidth
+	leftWidth, rightWidth, logWidth := p.columnWidths()
 
 	// Calculate available lines for content (minus border and title)
 	visibleLines := max(height-3, 1)
@@ -76,6 +104,62 @@ func (p *WorkDetailsPanel) SetSize(width, height int) {
 	p.overviewPanel.SetSize(leftWidth, height)
 	p.summaryPanel.SetSize(rightWidth, visibleLines)
 	p.taskPanel.SetSize(rightWidth, visibleLines)
+	p.logPanel.SetSize(logWidth, max(height-4, 1))
+}
+
+// columnWidths returns the content widths of the left, right and log
+// columns. The log column is 0 unless the log is shown, in which case it
+// takes half of what the right column would otherwise get. When maximized,
+// the focused column takes the whole width and the others get 0.
+func (p *WorkDetailsPanel) columnWidths() (left, right, log int) {
+	if p.maximized {
+		if p.rightPanelFocused {
+			return 0, p.width - 2, 0
+		}
+		return p.width - 2, 0, 0
+	}
+	if !p.showLog {
+		totalContentWidth := p.width - 4
+		left = int(float64(totalContentWidth) * p.columnRatio)
+		return left, totalContentWidth - left, 0
+	}
+	// Three bordered columns need two more characters than two
+	totalContentWidth := p.width - 6
+	left = int(float64(totalContentWidth) * p.columnRatio)
+	right = (totalContentWidth - left) / 2
+	return left, right, totalContentWidth - left - right
+}
+
+// SetShowLog shows or hides the orchestrator log column
+func (p *WorkDetailsPanel) SetShowLog(show bool) {
+	p.showLog = show
+	p.SetSize(p.width, p.height)
+}
+
+// IsLogShown reports whether the orchestrator log column is shown
+func (p *WorkDetailsPanel) IsLogShown() bool {
+	return p.showLog
+}
+
+// LogPanel returns the orchestrator log sub-panel
+func (p *WorkDetailsPanel) LogPanel() *WorkLogPanel {
+	return p.logPanel
+}
+
+// LogColumnStartX returns the x offset at which the log column starts, or -1
+// if it isn't shown
+func (p *WorkDetailsPanel) LogColumnStartX() int {
+	if !p.showLog || p.maximized {
+		return -1
+	}
+	left, right, _ := p.columnWidths()
+	return left + right + 4 // +4 for the borders of the first two columns
+}
+
+// SetMaximized shows only the focused column, at full width, or all of them
+func (p *WorkDetailsPanel) SetMaximized(maximized bool) {
+	p.maximized = maximized
+	p.SetSize(p.width, p.height)
 }
 
 // SetColumnRatio sets the column width ratio to match the issues panel
@@ -102,6 +186,48 @@ func (p *WorkDetailsPanel) SetFocusedWork(focusedWork *progress.WorkProgress) {
 	p.syncTaskPanel()
 }
 
+// SetBeadCommitCounts sets the per-bead commit counts for the focused work
+func (p *WorkDetailsPanel) SetBeadCommitCounts(counts map[string]int) {
+	p.taskPanel.SetBeadCommitCounts(counts)
+}
+
+// SetBeadDurations sets the per-bead task run time for the focused work
+func (p *WorkDetailsPanel) SetBeadDurations(durations map[string]time.Duration) {
+	p.taskPanel.SetBeadDurations(durations)
+}
+
+// SetStaleReason sets why the focused work's branch is stale ("" if it isn't)
+func (p *WorkDetailsPanel) SetStaleReason(reason string) {
+	p.summaryPanel.SetStaleReason(reason)
+}
+
+// SetProjectAutoPR sets the project's auto_pr setting shown for works that
+// don't override it
+func (p *WorkDetailsPanel) SetProjectAutoPR(autoPR bool) {
+	p.summaryPanel.SetProjectAutoPR(autoPR)
+}
+
+// SetTaskTypeGlyphs sets the glyphs registered for custom task types
+func (p *WorkDetailsPanel) SetTaskTypeGlyphs(glyphs map[string]string) {
+	p.overviewPanel.SetTaskTypeGlyphs(glyphs)
+	p.taskPanel.SetTaskTypeGlyphs(glyphs)
+}
+
+// SetReadOnly notes that checks needing git are skipped in read-only mode
+func (p *WorkDetailsPanel) SetReadOnly(readOnly bool) {
+	p.summaryPanel.SetReadOnly(readOnly)
+}
+
+// SetWorktreeSize sets the focused work's formatted worktree disk usage ("" if not measured)
+func (p *WorkDetailsPanel) SetWorktreeSize(size string) {
+	p.summaryPanel.SetWorktreeSize(size)
+}
+
+// SetFailingChecks sets the checks failing on the focused work's PR
+func (p *WorkDetailsPanel) SetFailingChecks(names []string) {
+	p.summaryPanel.SetFailingChecks(names)
+}
+
 // syncTaskPanel updates the task panel based on current selection
 func (p *WorkDetailsPanel) syncTaskPanel() {
 	if p.focusedWork == nil {
@@ -173,8 +299,8 @@ func (p *WorkDetailsPanel) ScrollToBottom() {
 }
 
 // SetOrchestratorHealth updates the orchestrator health status
-func (p *WorkDetailsPanel) SetOrchestratorHealth(healthy bool) {
-	p.overviewPanel.SetOrchestratorHealth(healthy)
+func (p *WorkDetailsPanel) SetOrchestratorHealth(health db.OrchestratorHealth) {
+	p.overviewPanel.SetOrchestratorHealth(health)
 }
 
 // IsOrchestratorHealthy returns whether the orchestrator is running
@@ -235,6 +361,34 @@ func (p *WorkDetailsPanel) IsSelectedTaskFailed() bool {
 	return p.overviewPanel.IsSelectedTaskFailed()
 }
 
+// SelectedTask returns the selected task, or nil if no task is selected
+func (p *WorkDetailsPanel) SelectedTask() *progress.TaskProgress {
+	if !p.IsTaskSelected() {
+		return nil
+	}
+	taskIdx := p.overviewPanel.GetSelectedIndex() - 1
+	if p.focusedWork == nil || taskIdx < 0 || taskIdx >= len(p.focusedWork.Tasks) {
+		return nil
+	}
+	return p.focusedWork.Tasks[taskIdx]
+}
+
+// SelectedTaskHasArtifacts returns true if the selected task wrote any artifacts
+func (p *WorkDetailsPanel) SelectedTaskHasArtifacts() bool {
+	task := p.SelectedTask()
+	return task != nil && len(task.Artifacts) > 0
+}
+
+// IsSelectedTaskPending returns true if the selected task is pending
+func (p *WorkDetailsPanel) IsSelectedTaskPending() bool {
+	return p.overviewPanel.IsSelectedTaskPending()
+}
+
+// SelectedTaskFailedDeps returns the IDs of failed tasks the selected task depends on
+func (p *WorkDetailsPanel) SelectedTaskFailedDeps() []string {
+	return p.overviewPanel.SelectedTaskFailedDeps()
+}
+
 // IsUnassignedBeadSelected returns true if an unassigned bead is currently selected
 func (p *WorkDetailsPanel) IsUnassignedBeadSelected() bool {
 	return p.overviewPanel.IsUnassignedBeadSelected()
@@ -265,6 +419,22 @@ func (p *WorkDetailsPanel) NavigateDown() {
 	p.syncTaskPanel()
 }
 
+// ToggleCompletedFold folds the focused work's completed tasks into a summary
+// row, or expands them again
+func (p *WorkDetailsPanel) ToggleCompletedFold() {
+	p.overviewPanel.ToggleCompletedFold()
+}
+
+// ExpandCompleted shows the focused work's folded completed tasks again
+func (p *WorkDetailsPanel) ExpandCompleted() {
+	p.overviewPanel.ExpandCompleted()
+}
+
+// IsCompletedFolded returns whether the focused work's completed tasks are folded
+func (p *WorkDetailsPanel) IsCompletedFolded() bool {
+	return p.overviewPanel.IsCompletedFolded()
+}
+
 // NavigateTaskUp is an alias for NavigateUp (for compatibility)
 func (p *WorkDetailsPanel) NavigateTaskUp() {
 	p.NavigateUp()
@@ -289,48 +459,64 @@ func (p *WorkDetailsPanel) RenderWithPanel(contentHeight int) string {
 	}
 
 	// Calculate column widths using the same formula as issues panel
-	totalContentWidth := p.width - 4
-	leftWidth := int(float64(totalContentWidth) * p.columnRatio)
-	rightWidth := totalContentWidth - leftWidth
+	leftWidth, rightWidth, logWidth := p.columnWidths()
 
 	// Content lines available inside each sub-panel (excluding border and title)
 	// Same formula as IssuesPanel: contentHeight - 3 for border (2) + title (1)
 	availableContentLines := max(contentHeight-3, 1)
 	availableContentLines--
 
+	if p.maximized && p.rightPanelFocused {
+		rightContent := p.renderRightPanel(availableContentLines, rightWidth)
+		return p.theme.Panel.Width(rightWidth).Height(contentHeight - 2).BorderForeground(p.theme.AccentColor).
+			Render(p.theme.Title.Render("Details") + "\n" + rightContent)
+	}
+
 	// === Left side: Work info and items list ===
 	leftContent := p.overviewPanel.Render(availableContentLines, leftWidth)
 
+	if p.maximized {
+		leftPanelStyle := p.theme.Panel.Width(leftWidth).Height(contentHeight - 2)
+		if p.leftPanelFocused {
+			leftPanelStyle = leftPanelStyle.BorderForeground(p.theme.AccentColor)
+		}
+		return leftPanelStyle.Render(p.theme.Title.Render("Work") + "\n" + leftContent)
+	}
+
 	// === Right side: Selected item details ===
 	rightContent := p.renderRightPanel(availableContentLines, rightWidth)
 
 	// Create the two panels with fixed height (matching IssuesPanel pattern exactly)
 	// IssuesPanel uses: Height(contentHeight - 2)
-	leftPanelStyle := tuiPanelStyle.Width(leftWidth).Height(contentHeight - 2)
+	leftPanelStyle := p.theme.Panel.Width(leftWidth).Height(contentHeight - 2)
 	if p.leftPanelFocused {
-		leftPanelStyle = leftPanelStyle.BorderForeground(lipgloss.Color("214"))
+		leftPanelStyle = leftPanelStyle.BorderForeground(p.theme.AccentColor)
 	}
 
-	leftPanel := leftPanelStyle.Render(tuiTitleStyle.Render("Work") + "\n" + leftContent)
+	leftPanel := leftPanelStyle.Render(p.theme.Title.Render("Work") + "\n" + leftContent)
 
 	// Right panel uses its own height setting
-	rightPanelStyle := tuiPanelStyle.Width(rightWidth).Height(contentHeight - 2)
+	rightPanelStyle := p.theme.Panel.Width(rightWidth).Height(contentHeight - 2)
 	if p.rightPanelFocused {
-		rightPanelStyle = rightPanelStyle.BorderForeground(lipgloss.Color("214"))
+		rightPanelStyle = rightPanelStyle.BorderForeground(p.theme.AccentColor)
 	}
 
-	rightPanel := rightPanelStyle.Render(tuiTitleStyle.Render("Details") + "\n" + rightContent)
+	rightPanel := rightPanelStyle.Render(p.theme.Title.Render("Details") + "\n" + rightContent)
 
-	// Combine panels horizontally
-	result := lipgloss.JoinHorizontal(lipgloss.Top, leftPanel, rightPanel)
+	if !p.showLog {
+		// Combine panels horizontally
+		return lipgloss.JoinHorizontal(lipgloss.Top, leftPanel, rightPanel)
+	}
 
-	return result
+	logContent := p.logPanel.Render(availableContentLines, logWidth-2)
+	logPanel := p.theme.Panel.Width(logWidth).Height(contentHeight - 2).Render(p.logPanel.Title() + "\n" + logContent)
+	return lipgloss.JoinHorizontal(lipgloss.Top, leftPanel, rightPanel, logPanel)
 }
 
 // renderRightPanel renders the right panel with selected item details using the appropriate sub-panel
 func (p *WorkDetailsPanel) renderRightPanel(_, panelWidth int) string {
 	if p.focusedWork == nil {
-		return tuiDimStyle.Render("Loading...")
+		return p.theme.Dim.Render("Loading...")
 	}
 
 	selectedIndex := p.overviewPanel.GetSelectedIndex()
@@ -344,7 +530,6 @@ func (p *WorkDetailsPanel) renderRightPanel(_, panelWidth int) string {
 	return p.taskPanel.Render(panelWidth)
 }
 
-
 // UpdateViewport handles mouse wheel events for the right panel viewport.
 // The caller (handleMouseWheel) has already verified the mouse is over the right panel.
 func (p *WorkDetailsPanel) UpdateViewport(msg tea.Msg) tea.Cmd {
@@ -361,6 +546,22 @@ func (p *WorkDetailsPanel) UpdateViewport(msg tea.Msg) tea.Cmd {
 
 // Update handles key events and returns an action.
 func (p *WorkDetailsPanel) Update(msg tea.KeyMsg) (tea.Cmd, WorkDetailAction) {
+	// Page keys scroll the log while it's shown, whichever side is focused
+	if p.showLog {
+		page := max(p.logPanel.height-1, 1)
+		switch msg.String() {
+		case "pgup":
+			p.logPanel.ScrollUp(page)
+			return nil, WorkDetailActionNone
+		case "pgdown":
+			p.logPanel.ScrollDown(page)
+			return nil, WorkDetailActionNone
+		case "end":
+			p.logPanel.Follow()
+			return nil, WorkDetailActionNone
+		}
+	}
+
 	// When right panel is focused, let viewport handle scrolling keys
 	if p.rightPanelFocused {
 		var cmd tea.Cmd
@@ -394,6 +595,22 @@ func (p *WorkDetailsPanel) Update(msg tea.KeyMsg) (tea.Cmd, WorkDetailAction) {
 			return cmd, WorkDetailActionCheckFeedback
 		case "d":
 			return cmd, WorkDetailActionDestroy
+		case "m":
+			return cmd, WorkDetailActionComplete
+		case "R":
+			return cmd, WorkDetailActionReport
+		case "D":
+			return cmd, WorkDetailActionDiff
+		case "z":
+			return cmd, WorkDetailActionTogglePause
+		case "T":
+			return cmd, WorkDetailActionCustomTask
+		case "C":
+			return cmd, WorkDetailActionCleanupStale
+		case "u":
+			return cmd, WorkDetailActionCancelSchedule
+		case "g":
+			return cmd, WorkDetailActionReviewPlan
 		case "a":
 			// Add child issue - only when there's a focused work with root issue
 			if p.focusedWork != nil && p.focusedWork.Work.RootIssueID != "" {
@@ -401,10 +618,44 @@ func (p *WorkDetailsPanel) Update(msg tea.KeyMsg) (tea.Cmd, WorkDetailAction) {
 			}
 			return cmd, WorkDetailActionNone
 		case "x":
-			// Reset failed task - only when a failed task is selected
+			// Reset failed task, or remove the selected unassigned bead
 			if p.IsTaskSelected() && p.IsSelectedTaskFailed() {
 				return cmd, WorkDetailActionResetTask
 			}
+			if p.IsUnassignedBeadSelected() {
+				return cmd, WorkDetailActionRemoveBead
+			}
+			return cmd, WorkDetailActionNone
+		case "b":
+			if p.IsUnassignedBeadSelected() {
+				return cmd, WorkDetailActionMoveBead
+			}
+			return cmd, WorkDetailActionNone
+		case "!":
+			if p.IsTaskSelected() && p.IsSelectedTaskPending() {
+				return cmd, WorkDetailActionRunTask
+			}
+			return cmd, WorkDetailActionNone
+		case "N":
+			return cmd, WorkDetailActionNotes
+		case "E":
+			return cmd, WorkDetailActionEnv
+		case "O":
+			return cmd, WorkDetailActionToggleAutoPR
+		case "L":
+			return cmd, WorkDetailActionToggleLog
+		case "X":
+			return cmd, WorkDetailActionRetryRateLimited
+		case "H":
+			return cmd, WorkDetailActionRelocate
+		case "V":
+			return cmd, WorkDetailActionTimeline
+		case "Z":
+			return cmd, WorkDetailActionToggleFold
+		case "enter":
+			if p.SelectedTaskHasArtifacts() {
+				return cmd, WorkDetailActionArtifacts
+			}
 			return cmd, WorkDetailActionNone
 		default:
 			return cmd, WorkDetailActionNone
@@ -441,16 +692,63 @@ func (p *WorkDetailsPanel) Update(msg tea.KeyMsg) (tea.Cmd, WorkDetailAction) {
 		return nil, WorkDetailActionCheckFeedback
 	case "d":
 		return nil, WorkDetailActionDestroy
+	case "m":
+		return nil, WorkDetailActionComplete
+	case "R":
+		return nil, WorkDetailActionReport
+	case "D":
+		return nil, WorkDetailActionDiff
+	case "z":
+		return nil, WorkDetailActionTogglePause
+	case "T":
+		return nil, WorkDetailActionCustomTask
+	case "C":
+		return nil, WorkDetailActionCleanupStale
+	case "u":
+		return nil, WorkDetailActionCancelSchedule
+	case "g":
+		return nil, WorkDetailActionReviewPlan
 	case "a":
 		// Add child issue - only when there's a focused work with root issue
 		if p.focusedWork != nil && p.focusedWork.Work.RootIssueID != "" {
 			return nil, WorkDetailActionAddChildIssue
 		}
 	case "x":
-		// Reset failed task - only when a failed task is selected
+		// Reset failed task, or remove the selected unassigned bead
 		if p.IsTaskSelected() && p.IsSelectedTaskFailed() {
 			return nil, WorkDetailActionResetTask
 		}
+		if p.IsUnassignedBeadSelected() {
+			return nil, WorkDetailActionRemoveBead
+		}
+	case "b":
+		if p.IsUnassignedBeadSelected() {
+			return nil, WorkDetailActionMoveBead
+		}
+	case "!":
+		if p.IsTaskSelected() && p.IsSelectedTaskPending() {
+			return nil, WorkDetailActionRunTask
+		}
+	case "N":
+		return nil, WorkDetailActionNotes
+	case "E":
+		return nil, WorkDetailActionEnv
+	case "O":
+		return nil, WorkDetailActionToggleAutoPR
+	case "L":
+		return nil, WorkDetailActionToggleLog
+	case "X":
+		return nil, WorkDetailActionRetryRateLimited
+	case "H":
+		return nil, WorkDetailActionRelocate
+	case "V":
+		return nil, WorkDetailActionTimeline
+	case "Z":
+		return nil, WorkDetailActionToggleFold
+	case "enter":
+		if p.SelectedTaskHasArtifacts() {
+			return nil, WorkDetailActionArtifacts
+		}
 	}
 
 	return nil, WorkDetailActionNone
@@ -0,0 +1,119 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// WorkLogPanel renders the optional third column of the work details view: the
+// tail of the focused work's orchestrator log. It follows new output until the
+// user scrolls up, then holds the view still until they scroll back down.
+type WorkLogPanel struct {
+	theme *Theme
+
+	// Dimensions
+	width  int
+	height int // Visible lines
+
+	// Data
+	lines  []string // Lines shown; frozen while paused
+	latest []string // Lines most recently read from the log
+	exists bool     // The log file exists
+	scroll int      // Lines scrolled up from the bottom (0 = following)
+}
+
+// NewWorkLogPanel creates a new WorkLogPanel
+func NewWorkLogPanel(theme *Theme) *WorkLogPanel {
+	return &WorkLogPanel{
+		theme:  theme,
+		width:  40,
+		height: 20,
+	}
+}
+
+// SetSize updates the panel dimensions
+func (p *WorkLogPanel) SetSize(width, height int) {
+	p.width = width
+	p.height = height
+	p.scroll = min(p.scroll, p.maxScroll())
+}
+
+// SetLog sets the lines read from the log and whether the file exists. While
+// paused, the new lines are kept but not shown.
+func (p *WorkLogPanel) SetLog(lines []string, exists bool) {
+	p.latest = lines
+	p.exists = exists
+	if !p.IsPaused() {
+		p.lines = lines
+	}
+}
+
+// Clear forgets the log, e.g. when another work is focused
+func (p *WorkLogPanel) Clear() {
+	p.lines, p.latest = nil, nil
+	p.exists = false
+	p.scroll = 0
+}
+
+// IsPaused reports whether the user has scrolled up, freezing the view
+func (p *WorkLogPanel) IsPaused() bool {
+	return p.scroll > 0
+}
+
+// ScrollUp scrolls up by n lines, pausing the view
+func (p *WorkLogPanel) ScrollUp(n int) {
+	p.scroll = min(p.scroll+n, p.maxScroll())
+}
+
+// ScrollDown scrolls down by n lines, following the log again once the
+// bottom is reached
+func (p *WorkLogPanel) ScrollDown(n int) {
+	p.scroll -= n
+	if p.scroll <= 0 {
+		p.Follow()
+	}
+}
+
+// Follow jumps to the newest output and follows the log again
+func (p *WorkLogPanel) Follow() {
+	p.scroll = 0
+	p.lines = p.latest
+}
+
+// maxScroll is how far up the shown lines can be scrolled
+func (p *WorkLogPanel) maxScroll() int {
+	return max(len(p.lines)-p.height, 0)
+}
+
+// Title returns the panel title, which says when the view is paused
+func (p *WorkLogPanel) Title() string {
+	title := p.theme.Title.Render("Orchestrator log")
+	if p.IsPaused() {
+		title += lipgloss.NewStyle().Foreground(p.theme.WarningColor).Render(" ⏸ paused (end to follow)")
+	}
+	return title
+}
+
+// Render returns the visible log lines
+func (p *WorkLogPanel) Render(visibleLines, panelWidth int) string {
+	if len(p.lines) == 0 {
+		if !p.exists {
+			return p.theme.Dim.Render("No log yet. It appears once the orchestrator starts.")
+		}
+		return p.theme.Dim.Render("The log is empty.")
+	}
+
+	end := len(p.lines) - p.scroll
+	start := max(end-visibleLines, 0)
+	var content strings.Builder
+	for i, line := range p.lines[start:end] {
+		if i > 0 {
+			content.WriteString("\n")
+		}
+		// Output from the orchestrator may carry colors meant for its terminal
+		content.WriteString(ansi.Truncate(ansi.Strip(line), panelWidth, "…"))
+	}
+	return content.String()
+}
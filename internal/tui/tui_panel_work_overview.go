@@ -2,6 +2,7 @@ package tui
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -17,6 +18,8 @@ import (
 // It displays the work header, branch info, progress, orchestrator health,
 // and a selectable list of tasks and unassigned beads.
 type WorkOverviewPanel struct {
+	theme *Theme
+
 	// Dimensions
 	width  int
 	height int
@@ -25,21 +28,25 @@ type WorkOverviewPanel struct {
 	focused bool
 
 	// Data
-	focusedWork         *progress.WorkProgress
-	selectedIndex       int  // 0 = root issue, 1+ = tasks, N+ = unassigned beads
-	hoveredIndex        int  // -1 = none, 0 = root issue, 1+ = tasks/unassigned beads
-	orchestratorHealthy bool // Whether the orchestrator process is running
+	focusedWork        *progress.WorkProgress
+	selectedIndex      int                   // 0 = root issue, 1+ = tasks, N+ = unassigned beads
+	hoveredIndex       int                   // -1 = none, 0 = root issue, 1+ = tasks/unassigned beads
+	orchestratorHealth db.OrchestratorHealth // Whether the orchestrator is running, wedged or down
+	taskGlyphs         map[string]string     // Custom task type -> glyph from [workflow.task_types]
+	foldedWorks        map[string]bool       // Works whose completed tasks are folded, for the session
 
 	// Zone prefix for unique zone IDs
 	zonePrefix string
 }
 
 // NewWorkOverviewPanel creates a new WorkOverviewPanel
-func NewWorkOverviewPanel() *WorkOverviewPanel {
+func NewWorkOverviewPanel(theme *Theme) *WorkOverviewPanel {
 	return &WorkOverviewPanel{
+		theme:        theme,
 		width:        40,
 		height:       20,
 		hoveredIndex: -1, // No item hovered initially
+		foldedWorks:  make(map[string]bool),
 		zonePrefix:   zone.NewPrefix(),
 	}
 }
@@ -70,14 +77,19 @@ func (p *WorkOverviewPanel) SetFocusedWork(focusedWork *progress.WorkProgress) {
 	}
 }
 
+// SetTaskTypeGlyphs sets the glyphs registered for custom task types
+func (p *WorkOverviewPanel) SetTaskTypeGlyphs(glyphs map[string]string) {
+	p.taskGlyphs = glyphs
+}
+
 // SetOrchestratorHealth updates the orchestrator health status
-func (p *WorkOverviewPanel) SetOrchestratorHealth(healthy bool) {
-	p.orchestratorHealthy = healthy
+func (p *WorkOverviewPanel) SetOrchestratorHealth(health db.OrchestratorHealth) {
+	p.orchestratorHealth = health
 }
 
 // IsOrchestratorHealthy returns whether the orchestrator is running
 func (p *WorkOverviewPanel) IsOrchestratorHealthy() bool {
-	return p.orchestratorHealthy
+	return p.orchestratorHealth == db.OrchestratorRunning
 }
 
 // GetSelectedIndex returns the currently selected index (0 = root issue, 1+ = tasks)
@@ -178,6 +190,43 @@ func (p *WorkOverviewPanel) IsSelectedTaskFailed() bool {
 	return false
 }
 
+// IsSelectedTaskPending returns true if the selected task is pending
+func (p *WorkOverviewPanel) IsSelectedTaskPending() bool {
+	if !p.IsTaskSelected() {
+		return false
+	}
+	taskIdx := p.selectedIndex - 1
+	if taskIdx >= 0 && taskIdx < len(p.focusedWork.Tasks) {
+		return p.focusedWork.Tasks[taskIdx].Task.Status == db.StatusPending
+	}
+	return false
+}
+
+// SelectedTaskFailedDeps returns the IDs of failed tasks the selected task depends on
+func (p *WorkOverviewPanel) SelectedTaskFailedDeps() []string {
+	if !p.IsTaskSelected() {
+		return nil
+	}
+	return p.taskDepsWithStatus(p.focusedWork.Tasks[p.selectedIndex-1], func(status string) bool {
+		return status == db.StatusFailed
+	})
+}
+
+// taskDepsWithStatus returns the dependencies of task whose status matches.
+// Dependencies outside the focused work are skipped.
+func (p *WorkOverviewPanel) taskDepsWithStatus(task *progress.TaskProgress, match func(status string) bool) []string {
+	var ids []string
+	for _, depID := range task.DependsOn {
+		for _, t := range p.focusedWork.Tasks {
+			if t.Task.ID == depID && match(t.Task.Status) {
+				ids = append(ids, depID)
+				break
+			}
+		}
+	}
+	return ids
+}
+
 // IsUnassignedBeadSelected returns true if an unassigned bead is currently selected
 func (p *WorkOverviewPanel) IsUnassignedBeadSelected() bool {
 	if p.focusedWork == nil {
@@ -214,26 +263,156 @@ func (p *WorkOverviewPanel) SetSelectedTaskID(id string) {
 	}
 }
 
-// NavigateUp moves selection to the previous item
+// NavigateUp moves selection to the previous item, skipping folded tasks
 func (p *WorkOverviewPanel) NavigateUp() {
+	p.navigate(-1)
+}
+
+// NavigateDown moves selection to the next item, skipping folded tasks
+func (p *WorkOverviewPanel) NavigateDown() {
+	p.navigate(1)
+}
+
+// navigate moves selection by step rows. The folded summary row is passed
+// over; it's expanded by clicking it or toggling the fold.
+func (p *WorkOverviewPanel) navigate(step int) {
 	if p.focusedWork == nil {
 		return
 	}
-	if p.selectedIndex > 0 {
-		p.selectedIndex--
+	rows, _ := p.rows()
+	pos := slices.Index(rows, p.selectedIndex)
+	if pos < 0 {
+		return
+	}
+	for i := pos + step; i >= 0 && i < len(rows); i += step {
+		if rows[i] != foldedSummaryItem {
+			p.selectedIndex = rows[i]
+			return
+		}
 	}
 }
 
-// NavigateDown moves selection to the next item
-func (p *WorkOverviewPanel) NavigateDown() {
+// foldedSummaryItem is the item index of the row standing in for folded
+// completed tasks
+const foldedSummaryItem = -2
+
+// ToggleCompletedFold folds the focused work's completed tasks into a
+// summary row, or expands them again. Each work keeps its own fold.
+func (p *WorkOverviewPanel) ToggleCompletedFold() {
 	if p.focusedWork == nil {
 		return
 	}
-	// 0 = root, 1..n = tasks, n+1..m = unassigned beads
-	maxIndex := len(p.focusedWork.Tasks) + len(p.focusedWork.UnassignedBeads)
-	if p.selectedIndex < maxIndex {
-		p.selectedIndex++
+	id := p.focusedWork.Work.ID
+	p.foldedWorks[id] = !p.foldedWorks[id]
+}
+
+// ExpandCompleted shows the focused work's completed tasks again
+func (p *WorkOverviewPanel) ExpandCompleted() {
+	if p.focusedWork != nil {
+		delete(p.foldedWorks, p.focusedWork.Work.ID)
+	}
+}
+
+// IsCompletedFolded returns whether the focused work's completed tasks are folded
+func (p *WorkOverviewPanel) IsCompletedFolded() bool {
+	return p.focusedWork != nil && p.foldedWorks[p.focusedWork.Work.ID]
+}
+
+// rows returns the item indexes in display order and how many tasks are
+// folded. Folded completed tasks are replaced by a single foldedSummaryItem
+// where the first of them would be. The selected task is never folded, so
+// selection always stays on a visible row.
+func (p *WorkOverviewPanel) rows() ([]int, int) {
+	tasksEndIdx := 1 + len(p.focusedWork.Tasks)
+	total := tasksEndIdx + len(p.focusedWork.UnassignedBeads)
+	rows := make([]int, 0, total)
+	folded := 0
+	fold := p.IsCompletedFolded()
+	for i := range total {
+		if fold && i > 0 && i < tasksEndIdx && i != p.selectedIndex &&
+			p.focusedWork.Tasks[i-1].Task.Status == db.StatusCompleted {
+			if folded == 0 {
+				rows = append(rows, foldedSummaryItem)
+			}
+			folded++
+			continue
+		}
+		rows = append(rows, i)
+	}
+	return rows, folded
+}
+
+// minOverviewItems is how many item rows the overview keeps room for before
+// it starts dropping header lines
+const minOverviewItems = 3
+
+// taskBlockers returns the open beads blocking a task's beads, leaving out
+// the task's own beads since the task works through those itself
+func taskBlockers(task *progress.TaskProgress) []string {
+	own := make(map[string]bool, len(task.Beads))
+	for _, bead := range task.Beads {
+		own[bead.ID] = true
+	}
+	var blockers []string
+	for _, bead := range task.Beads {
+		for _, id := range bead.BlockedBy {
+			if !own[id] && !slices.Contains(blockers, id) {
+				blockers = append(blockers, id)
+			}
+		}
 	}
+	return blockers
+}
+
+// overviewHeader is the set of lines the overview shows above its item list.
+// Render lays the header out from it, and the scroll window and tests size
+// the item list from lines(), so the two always agree.
+type overviewHeader struct {
+	branch   bool // Branch line
+	progress bool // Progress and warning counts line
+	health   bool // Orchestrator health line
+}
+
+// lines returns how many lines the header occupies: the work line, the
+// optional lines and the separator. Every line is truncated to the panel
+// width, so none of them wraps.
+func (h overviewHeader) lines() int {
+	n := 2
+	for _, shown := range []bool{h.branch, h.progress, h.health} {
+		if shown {
+			n++
+		}
+	}
+	return n
+}
+
+// headerLayout decides which header lines fit in a panel panelHeight lines
+// tall. When the panel is too short to also show minOverviewItems items, the
+// branch line is dropped first, then the progress line.
+func (p *WorkOverviewPanel) headerLayout(panelHeight int) overviewHeader {
+	h := overviewHeader{branch: true, progress: true}
+	if p.focusedWork != nil {
+		h.health = p.focusedWork.Work.Status == db.StatusProcessing || len(p.activeTaskIDs()) > 0
+	}
+	// One line is kept for the scroll indicator
+	for _, line := range []*bool{&h.branch, &h.progress} {
+		if panelHeight-h.lines()-1 >= minOverviewItems {
+			break
+		}
+		*line = false
+	}
+	return h
+}
+
+// activeTaskIDs returns the IDs of the focused work's running tasks
+func (p *WorkOverviewPanel) activeTaskIDs() []string {
+	var ids []string
+	for _, task := range p.focusedWork.Tasks {
+		if task.Task.Status == db.StatusProcessing {
+			ids = append(ids, task.Task.ID)
+		}
+	}
+	return ids
 }
 
 // Render returns the left panel content
@@ -249,11 +428,11 @@ func (p *WorkOverviewPanel) Render(panelHeight, panelWidth int) string {
 	contentWidth := panelWidth - 2
 
 	// Work header (1 line)
-	workHeader := fmt.Sprintf("%s %s", statusIcon(p.focusedWork.Work.Status), p.focusedWork.Work.ID)
+	workHeader := fmt.Sprintf("%s %s", p.theme.statusIcon(p.focusedWork.Work.Status), p.focusedWork.Work.ID)
 	if p.focusedWork.Work.Name != "" {
-		nameStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("81"))
+		nameStyle := lipgloss.NewStyle().Foreground(p.theme.InfoColor)
 		// Calculate available space for name
-		maxNameLen := contentWidth - 4 - len(p.focusedWork.Work.ID)
+		maxNameLen := contentWidth - 4 - ansi.StringWidth(p.focusedWork.Work.ID)
 
 		// Add creation time (if it will fit)
 		var timeStr string
@@ -267,7 +446,7 @@ func (p *WorkOverviewPanel) Render(panelHeight, panelWidth int) string {
 				days := int(timeAgo.Hours() / 24)
 				timeStr = fmt.Sprintf(" (%dd ago)", days)
 			}
-			maxNameLen -= len(timeStr)
+			maxNameLen -= ansi.StringWidth(timeStr)
 		}
 
 		if maxNameLen > 0 {
@@ -276,7 +455,7 @@ func (p *WorkOverviewPanel) Render(panelHeight, panelWidth int) string {
 
 		// Add time string at the end
 		if timeStr != "" {
-			timeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+			timeStyle := lipgloss.NewStyle().Foreground(p.theme.FaintColor)
 			workHeader += timeStyle.Render(timeStr)
 		}
 	} else {
@@ -292,13 +471,19 @@ func (p *WorkOverviewPanel) Render(panelHeight, panelWidth int) string {
 				days := int(timeAgo.Hours() / 24)
 				timeStr = fmt.Sprintf(" (%dd ago)", days)
 			}
-			timeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+			timeStyle := lipgloss.NewStyle().Foreground(p.theme.FaintColor)
 			workHeader += timeStyle.Render(timeStr)
 		}
 	}
-	content.WriteString(workHeader + "\n")
-	// Branch info (1 line) - "Branch: " is 8 chars
-	fmt.Fprintf(&content, "Branch: %s\n", ansi.Truncate(p.focusedWork.Work.BranchName, contentWidth-8, "..."))
+	layout := p.headerLayout(panelHeight)
+	// Every header line is truncated so it can't wrap and push the items down
+	writeLine := func(line string) {
+		content.WriteString(ansi.Truncate(line, contentWidth, "…") + "\n")
+	}
+	writeLine(workHeader)
+	if layout.branch {
+		writeLine("Branch: " + p.focusedWork.Work.BranchName)
+	}
 
 	// Progress percentage and warnings (1 line)
 	var progressLine strings.Builder
@@ -318,13 +503,13 @@ func (p *WorkOverviewPanel) Render(panelHeight, panelWidth int) string {
 	// Progress percentage
 	progressStyle := lipgloss.NewStyle().Bold(true)
 	if percentage == 100 {
-		progressStyle = progressStyle.Foreground(lipgloss.Color("82"))
+		progressStyle = progressStyle.Foreground(p.theme.SuccessColor)
 	} else if percentage >= 75 {
-		progressStyle = progressStyle.Foreground(lipgloss.Color("226"))
+		progressStyle = progressStyle.Foreground(p.theme.WarningColor)
 	} else if percentage >= 50 {
-		progressStyle = progressStyle.Foreground(lipgloss.Color("214"))
+		progressStyle = progressStyle.Foreground(p.theme.AccentColor)
 	} else {
-		progressStyle = progressStyle.Foreground(lipgloss.Color("247"))
+		progressStyle = progressStyle.Foreground(p.theme.MutedColor)
 	}
 	progressLine.WriteString("Progress: ")
 	progressLine.WriteString(progressStyle.Render(fmt.Sprintf("%d%%", percentage)))
@@ -332,62 +517,72 @@ func (p *WorkOverviewPanel) Render(panelHeight, panelWidth int) string {
 
 	// Warning badges
 	if p.focusedWork.UnassignedBeadCount > 0 {
-		warningStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+		warningStyle := lipgloss.NewStyle().Foreground(p.theme.AccentColor)
 		progressLine.WriteString("  ")
 		progressLine.WriteString(warningStyle.Render(fmt.Sprintf("⚠ %d unassigned", p.focusedWork.UnassignedBeadCount)))
 	}
+	if n := len(rateLimitedTaskIDs(p.focusedWork)); n > 0 {
+		limitStyle := lipgloss.NewStyle().Foreground(p.theme.WarningColor)
+		progressLine.WriteString("  ")
+		progressLine.WriteString(limitStyle.Render(fmt.Sprintf("%d rate-limited", n)))
+	}
 	if p.focusedWork.FeedbackCount > 0 {
-		alertStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+		alertStyle := lipgloss.NewStyle().Foreground(p.theme.ErrorColor)
 		progressLine.WriteString("  ")
 		progressLine.WriteString(alertStyle.Render("feedback"))
 	}
 
-	content.WriteString(progressLine.String() + "\n")
+	if layout.progress {
+		writeLine(progressLine.String())
+	}
 
 	// Orchestrator health (1 line) - only show if work is processing or has active tasks
-	hasActiveTask := false
-	for _, task := range p.focusedWork.Tasks {
-		if task.Task.Status == db.StatusProcessing {
-			hasActiveTask = true
-			break
-		}
-	}
-	// Base header lines: work header (1), branch (1), progress (1), separator (1) = 4
-	headerLines := 4
-	if p.focusedWork.Work.Status == db.StatusProcessing || hasActiveTask {
-		if p.orchestratorHealthy {
-			healthStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("2"))
-			content.WriteString(healthStyle.Render("✓ Orchestrator running"))
-		} else {
-			healthStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("1"))
-			content.WriteString(healthStyle.Render("✗ Orchestrator dead [o] restart"))
+	if layout.health {
+		activeTasks := p.activeTaskIDs()
+		switch p.orchestratorHealth {
+		case db.OrchestratorRunning:
+			healthStyle := lipgloss.NewStyle().Foreground(p.theme.SuccessColor)
+			health := p.theme.Icons.Completed + " Orchestrator running"
+			if len(activeTasks) > 0 {
+				health += ": " + strings.Join(activeTasks, ", ")
+			}
+			writeLine(healthStyle.Render(health))
+		case db.OrchestratorStale:
+			// The process is there but has stopped beating
+			healthStyle := lipgloss.NewStyle().Foreground(p.theme.WarningColor)
+			writeLine(healthStyle.Render("⚠ Orchestrator not responding [o] restart"))
+		default:
+			healthStyle := lipgloss.NewStyle().Foreground(p.theme.ErrorColor)
+			writeLine(healthStyle.Render(p.theme.Icons.Failed + " Orchestrator dead [o] restart"))
 		}
-		content.WriteString("\n")
-		headerLines = 5 // Add one for orchestrator health line
 	}
 
 	// Separator (1 line)
-	content.WriteString(strings.Repeat("─", contentWidth))
-	content.WriteString("\n")
-	availableLines := max(panelHeight-headerLines-1, 1)
+	writeLine(strings.Repeat("─", max(contentWidth, 0)))
+	availableLines := max(panelHeight-layout.lines()-1, 1)
 
-	// Total items: 1 root issue + n tasks + unassigned beads (if any)
-	totalItems := 1 + len(p.focusedWork.Tasks) + len(p.focusedWork.UnassignedBeads)
+	// Rows: 1 root issue + n tasks (less any folded) + unassigned beads (if any)
+	rows, folded := p.rows()
+	totalItems := len(rows)
 
 	// Calculate scroll window
+	selectedRow := max(slices.Index(rows, p.selectedIndex), 0)
 	startIdx := 0
-	if p.selectedIndex >= availableLines && availableLines > 0 {
-		startIdx = max(0, p.selectedIndex-availableLines/2)
+	if selectedRow >= availableLines && availableLines > 0 {
+		startIdx = max(0, selectedRow-availableLines/2)
 	}
 	endIdx := min(startIdx+availableLines, totalItems)
 
 	// Render visible items (use contentWidth which accounts for padding)
 	// Layout: index 0 = root issue, 1..n = tasks, n+1..m = unassigned beads
 	tasksEndIdx := 1 + len(p.focusedWork.Tasks)
-	for i := startIdx; i < endIdx; i++ {
+	for _, i := range rows[startIdx:endIdx] {
 		var itemLine string
 		var zoneID string
-		if i == 0 {
+		if i == foldedSummaryItem {
+			itemLine = p.renderFoldedLine(folded)
+			zoneID = p.zonePrefix + "folded"
+		} else if i == 0 {
 			// Root issue
 			itemLine = p.renderRootIssueLine(contentWidth)
 			zoneID = p.zonePrefix + "root"
@@ -407,19 +602,29 @@ func (p *WorkOverviewPanel) Render(panelHeight, panelWidth int) string {
 			}
 		}
 		if zoneID != "" && itemLine != "" {
-			content.WriteString(zone.Mark(zoneID, itemLine))
+			itemLine = ansi.Truncate(strings.TrimSuffix(itemLine, "\n"), contentWidth, "…")
+			content.WriteString(zone.Mark(zoneID, itemLine) + "\n")
 		}
 	}
 
 	// Scroll indicator
 	if totalItems > availableLines && availableLines > 0 {
 		scrollInfo := fmt.Sprintf("(%d-%d of %d)", startIdx+1, endIdx, totalItems)
-		content.WriteString(tuiDimStyle.Render(scrollInfo))
+		content.WriteString(p.theme.Dim.Render(scrollInfo))
 	}
 
 	return content.String()
 }
 
+// renderFoldedLine renders the summary row of the folded completed tasks
+func (p *WorkOverviewPanel) renderFoldedLine(folded int) string {
+	text := fmt.Sprintf("▸ %d completed", folded)
+	if p.hoveredIndex == foldedSummaryItem {
+		return "  " + lipgloss.NewStyle().Foreground(p.theme.AccentColor).Render(text) + "\n"
+	}
+	return "  " + p.theme.Dim.Render(text) + "\n"
+}
+
 // renderRootIssueLine renders the root issue line and returns it
 func (p *WorkOverviewPanel) renderRootIssueLine(panelWidth int) string {
 	var content strings.Builder
@@ -448,14 +653,14 @@ func (p *WorkOverviewPanel) renderRootIssueLine(panelWidth int) string {
 		issueIcon = "◆"
 	} else {
 		// Styled icon for normal display
-		issueIcon = lipgloss.NewStyle().Foreground(lipgloss.Color("81")).Render("◆")
+		issueIcon = lipgloss.NewStyle().Foreground(p.theme.InfoColor).Render("◆")
 	}
 
 	// Build text portion (ID and title)
 	textPortion := rootID
 	if rootTitle != "" {
 		// Calculate max title length: panelWidth - prefix(2) - icon(1) - spaces(2) - ID - buffer
-		maxTitleLen := panelWidth - 2 - 1 - 2 - len(rootID) - 4
+		maxTitleLen := panelWidth - 2 - 1 - 2 - ansi.StringWidth(rootID) - 4
 		if maxTitleLen > 0 {
 			textPortion += " " + ansi.Truncate(rootTitle, maxTitleLen, "...")
 		}
@@ -464,15 +669,15 @@ func (p *WorkOverviewPanel) renderRootIssueLine(panelWidth int) string {
 	content.WriteString(prefix)
 	if isSelected {
 		// Full selected style on icon + text
-		content.WriteString(tuiSelectedStyle.Render(issueIcon + " " + textPortion))
+		content.WriteString(p.theme.Selected.Render(issueIcon + " " + textPortion))
 	} else if isHovered {
 		// Orange text for hover on icon + text
-		hoverStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+		hoverStyle := lipgloss.NewStyle().Foreground(p.theme.AccentColor)
 		content.WriteString(hoverStyle.Render(issueIcon + " " + textPortion))
 	} else {
 		// Normal: styled icon + dim text
 		content.WriteString(issueIcon + " ")
-		content.WriteString(tuiDimStyle.Render(textPortion))
+		content.WriteString(p.theme.Dim.Render(textPortion))
 	}
 	content.WriteString("\n")
 	return content.String()
@@ -493,64 +698,89 @@ func (p *WorkOverviewPanel) renderTaskLine(taskIdx int, _ int) string {
 	}
 
 	// Status icon (plain or styled depending on hover/selected state)
-	statusStr := ""
-	switch task.Task.Status {
-	case db.StatusCompleted:
-		statusStr = "✓"
-	case db.StatusProcessing:
-		statusStr = "●"
-	case db.StatusFailed:
-		statusStr = "✗"
-	default:
-		statusStr = "○"
-	}
+	statusStr := p.theme.statusGlyph(task.Task.Status)
 
 	// Task type
-	taskType := "impl"
-	switch task.Task.TaskType {
-	case "estimate":
-		taskType = "est"
-	case "review":
-		taskType = "rev"
-	case "pr":
-		taskType = "pr"
-	case "update-pr-description":
-		taskType = "pr-upd"
-	case "log_analysis":
-		taskType = "log"
+	badge := p.theme.taskTypeBadge(task.Task.TaskType, p.taskGlyphs)
+
+	label := fmt.Sprintf("%s [%s]", task.Task.ID, badge.abbrev)
+	if task.Title != "" {
+		label += " " + task.Title
 	}
 
 	content.WriteString(prefix)
 	if isSelected {
 		// Full selected style on entire line
-		textContent := fmt.Sprintf("%s %s [%s]", statusStr, task.Task.ID, taskType)
-		content.WriteString(tuiSelectedStyle.Render(textContent))
+		textContent := fmt.Sprintf("%s %s %s", statusStr, badge.glyph, label)
+		content.WriteString(p.theme.Selected.Render(textContent))
 	} else if isHovered {
 		// Orange text for hover on entire line
-		hoverStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
-		textContent := fmt.Sprintf("%s %s [%s]", statusStr, task.Task.ID, taskType)
+		hoverStyle := lipgloss.NewStyle().Foreground(p.theme.AccentColor)
+		textContent := fmt.Sprintf("%s %s %s", statusStr, badge.glyph, label)
 		content.WriteString(hoverStyle.Render(textContent))
 	} else {
-		// Normal: styled status icon + dim text
+		// Normal: styled status icon and type glyph + dim text
 		var statusStyle lipgloss.Style
 		switch task.Task.Status {
 		case db.StatusCompleted:
-			statusStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("82"))
+			statusStyle = lipgloss.NewStyle().Foreground(p.theme.SuccessColor)
 		case db.StatusProcessing:
-			statusStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+			statusStyle = lipgloss.NewStyle().Foreground(p.theme.AccentColor)
 		case db.StatusFailed:
-			statusStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+			statusStyle = lipgloss.NewStyle().Foreground(p.theme.ErrorColor)
 		default:
-			statusStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("247"))
+			statusStyle = lipgloss.NewStyle().Foreground(p.theme.MutedColor)
 		}
 		content.WriteString(statusStyle.Render(statusStr))
 		content.WriteString(" ")
-		content.WriteString(tuiDimStyle.Render(fmt.Sprintf("%s [%s]", task.Task.ID, taskType)))
+		content.WriteString(p.theme.taskTypeStyle(task.Task.TaskType).Render(badge.glyph))
+		content.WriteString(" ")
+		content.WriteString(p.theme.Dim.Render(label))
+	}
+
+	// Pending tasks show where they stand in the orchestrator's queue
+	switch {
+	case task.QueuePosition == 0:
+	case p.queueStalled():
+		// Numbers would suggest the queue moves; flag it once instead
+		if task.QueuePosition == 1 {
+			content.WriteString(" " + lipgloss.NewStyle().Foreground(p.theme.WarningColor).Render("queue stalled"))
+		}
+	case task.QueuePosition == 1:
+		content.WriteString(" " + lipgloss.NewStyle().Foreground(p.theme.SuccessColor).Render("▶ next"))
+	default:
+		content.WriteString(" " + p.theme.Dim.Render(fmt.Sprintf("#%d", task.QueuePosition)))
+	}
+
+	// Processing tasks show the latest step they've reached
+	if step := task.LatestStep(); step != nil && task.Task.Status == db.StatusProcessing {
+		content.WriteString(" " + lipgloss.NewStyle().Foreground(p.theme.AccentColor).Render("▶ "+step.Step))
+	}
+
+	// Pending tasks note which dependencies they are still waiting for
+	if task.Task.Status == db.StatusPending {
+		waiting := p.taskDepsWithStatus(task, func(status string) bool {
+			return status != db.StatusCompleted
+		})
+		if len(waiting) > 0 {
+			content.WriteString(" ")
+			content.WriteString(lipgloss.NewStyle().Foreground(p.theme.WarningColor).Render("⏸ waits for " + strings.Join(waiting, ", ")))
+		}
+		if blockers := taskBlockers(task); len(blockers) > 0 {
+			content.WriteString(" ")
+			content.WriteString(lipgloss.NewStyle().Foreground(p.theme.ErrorColor).Render(p.theme.Icons.Waiting + " blocked by " + strings.Join(blockers, ", ")))
+		}
 	}
 	content.WriteString("\n")
 	return content.String()
 }
 
+// queueStalled returns whether the focused work's queue can't move: the
+// work is paused or its orchestrator isn't running
+func (p *WorkOverviewPanel) queueStalled() bool {
+	return p.focusedWork.Work.Paused || p.orchestratorHealth != db.OrchestratorRunning
+}
+
 // renderUnassignedBeadLine renders an unassigned bead line and returns it
 func (p *WorkOverviewPanel) renderUnassignedBeadLine(beadIdx, panelWidth int) string {
 	if beadIdx >= len(p.focusedWork.UnassignedBeads) {
@@ -570,11 +800,17 @@ func (p *WorkOverviewPanel) renderUnassignedBeadLine(beadIdx, panelWidth int) st
 		prefix = "► "
 	}
 
+	// Beads blocked by open beads can't be worked on yet
+	icon, iconColor := p.theme.Icons.Pending, p.theme.AccentColor
+	if len(bead.BlockedBy) > 0 {
+		icon, iconColor = p.theme.Icons.Waiting, p.theme.ErrorColor
+	}
+
 	// Build text portion (ID and title)
 	textPortion := bead.ID
 	if bead.Title != "" {
-		// Calculate max title length: panelWidth - prefix(2) - icon(1) - spaces(2) - ID - buffer
-		maxTitleLen := panelWidth - 2 - 1 - 2 - len(bead.ID) - 4
+		// Calculate max title length: panelWidth - prefix(2) - icon - spaces(2) - ID - buffer
+		maxTitleLen := panelWidth - 2 - ansi.StringWidth(icon) - 2 - ansi.StringWidth(bead.ID) - 4
 		if maxTitleLen > 0 {
 			textPortion += " " + ansi.Truncate(bead.Title, maxTitleLen, "...")
 		}
@@ -583,27 +819,32 @@ func (p *WorkOverviewPanel) renderUnassignedBeadLine(beadIdx, panelWidth int) st
 	content.WriteString(prefix)
 	if isSelected {
 		// Full selected style on icon + text
-		content.WriteString(tuiSelectedStyle.Render("○ " + textPortion))
+		content.WriteString(p.theme.Selected.Render(icon + " " + textPortion))
 	} else if isHovered {
 		// Orange text for hover on icon + text
-		hoverStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
-		content.WriteString(hoverStyle.Render("○ " + textPortion))
+		hoverStyle := lipgloss.NewStyle().Foreground(p.theme.AccentColor)
+		content.WriteString(hoverStyle.Render(icon + " " + textPortion))
 	} else {
-		// Normal: orange icon for unassigned + dim text
-		beadIcon := lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Render("○")
+		// Normal: orange icon for unassigned (red when blocked) + dim text
+		beadIcon := lipgloss.NewStyle().Foreground(iconColor).Render(icon)
 		content.WriteString(beadIcon + " ")
-		content.WriteString(tuiDimStyle.Render(textPortion))
+		content.WriteString(p.theme.Dim.Render(textPortion))
 	}
 	content.WriteString("\n")
 	return content.String()
 }
 
-// DetectClickedItem determines which item was clicked using bubblezone and returns its index
+// DetectClickedItem determines which item was clicked using bubblezone and returns its index,
+// or foldedSummaryItem for the row of folded completed tasks
 func (p *WorkOverviewPanel) DetectClickedItem(msg tea.MouseMsg) int {
 	if p.focusedWork == nil {
 		return -1
 	}
 
+	if p.IsCompletedFolded() && zone.Get(p.zonePrefix+"folded").InBounds(msg) {
+		return foldedSummaryItem
+	}
+
 	// Check root issue zone
 	if zone.Get(p.zonePrefix + "root").InBounds(msg) {
 		return 0
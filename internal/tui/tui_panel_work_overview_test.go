@@ -0,0 +1,271 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/progress"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkOverviewTaskDependencies(t *testing.T) {
+	p := NewWorkOverviewPanel(DarkTheme())
+	p.SetFocusedWork(&progress.WorkProgress{
+		Work: &db.Work{ID: "w-abc"},
+		Tasks: []*progress.TaskProgress{
+			{Task: &db.Task{ID: "w-abc.1", TaskType: "implement", Status: db.StatusCompleted}},
+			{Task: &db.Task{ID: "w-abc.2", TaskType: "implement", Status: db.StatusFailed}},
+			{Task: &db.Task{ID: "w-abc.3", TaskType: "review", Status: db.StatusPending}, DependsOn: []string{"w-abc.1", "w-abc.2"}},
+			{Task: &db.Task{ID: "w-abc.4", TaskType: "review", Status: db.StatusFailed}, DependsOn: []string{"w-abc.2"}},
+		},
+	})
+
+	// Only unfinished dependencies are listed
+	require.Contains(t, p.renderTaskLine(2, 80), "⏸ waits for w-abc.2")
+	require.NotContains(t, p.renderTaskLine(2, 80), "w-abc.1")
+	require.NotContains(t, p.renderTaskLine(1, 80), "waits for")
+
+	p.SetSelectedIndex(4) // w-abc.4
+	require.Equal(t, []string{"w-abc.2"}, p.SelectedTaskFailedDeps())
+	p.SetSelectedIndex(2) // w-abc.2
+	require.Empty(t, p.SelectedTaskFailedDeps())
+}
+
+func TestWorkOverviewTaskTypeGlyphs(t *testing.T) {
+	p := NewWorkOverviewPanel(DarkTheme())
+	p.SetFocusedWork(&progress.WorkProgress{
+		Work: &db.Work{ID: "w-abc"},
+		Tasks: []*progress.TaskProgress{
+			{Task: &db.Task{ID: "w-abc.1", TaskType: db.TaskTypeImplement, Status: db.StatusCompleted}},
+			{Task: &db.Task{ID: "w-abc.2", TaskType: db.TaskTypeReview, Status: db.StatusPending}},
+			{Task: &db.Task{ID: "w-abc.3", TaskType: "security-audit", Status: db.StatusPending}},
+			{Task: &db.Task{ID: "w-abc.4", TaskType: "changelog", Status: db.StatusPending}},
+		},
+	})
+	p.SetTaskTypeGlyphs(map[string]string{"security-audit": "🔒"})
+
+	require.Contains(t, p.renderTaskLine(0, 80), "⚙")
+	require.Contains(t, p.renderTaskLine(0, 80), "[impl]")
+	require.Contains(t, p.renderTaskLine(1, 80), "[rev]")
+
+	// Custom types show their own name, with the registered glyph or a generic one
+	require.Contains(t, p.renderTaskLine(2, 80), "🔒")
+	require.Contains(t, p.renderTaskLine(2, 80), "[security-audit]")
+	require.Contains(t, p.renderTaskLine(3, 80), p.theme.Icons.CustomTask)
+}
+
+func TestWorkOverviewHeaderLayout(t *testing.T) {
+	wp := &progress.WorkProgress{
+		Work: &db.Work{ID: "w-abc", Name: "A fairly long work name", BranchName: "feat/a-fairly-long-branch-name", RootIssueID: "bead-1", Status: db.StatusProcessing},
+		Tasks: []*progress.TaskProgress{
+			{Task: &db.Task{ID: "w-abc.1", TaskType: "implement", Status: db.StatusProcessing}, Title: "Implement the whole thing in one go"},
+			{Task: &db.Task{ID: "w-abc.2", TaskType: "review", Status: db.StatusPending}, DependsOn: []string{"w-abc.1"}},
+		},
+		WorkBeads:           []progress.BeadProgress{{ID: "bead-1", Title: "Root issue with a long title"}},
+		UnassignedBeads:     []progress.BeadProgress{{ID: "bead-2", Title: "Unassigned"}, {ID: "bead-3", Title: "Another"}},
+		UnassignedBeadCount: 2,
+		FeedbackCount:       1,
+	}
+
+	tests := []struct {
+		width, height    int
+		branch, progress bool
+	}{
+		{width: 80, height: 20, branch: true, progress: true},
+		{width: 14, height: 20, branch: true, progress: true},
+		{width: 40, height: 9, branch: true, progress: true},
+		{width: 40, height: 8, branch: false, progress: true},
+		{width: 12, height: 6, branch: false, progress: false},
+	}
+	for _, tt := range tests {
+		p := NewWorkOverviewPanel(DarkTheme())
+		p.SetFocusedWork(wp)
+		p.SetOrchestratorHealth(db.OrchestratorRunning)
+
+		layout := p.headerLayout(tt.height)
+		require.Equal(t, tt.branch, layout.branch, "%dx%d branch", tt.width, tt.height)
+		require.Equal(t, tt.progress, layout.progress, "%dx%d progress", tt.width, tt.height)
+		require.True(t, layout.health)
+
+		lines := strings.Split(strings.TrimSuffix(p.Render(tt.height, tt.width), "\n"), "\n")
+		require.LessOrEqual(t, len(lines), tt.height, "%dx%d renders too many lines", tt.width, tt.height)
+		requireLinesFit(t, strings.Join(lines, "\n"), tt.width-2)
+		// The item list starts right below the header the layout describes
+		require.Contains(t, lines[layout.lines()], "◆", "%dx%d root issue line", tt.width, tt.height)
+		require.Contains(t, lines[layout.lines()-1], "─")
+	}
+}
+
+func TestWorkOverviewBlockedBeads(t *testing.T) {
+	wp := &progress.WorkProgress{
+		Work: &db.Work{ID: "w-abc"},
+		Tasks: []*progress.TaskProgress{
+			{Task: &db.Task{ID: "w-abc.1", TaskType: "implement", Status: db.StatusPending}, Beads: []progress.BeadProgress{
+				{ID: "bead-1", Status: db.StatusPending, BlockedBy: []string{"bead-2", "ext-9"}},
+				{ID: "bead-2", Status: db.StatusPending},
+			}},
+			{Task: &db.Task{ID: "w-abc.2", TaskType: "implement", Status: db.StatusPending}, Beads: []progress.BeadProgress{
+				{ID: "bead-3", Status: db.StatusPending, BlockedBy: []string{"bead-2"}},
+			}},
+		},
+		UnassignedBeads: []progress.BeadProgress{
+			{ID: "bead-4", Title: "Ready"},
+			{ID: "bead-5", Title: "Blocked", BlockedBy: []string{"ext-9"}},
+		},
+	}
+	p := NewWorkOverviewPanel(DarkTheme())
+	p.SetFocusedWork(wp)
+
+	// A task's own beads don't count as blockers
+	require.Contains(t, p.renderTaskLine(0, 80), "⛔ blocked by ext-9")
+	require.NotContains(t, p.renderTaskLine(0, 80), "bead-2")
+	require.Contains(t, p.renderTaskLine(1, 80), "⛔ blocked by bead-2")
+
+	require.NotContains(t, p.renderUnassignedBeadLine(0, 40), "⛔")
+	require.Contains(t, p.renderUnassignedBeadLine(1, 40), "⛔ bead-5")
+	requireLinesFit(t, p.renderUnassignedBeadLine(1, 24), 24)
+
+	// The details panel names the blockers
+	details := NewWorkTaskPanel(DarkTheme())
+	details.SetUnassignedBead(&wp.UnassignedBeads[1])
+	require.Contains(t, details.renderUnassignedBeadDetails(60), "Blocked by: ext-9")
+	details.SetTask(wp.Tasks[0])
+	content := details.renderTaskDetails(60)
+	require.Contains(t, content, "⛔ bead-1")
+	require.Contains(t, content, "Blocked by: bead-2, ext-9")
+}
+
+func TestWorkOverviewFoldCompleted(t *testing.T) {
+	p := NewWorkOverviewPanel(DarkTheme())
+	wp := &progress.WorkProgress{
+		Work: &db.Work{ID: "w-abc"},
+		Tasks: []*progress.TaskProgress{
+			{Task: &db.Task{ID: "w-abc.1", TaskType: "implement", Status: db.StatusCompleted}},
+			{Task: &db.Task{ID: "w-abc.2", TaskType: "implement", Status: db.StatusCompleted}},
+			{Task: &db.Task{ID: "w-abc.3", TaskType: "implement", Status: db.StatusFailed}},
+			{Task: &db.Task{ID: "w-abc.4", TaskType: "review", Status: db.StatusCompleted}},
+			{Task: &db.Task{ID: "w-abc.5", TaskType: "review", Status: db.StatusPending}},
+		},
+		UnassignedBeads: []progress.BeadProgress{{ID: "bead-9", Title: "Unassigned"}},
+	}
+	p.SetFocusedWork(wp)
+
+	p.ToggleCompletedFold()
+	require.True(t, p.IsCompletedFolded())
+	rows, folded := p.rows()
+	require.Equal(t, []int{0, foldedSummaryItem, 3, 5, 6}, rows)
+	require.Equal(t, 3, folded)
+
+	out := p.Render(20, 60)
+	require.Contains(t, out, "▸ 3 completed")
+	require.NotContains(t, out, "w-abc.1")
+	require.Contains(t, out, "w-abc.3")
+	require.Contains(t, out, "bead-9", "unassigned beads follow the shorter task list")
+
+	// Navigation skips the folded row in both directions
+	p.NavigateDown()
+	require.Equal(t, "w-abc.3", p.GetSelectedTaskID())
+	p.NavigateDown()
+	require.Equal(t, "w-abc.5", p.GetSelectedTaskID())
+	p.NavigateDown()
+	require.True(t, p.IsUnassignedBeadSelected())
+	p.NavigateDown()
+	require.True(t, p.IsUnassignedBeadSelected())
+	p.SetSelectedIndex(3)
+	p.NavigateUp()
+	require.Equal(t, 0, p.GetSelectedIndex())
+
+	// A selected completed task stays visible
+	p.SetSelectedTaskID("w-abc.2")
+	rows, folded = p.rows()
+	require.Equal(t, []int{0, foldedSummaryItem, 2, 3, 5, 6}, rows)
+	require.Equal(t, 2, folded)
+
+	// The fold belongs to the work
+	p.SetFocusedWork(&progress.WorkProgress{Work: &db.Work{ID: "w-def"}, Tasks: wp.Tasks})
+	require.False(t, p.IsCompletedFolded())
+	p.SetFocusedWork(wp)
+	require.True(t, p.IsCompletedFolded())
+	p.ExpandCompleted()
+	require.False(t, p.IsCompletedFolded())
+	rows, _ = p.rows()
+	require.Len(t, rows, 7)
+}
+
+func TestWorkOverviewOrchestratorHealth(t *testing.T) {
+	p := NewWorkOverviewPanel(DarkTheme())
+	p.SetFocusedWork(&progress.WorkProgress{Work: &db.Work{ID: "w-abc", Status: db.StatusProcessing}})
+
+	for health, want := range map[db.OrchestratorHealth]string{
+		db.OrchestratorRunning: "✓ Orchestrator running",
+		db.OrchestratorStale:   "⚠ Orchestrator not responding [o] restart",
+		db.OrchestratorDown:    "✗ Orchestrator dead [o] restart",
+	} {
+		p.SetOrchestratorHealth(health)
+		require.Contains(t, p.Render(20, 80), want)
+	}
+}
+
+func TestWorkOverviewQueuePosition(t *testing.T) {
+	work := &db.Work{ID: "w-abc", Status: db.StatusProcessing}
+	p := NewWorkOverviewPanel(DarkTheme())
+	p.SetFocusedWork(&progress.WorkProgress{
+		Work: work,
+		Tasks: []*progress.TaskProgress{
+			{Task: &db.Task{ID: "w-abc.1", TaskType: db.TaskTypeImplement, Status: db.StatusCompleted}},
+			{Task: &db.Task{ID: "w-abc.2", TaskType: db.TaskTypeImplement, Status: db.StatusPending}, QueuePosition: 2},
+			{Task: &db.Task{ID: "w-abc.3", TaskType: db.TaskTypeImplement, Status: db.StatusPending}, QueuePosition: 1},
+		},
+	})
+	p.SetOrchestratorHealth(db.OrchestratorRunning)
+
+	require.Contains(t, p.renderTaskLine(2, 80), "▶ next")
+	require.Contains(t, p.renderTaskLine(1, 80), "#2")
+	require.NotContains(t, p.renderTaskLine(0, 80), "#")
+
+	// With no orchestrator to run it, the queue doesn't move
+	p.SetOrchestratorHealth(db.OrchestratorDown)
+	require.Contains(t, p.renderTaskLine(2, 80), "queue stalled")
+	require.NotContains(t, p.renderTaskLine(2, 80), "next")
+	require.NotContains(t, p.renderTaskLine(1, 80), "#2")
+
+	p.SetOrchestratorHealth(db.OrchestratorRunning)
+	work.Paused = true
+	require.Contains(t, p.renderTaskLine(2, 80), "queue stalled")
+}
+
+func TestWorkOverviewTaskSteps(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.Local)
+	var steps []*db.TaskStep
+	for i := range 12 {
+		steps = append(steps, &db.TaskStep{Step: fmt.Sprintf("step %d", i), CreatedAt: at.Add(time.Duration(i) * time.Minute)})
+	}
+	steps[11] = &db.TaskStep{Step: "running tests", Detail: "go test ./...", CreatedAt: at.Add(11 * time.Minute)}
+	wp := &progress.WorkProgress{
+		Work: &db.Work{ID: "w-abc"},
+		Tasks: []*progress.TaskProgress{
+			{Task: &db.Task{ID: "w-abc.1", TaskType: "implement", Status: db.StatusProcessing}, Steps: steps},
+			{Task: &db.Task{ID: "w-abc.2", TaskType: "implement", Status: db.StatusCompleted}, Steps: steps},
+		},
+	}
+	p := NewWorkOverviewPanel(DarkTheme())
+	p.SetFocusedWork(wp)
+
+	// Only the running task shows its latest step
+	require.Contains(t, p.renderTaskLine(0, 80), "▶ running tests")
+	require.NotContains(t, p.renderTaskLine(1, 80), "running tests")
+
+	// The details list the latest steps with their times
+	details := NewWorkTaskPanel(DarkTheme())
+	details.SetTask(wp.Tasks[0])
+	content := details.renderTaskDetails(60)
+	require.Contains(t, content, "Steps (12):")
+	require.Contains(t, content, "... 2 earlier")
+	require.NotContains(t, content, "step 1\n")
+	require.Contains(t, content, "12:02:00 step 2")
+	require.Contains(t, content, "12:11:00 running tests")
+	require.Contains(t, content, "go test ./...")
+}
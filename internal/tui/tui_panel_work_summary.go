@@ -10,11 +10,14 @@ import (
 	"github.com/charmbracelet/x/ansi"
 	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/progress"
+	"github.com/newhook/co/internal/project"
 )
 
 // WorkSummaryPanel renders the right side of the work details view when the root issue is selected.
 // It displays work overview, alerts, root issue details, and statistics.
 type WorkSummaryPanel struct {
+	theme *Theme
+
 	// Dimensions
 	width  int
 	height int
@@ -26,17 +29,23 @@ type WorkSummaryPanel struct {
 	viewport viewport.Model
 
 	// Data
-	focusedWork *progress.WorkProgress
+	focusedWork   *progress.WorkProgress
+	staleReason   string   // Why the work's branch is stale, empty if it isn't
+	worktreeSize  string   // Measured worktree disk usage, empty until measured
+	failingChecks []string // Checks failing on the work's PR, when looked up
+	readOnly      bool     // Read-only mode: the branch isn't checked against the remote
+	autoPR        bool     // The project's [workflow] auto_pr, used when the work doesn't override it
 }
 
 // NewWorkSummaryPanel creates a new WorkSummaryPanel
-func NewWorkSummaryPanel() *WorkSummaryPanel {
+func NewWorkSummaryPanel(theme *Theme) *WorkSummaryPanel {
 	vp := viewport.New(40, 20) // Initial size, will be updated
 	// Mouse wheel events are handled at the top level (planModel.handleMouseWheel)
 	// to ensure only the panel under the cursor scrolls
 	vp.MouseWheelEnabled = false
 
 	return &WorkSummaryPanel{
+		theme:    theme,
 		width:    40,
 		height:   20,
 		viewport: vp,
@@ -69,6 +78,31 @@ func (p *WorkSummaryPanel) SetFocusedWork(focusedWork *progress.WorkProgress) {
 	p.viewport.SetYOffset(0)
 }
 
+// SetStaleReason sets why the work's branch is stale ("" if it isn't)
+func (p *WorkSummaryPanel) SetStaleReason(reason string) {
+	p.staleReason = reason
+}
+
+// SetReadOnly notes that the branch isn't checked in read-only mode
+func (p *WorkSummaryPanel) SetReadOnly(readOnly bool) {
+	p.readOnly = readOnly
+}
+
+// SetProjectAutoPR sets the project's auto_pr setting
+func (p *WorkSummaryPanel) SetProjectAutoPR(autoPR bool) {
+	p.autoPR = autoPR
+}
+
+// SetWorktreeSize sets the formatted disk usage of the work's worktree ("" if not measured)
+func (p *WorkSummaryPanel) SetWorktreeSize(size string) {
+	p.worktreeSize = size
+}
+
+// SetFailingChecks sets the checks failing on the work's PR (nil if not looked up)
+func (p *WorkSummaryPanel) SetFailingChecks(names []string) {
+	p.failingChecks = names
+}
+
 // ScrollUp scrolls the content up (shows earlier content)
 func (p *WorkSummaryPanel) ScrollUp() {
 	p.viewport.ScrollUp(1)
@@ -111,7 +145,7 @@ func (p *WorkSummaryPanel) renderFullContent(panelWidth int) string {
 	var content strings.Builder
 
 	if p.focusedWork == nil {
-		content.WriteString(tuiDimStyle.Render("Loading..."))
+		content.WriteString(p.theme.Dim.Render("Loading..."))
 		return content.String()
 	}
 
@@ -119,7 +153,7 @@ func (p *WorkSummaryPanel) renderFullContent(panelWidth int) string {
 	contentWidth := panelWidth - 2
 
 	// == Work Overview Section ==
-	overviewStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("214"))
+	overviewStyle := lipgloss.NewStyle().Bold(true).Foreground(p.theme.AccentColor)
 	content.WriteString(overviewStyle.Render("Work Overview"))
 	content.WriteString("\n")
 	content.WriteString(strings.Repeat("─", contentWidth))
@@ -129,24 +163,91 @@ func (p *WorkSummaryPanel) renderFullContent(panelWidth int) string {
 	statusStyle := lipgloss.NewStyle()
 	switch p.focusedWork.Work.Status {
 	case db.StatusCompleted:
-		statusStyle = statusStyle.Foreground(lipgloss.Color("82"))
+		statusStyle = statusStyle.Foreground(p.theme.SuccessColor)
 	case db.StatusProcessing:
-		statusStyle = statusStyle.Foreground(lipgloss.Color("214"))
+		statusStyle = statusStyle.Foreground(p.theme.AccentColor)
 	case db.StatusFailed:
-		statusStyle = statusStyle.Foreground(lipgloss.Color("196"))
+		statusStyle = statusStyle.Foreground(p.theme.ErrorColor)
 	default:
-		statusStyle = statusStyle.Foreground(lipgloss.Color("247"))
+		statusStyle = statusStyle.Foreground(p.theme.MutedColor)
+	}
+	status := statusStyle.Render(p.focusedWork.Work.Status)
+	if p.focusedWork.Work.Paused {
+		status += lipgloss.NewStyle().Foreground(p.theme.WarningColor).Render(" ⏸ paused")
+	}
+	if at := p.focusedWork.Work.ScheduledRunAt; at != nil {
+		status += lipgloss.NewStyle().Foreground(p.theme.AccentColor).Render(" ⏰ runs at " + formatScheduledRun(*at, time.Now()))
+	}
+	fmt.Fprintf(&content, "Status: %s\n", status)
+	var running []string
+	for _, task := range p.focusedWork.Tasks {
+		if task.Task.Status == db.StatusProcessing {
+			running = append(running, task.Task.ID)
+		}
+	}
+	if len(running) > 0 {
+		fmt.Fprintf(&content, "Running: %s\n", strings.Join(running, ", "))
+	}
+	if n := p.focusedWork.Work.MaxParallelTasks; n > 0 {
+		fmt.Fprintf(&content, "Parallel tasks: %d\n", n)
+	}
+	if base := p.focusedWork.Work.BaseBranch; base != "" {
+		fmt.Fprintf(&content, "Base: %s\n", base)
+	}
+	if p.staleReason != "" {
+		staleStyle := lipgloss.NewStyle().Foreground(p.theme.MutedColor)
+		fmt.Fprintf(&content, "%s\n", staleStyle.Render("Stale: "+p.staleReason+" (C to clean up)"))
+	}
+	if p.readOnly {
+		fmt.Fprintf(&content, "Branch: %s\n", p.theme.Dim.Render("not checked against the remote (read-only)"))
+	}
+	if p.focusedWork.Work.IsRemote() {
+		fmt.Fprintf(&content, "Host: %s\n", p.focusedWork.Work.RemoteHost)
+	}
+	if p.focusedWork.WorktreeMissing {
+		fmt.Fprintf(&content, "Worktree: %s\n", p.theme.Error.Render("missing at "+p.focusedWork.Work.WorktreePath+" (H to relocate)"))
+	} else if p.worktreeSize != "" {
+		fmt.Fprintf(&content, "Worktree: %s\n", p.worktreeSize)
+	}
+
+	// Notes: the first few lines, the rest is in the editor
+	if notes := strings.TrimSpace(p.focusedWork.Work.Notes); notes != "" {
+		content.WriteString("Notes:\n")
+		lines := strings.Split(wrapText(notes, contentWidth-2), "\n")
+		for i, line := range lines {
+			if i == workNotesPreviewLines {
+				fmt.Fprintf(&content, "  %s\n", p.theme.Dim.Render(fmt.Sprintf("… %d more lines (N to edit)", len(lines)-i)))
+				break
+			}
+			fmt.Fprintf(&content, "  %s\n", line)
+		}
+	}
+
+	// Env overrides: only the keys, values may be secrets
+	if keys := project.EnvKeys(p.focusedWork.Work.Env); len(keys) > 0 {
+		fmt.Fprintf(&content, "Env overrides: %s\n", p.theme.Dim.Render(strings.Join(keys, ", ")+" (E to edit)"))
+	}
+
+	// Auto-PR: shown when on, or when the work turns the project's setting off
+	autoPR := p.autoPR
+	if p.focusedWork.Work.AutoPR != nil {
+		autoPR = *p.focusedWork.Work.AutoPR
+	}
+	switch {
+	case autoPR:
+		fmt.Fprintf(&content, "Auto-PR: enabled %s\n", p.theme.Dim.Render("(O to toggle)"))
+	case p.autoPR:
+		fmt.Fprintf(&content, "Auto-PR: disabled for this work %s\n", p.theme.Dim.Render("(O to toggle)"))
 	}
-	fmt.Fprintf(&content, "Status: %s\n", statusStyle.Render(p.focusedWork.Work.Status))
 
 	// PR URL (if available)
 	if p.focusedWork.Work.PRURL != "" {
-		prStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("81"))
+		prStyle := lipgloss.NewStyle().Foreground(p.theme.InfoColor)
 		fmt.Fprintf(&content, "PR: %s\n", prStyle.Render(p.focusedWork.Work.PRURL))
 
 		// PR Status section (only show if we have a PR)
 		content.WriteString("\n")
-		prStatusHeaderStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("141"))
+		prStatusHeaderStyle := lipgloss.NewStyle().Bold(true).Foreground(p.theme.HighlightColor)
 		content.WriteString(prStatusHeaderStyle.Render("PR Status"))
 		content.WriteString("\n")
 
@@ -157,19 +258,22 @@ func (p *WorkSummaryPanel) renderFullContent(panelWidth int) string {
 		}
 		ciIcon := "⏳"
 		ciText := "Pending"
-		ciColor := lipgloss.Color("226") // yellow
+		ciColor := p.theme.WarningColor // yellow
 		switch ciStatus {
 		case db.CIStatusSuccess:
-			ciIcon = "✓"
+			ciIcon = p.theme.Icons.Completed
 			ciText = "Passing"
-			ciColor = lipgloss.Color("82") // green
+			ciColor = p.theme.SuccessColor // green
 		case db.CIStatusFailure:
-			ciIcon = "✗"
+			ciIcon = p.theme.Icons.Failed
 			ciText = "Failing"
-			ciColor = lipgloss.Color("196") // red
+			ciColor = p.theme.ErrorColor // red
 		}
 		ciStyle := lipgloss.NewStyle().Foreground(ciColor)
 		fmt.Fprintf(&content, "  CI: %s\n", ciStyle.Render(ciIcon+" "+ciText))
+		if ciStatus == db.CIStatusFailure && len(p.failingChecks) > 0 {
+			fmt.Fprintf(&content, "    Checks: %s\n", ciStyle.Render(strings.Join(p.failingChecks, ", ")))
+		}
 
 		// Approval Status
 		approvalStatus := p.focusedWork.ApprovalStatus
@@ -178,27 +282,27 @@ func (p *WorkSummaryPanel) renderFullContent(panelWidth int) string {
 		}
 		approvalIcon := "⏳"
 		approvalText := "Awaiting review"
-		approvalColor := lipgloss.Color("247") // dim
+		approvalColor := p.theme.MutedColor // dim
 		switch approvalStatus {
 		case db.ApprovalStatusApproved:
-			approvalIcon = "✓"
+			approvalIcon = p.theme.Icons.Completed
 			if len(p.focusedWork.Approvers) > 0 {
 				approvalText = "Approved by " + strings.Join(p.focusedWork.Approvers, ", ")
 			} else {
 				approvalText = "Approved"
 			}
-			approvalColor = lipgloss.Color("82") // green
+			approvalColor = p.theme.SuccessColor // green
 		case db.ApprovalStatusChangesRequested:
 			approvalIcon = "⚠"
 			approvalText = "Changes requested"
-			approvalColor = lipgloss.Color("214") // orange
+			approvalColor = p.theme.AccentColor // orange
 		}
 		approvalStyle := lipgloss.NewStyle().Foreground(approvalColor)
 		fmt.Fprintf(&content, "  Review: %s\n", approvalStyle.Render(approvalIcon+" "+approvalText))
 
 		// Feedback (show bead IDs)
 		if p.focusedWork.FeedbackCount > 0 {
-			feedbackStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+			feedbackStyle := lipgloss.NewStyle().Foreground(p.theme.ErrorColor)
 			beadIDsStr := strings.Join(p.focusedWork.FeedbackBeadIDs, ", ")
 			fmt.Fprintf(&content, "  Feedback: %s\n", feedbackStyle.Render(beadIDsStr))
 		}
@@ -207,32 +311,32 @@ func (p *WorkSummaryPanel) renderFullContent(panelWidth int) string {
 		if p.focusedWork.MergeableState != "" {
 			mergeIcon := "⏳"
 			mergeText := "Unknown"
-			mergeColor := lipgloss.Color("247") // dim
+			mergeColor := p.theme.MutedColor // dim
 			switch p.focusedWork.MergeableState {
 			case db.MergeableStateClean:
-				mergeIcon = "✓"
+				mergeIcon = p.theme.Icons.Completed
 				mergeText = "Ready to merge"
-				mergeColor = lipgloss.Color("82") // green
+				mergeColor = p.theme.SuccessColor // green
 			case db.MergeableStateDirty:
 				mergeIcon = "⚠"
 				mergeText = "Has conflicts"
-				mergeColor = lipgloss.Color("196") // red
+				mergeColor = p.theme.ErrorColor // red
 			case db.MergeableStateBlocked:
 				mergeIcon = "⏸"
 				mergeText = "Blocked by checks"
-				mergeColor = lipgloss.Color("226") // yellow
+				mergeColor = p.theme.WarningColor // yellow
 			case db.MergeableStateBehind:
 				mergeIcon = "↓"
 				mergeText = "Behind main"
-				mergeColor = lipgloss.Color("247") // dim
+				mergeColor = p.theme.MutedColor // dim
 			case db.MergeableStateDraft:
 				mergeIcon = "📝"
 				mergeText = "Draft PR"
-				mergeColor = lipgloss.Color("247") // dim
+				mergeColor = p.theme.MutedColor // dim
 			case db.MergeableStateUnstable:
 				mergeIcon = "⚠"
 				mergeText = "CI unstable"
-				mergeColor = lipgloss.Color("226") // yellow
+				mergeColor = p.theme.WarningColor // yellow
 			}
 			mergeStyle := lipgloss.NewStyle().Foreground(mergeColor)
 			fmt.Fprintf(&content, "  Merge: %s\n", mergeStyle.Render(mergeIcon+" "+mergeText))
@@ -268,33 +372,46 @@ func (p *WorkSummaryPanel) renderFullContent(panelWidth int) string {
 
 	progressStyle := lipgloss.NewStyle().Bold(true)
 	if percentage == 100 {
-		progressStyle = progressStyle.Foreground(lipgloss.Color("82"))
+		progressStyle = progressStyle.Foreground(p.theme.SuccessColor)
 	} else if percentage >= 75 {
-		progressStyle = progressStyle.Foreground(lipgloss.Color("226"))
+		progressStyle = progressStyle.Foreground(p.theme.WarningColor)
 	} else if percentage >= 50 {
-		progressStyle = progressStyle.Foreground(lipgloss.Color("214"))
+		progressStyle = progressStyle.Foreground(p.theme.AccentColor)
 	} else {
-		progressStyle = progressStyle.Foreground(lipgloss.Color("247"))
+		progressStyle = progressStyle.Foreground(p.theme.MutedColor)
 	}
 	content.WriteString("Progress: ")
 	content.WriteString(progressStyle.Render(fmt.Sprintf("%d%%", percentage)))
 	fmt.Fprintf(&content, " (%d/%d tasks completed)\n", completedTasks, len(p.focusedWork.Tasks))
 
 	// Alerts/Warnings
-	if p.focusedWork.UnassignedBeadCount > 0 || p.focusedWork.FeedbackCount > 0 {
+	setupWarnings := p.focusedWork.Work.SetupWarnings
+	rateLimited := len(rateLimitedTaskIDs(p.focusedWork))
+	if p.focusedWork.UnassignedBeadCount > 0 || p.focusedWork.FeedbackCount > 0 || len(setupWarnings) > 0 || rateLimited > 0 {
 		content.WriteString("\n")
 		alertHeaderStyle := lipgloss.NewStyle().Bold(true)
 		content.WriteString(alertHeaderStyle.Render("Alerts:"))
 		content.WriteString("\n")
 
 		if p.focusedWork.UnassignedBeadCount > 0 {
-			warningStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+			warningStyle := lipgloss.NewStyle().Foreground(p.theme.AccentColor)
 			content.WriteString(warningStyle.Render(fmt.Sprintf("  ⚠ %d unassigned bead(s) need attention\n", p.focusedWork.UnassignedBeadCount)))
 		}
 		if p.focusedWork.FeedbackCount > 0 {
-			alertStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+			alertStyle := lipgloss.NewStyle().Foreground(p.theme.ErrorColor)
 			beadIDsStr := strings.Join(p.focusedWork.FeedbackBeadIDs, ", ")
-			content.WriteString(alertStyle.Render(fmt.Sprintf("  ● %d pending PR feedback: %s\n", p.focusedWork.FeedbackCount, beadIDsStr)))
+			content.WriteString(alertStyle.Render(fmt.Sprintf("  %s %d pending PR feedback: %s\n", p.theme.Icons.Activity, p.focusedWork.FeedbackCount, beadIDsStr)))
+		}
+		warningStyle := lipgloss.NewStyle().Foreground(p.theme.WarningColor)
+		if rateLimited > 0 {
+			content.WriteString(warningStyle.Render(fmt.Sprintf("  ⚠ %d rate-limited task(s), [X] to retry", rateLimited)))
+			content.WriteString("\n")
+		}
+		for _, warning := range setupWarnings {
+			// Only the first line; `co work show` has a failed hook's output
+			first, _, _ := strings.Cut(warning, "\n")
+			content.WriteString(warningStyle.Render(ansi.Truncate("  ⚠ Worktree setup: "+first, contentWidth, "...")))
+			content.WriteString("\n")
 		}
 	}
 
@@ -302,7 +419,7 @@ func (p *WorkSummaryPanel) renderFullContent(panelWidth int) string {
 
 	// == Root Issue Section ==
 	rootID := p.focusedWork.Work.RootIssueID
-	issueHeaderStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("81"))
+	issueHeaderStyle := lipgloss.NewStyle().Bold(true).Foreground(p.theme.InfoColor)
 	content.WriteString(issueHeaderStyle.Render("Root Issue"))
 	content.WriteString("\n")
 	content.WriteString(strings.Repeat("─", contentWidth))
@@ -348,16 +465,15 @@ func (p *WorkSummaryPanel) renderFullContent(panelWidth int) string {
 		if rootBead.Description != "" {
 			content.WriteString("\n")
 			content.WriteString("Description:\n")
-			// Keep multiline but truncate to reasonable length
-			desc := rootBead.Description
-			desc = ansi.Truncate(desc, 300, "...")
-			content.WriteString(tuiDimStyle.Render(desc))
+			// Keep multiline but wrap to the panel and truncate to reasonable length
+			desc := ansi.Truncate(wrapText(rootBead.Description, contentWidth), 300, "...")
+			content.WriteString(p.theme.Dim.Render(desc))
 			content.WriteString("\n")
 		}
 	} else {
 		// Fallback if bead not found
 		fmt.Fprintf(&content, "Issue: %s\n", rootID)
-		content.WriteString(tuiDimStyle.Render("(Issue details not loaded)"))
+		content.WriteString(p.theme.Dim.Render("(Issue details not loaded)"))
 		content.WriteString("\n")
 	}
 
@@ -373,30 +489,32 @@ func (p *WorkSummaryPanel) renderFullContent(panelWidth int) string {
 	var estimateTasks, implementTasks, reviewTasks, prTasks int
 	for _, task := range p.focusedWork.Tasks {
 		switch task.Task.TaskType {
-		case "estimate":
+		case db.TaskTypeEstimate:
 			estimateTasks++
-		case "implement":
+		case db.TaskTypeImplement:
 			implementTasks++
-		case "review":
+		case db.TaskTypeReview:
 			reviewTasks++
-		case "pr", "update-pr-description":
+		case db.TaskTypePR, db.TaskTypeUpdatePRDescription:
 			prTasks++
 		}
 	}
 	if estimateTasks > 0 || reviewTasks > 0 || prTasks > 0 {
 		content.WriteString("  Task Breakdown: ")
 		parts := []string{}
-		if estimateTasks > 0 {
-			parts = append(parts, fmt.Sprintf("%d estimate", estimateTasks))
-		}
-		if implementTasks > 0 {
-			parts = append(parts, fmt.Sprintf("%d implement", implementTasks))
-		}
-		if reviewTasks > 0 {
-			parts = append(parts, fmt.Sprintf("%d review", reviewTasks))
-		}
-		if prTasks > 0 {
-			parts = append(parts, fmt.Sprintf("%d pr", prTasks))
+		for _, c := range []struct {
+			taskType string
+			count    int
+		}{
+			{db.TaskTypeEstimate, estimateTasks},
+			{db.TaskTypeImplement, implementTasks},
+			{db.TaskTypeReview, reviewTasks},
+			{db.TaskTypePR, prTasks},
+		} {
+			if c.count > 0 {
+				glyph := p.theme.taskTypeStyle(c.taskType).Render(p.theme.taskTypeBadge(c.taskType, nil).glyph)
+				parts = append(parts, fmt.Sprintf("%s %d %s", glyph, c.count, c.taskType))
+			}
 		}
 		content.WriteString(strings.Join(parts, ", "))
 		content.WriteString("\n")
@@ -2,6 +2,7 @@ package tui
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
@@ -28,6 +29,8 @@ const (
 // Each tab can be clicked to focus that work. Running works show a spinner.
 // Styled similar to zellij with seamless color transitions between tabs.
 type WorkTabsBar struct {
+	theme *Theme
+
 	// Dimensions
 	width int
 
@@ -35,7 +38,10 @@ type WorkTabsBar struct {
 	workTiles          []*progress.WorkProgress
 	focusedWorkID      string
 	hoveredTabID       string
-	orchestratorHealth map[string]bool // workID -> orchestrator alive
+	orchestratorHealth map[string]db.OrchestratorHealth // workID -> orchestrator health
+	staleWorks         map[string]string                // workID -> why its branch is stale
+	workActivity       map[string]workActivity          // workID -> changes since the work was last viewed
+	groupHeaders       map[string]workGroupHeader       // workID -> header shown before it, while works are grouped
 
 	// Panel state
 	activePanel Panel // Which panel is currently focused
@@ -48,15 +54,16 @@ type WorkTabsBar struct {
 }
 
 // NewWorkTabsBar creates a new WorkTabsBar
-func NewWorkTabsBar() *WorkTabsBar {
+func NewWorkTabsBar(theme *Theme) *WorkTabsBar {
 	s := spinner.New()
-	s.Spinner = spinner.MiniDot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	s.Spinner = theme.Icons.MiniSpinner
+	s.Style = theme.Spinner
 
 	return &WorkTabsBar{
+		theme:              theme,
 		width:              80,
 		spinner:            s,
-		orchestratorHealth: make(map[string]bool),
+		orchestratorHealth: make(map[string]db.OrchestratorHealth),
 		zonePrefix:         zone.NewPrefix(),
 	}
 }
@@ -82,10 +89,31 @@ func (b *WorkTabsBar) SetHoveredTabID(id string) {
 }
 
 // SetOrchestratorHealth sets the orchestrator health for a work
-func (b *WorkTabsBar) SetOrchestratorHealth(healthMap map[string]bool) {
+func (b *WorkTabsBar) SetOrchestratorHealth(healthMap map[string]db.OrchestratorHealth) {
 	b.orchestratorHealth = healthMap
 }
 
+// SetStaleWorks sets which works have a merged or deleted branch
+func (b *WorkTabsBar) SetStaleWorks(stale map[string]string) {
+	b.staleWorks = stale
+}
+
+// SetWorkActivity sets which works changed since they were last viewed
+func (b *WorkTabsBar) SetWorkActivity(activity map[string]workActivity) {
+	b.workActivity = activity
+}
+
+// SetGroupHeaders sets the group headers shown before the first work of
+// each group, or nil when works aren't grouped
+func (b *WorkTabsBar) SetGroupHeaders(headers map[string]workGroupHeader) {
+	b.groupHeaders = headers
+}
+
+// ClearWorkActivity drops the new-activity badge of a work
+func (b *WorkTabsBar) ClearWorkActivity(workID string) {
+	delete(b.workActivity, workID)
+}
+
 // SetActivePanel sets which panel is currently active
 func (b *WorkTabsBar) SetActivePanel(panel Panel) {
 	b.activePanel = panel
@@ -133,7 +161,7 @@ func (b *WorkTabsBar) getWorkState(work *progress.WorkProgress) WorkState {
 	}
 
 	// Check orchestrator health
-	if alive, ok := b.orchestratorHealth[work.Work.ID]; ok && !alive {
+	if health, ok := b.orchestratorHealth[work.Work.ID]; ok && health != db.OrchestratorRunning {
 		return WorkStateDead
 	}
 
@@ -143,14 +171,7 @@ func (b *WorkTabsBar) getWorkState(work *progress.WorkProgress) WorkState {
 
 // Render renders the tab bar with zellij-like styling
 func (b *WorkTabsBar) Render() string {
-	// Colors
-	barBg := lipgloss.Color("235")      // Dark background
-	ribbonBg := lipgloss.Color("29")    // Teal for ribbon
-	ribbonFg := lipgloss.Color("15")    // White text
-	inactiveBg := lipgloss.Color("240") // Gray for inactive
-	inactiveFg := lipgloss.Color("255") // Light text
-	activeBg := lipgloss.Color("214")   // Orange for active
-	activeFg := lipgloss.Color("232")   // Dark text
+	barBg := b.theme.TabBarBg
 
 	// Zellij-style: uses right-pointing triangle on both sides
 	triangle := "\ue0b0" // U+E0B0 - right-pointing solid triangle
@@ -163,12 +184,7 @@ func (b *WorkTabsBar) Render() string {
 	if b.activePanel == PanelWorkTabs {
 		ribbonText = "► Ørchestratör ◄"
 	}
-	ribbonStyle := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(ribbonFg).
-		Background(ribbonBg)
-
-	content += ribbonStyle.Render(ribbonText)
+	content += b.theme.TabRibbon.Render(ribbonText)
 
 	// Space before tabs
 	spaceStyle := lipgloss.NewStyle().Background(barBg)
@@ -179,19 +195,25 @@ func (b *WorkTabsBar) Render() string {
 			continue
 		}
 
+		// Group headers are labels only: not zoned, so they can't be clicked
+		if header, ok := b.groupHeaders[work.Work.ID]; ok {
+			headerStyle := lipgloss.NewStyle().
+				Foreground(b.theme.HighlightColor).
+				Background(barBg).
+				Bold(true)
+			content += headerStyle.Render("▾ "+header.label()) + spaceStyle.Render(" ")
+		}
+
 		isActive := work.Work.ID == b.focusedWorkID
 		isHovered := work.Work.ID == b.hoveredTabID
 		workState := b.getWorkState(work)
 
-		// Determine tab colors
-		var tabBg, tabFg lipgloss.Color
+		// Determine tab style
+		tabStyle := b.theme.TabInactive
 		if isActive || isHovered {
-			tabBg = activeBg
-			tabFg = activeFg
-		} else {
-			tabBg = inactiveBg
-			tabFg = inactiveFg
+			tabStyle = b.theme.TabActive
 		}
+		tabBg := tabStyle.GetBackground()
 
 		// Build the entire tab content
 		var tabBuilder string
@@ -206,9 +228,9 @@ func (b *WorkTabsBar) Render() string {
 		var icon string
 		switch workState {
 		case WorkStateMerged:
-			icon = "✓" // Checkmark for merged PRs
+			icon = b.theme.Icons.Completed // Checkmark for merged PRs
 		case WorkStateCompleted:
-			icon = "✓"
+			icon = b.theme.Icons.Completed
 		case WorkStateRunning:
 			// Get raw spinner frame by removing style - View() with styling adds
 			// ANSI reset codes that break the background color of the containing tab
@@ -216,11 +238,11 @@ func (b *WorkTabsBar) Render() string {
 			unstyled.Style = lipgloss.NewStyle()
 			icon = unstyled.View()
 		case WorkStateFailed:
-			icon = "✗"
+			icon = b.theme.Icons.Failed
 		case WorkStateDead:
-			icon = "☠"
+			icon = b.theme.Icons.Dead
 		default:
-			icon = "○"
+			icon = b.theme.Icons.Pending
 		}
 
 		// Work name
@@ -232,25 +254,77 @@ func (b *WorkTabsBar) Render() string {
 
 		// Tab content with optional unseen badge
 		tabContent := fmt.Sprintf(" %s %s", icon, name)
-		tabStyle := lipgloss.NewStyle().
-			Foreground(tabFg).
-			Background(tabBg)
 		tabBuilder += tabStyle.Render(tabContent)
 
+		// Paused works don't pick up new tasks until resumed
+		if work.Work.Paused {
+			badgeStyle := lipgloss.NewStyle().
+				Foreground(b.theme.WarningColor).
+				Background(tabBg)
+			tabBuilder += badgeStyle.Render(" ⏸ paused")
+		}
+
+		// Scheduled works are started by the control plane later (co run --at)
+		if at := work.Work.ScheduledRunAt; at != nil {
+			badgeStyle := lipgloss.NewStyle().
+				Foreground(b.theme.AccentColor).
+				Background(tabBg)
+			tabBuilder += badgeStyle.Render(" ⏰ " + formatScheduledRun(*at, time.Now()))
+		}
+
+		// Stale works have a branch that's merged or gone and only need cleaning up
+		if b.staleWorks[work.Work.ID] != "" {
+			badgeStyle := lipgloss.NewStyle().
+				Foreground(b.theme.MutedColor).
+				Background(tabBg)
+			tabBuilder += badgeStyle.Render(" ⌫ stale")
+		}
+
+		// A remote work runs on its host
+		if work.Work.IsRemote() {
+			badgeStyle := lipgloss.NewStyle().
+				Foreground(b.theme.MutedColor).
+				Background(tabBg)
+			tabBuilder += badgeStyle.Render(" @" + work.Work.RemoteHost)
+		}
+
+		// A worktree that's gone makes every action in the work fail
+		if work.WorktreeMissing {
+			badgeStyle := lipgloss.NewStyle().
+				Foreground(b.theme.ErrorColor).
+				Background(tabBg)
+			tabBuilder += badgeStyle.Render(" ⚠ worktree missing")
+		}
+
 		// Add pending work indicator (orange warning for feedback or unassigned beads)
 		if work.FeedbackCount > 0 || work.UnassignedBeadCount > 0 {
 			badgeStyle := lipgloss.NewStyle().
-				Foreground(lipgloss.Color("214")). // Orange for pending work
+				Foreground(b.theme.AccentColor). // Orange for pending work
 				Background(tabBg)
 			tabBuilder += badgeStyle.Render(" \uf071") // nf-fa-exclamation_triangle
 		}
 
-		// Add unseen PR changes indicator (colored dot)
-		if work.HasUnseenPRChanges {
+		// Add unseen changes indicator (colored dot) for PR changes or task and
+		// bead activity since the work was last viewed, with new completions
+		// and failures counted
+		activity := b.workActivity[work.Work.ID]
+		if work.HasUnseenPRChanges || activity.changed {
+			badgeStyle := lipgloss.NewStyle().
+				Foreground(b.theme.InfoColor). // Cyan dot for new changes
+				Background(tabBg)
+			tabBuilder += badgeStyle.Render(" " + b.theme.Icons.Activity)
+		}
+		if activity.completed > 0 {
+			badgeStyle := lipgloss.NewStyle().
+				Foreground(b.theme.SuccessColor).
+				Background(tabBg)
+			tabBuilder += badgeStyle.Render(fmt.Sprintf(" %d%s", activity.completed, b.theme.Icons.Completed))
+		}
+		if activity.failed > 0 {
 			badgeStyle := lipgloss.NewStyle().
-				Foreground(lipgloss.Color("81")). // Cyan dot for new changes
+				Foreground(b.theme.ErrorColor).
 				Background(tabBg)
-			tabBuilder += badgeStyle.Render(" ●")
+			tabBuilder += badgeStyle.Render(fmt.Sprintf(" %d%s", activity.failed, b.theme.Icons.Failed))
 		}
 
 		// Trailing space
@@ -3,17 +3,21 @@ package tui
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/x/ansi"
 	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/progress"
+	"github.com/newhook/co/internal/worktree"
 )
 
 // WorkTaskPanel renders the right side of the work details view when a task or unassigned bead is selected.
 // It displays task details including ID, type, status, beads, and errors, or unassigned bead details.
 type WorkTaskPanel struct {
+	theme *Theme
+
 	// Dimensions
 	width  int
 	height int
@@ -25,19 +29,23 @@ type WorkTaskPanel struct {
 	viewport viewport.Model
 
 	// Data
-	selectedTask   *progress.TaskProgress // The selected task, or nil if unassigned bead
-	selectedBead   *progress.BeadProgress // The selected unassigned bead, or nil if task
-	isUnassigned   bool          // True if showing an unassigned bead
+	selectedTask *progress.TaskProgress   // The selected task, or nil if unassigned bead
+	selectedBead *progress.BeadProgress   // The selected unassigned bead, or nil if task
+	isUnassigned bool                     // True if showing an unassigned bead
+	commitCounts map[string]int           // beadID -> commits on the work branch mentioning it
+	beadTime     map[string]time.Duration // beadID -> task run time it has taken
+	taskGlyphs   map[string]string        // Custom task type -> glyph from [workflow.task_types]
 }
 
 // NewWorkTaskPanel creates a new WorkTaskPanel
-func NewWorkTaskPanel() *WorkTaskPanel {
+func NewWorkTaskPanel(theme *Theme) *WorkTaskPanel {
 	vp := viewport.New(40, 20) // Initial size, will be updated
 	// Mouse wheel events are handled at the top level (planModel.handleMouseWheel)
 	// to ensure only the panel under the cursor scrolls
 	vp.MouseWheelEnabled = false
 
 	return &WorkTaskPanel{
+		theme:    theme,
 		width:    40,
 		height:   20,
 		viewport: vp,
@@ -81,6 +89,21 @@ func (p *WorkTaskPanel) SetUnassignedBead(bead *progress.BeadProgress) {
 	p.viewport.SetYOffset(0)
 }
 
+// SetBeadCommitCounts sets the per-bead commit counts shown next to each bead
+func (p *WorkTaskPanel) SetBeadCommitCounts(counts map[string]int) {
+	p.commitCounts = counts
+}
+
+// SetBeadDurations sets the per-bead task run time shown next to each bead
+func (p *WorkTaskPanel) SetBeadDurations(durations map[string]time.Duration) {
+	p.beadTime = durations
+}
+
+// SetTaskTypeGlyphs sets the glyphs registered for custom task types
+func (p *WorkTaskPanel) SetTaskTypeGlyphs(glyphs map[string]string) {
+	p.taskGlyphs = glyphs
+}
+
 // Clear clears the panel content
 func (p *WorkTaskPanel) Clear() {
 	p.selectedTask = nil
@@ -122,7 +145,7 @@ func (p *WorkTaskPanel) Render(panelWidth int) string {
 	} else if p.selectedTask != nil {
 		fullContent = p.renderTaskDetails(panelWidth)
 	} else {
-		fullContent = tuiDimStyle.Render("Select an item to view details")
+		fullContent = p.theme.Dim.Render("Select an item to view details")
 	}
 
 	// Set the content in the viewport
@@ -135,7 +158,7 @@ func (p *WorkTaskPanel) Render(panelWidth int) string {
 // renderTaskDetails renders details for a task
 func (p *WorkTaskPanel) renderTaskDetails(panelWidth int) string {
 	if p.selectedTask == nil {
-		return tuiDimStyle.Render("No task selected")
+		return p.theme.Dim.Render("No task selected")
 	}
 
 	var content strings.Builder
@@ -145,7 +168,15 @@ func (p *WorkTaskPanel) renderTaskDetails(panelWidth int) string {
 	contentWidth := panelWidth - 2
 
 	fmt.Fprintf(&content, "ID: %s\n", task.Task.ID)
-	fmt.Fprintf(&content, "Type: %s\n", task.Task.TaskType)
+	if task.Title != "" {
+		fmt.Fprintf(&content, "Title: %s\n", task.Title)
+	}
+	taskType := task.Task.TaskType
+	if taskType == "" {
+		taskType = db.TaskTypeImplement
+	}
+	badge := p.theme.taskTypeBadge(taskType, p.taskGlyphs)
+	fmt.Fprintf(&content, "Type: %s %s\n", p.theme.taskTypeStyle(taskType).Render(badge.glyph), taskType)
 	fmt.Fprintf(&content, "Status: %s\n", task.Task.Status)
 
 	if task.Task.ComplexityBudget > 0 {
@@ -159,26 +190,96 @@ func (p *WorkTaskPanel) renderTaskDetails(panelWidth int) string {
 			fmt.Fprintf(&content, "  ... and %d more\n", len(task.Beads)-10)
 			break
 		}
-		statusStr := "○"
+		statusStr := p.theme.Icons.Pending
+		blocked := false
 		switch bead.Status {
-		case db.StatusCompleted:
-			statusStr = "✓"
-		case db.StatusProcessing:
-			statusStr = "●"
+		case db.StatusCompleted, db.StatusProcessing:
+			statusStr = p.theme.statusGlyph(bead.Status)
+		default:
+			if len(bead.BlockedBy) > 0 {
+				statusStr, blocked = p.theme.Icons.Waiting, true
+			}
 		}
 		beadLine := fmt.Sprintf("  %s %s", statusStr, bead.ID)
+		var meta []string
+		if commits := formatCommitCount(p.commitCounts[bead.ID]); commits != "" {
+			meta = append(meta, commits)
+		}
+		if spent := formatBeadTime(p.beadTime[bead.ID]); spent != "" {
+			meta = append(meta, spent)
+		}
+		metaStr := strings.Join(meta, " · ")
+		if metaStr != "" {
+			beadLine += " " + metaStr
+		}
 		if bead.Title != "" {
-			// "  ○ ID: " is about 8 chars prefix
-			beadLine += ": " + ansi.Truncate(bead.Title, contentWidth-8-len(bead.ID), "...")
+			// "  <icon> ID: " is about 8 chars prefix
+			maxTitleLen := contentWidth - 7 - ansi.StringWidth(statusStr) - ansi.StringWidth(bead.ID)
+			if metaStr != "" {
+				maxTitleLen -= ansi.StringWidth(metaStr) + 1
+			}
+			beadLine += ": " + ansi.Truncate(bead.Title, maxTitleLen, "...")
 		}
 		content.WriteString(beadLine + "\n")
+		if blocked {
+			content.WriteString(p.renderBlockedBy(bead.BlockedBy, "    ", contentWidth))
+		}
+	}
+
+	// Show progress steps, the latest ones when there are many
+	if len(task.Steps) > 0 {
+		fmt.Fprintf(&content, "\nSteps (%d):\n", len(task.Steps))
+		steps := task.Steps
+		if len(steps) > 10 {
+			fmt.Fprintf(&content, "  ... %d earlier\n", len(steps)-10)
+			steps = steps[len(steps)-10:]
+		}
+		for _, step := range steps {
+			line := fmt.Sprintf("  %s %s", step.CreatedAt.Local().Format("15:04:05"), step.Step)
+			if step.Detail != "" {
+				detail := ansi.Truncate(step.Detail, max(contentWidth-ansi.StringWidth(line)-1, 8), "...")
+				line += " " + p.theme.Dim.Render(detail)
+			}
+			content.WriteString(line + "\n")
+		}
+	}
+
+	if len(task.Artifacts) > 0 {
+		fmt.Fprintf(&content, "\nArtifacts (%d): %s\n", len(task.Artifacts), p.theme.Dim.Render("[Enter] browse"))
+		for i, artifact := range task.Artifacts {
+			if i >= 10 {
+				fmt.Fprintf(&content, "  ... and %d more\n", len(task.Artifacts)-10)
+				break
+			}
+			info := fmt.Sprintf("%s  %s", worktree.FormatBytes(artifact.Size), artifact.ModTime.Format("Jan 2 15:04"))
+			name := ansi.Truncate(artifact.Name, max(contentWidth-4-ansi.StringWidth(info), 8), "...")
+			content.WriteString("  " + name + "  " + p.theme.Dim.Render(info) + "\n")
+		}
+	}
+
+	if len(task.Runs) > 0 {
+		fmt.Fprintf(&content, "\nRuns (%d):\n", len(task.Runs))
+		// The latest attempts matter most
+		first := max(len(task.Runs)-5, 0)
+		if first > 0 {
+			fmt.Fprintf(&content, "  ... %d earlier\n", first)
+		}
+		for i, run := range task.Runs[first:] {
+			when := p.theme.Dim.Render(run.StartedAt.Local().Format("Jan 2 15:04"))
+			line := fmt.Sprintf("  %s #%d %s", p.theme.statusIcon(run.Status), first+i+1, when)
+			summary := ansi.Truncate(run.Summary(), max(contentWidth-ansi.StringWidth(line)-1, 8), "...")
+			content.WriteString(line + " " + summary + "\n")
+		}
 	}
 
 	// Show error if failed
 	if task.Task.Status == db.StatusFailed && task.Task.ErrorMessage != "" {
-		errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+		errorStyle := lipgloss.NewStyle().Foreground(p.theme.ErrorColor)
 		content.WriteString("\n")
 		content.WriteString(errorStyle.Render("Error:"))
+		if badge := p.theme.failureBadge(task.Task.FailureKind); badge != "" {
+			content.WriteString(" " + badge)
+		}
 		content.WriteString("\n")
 		content.WriteString(ansi.Truncate(task.Task.ErrorMessage, contentWidth, "..."))
 	}
@@ -189,7 +290,7 @@ func (p *WorkTaskPanel) renderTaskDetails(panelWidth int) string {
 // renderUnassignedBeadDetails renders details for an unassigned bead
 func (p *WorkTaskPanel) renderUnassignedBeadDetails(panelWidth int) string {
 	if p.selectedBead == nil {
-		return tuiDimStyle.Render("No bead selected")
+		return p.theme.Dim.Render("No bead selected")
 	}
 
 	var content strings.Builder
@@ -199,10 +300,10 @@ func (p *WorkTaskPanel) renderUnassignedBeadDetails(panelWidth int) string {
 	contentWidth := panelWidth - 2
 
 	// Header with warning style and action hint
-	warningStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	warningStyle := lipgloss.NewStyle().Foreground(p.theme.AccentColor)
 	content.WriteString(warningStyle.Render("Unassigned Issue"))
 	content.WriteString(" ")
-	content.WriteString(tuiDimStyle.Render("[p] plan [r] run"))
+	content.WriteString(p.theme.Dim.Render("[p] plan [r] run"))
 	content.WriteString("\n\n")
 
 	fmt.Fprintf(&content, "ID: %s\n", bead.ID)
@@ -214,6 +315,9 @@ func (p *WorkTaskPanel) renderUnassignedBeadDetails(panelWidth int) string {
 	}
 	fmt.Fprintf(&content, "Priority: %d\n", bead.Priority)
 	fmt.Fprintf(&content, "Status: %s\n", bead.BeadStatus)
+	if len(bead.BlockedBy) > 0 {
+		content.WriteString(p.renderBlockedBy(bead.BlockedBy, "", contentWidth))
+	}
 
 	if bead.Description != "" {
 		content.WriteString("\nDescription:\n")
@@ -222,3 +326,33 @@ func (p *WorkTaskPanel) renderUnassignedBeadDetails(panelWidth int) string {
 
 	return content.String()
 }
+
+// renderBlockedBy renders the line naming the open beads that block a bead
+func (p *WorkTaskPanel) renderBlockedBy(blockers []string, indent string, contentWidth int) string {
+	line := ansi.Truncate(p.theme.Icons.Waiting+" Blocked by: "+strings.Join(blockers, ", "), contentWidth-ansi.StringWidth(indent), "...")
+	return indent + lipgloss.NewStyle().Foreground(p.theme.ErrorColor).Render(line) + "\n"
+}
+
+// formatBeadTime renders the task run time a bead has taken, or "" when it
+// has taken none
+func formatBeadTime(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	if d < time.Minute {
+		return d.Round(time.Second).String()
+	}
+	return d.Round(time.Minute).String()
+}
+
+// formatCommitCount renders a bead's commit count, or "" when it has none
+func formatCommitCount(n int) string {
+	switch n {
+	case 0:
+		return ""
+	case 1:
+		return "1 commit"
+	default:
+		return fmt.Sprintf("%d commits", n)
+	}
+}
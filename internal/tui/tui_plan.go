@@ -2,24 +2,34 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/charmbracelet/x/ansi"
 	"github.com/newhook/co/internal/beads"
 	beadswatcher "github.com/newhook/co/internal/beads/watcher"
+	"github.com/newhook/co/internal/claude"
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/git"
+	"github.com/newhook/co/internal/github"
+	"github.com/newhook/co/internal/logging"
 	"github.com/newhook/co/internal/progress"
 	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/tail"
+	"github.com/newhook/co/internal/task"
 	trackingwatcher "github.com/newhook/co/internal/tracking/watcher"
 	"github.com/newhook/co/internal/work"
+	"github.com/newhook/co/internal/worktree"
 	"github.com/newhook/co/internal/zellij"
 )
 
@@ -32,7 +42,9 @@ type trackingWatcherEventMsg trackingwatcher.WatcherEvent
 // planModel is the Plan Mode model focused on issue/bead management
 type planModel struct {
 	ctx         context.Context
+	cancel      context.CancelFunc // Cancels ctx when the model is torn down
 	proj        *project.Project
+	theme       *Theme
 	workService *work.WorkService // Shared WorkService for all work operations
 	width       int
 	height      int
@@ -64,18 +76,90 @@ type planModel struct {
 	statusMessage string
 	statusIsError bool
 	lastUpdate    time.Time
+	bdMissing     bool            // bd isn't in PATH, so keys that modify beads are disabled
+	readOnly      bool            // Nothing may be changed: --read-only, or the tracking database isn't writable
+	leaser        *instanceLeaser // Lease on .co/tui.lock, nil in read-only mode
+	primaryPID    int             // PID of the primary TUI while this one is a secondary, else 0
+
+	// Manual refresh state
+	refreshPending  int       // Loads still outstanding from ctrl+r/F5 (0 = none in flight)
+	lastUpdateFlash time.Time // When fresh data last arrived, for the status bar highlight
 
 	// Work state
-	focusedWorkID          string          // ID of focused work (splits screen)
-	workSelectionCleared   bool            // User manually cleared work selection filter (don't auto-restore)
-	pendingWorkSelectIndex int             // Index of work to select after tiles load (-1 = none)
-	workTiles              []*progress.WorkProgress // Cached work tiles for the tabs bar
-	workDetailsFocusLeft   bool            // Whether left panel has focus in work details (true=left, false=right)
-	addChildToWorkID       string          // Work ID to add newly created child bead to (for add-child-and-run flow)
+	focusedWorkID           string                           // ID of focused work (splits screen)
+	workSelectionCleared    bool                             // User manually cleared work selection filter (don't auto-restore)
+	pendingWorkSelectIndex  int                              // Index of work to select after tiles load (-1 = none)
+	pendingFocusWorkID      string                           // Newly created work to zoom into once tiles load
+	awaitingWorktree        map[string]bool                  // Works created here whose worktree isn't set up yet
+	workTiles               []*progress.WorkProgress         // Cached work tiles for the tabs bar
+	beadCommitCounts        map[string]map[string]int        // workID -> beadID -> commits, refreshed with work tiles
+	beadDurations           map[string]time.Duration         // beadID -> task run time, refreshed with work tiles
+	beadEstimates           map[string]db.ComplexityEstimate // beadID -> cached complexity estimate, refreshed with work tiles
+	estimatingBeads         map[string]bool                  // Beads with an estimate running
+	estimateBead            task.BeadEstimator               // Estimates a bead with Claude; a field so tests can stub it
+	workDetailsFocusLeft    bool                             // Whether left panel has focus in work details (true=left, false=right)
+	addChildToWorkID        string                           // Work ID to add newly created child bead to (for add-child-and-run flow)
+	completionPlan          *work.CompletionPlan             // Plan shown in the complete-work checklist dialog
+	completionOpts          work.CompleteWorkOptions         // Steps the user has toggled off in that dialog
+	complexityReport        *db.ComplexityReport             // Stats shown in the complexity overlay
+	spawnErr                *spawnError                      // Failed spawn shown in the spawn error overlay
+	diffView                *diffView                        // Diff overlay for a work's branch
+	artifactView            *artifactView                    // Artifact browser for a task
+	timelineView            *timelineView                    // Chart of when a work's tasks ran
+	workNotes               *workNotesEditor                 // Notes editor for the focused work
+	checklistImport         *checklistImportDialog           // Markdown checklist being pasted to create issues from
+	dedupe                  *dedupeDialog                    // Likely duplicates of the selected issue
+	runAll                  *runAllDialog                    // Idle works being run together (R)
+	workEnv                 *workEnvEditor                   // Env overrides editor for the focused work
+	workRelocate            *workRelocateDialog              // Repair dialog for a work whose worktree is missing
+	taskTypeCursor          int                              // Highlighted entry in the custom task type picker
+	paletteCursor           int                              // Highlighted entry in the command palette
+	planReview              *planReview                      // Proposed tasks being reviewed before a run (g)
+	removeBead              *beadInTaskMsg                   // Bead removal waiting on the pending task dialog
+	assignBeadIDs           []string                         // Issues waiting on the assign confirmation
+	assignBeadsErr          string                           // Why adding those issues was rejected, shown in the dialog
+	assignBeadsScroll       int                              // First of those issues the dialog lists
+	submittedDialog         *submittedDialog                 // Dialog closed while its command runs
+	destroyWorkID           string                           // Work the destroy dialog was opened for
+	destroyPlan             *work.DestructionPlan            // What destroying that work does, once loaded
+	moveBeadID              string                           // Unassigned bead the move picker moves out of the focused work
+	moveTargetCursor        int                              // Highlighted work in the move picker
+	moveAssign              bool                             // Move picker adds an issue being triaged rather than moving one
+	triage                  *triageSession                   // Triage queue walked in the triage view (T)
+	startTriage             bool                             // Open triage as soon as the TUI starts (co bead triage)
+	startTour               bool                             // Open the tour once the works load (co tui --tour)
+	offerTour               bool                             // No state file yet: open the tour if the project has no works
+	tour                    *tourDialog                      // First-run tour, while it's shown
+	pendingDraft            *dialogDraft                     // Draft left by an earlier session, offered for restore
+	savedDraft              *dialogDraft                     // Draft last written for the open dialog
+	draftKeys               int                              // Key presses in the open dialog, for saving its draft every few
+	projectLabels           []string                         // Labels in use across the project, for the label dialogs
+	labelTargets            []string                         // Beads the label picker applies to
+	labelCursor             int                              // Highlighted entry in the label picker
+	orchestratorHealth      map[string]db.OrchestratorHealth // workID -> orchestrator health, refreshed with the work tiles
+	staleWorks              map[string]string                // workID -> why its branch is stale (merged or deleted on the remote)
+	problemsOnly            bool                             // Tabs bar shows only works with failed tasks or dead orchestrators (F)
+	groupByRoot             bool                             // Tabs bar groups works by root issue (G)
+	rootTitles              map[string]string                // Root issue titles for the group headers, "" while looked up or when not found
+	staleCheckedAt          time.Time                        // When staleWorks was last refreshed from the remote
+	staleCheckInFlight      bool                             // A remote branch check is running
+	worktreeSizes           map[string]worktree.Usage        // workID -> measured worktree disk usage
+	prChecks                *github.ChecksCache              // Checks of works' PRs, fetched with gh at most once a minute
+	failingChecks           map[string][]string              // workID -> names of the checks failing on its PR
+	worktreeMeasuredAt      time.Time                        // When worktreeSizes was last measured
+	worktreeMeasureInFlight bool                             // A worktree measurement is running
+	notificationsMuted      bool                             // Task notifications are muted for this session (M)
+	seenWorks               map[string]workSnapshot          // workID -> state when last viewed, persisted in the state file
+	journal                 []*journalEntry                  // Actions taken this session, oldest first, for undo (u)
+	undoTarget              *journalEntry                    // Action the undo dialog offers to reverse
+	confirmQuit             bool                             // Ask before quitting while tasks are processing ([tui] confirm_quit)
 
 	// Multi-select state
 	selectedBeads map[string]bool // beadID -> is selected
 
+	// Issues per status filter, from the last refresh of the unfiltered list
+	beadCounts beadCounts
+
 	// Loading state
 	loading bool
 
@@ -84,40 +168,43 @@ type planModel struct {
 
 	// Per-bead session tracking
 	activeBeadSessions map[string]bool // beadID -> has active session
+	resumablePlans     map[string]bool // beadID -> has a plan conversation `co plan` can resume
 	zj                 zellij.SessionManager
 
 	// Two-column layout settings
 	columnRatio float64 // Ratio of issues column width (0.0-1.0), default 0.4 for 40/60 split
+	maximized   bool    // Whether the focused panel fills the view, until toggled back
 
 	// Mouse state
 	mouseX              int
 	mouseY              int
-	hoveredButton       string    // which button is hovered ("n", "e", "w", "p", etc.)
-	hoveredIssue        int       // index of hovered issue, -1 if none
-	lastWheelScroll     time.Time // For debouncing rapid wheel events
-	hoveredWorkItem     int       // index of hovered work detail item, -1 if none
-	hoveredDialogButton string    // which dialog button is hovered ("ok", "cancel")
-	hoveredTabID        string    // which work tab is hovered
+	hoveredButton       string       // which button is hovered ("n", "e", "w", "p", etc.)
+	hoveredIssue        int          // index of hovered issue, -1 if none
+	lastWheelScroll     time.Time    // For debouncing rapid wheel events
+	hoveredWorkItem     int          // index of hovered work detail item, -1 if none
+	hoveredDialogButton string       // which dialog button is hovered ("ok", "cancel")
+	hoveredTabID        string       // which work tab is hovered
+	clicks              clickTracker // Recognizes double-clicks
 
 	// Database watcher for cache invalidation
 	beadsWatcher    *beadswatcher.Watcher
 	trackingWatcher *trackingwatcher.Watcher
 
+	// Tails the focused work's orchestrator log while its column is shown (L)
+	logTail *tail.Tailer
+
+	// Refresh intervals from [tui]; the issues are polled at planRefresh and
+	// the works at workRefresh when their watcher isn't running
+	workRefresh time.Duration
+	planRefresh time.Duration
+
 	// New bead animation tracking
 	newBeads map[string]time.Time // beadID -> creation timestamp for animation
 }
 
-// newPlanModel creates a new Plan Mode model
-func newPlanModel(ctx context.Context, proj *project.Project) *planModel {
-	s := spinner.New()
-	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
-
-	ti := textinput.New()
-	ti.Placeholder = "Search..."
-	ti.CharLimit = 100
-	ti.Width = 40
-
+// startWatchers starts the beads and tracking database watchers. A watcher
+// that fails to start is reported and left nil.
+func startWatchers(proj *project.Project) (*beadswatcher.Watcher, *trackingwatcher.Watcher) {
 	// Initialize beads database watcher
 	beadsDBPath := filepath.Join(proj.BeadsPath(), "beads.db")
 	beadsWatcher, err := beadswatcher.New(beadswatcher.DefaultConfig(beadsDBPath))
@@ -148,9 +235,39 @@ func newPlanModel(ctx context.Context, proj *project.Project) *planModel {
 		}
 	}
 
+	return beadsWatcher, trackingWatcher
+}
+
+// newPlanModel creates a new Plan Mode model. It is read-only when readOnly is
+// set or the project's tracking database can't be written.
+func newPlanModel(ctx context.Context, proj *project.Project, theme *Theme, readOnly bool) *planModel {
+	s := spinner.New()
+	s.Spinner = theme.Icons.Spinner
+	s.Style = theme.Spinner
+
+	ti := textinput.New()
+	ti.Placeholder = "Search..."
+	ti.CharLimit = 100
+	ti.Width = 40
+
+	// Scope in-flight work and watcher subscriptions to this model so they end
+	// when it's torn down (on quit or when switching projects)
+	ctx, cancel := context.WithCancel(ctx)
+
+	// Initialize the database watchers unless they're turned off in the
+	// config; without one the TUI polls that database instead
+	var beadsWatcher *beadswatcher.Watcher
+	var trackingWatcher *trackingwatcher.Watcher
+	if proj.Config.TUI.IsWatcherEnabled() {
+		beadsWatcher, trackingWatcher = startWatchers(proj)
+	}
+
+	state, stateFound := loadTUIState(proj.Root)
 	m := &planModel{
 		ctx:                    ctx,
+		cancel:                 cancel,
 		proj:                   proj,
+		theme:                  theme,
 		workService:            work.NewWorkService(proj),
 		width:                  80,
 		height:                 24,
@@ -160,14 +277,23 @@ func newPlanModel(ctx context.Context, proj *project.Project) *planModel {
 		activeBeadSessions:     make(map[string]bool),
 		selectedBeads:          make(map[string]bool),
 		newBeads:               make(map[string]time.Time),
+		estimatingBeads:        make(map[string]bool),
 		zj:                     zellij.New(),
-		columnRatio:            0.4,  // Default 40/60 split (issues/details)
+		columnRatio:            state.columnRatio(),
 		hoveredIssue:           -1,   // No issue hovered initially
 		hoveredWorkItem:        -1,   // No work item hovered initially
 		pendingWorkSelectIndex: -1,   // No pending work selection
 		workDetailsFocusLeft:   true, // Start with left panel focused
 		beadsWatcher:           beadsWatcher,
 		trackingWatcher:        trackingWatcher,
+		workRefresh:            proj.Config.TUI.GetWorkRefresh(),
+		planRefresh:            proj.Config.TUI.GetPlanRefresh(),
+		bdMissing:              !beads.CLIAvailable(),
+		readOnly:               readOnly || !proj.TrackingDBWritable(),
+		seenWorks:              state.SeenWorks,
+		offerTour:              !stateFound,
+		clicks:                 clickTracker{window: proj.Config.TUI.GetDoubleClick()},
+		confirmQuit:            proj.Config.TUI.IsConfirmQuit(),
 		filters: beadFilters{
 			status: "open",
 			sortBy: "default",
@@ -175,29 +301,42 @@ func newPlanModel(ctx context.Context, proj *project.Project) *planModel {
 	}
 
 	// Initialize panels
-	m.statusBar = NewStatusBar()
-	m.issuesPanel = NewIssuesPanel()
-	m.detailsPanel = NewIssueDetailsPanel()
-	m.workDetails = NewWorkDetailsPanel()
-	m.workTabsBar = NewWorkTabsBar()
-	m.linearImportPanel = NewLinearImportPanel()
-	m.prImportPanel = NewPRImportPanel()
-	m.beadFormPanel = NewBeadFormPanel()
-	m.createWorkPanel = NewCreateWorkPanel()
+	m.statusBar = NewStatusBar(theme)
+	m.issuesPanel = NewIssuesPanel(theme)
+	m.detailsPanel = NewIssueDetailsPanel(theme)
+	m.workDetails = NewWorkDetailsPanel(theme)
+	m.workTabsBar = NewWorkTabsBar(theme)
+	m.linearImportPanel = NewLinearImportPanel(theme)
+	m.prImportPanel = NewPRImportPanel(theme)
+	m.beadFormPanel = NewBeadFormPanel(theme)
+	m.beadFormPanel.SetDescriptionTemplates(proj.Config.Beads.DescriptionTemplate)
+	m.createWorkPanel = NewCreateWorkPanel(theme)
+	m.createWorkPanel.SetDefaultBase(proj.Config.Repo.GetBaseBranch())
 
 	// Set up status bar data providers
 	m.statusBar.SetDataProviders(
-		func() []beadItem { return m.beadItems },
-		func() int { return m.beadsCursor },
-		func() map[string]bool { return m.activeBeadSessions },
 		func() ViewMode { return m.viewMode },
 		func() string { return m.textInput.View() },
 	)
 
-	// Set up the failed task selected provider for work detail context
-	m.statusBar.SetFailedTaskSelectedProvider(func() bool {
-		return m.workDetails.IsSelectedTaskFailed()
-	})
+	// Problems in config.toml are listed before anything else
+	if proj.ConfigErr != nil {
+		m.viewMode = ViewConfigErrors
+	}
+	m.estimateBead = func(ctx context.Context, bead beads.Bead) (int, int, error) {
+		return claude.EstimateBead(ctx, bead, proj.Root, proj.Config)
+	}
+	// The first TUI open on the project is the primary; later ones wait
+	// for its lease to lapse
+	if !m.readOnly {
+		m.leaser = newInstanceLeaser(proj.Root)
+		m.applyLease(m.leaser.claim())
+	}
+	if !readOnly && !m.secondary() {
+		if m.pendingDraft = loadDialogDraft(proj.Root); m.pendingDraft != nil && m.viewMode == ViewNormal {
+			m.viewMode = ViewDraftRestore
+		}
+	}
 
 	return m
 }
@@ -233,13 +372,22 @@ func (m *planModel) Init() tea.Cmd {
 	cmds := []tea.Cmd{
 		m.spinner.Tick,
 		m.workTabsBar.GetSpinner().Tick, // Tick the tabs bar spinner
+		m.statusBar.SpinnerTick(),       // Tick the status bar spinner (loading/refreshing)
 		m.refreshData(),
 		m.loadWorkTiles(), // Load work tiles for the tabs bar
+		m.scheduleOrchestratorHealthCheck(),
+		m.scheduleLeaseRefresh(),
+	}
+	if m.startTriage {
+		// Sent as a key press so read-only and config checks still apply
+		cmds = append(cmds, func() tea.Msg { return keyMsgFor("T") })
 	}
 
-	// Subscribe to watcher events if watcher is available
+	// Subscribe to watcher events if watcher is available, otherwise poll
 	if m.beadsWatcher != nil {
 		cmds = append(cmds, m.waitForWatcherEvent())
+	} else {
+		cmds = append(cmds, m.scheduleIssuesPoll())
 	}
 
 	// Subscribe to tracking watcher events if watcher is available
@@ -250,6 +398,16 @@ func (m *planModel) Init() tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
+// issuesPollMsg triggers a reload of the issues when the beads watcher isn't running
+type issuesPollMsg struct{}
+
+// scheduleIssuesPoll reloads the issues after planRefresh
+func (m *planModel) scheduleIssuesPoll() tea.Cmd {
+	return tea.Tick(m.planRefresh, func(time.Time) tea.Msg {
+		return issuesPollMsg{}
+	})
+}
+
 // waitForWatcherEvent waits for a watcher event and returns it as a tea.Msg
 func (m *planModel) waitForWatcherEvent() tea.Cmd {
 	if m.beadsWatcher == nil {
@@ -257,7 +415,11 @@ func (m *planModel) waitForWatcherEvent() tea.Cmd {
 	}
 
 	return func() tea.Msg {
-		sub := m.beadsWatcher.Broker().Subscribe(m.ctx)
+		// Scope the subscription to this one event so the broker drops it
+		// (and its goroutine) once the event has been received
+		ctx, cancel := context.WithCancel(m.ctx)
+		defer cancel()
+		sub := m.beadsWatcher.Broker().Subscribe(ctx)
 
 		evt, ok := <-sub
 		if !ok {
@@ -275,7 +437,11 @@ func (m *planModel) waitForTrackingWatcherEvent() tea.Cmd {
 	}
 
 	return func() tea.Msg {
-		sub := m.trackingWatcher.Broker().Subscribe(m.ctx)
+		// Scope the subscription to this one event so the broker drops it
+		// (and its goroutine) once the event has been received
+		ctx, cancel := context.WithCancel(m.ctx)
+		defer cancel()
+		sub := m.trackingWatcher.Broker().Subscribe(ctx)
 
 		evt, ok := <-sub
 		if !ok {
@@ -288,7 +454,22 @@ func (m *planModel) waitForTrackingWatcherEvent() tea.Cmd {
 
 // Update implements tea.Model
 func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	model, cmd := m.update(msg)
+	if logCmd := m.syncLogTail(); logCmd != nil {
+		cmd = tea.Batch(cmd, logCmd)
+	}
+	return model, cmd
+}
+
+// update handles a message; Update wraps it to keep the log tailer in sync
+func (m *planModel) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case orchestratorLogMsg:
+		return m, m.handleOrchestratorLog(msg)
+
+	case journalUndoneMsg:
+		return m, m.handleJournalUndone(msg)
+
 	case watcherEventMsg:
 		// Handle watcher events
 		if msg.Type == beadswatcher.DBChanged {
@@ -305,12 +486,22 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Continue waiting for next event
 		return m, m.waitForWatcherEvent()
 
+	case issuesPollMsg:
+		if m.proj.Beads != nil {
+			_ = m.proj.Beads.FlushCache(m.ctx)
+		}
+		return m, tea.Batch(m.refreshData(), m.scheduleIssuesPoll())
+
 	case trackingWatcherEventMsg:
 		// Handle tracking database watcher events
 		if msg.Type == trackingwatcher.DBChanged {
-			// Tracking database changed - reload work tiles and work details
-			// This is more targeted than a full refresh
-			return m, tea.Batch(m.loadWorkTiles(), m.waitForTrackingWatcherEvent())
+			// Tracking database changed - reload only the works the change
+			// log names, or all of them when it can't tell
+			workIDs, ok := trackingwatcher.WatcherEvent(msg).WorkIDs()
+			if !ok {
+				return m, tea.Batch(m.loadWorkTiles(), m.waitForTrackingWatcherEvent())
+			}
+			return m, tea.Batch(m.reloadWorkTiles(workIDs), m.waitForTrackingWatcherEvent())
 		} else if msg.Type == trackingwatcher.WatcherError {
 			// Log error and continue waiting for events
 			return m, m.waitForTrackingWatcherEvent()
@@ -389,6 +580,8 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Handle clicks on status bar buttons
 		if msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft {
+			doubleClick := m.clicks.press(msg.X, msg.Y, time.Now())
+
 			// Check for clicks on tabs bar
 			tabsBarHeight := m.workTabsBar.Height()
 			if tabsBarHeight > 0 && msg.Y < tabsBarHeight {
@@ -397,6 +590,11 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 				clickedWorkID := m.workTabsBar.HandleClick(msg)
 				if clickedWorkID != "" {
+					// The first click focused the work; the second zooms in, like Enter
+					if doubleClick && m.focusedWorkID == clickedWorkID {
+						m.activePanel = PanelWorkDetails
+						return m, nil
+					}
 					// Focus the clicked work
 					if m.focusedWorkID == clickedWorkID {
 						// Already focused - unfocus
@@ -416,14 +614,13 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.statusMessage = fmt.Sprintf("Focused on work %s", m.focusedWorkID)
 					m.statusIsError = false
 
-					// Clear unseen PR changes flag for this work
-					_ = m.proj.DB.MarkWorkPRSeen(m.ctx, clickedWorkID)
+					m.markWorkSeen(clickedWorkID)
 
 					// Set up the work details panel
 					focusedWork := m.findWorkByID(m.focusedWorkID)
 					m.workDetails.SetFocusedWork(focusedWork)
 					m.workDetails.SetSelectedIndex(0)
-					m.workDetails.SetOrchestratorHealth(checkOrchestratorHealth(m.ctx, m.proj.DB, m.focusedWorkID))
+					m.workDetails.SetOrchestratorHealth(m.orchestratorHealth[m.focusedWorkID])
 
 					return m, m.updateWorkSelectionFilter()
 				}
@@ -431,23 +628,9 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 			if msg.Y == statusBarY {
-				clickedButton := m.detectCommandsBarButton(msg)
 				// Trigger the corresponding action by simulating a key press
-				switch clickedButton {
-				case "n":
-					return m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
-				case "e":
-					return m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
-				case "a":
-					return m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
-				case "x":
-					return m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
-				case "w":
-					return m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'w'}})
-				case "p":
-					return m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
-				case "?":
-					return m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}})
+				if clickedButton := m.detectCommandsBarButton(msg); clickedButton != "" {
+					return m.handleKeyPress(keyMsgFor(clickedButton))
 				}
 			} else {
 				// Check if clicking on dialog buttons
@@ -475,12 +658,12 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						// Determine mode and call appropriate action
 						if result.EditBeadID != "" {
 							// Edit mode
-							return m, m.saveBeadEdit(result.EditBeadID, result.Title, result.Description, result.BeadType, result.Status)
+							return m, m.saveBeadEdit(result.EditBeadID, result.Title, result.Description, result.BeadType, result.PrevStatus, result.Status, result.PrevLabels, result.Labels)
 						}
 
 						// Create or add-child mode
 						isEpic := result.BeadType == "epic"
-						return m, m.createBead(result.Title, result.BeadType, result.Priority, isEpic, result.Description, result.ParentID)
+						return m, m.createBead(result.Title, result.BeadType, result.Priority, isEpic, result.Description, result.ParentID, result.BlockedBy)
 					}
 				} else if clickedDialogButton == "cancel" {
 					// Cancel the form
@@ -490,6 +673,7 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						m.createWorkPanel.Blur()
 					} else {
 						m.beadFormPanel.Blur()
+						m.discardDraft()
 					}
 					m.viewMode = ViewNormal
 					return m, nil
@@ -502,9 +686,15 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							m.statusIsError = true
 							return m, nil
 						}
+						if problem := m.createWorkPanel.BranchProblem(); problem != "" {
+							m.statusMessage = problem
+							m.statusIsError = true
+							return m, nil
+						}
 						m.viewMode = ViewNormal
 						m.selectedBeads = make(map[string]bool)
-						return m, m.executeCreateWork(result.BeadID, result.BranchName, false, result.UseExistingBranch)
+						m.submittedDialog = &submittedDialog{view: ViewCreateWork, action: "Create work", id: result.BeadID}
+						return m, m.executeCreateWork(result, false)
 					}
 				} else if clickedDialogButton == "auto" {
 					// Handle auto button for work creation
@@ -515,9 +705,15 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 							m.statusIsError = true
 							return m, nil
 						}
+						if problem := m.createWorkPanel.BranchProblem(); problem != "" {
+							m.statusMessage = problem
+							m.statusIsError = true
+							return m, nil
+						}
 						m.viewMode = ViewNormal
 						m.selectedBeads = make(map[string]bool)
-						return m, m.executeCreateWork(result.BeadID, result.BranchName, true, result.UseExistingBranch)
+						m.submittedDialog = &submittedDialog{view: ViewCreateWork, action: "Create work", id: result.BeadID}
+						return m, m.executeCreateWork(result, true)
 					}
 				}
 
@@ -525,29 +721,40 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if m.focusedWorkID != "" {
 					clickedPanel := m.detectClickedPanel(msg)
 					switch clickedPanel {
-					case "work-left":
+					case panelWorkLeft:
 						// Check if clicking on a task or root issue using bubblezone
 						clickedItem := m.workDetails.DetectClickedItem(msg)
+						if clickedItem == foldedSummaryItem {
+							// The folded completed tasks' row expands them
+							m.workDetails.ExpandCompleted()
+							m.activePanel = PanelWorkDetails
+							return m, nil
+						}
 						if clickedItem >= 0 {
 							m.workDetails.SetSelectedIndex(clickedItem)
 							m.activePanel = PanelWorkDetails
+							if doubleClick {
+								return m.openClickedTask()
+							}
 							// Update filter to show beads for clicked item
 							return m, m.updateWorkSelectionFilter()
 						}
 						m.activePanel = PanelWorkDetails
 						return m, nil
-					case "work-right":
+					case panelWorkRight:
 						m.activePanel = PanelWorkDetails
 						return m, nil
-					case "issues-left":
+					case panelIssuesLeft:
 						// Check if clicking on an issue
 						clickedIssue := m.detectHoveredIssue(msg)
-						if clickedIssue >= 0 && clickedIssue < len(m.beadItems) {
+						if msg.Shift {
+							m.selectIssueRange(clickedIssue)
+						} else if clickedIssue >= 0 && clickedIssue < len(m.beadItems) {
 							m.beadsCursor = clickedIssue
 						}
 						m.activePanel = PanelLeft
 						return m, nil
-					case "issues-right":
+					case panelIssuesRight:
 						m.activePanel = PanelRight
 						return m, nil
 					}
@@ -555,7 +762,11 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					// Normal mode - just check for issue clicks
 					clickedIssue := m.detectHoveredIssue(msg)
 					if clickedIssue >= 0 && clickedIssue < len(m.beadItems) {
-						m.beadsCursor = clickedIssue
+						if msg.Shift {
+							m.selectIssueRange(clickedIssue)
+						} else {
+							m.beadsCursor = clickedIssue
+						}
 						m.activePanel = PanelLeft
 					} else if msg.X > m.width/2 {
 						// Clicked on right side - switch to details panel
@@ -567,6 +778,16 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case planDataMsg:
+		if msg.manual {
+			m.finishRefreshStep()
+		}
+		if msg.err == nil {
+			m.recordJournal(msg.journal)
+		}
+		if msg.submitted {
+			m.discardDraft()
+		}
+
 		// Ignore stale search results from older requests
 		if msg.searchSeq < m.searchSeq {
 			return m, nil
@@ -575,6 +796,12 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		var expireCmds []tea.Cmd
 		now := time.Now()
 
+		// Briefly highlight the last-update time so it's obvious new data arrived
+		m.lastUpdateFlash = now
+		expireCmds = append(expireCmds, tea.Tick(lastUpdateFlashDuration, func(time.Time) tea.Msg {
+			return updateFlashExpiredMsg{}
+		}))
+
 		// Detect new beads by comparing with existing list
 		if len(m.beadItems) > 0 {
 			existingIDs := make(map[string]bool)
@@ -591,14 +818,24 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		m.beadItems = msg.beads
+		if msg.counts != nil {
+			m.beadCounts = *msg.counts
+		}
 		if msg.activeSessions != nil {
 			m.activeBeadSessions = msg.activeSessions
 		}
+		if msg.resumablePlans != nil {
+			m.resumablePlans = msg.resumablePlans
+		}
 		m.loading = false
 		m.lastUpdate = time.Now()
 		if msg.err != nil {
 			m.statusMessage = msg.err.Error()
 			m.statusIsError = true
+		} else if cleared := m.pruneHiddenSelections(); cleared > 0 {
+			// Issues out of view mustn't ride along into w, A or x unseen
+			m.statusMessage = fmt.Sprintf("Cleared %d hidden selection(s)", cleared)
+			m.statusIsError = false
 		}
 
 		// Ensure cursor stays within bounds after filter changes
@@ -625,6 +862,97 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case completionPlanLoadedMsg:
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Cannot complete work: %v", msg.err)
+			m.statusIsError = true
+			return m, nil
+		}
+		m.completionPlan = msg.plan
+		m.completionOpts = work.CompleteWorkOptions{SkipPRCheck: msg.skipPRCheck}
+		m.viewMode = ViewCompleteWorkConfirm
+		return m, nil
+
+	case destructionPlanLoadedMsg:
+		// The dialog keeps its generic summary when the plan fails to load
+		if msg.err == nil && msg.workID == m.destroyWorkID {
+			m.destroyPlan = msg.plan
+		}
+		return m, nil
+
+	case complexityStatsLoadedMsg:
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Failed to load complexity stats: %v", msg.err)
+			m.statusIsError = true
+			return m, nil
+		}
+		m.complexityReport = msg.report
+		m.viewMode = ViewComplexityStats
+		return m, nil
+
+	case projectLabelsLoadedMsg:
+		if msg.err == nil {
+			m.projectLabels = msg.labels
+		}
+		return m, nil
+
+	case labelsAppliedMsg:
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Failed to update label %s: %v", msg.label, msg.err)
+			m.statusIsError = true
+			return m, m.refreshData()
+		}
+		target := strings.Join(msg.beadIDs, ", ")
+		if len(msg.beadIDs) > 3 {
+			target = fmt.Sprintf("%d issues", len(msg.beadIDs))
+		}
+		if msg.removed {
+			m.statusMessage = fmt.Sprintf("Removed label %s from %s", msg.label, target)
+		} else {
+			m.statusMessage = fmt.Sprintf("Labeled %s with %s", target, msg.label)
+		}
+		m.statusIsError = false
+		m.selectedBeads = make(map[string]bool)
+		return m, tea.Batch(m.refreshData(), m.loadProjectLabels())
+
+	case beadsClosedMsg:
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Failed to close issues: %v", msg.err)
+			m.statusIsError = true
+			return m, m.refreshData()
+		}
+		m.recordJournal(msg.journal)
+		noun := "issues"
+		if msg.closed == 1 {
+			noun = "issue"
+		}
+		m.statusMessage = fmt.Sprintf("Closed %d %s", msg.closed, noun)
+		if msg.skipped > 0 {
+			m.statusMessage += fmt.Sprintf(" (%d skipped)", msg.skipped)
+		}
+		m.statusIsError = false
+		m.selectedBeads = make(map[string]bool)
+		return m, m.refreshData()
+
+	case duplicateMergedMsg:
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Failed to merge %s into %s: %v", msg.duplicate, msg.survivor, msg.err)
+			m.statusIsError = true
+		} else {
+			m.statusMessage = fmt.Sprintf("Merged %s into %s and closed it as a duplicate", msg.duplicate, msg.survivor)
+			m.statusIsError = false
+		}
+		return m, m.refreshData()
+
+	case checklistImportedMsg:
+		m.statusMessage = fmt.Sprintf("Created %d issue(s) from the checklist", len(msg.created))
+		m.statusIsError = false
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("%s, then failed: %v", m.statusMessage, msg.err)
+			m.statusIsError = true
+		}
+		return m, m.refreshData()
+
 	case planStatusMsg:
 		m.statusMessage = msg.message
 		m.statusIsError = msg.isError
@@ -634,9 +962,13 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.err != nil {
 			m.statusMessage = fmt.Sprintf("Failed: %v", msg.err)
 			m.statusIsError = true
+			m.showSpawnError(msg.spawnErr)
 		} else if msg.resumed {
 			m.statusMessage = fmt.Sprintf("Resumed session for %s", msg.beadID)
 			m.statusIsError = false
+		} else if msg.conversationResumed {
+			m.statusMessage = fmt.Sprintf("Resuming the planning conversation for %s (co plan %s --new starts over)", msg.beadID, msg.beadID)
+			m.statusIsError = false
 		} else if msg.sessionCreated {
 			m.statusMessage = fmt.Sprintf("Started session for %s | Zellij: zellij attach %s", msg.beadID, msg.sessionName)
 			m.statusIsError = false
@@ -647,9 +979,37 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Refresh to update session indicators
 		return m, m.refreshData()
 
+	case branchCheckTickMsg:
+		name, ok := m.createWorkPanel.BranchCheckDue(msg.seq)
+		if !ok || m.viewMode != ViewCreateWork {
+			return m, nil
+		}
+		return m, func() tea.Msg {
+			check, err := m.workService.CheckBranch(m.ctx, name)
+			return branchCheckedMsg{seq: msg.seq, check: check, err: err}
+		}
+
+	case branchCheckedMsg:
+		// A failed check (git unavailable) just leaves the name unvalidated;
+		// work creation checks it again
+		if msg.err == nil {
+			m.createWorkPanel.SetBranchCheck(msg.seq, msg.check)
+		}
+		return m, nil
+
 	case planWorkCreatedMsg:
+		// A work created from triage doesn't take focus away from the queue
+		if !m.triageWorkCreated(msg) && msg.focus && msg.workID != "" {
+			m.pendingFocusWorkID = msg.workID
+		}
+		if msg.err != nil && msg.workID == "" {
+			// The error says what was undone
+			return m, m.showCommandError("Create work", msg.beadID, msg.err, nil)
+		}
+		m.commandSucceeded("Create work", msg.beadID)
 		if msg.err != nil {
-			m.statusMessage = fmt.Sprintf("Failed to create work: %v", msg.err)
+			// A work ID means some of it stayed
+			m.statusMessage = fmt.Sprintf("Work %s only partly created: %v", msg.workID, msg.err)
 			m.statusIsError = true
 		} else {
 			if msg.sessionCreated {
@@ -659,49 +1019,153 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			m.statusIsError = false
 		}
+		if m.awaitingWorktree == nil {
+			m.awaitingWorktree = make(map[string]bool)
+		}
+		m.awaitingWorktree[msg.workID] = true
 		// Refresh work tiles to show the new work in the tabs bar
 		return m, tea.Batch(m.refreshData(), m.loadWorkTiles())
 
+	case workImportedMsg:
+		return m.handleWorkImported(msg)
+
+	case idleWorksLoadedMsg:
+		return m, m.handleIdleWorksLoaded(msg)
+
+	case runAllOutcomeMsg:
+		return m, m.handleRunAllOutcome(msg)
+
+	case runAllDoneMsg:
+		return m, m.handleRunAllDone(msg)
+
+	case triageQueueLoadedMsg:
+		return m.handleTriageQueueLoaded(msg)
+
+	case triageAppliedMsg:
+		return m.handleTriageApplied(msg)
+
+	case triageUndoneMsg:
+		return m.handleTriageUndone(msg)
+
+	case beadMovedMsg:
+		m.viewMode = ViewNormal
+		if msg.err != nil {
+			return m, m.showCommandError(fmt.Sprintf("Moving %s from %s to %s", msg.beadID, msg.fromWorkID, msg.toWorkID), msg.beadID, msg.err, nil)
+		}
+		m.statusMessage = fmt.Sprintf("Moved %s from work %s to work %s", msg.beadID, msg.fromWorkID, msg.toWorkID)
+		m.statusIsError = false
+		m.recordJournal(&journalEntry{kind: journalMove, workID: msg.toWorkID, fromWorkID: msg.fromWorkID, beadIDs: []string{msg.beadID}})
+		return m, tea.Batch(m.refreshData(), m.loadWorkTiles())
+
 	case beadAddedToWorkMsg:
 		m.viewMode = ViewNormal
 		if msg.err != nil {
-			m.statusMessage = fmt.Sprintf("Failed to add issue: %v", msg.err)
-			m.statusIsError = true
 			m.addChildToWorkID = "" // Clear on error
-		} else {
-			m.statusMessage = fmt.Sprintf("Added %s to work %s", msg.beadID, msg.workID)
-			m.statusIsError = false
+			return m, m.showCommandError("Add issue", msg.workID, msg.err, nil)
+		}
+		m.commandSucceeded("Add issue", msg.workID)
+		m.recordJournal(msg.journal)
+		m.statusMessage = fmt.Sprintf("Added %s to work %s", msg.beadID, msg.workID)
+		m.statusIsError = false
+		if m.isWorkPaused(msg.workID) {
+			m.statusMessage += pausedWorkWarning
+		}
 
-			// Check if we should run the work (add-child-and-run flow)
-			if m.addChildToWorkID != "" && m.addChildToWorkID == msg.workID {
-				m.addChildToWorkID = "" // Clear before running
-				// Run the work in single-bead mode
-				return m, tea.Batch(m.refreshData(), m.loadWorkTiles(), m.runFocusedWork(false))
-			}
+		// Check if we should run the work (add-child-and-run flow)
+		if m.addChildToWorkID != "" && m.addChildToWorkID == msg.workID {
+			m.addChildToWorkID = "" // Clear before running
+			// Run the work in single-bead mode
+			return m, tea.Batch(m.refreshData(), m.loadWorkTiles(), m.runFocusedWork(false))
 		}
 		// Refresh work tiles to update the tabs bar
 		return m, tea.Batch(m.refreshData(), m.loadWorkTiles())
 
-	case workCommandMsg:
-		// Reset to normal mode
-		m.viewMode = ViewNormal
+	case beadsAssignedAndRunMsg:
+		return m, m.handleBeadsAssignedAndRun(msg)
+
+	case orchestratorHealthMsg:
+		if m.orchestratorHealth == nil {
+			m.orchestratorHealth = make(map[string]db.OrchestratorHealth)
+		}
+		maps.Copy(m.orchestratorHealth, msg.health)
+		m.workTabsBar.SetOrchestratorHealth(m.orchestratorHealth)
+		m.syncWorkTabs()
+		if m.focusedWorkID != "" {
+			m.workDetails.SetOrchestratorHealth(m.orchestratorHealth[m.focusedWorkID])
+		}
+		if m.trackingWatcher == nil {
+			// Nothing else notices tracking changes; reload the works too
+			return m, tea.Batch(m.loadWorkTiles(), m.scheduleOrchestratorHealthCheck())
+		}
+		return m, m.scheduleOrchestratorHealthCheck()
+
+	case diffStatLoadedMsg:
+		if m.diffView != nil && m.diffView.workID == msg.workID {
+			m.diffView.setStat(msg)
+		}
+		return m, nil
+
+	case diffFileLoadedMsg:
+		// A late load of a file the user has left, or moved on from, is dropped
+		if m.diffView != nil && m.diffView.awaits(msg) {
+			m.diffView.setFile(msg)
+		}
+		return m, nil
+
+	case workReportMsg:
 		if msg.err != nil {
-			m.statusMessage = fmt.Sprintf("%s failed: %v", msg.action, msg.err)
+			m.statusMessage = fmt.Sprintf("Report failed: %v", msg.err)
 			m.statusIsError = true
+		} else if msg.copied {
+			m.statusMessage = fmt.Sprintf("Report for %s copied to clipboard and saved to %s", msg.workID, msg.path)
+			m.statusIsError = false
 		} else {
-			m.statusMessage = fmt.Sprintf("%s completed for %s", msg.action, msg.workID)
+			m.statusMessage = fmt.Sprintf("Report for %s saved to %s (clipboard unavailable)", msg.workID, msg.path)
 			m.statusIsError = false
-			// If work was destroyed, clear the focused work
-			if msg.action == "Destroy work" {
+		}
+		return m, nil
+
+	case workCommandMsg:
+		// Reset to normal mode
+		m.viewMode = ViewNormal
+		if msg.err != nil {
+			// A work that no longer exists can't stay focused
+			if errors.Is(msg.err, coerrors.NotFound) && msg.workID == m.focusedWorkID {
 				m.focusedWorkID = ""
 				m.filters.task = ""
 				m.filters.children = ""
 			}
+			return m, m.showCommandError(msg.action, msg.workID, msg.err, msg.spawnErr)
+		}
+		m.commandSucceeded(msg.action, msg.workID)
+		m.recordJournal(msg.journal)
+		m.statusMessage = fmt.Sprintf("%s completed for %s", msg.action, msg.workID)
+		if len(msg.taskIDs) > 0 {
+			m.statusMessage += fmt.Sprintf(" (created %s)", strings.Join(msg.taskIDs, ", "))
+		}
+		m.statusIsError = false
+		if msg.action == "Save notes" {
+			m.discardDraft()
+		}
+		if msg.action == "Run work" {
+			m.statusMessage += m.blockedWorkWarning(msg.workID)
+			if m.isWorkPaused(msg.workID) {
+				m.statusMessage += pausedWorkWarning
+			}
+		}
+		// If work was destroyed, clear the focused work
+		if msg.action == "Destroy work" {
+			m.focusedWorkID = ""
+			m.filters.task = ""
+			m.filters.children = ""
 		}
 		// Refresh data and work tiles
 		return m, tea.Batch(m.refreshData(), m.loadWorkTiles())
 
 	case workTilesLoadedMsg:
+		if msg.manual {
+			m.finishRefreshStep()
+		}
 		if msg.err != nil {
 			m.statusMessage = fmt.Sprintf("Failed to load works: %v", msg.err)
 			m.statusIsError = true
@@ -710,31 +1174,81 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.pendingWorkSelectIndex = -1 // Clear pending selection on error
 			return m, nil
 		}
-		m.workTiles = msg.works
-		m.workTabsBar.SetWorkTiles(msg.works)
-		m.workTabsBar.SetOrchestratorHealth(msg.orchestratorHealth)
-		m.loading = false
+		return m.applyWorkTiles(msg.works, msg.orchestratorHealth)
 
-		// Check for pending work selection (from [0-9] hotkey)
-		if m.pendingWorkSelectIndex >= 0 {
-			pendingIndex := m.pendingWorkSelectIndex
-			m.pendingWorkSelectIndex = -1 // Clear pending selection
-			return m.doSelectWorkAtIndex(pendingIndex)
+	case workTilesReloadedMsg:
+		works, ok := patchWorkTiles(m.workTiles, msg.works)
+		if msg.err != nil || !ok {
+			// A work was added or removed, or couldn't be read on its own
+			return m, m.loadWorkTiles()
 		}
+		health := maps.Clone(m.orchestratorHealth)
+		if health == nil {
+			health = make(map[string]db.OrchestratorHealth)
+		}
+		maps.Copy(health, msg.orchestratorHealth)
+		return m.applyWorkTiles(works, health)
 
-		// Update work details panel and filter if a work is focused
-		if m.focusedWorkID != "" {
-			focusedWork := m.findWorkByID(m.focusedWorkID)
-			m.workDetails.SetFocusedWork(focusedWork)
-			// Use pre-computed orchestrator health
-			if health, ok := msg.orchestratorHealth[m.focusedWorkID]; ok {
-				m.workDetails.SetOrchestratorHealth(health)
-			}
-			// Rebuild the filter to reflect any changes in work beads
-			// BUT skip if user manually cleared the filter (e.g., pressed '*')
-			if !m.workSelectionCleared {
-				return m, m.updateWorkSelectionFilter()
-			}
+	case planPreviewMsg:
+		return m.handlePlanPreview(msg)
+
+	case beadInTaskMsg:
+		m.removeBead = &msg
+		m.viewMode = ViewRemoveBeadConfirm
+		return m, nil
+
+	case beadCommitsLoadedMsg:
+		m.beadCommitCounts = msg.counts
+		return m, nil
+
+	case beadEstimatesLoadedMsg:
+		// Like the durations, a failed load leaves the last estimates up
+		if msg.err == nil {
+			m.beadEstimates = msg.estimates
+		}
+		return m, nil
+
+	case beadsEstimatedMsg:
+		return m, m.handleBeadsEstimated(msg)
+
+	case beadDurationsLoadedMsg:
+		// On failure the last totals stay up; they're refreshed with the tiles
+		if msg.err == nil {
+			m.beadDurations = msg.durations
+		}
+		return m, nil
+
+	case staleWorksCheckedMsg:
+		m.staleWorks = msg.stale
+		m.staleCheckedAt = msg.checkedAt
+		m.staleCheckInFlight = false
+		m.workTabsBar.SetStaleWorks(msg.stale)
+		return m, nil
+
+	case workStatusesReconciledMsg:
+		return m, m.handleWorkStatusesReconciled(msg)
+
+	case leaseRefreshMsg:
+		if msg.root != m.proj.Root {
+			return m, nil
+		}
+		return m, m.claimLease()
+
+	case leaseCheckedMsg:
+		return m, m.handleLeaseChecked(msg)
+
+	case worktreeSizesMeasuredMsg:
+		m.worktreeSizes = msg.sizes
+		m.worktreeMeasuredAt = msg.measuredAt
+		m.worktreeMeasureInFlight = false
+		return m, nil
+
+	case artifactEditorClosedMsg:
+		if msg.err != nil {
+			m.statusMessage = fmt.Sprintf("Editor error: %v", msg.err)
+			m.statusIsError = true
+		} else if m.artifactView != nil {
+			m.artifactView.reload()
 		}
 		return m, nil
 
@@ -838,17 +1352,31 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		delete(m.newBeads, msg.beadID)
 		return m, nil
 
+	case prChecksMsg:
+		m.handlePRChecks(msg)
+		return m, nil
+
+	case rootTitlesMsg:
+		m.handleRootTitles(msg)
+		return m, nil
+
+	case updateFlashExpiredMsg:
+		// Nothing to update; the re-render drops the highlight once it has expired
+		return m, nil
+
 	case tea.KeyMsg:
+		debugEvent("key", append(keyAttrs(msg), "view", int(m.viewMode))...)
 		return m.handleKeyPress(msg)
 
 	case spinner.TickMsg:
-		// Update both spinners
+		// Update all spinners; each ignores ticks meant for the others
 		var cmd1, cmd2 tea.Cmd
 		m.spinner, cmd1 = m.spinner.Update(msg)
 		tabsSpinner := m.workTabsBar.GetSpinner()
 		tabsSpinner, cmd2 = tabsSpinner.Update(msg)
 		m.workTabsBar.UpdateSpinner(tabsSpinner)
-		return m, tea.Batch(cmd1, cmd2)
+		cmd3 := m.statusBar.UpdateSpinner(msg)
+		return m, tea.Batch(cmd1, cmd2, cmd3)
 
 	default:
 		// Handle Kitty keyboard protocol escape sequences
@@ -882,10 +1410,15 @@ func (m *planModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 // planDataMsg is sent when data is refreshed
 type planDataMsg struct {
 	beads          []beadItem
+	counts         *beadCounts // issues per status filter; nil when the list isn't filtered by status
 	activeSessions map[string]bool
+	resumablePlans map[string]bool // beads with a plan conversation to resume; nil if not loaded
 	err            error
-	searchSeq      uint64 // Sequence number to detect stale results
-	createdBeadID  string // ID of newly created bead (for add-child-and-run flow)
+	searchSeq      uint64        // Sequence number to detect stale results
+	createdBeadID  string        // ID of newly created bead (for add-child-and-run flow)
+	journal        *journalEntry // recorded for undo when an edit closed or reopened a bead
+	submitted      bool          // The issue form was saved, so its draft can go
+	manual         bool          // Loaded for ctrl+r/F5, which waits on it
 }
 
 // planStatusMsg is sent to update status text
@@ -896,11 +1429,15 @@ type planStatusMsg struct {
 
 // planSessionSpawnedMsg indicates a planning session was spawned or resumed
 type planSessionSpawnedMsg struct {
-	beadID         string
-	resumed        bool
-	err            error
-	sessionCreated bool   // true if a new zellij session was created
-	sessionName    string // e.g., 'co-myproject'
+	beadID  string
+	resumed bool
+	err     error
+	// conversationResumed is set when a new tab picks up the bead's last
+	// planning conversation rather than starting a new one
+	conversationResumed bool
+	sessionCreated      bool   // true if a new zellij session was created
+	sessionName         string // e.g., 'co-myproject'
+	spawnErr            *spawnError
 }
 
 // planWorkCreatedMsg indicates work was created from a bead
@@ -910,13 +1447,15 @@ type planWorkCreatedMsg struct {
 	err            error
 	sessionCreated bool   // true if a new zellij session was created
 	sessionName    string // e.g., 'co-myproject'
+	focus          bool   // zoom into the new work once it shows up in the tabs bar
 }
 
 // beadAddedToWorkMsg indicates a bead was added to a work
 type beadAddedToWorkMsg struct {
-	beadID string
-	workID string
-	err    error
+	beadID  string
+	workID  string
+	err     error
+	journal *journalEntry // recorded for undo when the beads were added
 }
 
 // editorFinishedMsg is sent when the external editor closes
@@ -951,18 +1490,35 @@ func clearStatusAfter(d time.Duration) tea.Cmd {
 	})
 }
 
+// updateFlashExpiredMsg triggers a re-render once the last-update highlight has expired
+type updateFlashExpiredMsg struct{}
+
+// lastUpdateFlashDuration is how long the status bar highlights the last-update time
+const lastUpdateFlashDuration = 1500 * time.Millisecond
+
 // newBeadExpireMsg is sent when the animation for a new bead should expire
 type newBeadExpireMsg struct {
 	beadID string
 }
 
-// workCommandMsg indicates a work command completed
-type workCommandMsg struct {
-	action string
+// workReportMsg indicates a work report was generated
+type workReportMsg struct {
 	workID string
+	path   string // where the report was saved
+	copied bool   // whether it was also copied to the clipboard
 	err    error
 }
 
+// workCommandMsg indicates a work command completed
+type workCommandMsg struct {
+	action   string
+	workID   string
+	taskIDs  []string // tasks the command created, named in the status message
+	err      error
+	spawnErr *spawnError   // set when a spawn failed, shown in the spawn error overlay
+	journal  *journalEntry // recorded for undo when the command succeeded
+}
+
 // newBeadAnimationDuration is how long newly created beads are highlighted
 const newBeadAnimationDuration = 5 * time.Second
 
@@ -991,10 +1547,14 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case ViewCreateBead, ViewCreateBeadInline, ViewAddChildBead, ViewEditBead:
 		// Delegate to bead form panel and handle returned action
 		cmd, action := m.beadFormPanel.Update(msg)
+		debugEvent("bead form key", append(keyAttrs(msg), "field", m.beadFormPanel.focusIdx, "action", int(action))...)
 
 		switch action {
+		case BeadFormActionNone:
+			m.noteDraftKey()
 		case BeadFormActionCancel:
 			m.viewMode = ViewNormal
+			m.discardDraft()
 			return m, cmd
 
 		case BeadFormActionSubmit:
@@ -1009,12 +1569,12 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			// Determine mode and call appropriate action
 			if result.EditBeadID != "" {
 				// Edit mode
-				return m, m.saveBeadEdit(result.EditBeadID, result.Title, result.Description, result.BeadType, result.Status)
+				return m, m.saveBeadEdit(result.EditBeadID, result.Title, result.Description, result.BeadType, result.PrevStatus, result.Status, result.PrevLabels, result.Labels)
 			}
 
 			// Create or add-child mode
 			isEpic := result.BeadType == "epic"
-			return m, m.createBead(result.Title, result.BeadType, result.Priority, isEpic, result.Description, result.ParentID)
+			return m, m.createBead(result.Title, result.BeadType, result.Priority, isEpic, result.Description, result.ParentID, result.BlockedBy)
 		}
 
 		return m, cmd
@@ -1024,7 +1584,10 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 		switch action {
 		case CreateWorkActionCancel:
-			m.viewMode = ViewNormal
+			m.viewMode = m.dialogReturnView()
+			if m.triage != nil {
+				m.triage.creatingFor = ""
+			}
 			return m, cmd
 
 		case CreateWorkActionExecute:
@@ -1034,10 +1597,19 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				m.statusIsError = true
 				return m, nil
 			}
-			m.viewMode = ViewNormal
+			if problem := m.createWorkPanel.BranchProblem(); problem != "" {
+				m.statusMessage = problem
+				m.statusIsError = true
+				return m, nil
+			}
+			m.viewMode = m.dialogReturnView()
+			if m.triage != nil {
+				m.triage.busy = true
+			}
 			// Clear selections after work creation
 			m.selectedBeads = make(map[string]bool)
-			return m, m.executeCreateWork(result.BeadID, result.BranchName, false, result.UseExistingBranch)
+			m.submittedDialog = &submittedDialog{view: ViewCreateWork, action: "Create work", id: result.BeadID}
+			return m, m.executeCreateWork(result, false)
 
 		case CreateWorkActionAuto:
 			result := m.createWorkPanel.GetResult()
@@ -1046,10 +1618,30 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				m.statusIsError = true
 				return m, nil
 			}
-			m.viewMode = ViewNormal
+			if problem := m.createWorkPanel.BranchProblem(); problem != "" {
+				m.statusMessage = problem
+				m.statusIsError = true
+				return m, nil
+			}
+			m.viewMode = m.dialogReturnView()
+			if m.triage != nil {
+				m.triage.busy = true
+			}
 			// Clear selections after work creation
 			m.selectedBeads = make(map[string]bool)
-			return m, m.executeCreateWork(result.BeadID, result.BranchName, true, result.UseExistingBranch)
+			m.submittedDialog = &submittedDialog{view: ViewCreateWork, action: "Create work", id: result.BeadID}
+			return m, m.executeCreateWork(result, true)
+
+		case CreateWorkActionImport:
+			if m.triage != nil {
+				// Importing a manifest isn't a disposition for the issue
+				m.triage.creatingFor = ""
+			}
+			m.viewMode = m.dialogReturnView()
+			m.selectedBeads = make(map[string]bool)
+			m.statusMessage = "Importing " + m.createWorkPanel.ManifestPath() + "..."
+			m.statusIsError = false
+			return m, m.importWorkManifest(m.createWorkPanel.ManifestPath())
 		}
 
 		return m, cmd
@@ -1057,8 +1649,24 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.updateBeadSearch(msg)
 	case ViewLabelFilter:
 		return m.updateLabelFilter(msg)
+	case ViewLabelPicker:
+		return m.updateLabelPicker(msg)
 	case ViewCloseBeadConfirm:
 		return m.updateCloseBeadConfirm(msg)
+	case ViewCompleteWorkConfirm:
+		return m.updateCompleteWorkConfirm(msg)
+	case ViewTaskTypePicker:
+		return m.updateTaskTypePicker(msg)
+	case ViewCommandPalette:
+		return m.updateCommandPalette(msg)
+	case ViewPlanReview:
+		return m.updatePlanReview(msg)
+	case ViewRemoveBeadConfirm:
+		return m.updateRemoveBeadConfirm(msg)
+	case ViewAssignBeads:
+		return m.updateAssignBeadsConfirm(msg)
+	case ViewMoveBeadPicker:
+		return m.updateMoveBeadPicker(msg)
 	case ViewLinearImportInline:
 		// Delegate to linear import panel and handle returned action
 		cmd, action := m.linearImportPanel.Update(msg)
@@ -1110,19 +1718,134 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Handle destroy confirmation dialog
 		switch msg.String() {
 		case "y", "Y":
-			if m.focusedWorkID != "" {
+			if m.destroyWorkID != "" {
 				// Return to normal mode after destroy
 				m.viewMode = ViewNormal
-				return m, m.destroyFocusedWork()
+				return m, m.destroyWork(m.destroyWorkID)
 			}
 		case "n", "N", "esc":
 			// Return to normal mode on cancel
 			m.viewMode = ViewNormal
 		}
 		return m, nil
-	case ViewHelp:
+	case ViewHelp, ViewComplexityStats:
 		m.viewMode = ViewNormal
 		return m, nil
+	case ViewSpawnError:
+		m.viewMode = ViewNormal
+		m.spawnErr = nil
+		return m, nil
+	case ViewDiff:
+		cmd, done := m.diffView.Update(m.ctx, m.workService.Git, msg)
+		if done {
+			m.viewMode = ViewNormal
+			m.diffView = nil
+		}
+		return m, cmd
+	case ViewArtifacts:
+		cmd, done := m.artifactView.Update(msg)
+		if done {
+			m.viewMode = ViewNormal
+			m.artifactView = nil
+		}
+		return m, cmd
+	case ViewWorkNotes:
+		cmd, done, save := m.workNotes.Update(msg)
+		if !done {
+			m.noteDraftKey()
+			return m, cmd
+		}
+		m.viewMode = ViewNormal
+		editor := m.workNotes
+		m.workNotes = nil
+		if save {
+			return m, m.saveWorkNotes(editor.workID, editor.Value())
+		}
+		m.discardDraft()
+		return m, nil
+	case ViewChecklistImport:
+		cmd, done, submit := m.checklistImport.Update(msg)
+		if !done {
+			return m, cmd
+		}
+		m.viewMode = ViewNormal
+		dialog := m.checklistImport
+		m.checklistImport = nil
+		if submit {
+			return m, m.importChecklist(dialog.Items(), dialog.sectionEpics)
+		}
+		return m, nil
+	case ViewTour:
+		outcome := m.tour.Update(msg)
+		if outcome == tourStay {
+			return m, nil
+		}
+		m.closeTour()
+		switch outcome {
+		case tourCreate:
+			return m.handleKeyPress(keyMsgFor("n"))
+		case tourPassThrough:
+			return m.handleKeyPress(msg)
+		}
+		return m, nil
+	case ViewDedupe:
+		done, survivor, duplicate := m.dedupe.Update(msg)
+		if !done {
+			return m, nil
+		}
+		m.viewMode = ViewNormal
+		m.dedupe = nil
+		if survivor != "" {
+			m.statusMessage = fmt.Sprintf("Merging %s into %s...", duplicate, survivor)
+			m.statusIsError = false
+			return m, m.mergeDuplicate(survivor, duplicate)
+		}
+		return m, nil
+	case ViewRunAll:
+		return m.updateRunAll(msg)
+	case ViewUndoConfirm:
+		return m.updateUndoConfirm(msg)
+	case ViewConfigErrors:
+		return m.updateConfigErrors(msg)
+	case ViewWorkEnv:
+		cmd, done, save := m.workEnv.Update(msg)
+		if !done {
+			return m, cmd
+		}
+		m.viewMode = ViewNormal
+		editor := m.workEnv
+		m.workEnv = nil
+		if save {
+			env, _ := editor.Value()
+			return m, m.saveWorkEnv(editor.workID, env)
+		}
+		return m, nil
+	case ViewWorkRelocate:
+		cmd, done, relocate := m.workRelocate.Update(msg)
+		if !done {
+			return m, cmd
+		}
+		m.viewMode = ViewNormal
+		dialog := m.workRelocate
+		m.workRelocate = nil
+		if relocate {
+			return m, m.relocateWorktree(dialog.workID, dialog.Options(m.proj.Root))
+		}
+		return m, nil
+	case ViewTriage:
+		return m.updateTriage(msg)
+	case ViewReviewChoice:
+		return m.updateReviewChoice(msg)
+	case ViewQuitConfirm:
+		return m.updateQuitConfirm(msg)
+	case ViewTimeline:
+		if m.timelineView.Update(msg) {
+			m.viewMode = ViewNormal
+			m.timelineView = nil
+		}
+		return m, nil
+	case ViewDraftRestore:
+		return m.updateDraftRestore(msg)
 	}
 
 	// Normal mode key handling
@@ -1134,7 +1857,7 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case "h", "left":
 			// Move to previous work tab
 			currentIndex := -1
-			for i, work := range m.workTiles {
+			for i, work := range m.visibleWorkTiles() {
 				if work != nil && work.Work.ID == m.focusedWorkID {
 					currentIndex = i
 					break
@@ -1148,14 +1871,15 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 		case "l", "right":
 			// Move to next work tab
+			works := m.visibleWorkTiles()
 			currentIndex := -1
-			for i, work := range m.workTiles {
+			for i, work := range works {
 				if work != nil && work.Work.ID == m.focusedWorkID {
 					currentIndex = i
 					break
 				}
 			}
-			if currentIndex >= 0 && currentIndex < len(m.workTiles)-1 {
+			if currentIndex >= 0 && currentIndex < len(works)-1 {
 				// Select next work
 				return m.doSelectWorkAtIndex(currentIndex + 1)
 			}
@@ -1170,6 +1894,11 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	}
 
+	// Refuse actions this session can't run before any panel handles them
+	if m.refuseDisabledKey(msg.String()) {
+		return m, nil
+	}
+
 	// Delegate to work details panel when it's active
 	if m.activePanel == PanelWorkDetails && m.focusedWorkID != "" {
 		cmd, action := m.workDetails.Update(msg)
@@ -1187,7 +1916,7 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			useAutoGroup := focusedWork != nil && len(focusedWork.UnassignedBeads) > 1
 			return m, m.runFocusedWork(useAutoGroup)
 		case WorkDetailActionReview:
-			return m, m.createReviewTask()
+			return m, m.startReview()
 		case WorkDetailActionPR:
 			return m, m.createPRTask()
 		case WorkDetailActionRestartOrchestrator:
@@ -1203,20 +1932,145 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				m.statusIsError = true
 				return m, nil
 			}
+			m.destroyWorkID = m.focusedWorkID
+			m.destroyPlan = nil
 			m.viewMode = ViewDestroyConfirm
-			return m, cmd
+			return m, tea.Batch(cmd, m.loadDestructionPlan(m.focusedWorkID))
+		case WorkDetailActionComplete:
+			focusedWork := m.workDetails.GetFocusedWork()
+			if focusedWork != nil && focusedWork.Work.Status != db.StatusIdle && focusedWork.Work.Status != db.StatusMerged {
+				m.statusMessage = "Only idle or merged works can be completed"
+				m.statusIsError = true
+				return m, nil
+			}
+			return m, m.loadCompletionPlan(m.focusedWorkID)
+		case WorkDetailActionCleanupStale:
+			return m, m.cleanupStaleWork(m.focusedWorkID)
+		case WorkDetailActionReport:
+			return m, m.generateWorkReport(m.focusedWorkID)
+		case WorkDetailActionDiff:
+			return m, m.openDiffView()
+		case WorkDetailActionTogglePause:
+			return m, m.togglePauseFocusedWork()
+		case WorkDetailActionToggleAutoPR:
+			return m, m.toggleAutoPRFocusedWork()
+		case WorkDetailActionToggleLog:
+			m.toggleLog()
+			return m, nil
+		case WorkDetailActionCancelSchedule:
+			return m, m.cancelScheduledRun()
+		case WorkDetailActionReviewPlan:
+			m.statusMessage = fmt.Sprintf("Planning tasks for %s...", m.focusedWorkID)
+			m.statusIsError = false
+			return m, m.previewFocusedWorkPlan()
+		case WorkDetailActionCustomTask:
+			if len(m.proj.Config.Workflow.TaskTypeNames()) == 0 {
+				m.statusMessage = "No custom task types configured in [workflow.task_types]"
+				m.statusIsError = true
+				return m, nil
+			}
+			m.taskTypeCursor = 0
+			m.viewMode = ViewTaskTypePicker
+			return m, nil
 		case WorkDetailActionAddChildIssue:
 			// Add child issue to root issue, then add to work and run
 			focusedWork := m.workDetails.GetFocusedWork()
 			if focusedWork != nil && focusedWork.Work.RootIssueID != "" {
 				m.addChildToWorkID = focusedWork.Work.ID
 				m.beadFormPanel.SetAddChildMode(focusedWork.Work.RootIssueID)
+				m.beadFormPanel.SetBeadCandidates(m.beadItems)
 				m.viewMode = ViewAddChildBead
 				return m, m.beadFormPanel.Init()
 			}
 			return m, nil
 		case WorkDetailActionResetTask:
+			// Retrying is pointless while a dependency is still failed
+			if failed := m.workDetails.SelectedTaskFailedDeps(); len(failed) > 0 {
+				m.statusMessage = fmt.Sprintf("Cannot retry %s: it depends on failed %s", m.workDetails.GetSelectedTaskID(), strings.Join(failed, ", "))
+				m.statusIsError = true
+				return m, nil
+			}
 			return m, m.resetSelectedTask()
+		case WorkDetailActionRetryRateLimited:
+			return m, m.retryRateLimitedTasks()
+		case WorkDetailActionRelocate:
+			wp := m.findWorkByID(m.focusedWorkID)
+			if wp == nil {
+				return m, nil
+			}
+			if !wp.WorktreeMissing {
+				m.statusMessage = fmt.Sprintf("Worktree of %s isn't missing", wp.Work.ID)
+				m.statusIsError = true
+				return m, nil
+			}
+			m.workRelocate = newWorkRelocateDialog(m.theme, m.proj.Root, wp.Work)
+			m.viewMode = ViewWorkRelocate
+			return m, textinput.Blink
+		case WorkDetailActionRemoveBead:
+			beadID := m.workDetails.GetSelectedUnassignedBeadID()
+			if beadID == "" {
+				return m, nil
+			}
+			return m, m.removeBeadFromWork(m.focusedWorkID, beadID, false)
+		case WorkDetailActionMoveBead:
+			beadID := m.workDetails.GetSelectedUnassignedBeadID()
+			if beadID == "" {
+				return m, nil
+			}
+			if len(m.moveTargets()) == 0 {
+				m.statusMessage = fmt.Sprintf("No other active works to move %s to", beadID)
+				m.statusIsError = true
+				return m, nil
+			}
+			m.moveBeadID = beadID
+			m.moveTargetCursor = 0
+			m.viewMode = ViewMoveBeadPicker
+			return m, nil
+		case WorkDetailActionNotes:
+			wp := m.findWorkByID(m.focusedWorkID)
+			if wp == nil {
+				return m, nil
+			}
+			m.workNotes = newWorkNotesEditor(m.theme, wp.Work.ID, wp.Work.Notes)
+			m.viewMode = ViewWorkNotes
+			return m, textarea.Blink
+		case WorkDetailActionEnv:
+			wp := m.findWorkByID(m.focusedWorkID)
+			if wp == nil {
+				return m, nil
+			}
+			m.workEnv = newWorkEnvEditor(m.theme, wp.Work.ID, wp.Work.Env)
+			m.viewMode = ViewWorkEnv
+			return m, textarea.Blink
+		case WorkDetailActionArtifacts:
+			task := m.workDetails.SelectedTask()
+			if task == nil {
+				return m, nil
+			}
+			m.artifactView = newArtifactView(m.theme, task.Task.ID, task.Artifacts)
+			m.viewMode = ViewArtifacts
+			return m, nil
+		case WorkDetailActionTimeline:
+			m.timelineView = newTimelineView(m.theme, m.focusedWorkID)
+			m.viewMode = ViewTimeline
+			return m, nil
+		case WorkDetailActionToggleFold:
+			m.workDetails.ToggleCompletedFold()
+			if m.workDetails.IsCompletedFolded() {
+				m.statusMessage = "Completed tasks folded (Z to expand)"
+			} else {
+				m.statusMessage = "Completed tasks expanded"
+			}
+			m.statusIsError = false
+			return m, nil
+		case WorkDetailActionRunTask:
+			taskID := m.workDetails.GetSelectedTaskID()
+			if taskID == "" {
+				return m, nil
+			}
+			m.statusMessage = fmt.Sprintf("Starting task %s...", taskID)
+			m.statusIsError = false
+			return m, m.runSelectedTask(taskID)
 		case WorkDetailActionPlan:
 			// Start planning session for selected unassigned bead
 			beadID := m.workDetails.GetSelectedUnassignedBeadID()
@@ -1314,6 +2168,7 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Create new bead inline
 		m.viewMode = ViewCreateBeadInline
 		m.beadFormPanel.Reset()
+		m.beadFormPanel.SetBeadCandidates(m.beadItems)
 		return m, m.beadFormPanel.Init()
 
 	case "x":
@@ -1348,7 +2203,15 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.textInput.Reset()
 		m.textInput.SetValue(m.filters.label)
 		m.textInput.Focus()
-		return m, nil
+		return m, m.loadProjectLabels()
+
+	case "#":
+		// Add or remove a label on the selected issues
+		return m, m.openLabelPicker()
+
+	case "$":
+		// Estimate the complexity of the selected issues
+		return m, m.estimateBeads()
 
 	case "*":
 		// Show all issues (clear status filter AND work selection filter)
@@ -1388,24 +2251,24 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.beadsExpanded = !m.beadsExpanded
 		return m, nil
 
-	case "[":
-		// Decrease column ratio (make issues column narrower)
-		if m.columnRatio > 0.3 {
-			m.columnRatio -= 0.1
-			if m.columnRatio < 0.3 {
-				m.columnRatio = 0.3
-			}
-		}
+	case "F":
+		return m, m.toggleProblemsFilter()
+
+	case "G":
+		return m, m.toggleWorkGrouping()
+
+	case "<", "[":
+		// Narrow the left column
+		m.adjustColumnRatio(-columnRatioStep)
 		return m, nil
 
-	case "]":
-		// Increase column ratio (make issues column wider)
-		if m.columnRatio < 0.5 {
-			m.columnRatio += 0.1
-			if m.columnRatio > 0.5 {
-				m.columnRatio = 0.5
-			}
-		}
+	case ">", "]":
+		// Widen the left column
+		m.adjustColumnRatio(columnRatioStep)
+		return m, nil
+
+	case "_":
+		m.toggleMaximize()
 		return m, nil
 
 	case " ":
@@ -1440,8 +2303,7 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				return m, nil
 			}
 			// Generate proposed branch name from cursor bead
-			branchBeads := []*beadsForBranch{{ID: bead.ID, Title: bead.Title}}
-			branchName := generateBranchNameFromBeadsForBranch(branchBeads)
+			branchName := work.GenerateBranchNameFromIssues([]*beads.Bead{bead.Bead})
 			m.createWorkPanel.Reset(bead.ID, branchName)
 			// Load available branches for the "existing branch" mode
 			if branches, err := git.NewOperations().ListBranches(m.ctx, m.proj.MainRepoPath()); err == nil {
@@ -1452,10 +2314,52 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case "W":
+		// Create a new work from the selected issue(s), then zoom into it.
+		// Unlike w this also works while another work is focused.
+		if len(m.beadItems) == 0 {
+			return m, nil
+		}
+		var newWorkBeads []*beads.Bead
+		for _, item := range m.beadItems {
+			if m.selectedBeads[item.ID] {
+				if item.assignedWorkID != "" {
+					m.statusMessage = fmt.Sprintf("Cannot create work: %s already assigned to %s", item.ID, item.assignedWorkID)
+					m.statusIsError = true
+					return m, nil
+				}
+				newWorkBeads = append(newWorkBeads, item.Bead)
+			}
+		}
+		if len(newWorkBeads) == 0 && m.beadsCursor < len(m.beadItems) {
+			bead := m.beadItems[m.beadsCursor]
+			if bead.assignedWorkID != "" {
+				m.statusMessage = fmt.Sprintf("Cannot create work: %s already assigned to %s", bead.ID, bead.assignedWorkID)
+				m.statusIsError = true
+				return m, nil
+			}
+			newWorkBeads = append(newWorkBeads, bead.Bead)
+		}
+		if len(newWorkBeads) == 0 {
+			return m, nil
+		}
+		beadIDs := make([]string, len(newWorkBeads))
+		for i, b := range newWorkBeads {
+			beadIDs[i] = b.ID
+		}
+		m.createWorkPanel.ResetForBeads(beadIDs, work.GenerateBranchNameFromIssues(newWorkBeads))
+		if branches, err := git.NewOperations().ListBranches(m.ctx, m.proj.MainRepoPath()); err == nil {
+			m.createWorkPanel.SetBranches(branches)
+		}
+		m.selectedBeads = make(map[string]bool)
+		m.viewMode = ViewCreateWork
+		return m, m.createWorkPanel.Init()
+
 	case "a":
 		// Add child issue to selected issue
 		if len(m.beadItems) > 0 && m.beadsCursor < len(m.beadItems) {
 			m.beadFormPanel.SetAddChildMode(m.beadItems[m.beadsCursor].ID)
+			m.beadFormPanel.SetBeadCandidates(m.beadItems)
 			m.viewMode = ViewAddChildBead
 			return m, m.beadFormPanel.Init()
 		}
@@ -1465,7 +2369,7 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Edit selected issue using the unified bead form
 		if len(m.beadItems) > 0 && m.beadsCursor < len(m.beadItems) {
 			bead := m.beadItems[m.beadsCursor]
-			m.beadFormPanel.SetEditMode(bead.ID, bead.Title, bead.Description, bead.Type, bead.Priority, bead.Status)
+			m.beadFormPanel.SetEditMode(bead.ID, bead.Title, bead.Description, bead.Type, bead.Priority, bead.Status, bead.Labels)
 			m.viewMode = ViewEditBead
 			return m, m.beadFormPanel.Init()
 		}
@@ -1500,6 +2404,35 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.prImportPanel.Reset()
 		return m, m.prImportPanel.Init()
 
+	case "B":
+		// Bulk import issues from a pasted markdown checklist
+		m.checklistImport = newChecklistImportDialog(m.theme)
+		m.viewMode = ViewChecklistImport
+		return m, textarea.Blink
+
+	case "=":
+		// Look for likely duplicates of the selected issue
+		if m.beadsCursor >= len(m.beadItems) {
+			return m, nil
+		}
+		threshold := beads.DefaultDuplicateThreshold
+		if m.proj.Config != nil {
+			threshold = m.proj.Config.Beads.GetDedupeThreshold()
+		}
+		m.dedupe = newDedupeDialog(m.theme, *m.beadItems[m.beadsCursor].Bead, m.beadItems, threshold)
+		m.viewMode = ViewDedupe
+		return m, nil
+
+	case "T":
+		// Triage the open issues that aren't in a work, one at a time
+		return m, m.openTriage()
+
+	case "R":
+		// Run every idle work that has something to do
+		m.statusMessage = "Finding idle works..."
+		m.statusIsError = false
+		return m, m.loadIdleWorks()
+
 	case "A":
 		// Add selected issue(s) to the focused work
 		if m.focusedWorkID == "" {
@@ -1536,9 +2469,9 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 
 			if len(beadsToAdd) > 0 {
-				// Add issues directly to the focused work
-				m.selectedBeads = make(map[string]bool) // Clear selection after adding
-				return m, m.addBeadsToWork(beadsToAdd, m.focusedWorkID)
+				// Confirm first, offering to run the work right away
+				m.openAssignBeadsConfirm(beadsToAdd)
+				return m, nil
 			}
 		}
 		return m, nil
@@ -1547,7 +2480,41 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.viewMode = ViewHelp
 		return m, nil
 
+	case ":", "ctrl+p":
+		return m.openCommandPalette()
+
+	case "ctrl+r", "f5":
+		return m, m.manualRefresh()
+
+	case "M":
+		// Mute or unmute task notifications for this session
+		m.notificationsMuted = !m.notificationsMuted
+		if m.notificationsMuted {
+			m.statusMessage = "Task notifications muted"
+		} else {
+			m.statusMessage = "Task notifications unmuted"
+		}
+		m.statusIsError = false
+		return m, nil
+
+	case "u":
+		// Show recent actions and offer to undo the last one
+		m.openUndo()
+		return m, nil
+
+	case "S":
+		m.markAllWorksSeen()
+		m.statusMessage = "Marked all works as seen"
+		m.statusIsError = false
+		return m, nil
+
+	case "%":
+		return m, m.loadComplexityStats()
+
 	case "q":
+		if m.openQuitConfirm() {
+			return m, nil
+		}
 		// Clean up resources before quitting
 		m.cleanup()
 		return m, tea.Quit
@@ -1558,37 +2525,51 @@ func (m *planModel) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // cleanup releases resources when the TUI exits
 func (m *planModel) cleanup() {
-	// Stop the beads watcher if it's running
+	// End watcher subscriptions and any commands still running against this project
+	if m.cancel != nil {
+		m.cancel()
+	}
+	// Let another TUI on the project take over as the primary right away
+	if m.leaser != nil {
+		if err := m.leaser.release(); err != nil {
+			logging.Debug("release TUI lease failed", "error", err)
+		}
+		m.leaser = nil
+	}
+	// Stop the database watchers if they're running
 	if m.beadsWatcher != nil {
 		_ = m.beadsWatcher.Stop()
+		m.beadsWatcher = nil
+	}
+	if m.trackingWatcher != nil {
+		_ = m.trackingWatcher.Stop()
+		m.trackingWatcher = nil
 	}
-	// Note: m.proj.Beads is owned by the Project and closed by proj.Close()
-	// which is deferred in runTUI. Do not close it here to avoid double-close.
+	m.stopLogTail()
+	// Note: m.proj.Beads is owned by the Project and closed by the root model
+	// along with the project. Do not close it here to avoid double-close.
 }
 
 // syncPanels synchronizes data from planModel to the panel components
 func (m *planModel) syncPanels() {
 	// Calculate column widths
-	totalContentWidth := m.width - 4
-	issuesWidth := int(float64(totalContentWidth) * m.columnRatio)
-	detailsWidth := totalContentWidth - issuesWidth
-
-	// Determine status bar context based on focused panel
-	var statusBarCtx StatusBarContext
-	switch m.activePanel {
-	case PanelWorkDetails:
-		statusBarCtx = StatusBarContextWorkDetail
-	default:
-		statusBarCtx = StatusBarContextIssues
-	}
+	issuesWidth, detailsWidth := m.planColumnWidths()
 
 	// Sync status bar
 	m.statusBar.SetSize(m.width)
-	m.statusBar.SetContext(statusBarCtx)
+	m.statusBar.SetCommands(m.statusCommands())
 	m.statusBar.SetStatus(m.statusMessage, m.statusIsError)
 	m.statusBar.SetLoading(m.loading)
 	m.statusBar.SetLastUpdate(m.lastUpdate)
+	m.statusBar.SetRefreshing(m.refreshPending > 0)
+	m.statusBar.SetUpdateFlash(time.Since(m.lastUpdateFlash) < lastUpdateFlashDuration)
+	m.statusBar.SetBeadsDisabled(m.bdMissing)
+	m.statusBar.SetReadOnly(m.readOnly)
+	m.statusBar.SetPrimaryPID(m.primaryPID)
+	m.statusBar.SetConfigInvalid(m.configInvalid())
 	m.statusBar.SetHoveredButton(m.hoveredButton)
+	m.statusBar.SetWorktreeUsage(m.totalWorktreeSize())
+	m.statusBar.SetProblemWorks(m.problemWorkCount())
 
 	// Sync issues panel
 	m.issuesPanel.SetSize(issuesWidth, m.height)
@@ -1603,6 +2584,9 @@ func (m *planModel) syncPanels() {
 		m.newBeads,
 	)
 	m.issuesPanel.SetWorkContext(m.focusedWorkID)
+	m.issuesPanel.SetResumablePlans(m.resumablePlans)
+	m.issuesPanel.SetEstimates(m.beadEstimates, m.estimatingBeads)
+	m.issuesPanel.SetCounts(m.beadCounts)
 	m.issuesPanel.SetHoveredIssue(m.hoveredIssue)
 
 	// Sync details panel
@@ -1621,6 +2605,19 @@ func (m *planModel) syncPanels() {
 		}
 	}
 	m.detailsPanel.SetData(focusedBead, hasActiveSession, childBeadMap)
+	m.detailsPanel.SetPlanResumable(focusedBead != nil && m.resumablePlans[focusedBead.ID])
+	if focusedBead != nil && focusedBead.assignedWorkID != "" {
+		m.detailsPanel.SetCommitCount(m.beadCommitCounts[focusedBead.assignedWorkID][focusedBead.ID])
+	} else {
+		m.detailsPanel.SetCommitCount(0)
+	}
+	if focusedBead != nil {
+		m.detailsPanel.SetTimeSpent(m.beadDurations[focusedBead.ID])
+		m.detailsPanel.SetEstimate(m.beadEstimates[focusedBead.ID], m.estimatingBeads[focusedBead.ID])
+	} else {
+		m.detailsPanel.SetTimeSpent(0)
+		m.detailsPanel.SetEstimate(db.ComplexityEstimate{}, false)
+	}
 
 	// Sync work tabs bar
 	m.workTabsBar.SetSize(m.width)
@@ -1634,7 +2631,9 @@ func (m *planModel) syncPanels() {
 		// Calculate the correct work panel height (same formula as renderFocusedWorkSplitView)
 		workPanelHeight := m.calculateWorkPanelHeight() + 2 // +2 for border
 		m.workDetails.SetSize(m.width, workPanelHeight)
-		m.workDetails.SetColumnRatio(m.columnRatio) // Use same ratio as issues panel
+		m.workDetails.SetColumnRatio(m.effectiveColumnRatio()) // Use same ratio as issues panel
+		maximized := m.maximizedPanel()
+		m.workDetails.SetMaximized(maximized == panelWorkLeft || maximized == panelWorkRight)
 		// Pass focus state based on whether work details panel is active and which sub-panel has focus
 		leftFocused := m.activePanel == PanelWorkDetails && m.workDetailsFocusLeft
 		rightFocused := m.activePanel == PanelWorkDetails && !m.workDetailsFocusLeft
@@ -1642,6 +2641,14 @@ func (m *planModel) syncPanels() {
 		focusedWork := m.findWorkByID(m.focusedWorkID)
 		m.workDetails.SetFocusedWork(focusedWork)
 		m.workDetails.SetHoveredItem(m.hoveredWorkItem)
+		m.workDetails.SetBeadCommitCounts(m.beadCommitCounts[m.focusedWorkID])
+		m.workDetails.SetBeadDurations(m.beadDurations)
+		m.workDetails.SetStaleReason(m.staleWorks[m.focusedWorkID])
+		m.workDetails.SetWorktreeSize(m.worktreeSize(m.focusedWorkID))
+		m.workDetails.SetFailingChecks(m.failingChecks[m.focusedWorkID])
+		m.workDetails.SetReadOnly(m.readOnly)
+		m.workDetails.SetProjectAutoPR(m.proj.Config.Workflow.AutoPR)
+		m.workDetails.SetTaskTypeGlyphs(m.proj.Config.Workflow.TaskTypeGlyphs())
 	}
 
 	// Sync Linear import panel
@@ -1665,6 +2672,56 @@ func (m *planModel) syncPanels() {
 	m.createWorkPanel.SetHoveredButton(m.hoveredDialogButton)
 }
 
+// applyWorkTiles shows a freshly loaded list of works, whether all of them
+// were reloaded or only some were patched in
+func (m *planModel) applyWorkTiles(works []*progress.WorkProgress, health map[string]db.OrchestratorHealth) (tea.Model, tea.Cmd) {
+	notifyEvents := m.notifyTaskEvents(taskTransitions(m.workTiles, works))
+	m.workTiles = works
+	m.orchestratorHealth = health
+	m.syncWorkTabs()
+	m.workTabsBar.SetOrchestratorHealth(health)
+	m.loading = false
+	m.updateSeenWorks()
+	m.reportWorktreeSetup()
+	m.maybeOpenTour()
+
+	// Rescan commit activity once per tiles refresh; the stale branch
+	// check and worktree measurement are rate limited and usually no-ops
+	loadCommits := tea.Batch(m.loadBeadCommits(works), m.loadBeadDurations(), m.loadBeadEstimates(), m.checkStaleWorks(), m.measureWorktrees(), m.loadRootTitles(), notifyEvents, m.reconcileWorkStatuses(works, health))
+
+	// Check for pending work selection (from [0-9] hotkey)
+	if m.pendingWorkSelectIndex >= 0 {
+		pendingIndex := m.pendingWorkSelectIndex
+		m.pendingWorkSelectIndex = -1 // Clear pending selection
+		model, cmd := m.doSelectWorkAtIndex(pendingIndex)
+		return model, tea.Batch(cmd, loadCommits)
+	}
+
+	// Zoom into a just-created work once it appears
+	if m.pendingFocusWorkID != "" {
+		for _, work := range m.workTiles {
+			if work != nil && work.Work.ID == m.pendingFocusWorkID {
+				m.pendingFocusWorkID = ""
+				model, cmd := m.doSelectWork(work)
+				return model, tea.Batch(cmd, loadCommits)
+			}
+		}
+	}
+
+	// Update work details panel and filter if a work is focused
+	if m.focusedWorkID != "" {
+		focusedWork := m.findWorkByID(m.focusedWorkID)
+		m.workDetails.SetFocusedWork(focusedWork)
+		m.workDetails.SetOrchestratorHealth(m.orchestratorHealth[m.focusedWorkID])
+		// Rebuild the filter to reflect any changes in work beads
+		// BUT skip if user manually cleared the filter (e.g., pressed '*')
+		if !m.workSelectionCleared {
+			return m, tea.Batch(m.updateWorkSelectionFilter(), loadCommits)
+		}
+	}
+	return m, loadCommits
+}
+
 // View implements tea.Model
 func (m *planModel) View() string {
 	// Handle dialogs
@@ -1680,10 +2737,62 @@ func (m *planModel) View() string {
 		// Fall through to normal rendering
 	case ViewLabelFilter:
 		return m.renderWithDialog(m.renderLabelFilterDialogContent())
+	case ViewLabelPicker:
+		return m.renderWithDialog(m.renderLabelPickerContent())
 	case ViewCloseBeadConfirm:
 		return m.renderWithDialog(m.renderCloseBeadConfirmContent())
 	case ViewDestroyConfirm:
 		return m.renderWithDialog(m.renderDestroyConfirmContent())
+	case ViewCompleteWorkConfirm:
+		return m.renderWithDialog(m.renderCompleteWorkConfirmContent())
+	case ViewComplexityStats:
+		return m.renderWithDialog(m.renderComplexityStatsContent())
+	case ViewSpawnError:
+		return m.renderWithDialog(m.renderSpawnErrorContent())
+	case ViewDiff:
+		return m.renderWithDialog(m.diffView.render(m.width-4, m.height-2))
+	case ViewArtifacts:
+		return m.renderWithDialog(m.artifactView.render(m.width-4, m.height-2))
+	case ViewWorkNotes:
+		return m.renderWithDialog(m.workNotes.render(m.width-4, m.height-2))
+	case ViewChecklistImport:
+		return m.renderWithDialog(m.checklistImport.render(m.width-4, m.height-2))
+	case ViewDedupe:
+		return m.renderWithDialog(m.dedupe.render(m.width-4, m.height-2))
+	case ViewRunAll:
+		return m.renderWithDialog(m.runAll.render(m.width-4, m.height-2))
+	case ViewTour:
+		return m.renderWithDialog(m.tour.render(m.width - 4))
+	case ViewWorkEnv:
+		return m.renderWithDialog(m.workEnv.render(m.width-4, m.height-2))
+	case ViewWorkRelocate:
+		return m.renderWithDialog(m.workRelocate.render(m.width-4, m.height-2))
+	case ViewTriage:
+		return m.renderWithDialog(m.renderTriageContent())
+	case ViewReviewChoice:
+		return m.renderWithDialog(m.renderReviewChoiceContent())
+	case ViewQuitConfirm:
+		return m.renderWithDialog(m.renderQuitConfirmContent())
+	case ViewTimeline:
+		return m.renderWithDialog(m.timelineView.render(m.findWorkByID(m.timelineView.workID), m.width-4, m.height-2))
+	case ViewUndoConfirm:
+		return m.renderWithDialog(m.renderUndoConfirmContent())
+	case ViewConfigErrors:
+		return m.renderWithDialog(m.renderConfigErrorsContent())
+	case ViewDraftRestore:
+		return m.renderWithDialog(m.renderDraftRestoreContent())
+	case ViewTaskTypePicker:
+		return m.renderWithDialog(m.renderTaskTypePickerContent())
+	case ViewCommandPalette:
+		return m.renderWithDialog(m.renderCommandPaletteContent())
+	case ViewPlanReview:
+		return m.renderWithDialog(m.renderPlanReviewContent())
+	case ViewRemoveBeadConfirm:
+		return m.renderWithDialog(m.renderRemoveBeadConfirmContent())
+	case ViewAssignBeads:
+		return m.renderWithDialog(m.renderAssignBeadsConfirmContent())
+	case ViewMoveBeadPicker:
+		return m.renderWithDialog(m.renderMoveBeadPickerContent())
 	case ViewLinearImportInline:
 		// Inline import mode - render normal view with import form in details area
 		// Fall through to normal rendering
@@ -1712,41 +2821,6 @@ func (m *planModel) View() string {
 	return lipgloss.JoinVertical(lipgloss.Left, workTabsBar, content, statusBar)
 }
 
-// beadsForBranch is a minimal struct for branch name generation
-type beadsForBranch struct {
-	ID    string
-	Title string
-}
-
-// generateBranchNameFromBeadsForBranch generates a branch name from beads
-func generateBranchNameFromBeadsForBranch(beads []*beadsForBranch) string {
-	if len(beads) == 0 {
-		return ""
-	}
-	// Use the same logic as generateBranchNameFromBeads but with local struct
-	var titles []string
-	for _, b := range beads {
-		titles = append(titles, b.Title)
-	}
-	combined := strings.Join(titles, " ")
-	// Sanitize for branch name
-	combined = strings.ToLower(combined)
-	combined = strings.ReplaceAll(combined, " ", "-")
-	// Remove special characters
-	var result strings.Builder
-	for _, c := range combined {
-		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-' || c == '_' {
-			result.WriteRune(c)
-		}
-	}
-	branchName := result.String()
-	// Limit length
-	branchName = ansi.Truncate(branchName, 50, "")
-	// Remove trailing dashes
-	branchName = strings.TrimRight(branchName, "-")
-	return "feat/" + branchName
-}
-
 // updateWorkSelectionFilter updates the bead filter based on the current work details selection
 // and triggers a data refresh
 func (m *planModel) updateWorkSelectionFilter() tea.Cmd {
@@ -1807,10 +2881,11 @@ func (m *planModel) selectWorkByIndex(digit int) (tea.Model, tea.Cmd) {
 	return m.doSelectWorkAtIndex(index)
 }
 
-// doSelectWorkAtIndex performs the actual work selection at a given index.
-// This is called either directly from selectWorkByIndex or after work tiles are loaded.
+// doSelectWorkAtIndex performs the actual work selection at a given position
+// in the tabs bar. This is called either directly from selectWorkByIndex or
+// after work tiles are loaded.
 func (m *planModel) doSelectWorkAtIndex(index int) (tea.Model, tea.Cmd) {
-	works := m.workTiles
+	works := m.visibleWorkTiles()
 
 	// Check if index is valid
 	if index >= len(works) {
@@ -1819,7 +2894,11 @@ func (m *planModel) doSelectWorkAtIndex(index int) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
-	work := works[index]
+	return m.doSelectWork(works[index])
+}
+
+// doSelectWork zooms into a work
+func (m *planModel) doSelectWork(work *progress.WorkProgress) (tea.Model, tea.Cmd) {
 	if work == nil {
 		return m, nil
 	}
@@ -1834,16 +2913,15 @@ func (m *planModel) doSelectWorkAtIndex(index int) (tea.Model, tea.Cmd) {
 	m.statusMessage = fmt.Sprintf("Focused on work %s", m.focusedWorkID)
 	m.statusIsError = false
 
-	// Clear unseen PR changes flag for this work
-	_ = m.proj.DB.MarkWorkPRSeen(m.ctx, m.focusedWorkID)
+	m.markWorkSeen(m.focusedWorkID)
 
-	// Set up the work details panel
+	// Set up the work details panel, using the cached orchestrator health
 	m.workDetails.SetFocusedWork(work)
 	m.workDetails.SetSelectedIndex(0)
-	m.workDetails.SetOrchestratorHealth(checkOrchestratorHealth(m.ctx, m.proj.DB, m.focusedWorkID))
+	m.workDetails.SetOrchestratorHealth(m.orchestratorHealth[m.focusedWorkID])
 
 	// Update the filter and refresh
-	return m, m.updateWorkSelectionFilter()
+	return m, tea.Batch(m.updateWorkSelectionFilter(), m.loadFailingChecks(work))
 }
 
 // findWorkByID finds a work by its ID in the cached work tiles.
@@ -1856,3 +2934,22 @@ func (m *planModel) findWorkByID(id string) *progress.WorkProgress {
 	}
 	return nil
 }
+
+// pruneHiddenSelections drops selected issues the issues list no longer
+// shows, after a filter change or a refresh, and returns how many it dropped
+func (m *planModel) pruneHiddenSelections() int {
+	visible := make(map[string]bool, len(m.beadItems))
+	for _, item := range m.beadItems {
+		visible[item.ID] = true
+	}
+	cleared := 0
+	for id, selected := range m.selectedBeads {
+		if !visible[id] {
+			delete(m.selectedBeads, id)
+			if selected {
+				cleared++
+			}
+		}
+	}
+	return cleared
+}
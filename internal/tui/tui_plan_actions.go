@@ -0,0 +1,519 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/db"
+)
+
+// actionScope says where in plan mode an action's key is handled
+type actionScope int
+
+const (
+	scopeGlobal actionScope = iota // Anywhere in normal mode
+	scopeIssues                    // The issues panel
+	scopeWork                      // The work details panel of a focused work
+)
+
+// Help screen sections, in display order
+const (
+	sectionLayout     = "Layout"
+	sectionNavigation = "Navigation"
+	sectionIssues     = "Issue Management"
+	sectionFiltering  = "Filtering & Sorting"
+	sectionWork       = "Work Mode"
+	sectionGeneral    = "General"
+)
+
+var helpSections = []string{sectionLayout, sectionNavigation, sectionIssues, sectionFiltering, sectionWork, sectionGeneral}
+
+// planAction is a plan-mode command. planActions is the single list the
+// command palette, the status bar buttons and the help screen are built from.
+type planAction struct {
+	key     string // Binding, as reported by tea.KeyMsg.String()
+	keyHelp string // Binding as shown in help and the palette, when it differs from key
+	name    string // What the action does
+	section string // Help screen section
+	scope   actionScope
+
+	// button is the status bar label; empty keeps the action off the bar.
+	// buttonFor overrides it when the label depends on state.
+	button    string
+	buttonFor func(m *planModel) string
+	// onlyWhenAvailable keeps the button off the bar while the action is unavailable
+	onlyWhenAvailable bool
+
+	needsBD bool // Shells out to bd, so it's unavailable when bd is missing
+	mutates bool // Changes works, tasks or issues or starts sessions, so it's unavailable in read-only mode
+	// unavailable returns why the action can't run right now, or "" if it can
+	unavailable func(m *planModel) string
+	// run performs the action. Entries without one (navigation keys) only
+	// appear in help.
+	run func(m *planModel) tea.Cmd
+}
+
+// displayKey returns the binding as shown to the user
+func (a *planAction) displayKey() string {
+	if a.keyHelp != "" {
+		return a.keyHelp
+	}
+	return a.key
+}
+
+// disabled returns why the action can't run at all in this session (bd is
+// missing, read-only mode), or "" if it can. Disabled actions are dimmed
+// wherever they're listed.
+func (a *planAction) disabled(m *planModel) string {
+	if a.mutates && m.readOnly {
+		return readOnlyMessage
+	}
+	if a.mutates && m.configInvalid() {
+		return configInvalidMessage
+	}
+	if a.needsBD && m.bdMissing {
+		return bdMissingMessage
+	}
+	return ""
+}
+
+// reason returns why the action can't run right now, or "" if it can
+func (a *planAction) reason(m *planModel) string {
+	if reason := a.disabled(m); reason != "" {
+		return reason
+	}
+	if a.unavailable != nil {
+		return a.unavailable(m)
+	}
+	return ""
+}
+
+// pressKey runs an action by handling its key as if it was typed, so the
+// palette and status bar buttons share the keyboard's code path.
+func pressKey(key string) func(m *planModel) tea.Cmd {
+	return func(m *planModel) tea.Cmd {
+		_, cmd := m.handleKeyPress(keyMsgFor(key))
+		return cmd
+	}
+}
+
+// rootKey runs an action the root model handles (project picker, quit) by
+// sending its key back through the program once the palette has closed.
+func rootKey(key string) func(m *planModel) tea.Cmd {
+	return func(m *planModel) tea.Cmd {
+		return func() tea.Msg { return keyMsgFor(key) }
+	}
+}
+
+// keyMsgFor builds the tea.KeyMsg whose String() is key
+func keyMsgFor(key string) tea.KeyMsg {
+	switch key {
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "ctrl+r":
+		return tea.KeyMsg{Type: tea.KeyCtrlR}
+	case " ":
+		return tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+}
+
+// unavailability checks shared by several actions
+
+func needCursorBead(m *planModel) string {
+	if m.beadsCursor >= len(m.beadItems) {
+		return "no issue selected"
+	}
+	return ""
+}
+
+func needFocusedWork(m *planModel) string {
+	if m.focusedWorkID == "" {
+		return "select a work first (press 1-9)"
+	}
+	return ""
+}
+
+// planActions is filled in by init, since its handlers refer back to it
+// through handleKeyPress.
+var planActions []planAction
+
+func init() {
+	planActions = []planAction{
+		// Layout
+		{key: "<", keyHelp: "<, [", name: "Narrow the left column", section: sectionLayout, run: pressKey("<")},
+		{key: ">", keyHelp: ">, ]", name: "Widen the left column", section: sectionLayout, run: pressKey(">")},
+		{key: "_", name: "Maximize the focused panel, or restore the split", section: sectionLayout, run: pressKey("_")},
+		{key: "tab", name: "Switch between the work and issues panels", section: sectionLayout},
+
+		// Navigation
+		{key: "j", keyHelp: "j/k, ↑/↓", name: "Navigate list", section: sectionNavigation},
+		{key: "1", keyHelp: "1-9", name: "Select work by position", section: sectionNavigation},
+		{key: "P", name: "Switch project", section: sectionNavigation, run: rootKey("P")},
+		{key: "ctrl+r", keyHelp: "ctrl+r, F5", name: "Refresh now (also re-checks for bd and config.toml)", section: sectionNavigation, run: pressKey("ctrl+r")},
+
+		// Issue management; the order of buttons here is their order on the status bar
+		{key: "n", name: "Create new issue", button: "[n]New", section: sectionIssues, scope: scopeIssues, needsBD: true, mutates: true, run: pressKey("n")},
+		{key: "e", name: "Edit issue inline", button: "[e]Edit", section: sectionIssues, scope: scopeIssues, needsBD: true, unavailable: needCursorBead, mutates: true, run: pressKey("e")},
+		{key: "E", name: "Edit issue in $EDITOR", section: sectionIssues, scope: scopeIssues, needsBD: true, unavailable: needCursorBead, mutates: true, run: pressKey("E")},
+		{key: "a", name: "Add child issue (blocked by selected)", button: "[a]Child", section: sectionIssues, scope: scopeIssues, needsBD: true, unavailable: needCursorBead, mutates: true, run: pressKey("a")},
+		{key: "x", name: "Close selected issue(s)", button: "[x]Close", section: sectionIssues, scope: scopeIssues, needsBD: true, unavailable: needCursorBead, mutates: true, run: pressKey("x")},
+		{key: "#", name: "Add/remove a label (all selected issues)", section: sectionIssues, scope: scopeIssues, needsBD: true, unavailable: needCursorBead, mutates: true, run: pressKey("#")},
+		{key: " ", keyHelp: "Space", name: "Toggle issue selection (for multi-select)", section: sectionIssues, scope: scopeIssues, unavailable: needCursorBead, run: pressKey(" ")},
+		{key: "w", name: "Create work from issue", button: "[w]Work", section: sectionIssues, scope: scopeIssues, unavailable: needCursorBead, mutates: true, run: pressKey("w")},
+		{key: "W", name: "New work from selected issue(s), then focus it", section: sectionIssues, scope: scopeIssues, unavailable: needCursorBead, mutates: true, run: pressKey("W")},
+		{key: "B", name: "Bulk import issues from a pasted markdown checklist", section: sectionIssues, scope: scopeIssues, needsBD: true, mutates: true, run: pressKey("B")},
+		{key: "=", name: "Find likely duplicates of the issue and merge one into it", section: sectionIssues, scope: scopeIssues, needsBD: true, unavailable: needCursorBead, mutates: true, run: pressKey("=")},
+		{key: "$", name: "Estimate complexity of selected issue(s) with Claude", section: sectionIssues, scope: scopeIssues, unavailable: needCursorBead, mutates: true, run: pressKey("$")},
+		{key: "T", name: "Triage open issues one at a time (priority, add to work, close, skip)", section: sectionIssues, scope: scopeIssues, needsBD: true, mutates: true, run: pressKey("T")},
+		{key: "A", name: "Add issue(s) to the focused work (then Enter adds, r or p also runs it)", button: "[A]dd", section: sectionIssues, scope: scopeIssues, unavailable: needFocusedWork, mutates: true, run: pressKey("A")},
+		{key: "u", name: "Undo the last assignment, removal, close/reopen or task creation (lists recent actions)", section: sectionIssues, scope: scopeIssues, mutates: true, run: pressKey("u")},
+		{key: "i", name: "Import issue from Linear", button: "[i]Import", section: sectionIssues, scope: scopeIssues, needsBD: true, mutates: true, run: pressKey("i"),
+			unavailable: func(m *planModel) string {
+				if m.proj.Config == nil || m.proj.Config.Linear.APIKey == "" {
+					return "Linear API key not configured ([linear] api_key)"
+				}
+				return ""
+			}},
+		{key: "I", name: "Import from GitHub PR", section: sectionIssues, scope: scopeIssues, needsBD: true, mutates: true, run: pressKey("I")},
+		{key: "p", name: "Start/resume planning session", button: "[p]Plan", section: sectionIssues, scope: scopeIssues, unavailable: needCursorBead, mutates: true, run: pressKey("p"),
+			buttonFor: func(m *planModel) string {
+				if m.beadsCursor < len(m.beadItems) && m.activeBeadSessions[m.beadItems[m.beadsCursor].ID] {
+					return "[p]Resume"
+				}
+				return "[p]Plan"
+			}},
+
+		// Filtering and sorting
+		{key: "o", name: "Show open issues", section: sectionFiltering, scope: scopeIssues, run: pressKey("o")},
+		{key: "c", name: "Show closed issues", section: sectionFiltering, scope: scopeIssues, run: pressKey("c")},
+		{key: "r", name: "Show ready issues", section: sectionFiltering, scope: scopeIssues, run: pressKey("r")},
+		{key: "*", name: "Show all issues (clears the work filter)", section: sectionFiltering, scope: scopeIssues, run: pressKey("*")},
+		{key: "/", name: "Fuzzy search", section: sectionFiltering, scope: scopeIssues, run: pressKey("/")},
+		{key: "L", name: "Filter by label (Tab completes)", section: sectionFiltering, scope: scopeIssues, run: pressKey("L")},
+		{key: "s", name: "Cycle sort mode", section: sectionFiltering, scope: scopeIssues, run: pressKey("s")},
+		{key: "v", name: "Toggle expanded view", section: sectionFiltering, scope: scopeIssues, run: pressKey("v")},
+
+		// Work mode
+		{key: "t", name: "Open a terminal in the worktree", button: "[t]erminal", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("t")},
+		{key: "c", name: "Open Claude in the worktree", button: "[c]laude", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("c")},
+		{key: "r", name: "Run the work", button: "[r]un", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("r")},
+		{key: "o", name: "Restart the orchestrator", button: "[o]rch", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("o")},
+		{key: "v", name: "Create a review task", button: "[v]review", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("v")},
+		{key: "p", name: "Create a PR task (plans the selected unassigned issue instead)", button: "[p]r", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("p")},
+		{key: "f", name: "Check PR feedback", button: "[f]eedback", section: sectionWork, scope: scopeWork, needsBD: true, mutates: true, run: pressKey("f")},
+		{key: "x", name: "Reset the selected failed task (removes the selected unassigned issue instead)", button: "[x]Reset", onlyWhenAvailable: true, section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("x"),
+			buttonFor: func(m *planModel) string {
+				if m.workDetails.IsUnassignedBeadSelected() {
+					return "[x]Remove"
+				}
+				return "[x]Reset"
+			},
+			unavailable: func(m *planModel) string {
+				if m.workDetails.IsUnassignedBeadSelected() {
+					return ""
+				}
+				if !m.workDetails.IsTaskSelected() || !m.workDetails.IsSelectedTaskFailed() {
+					return "select a failed task or an unassigned issue"
+				}
+				return ""
+			}},
+		{key: "X", name: "Reset all of the work's rate-limited tasks", onlyWhenAvailable: true, section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("X"),
+			unavailable: func(m *planModel) string {
+				if wp := m.findWorkByID(m.focusedWorkID); wp == nil || len(rateLimitedTaskIDs(wp)) == 0 {
+					return "no tasks failed on a rate limit"
+				}
+				return ""
+			}},
+		{key: "H", name: "Relocate or re-create a missing worktree", onlyWhenAvailable: true, section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("H"),
+			unavailable: func(m *planModel) string {
+				if wp := m.findWorkByID(m.focusedWorkID); wp == nil || !wp.WorktreeMissing {
+					return "worktree isn't missing"
+				}
+				return ""
+			}},
+		{key: "b", name: "Move the selected unassigned issue to another work", onlyWhenAvailable: true, section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("b"),
+			unavailable: func(m *planModel) string {
+				if !m.workDetails.IsUnassignedBeadSelected() {
+					return "select an unassigned issue"
+				}
+				return ""
+			}},
+		{key: "!", name: "Run the selected pending task now, in its own tab", onlyWhenAvailable: true, section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("!"),
+			unavailable: func(m *planModel) string {
+				if !m.workDetails.IsTaskSelected() || !m.workDetails.IsSelectedTaskPending() {
+					return "select a pending task"
+				}
+				return ""
+			}},
+		{key: "enter", name: "Browse the selected task's artifacts (view, or E to edit)", onlyWhenAvailable: true, section: sectionWork, scope: scopeWork, run: pressKey("enter"),
+			unavailable: func(m *planModel) string {
+				if !m.workDetails.SelectedTaskHasArtifacts() {
+					return "select a task that wrote artifacts"
+				}
+				return ""
+			}},
+		{key: "N", name: "Edit the work's notes", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("N")},
+		{key: "E", name: "Edit the work's environment overrides", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("E")},
+		{key: "m", name: "Complete the work (PR merged)", button: "[m]Complete", section: sectionWork, scope: scopeWork, needsBD: true, mutates: true, run: pressKey("m"),
+			unavailable: func(m *planModel) string {
+				if wp := m.findWorkByID(m.focusedWorkID); wp != nil && wp.Work.Status != db.StatusIdle && wp.Work.Status != db.StatusMerged {
+					return "only idle or merged works can be completed"
+				}
+				return ""
+			}},
+		{key: "d", name: "Destroy the work", button: "[d]estroy", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("d"),
+			unavailable: func(m *planModel) string {
+				if wp := m.findWorkByID(m.focusedWorkID); wp != nil && wp.Work.Status == db.StatusProcessing {
+					return "work is processing"
+				}
+				return ""
+			}},
+		{key: "esc", keyHelp: "Esc", name: "Deselect the work", button: "[Esc]Deselect", section: sectionWork, scope: scopeWork, run: pressKey("esc")},
+		{key: "a", name: "Add child issue to the work's root issue and run it", section: sectionWork, scope: scopeWork, needsBD: true, mutates: true, run: pressKey("a"),
+			unavailable: func(m *planModel) string {
+				if wp := m.findWorkByID(m.focusedWorkID); wp == nil || wp.Work.RootIssueID == "" {
+					return "work has no root issue"
+				}
+				return ""
+			}},
+		{key: "R", name: "Run all idle works with pending issues or tasks, staggered (p in the dialog groups with the LLM)", section: sectionWork, scope: scopeIssues, mutates: true, run: pressKey("R")},
+		{key: "F", name: "Show only problem works (failed tasks, dead orchestrators), jumping to the first failed task", section: sectionWork, run: pressKey("F")},
+		{key: "G", name: "Group works in the tabs bar by root issue, with each group's completion", section: sectionWork, run: pressKey("G")},
+		{key: "R", name: "Standup report for the work (copied to clipboard, saved to .co/reports/)", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("R")},
+		{key: "D", name: "Diff of the work's branch (Enter opens a file)", section: sectionWork, scope: scopeWork, run: pressKey("D")},
+		{key: "V", name: "Timeline of when the work's tasks ran (a: wall clock or since work start)", section: sectionWork, scope: scopeWork, run: pressKey("V")},
+		{key: "Z", name: "Fold/expand the completed tasks (remembered per work)", section: sectionWork, scope: scopeWork, run: pressKey("Z")},
+		{key: "z", name: "Pause/resume the work (running task finishes)", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("z")},
+		{key: "O", name: "Turn auto-PR on/off for the work", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("O")},
+		{key: "L", name: "Show/hide the orchestrator log (PgUp/PgDn scroll, End follows)", section: sectionWork, scope: scopeWork, run: pressKey("L")},
+		{key: "T", name: "New task of a custom type ([workflow.task_types])", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("T"),
+			unavailable: func(m *planModel) string {
+				if len(m.proj.Config.Workflow.TaskTypeNames()) == 0 {
+					return "no custom task types configured in [workflow.task_types]"
+				}
+				return ""
+			}},
+		{key: "g", name: "Review the LLM task grouping, then run (move issues, merge/split/rename tasks)", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("g"),
+			unavailable: func(m *planModel) string {
+				if wp := m.findWorkByID(m.focusedWorkID); wp == nil || len(wp.UnassignedBeads) == 0 {
+					return "work has no unassigned issues"
+				}
+				return ""
+			}},
+		{key: "u", name: "Cancel the work's scheduled run (co run --at)", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("u"),
+			unavailable: func(m *planModel) string {
+				if wp := m.findWorkByID(m.focusedWorkID); wp == nil || wp.Work.ScheduledRunAt == nil {
+					return "work has no scheduled run"
+				}
+				return ""
+			}},
+		{key: "C", name: "Clean up a stale work (branch merged or deleted)", section: sectionWork, scope: scopeWork, mutates: true, run: pressKey("C"),
+			unavailable: func(m *planModel) string {
+				if m.staleWorks[m.focusedWorkID] == "" {
+					return "work isn't stale"
+				}
+				return ""
+			}},
+
+		// General
+		{key: "%", name: "Complexity budget vs actual stats", section: sectionGeneral, run: pressKey("%")},
+		{key: "S", name: "Mark all works as seen (clears new-activity badges)", section: sectionGeneral, run: pressKey("S")},
+		{key: "M", name: "Mute/unmute task notifications ([tui] notify_on_*)", section: sectionGeneral, run: pressKey("M")},
+		{key: ":", keyHelp: ": or ctrl+p", name: "Command palette", section: sectionGeneral},
+		{key: "?", name: "Help", button: "[?]Help", section: sectionGeneral, run: pressKey("?")},
+		{key: "q", name: "Quit", section: sectionGeneral, run: rootKey("q")},
+	}
+}
+
+// workDetailsActive reports whether keys go to the focused work's details panel
+func (m *planModel) workDetailsActive() bool {
+	return m.activePanel == PanelWorkDetails && m.focusedWorkID != ""
+}
+
+// contextActions returns the runnable actions for the active panel: its own
+// actions followed by the global ones.
+func (m *planModel) contextActions() []*planAction {
+	scope := scopeIssues
+	if m.workDetailsActive() {
+		scope = scopeWork
+	}
+	var actions, global []*planAction
+	for i := range planActions {
+		a := &planActions[i]
+		if a.run == nil {
+			continue
+		}
+		switch a.scope {
+		case scope:
+			actions = append(actions, a)
+		case scopeGlobal:
+			global = append(global, a)
+		}
+	}
+	return append(actions, global...)
+}
+
+// findContextAction returns the runnable action bound to key in the active panel, or nil
+func (m *planModel) findContextAction(key string) *planAction {
+	for _, a := range m.contextActions() {
+		if a.key == key {
+			return a
+		}
+	}
+	return nil
+}
+
+// refuseDisabledKey reports the action bound to key in the active panel when
+// it is disabled in this session, returning false if the key may be handled
+func (m *planModel) refuseDisabledKey(key string) bool {
+	a := m.findContextAction(key)
+	if a == nil {
+		return false
+	}
+	switch reason := a.disabled(m); reason {
+	case "":
+		return false
+	case bdMissingMessage:
+		m.reportBDMissing()
+	case configInvalidMessage:
+		m.reportConfigInvalid()
+	default:
+		m.statusMessage = fmt.Sprintf("%s: %s", a.name, reason)
+		m.statusIsError = true
+	}
+	return true
+}
+
+// statusCommands returns the status bar buttons for the active panel
+func (m *planModel) statusCommands() []statusCommand {
+	var commands []statusCommand
+	for _, a := range m.contextActions() {
+		if a.button == "" {
+			continue
+		}
+		reason := a.reason(m)
+		if reason != "" && a.onlyWhenAvailable {
+			continue
+		}
+		label := a.button
+		if a.buttonFor != nil {
+			label = a.buttonFor(m)
+		}
+		// Only a disabled action dims its button; other reasons are reported when pressed
+		commands = append(commands, statusCommand{key: a.key, label: label, dimmed: a.disabled(m) != ""})
+	}
+	return commands
+}
+
+// Help screen layout: sections are laid out in columns of up to
+// helpColumnWidth, with the action names starting helpKeyWidth in
+const (
+	helpColumnWidth = 60
+	helpColumnGap   = 4
+	helpKeyWidth    = 14
+)
+
+// helpScopes returns the scopes whose keys can be pressed in the current
+// mode: the work panel's only while a work is focused, and neither panel's
+// while the other one is maximized.
+func (m *planModel) helpScopes() map[actionScope]bool {
+	scopes := map[actionScope]bool{scopeGlobal: true}
+	switch m.maximizedPanel() {
+	case panelWorkLeft, panelWorkRight:
+		scopes[scopeWork] = true
+	case panelIssuesLeft, panelIssuesRight:
+		scopes[scopeIssues] = true
+	default:
+		scopes[scopeIssues] = true
+		scopes[scopeWork] = m.focusedWorkID != ""
+	}
+	return scopes
+}
+
+// helpSectionLines returns the lines of one help section, its actions
+// filtered to scopes and their names wrapped to width, or nil if none of
+// its actions apply.
+func (m *planModel) helpSectionLines(section string, scopes map[actionScope]bool, width int) []string {
+	var lines []string
+	for i := range planActions {
+		a := &planActions[i]
+		if a.section != section || !scopes[a.scope] {
+			continue
+		}
+		key := a.displayKey()
+		name := strings.Split(ansi.Wordwrap(a.name, max(width-helpKeyWidth, 10), ""), "\n")
+		entry := []string{key + strings.Repeat(" ", max(helpKeyWidth-ansi.StringWidth(key), 1)) + name[0]}
+		for _, rest := range name[1:] {
+			entry = append(entry, strings.Repeat(" ", helpKeyWidth)+rest)
+		}
+		if a.disabled(m) != "" {
+			for j := range entry {
+				entry[j] = m.theme.Dim.Render(entry[j])
+			}
+		}
+		lines = append(lines, entry...)
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return append([]string{section, strings.Repeat("─", min(28, width))}, lines...)
+}
+
+// helpText builds the key reference of the help screen from planActions,
+// listing the keys that apply in the current mode in as many columns as fit
+// in width.
+func (m *planModel) helpText(width int) string {
+	colWidth := min(helpColumnWidth, width)
+	columns := max((width+helpColumnGap)/(colWidth+helpColumnGap), 1)
+
+	scopes := m.helpScopes()
+	var blocks [][]string
+	total := 0
+	for _, section := range helpSections {
+		if lines := m.helpSectionLines(section, scopes, colWidth); lines != nil {
+			blocks = append(blocks, lines)
+			total += len(lines) + 1
+		}
+	}
+
+	// Fill the columns in order, moving on once one holds its share of lines
+	target := (total + columns - 1) / columns
+	layout := [][]string{nil}
+	for _, block := range blocks {
+		col := layout[len(layout)-1]
+		if len(col) > 0 && len(col)+len(block) > target && len(layout) < columns {
+			layout = append(layout, nil)
+			col = nil
+		}
+		if len(col) > 0 {
+			col = append(col, "")
+		}
+		layout[len(layout)-1] = append(col, block...)
+	}
+
+	var b strings.Builder
+	rows := 0
+	for _, col := range layout {
+		rows = max(rows, len(col))
+	}
+	for row := range rows {
+		var line strings.Builder
+		for i, col := range layout {
+			cell := ""
+			if row < len(col) {
+				cell = col[row]
+			}
+			if i < len(layout)-1 {
+				cell += strings.Repeat(" ", max(colWidth-ansi.StringWidth(cell), 0)+helpColumnGap)
+			}
+			line.WriteString(cell)
+		}
+		b.WriteString("  " + strings.TrimRight(line.String(), " ") + "\n")
+	}
+	return b.String()
+}
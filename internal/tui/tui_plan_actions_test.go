@@ -0,0 +1,165 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusCommandsFromActions(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	labels := func() []string {
+		var labels []string
+		for _, c := range m.statusCommands() {
+			labels = append(labels, c.label)
+		}
+		return labels
+	}
+
+	require.Equal(t, []string{"[n]New", "[e]Edit", "[a]Child", "[x]Close", "[w]Work", "[A]dd", "[i]Import", "[p]Plan", "[?]Help"}, labels())
+
+	m.activeBeadSessions["bead-1"] = true
+	require.Contains(t, labels(), "[p]Resume")
+
+	// Without bd the bead-editing buttons are dimmed
+	m.bdMissing = true
+	for _, c := range m.statusCommands() {
+		require.Equal(t, m.findContextAction(c.key).needsBD, c.dimmed, c.key)
+	}
+	m.bdMissing = false
+
+	// Read-only mode dims everything that changes the project
+	m.readOnly = true
+	for _, c := range m.statusCommands() {
+		require.Equal(t, c.key != "?", c.dimmed, c.key)
+	}
+	m.readOnly = false
+
+	// Reset only shows while a failed task is selected
+	focusWork(t, m, w)
+	require.Equal(t, []string{"[t]erminal", "[c]laude", "[r]un", "[o]rch", "[v]review", "[p]r", "[f]eedback", "[m]Complete", "[d]estroy", "[Esc]Deselect", "[?]Help"}, labels())
+}
+
+func TestHelpListsActions(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	unwrapped := func(help string) string {
+		return strings.Join(strings.Fields(ansi.Strip(help)), " ")
+	}
+
+	// Every action is listed once a work is focused. A single column keeps
+	// wrapped names in order, so they can be joined back up.
+	focusWork(t, m, w)
+	help := unwrapped(m.helpText(helpColumnWidth))
+	for _, section := range helpSections {
+		require.Contains(t, help, section)
+	}
+	for _, a := range planActions {
+		require.Contains(t, help, a.name)
+	}
+
+	// Without a focused work, the work panel's keys are left out
+	m.focusedWorkID = ""
+	help = unwrapped(m.helpText(helpColumnWidth))
+	require.NotContains(t, help, "Destroy the work")
+	require.Contains(t, help, "Group works in the tabs bar")
+	require.Contains(t, help, "Create new issue")
+	require.Contains(t, m.helpContext(), "once a work is selected")
+
+	// A maximized panel lists only its own keys
+	focusWork(t, m, w)
+	m.activePanel = PanelWorkDetails
+	m.maximized = true
+	help = unwrapped(m.helpText(helpColumnWidth))
+	require.Contains(t, help, "Destroy the work")
+	require.NotContains(t, help, "Create new issue")
+	require.Contains(t, m.helpContext(), "maximized work panel")
+}
+
+func TestHelpColumnsFitWidth(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+	rows := func(width int) int {
+		help := m.helpText(width)
+		requireLinesFit(t, help, width+2)
+		return strings.Count(help, "\n")
+	}
+
+	// Wider screens fit more columns, so fewer rows
+	narrow, medium, wide := rows(50), rows(130), rows(200)
+	require.Less(t, medium, narrow)
+	require.Less(t, wide, medium)
+	require.Contains(t, ansi.Strip(m.helpText(200)), "Layout")
+}
+
+func TestReadOnlyRefusesChanges(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	m.readOnly = true
+	m.beadItems = []beadItem{testBeadItem("bead-1", "Feature A", "open", 2, "task")}
+
+	// Neither the issues panel nor the work panel changes anything
+	require.Nil(t, press(m, "n"))
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.Contains(t, m.statusMessage, readOnlyMessage)
+
+	focusWork(t, m, w)
+	require.Nil(t, press(m, "d"))
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.Contains(t, m.statusMessage, "Destroy the work: "+readOnlyMessage)
+
+	// Looking around still works
+	press(m, "?")
+	require.Equal(t, ViewHelp, m.viewMode)
+	press(m, "esc")
+
+	m.syncPanels()
+	require.Contains(t, m.statusBar.Render(), "READ-ONLY")
+}
+
+func TestConfigProblemsRefuseChanges(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+	require.NoError(t, os.MkdirAll(filepath.Join(m.proj.Root, project.ConfigDir), 0755))
+	require.NoError(t, os.WriteFile(m.proj.ConfigPath(), []byte("[workflow]\nauto_pr = \"yes\"\n"), 0600))
+	require.NoError(t, m.proj.RecheckConfig())
+	require.NotNil(t, m.proj.ConfigErr)
+	m.viewMode = ViewConfigErrors
+
+	require.Contains(t, m.renderConfigErrorsContent(), "workflow.auto_pr: expected a boolean, got a string")
+	press(m, "esc")
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.Contains(t, m.statusMessage, configInvalidMessage)
+
+	require.Nil(t, press(m, "n"))
+	require.Equal(t, ViewNormal, m.viewMode)
+	m.syncPanels()
+	require.Contains(t, m.statusBar.Render(), "BAD CONFIG")
+
+	// Once the file is fixed, a refresh re-checks it and enables changes again
+	require.NoError(t, os.WriteFile(m.proj.ConfigPath(), []byte("[workflow]\nauto_pr = true\n"), 0600))
+	press(m, "ctrl+r")
+	require.Nil(t, m.proj.ConfigErr)
+	require.Equal(t, "config.toml is valid: changes enabled", m.statusMessage)
+	require.NotEqual(t, configInvalidMessage, m.findContextAction("n").disabled(m))
+}
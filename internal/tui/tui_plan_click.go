@@ -0,0 +1,80 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/project"
+)
+
+// clickTracker turns two presses of the left button on the same cell into a
+// double-click. Terminals report only presses and releases, so the second
+// press is recognized by how soon it follows the first.
+type clickTracker struct {
+	window time.Duration // Longest gap between the presses; 0 uses the default
+
+	x, y int
+	at   time.Time // Time of the last press that didn't complete a double-click
+}
+
+// press records a press at x, y and reports whether it completes a
+// double-click. The press after a double-click starts over, so a triple
+// click is one double-click and a single one.
+func (c *clickTracker) press(x, y int, now time.Time) bool {
+	window := c.window
+	if window <= 0 {
+		window = project.DefaultDoubleClick
+	}
+	if !c.at.IsZero() && x == c.x && y == c.y && now.Sub(c.at) <= window {
+		c.at = time.Time{}
+		return true
+	}
+	c.x, c.y, c.at = x, y, now
+	return false
+}
+
+// selectIssueRange adds every issue between the cursor and index to the
+// multi-selection and moves the cursor to index, like shift+click in a file
+// list. Issues already assigned to a work are skipped, as with space.
+func (m *planModel) selectIssueRange(index int) {
+	if index < 0 || index >= len(m.beadItems) {
+		return
+	}
+	if m.selectedBeads == nil {
+		m.selectedBeads = make(map[string]bool)
+	}
+	from, to := min(m.beadsCursor, index), max(m.beadsCursor, index)
+	selected, skipped := 0, 0
+	for _, item := range m.beadItems[from : to+1] {
+		if item.assignedWorkID != "" {
+			skipped++
+			continue
+		}
+		m.selectedBeads[item.ID] = true
+		selected++
+	}
+	m.beadsCursor = index
+
+	m.statusMessage = fmt.Sprintf("Selected %d issues", selected)
+	if skipped > 0 {
+		m.statusMessage += fmt.Sprintf(" (%d already in a work skipped)", skipped)
+	}
+	m.statusIsError = false
+}
+
+// openClickedTask handles a double-click on a task in a zoomed work: it opens
+// the task's artifacts when it wrote any, and otherwise shows the work's
+// orchestrator log beside the task details.
+func (m *planModel) openClickedTask() (tea.Model, tea.Cmd) {
+	if !m.workDetails.IsTaskSelected() {
+		return m, nil
+	}
+	if m.workDetails.SelectedTaskHasArtifacts() {
+		return m.handleKeyPress(keyMsgFor("enter"))
+	}
+	if !m.workDetails.IsLogShown() {
+		m.toggleLog()
+	}
+	return m, nil
+}
@@ -0,0 +1,57 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClickTracker(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(ms int) time.Time { return start.Add(time.Duration(ms) * time.Millisecond) }
+
+	c := clickTracker{window: 400 * time.Millisecond}
+	require.False(t, c.press(5, 3, at(0)))
+	require.True(t, c.press(5, 3, at(300)), "a second press on the same cell within the window")
+	require.False(t, c.press(5, 3, at(350)), "the press after a double-click starts over")
+
+	require.False(t, c.press(5, 3, at(1000)))
+	require.False(t, c.press(5, 3, at(1401)), "too slow")
+	require.True(t, c.press(5, 3, at(1801)), "the slow press becomes the first of a new pair")
+
+	require.False(t, c.press(5, 3, at(3000)))
+	require.False(t, c.press(6, 3, at(3100)), "another cell")
+	require.True(t, c.press(6, 3, at(3200)))
+
+	// Without a configured window the default applies
+	var d clickTracker
+	require.False(t, d.press(0, 0, at(0)))
+	require.True(t, d.press(0, 0, at(400)))
+}
+
+func TestSelectIssueRange(t *testing.T) {
+	m := &planModel{beadItems: []beadItem{
+		testBeadItem("bead-1", "One", "open", 2, "task"),
+		testBeadItem("bead-2", "Two", "open", 2, "task"),
+		testBeadItem("bead-3", "Three", "open", 2, "task"),
+		testBeadItem("bead-4", "Four", "open", 2, "task"),
+	}}
+	m.beadItems[2].assignedWorkID = "w-abc"
+	m.beadsCursor = 3
+
+	// Upwards from the cursor, skipping the assigned issue
+	m.selectIssueRange(1)
+	require.Equal(t, map[string]bool{"bead-2": true, "bead-4": true}, m.selectedBeads)
+	require.Equal(t, 1, m.beadsCursor)
+	require.Equal(t, "Selected 2 issues (1 already in a work skipped)", m.statusMessage)
+
+	// The range extends from the new cursor and keeps earlier selections
+	m.selectIssueRange(0)
+	require.Len(t, m.selectedBeads, 3)
+	require.True(t, m.selectedBeads["bead-1"])
+
+	// Off the list changes nothing
+	m.selectIssueRange(9)
+	require.Equal(t, 0, m.beadsCursor)
+}
@@ -0,0 +1,216 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/control"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/github"
+	"github.com/newhook/co/internal/logging"
+	workpkg "github.com/newhook/co/internal/work"
+)
+
+// The command generators in this file take what they use as arguments
+// rather than reaching through the plan model, so each can be run, and
+// tested, without one. The model's methods of the same purpose pass in its
+// context, store and services.
+
+// controlPlaneStarter ensures the project's control plane is running, as
+// control.EnsureControlPlane does
+type controlPlaneStarter func(ctx context.Context) (*control.InitResult, error)
+
+// fetchWork loads a work by the ID captured when an action's key was pressed.
+// The tiles may have been refreshed since, so the work is looked up again
+// rather than taken from the current selection, and a work that was destroyed
+// in the meantime is an error instead of falling through to its neighbor.
+func fetchWork(ctx context.Context, store db.Store, workID string) (*db.Work, error) {
+	if workID == "" {
+		return nil, fmt.Errorf("no work selected")
+	}
+	work, err := store.GetWork(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s no longer exists", workID)
+	}
+	return work, nil
+}
+
+// createWorkCmd creates a work unit with the given branch name.
+// This uses the shared CreateWorkFromBead method which handles:
+// 1. Expanding the bead to collect all issue IDs
+// 2. Creating work record in DB (with auto flag)
+// 3. Adding the other selected issues
+// 4. Ensuring control plane is running, in the zellij session
+// A failed step undoes the ones before it.
+func createWorkCmd(ctx context.Context, svc *workpkg.WorkService, startControlPlane controlPlaneStarter, remote bool, req CreateWorkResult, auto bool) tea.Cmd {
+	beadID := req.BeadID
+	return func() tea.Msg {
+		logging.Debug("executeCreateWork started", "beadID", beadID, "branchName", req.BranchName, "baseBranch", req.BaseBranch, "auto", auto, "useExistingBranch", req.UseExistingBranch)
+
+		// The control plane creates the worktree and starts the orchestrator.
+		// If it can't be started, or the other issues can't be added, the work
+		// is removed again rather than left half made.
+		var sessionResult *control.InitResult
+		opts := workpkg.CreateWorkFromBeadOptions{
+			BeadID:            beadID,
+			BranchName:        req.BranchName,
+			BaseBranch:        req.BaseBranch,
+			Auto:              auto,
+			UseExistingBranch: req.UseExistingBranch,
+			Remote:            remote,
+			AdditionalBeadIDs: req.AdditionalBeadIDs,
+			Start: func(ctx context.Context) error {
+				var err error
+				sessionResult, err = startControlPlane(ctx)
+				return err
+			},
+		}
+		result, err := svc.CreateWorkFromBead(ctx, opts)
+		if err != nil {
+			logging.Error("executeCreateWork failed", "beadID", beadID, "error", err)
+			msg := planWorkCreatedMsg{beadID: beadID, err: err, focus: req.FocusOnCreate}
+			if result != nil {
+				// Undoing failed, so the work is still there to be looked at
+				msg.workID = result.WorkID
+			}
+			return msg
+		}
+		logging.Debug("executeCreateWork completed successfully", "workID", result.WorkID)
+
+		msg := planWorkCreatedMsg{beadID: beadID, workID: result.WorkID, focus: req.FocusOnCreate}
+		if sessionResult != nil && sessionResult.SessionCreated {
+			msg.sessionCreated = true
+			msg.sessionName = sessionResult.SessionName
+		}
+		return msg
+	}
+}
+
+// addBeadsCmd adds beads to a work
+func addBeadsCmd(ctx context.Context, store db.Store, svc *workpkg.WorkService, beadIDs []string, workID string) tea.Cmd {
+	return func() tea.Msg {
+		beadIDsStr := strings.Join(beadIDs, ", ")
+		if _, err := fetchWork(ctx, store, workID); err != nil {
+			return beadAddedToWorkMsg{beadID: beadIDsStr, workID: workID, err: err}
+		}
+		if _, err := svc.AddBeads(ctx, workID, beadIDs, false); err != nil {
+			return beadAddedToWorkMsg{beadID: beadIDsStr, workID: workID, err: fmt.Errorf("failed to add issues to work: %w", err)}
+		}
+		return beadAddedToWorkMsg{beadID: beadIDsStr, workID: workID, journal: &journalEntry{kind: journalAssign, workID: workID, beadIDs: beadIDs}}
+	}
+}
+
+// assignAndRunCmd adds beads to a work and then runs it, creating one task
+// per bead or, with usePlan, grouping them with the LLM. A failed run leaves
+// the beads in the work rather than taking them back out. Spawn output is
+// logged under root.
+func assignAndRunCmd(ctx context.Context, store db.Store, svc *workpkg.WorkService, root string, beadIDs []string, workID string, usePlan bool) tea.Cmd {
+	return func() tea.Msg {
+		msg := beadsAssignedAndRunMsg{workID: workID, beadIDs: beadIDs}
+		if _, err := fetchWork(ctx, store, workID); err != nil {
+			msg.err = err
+			return msg
+		}
+		if _, err := svc.AddBeads(ctx, workID, beadIDs, false); err != nil {
+			msg.err = fmt.Errorf("failed to add issues to work: %w", err)
+			return msg
+		}
+		msg.journal = &journalEntry{kind: journalAssign, workID: workID, beadIDs: beadIDs}
+
+		out := &spawnOutput{}
+		result, err := svc.RunWork(ctx, workID, usePlan, out)
+		if err != nil {
+			msg.runErr = err
+			msg.spawnErr = orchestratorSpawnError(root, "Run work", workID, err, out)
+			return msg
+		}
+		msg.taskIDs = result.TaskIDs
+		msg.spawned = result.OrchestratorSpawned
+		return msg
+	}
+}
+
+// restartOrchestratorCmd kills and restarts the orchestrator of a work
+func restartOrchestratorCmd(ctx context.Context, store db.Store, orchestrators workpkg.OrchestratorManager, startControlPlane controlPlaneStarter, projectName, root, workID string) tea.Cmd {
+	return func() tea.Msg {
+		// Get work details
+		workRec, err := fetchWork(ctx, store, workID)
+		if err != nil {
+			return workCommandMsg{action: "Restart orchestrator", workID: workID, err: err}
+		}
+
+		// Stop the existing orchestrator, whether it's running or wedged
+		if _, err := workpkg.StopOrchestrator(ctx, store, workID); err != nil {
+			return workCommandMsg{action: "Restart orchestrator", workID: workID, err: err}
+		}
+
+		// Ensure control plane is running (may have been killed along with zellij)
+		if _, err := startControlPlane(ctx); err != nil {
+			return workCommandMsg{action: "Restart orchestrator", workID: workID, err: fmt.Errorf("failed to ensure control plane: %w", err)}
+		}
+
+		// Spawn a new orchestrator
+		out := &spawnOutput{}
+		spawned, err := orchestrators.EnsureWorkOrchestrator(ctx, workID, projectName, workRec.SessionDir(root), workRec.Name, out)
+		if err != nil {
+			return workCommandMsg{action: "Restart orchestrator", workID: workID, err: err, spawnErr: newSpawnError(root, "Restart orchestrator", workID, err, out)}
+		}
+
+		status := "already running"
+		if spawned {
+			status = "restarted"
+		}
+		return workCommandMsg{action: fmt.Sprintf("Orchestrator %s", status), workID: workID}
+	}
+}
+
+// togglePauseCmd pauses a work, or resumes it if paused
+func togglePauseCmd(ctx context.Context, store db.Store, svc *workpkg.WorkService, root, workID string, paused bool) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := fetchWork(ctx, store, workID); err != nil {
+			return workCommandMsg{action: "Pause work", workID: workID, err: err}
+		}
+		if !paused {
+			if err := svc.PauseWork(ctx, workID); err != nil {
+				return workCommandMsg{action: "Pause work", workID: workID, err: err}
+			}
+			return workCommandMsg{action: "Pause work", workID: workID}
+		}
+
+		out := &spawnOutput{}
+		if _, err := svc.ResumeWork(ctx, workID, out); err != nil {
+			return workCommandMsg{action: "Resume work", workID: workID, err: err, spawnErr: orchestratorSpawnError(root, "Resume work", workID, err, out)}
+		}
+		return workCommandMsg{action: "Resume work", workID: workID}
+	}
+}
+
+// loadCompletionPlanCmd gathers what completing a work would touch. With
+// skipPRCheck the dialog starts with the PR verification step unchecked.
+func loadCompletionPlanCmd(ctx context.Context, svc *workpkg.WorkService, workID string, skipPRCheck bool) tea.Cmd {
+	return func() tea.Msg {
+		plan, err := svc.PlanCompleteWork(ctx, workID)
+		return completionPlanLoadedMsg{plan: plan, err: err, skipPRCheck: skipPRCheck}
+	}
+}
+
+// loadFailingChecksCmd looks up the names of the checks failing on a work's PR
+func loadFailingChecksCmd(ctx context.Context, checks *github.ChecksCache, workID, prURL string) tea.Cmd {
+	return func() tea.Msg {
+		all, err := checks.Get(ctx, prURL, false)
+		if err != nil {
+			return prChecksMsg{workID: workID, err: err}
+		}
+		var failing []string
+		for _, check := range github.FailingChecks(all) {
+			failing = append(failing, check.Name)
+		}
+		return prChecksMsg{workID: workID, failing: failing}
+	}
+}
@@ -0,0 +1,251 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/control"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/github"
+	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/testutil"
+	workpkg "github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// controlPlaneUp is a controlPlaneStarter for a control plane that is
+// already running
+func controlPlaneUp(context.Context) (*control.InitResult, error) {
+	return &control.InitResult{}, nil
+}
+
+func TestFetchWork(t *testing.T) {
+	ctx := context.Background()
+	store := &testutil.StoreMock{
+		GetWorkFunc: func(ctx context.Context, id string) (*db.Work, error) {
+			if id == "w-gone" {
+				return nil, nil
+			}
+			return &db.Work{ID: id}, nil
+		},
+	}
+
+	work, err := fetchWork(ctx, store, "w-abc")
+	require.NoError(t, err)
+	assert.Equal(t, "w-abc", work.ID)
+
+	_, err = fetchWork(ctx, store, "w-gone")
+	require.True(t, errors.Is(err, coerrors.NotFound))
+
+	_, err = fetchWork(ctx, store, "")
+	require.ErrorContains(t, err, "no work selected")
+	require.Len(t, store.GetWorkCalls(), 2)
+}
+
+func TestAddBeadsCmd(t *testing.T) {
+	ctx := context.Background()
+	store := &testutil.StoreMock{
+		GetWorkFunc: func(ctx context.Context, id string) (*db.Work, error) {
+			if id == "w-gone" {
+				return nil, nil
+			}
+			return &db.Work{ID: id}, nil
+		},
+	}
+	svc := &workpkg.WorkService{DB: store, Config: &project.Config{}}
+
+	msg := addBeadsCmd(ctx, store, svc, []string{"bead-1", "bead-2"}, "w-abc")().(beadAddedToWorkMsg)
+	require.NoError(t, msg.err)
+	assert.Equal(t, "bead-1, bead-2", msg.beadID)
+	assert.Equal(t, &journalEntry{kind: journalAssign, workID: "w-abc", beadIDs: []string{"bead-1", "bead-2"}}, msg.journal)
+	require.Len(t, store.AddWorkBeadsCalls(), 1)
+
+	// A work destroyed since the key was pressed adds nothing
+	msg = addBeadsCmd(ctx, store, svc, []string{"bead-3"}, "w-gone")().(beadAddedToWorkMsg)
+	require.True(t, errors.Is(msg.err, coerrors.NotFound))
+	assert.Nil(t, msg.journal)
+	require.Len(t, store.AddWorkBeadsCalls(), 1)
+}
+
+func TestAssignAndRunCmdKeepsBeadsWhenAddingFails(t *testing.T) {
+	ctx := context.Background()
+	store := &testutil.StoreMock{
+		GetWorkFunc: func(ctx context.Context, id string) (*db.Work, error) {
+			return &db.Work{ID: id}, nil
+		},
+		IsBeadInTaskFunc: func(ctx context.Context, workID string, beadID string) (bool, error) {
+			return beadID == "bead-9", nil
+		},
+	}
+	svc := &workpkg.WorkService{DB: store, Config: &project.Config{}}
+
+	// Adding failed, so nothing changed and the work wasn't run
+	msg := assignAndRunCmd(ctx, store, svc, t.TempDir(), []string{"bead-9"}, "w-abc", false)().(beadsAssignedAndRunMsg)
+	require.True(t, errors.Is(msg.err, coerrors.Conflict))
+	require.NoError(t, msg.runErr)
+	assert.Nil(t, msg.journal)
+	assert.Empty(t, store.AddWorkBeadsCalls())
+	assert.Empty(t, store.GetWorkTasksCalls())
+}
+
+func TestAssignAndRunCmdRunFailure(t *testing.T) {
+	ctx := context.Background()
+	store := &testutil.StoreMock{
+		GetWorkFunc: func(ctx context.Context, id string) (*db.Work, error) {
+			return &db.Work{ID: id}, nil
+		},
+	}
+	svc := &workpkg.WorkService{DB: store, Config: &project.Config{}}
+
+	// The beads were added, but the work can't run; nothing was spawned, so
+	// there's no overlay
+	msg := assignAndRunCmd(ctx, store, svc, t.TempDir(), []string{"bead-1"}, "w-abc", false)().(beadsAssignedAndRunMsg)
+	require.NoError(t, msg.err)
+	require.True(t, errors.Is(msg.runErr, coerrors.Validation))
+	assert.Nil(t, msg.spawnErr)
+	assert.NotNil(t, msg.journal)
+	require.Len(t, store.AddWorkBeadsCalls(), 1)
+}
+
+func TestRestartOrchestratorCmd(t *testing.T) {
+	ctx := context.Background()
+	store := &testutil.StoreMock{
+		GetWorkFunc: func(ctx context.Context, id string) (*db.Work, error) {
+			return &db.Work{ID: id, Name: "login"}, nil
+		},
+		GetOrchestratorProcessFunc: func(ctx context.Context, workID string) (*db.Process, error) {
+			// Registered on another host, so it isn't signalled from here
+			return &db.Process{ID: "proc-1", Hostname: "elsewhere", PID: 1}, nil
+		},
+	}
+	orchestrators := &workpkg.OrchestratorManagerMock{
+		EnsureWorkOrchestratorFunc: func(ctx context.Context, workID, projName, workDir, friendlyName string, w io.Writer) (bool, error) {
+			return true, nil
+		},
+	}
+
+	msg := restartOrchestratorCmd(ctx, store, orchestrators, controlPlaneUp, "proj", "/root", "w-abc")().(workCommandMsg)
+	require.NoError(t, msg.err)
+	assert.Equal(t, "Orchestrator restarted", msg.action)
+	require.Len(t, store.UnregisterProcessCalls(), 1)
+	assert.Equal(t, "proc-1", store.UnregisterProcessCalls()[0].ID)
+	calls := orchestrators.EnsureWorkOrchestratorCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "proj", calls[0].ProjName)
+	assert.Equal(t, "login", calls[0].FriendlyName)
+
+	// Without a control plane there's nothing to run the orchestrator in
+	down := func(context.Context) (*control.InitResult, error) {
+		return nil, errors.New("zellij not found")
+	}
+	msg = restartOrchestratorCmd(ctx, store, orchestrators, down, "proj", "/root", "w-abc")().(workCommandMsg)
+	require.ErrorContains(t, msg.err, "failed to ensure control plane: zellij not found")
+	require.Len(t, orchestrators.EnsureWorkOrchestratorCalls(), 1)
+}
+
+func TestRestartOrchestratorCmdLeavesReusedPIDAlone(t *testing.T) {
+	ctx := context.Background()
+	// The orchestrator died and its PID went to an unrelated process
+	other := exec.Command("sleep", "30")
+	require.NoError(t, other.Start())
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+
+	store := &testutil.StoreMock{
+		GetWorkFunc: func(ctx context.Context, id string) (*db.Work, error) {
+			return &db.Work{ID: id, Name: "login"}, nil
+		},
+		GetOrchestratorProcessFunc: func(ctx context.Context, workID string) (*db.Process, error) {
+			return &db.Process{ID: "proc-1", Hostname: hostname, PID: other.Process.Pid}, nil
+		},
+	}
+	orchestrators := &workpkg.OrchestratorManagerMock{}
+
+	msg := restartOrchestratorCmd(ctx, store, orchestrators, controlPlaneUp, "proj", "/root", "w-abc")().(workCommandMsg)
+	require.NoError(t, msg.err)
+	require.Len(t, store.UnregisterProcessCalls(), 1, "the dead orchestrator's record is dropped")
+	require.Len(t, orchestrators.EnsureWorkOrchestratorCalls(), 1)
+
+	// The process is still there for the test to kill; it got no SIGTERM
+	require.NoError(t, other.Process.Signal(syscall.SIGKILL))
+	_ = other.Wait()
+	status := other.ProcessState.Sys().(syscall.WaitStatus)
+	assert.Equal(t, syscall.SIGKILL, status.Signal(), "a process that isn't the orchestrator is never signalled")
+}
+
+func TestTogglePauseCmd(t *testing.T) {
+	ctx := context.Background()
+	work := &db.Work{ID: "w-abc"}
+	store := &testutil.StoreMock{
+		GetWorkFunc: func(ctx context.Context, id string) (*db.Work, error) {
+			return work, nil
+		},
+	}
+	svc := &workpkg.WorkService{DB: store, Config: &project.Config{}}
+
+	msg := togglePauseCmd(ctx, store, svc, t.TempDir(), "w-abc", false)().(workCommandMsg)
+	require.NoError(t, msg.err)
+	assert.Equal(t, "Pause work", msg.action)
+	calls := store.SetWorkPausedCalls()
+	require.Len(t, calls, 1)
+	assert.True(t, calls[0].Paused)
+
+	// Resuming a work with no worktree yet only clears the flag
+	work.Paused = true
+	msg = togglePauseCmd(ctx, store, svc, t.TempDir(), "w-abc", true)().(workCommandMsg)
+	require.NoError(t, msg.err)
+	assert.Equal(t, "Resume work", msg.action)
+	calls = store.SetWorkPausedCalls()
+	require.Len(t, calls, 2)
+	assert.False(t, calls[1].Paused)
+}
+
+func TestLoadCompletionPlanCmd(t *testing.T) {
+	ctx := context.Background()
+	store := &testutil.StoreMock{
+		GetWorkFunc: func(ctx context.Context, id string) (*db.Work, error) {
+			return &db.Work{ID: id, Status: db.StatusProcessing}, nil
+		},
+	}
+	svc := &workpkg.WorkService{DB: store, Config: &project.Config{}}
+
+	// A stale work's dialog starts with the PR check skipped, even when the
+	// plan can't be made
+	msg := loadCompletionPlanCmd(ctx, svc, "w-abc", true)().(completionPlanLoadedMsg)
+	require.True(t, errors.Is(msg.err, coerrors.Validation))
+	assert.Nil(t, msg.plan)
+	assert.True(t, msg.skipPRCheck)
+}
+
+func TestLoadFailingChecksCmd(t *testing.T) {
+	ctx := context.Background()
+	client := &github.GitHubClientMock{
+		GetPRChecksFunc: func(ctx context.Context, prURL string, requiredOnly bool) ([]github.PRCheck, error) {
+			return []github.PRCheck{
+				{Name: "lint", Bucket: "pass"},
+				{Name: "test", Bucket: "fail"},
+				{Name: "e2e", Bucket: "fail"},
+			}, nil
+		},
+	}
+	checks := github.NewChecksCache(client, time.Minute)
+	prURL := "https://github.com/o/r/pull/1"
+
+	msg := loadFailingChecksCmd(ctx, checks, "w-abc", prURL)().(prChecksMsg)
+	require.NoError(t, msg.err)
+	assert.Equal(t, "w-abc", msg.workID)
+	assert.Equal(t, []string{"test", "e2e"}, msg.failing)
+
+	// A second look within the minute is answered from the cache
+	msg = loadFailingChecksCmd(ctx, checks, "w-abc", prURL)().(prChecksMsg)
+	assert.Equal(t, []string{"test", "e2e"}, msg.failing)
+	require.Len(t, client.GetPRChecksCalls(), 1)
+}
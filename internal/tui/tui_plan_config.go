@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/project"
+)
+
+// configInvalidMessage explains why actions that change the project do nothing
+// while config.toml has problems
+const configInvalidMessage = "config.toml has problems: changes are disabled"
+
+// configInvalid reports whether the project was opened with problems in its
+// config.toml that haven't been fixed yet
+func (m *planModel) configInvalid() bool {
+	return m.proj != nil && m.proj.ConfigErr != nil
+}
+
+// reportConfigInvalid shows the config banner in place of a refused action
+func (m *planModel) reportConfigInvalid() {
+	m.statusMessage = configInvalidMessage + " (fix it, then press ctrl+r)"
+	m.statusIsError = true
+}
+
+// recheckConfig validates config.toml again. When the problems are gone,
+// actions are enabled again; when some remain, they're listed again.
+func (m *planModel) recheckConfig() {
+	if !m.configInvalid() {
+		return
+	}
+	if err := m.proj.RecheckConfig(); err != nil {
+		m.statusMessage = fmt.Sprintf("Failed to re-check config: %v", err)
+		m.statusIsError = true
+		return
+	}
+	if m.proj.ConfigErr == nil {
+		m.statusMessage = "config.toml is valid: changes enabled"
+		m.statusIsError = false
+		if m.viewMode == ViewConfigErrors {
+			m.viewMode = ViewNormal
+		}
+		return
+	}
+	m.viewMode = ViewConfigErrors
+}
+
+func (m *planModel) updateConfigErrors(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+r", "f5":
+		return m, m.manualRefresh()
+	case "esc", "enter", "q":
+		m.viewMode = ViewNormal
+		if m.configInvalid() {
+			m.reportConfigInvalid()
+		}
+		if m.pendingDraft != nil {
+			m.viewMode = ViewDraftRestore
+		}
+	}
+	return m, nil
+}
+
+func (m *planModel) renderConfigErrorsContent() string {
+	var b strings.Builder
+	b.WriteString(m.theme.Title.Render("Problems in " + filepath.Join(project.ConfigDir, project.ConfigFile)))
+	b.WriteString("\n\n")
+
+	cfgErr := m.proj.ConfigErr
+	if cfgErr == nil {
+		b.WriteString("  " + m.theme.Success.Render("No problems left") + "\n\n  [Esc] Close")
+		return m.theme.Dialog.Render(b.String())
+	}
+	for _, p := range cfgErr.Problems {
+		where := m.theme.Dim.Render("        ")
+		if p.Line > 0 {
+			where = m.theme.Dim.Render(fmt.Sprintf("%-8s", fmt.Sprintf("line %d", p.Line)))
+		}
+		msg := p.Message
+		if p.Key != "" {
+			msg = p.Key + ": " + msg
+		}
+		fmt.Fprintf(&b, "  %s %s\n", where, m.theme.Error.Render(msg))
+	}
+	b.WriteString("\n  " + m.theme.Dim.Render(cfgErr.Path) + "\n\n")
+	b.WriteString("  Broken keys use their defaults. Actions that change the project\n")
+	b.WriteString("  are disabled until the file is fixed.\n\n")
+	b.WriteString("  [ctrl+r] Re-check  [Esc] Close")
+	return m.theme.Dialog.Render(b.String())
+}
@@ -1,19 +1,79 @@
 package tui
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/control"
 	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/github"
 	"github.com/newhook/co/internal/linear"
-	"github.com/newhook/co/internal/control"
 	"github.com/newhook/co/internal/work"
 )
 
+// bdMissingMessage explains why bead-editing keys do nothing when bd isn't installed
+const bdMissingMessage = "bead integration disabled: bd not found in PATH"
+
+// readOnlyMessage explains why actions that change the project do nothing in read-only mode
+const readOnlyMessage = "read-only mode: changes are disabled"
+
+// reportBDMissing shows the bd-missing banner in place of a bead-editing action
+func (m *planModel) reportBDMissing() tea.Cmd {
+	m.statusMessage = bdMissingMessage + " (install bd, then press ctrl+r)"
+	m.statusIsError = true
+	return nil
+}
+
+// manualRefresh reloads issues and works on demand (ctrl+r/F5). It also
+// re-checks for bd in case it was installed since startup, and config.toml
+// if it had problems. A refresh that is already in flight absorbs repeated
+// presses instead of starting another.
+func (m *planModel) manualRefresh() tea.Cmd {
+	if m.refreshPending > 0 {
+		return nil
+	}
+
+	wasMissing := m.bdMissing
+	m.bdMissing = !beads.CLIAvailable()
+	if wasMissing && !m.bdMissing {
+		m.statusMessage = "bd found: bead integration enabled"
+		m.statusIsError = false
+	} else {
+		// Clear the status so the refreshing indicator is visible
+		m.statusMessage = ""
+		m.statusIsError = false
+	}
+	m.recheckConfig()
+
+	// Only these two loads count down, not the ones other updates start
+	// meanwhile
+	m.refreshPending = 2 // issues + work tiles
+	loadBeads, loadTiles := m.refreshData(), m.loadWorkTiles()
+	return tea.Batch(
+		func() tea.Msg {
+			msg := loadBeads().(planDataMsg)
+			msg.manual = true
+			return msg
+		},
+		func() tea.Msg {
+			msg := loadTiles().(workTilesLoadedMsg)
+			msg.manual = true
+			return msg
+		},
+	)
+}
+
+// finishRefreshStep records that one of a manual refresh's loads has completed.
+func (m *planModel) finishRefreshStep() {
+	if m.refreshPending > 0 {
+		m.refreshPending--
+	}
+}
+
 // refreshData creates a tea.Cmd that refreshes bead data
 func (m *planModel) refreshData() tea.Cmd {
 	// Capture current filter and sequence at creation time to avoid race conditions
@@ -26,54 +86,65 @@ func (m *planModel) refreshData() tea.Cmd {
 // This prevents race conditions when the user types quickly.
 func (m *planModel) refreshDataWithFilters(filters beadFilters, seq uint64) tea.Cmd {
 	return func() tea.Msg {
-		items, err := m.loadBeadsWithFilters(filters)
+		items, counts, err := m.loadBeadsWithFilters(filters)
 
-		// Also fetch active sessions
+		// Also fetch active sessions, and the conversations that can be resumed
 		session := m.sessionName()
 		activeSessions, _ := m.proj.DB.GetBeadsWithActiveSessions(m.ctx, session)
+		resumablePlans, _ := m.proj.DB.GetBeadsWithPlanConversations(m.ctx)
 
 		return planDataMsg{
 			beads:          items,
+			counts:         counts,
 			activeSessions: activeSessions,
+			resumablePlans: resumablePlans,
 			err:            err,
 			searchSeq:      seq,
 		}
 	}
 }
 
-func (m *planModel) loadBeads() ([]beadItem, error) {
+func (m *planModel) loadBeads() ([]beadItem, *beadCounts, error) {
 	return m.loadBeadsWithFilters(m.filters)
 }
 
-// loadBeadsWithFilters loads beads using the provided filters.
+// loadBeadsWithFilters loads beads using the provided filters, along with the
+// status counts when the status filter applies (nil for task and children views).
 // This allows capturing filters at command creation time to avoid race conditions.
-func (m *planModel) loadBeadsWithFilters(filters beadFilters) ([]beadItem, error) {
-	mainRepoPath := m.proj.MainRepoPath()
-
-	// Handle task filter - show beads assigned to a specific task
-	if filters.task != "" {
-		return m.loadBeadsForTask(filters)
+// Whichever view is loaded, each bead is marked with the work it's assigned to.
+func (m *planModel) loadBeadsWithFilters(filters beadFilters) ([]beadItem, *beadCounts, error) {
+	var items []beadItem
+	var counts *beadCounts
+	var err error
+	switch {
+	case filters.task != "":
+		// Show beads assigned to a specific task
+		items, err = m.loadBeadsForTask(filters)
+	case filters.children != "":
+		// Show children (dependents) of a specific bead
+		items, err = m.loadBeadsForChildren(filters)
+	default:
+		items, counts, err = m.loadBeadList(filters)
 	}
-
-	// Handle children filter - show children (dependents) of a specific bead
-	if filters.children != "" {
-		return m.loadBeadsForChildren(filters)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := markAssignedWorks(m.ctx, m.proj.DB, items); err != nil {
+		return nil, nil, err
 	}
+	return items, counts, nil
+}
 
-	// Use the shared fetchBeadsWithFilters function
-	items, err := fetchBeadsWithFilters(m.ctx, m.proj.Beads, mainRepoPath, filters)
+// loadBeadList loads the issues list for the status, label and search filters
+func (m *planModel) loadBeadList(filters beadFilters) ([]beadItem, *beadCounts, error) {
+	items, counts, err := fetchBeadsWithFilters(m.ctx, m.proj.Beads, filters)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	// Fetch assigned beads from database and populate assignedWorkID
-	assignedBeads, err := m.proj.DB.GetAllAssignedBeads(m.ctx)
-	if err == nil {
-		for i := range items {
-			if workID, ok := assignedBeads[items[i].ID]; ok {
-				items[i].assignedWorkID = workID
-			}
-		}
+	// Search results are listed flat, best match first
+	if filters.searchText != "" {
+		return items, counts, nil
 	}
 
 	// Build tree structure from dependencies
@@ -102,7 +173,7 @@ func (m *planModel) loadBeadsWithFilters(filters beadFilters) ([]beadItem, error
 		}
 	}
 
-	return items, nil
+	return items, counts, nil
 }
 
 // loadBeadsForTask loads beads assigned to a specific task.
@@ -130,18 +201,16 @@ func (m *planModel) loadBeadsForTask(filters beadFilters) ([]beadItem, error) {
 		})
 	}
 
-	// Apply search text filter if set
+	// Apply search text filter if set; results are listed best match first
 	if filters.searchText != "" {
-		searchLower := strings.ToLower(filters.searchText)
 		var filtered []beadItem
 		for _, item := range items {
-			if strings.Contains(strings.ToLower(item.ID), searchLower) ||
-				strings.Contains(strings.ToLower(item.Title), searchLower) ||
-				strings.Contains(strings.ToLower(item.Description), searchLower) {
+			if item.matchSearch(filters.searchText) {
 				filtered = append(filtered, item)
 			}
 		}
-		items = filtered
+		sortBySearchScore(filtered)
+		return filtered, nil
 	}
 
 	// Build tree structure from dependencies
@@ -176,18 +245,16 @@ func (m *planModel) loadBeadsForChildren(filters beadFilters) ([]beadItem, error
 		})
 	}
 
-	// Apply search text filter if set
+	// Apply search text filter if set; results are listed best match first
 	if filters.searchText != "" {
-		searchLower := strings.ToLower(filters.searchText)
 		var filtered []beadItem
 		for _, item := range items {
-			if strings.Contains(strings.ToLower(item.ID), searchLower) ||
-				strings.Contains(strings.ToLower(item.Title), searchLower) ||
-				strings.Contains(strings.ToLower(item.Description), searchLower) {
+			if item.matchSearch(filters.searchText) {
 				filtered = append(filtered, item)
 			}
 		}
-		items = filtered
+		sortBySearchScore(filtered)
+		return filtered, nil
 	}
 
 	// Build tree structure from dependencies
@@ -196,7 +263,9 @@ func (m *planModel) loadBeadsForChildren(filters beadFilters) ([]beadItem, error
 	return items, nil
 }
 
-func (m *planModel) createBead(title, beadType string, priority int, isEpic bool, description string, parent string) tea.Cmd {
+// createBead creates a bead and makes it depend on each of blockedBy. A
+// dependency that can't be added is reported but the bead is kept.
+func (m *planModel) createBead(title, beadType string, priority int, isEpic bool, description string, parent string, blockedBy []string) tea.Cmd {
 	return func() tea.Msg {
 		ctx := m.ctx
 		beadsPath := m.proj.BeadsPath()
@@ -213,42 +282,72 @@ func (m *planModel) createBead(title, beadType string, priority int, isEpic bool
 			return planDataMsg{err: fmt.Errorf("failed to create issue: %w", err)}
 		}
 
+		var depErrs []error
+		for _, dependsOnID := range blockedBy {
+			if err := beads.AddDependency(ctx, beadID, dependsOnID, beadsPath); err != nil {
+				depErrs = append(depErrs, err)
+			}
+		}
+
 		// Refresh after creation
-		items, err := m.loadBeads()
+		items, counts, err := m.loadBeads()
 		session := m.sessionName()
 		activeSessions, _ := m.proj.DB.GetBeadsWithActiveSessions(m.ctx, session)
 
-		return planDataMsg{beads: items, activeSessions: activeSessions, err: err, createdBeadID: beadID}
+		if len(depErrs) > 0 {
+			err = errors.Join(fmt.Errorf("created %s but %d of %d dependencies failed: %w", beadID, len(depErrs), len(blockedBy), depErrs[0]), err)
+		}
+		return planDataMsg{beads: items, counts: counts, activeSessions: activeSessions, err: err, createdBeadID: beadID, submitted: true}
 	}
 }
 
-func (m *planModel) closeBead(beadID string) tea.Cmd {
+// checklistImportedMsg reports the issues created from a pasted checklist
+type checklistImportedMsg struct {
+	created []beads.ImportedBead
+	err     error
+}
+
+// importChecklist creates an issue for each unchecked checklist item, with
+// headings as labels or, with sectionEpics, as epics over their items
+func (m *planModel) importChecklist(items []beads.ChecklistItem, sectionEpics bool) tea.Cmd {
 	return func() tea.Msg {
-		beadsPath := m.proj.BeadsPath()
-		session := m.sessionName()
-		tabName := db.TabNameForBead(beadID)
-
-		// If there's an active session for this bead, close it
-		if m.activeBeadSessions[beadID] {
-			// Terminate and close the tab
-			_ = m.zj.Session(session).TerminateAndCloseTab(m.ctx, tabName)
-			// Unregister from database
-			_ = m.proj.DB.UnregisterPlanSession(m.ctx, beadID)
-		}
+		created, err := beads.ImportChecklist(m.ctx, beads.NewCLI(m.proj.BeadsPath()), items, beads.ChecklistImportOptions{
+			Type:         "task",
+			Priority:     2,
+			SectionEpics: sectionEpics,
+		})
+		return checklistImportedMsg{created: created, err: err}
+	}
+}
 
-		// Close the bead
-		if err := beads.Close(m.ctx, beadID, beadsPath); err != nil {
-			return planDataMsg{err: fmt.Errorf("failed to close issue: %w", err)}
-		}
+// duplicateMergedMsg reports the outcome of merging an issue into another
+// as its duplicate
+type duplicateMergedMsg struct {
+	survivor  string
+	duplicate string
+	err       error
+}
 
-		// Refresh after close
-		items, err := m.loadBeads()
-		activeSessions, _ := m.proj.DB.GetBeadsWithActiveSessions(m.ctx, session)
-		return planDataMsg{beads: items, activeSessions: activeSessions, err: err}
+// mergeDuplicate merges the issue duplicate into survivor, moving its work
+// and task assignments along
+func (m *planModel) mergeDuplicate(survivor, duplicate string) tea.Cmd {
+	return func() tea.Msg {
+		err := m.workService.MergeDuplicateBeads(m.ctx, survivor, duplicate)
+		return duplicateMergedMsg{survivor: survivor, duplicate: duplicate, err: err}
 	}
 }
 
-func (m *planModel) closeBeads(beadIDs []string) tea.Cmd {
+// beadsClosedMsg reports the outcome of closing a batch of beads
+type beadsClosedMsg struct {
+	closed  int
+	skipped int
+	err     error
+	journal *journalEntry // recorded for undo when the beads were closed
+}
+
+// closeBeads closes the given beads in one bd call, ending any plan sessions
+// attached to them first. skipped is carried through for the status report.
+func (m *planModel) closeBeads(beadIDs []string, skipped int) tea.Cmd {
 	return func() tea.Msg {
 		beadsPath := m.proj.BeadsPath()
 		session := m.sessionName()
@@ -265,21 +364,23 @@ func (m *planModel) closeBeads(beadIDs []string) tea.Cmd {
 			}
 		}
 
-		// Close all beads using the beads package
-		for _, beadID := range beadIDs {
-			if err := beads.Close(m.ctx, beadID, beadsPath); err != nil {
-				return planDataMsg{err: fmt.Errorf("failed to close issue %s: %w", beadID, err)}
-			}
+		if err := beads.CloseMany(m.ctx, beadIDs, beadsPath); err != nil {
+			return beadsClosedMsg{skipped: skipped, err: err}
 		}
-
-		// Refresh after close
-		items, err := m.loadBeads()
-		activeSessions, _ := m.proj.DB.GetBeadsWithActiveSessions(m.ctx, session)
-		return planDataMsg{beads: items, activeSessions: activeSessions, err: err}
+		return beadsClosedMsg{closed: len(beadIDs), skipped: skipped, journal: &journalEntry{kind: journalClose, beadIDs: beadIDs}}
 	}
 }
 
-func (m *planModel) saveBeadEdit(beadID, title, description, beadType, status string) tea.Cmd {
+func (m *planModel) saveBeadEdit(beadID, title, description, beadType, prevStatus, status string, prevLabels, labels []string) tea.Cmd {
+	addLabels, removeLabels := labelChanges(prevLabels, labels)
+	// Closing or reopening a bead through the form can be undone like any other
+	var journal *journalEntry
+	switch {
+	case prevStatus != "closed" && status == "closed":
+		journal = &journalEntry{kind: journalClose, beadIDs: []string{beadID}}
+	case prevStatus == "closed" && status != "closed":
+		journal = &journalEntry{kind: journalReopen, beadIDs: []string{beadID}}
+	}
 	return func() tea.Msg {
 		beadsPath := m.proj.BeadsPath()
 
@@ -293,12 +394,18 @@ func (m *planModel) saveBeadEdit(beadID, title, description, beadType, status st
 		if err != nil {
 			return planDataMsg{err: fmt.Errorf("failed to update issue: %w", err)}
 		}
+		if err := beads.AddLabels(m.ctx, beadID, beadsPath, addLabels); err != nil {
+			return planDataMsg{err: err}
+		}
+		if err := beads.RemoveLabels(m.ctx, beadID, beadsPath, removeLabels); err != nil {
+			return planDataMsg{err: err}
+		}
 
 		// Refresh after update
-		items, err := m.loadBeads()
+		items, counts, err := m.loadBeads()
 		session := m.sessionName()
 		activeSessions, _ := m.proj.DB.GetBeadsWithActiveSessions(m.ctx, session)
-		return planDataMsg{beads: items, activeSessions: activeSessions, err: err}
+		return planDataMsg{beads: items, counts: counts, activeSessions: activeSessions, err: err, journal: journal, submitted: true}
 	}
 }
 
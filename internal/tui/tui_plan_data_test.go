@@ -0,0 +1,130 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManualRefreshCoalesces(t *testing.T) {
+	m := &planModel{
+		theme:     DarkTheme(),
+		ctx:       context.Background(),
+		statusBar: NewStatusBar(DarkTheme()),
+	}
+	m.statusMessage = "old message"
+
+	require.NotNil(t, m.manualRefresh(), "first refresh should start loading")
+	require.Equal(t, 2, m.refreshPending)
+	require.Empty(t, m.statusMessage, "status is cleared so the indicator shows")
+
+	// Repeated presses while in flight are absorbed
+	require.Nil(t, m.manualRefresh())
+	require.Equal(t, 2, m.refreshPending)
+
+	m.statusBar.SetSize(120)
+	m.statusBar.SetRefreshing(m.refreshPending > 0)
+	require.Contains(t, m.statusBar.Render(), "refreshing")
+
+	// Each completed load counts down; extra completions don't go negative
+	m.finishRefreshStep()
+	m.finishRefreshStep()
+	m.finishRefreshStep()
+	require.Equal(t, 0, m.refreshPending)
+	require.NotNil(t, m.manualRefresh(), "a new refresh can start once the last finished")
+}
+
+func TestPlanDataFlashesLastUpdate(t *testing.T) {
+	m := &planModel{
+		theme:          DarkTheme(),
+		ctx:            context.Background(),
+		refreshPending: 1,
+		newBeads:       map[string]time.Time{},
+	}
+
+	_, cmd := m.Update(planDataMsg{})
+	require.NotNil(t, cmd, "a tick is scheduled to end the highlight")
+	require.False(t, m.lastUpdateFlash.IsZero())
+	require.Equal(t, 1, m.refreshPending, "only the manual refresh's own load counts down")
+
+	m.Update(planDataMsg{manual: true})
+	require.Equal(t, 0, m.refreshPending)
+}
+
+func TestOrchestratorHealthIsCached(t *testing.T) {
+	m := &planModel{
+		theme:         DarkTheme(),
+		ctx:           context.Background(),
+		workTabsBar:   NewWorkTabsBar(DarkTheme()),
+		workDetails:   NewWorkDetailsPanel(DarkTheme()),
+		focusedWorkID: "w-abc",
+	}
+
+	_, cmd := m.Update(orchestratorHealthMsg{health: map[string]db.OrchestratorHealth{"w-abc": db.OrchestratorRunning, "w-def": db.OrchestratorDown}})
+	require.NotNil(t, cmd, "the next health check is scheduled")
+	require.Equal(t, map[string]db.OrchestratorHealth{"w-abc": db.OrchestratorRunning, "w-def": db.OrchestratorDown}, m.orchestratorHealth)
+	require.True(t, m.workDetails.IsOrchestratorHealthy(), "focused work reads the cached health")
+
+	m.Update(orchestratorHealthMsg{health: map[string]db.OrchestratorHealth{"w-abc": db.OrchestratorStale}})
+	require.False(t, m.workDetails.IsOrchestratorHealthy())
+	require.Equal(t, db.OrchestratorDown, m.orchestratorHealth["w-def"])
+}
+
+func TestBeadSearchRanksMatches(t *testing.T) {
+	described := testBeadItem("bd-1", "Tidy up", "open", 2, "task")
+	described.Description = "Move the session store behind an interface"
+	scattered := testBeadItem("bd-2", "Save settings in one", "open", 2, "task")
+	titled := testBeadItem("bd-3", "Expire idle sessions", "open", 2, "task")
+	noisy := testBeadItem("bd-4", "Docs", "open", 2, "task")
+	noisy.Description = "Some extra sentences in one long description"
+
+	var results []beadItem
+	for _, item := range []beadItem{described, scattered, titled, noisy} {
+		if item.matchSearch("session") {
+			results = append(results, item)
+		}
+	}
+	sortBySearchScore(results)
+
+	// Exact title matches rank above scattered ones, which rank above
+	// description matches; descriptions never match fuzzily
+	var ids []string
+	for _, item := range results {
+		ids = append(ids, item.ID)
+	}
+	require.Equal(t, []string{"bd-3", "bd-2", "bd-1"}, ids)
+	require.Equal(t, []int{12, 13, 14, 15, 16, 17, 18}, results[0].titleMatches)
+	require.Empty(t, results[2].titleMatches)
+
+	// IDs are matched too, and outrank an equal title match
+	id := testBeadItem("session-1", "Other", "open", 2, "task")
+	require.True(t, id.matchSearch("session"))
+	require.Greater(t, id.searchScore, results[0].searchScore)
+	require.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, id.idMatches)
+}
+
+func TestMarkAssignedWorks(t *testing.T) {
+	store := &testutil.StoreMock{
+		GetAllAssignedBeadsFunc: func(ctx context.Context) (map[string]string, error) {
+			return map[string]string{"bd-1": "w-abc"}, nil
+		},
+	}
+	items := []beadItem{
+		{BeadWithDeps: &beads.BeadWithDeps{Bead: &beads.Bead{ID: "bd-1"}}},
+		{BeadWithDeps: &beads.BeadWithDeps{Bead: &beads.Bead{ID: "bd-2"}}, assignedWorkID: "w-stale"},
+	}
+	require.NoError(t, markAssignedWorks(context.Background(), store, items))
+	require.Equal(t, "w-abc", items[0].assignedWorkID)
+	require.Empty(t, items[1].assignedWorkID, "a bead no longer in a work is unmarked")
+
+	store.GetAllAssignedBeadsFunc = func(ctx context.Context) (map[string]string, error) {
+		return nil, errors.New("db closed")
+	}
+	require.Error(t, markAssignedWorks(context.Background(), store, items))
+}
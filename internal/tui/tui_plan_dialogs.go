@@ -2,8 +2,14 @@ package tui
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/db"
 )
 
 // Dialog update handlers
@@ -51,6 +57,13 @@ func (m *planModel) updateLabelFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.viewMode = ViewNormal
 		m.filters.label = m.textInput.Value()
 		return m, m.refreshData()
+	case "tab":
+		// Complete to the best matching label in use
+		if matches := matchingLabels(m.projectLabels, m.textInput.Value()); len(matches) > 0 {
+			m.textInput.SetValue(matches[0])
+			m.textInput.CursorEnd()
+		}
+		return m, nil
 	default:
 		var cmd tea.Cmd
 		m.textInput, cmd = m.textInput.Update(msg)
@@ -58,39 +71,187 @@ func (m *planModel) updateLabelFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	}
 }
 
+// closeCandidates partitions the beads targeted by the close confirmation dialog.
+type closeCandidates struct {
+	open          []beadItem // Beads that can be closed without warning
+	alreadyClosed []beadItem // Beads that are already closed; always skipped
+	inActiveWork  []beadItem // Beads assigned to a work that hasn't finished yet
+}
+
+// collectCloseCandidates gathers the selected beads (or the cursor bead when
+// nothing is selected) and sorts them into closeCandidates.
+func (m *planModel) collectCloseCandidates() closeCandidates {
+	var targets []beadItem
+	for _, item := range m.beadItems {
+		if m.selectedBeads[item.ID] {
+			targets = append(targets, item)
+		}
+	}
+
+	// If no selected beads, use cursor bead
+	if len(targets) == 0 && len(m.beadItems) > 0 && m.beadsCursor < len(m.beadItems) {
+		targets = append(targets, m.beadItems[m.beadsCursor])
+	}
+
+	var c closeCandidates
+	for _, item := range targets {
+		switch {
+		case item.Status == beads.StatusClosed:
+			c.alreadyClosed = append(c.alreadyClosed, item)
+		case item.assignedWorkID != "" && m.isWorkActive(item.assignedWorkID):
+			c.inActiveWork = append(c.inActiveWork, item)
+		default:
+			c.open = append(c.open, item)
+		}
+	}
+	return c
+}
+
+// isWorkActive reports whether a work is still in progress. Works missing from
+// the cached tiles are treated as active so their beads are flagged.
+func (m *planModel) isWorkActive(workID string) bool {
+	wp := m.findWorkByID(workID)
+	if wp == nil {
+		return true
+	}
+	return wp.Work.Status != db.StatusCompleted && wp.Work.Status != db.StatusMerged
+}
+
 func (m *planModel) updateCloseBeadConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if msg.Type == tea.KeyEsc || msg.String() == "esc" || msg.String() == "escape" {
 		m.viewMode = ViewNormal
 		return m, nil
 	}
 	switch msg.String() {
-	case "y", "Y":
-		// Collect selected beads
+	case "y", "Y", "s", "S":
+		candidates := m.collectCloseCandidates()
+		skipActive := msg.String() == "s" || msg.String() == "S"
+		if skipActive && len(candidates.inActiveWork) == 0 {
+			// Nothing to skip; ignore the key so it isn't mistaken for confirm
+			return m, nil
+		}
+
 		var beadIDs []string
-		for _, item := range m.beadItems {
-			if m.selectedBeads[item.ID] {
+		for _, item := range candidates.open {
+			beadIDs = append(beadIDs, item.ID)
+		}
+		skipped := len(candidates.alreadyClosed)
+		if skipActive {
+			skipped += len(candidates.inActiveWork)
+		} else {
+			for _, item := range candidates.inActiveWork {
 				beadIDs = append(beadIDs, item.ID)
 			}
 		}
 
-		// If no selected beads, use cursor bead
-		if len(beadIDs) == 0 && len(m.beadItems) > 0 && m.beadsCursor < len(m.beadItems) {
-			beadIDs = append(beadIDs, m.beadItems[m.beadsCursor].ID)
+		m.viewMode = ViewNormal
+		if len(beadIDs) == 0 {
+			m.statusMessage = fmt.Sprintf("Nothing to close (%d skipped)", skipped)
+			m.statusIsError = false
+			return m, nil
 		}
+		return m, m.closeBeads(beadIDs, skipped)
+	case "n", "N":
+		m.viewMode = ViewNormal
+		return m, nil
+	}
+	return m, nil
+}
 
+func (m *planModel) updateCompleteWorkConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyEsc || msg.String() == "esc" || msg.String() == "escape" {
 		m.viewMode = ViewNormal
-		if len(beadIDs) == 1 {
-			// Single bead - use the existing closeBead function
-			return m, m.closeBead(beadIDs[0])
-		} else if len(beadIDs) > 1 {
-			// Multiple beads - use the batch close function
-			return m, m.closeBeads(beadIDs)
-		}
+		m.completionPlan = nil
 		return m, nil
+	}
+	switch msg.String() {
+	case "1":
+		m.completionOpts.SkipPRCheck = !m.completionOpts.SkipPRCheck
+	case "2":
+		m.completionOpts.KeepBeads = !m.completionOpts.KeepBeads
+	case "3":
+		m.completionOpts.KeepWorktree = !m.completionOpts.KeepWorktree
+	case "4":
+		m.completionOpts.KeepBranch = !m.completionOpts.KeepBranch
+	case "y", "Y":
+		m.viewMode = ViewNormal
+		if m.completionPlan == nil {
+			return m, nil
+		}
+		workID := m.completionPlan.WorkID
+		m.completionPlan = nil
+		m.statusMessage = fmt.Sprintf("Completing work %s...", workID)
+		m.statusIsError = false
+		return m, m.completeWork(workID, m.completionOpts)
 	case "n", "N":
+		m.viewMode = ViewNormal
+		m.completionPlan = nil
+	}
+	return m, nil
+}
+
+func (m *planModel) updateTaskTypePicker(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyEsc || msg.String() == "esc" || msg.String() == "escape" {
 		m.viewMode = ViewNormal
 		return m, nil
 	}
+	names := m.proj.Config.Workflow.TaskTypeNames()
+	switch msg.String() {
+	case "j", "down":
+		if m.taskTypeCursor < len(names)-1 {
+			m.taskTypeCursor++
+		}
+	case "k", "up":
+		if m.taskTypeCursor > 0 {
+			m.taskTypeCursor--
+		}
+	case "enter":
+		m.viewMode = ViewNormal
+		if m.taskTypeCursor >= len(names) {
+			return m, nil
+		}
+		typeName := names[m.taskTypeCursor]
+		m.statusMessage = fmt.Sprintf("Creating %s task...", typeName)
+		m.statusIsError = false
+		return m, m.createCustomTask(typeName)
+	}
+	return m, nil
+}
+
+func (m *planModel) updateMoveBeadPicker(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyEsc || msg.String() == "esc" || msg.String() == "escape" {
+		m.viewMode = m.dialogReturnView()
+		m.moveBeadID = ""
+		m.moveAssign = false
+		return m, nil
+	}
+	targets := m.moveTargets()
+	switch msg.String() {
+	case "j", "down":
+		if m.moveTargetCursor < len(targets)-1 {
+			m.moveTargetCursor++
+		}
+	case "k", "up":
+		if m.moveTargetCursor > 0 {
+			m.moveTargetCursor--
+		}
+	case "enter":
+		m.viewMode = m.dialogReturnView()
+		beadID := m.moveBeadID
+		assign := m.moveAssign
+		m.moveBeadID = ""
+		m.moveAssign = false
+		if m.moveTargetCursor >= len(targets) || beadID == "" {
+			return m, nil
+		}
+		toWorkID := targets[m.moveTargetCursor].Work.ID
+		if assign {
+			return m, m.assignTriageBead(toWorkID)
+		}
+		m.statusMessage = fmt.Sprintf("Moving %s to %s...", beadID, toWorkID)
+		m.statusIsError = false
+		return m, m.moveBeadToWork(beadID, m.focusedWorkID, toWorkID)
+	}
 	return m, nil
 }
 
@@ -102,6 +263,16 @@ func (m *planModel) renderLabelFilterDialogContent() string {
 		currentLabel = "(none)"
 	}
 
+	var suggestions strings.Builder
+	matches := matchingLabels(m.projectLabels, m.textInput.Value())
+	for i, label := range matches {
+		if i == maxLabelSuggestions {
+			suggestions.WriteString(m.theme.Dim.Render(fmt.Sprintf("    ... %d more", len(matches)-i)) + "\n")
+			break
+		}
+		suggestions.WriteString("    " + m.theme.Dim.Render(label) + "\n")
+	}
+
 	content := fmt.Sprintf(`
   Filter by Label
 
@@ -109,48 +280,59 @@ func (m *planModel) renderLabelFilterDialogContent() string {
 
   Enter label name (empty to clear):
   %s
+%s
+  [Tab] Complete  [Enter] Apply  [Esc] Cancel
+`, currentLabel, m.textInput.View(), suggestions.String())
 
-  [Enter] Apply  [Esc] Cancel
-`, currentLabel, m.textInput.View())
-
-	return tuiDialogStyle.Render(content)
+	return m.theme.Dialog.Render(content)
 }
 
 func (m *planModel) renderCloseBeadConfirmContent() string {
-	// Collect selected beads
-	var selectedBeads []beadItem
-	for _, item := range m.beadItems {
-		if m.selectedBeads[item.ID] {
-			selectedBeads = append(selectedBeads, item)
-		}
-	}
-
-	// If no selected beads, use cursor bead
-	if len(selectedBeads) == 0 && len(m.beadItems) > 0 && m.beadsCursor < len(m.beadItems) {
-		selectedBeads = append(selectedBeads, m.beadItems[m.beadsCursor])
-	}
+	candidates := m.collectCloseCandidates()
+	total := len(candidates.open) + len(candidates.alreadyClosed) + len(candidates.inActiveWork)
 
 	// Build the confirmation message
 	var beadsList string
-	if len(selectedBeads) == 1 {
-		beadsList = fmt.Sprintf("  %s\n  %s", selectedBeads[0].ID, selectedBeads[0].Title)
-	} else if len(selectedBeads) > 1 {
-		beadsList = fmt.Sprintf("  %d issues:\n", len(selectedBeads))
-		for i, bead := range selectedBeads {
+	if total == 1 && len(candidates.open) == 1 {
+		beadsList = fmt.Sprintf("  %s\n  %s", candidates.open[0].ID, candidates.open[0].Title)
+	} else if len(candidates.open) > 0 {
+		beadsList = fmt.Sprintf("  %d issues:\n", len(candidates.open))
+		for i, bead := range candidates.open {
 			if i < 5 { // Show first 5 beads
 				beadsList += fmt.Sprintf("  - %s: %s\n", bead.ID, bead.Title)
 			}
 		}
-		if len(selectedBeads) > 5 {
-			beadsList += fmt.Sprintf("  ... and %d more", len(selectedBeads)-5)
+		if len(candidates.open) > 5 {
+			beadsList += fmt.Sprintf("  ... and %d more", len(candidates.open)-5)
+		}
+	}
+
+	// Flagged beads are always listed in full so nothing is closed by surprise
+	var warnings string
+	if len(candidates.inActiveWork) > 0 {
+		warningStyle := lipgloss.NewStyle().Foreground(m.theme.AccentColor)
+		warnings += "\n  " + warningStyle.Render("Assigned to an active work:") + "\n"
+		for _, bead := range candidates.inActiveWork {
+			warnings += fmt.Sprintf("  ! %s: %s (%s)\n", bead.ID, bead.Title, bead.assignedWorkID)
+		}
+	}
+	if len(candidates.alreadyClosed) > 0 {
+		warnings += "\n  " + m.theme.Dim.Render("Already closed (skipped):") + "\n"
+		for _, bead := range candidates.alreadyClosed {
+			warnings += fmt.Sprintf("  - %s: %s\n", bead.ID, bead.Title)
 		}
 	}
 
 	var title string
-	if len(selectedBeads) == 1 {
+	if total == 1 {
 		title = "Close Issue"
 	} else {
-		title = fmt.Sprintf("Close %d Issues", len(selectedBeads))
+		title = fmt.Sprintf("Close %d Issues", total)
+	}
+
+	buttons := "[y] Yes  [n] No"
+	if len(candidates.inActiveWork) > 0 {
+		buttons = "[y] Close all  [s] Skip assigned  [n] No"
 	}
 
 	content := fmt.Sprintf(`
@@ -158,20 +340,142 @@ func (m *planModel) renderCloseBeadConfirmContent() string {
 
   Are you sure you want to close:
 %s
+%s
+  %s
+`, title, beadsList, warnings, buttons)
 
-  [y] Yes  [n] No
-`, title, beadsList)
+	return m.theme.Dialog.Render(content)
+}
+
+func (m *planModel) renderCompleteWorkConfirmContent() string {
+	plan := m.completionPlan
+	if plan == nil {
+		return m.theme.Dialog.Render("\n  Loading...\n")
+	}
+	opts := m.completionOpts
+
+	check := func(enabled bool) string {
+		if enabled {
+			return "[x]"
+		}
+		return "[ ]"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n  Complete Work %s\n\n", plan.WorkID)
+	if reason := m.staleWorks[plan.WorkID]; reason != "" {
+		b.WriteString(m.theme.Dim.Render("  Stale: "+reason) + "\n\n")
+	}
+
+	prURL := plan.PRURL
+	if prURL == "" {
+		prURL = "(no PR)"
+	}
+	fmt.Fprintf(&b, "  1 %s Verify PR is merged: %s\n", check(!opts.SkipPRCheck), prURL)
+
+	fmt.Fprintf(&b, "  2 %s Close %d open issue(s)\n", check(!opts.KeepBeads), len(plan.Beads))
+	for i, bead := range plan.Beads {
+		if i >= 8 {
+			fmt.Fprintf(&b, "        ... and %d more\n", len(plan.Beads)-8)
+			break
+		}
+		fmt.Fprintf(&b, "        - %s: %s\n", bead.ID, ansi.Truncate(bead.Title, 40, "..."))
+	}
+
+	worktree := plan.WorktreePath
+	if worktree == "" {
+		worktree = "(none)"
+	}
+	fmt.Fprintf(&b, "  3 %s Remove worktree: %s\n", check(!opts.KeepWorktree), worktree)
+
+	// Keeping the worktree keeps the branch checked out, so it can't be deleted
+	deleteBranch := !opts.KeepBranch && !opts.KeepWorktree
+	branchLine := fmt.Sprintf("  4 %s Delete %s: %s", check(deleteBranch), plan.BranchLocation(), plan.BranchName)
+	if opts.KeepWorktree && !opts.KeepBranch {
+		branchLine += m.theme.Dim.Render(" (kept with worktree)")
+	}
+	b.WriteString(branchLine + "\n")
+
+	b.WriteString("      Mark work as completed\n\n")
+	b.WriteString("  [1-4] Toggle  [y] Run  [n] Cancel\n")
+
+	return m.theme.Dialog.Render(b.String())
+}
+
+func (m *planModel) renderTaskTypePickerContent() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n  New Task for %s\n\n", m.focusedWorkID)
+
+	workflow := m.proj.Config.Workflow
+	for i, name := range workflow.TaskTypeNames() {
+		taskType, _ := workflow.GetTaskType(name)
+		cursor := "  "
+		if i == m.taskTypeCursor {
+			cursor = "> "
+		}
+		details := taskType.Prompt
+		if taskType.NeedsBeads {
+			details += ", needs beads"
+		}
+		if taskType.MaxIterations > 0 {
+			details += fmt.Sprintf(", max %d", taskType.MaxIterations)
+		}
+		fmt.Fprintf(&b, "  %s%s %s\n", cursor, name, m.theme.Dim.Render("("+details+")"))
+	}
+
+	b.WriteString("\n  [j/k] Select  [Enter] Create  [Esc] Cancel\n")
+
+	return m.theme.Dialog.Render(b.String())
+}
+
+func (m *planModel) renderMoveBeadPickerContent() string {
+	var b strings.Builder
+	verb := "Move"
+	if m.moveAssign {
+		verb = "Add"
+		fmt.Fprintf(&b, "\n  Add %s to\n\n", m.moveBeadID)
+	} else {
+		fmt.Fprintf(&b, "\n  Move %s from %s to\n\n", m.moveBeadID, m.focusedWorkID)
+	}
 
-	return tuiDialogStyle.Render(content)
+	for i, wp := range m.moveTargets() {
+		cursor := "  "
+		if i == m.moveTargetCursor {
+			cursor = "> "
+		}
+		name := wp.Work.Name
+		if name == "" {
+			name = wp.Work.BranchName
+		}
+		fmt.Fprintf(&b, "  %s%s %s %s\n", cursor, wp.Work.ID, name, m.theme.Dim.Render("("+wp.Work.Status+")"))
+	}
+
+	fmt.Fprintf(&b, "\n  [j/k] Select  [Enter] %s  [Esc] Cancel\n", verb)
+
+	return m.theme.Dialog.Render(b.String())
 }
 
 func (m *planModel) renderDestroyConfirmContent() string {
-	workID := m.focusedWorkID
+	workID := m.destroyWorkID
 	workName := workID
 
-	// Try to get work name from focused work
-	if focusedWork := m.workDetails.GetFocusedWork(); focusedWork != nil && focusedWork.Work.Name != "" {
-		workName = focusedWork.Work.Name
+	// Try to get work name from the loaded tiles
+	if wp := m.findWorkByID(workID); wp != nil && wp.Work.Name != "" {
+		workName = wp.Work.Name
+	}
+
+	// Until the plan has loaded, describe the steps in general
+	steps := `  - Remove the git worktree
+  - Delete the work directory
+  - Update database records
+`
+	if plan := m.destroyPlan; plan != nil && plan.WorkID == workID {
+		var b strings.Builder
+		plan.Print(&b)
+		steps = ""
+		for _, line := range strings.Split(strings.TrimRight(b.String(), "\n"), "\n") {
+			steps += "  - " + strings.TrimSpace(line) + "\n"
+		}
 	}
 
 	content := fmt.Sprintf(`
@@ -182,13 +486,268 @@ func (m *planModel) renderDestroyConfirmContent() string {
   %s
 
   This will:
-  - Remove the git worktree
-  - Delete the work directory
-  - Update database records
-
+%s
   [y] Yes  [n] No
-`, workID, workName)
+`, workID, workName, steps)
 
-	return tuiDialogStyle.Render(content)
+	return m.theme.Dialog.Render(content)
 }
 
+func (m *planModel) updateRemoveBeadConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	pending := m.removeBead
+	switch msg.String() {
+	case "y", "Y":
+		m.viewMode = ViewNormal
+		m.removeBead = nil
+		if pending != nil {
+			return m, m.removeBeadFromWork(pending.workID, pending.err.BeadID, true)
+		}
+	case "n", "N", "esc", "escape":
+		m.viewMode = ViewNormal
+		m.removeBead = nil
+		if pending != nil {
+			m.statusMessage = fmt.Sprintf("Kept %s in work %s", pending.err.BeadID, pending.workID)
+			m.statusIsError = false
+		}
+	}
+	return m, nil
+}
+
+func (m *planModel) renderRemoveBeadConfirmContent() string {
+	pending := m.removeBead
+	if pending == nil {
+		return ""
+	}
+	content := fmt.Sprintf(`
+  Remove Issue From Work
+
+  %s has already been added to pending task(s):
+  %s
+
+  Removing it also takes it out of those tasks.
+  A task left without issues is deleted.
+
+  [y] Remove from tasks and work  [n] Abort
+`, pending.err.BeadID, strings.Join(pending.err.TaskIDs, ", "))
+
+	return m.theme.Dialog.Render(content)
+}
+
+// complexityBucketLabels names the actual/budget histogram buckets.
+var complexityBucketLabels = [db.ComplexityHistogramBuckets]string{
+	"  0-24%", " 25-49%", " 50-74%", " 75-99%", "100-124%", "125-149%", "150-174%", "175-199%", "  200%+",
+}
+
+// histogramBlocks are the partial block characters used for fractional bar widths.
+var histogramBlocks = []rune{' ', '▏', '▎', '▍', '▌', '▋', '▊', '▉', '█'}
+
+// renderHistogramBar renders count as a bar scaled so maxCount fills width cells,
+// using eighth-block characters for the remainder.
+func renderHistogramBar(count, maxCount, width int) string {
+	if count <= 0 || maxCount <= 0 || width <= 0 {
+		return ""
+	}
+	eighths := count * width * 8 / maxCount
+	if eighths == 0 {
+		eighths = 1 // Always show non-empty buckets
+	}
+	bar := strings.Repeat("█", eighths/8)
+	if rem := eighths % 8; rem > 0 {
+		bar += string(histogramBlocks[rem])
+	}
+	return bar
+}
+
+// renderComplexityStatsSection renders budget, per-type averages, overruns and
+// the histogram for one set of stats.
+func (m *planModel) renderComplexityStatsSection(b *strings.Builder, title string, stats *db.ComplexityStats) {
+	fmt.Fprintf(b, "  %s\n", m.theme.Label.Render(title))
+	if stats == nil || stats.TaskCount == 0 {
+		b.WriteString(m.theme.Dim.Render("    No tasks with recorded complexity") + "\n\n")
+		return
+	}
+
+	pct := stats.TotalActual * 100 / max(stats.TotalBudget, 1)
+	fmt.Fprintf(b, "    %d tasks  budget %d  actual %d  (%d%%)\n\n", stats.TaskCount, stats.TotalBudget, stats.TotalActual, pct)
+
+	fmt.Fprintf(b, "    %-12s %5s %10s %10s\n", "Type", "Tasks", "Avg budget", "Avg actual")
+	for _, t := range stats.ByType {
+		fmt.Fprintf(b, "    %-12s %5d %10d %10d\n", ansi.Truncate(t.TaskType, 12, ""), t.TaskCount, t.AverageBudget(), t.AverageActual())
+	}
+
+	if len(stats.TopOverruns) > 0 {
+		b.WriteString("\n    Biggest overruns\n")
+		for _, o := range stats.TopOverruns {
+			fmt.Fprintf(b, "    %-16s %-10s %6d → %-6d %s\n", o.TaskID, ansi.Truncate(o.TaskType, 10, ""), o.Budget, o.Actual,
+				m.theme.Error.Render(fmt.Sprintf("+%d", o.Overrun())))
+		}
+	}
+
+	b.WriteString("\n    Actual / budget\n")
+	maxCount := 0
+	for _, count := range stats.Histogram {
+		maxCount = max(maxCount, count)
+	}
+	for i, count := range stats.Histogram {
+		fmt.Fprintf(b, "    %s │%-20s %d\n", complexityBucketLabels[i], renderHistogramBar(count, maxCount, 20), count)
+	}
+	b.WriteString("\n")
+}
+
+func (m *planModel) renderSpawnErrorContent() string {
+	se := m.spawnErr
+	if se == nil {
+		return m.theme.Dialog.Render("\n  No spawn error\n")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n  %s failed for %s\n\n", se.action, se.id)
+	fmt.Fprintf(&b, "  %s\n\n", m.theme.Error.Render(se.err.Error()))
+	if len(se.output) > 0 {
+		fmt.Fprintf(&b, "  Last %d lines of output:\n", len(se.output))
+		for _, line := range se.output {
+			b.WriteString("    " + m.theme.Dim.Render(line) + "\n")
+		}
+		b.WriteString("\n")
+	} else {
+		b.WriteString("  " + m.theme.Dim.Render("(no output captured)") + "\n\n")
+	}
+	if se.logPath != "" {
+		fmt.Fprintf(&b, "  Full output: %s\n\n", se.logPath)
+	}
+	b.WriteString("  Press any key to close\n")
+
+	return m.theme.Dialog.Render(b.String())
+}
+
+func (m *planModel) renderComplexityStatsContent() string {
+	report := m.complexityReport
+	if report == nil {
+		return m.theme.Dialog.Render("\n  Loading...\n")
+	}
+
+	var b strings.Builder
+	b.WriteString("\n  Complexity Budget vs Actual\n\n")
+	if m.focusedWorkID != "" {
+		m.renderComplexityStatsSection(&b, "Work "+m.focusedWorkID, report.Works[m.focusedWorkID])
+	}
+	m.renderComplexityStatsSection(&b, "Project", &report.Project)
+	b.WriteString("  Press any key to close\n")
+
+	return m.theme.Dialog.Render(b.String())
+}
+
+// openAssignBeadsConfirm asks to add issues to the focused work
+func (m *planModel) openAssignBeadsConfirm(beadIDs []string) {
+	m.assignBeadIDs = beadIDs
+	m.assignBeadsErr = ""
+	m.assignBeadsScroll = 0
+	m.viewMode = ViewAssignBeads
+}
+
+// assignBeadsShown is how many issues the assign confirmation lists at once
+func (m *planModel) assignBeadsShown() int {
+	return max(m.height-16, 8)
+}
+
+// updateAssignBeadsConfirm handles the assign confirmation: Enter only adds
+// the issues to the focused work, r also runs it with one task per issue and
+// p runs it with LLM task grouping. j/k scroll a list too long for the screen.
+func (m *planModel) updateAssignBeadsConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	beadIDs, workID := m.assignBeadIDs, m.focusedWorkID
+	m.assignBeadsErr = ""
+	switch msg.String() {
+	case "enter", "y", "Y", "r", "p":
+		// Issues another work took since the dialog opened can't be added
+		if owned := m.assignBeadsOwned(); len(owned) > 0 {
+			m.statusMessage = fmt.Sprintf("Cannot add: %s already in another work", strings.Join(owned, ", "))
+			m.statusIsError = true
+			return m, nil
+		}
+	}
+	switch msg.String() {
+	case "enter", "y", "Y":
+		m.closeAssignBeadsConfirm()
+		m.submittedDialog = &submittedDialog{view: ViewAssignBeads, action: "Add issue", id: workID, beadIDs: beadIDs}
+		return m, m.addBeadsToWork(beadIDs, workID)
+	case "r", "p":
+		usePlan := msg.String() == "p"
+		m.closeAssignBeadsConfirm()
+		m.submittedDialog = &submittedDialog{view: ViewAssignBeads, action: "Add issue", id: workID, beadIDs: beadIDs}
+		m.statusMessage = fmt.Sprintf("Adding %s to %s and running it...", strings.Join(beadIDs, ", "), workID)
+		m.statusIsError = false
+		return m, m.assignAndRunWork(beadIDs, workID, usePlan)
+	case "j", "down":
+		m.assignBeadsScroll = min(m.assignBeadsScroll+1, max(len(beadIDs)-m.assignBeadsShown(), 0))
+	case "k", "up":
+		m.assignBeadsScroll = max(m.assignBeadsScroll-1, 0)
+	case "n", "N", "esc", "escape":
+		m.viewMode = ViewNormal
+		m.assignBeadIDs = nil
+	}
+	return m, nil
+}
+
+// closeAssignBeadsConfirm closes the assign confirmation once the issues are
+// sent, clearing the selection they came from
+func (m *planModel) closeAssignBeadsConfirm() {
+	m.viewMode = ViewNormal
+	m.assignBeadIDs = nil
+	m.selectedBeads = make(map[string]bool)
+}
+
+// assignBeadsOwned returns the issues waiting on the assign confirmation
+// that another work has, as the refreshed issues list shows them
+func (m *planModel) assignBeadsOwned() []string {
+	var owned []string
+	for _, item := range m.beadItems {
+		if slices.Contains(m.assignBeadIDs, item.ID) && item.assignedWorkID != "" && item.assignedWorkID != m.focusedWorkID {
+			owned = append(owned, item.ID)
+		}
+	}
+	return owned
+}
+
+func (m *planModel) renderAssignBeadsConfirmContent() string {
+	items := make(map[string]beadItem, len(m.beadItems))
+	for _, item := range m.beadItems {
+		items[item.ID] = item
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n  Add Issues To Work\n\n  Add %d issue(s) to %s?\n", len(m.assignBeadIDs), m.focusedWorkID)
+	// Every issue that will be added can be seen, scrolling when the list
+	// is longer than the screen
+	start := min(m.assignBeadsScroll, max(len(m.assignBeadIDs)-m.assignBeadsShown(), 0))
+	end := min(start+m.assignBeadsShown(), len(m.assignBeadIDs))
+	if start > 0 {
+		b.WriteString(m.theme.Dim.Render(fmt.Sprintf("  ↑ %d more", start)) + "\n")
+	}
+	for _, id := range m.assignBeadIDs[start:end] {
+		line := "  " + id
+		item, ok := items[id]
+		if ok {
+			line += " " + item.Title
+		}
+		line = ansi.Truncate(line, max(m.width-24, 20), "…")
+		// Issues another work has carry its marker, as in the issues list
+		if ok && item.assignedWorkID != "" && item.assignedWorkID != m.focusedWorkID {
+			line += " " + lipgloss.NewStyle().Foreground(m.theme.ErrorColor).Render("["+item.assignedWorkID+"]")
+		}
+		b.WriteString(line + "\n")
+	}
+	if end < len(m.assignBeadIDs) {
+		b.WriteString(m.theme.Dim.Render(fmt.Sprintf("  ↓ %d more (j/k to scroll)", len(m.assignBeadIDs)-end)) + "\n")
+	}
+
+	if owned := m.assignBeadsOwned(); len(owned) > 0 {
+		b.WriteString("\n  " + lipgloss.NewStyle().Foreground(m.theme.ErrorColor).Render("Already in another work: "+strings.Join(owned, ", ")) + "\n")
+		b.WriteString("\n  [Esc] Cancel\n")
+	} else {
+		if m.assignBeadsErr != "" {
+			b.WriteString("\n  " + m.theme.Error.Render(m.assignBeadsErr) + "\n")
+		}
+		b.WriteString("\n  [Enter] Add  [r] Add and run  [p] Add and run with plan  [Esc] Cancel\n")
+	}
+	return m.theme.Dialog.Render(b.String())
+}
@@ -2,10 +2,16 @@ package tui
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/progress"
+	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/work"
 	"github.com/stretchr/testify/require"
 )
 
@@ -96,6 +102,7 @@ func TestMultiSelectionCloseConfirmation(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Create a mock planModel
 			m := &planModel{
+				theme:         DarkTheme(),
 				beadItems:     tt.beadItems,
 				selectedBeads: tt.selectedBeads,
 				beadsCursor:   tt.cursorIndex,
@@ -198,6 +205,7 @@ func TestUpdateCloseBeadConfirm(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Create a mock planModel with selected beads
 			m := &planModel{
+				theme: DarkTheme(),
 				beadItems: []beadItem{
 					testBeadItem("bead-1", "Task 1", "open", 2, "task"),
 					testBeadItem("bead-2", "Task 2", "open", 2, "task"),
@@ -284,6 +292,7 @@ func TestCloseKeyHandlerWithSelection(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Create a mock planModel
 			m := &planModel{
+				theme:         DarkTheme(),
 				beadItems:     tt.beadItems,
 				selectedBeads: tt.selectedBeads,
 				beadsCursor:   tt.cursorIndex,
@@ -322,6 +331,7 @@ func TestBatchCloseFunction(t *testing.T) {
 
 	// Verify the function signature exists and accepts multiple IDs
 	m := &planModel{
+		theme:              DarkTheme(),
 		ctx:                context.Background(),
 		beadItems:          []beadItem{},
 		selectedBeads:      map[string]bool{},
@@ -329,7 +339,7 @@ func TestBatchCloseFunction(t *testing.T) {
 	}
 
 	// The closeBeads function should accept a slice of bead IDs
-	cmd := m.closeBeads(beadIDs)
+	cmd := m.closeBeads(beadIDs, 0)
 
 	// Verify the command is not nil
 	require.NotNil(t, cmd, "closeBeads should return a non-nil command")
@@ -339,6 +349,68 @@ func TestBatchCloseFunction(t *testing.T) {
 	// This would require mocking exec.CommandContext or using an interface
 }
 
+// TestCollectCloseCandidates tests partitioning of beads for batch close
+func TestCollectCloseCandidates(t *testing.T) {
+	assigned := testBeadItem("bead-3", "Task 3", "open", 2, "task")
+	assigned.assignedWorkID = "w-active"
+	finished := testBeadItem("bead-4", "Task 4", "open", 2, "task")
+	finished.assignedWorkID = "w-done"
+
+	m := &planModel{
+		theme: DarkTheme(),
+		beadItems: []beadItem{
+			testBeadItem("bead-1", "Task 1", "open", 2, "task"),
+			testBeadItem("bead-2", "Task 2", "closed", 2, "task"),
+			assigned,
+			finished,
+		},
+		selectedBeads: map[string]bool{"bead-1": true, "bead-2": true, "bead-3": true, "bead-4": true},
+		workTiles: []*progress.WorkProgress{
+			{Work: &db.Work{ID: "w-active", Status: db.StatusProcessing}},
+			{Work: &db.Work{ID: "w-done", Status: db.StatusMerged}},
+		},
+		viewMode: ViewCloseBeadConfirm,
+	}
+
+	ids := func(items []beadItem) []string {
+		var out []string
+		for _, item := range items {
+			out = append(out, item.ID)
+		}
+		return out
+	}
+
+	c := m.collectCloseCandidates()
+	require.Equal(t, []string{"bead-1", "bead-4"}, ids(c.open), "open beads and beads of finished works close without warning")
+	require.Equal(t, []string{"bead-2"}, ids(c.alreadyClosed))
+	require.Equal(t, []string{"bead-3"}, ids(c.inActiveWork))
+
+	dialog := m.renderCloseBeadConfirmContent()
+	require.Contains(t, dialog, "[s] Skip assigned", "skip option should be offered when beads are flagged")
+	require.Contains(t, dialog, "Already closed")
+
+	// Skipping with nothing flagged is ignored
+	m.selectedBeads = map[string]bool{"bead-1": true}
+	_, cmd := m.updateCloseBeadConfirm(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	require.Nil(t, cmd)
+	require.Equal(t, ViewCloseBeadConfirm, m.viewMode)
+}
+
+// TestBeadsClosedMsgReport tests the status report and selection reset after a batch close
+func TestBeadsClosedMsgReport(t *testing.T) {
+	m := &planModel{
+		theme:         DarkTheme(),
+		ctx:           context.Background(),
+		selectedBeads: map[string]bool{"bead-1": true},
+	}
+
+	_, cmd := m.Update(beadsClosedMsg{closed: 6, skipped: 2})
+	require.NotNil(t, cmd, "should refresh after closing")
+	require.Equal(t, "Closed 6 issues (2 skipped)", m.statusMessage)
+	require.False(t, m.statusIsError)
+	require.Empty(t, m.selectedBeads, "selection should be cleared")
+}
+
 // TestCloseConfirmationEdgeCases tests edge cases for close confirmation
 func TestCloseConfirmationEdgeCases(t *testing.T) {
 	tests := []struct {
@@ -350,6 +422,7 @@ func TestCloseConfirmationEdgeCases(t *testing.T) {
 			name: "Empty selection and invalid cursor",
 			setup: func() *planModel {
 				return &planModel{
+					theme:         DarkTheme(),
 					beadItems:     []beadItem{testBeadItem("bead-1", "Task", "open", 2, "task")},
 					selectedBeads: map[string]bool{},
 					beadsCursor:   10, // Invalid cursor position
@@ -362,6 +435,7 @@ func TestCloseConfirmationEdgeCases(t *testing.T) {
 			name: "Already closed beads in selection",
 			setup: func() *planModel {
 				return &planModel{
+					theme: DarkTheme(),
 					beadItems: []beadItem{
 						testBeadItem("bead-1", "Task 1", "closed", 2, "task"),
 						testBeadItem("bead-2", "Task 2", "open", 2, "task"),
@@ -383,6 +457,7 @@ func TestCloseConfirmationEdgeCases(t *testing.T) {
 				item1.assignedWorkID = "w-123"
 				item2 := testBeadItem("bead-2", "Task 2", "open", 2, "task")
 				return &planModel{
+					theme:     DarkTheme(),
 					beadItems: []beadItem{item1, item2},
 					selectedBeads: map[string]bool{
 						"bead-1": true, // Already assigned to work
@@ -413,3 +488,191 @@ func TestCloseConfirmationEdgeCases(t *testing.T) {
 		})
 	}
 }
+
+// TestCompleteWorkConfirm tests the checklist dialog for completing a merged work
+func TestCompleteWorkConfirm(t *testing.T) {
+	m := &planModel{
+		theme:    DarkTheme(),
+		viewMode: ViewCompleteWorkConfirm,
+		completionPlan: &work.CompletionPlan{
+			WorkID:       "w-abc",
+			PRURL:        "https://github.com/owner/repo/pull/1",
+			BranchName:   "feat/thing",
+			WorktreePath: "/proj/w-abc/tree",
+			Beads: []beads.Bead{
+				{ID: "bead-1", Title: "First"},
+				{ID: "bead-2", Title: "Second"},
+			},
+		},
+	}
+
+	dialog := m.renderCompleteWorkConfirmContent()
+	for _, want := range []string{"w-abc", "bead-1", "bead-2", "feat/thing", "/proj/w-abc/tree", "pull/1"} {
+		require.Contains(t, dialog, want, "dialog should show everything that will be affected")
+	}
+
+	// Toggle keeping beads and the worktree
+	m.updateCompleteWorkConfirm(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	m.updateCompleteWorkConfirm(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+	require.True(t, m.completionOpts.KeepBeads)
+	require.True(t, m.completionOpts.KeepWorktree)
+	require.Contains(t, m.renderCompleteWorkConfirmContent(), "kept with worktree")
+
+	// Cancel clears the plan
+	m.updateCompleteWorkConfirm(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.Nil(t, m.completionPlan)
+}
+
+func TestAssignBeadsConfirmScrolls(t *testing.T) {
+	m := &planModel{theme: DarkTheme(), width: 120, height: 20, focusedWorkID: "w-abc"}
+	var beadIDs []string
+	for i := 1; i <= 12; i++ {
+		beadIDs = append(beadIDs, fmt.Sprintf("bead-%02d", i))
+	}
+	m.openAssignBeadsConfirm(beadIDs)
+	key := func(s string) { m.updateAssignBeadsConfirm(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}) }
+
+	// The screen fits eight; the rest are a scroll away rather than hidden
+	dialog := m.renderAssignBeadsConfirmContent()
+	require.Contains(t, dialog, "bead-08")
+	require.NotContains(t, dialog, "bead-09")
+	require.Contains(t, dialog, "4 more (j/k to scroll)")
+
+	for range 10 {
+		key("j")
+	}
+	dialog = m.renderAssignBeadsConfirmContent()
+	require.Contains(t, dialog, "bead-12")
+	require.NotContains(t, dialog, "bead-04")
+	require.Contains(t, dialog, "↑ 4 more")
+	require.NotContains(t, dialog, "j/k to scroll")
+
+	key("k")
+	require.Contains(t, m.renderAssignBeadsConfirmContent(), "bead-04")
+	require.Equal(t, ViewAssignBeads, m.viewMode)
+}
+
+func TestRenderHistogramBar(t *testing.T) {
+	require.Equal(t, "", renderHistogramBar(0, 10, 20))
+	require.Equal(t, strings.Repeat("█", 20), renderHistogramBar(10, 10, 20))
+	require.Equal(t, strings.Repeat("█", 10), renderHistogramBar(5, 10, 20))
+	require.Equal(t, "▏", renderHistogramBar(1, 1000, 20), "tiny non-zero counts still show a sliver")
+	require.Equal(t, "█▌", renderHistogramBar(3, 4, 2))
+}
+
+func TestComplexityStatsOverlay(t *testing.T) {
+	stats := &db.ComplexityStats{
+		TaskCount:   2,
+		TotalBudget: 200,
+		TotalActual: 300,
+		ByType: []db.ComplexityTypeStats{
+			{TaskType: "implement", TaskCount: 2, TotalBudget: 200, TotalActual: 300},
+		},
+		TopOverruns: []db.ComplexityOverrun{
+			{TaskID: "w-abc.2", WorkID: "w-abc", TaskType: "implement", Budget: 100, Actual: 250},
+		},
+	}
+	stats.Histogram[2] = 1
+	stats.Histogram[8] = 1
+
+	m := &planModel{
+		theme:            DarkTheme(),
+		viewMode:         ViewComplexityStats,
+		focusedWorkID:    "w-abc",
+		complexityReport: &db.ComplexityReport{Project: *stats, Works: map[string]*db.ComplexityStats{"w-abc": stats}},
+	}
+
+	dialog := m.renderComplexityStatsContent()
+	for _, want := range []string{"Work w-abc", "Project", "budget 200", "actual 300", "(150%)", "w-abc.2", "+150", "200%+"} {
+		require.Contains(t, dialog, want)
+	}
+
+	// A work with no measured tasks still renders the project section
+	m.focusedWorkID = "w-empty"
+	dialog = m.renderComplexityStatsContent()
+	require.Contains(t, dialog, "No tasks with recorded complexity")
+	require.Contains(t, dialog, "w-abc.2")
+}
+
+func TestBDMissingDisablesBeadKeys(t *testing.T) {
+	m := &planModel{
+		theme:         DarkTheme(),
+		ctx:           context.Background(),
+		beadItems:     []beadItem{testBeadItem("bead-1", "Task 1", "open", 2, "task")},
+		selectedBeads: map[string]bool{},
+		viewMode:      ViewNormal,
+		activePanel:   PanelLeft,
+		bdMissing:     true,
+	}
+
+	for _, key := range []string{"n", "e", "a", "x"} {
+		m.statusMessage = ""
+		m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		require.Equal(t, ViewNormal, m.viewMode, "key %q should not open a dialog without bd", key)
+		require.Contains(t, m.statusMessage, bdMissingMessage)
+		require.True(t, m.statusIsError)
+	}
+
+	// Keys that don't need bd still work
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	require.True(t, m.beadsExpanded)
+
+	// The status bar shows the banner and dims the disabled buttons
+	bar := NewStatusBar(DarkTheme())
+	bar.SetSize(200)
+	bar.SetBeadsDisabled(true)
+	require.Contains(t, bar.Render(), bdMissingMessage)
+}
+
+func TestCreateWorkFromSelectedBeads(t *testing.T) {
+	newModel := func() *planModel {
+		return &planModel{
+			theme: DarkTheme(),
+			ctx:   context.Background(),
+			proj:  &project.Project{Root: t.TempDir()},
+			beadItems: []beadItem{
+				testBeadItem("bead-1", "Fix login", "open", 2, "task"),
+				testBeadItem("bead-2", "Add logout", "open", 2, "task"),
+				testBeadItem("bead-3", "Taken", "open", 2, "task"),
+			},
+			selectedBeads:   map[string]bool{},
+			viewMode:        ViewNormal,
+			activePanel:     PanelLeft,
+			focusedWorkID:   "w-other",
+			createWorkPanel: NewCreateWorkPanel(DarkTheme()),
+		}
+	}
+	pressW := func(m *planModel) {
+		m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("W")})
+	}
+
+	// Selected beads become one work, with the branch named by the shared helper
+	m := newModel()
+	m.selectedBeads["bead-1"] = true
+	m.selectedBeads["bead-2"] = true
+	pressW(m)
+	require.Equal(t, ViewCreateWork, m.viewMode)
+	result := m.createWorkPanel.GetResult()
+	require.Equal(t, "bead-1", result.BeadID)
+	require.Equal(t, []string{"bead-2"}, result.AdditionalBeadIDs)
+	require.True(t, result.FocusOnCreate)
+	require.Equal(t, work.GenerateBranchNameFromIssues([]*beads.Bead{m.beadItems[0].Bead, m.beadItems[1].Bead}), result.BranchName)
+	require.Empty(t, m.selectedBeads)
+
+	// Beads that already belong to a work are rejected
+	m = newModel()
+	m.beadItems[2].assignedWorkID = "w-other"
+	m.beadsCursor = 2
+	pressW(m)
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.True(t, m.statusIsError)
+	require.Contains(t, m.statusMessage, "bead-3 already assigned to w-other")
+
+	// Plain w still creates an unfocused work from the cursor bead
+	m = newModel()
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	require.Equal(t, ViewCreateWork, m.viewMode)
+	require.False(t, m.createWorkPanel.GetResult().FocusOnCreate)
+	require.Empty(t, m.createWorkPanel.GetResult().AdditionalBeadIDs)
+}
@@ -0,0 +1,81 @@
+package tui
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/logging"
+	"github.com/newhook/co/internal/worktree"
+)
+
+// worktreeMeasureInterval is the minimum time between worktree disk usage
+// measurements. Walking a worktree with node_modules is slow, so it is
+// sampled alongside work tile refreshes rather than run on every one.
+const worktreeMeasureInterval = 10 * time.Minute
+
+// worktreeSizesMeasuredMsg carries the result of a background worktree measurement
+type worktreeSizesMeasuredMsg struct {
+	sizes      map[string]worktree.Usage // workID -> disk usage
+	measuredAt time.Time
+}
+
+// measureWorktrees measures the loaded works' worktrees off the UI thread when
+// the last measurement is older than worktreeMeasureInterval. It returns nil
+// while a measurement is in flight or the cached sizes are still fresh.
+func (m *planModel) measureWorktrees() tea.Cmd {
+	if m.worktreeMeasureInFlight || time.Since(m.worktreeMeasuredAt) < worktreeMeasureInterval {
+		return nil
+	}
+
+	paths := make(map[string]string)
+	for _, wp := range m.workTiles {
+		if wp != nil && wp.Work.WorktreePath != "" && !wp.Work.IsRemote() {
+			paths[wp.Work.ID] = wp.Work.WorktreePath
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	m.worktreeMeasureInFlight = true
+	return func() tea.Msg {
+		sizes := make(map[string]worktree.Usage)
+		for workID, path := range paths {
+			ctx, cancel := context.WithTimeout(m.ctx, worktree.MeasureTimeout)
+			usage, err := worktree.Measure(ctx, path, 0)
+			cancel()
+			if err != nil {
+				// Not created yet or already removed
+				logging.Debug("measureWorktrees skipped work", "workID", workID, "error", err)
+				continue
+			}
+			sizes[workID] = usage
+		}
+		return worktreeSizesMeasuredMsg{sizes: sizes, measuredAt: time.Now()}
+	}
+}
+
+// worktreeSize returns the formatted disk usage of a work's worktree, or "" if
+// it hasn't been measured.
+func (m *planModel) worktreeSize(workID string) string {
+	usage, ok := m.worktreeSizes[workID]
+	if !ok {
+		return ""
+	}
+	return usage.String()
+}
+
+// totalWorktreeSize returns the formatted combined disk usage of the measured
+// worktrees, or "" if none have been measured.
+func (m *planModel) totalWorktreeSize() string {
+	if len(m.worktreeSizes) == 0 {
+		return ""
+	}
+	var total worktree.Usage
+	for _, usage := range m.worktreeSizes {
+		total.Bytes += usage.Bytes
+		total.Truncated = total.Truncated || usage.Truncated
+	}
+	return total.String()
+}
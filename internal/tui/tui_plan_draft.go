@@ -0,0 +1,277 @@
+package tui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/logging"
+	"github.com/newhook/co/internal/project"
+)
+
+// tuiDraftFile is where the text of an open dialog is kept, under .co/, so it
+// survives the TUI crashing or its pane being killed
+const tuiDraftFile = "tui-draft.json"
+
+// draftSaveEvery is how many key presses in a dialog go by between saves of
+// its draft
+const draftSaveEvery = 5
+
+// Dialogs whose drafts are kept
+const (
+	draftKindBead      = "bead"       // Create, add-child or edit issue form
+	draftKindWorkNotes = "work-notes" // Work notes editor
+)
+
+// dialogDraft is the unsubmitted content of a dialog
+type dialogDraft struct {
+	Kind        string    `json:"kind"`
+	SavedAt     time.Time `json:"saved_at"`
+	EditBeadID  string    `json:"edit_bead_id,omitempty"` // Issue being edited
+	ParentID    string    `json:"parent_id,omitempty"`    // Parent of the child issue being created
+	Title       string    `json:"title,omitempty"`
+	Description string    `json:"description,omitempty"`
+	BeadType    string    `json:"type,omitempty"`
+	Priority    int       `json:"priority"`
+	Status      string    `json:"status,omitempty"`
+	PrevStatus  string    `json:"prev_status,omitempty"`
+	Labels      []string  `json:"labels,omitempty"`
+	PrevLabels  []string  `json:"prev_labels,omitempty"`
+	BlockedBy   []string  `json:"blocked_by,omitempty"`
+	WorkID      string    `json:"work_id,omitempty"` // Work whose notes are edited
+	Notes       string    `json:"notes,omitempty"`
+}
+
+// beadFormDraft captures the bead form's values as a draft
+func beadFormDraft(r BeadFormResult) *dialogDraft {
+	return &dialogDraft{
+		Kind:        draftKindBead,
+		EditBeadID:  r.EditBeadID,
+		ParentID:    r.ParentID,
+		Title:       r.Title,
+		Description: r.Description,
+		BeadType:    r.BeadType,
+		Priority:    r.Priority,
+		Status:      r.Status,
+		PrevStatus:  r.PrevStatus,
+		Labels:      r.Labels,
+		PrevLabels:  r.PrevLabels,
+		BlockedBy:   r.BlockedBy,
+	}
+}
+
+// beadFormResult returns the bead form values saved in a draft
+func (d *dialogDraft) beadFormResult() BeadFormResult {
+	return BeadFormResult{
+		Title:       d.Title,
+		Description: d.Description,
+		BeadType:    d.BeadType,
+		Priority:    d.Priority,
+		Status:      d.Status,
+		PrevStatus:  d.PrevStatus,
+		Labels:      d.Labels,
+		PrevLabels:  d.PrevLabels,
+		EditBeadID:  d.EditBeadID,
+		ParentID:    d.ParentID,
+		BlockedBy:   d.BlockedBy,
+	}
+}
+
+// empty reports whether the draft holds nothing worth restoring
+func (d *dialogDraft) empty() bool {
+	if d.Kind == draftKindWorkNotes {
+		return d.Notes == ""
+	}
+	return d.Title == "" && d.Description == ""
+}
+
+// sameContent reports whether two drafts hold the same dialog content
+func (d *dialogDraft) sameContent(other *dialogDraft) bool {
+	if other == nil {
+		return false
+	}
+	return d.Kind == other.Kind && d.EditBeadID == other.EditBeadID && d.ParentID == other.ParentID &&
+		d.Title == other.Title && d.Description == other.Description && d.BeadType == other.BeadType &&
+		d.Priority == other.Priority && d.Status == other.Status && slices.Equal(d.Labels, other.Labels) &&
+		slices.Equal(d.BlockedBy, other.BlockedBy) && d.WorkID == other.WorkID && d.Notes == other.Notes
+}
+
+// describe says which dialog the draft belongs to
+func (d *dialogDraft) describe() string {
+	switch {
+	case d.Kind == draftKindWorkNotes:
+		return "notes for " + d.WorkID
+	case d.EditBeadID != "":
+		return "edit of " + d.EditBeadID
+	case d.ParentID != "":
+		return "new child issue of " + d.ParentID
+	}
+	return "new issue"
+}
+
+// loadDialogDraft reads the draft file, returning nil if there is no draft
+// or it can't be read
+func loadDialogDraft(root string) *dialogDraft {
+	data, err := os.ReadFile(filepath.Join(root, project.ConfigDir, tuiDraftFile))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logging.Debug("loadDialogDraft failed", "error", err)
+		}
+		return nil
+	}
+	var draft dialogDraft
+	if err := json.Unmarshal(data, &draft); err != nil {
+		logging.Debug("loadDialogDraft ignored invalid draft file", "error", err)
+		return nil
+	}
+	if draft.Kind != draftKindBead && draft.Kind != draftKindWorkNotes || draft.empty() {
+		return nil
+	}
+	return &draft
+}
+
+// saveDialogDraft writes the draft file
+func saveDialogDraft(root string, draft *dialogDraft) error {
+	data, err := json.MarshalIndent(draft, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode dialog draft: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, project.ConfigDir, tuiDraftFile), data, 0644); err != nil {
+		return fmt.Errorf("failed to write dialog draft: %w", err)
+	}
+	return nil
+}
+
+// clearDialogDraft removes the draft file
+func clearDialogDraft(root string) error {
+	if err := os.Remove(filepath.Join(root, project.ConfigDir, tuiDraftFile)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove dialog draft: %w", err)
+	}
+	return nil
+}
+
+// currentDraft captures the content of the open draft-keeping dialog, or
+// returns nil if none is open
+func (m *planModel) currentDraft() *dialogDraft {
+	switch m.viewMode {
+	case ViewCreateBead, ViewCreateBeadInline, ViewAddChildBead, ViewEditBead:
+		return beadFormDraft(m.beadFormPanel.GetResult())
+	case ViewWorkNotes:
+		return &dialogDraft{Kind: draftKindWorkNotes, WorkID: m.workNotes.workID, Notes: m.workNotes.Value()}
+	}
+	return nil
+}
+
+// noteDraftKey counts a key press in a draft-keeping dialog and saves the
+// draft every few of them, when its content changed
+func (m *planModel) noteDraftKey() {
+	if m.readOnly || m.secondary() || m.proj == nil {
+		return
+	}
+	m.draftKeys++
+	if m.draftKeys%draftSaveEvery != 0 {
+		return
+	}
+	draft := m.currentDraft()
+	if draft == nil || draft.sameContent(m.savedDraft) {
+		return
+	}
+	draft.SavedAt = time.Now()
+	if draft.empty() {
+		// Nothing typed yet, or all of it deleted again
+		m.discardDraft()
+		return
+	}
+	if err := saveDialogDraft(m.proj.Root, draft); err != nil {
+		logging.Debug("noteDraftKey failed", "error", err)
+		return
+	}
+	m.savedDraft = draft
+}
+
+// discardDraft forgets the saved draft, after its dialog was submitted or
+// cancelled
+func (m *planModel) discardDraft() {
+	m.draftKeys = 0
+	m.savedDraft = nil
+	if m.readOnly || m.secondary() || m.proj == nil {
+		return
+	}
+	if err := clearDialogDraft(m.proj.Root); err != nil {
+		logging.Debug("discardDraft failed", "error", err)
+	}
+}
+
+// updateDraftRestore handles the offer to restore a draft left by a previous
+// session
+func (m *planModel) updateDraftRestore(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	draft := m.pendingDraft
+	switch msg.String() {
+	case "y", "enter":
+		m.pendingDraft = nil
+		return m, m.restoreDraft(draft)
+	case "n", "d":
+		m.pendingDraft = nil
+		m.viewMode = ViewNormal
+		m.discardDraft()
+		m.statusMessage = "Discarded the unsaved " + draft.describe()
+		m.statusIsError = false
+	case "esc":
+		// Kept on disk: offered again next time unless another dialog replaces it
+		m.pendingDraft = nil
+		m.viewMode = ViewNormal
+	}
+	return m, nil
+}
+
+// restoreDraft reopens the dialog a draft was saved from with its content
+func (m *planModel) restoreDraft(draft *dialogDraft) tea.Cmd {
+	m.savedDraft = draft
+	if draft.Kind == draftKindWorkNotes {
+		m.workNotes = newWorkNotesEditor(m.theme, draft.WorkID, draft.Notes)
+		m.viewMode = ViewWorkNotes
+		return textarea.Blink
+	}
+	m.beadFormPanel.RestoreDraft(draft.beadFormResult())
+	m.beadFormPanel.SetBeadCandidates(m.beadItems)
+	switch {
+	case draft.EditBeadID != "":
+		m.viewMode = ViewEditBead
+	case draft.ParentID != "":
+		m.viewMode = ViewAddChildBead
+	default:
+		m.viewMode = ViewCreateBeadInline
+	}
+	return m.beadFormPanel.Init()
+}
+
+func (m *planModel) renderDraftRestoreContent() string {
+	draft := m.pendingDraft
+	var b strings.Builder
+	b.WriteString(m.theme.Title.Render("Restore unsaved draft?") + "\n\n")
+	fmt.Fprintf(&b, "  The TUI closed while the %s was open.\n", draft.describe())
+	fmt.Fprintf(&b, "  %s\n\n", m.theme.Dim.Render("Saved "+draft.SavedAt.Local().Format("2006-01-02 15:04")))
+	preview := draft.Title
+	if draft.Kind == draftKindWorkNotes {
+		preview = draft.Notes
+	}
+	if preview = strings.TrimSpace(preview); preview != "" {
+		lines := strings.Split(wrapText(preview, 60), "\n")
+		if len(lines) > 3 {
+			lines = append(lines[:3], "…")
+		}
+		for _, line := range lines {
+			b.WriteString("  " + m.theme.Value.Render(line) + "\n")
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(m.theme.styleHotkeys("  [y] Restore  [n] Discard  [Esc] Later"))
+	return m.theme.Dialog.Render(b.String())
+}
@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/logging"
+	workpkg "github.com/newhook/co/internal/work"
+)
+
+// submittedDialog is a dialog that closed to run its command. If the command
+// is rejected as invalid, the dialog opens again as it was, with the reason
+// in its body, so the input can be fixed rather than entered again.
+type submittedDialog struct {
+	view       ViewMode
+	action, id string              // What the command's error is reported with
+	beadIDs    []string            // ViewAssignBeads: the issues being added
+	plan       *workpkg.PlanResult // ViewPlanReview: the plan being applied
+}
+
+// commandSucceeded forgets the submitted dialog of a command that worked
+func (m *planModel) commandSucceeded(action, id string) {
+	if d := m.submittedDialog; d != nil && d.action == action && d.id == id {
+		m.submittedDialog = nil
+	}
+}
+
+// showCommandError reports a failed work command according to its error's
+// kind, and returns the refresh of what the failure may have changed. A
+// validation error from a dialog's command opens the dialog again with the
+// message in it; nothing changed, so nothing is refreshed. A work, task or
+// bead that no longer exists gets no message: the refresh drops it from the
+// view. Failures of git, zellij and other external tools open the error
+// overlay, and so do spawn failures that carry captured output. Everything
+// else, including conflicts, goes to the status bar.
+func (m *planModel) showCommandError(action, id string, err error, se *spawnError) tea.Cmd {
+	if d := m.submittedDialog; d != nil && d.action == action && d.id == id {
+		m.submittedDialog = nil
+		if errors.Is(err, coerrors.Validation) && m.reopenDialog(d, err.Error()) {
+			return nil
+		}
+	}
+
+	refresh := tea.Batch(m.refreshData(), m.loadWorkTiles())
+	if errors.Is(err, coerrors.NotFound) {
+		logging.Debug("command target no longer exists", "action", action, "id", id, "error", err)
+		m.statusMessage = ""
+		m.statusIsError = false
+		return refresh
+	}
+
+	m.statusMessage = fmt.Sprintf("%s failed: %v", action, err)
+	m.statusIsError = true
+	if errors.Is(err, coerrors.Validation) || errors.Is(err, coerrors.Conflict) {
+		return refresh
+	}
+	if se == nil && errors.Is(err, coerrors.ExternalTool) {
+		se = &spawnError{action: action, id: id, err: err}
+	}
+	m.showSpawnError(se)
+	return refresh
+}
+
+// reopenDialog opens a submitted dialog again, showing why its command was
+// rejected. It doesn't once the user has moved on to another dialog or work.
+func (m *planModel) reopenDialog(d *submittedDialog, reason string) bool {
+	if m.viewMode != m.dialogReturnView() {
+		return false
+	}
+	switch d.view {
+	case ViewCreateWork:
+		m.createWorkPanel.SetError(reason)
+		// A work created from triage still belongs to the issue being triaged
+		if m.triage != nil {
+			m.triage.creatingFor = d.id
+		}
+	case ViewAssignBeads:
+		if m.focusedWorkID != d.id {
+			return false
+		}
+		m.openAssignBeadsConfirm(d.beadIDs)
+		m.assignBeadsErr = reason
+	case ViewPlanReview:
+		m.planReview = &planReview{plan: d.plan, err: reason}
+	}
+	m.viewMode = d.view
+	m.statusMessage = ""
+	m.statusIsError = false
+	return true
+}
@@ -0,0 +1,119 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/logging"
+	"github.com/newhook/co/internal/task"
+)
+
+// beadEstimatesLoadedMsg carries the cached complexity estimate of every bead
+type beadEstimatesLoadedMsg struct {
+	estimates map[string]db.ComplexityEstimate
+	err       error
+}
+
+// loadBeadEstimates reads the cached complexity estimates, stale or not
+func (m *planModel) loadBeadEstimates() tea.Cmd {
+	return func() tea.Msg {
+		estimates, err := m.proj.DB.ListComplexityEstimates(m.ctx)
+		return beadEstimatesLoadedMsg{estimates: estimates, err: err}
+	}
+}
+
+// beadsEstimatedMsg carries the outcome of estimating issues from plan mode
+type beadsEstimatedMsg struct {
+	beadIDs []string
+	scores  map[string]int // beadID -> complexity score, for the issues estimated
+	err     error          // First failure, if any issue couldn't be estimated
+	failed  int
+}
+
+// estimateBeads estimates the complexity of the selected issues, or the one
+// under the cursor, in the background. The estimates are cached where the
+// orchestrator's estimation tasks cache theirs, so a work created from the
+// issues later doesn't estimate them again.
+func (m *planModel) estimateBeads() tea.Cmd {
+	var targets []beads.Bead
+	for _, item := range m.beadItems {
+		if m.selectedBeads[item.ID] && !m.estimatingBeads[item.ID] {
+			targets = append(targets, *item.Bead)
+		}
+	}
+	if len(targets) == 0 && len(m.selectedBeads) == 0 && m.beadsCursor < len(m.beadItems) {
+		if item := m.beadItems[m.beadsCursor]; !m.estimatingBeads[item.ID] {
+			targets = []beads.Bead{*item.Bead}
+		}
+	}
+	if len(targets) == 0 {
+		m.statusMessage = "Already estimating"
+		m.statusIsError = false
+		return nil
+	}
+
+	beadIDs := make([]string, len(targets))
+	for i, bead := range targets {
+		beadIDs[i] = bead.ID
+		m.estimatingBeads[bead.ID] = true
+	}
+	if len(targets) == 1 {
+		m.statusMessage = fmt.Sprintf("Estimating %s...", targets[0].ID)
+	} else {
+		m.statusMessage = fmt.Sprintf("Estimating %d issues...", len(targets))
+	}
+	m.statusIsError = false
+
+	ctx, database, estimate := m.ctx, m.proj.DB, m.estimateBead
+	return func() tea.Msg {
+		msg := beadsEstimatedMsg{beadIDs: beadIDs, scores: make(map[string]int)}
+		for _, bead := range targets {
+			score, _, err := task.EstimateAndCache(ctx, database, bead, estimate)
+			if err != nil {
+				logging.Warn("estimateBeads failed", "beadID", bead.ID, "error", err)
+				if msg.err == nil {
+					msg.err = err
+				}
+				msg.failed++
+				continue
+			}
+			msg.scores[bead.ID] = score
+		}
+		return msg
+	}
+}
+
+// handleBeadsEstimated reports an estimate and reloads the cached estimates
+func (m *planModel) handleBeadsEstimated(msg beadsEstimatedMsg) tea.Cmd {
+	for _, id := range msg.beadIDs {
+		delete(m.estimatingBeads, id)
+	}
+	switch {
+	case msg.err != nil && len(msg.scores) == 0:
+		m.statusMessage = fmt.Sprintf("Estimate failed: %v", msg.err)
+		m.statusIsError = true
+	case msg.err != nil:
+		m.statusMessage = fmt.Sprintf("Estimated %d issue(s), %d failed: %v", len(msg.scores), msg.failed, msg.err)
+		m.statusIsError = true
+	case len(msg.beadIDs) == 1:
+		id := msg.beadIDs[0]
+		m.statusMessage = fmt.Sprintf("Estimated %s: complexity %d/10", id, msg.scores[id])
+		m.statusIsError = false
+	default:
+		scores := make([]string, 0, len(msg.beadIDs))
+		for _, id := range msg.beadIDs {
+			scores = append(scores, fmt.Sprintf("%s %d", id, msg.scores[id]))
+		}
+		m.statusMessage = "Estimated " + strings.Join(scores, ", ")
+		m.statusIsError = false
+	}
+	return m.loadBeadEstimates()
+}
+
+// formatEstimateTokens renders an estimate's context tokens in thousands
+func formatEstimateTokens(tokens int) string {
+	return fmt.Sprintf("~%dK tokens", (tokens+500)/1000)
+}
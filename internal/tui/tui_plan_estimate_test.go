@@ -0,0 +1,61 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanFlowEstimateIssues(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+	var estimated []string
+	m.estimateBead = func(ctx context.Context, bead beads.Bead) (int, int, error) {
+		estimated = append(estimated, bead.ID)
+		if bead.ID == "bead-3" {
+			return 0, 0, errors.New("claude not found")
+		}
+		return 4, 20000, nil
+	}
+	m.beadsExpanded = true
+
+	// The issue under the cursor is estimated in the background
+	cmd := press(m, "$")
+	require.Equal(t, "Estimating bead-1...", m.statusMessage)
+	require.Contains(t, ansi.Strip(m.View()), "[P2 task ~…]")
+	m.Update(cmd())
+	require.Equal(t, []string{"bead-1"}, estimated)
+	require.Equal(t, "Estimated bead-1: complexity 4/10", m.statusMessage)
+	require.Empty(t, m.estimatingBeads)
+
+	// Reloading the cache shows the score in the list and the details panel
+	m.Update(m.loadBeadEstimates()())
+	view := ansi.Strip(m.View())
+	require.Contains(t, view, "[P2 task ~4]")
+	require.Contains(t, view, "Estimate: complexity 4/10 · ~20K tokens")
+
+	// Once the issue changes, its estimate is stale until estimated again
+	m.beadItems[0].Title = "Fix login and signup"
+	view = ansi.Strip(m.View())
+	require.Contains(t, view, "[P2 task ~4?]")
+	require.Contains(t, view, "stale: issue changed")
+
+	// A selection is estimated as a whole, reporting the failures
+	press(m, "j", " ", "j", " ")
+	estimated = nil
+	m.Update(press(m, "$")())
+	require.Equal(t, []string{"bead-2", "bead-3"}, estimated)
+	require.True(t, m.statusIsError)
+	require.Contains(t, m.statusMessage, "Estimated 1 issue(s), 1 failed")
+	estimates, err := h.DB.ListComplexityEstimates(context.Background())
+	require.NoError(t, err)
+	require.Contains(t, estimates, "bead-2")
+	require.NotContains(t, estimates, "bead-3")
+}
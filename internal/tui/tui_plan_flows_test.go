@@ -0,0 +1,2002 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/beads"
+	beadswatcher "github.com/newhook/co/internal/beads/watcher"
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/git"
+	"github.com/newhook/co/internal/github"
+	"github.com/newhook/co/internal/progress"
+	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/task"
+	"github.com/newhook/co/internal/testutil"
+	trackingwatcher "github.com/newhook/co/internal/tracking/watcher"
+	workpkg "github.com/newhook/co/internal/work"
+	"github.com/newhook/co/internal/worktree"
+	"github.com/stretchr/testify/require"
+)
+
+// newFlowTestModel builds a plan model wired to the harness's tracking DB and
+// mocked work service, without watchers, zellij or a beads database.
+func newFlowTestModel(t *testing.T, h *testutil.TestHarness) *planModel {
+	t.Helper()
+	theme := DarkTheme()
+	m := &planModel{
+		ctx:                    context.Background(),
+		proj:                   &project.Project{Root: t.TempDir(), Config: h.Config, DB: h.DB},
+		theme:                  theme,
+		workService:            h.WorkService,
+		width:                  120,
+		height:                 40,
+		activePanel:            PanelLeft,
+		textInput:              textinput.New(),
+		activeBeadSessions:     make(map[string]bool),
+		selectedBeads:          make(map[string]bool),
+		estimatingBeads:        make(map[string]bool),
+		pendingWorkSelectIndex: -1,
+		workDetailsFocusLeft:   true,
+		filters:                beadFilters{status: "open", sortBy: "default"},
+		beadItems: []beadItem{
+			testBeadItem("bead-1", "Fix login", "open", 2, "task"),
+			testBeadItem("bead-2", "Add logout", "open", 2, "task"),
+			testBeadItem("bead-3", "Write docs", "open", 2, "task"),
+		},
+	}
+	m.statusBar = NewStatusBar(theme)
+	m.issuesPanel = NewIssuesPanel(theme)
+	m.detailsPanel = NewIssueDetailsPanel(theme)
+	m.workDetails = NewWorkDetailsPanel(theme)
+	m.workTabsBar = NewWorkTabsBar(theme)
+	m.linearImportPanel = NewLinearImportPanel(theme)
+	m.prImportPanel = NewPRImportPanel(theme)
+	m.beadFormPanel = NewBeadFormPanel(theme)
+	m.createWorkPanel = NewCreateWorkPanel(theme)
+	return m
+}
+
+// press sends one key to the model the way bubbletea would
+func press(m *planModel, keys ...string) tea.Cmd {
+	var cmd tea.Cmd
+	for _, k := range keys {
+		var msg tea.KeyMsg
+		switch k {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case "tab":
+			msg = tea.KeyMsg{Type: tea.KeyTab}
+		case "ctrl+s":
+			msg = tea.KeyMsg{Type: tea.KeyCtrlS}
+		case "ctrl+x":
+			msg = tea.KeyMsg{Type: tea.KeyCtrlX}
+		case "ctrl+o":
+			msg = tea.KeyMsg{Type: tea.KeyCtrlO}
+		case "ctrl+t":
+			msg = tea.KeyMsg{Type: tea.KeyCtrlT}
+		case " ":
+			msg = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+		case "pgup":
+			msg = tea.KeyMsg{Type: tea.KeyPgUp}
+		case "pgdown":
+			msg = tea.KeyMsg{Type: tea.KeyPgDown}
+		case "end":
+			msg = tea.KeyMsg{Type: tea.KeyEnd}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		}
+		_, cmd = m.Update(msg)
+	}
+	return cmd
+}
+
+// focusWork makes workID the focused work through the created-work flow
+func focusWork(t *testing.T, m *planModel, w *db.Work) {
+	t.Helper()
+	m.Update(planWorkCreatedMsg{beadID: "bead-1", workID: w.ID, focus: true})
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{{Work: w}}})
+	require.Equal(t, w.ID, m.focusedWorkID)
+	require.Equal(t, PanelWorkDetails, m.activePanel)
+}
+
+func TestPlanFlowAddSelectedIssuesToWork(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+
+	// Back in the issues panel, space selects the first two issues
+	m.activePanel = PanelLeft
+	press(m, " ", "j", " ")
+	require.Equal(t, map[string]bool{"bead-1": true, "bead-2": true}, m.selectedBeads)
+
+	require.Nil(t, press(m, "A"))
+	require.Equal(t, ViewAssignBeads, m.viewMode)
+	require.Contains(t, m.View(), "Add 2 issue(s) to w-abc?")
+
+	cmd := press(m, "enter")
+	require.NotNil(t, cmd)
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.Empty(t, m.selectedBeads, "selection is cleared once the issues are sent")
+
+	msg := cmd()
+	require.IsType(t, beadAddedToWorkMsg{}, msg)
+	require.NoError(t, msg.(beadAddedToWorkMsg).err)
+
+	workBeads, err := h.DB.GetWorkBeads(context.Background(), "w-abc")
+	require.NoError(t, err)
+	var ids []string
+	for _, wb := range workBeads {
+		ids = append(ids, wb.BeadID)
+	}
+	require.ElementsMatch(t, []string{"bead-1", "bead-2"}, ids)
+
+	m.Update(msg)
+	require.False(t, m.statusIsError)
+	require.Contains(t, m.statusMessage, "Added bead-1, bead-2 to work w-abc")
+}
+
+func TestPlanFlowAssignAndRun(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	h.CreateBead("bead-1", "Fix login")
+	h.CreateBead("bead-2", "Add logout")
+	h.CreateBead("bead-3", "Write docs")
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+	m.activePanel = PanelLeft
+
+	// Esc adds nothing
+	press(m, "A")
+	require.Nil(t, press(m, "esc"))
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	// The worktree is missing, so the run fails but the issue stays added
+	press(m, "A")
+	cmd := press(m, "r")
+	require.NotNil(t, cmd)
+	m.Update(cmd())
+	require.True(t, m.statusIsError)
+	require.Contains(t, m.statusMessage, "Run work failed: bead-1 stay in the work")
+	workBeads, err := h.DB.GetWorkBeads(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Len(t, workBeads, 1)
+	m.viewMode = ViewNormal
+
+	// r adds and runs with one task per issue
+	h.Worktree.ExistsPathFunc = func(string) bool { return true }
+	press(m, "j", "A")
+	m.Update(press(m, "r")())
+	require.False(t, m.statusIsError, m.statusMessage)
+	require.Contains(t, m.statusMessage, "Assigned 1 issue(s), created 2 task(s), orchestrator spawned")
+	tasks, err := h.DB.GetWorkTasks(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Len(t, tasks, 2, "the issue left over from the failed run gets its task too")
+}
+
+func TestPlanFlowDestroyWork(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+
+	// d in the issues panel must not reach the destroy flow
+	m.activePanel = PanelLeft
+	require.Nil(t, press(m, "d"))
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	// From the work details it asks first, and n backs out
+	m.activePanel = PanelWorkDetails
+	press(m, "d")
+	require.Equal(t, ViewDestroyConfirm, m.viewMode)
+	require.Nil(t, press(m, "n"))
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	// The dialog lists exactly what goes once the plan has loaded
+	press(m, "d")
+	m.Update(m.loadDestructionPlan("w-abc")())
+	dialog := m.renderDestroyConfirmContent()
+	require.Contains(t, dialog, "Remove worktree: "+w.WorktreePath)
+	require.Contains(t, dialog, "Keep branch: feat/abc")
+	require.NotNil(t, press(m, "y"), "confirming schedules the destroy")
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	// A processing work can't be destroyed
+	w.Status = db.StatusProcessing
+	m.workDetails.SetFocusedWork(&progress.WorkProgress{Work: w})
+	press(m, "d")
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.True(t, m.statusIsError)
+	require.Contains(t, m.statusMessage, "currently processing")
+}
+
+func TestPlanFlowActionsResolveWorkWhenRun(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	w := h.CreateWork("w-abc", "feat/abc")
+	neighbor := h.CreateWork("w-def", "feat/def")
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+
+	// refresh stands in for a watcher event landing between the key press
+	// and the command running: w-abc is destroyed elsewhere and its neighbor
+	// takes its place in the tiles
+	refresh := func() {
+		t.Helper()
+		require.NoError(t, h.DB.DeleteWork(ctx, "w-abc"))
+		m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{{Work: neighbor}}})
+	}
+
+	press(m, "d")
+	require.Equal(t, ViewDestroyConfirm, m.viewMode)
+	review := press(m, "esc", "v")
+	require.NotNil(t, review)
+	press(m, "d")
+	refresh()
+	destroy := press(m, "y")
+	require.NotNil(t, destroy)
+
+	for _, cmd := range []tea.Cmd{review, destroy} {
+		msg := cmd()
+		require.IsType(t, workCommandMsg{}, msg)
+		require.Equal(t, "w-abc", msg.(workCommandMsg).workID)
+		require.ErrorContains(t, msg.(workCommandMsg).err, "work w-abc no longer exists")
+		require.ErrorIs(t, msg.(workCommandMsg).err, coerrors.NotFound)
+		// The refresh drops the work; there's nothing to report
+		m.Update(msg)
+		require.False(t, m.statusIsError)
+	}
+
+	// Nothing was scheduled or created for the neighbor
+	scheduled, err := h.DB.GetScheduledTasksForWork(ctx, "w-def")
+	require.NoError(t, err)
+	require.Empty(t, scheduled)
+	tasks, err := h.DB.GetWorkTasks(ctx, "w-def")
+	require.NoError(t, err)
+	require.Empty(t, tasks)
+}
+
+func TestPlanFlowPollsWithoutWatchers(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+	m.workRefresh = 2 * time.Second
+	m.planRefresh = 10 * time.Second
+
+	// With no watchers running the help shows both databases being polled
+	help := m.refreshHelp()
+	require.Contains(t, help, "Issues        every 10s")
+	require.Contains(t, help, "Works         every 2s")
+
+	// A poll reloads the issues and schedules the next one
+	_, cmd := m.Update(issuesPollMsg{})
+	require.NotNil(t, cmd)
+	batch, ok := cmd().(tea.BatchMsg)
+	require.True(t, ok)
+	require.Len(t, batch, 2)
+
+	// The health check reloads the works too
+	_, cmd = m.Update(orchestratorHealthMsg{})
+	require.NotNil(t, cmd)
+	batch, ok = cmd().(tea.BatchMsg)
+	require.True(t, ok)
+	require.Len(t, batch, 2)
+}
+
+func TestPlanFlowChecklistImport(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+	m.activePanel = PanelLeft
+
+	press(m, "B")
+	require.Equal(t, ViewChecklistImport, m.viewMode)
+	require.Nil(t, press(m, "ctrl+s"), "nothing to create yet")
+	require.Equal(t, ViewChecklistImport, m.viewMode)
+
+	m.checklistImport.textarea.SetValue("## Backend\n- [ ] Add endpoint\n- [x] Pick a library\n")
+	out := ansi.Strip(m.View())
+	require.Contains(t, out, "1 issue(s) to create, 1 heading(s) as labels, 1 checked item(s) skipped")
+	press(m, "ctrl+t")
+	require.Contains(t, ansi.Strip(m.View()), "1 heading(s) as epics")
+
+	require.NotNil(t, press(m, "ctrl+s"), "creating the issues")
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.Nil(t, m.checklistImport)
+
+	m.Update(checklistImportedMsg{created: []beads.ImportedBead{{ID: "bd-1"}}, err: errors.New("bd failed")})
+	require.True(t, m.statusIsError)
+	require.Contains(t, m.statusMessage, "Created 1 issue(s) from the checklist, then failed: bd failed")
+}
+
+func TestPlanFlowEditWorkNotes(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	w := h.CreateWork("w-abc", "feat/abc")
+	require.NoError(t, h.DB.SetWorkNotes(ctx, "w-abc", "Waiting on design review"))
+	w, err := h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+
+	// The editor opens pre-filled; Esc throws the edit away
+	press(m, "N")
+	require.Equal(t, ViewWorkNotes, m.viewMode)
+	require.Equal(t, "Waiting on design review", m.workNotes.Value())
+	press(m, "!", "esc")
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.Nil(t, m.workNotes)
+
+	press(m, "N", "enter", "Spec: docs/login.md")
+	cmd := press(m, "ctrl+s")
+	require.NotNil(t, cmd)
+	require.Equal(t, ViewNormal, m.viewMode)
+	msg := cmd()
+	require.NoError(t, msg.(workCommandMsg).err)
+	m.Update(msg)
+	require.Contains(t, m.statusMessage, "Save notes completed for w-abc")
+
+	w, err = h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Equal(t, "Waiting on design review\nSpec: docs/login.md", w.Notes)
+
+	// The summary shows the first lines of the notes
+	summary := NewWorkSummaryPanel(DarkTheme())
+	summary.SetSize(60, 40)
+	summary.SetFocusedWork(&progress.WorkProgress{Work: w})
+	content := summary.renderFullContent(60)
+	require.Contains(t, content, "Notes:\n  Waiting on design review\n  Spec: docs/login.md\n")
+}
+
+func TestPlanFlowEditWorkEnv(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	w := h.CreateWork("w-abc", "feat/abc")
+	require.NoError(t, h.DB.SetWorkEnv(ctx, "w-abc", []string{"PORT=3001"}))
+	w, err := h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+
+	press(m, "E")
+	require.Equal(t, ViewWorkEnv, m.viewMode)
+	env, err := m.workEnv.Value()
+	require.NoError(t, err)
+	require.Equal(t, []string{"PORT=3001"}, env)
+
+	// A malformed line keeps the editor open
+	press(m, "enter", "not an assignment")
+	require.Nil(t, press(m, "ctrl+s"))
+	require.Equal(t, ViewWorkEnv, m.viewMode)
+	require.Contains(t, m.workEnv.err, "expected KEY=value")
+
+	for range len("not an assignment") {
+		press(m, "backspace")
+	}
+	press(m, "DEBUG=1")
+	cmd := press(m, "ctrl+s")
+	require.NotNil(t, cmd)
+	require.Equal(t, ViewNormal, m.viewMode)
+	msg := cmd()
+	require.NoError(t, msg.(workCommandMsg).err)
+
+	w, err = h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Equal(t, []string{"PORT=3001", "DEBUG=1"}, w.Env)
+
+	// The summary lists the overridden keys, not their values
+	summary := NewWorkSummaryPanel(DarkTheme())
+	summary.SetSize(60, 40)
+	summary.SetFocusedWork(&progress.WorkProgress{Work: w})
+	content := summary.renderFullContent(60)
+	require.Contains(t, content, "Env overrides: PORT, DEBUG")
+	require.NotContains(t, content, "3001")
+}
+
+func TestPlanFlowRunWarnsWhenAllBeadsBlocked(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{{Work: w, UnassignedBeads: []progress.BeadProgress{
+		{ID: "bead-1", BlockedBy: []string{"ext-9"}},
+		{ID: "bead-2", BlockedBy: []string{"ext-9", "ext-10"}},
+	}}}})
+	m.Update(workCommandMsg{action: "Run work", workID: "w-abc"})
+	require.Contains(t, m.statusMessage, "every bead is blocked, by ext-9, ext-10")
+
+	// One ready bead is enough to make progress
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{{Work: w, UnassignedBeads: []progress.BeadProgress{
+		{ID: "bead-1", BlockedBy: []string{"ext-9"}},
+		{ID: "bead-2"},
+	}}}})
+	m.Update(workCommandMsg{action: "Run work", workID: "w-abc"})
+	require.NotContains(t, m.statusMessage, "blocked")
+}
+
+func TestPlanFlowCompleteWorkShowsStepOutput(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	h.CreateWork("w-abc", "feat/abc")
+	require.NoError(t, h.DB.IdleWork(ctx, "w-abc"))
+	h.Worktree.RemoveForceFunc = func(ctx context.Context, repoPath, worktreePath string) error {
+		return errors.New("worktree is locked")
+	}
+
+	m := newFlowTestModel(t, h)
+	msg := m.completeWork("w-abc", workpkg.CompleteWorkOptions{SkipPRCheck: true, KeepBeads: true})()
+	m.Update(msg)
+
+	// The steps that ran are shown along with the one that failed
+	require.Equal(t, ViewSpawnError, m.viewMode)
+	view := m.View()
+	require.Contains(t, view, "Keeping beads open")
+	require.Contains(t, view, "worktree is locked")
+}
+
+func TestPlanFlowCommandErrorKinds(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+
+	// Bad input stays in the status bar
+	m.Update(workCommandMsg{action: "Run work", workID: "w-abc", err: coerrors.Errorf(coerrors.Validation, "work w-abc has no worktree path configured")})
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.True(t, m.statusIsError)
+	require.Contains(t, m.statusMessage, "no worktree path configured")
+
+	// External tool failures open the error overlay even without captured output
+	m.Update(workCommandMsg{action: "Destroy work scheduled", workID: "w-abc", err: coerrors.Wrap(coerrors.ExternalTool, errors.New("zellij: session not found"))})
+	require.Equal(t, ViewSpawnError, m.viewMode)
+	require.Contains(t, m.View(), "zellij: session not found")
+	press(m, "esc")
+
+	// A work that is already gone is dropped quietly
+	_, cmd := m.Update(workCommandMsg{action: "Run work", workID: "w-abc", err: coerrors.Errorf(coerrors.NotFound, "work w-abc no longer exists")})
+	require.NotNil(t, cmd)
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.False(t, m.statusIsError)
+	require.Empty(t, m.statusMessage)
+	require.Empty(t, m.focusedWorkID)
+}
+
+func TestPlanFlowValidationErrorKeepsDialogOpen(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+	rejected := coerrors.Errorf(coerrors.Validation, "bead-9 is closed")
+
+	m := newFlowTestModel(t, h)
+
+	// Create work: the form comes back as it was, with the reason in it
+	press(m, "w")
+	require.Equal(t, ViewCreateWork, m.viewMode)
+	press(m, "tab", "tab", "tab")
+	require.NotNil(t, press(m, "enter"))
+	require.Equal(t, ViewNormal, m.viewMode)
+	_, cmd := m.Update(planWorkCreatedMsg{beadID: "bead-1", err: rejected})
+	require.Nil(t, cmd, "nothing changed, so nothing is refreshed")
+	require.Equal(t, ViewCreateWork, m.viewMode)
+	require.Contains(t, m.View(), "bead-9 is closed")
+	require.Equal(t, "bead-1", m.createWorkPanel.GetBeadID())
+	press(m, "esc")
+
+	// Add issues: the same issues wait on the confirmation again
+	focusWork(t, m, w)
+	m.activePanel = PanelLeft
+	press(m, " ", "j", " ", "A", "enter")
+	require.Equal(t, ViewNormal, m.viewMode)
+	m.Update(beadAddedToWorkMsg{beadID: "bead-1, bead-2", workID: "w-abc", err: rejected})
+	require.Equal(t, ViewAssignBeads, m.viewMode)
+	require.Equal(t, []string{"bead-1", "bead-2"}, m.assignBeadIDs)
+	require.Contains(t, m.View(), "bead-9 is closed")
+	press(m, "esc")
+
+	// Plan review: the edited plan is back for another try
+	plan := &workpkg.PlanResult{WorkID: "w-abc", Groups: []workpkg.PlanGroup{{Name: "Auth", Beads: []workpkg.PlanBead{{ID: "bead-1", Title: "Fix login"}}}}}
+	m.Update(planPreviewMsg{workID: "w-abc", plan: plan})
+	require.Equal(t, ViewPlanReview, m.viewMode)
+	press(m, "enter")
+	require.Equal(t, ViewNormal, m.viewMode)
+	m.Update(workCommandMsg{action: "Run work", workID: "w-abc", err: rejected})
+	require.Equal(t, ViewPlanReview, m.viewMode)
+	require.Same(t, plan, m.planReview.plan)
+	require.Contains(t, m.View(), "bead-9 is closed")
+	press(m, "esc")
+
+	// Once the dialog's command is done with, its errors go to the status bar
+	m.Update(workCommandMsg{action: "Run work", workID: "w-abc", err: rejected})
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.Contains(t, m.statusMessage, "bead-9 is closed")
+}
+
+func TestPlanFlowProblemsFilter(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ok := h.CreateWork("w-ok", "feat/ok")
+	failed := h.CreateWork("w-fail", "feat/fail")
+	dead := h.CreateWork("w-dead", "feat/dead")
+	dead.Status = db.StatusProcessing
+
+	m := newFlowTestModel(t, h)
+	m.columnRatio = 0.4
+	m.Update(workTilesLoadedMsg{
+		works: []*progress.WorkProgress{
+			{Work: ok, Tasks: []*progress.TaskProgress{{Task: &db.Task{ID: "w-ok.1", Status: db.StatusCompleted}}}},
+			{Work: failed, Tasks: []*progress.TaskProgress{
+				{Task: &db.Task{ID: "w-fail.1", Status: db.StatusCompleted}},
+				{Task: &db.Task{ID: "w-fail.2", Status: db.StatusFailed}},
+			}},
+			{Work: dead},
+		},
+		orchestratorHealth: map[string]db.OrchestratorHealth{"w-ok": db.OrchestratorRunning, "w-fail": db.OrchestratorRunning, "w-dead": db.OrchestratorDown},
+	})
+
+	// The badge counts problem works while the filter is off
+	require.False(t, m.problemsOnly)
+	m.syncPanels()
+	require.Contains(t, m.statusBar.Render(), "⚠ 2")
+
+	press(m, "F")
+	require.True(t, m.problemsOnly)
+	var ids []string
+	for _, wp := range m.visibleWorkTiles() {
+		ids = append(ids, wp.Work.ID)
+	}
+	require.Equal(t, []string{"w-fail", "w-dead"}, ids)
+	m.workTabsBar.SetSize(200)
+	require.NotContains(t, m.workTabsBar.Render(), "w-ok")
+
+	// Positions follow the filtered tabs
+	press(m, "2")
+	require.Equal(t, "w-dead", m.focusedWorkID)
+
+	// Turning the filter on inside a work jumps to its first failed task
+	press(m, "1", "F", "F")
+	require.Equal(t, "w-fail", m.focusedWorkID)
+	require.True(t, m.problemsOnly)
+	require.Equal(t, "w-fail.2", m.workDetails.GetSelectedTaskID())
+
+	press(m, "F")
+	require.Len(t, m.visibleWorkTiles(), 3)
+	m.syncPanels()
+	require.Contains(t, m.statusBar.Render(), "⚠ 2")
+}
+
+func TestPlanFlowGroupWorksByRoot(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	a1 := h.CreateWork("w-a1", "feat/a1")
+	a1.RootIssueID = "ep-a"
+	loose := h.CreateWork("w-loose", "feat/loose")
+	b := h.CreateWork("w-b", "feat/b")
+	b.RootIssueID = "ep-b"
+	a2 := h.CreateWork("w-a2", "feat/a2")
+	a2.RootIssueID = "ep-a"
+
+	m := newFlowTestModel(t, h)
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{
+		{Work: a1, WorkBeads: []progress.BeadProgress{{ID: "ep-a", BeadStatus: "open"}, {ID: "a-1", BeadStatus: "closed"}}},
+		{Work: loose},
+		{Work: b},
+		{Work: a2, WorkBeads: []progress.BeadProgress{{ID: "ep-a", BeadStatus: "open"}, {ID: "a-2", BeadStatus: "open"}}},
+	}})
+	press(m, "3")
+	require.Equal(t, "w-b", m.focusedWorkID)
+
+	// Grouping keeps the focused work while moving it with its group
+	cmd := press(m, "G")
+	require.NotNil(t, cmd, "root issue titles are looked up")
+	var ids []string
+	for _, wp := range m.visibleWorkTiles() {
+		ids = append(ids, wp.Work.ID)
+	}
+	require.Equal(t, []string{"w-a1", "w-a2", "w-b", "w-loose"}, ids)
+	require.Equal(t, "w-b", m.focusedWorkID)
+
+	// Headers aren't positions: 2 is the second work, not a header
+	press(m, "2")
+	require.Equal(t, "w-a2", m.focusedWorkID)
+
+	// The shared root issue is counted once: 1 of 3 issues closed
+	m.Update(rootTitlesMsg{titles: map[string]string{"ep-a": "Checkout revamp"}})
+	m.workTabsBar.SetSize(300)
+	bar := m.workTabsBar.Render()
+	require.Contains(t, bar, "ep-a Checkout revamp 33%")
+	require.Contains(t, bar, "ep-b 0%")
+	require.Contains(t, bar, "ungrouped")
+	require.Nil(t, m.loadRootTitles(), "titles are looked up once")
+
+	press(m, "G")
+	require.Equal(t, "w-loose", m.visibleWorkTiles()[1].Work.ID)
+	require.Equal(t, "w-a2", m.focusedWorkID)
+	require.NotContains(t, m.workTabsBar.Render(), "ungrouped")
+}
+
+func TestPlanFlowCloseSelectedBeads(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+	press(m, " ", "j", "j", " ")
+	require.Equal(t, map[string]bool{"bead-1": true, "bead-3": true}, m.selectedBeads)
+
+	press(m, "x")
+	require.Equal(t, ViewCloseBeadConfirm, m.viewMode)
+	candidates := m.collectCloseCandidates()
+	var ids []string
+	for _, item := range candidates.open {
+		ids = append(ids, item.ID)
+	}
+	require.Equal(t, []string{"bead-1", "bead-3"}, ids)
+
+	// Esc backs out without closing anything
+	require.Nil(t, press(m, "esc"))
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	press(m, "x")
+	require.NotNil(t, press(m, "y"), "confirming closes the issues")
+	require.Equal(t, ViewNormal, m.viewMode)
+}
+
+func TestPlanFlowFilterChangeClearsHiddenSelections(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+	m.newBeads = make(map[string]time.Time)
+	press(m, " ", "j", "j", " ")
+	require.Equal(t, map[string]bool{"bead-1": true, "bead-3": true}, m.selectedBeads)
+
+	// A refresh that still shows every selected issue keeps them quietly
+	m.Update(planDataMsg{beads: m.beadItems})
+	require.Len(t, m.selectedBeads, 2)
+	require.Empty(t, m.statusMessage)
+
+	// A failed load doesn't take the selection with it
+	m.Update(planDataMsg{err: errors.New("bd failed")})
+	require.Len(t, m.selectedBeads, 2)
+
+	// Narrowing the list drops the selected issue it hides
+	press(m, "/", "l", "o", "g")
+	m.Update(planDataMsg{beads: []beadItem{testBeadItem("bead-1", "Fix login", "open", 2, "task")}, searchSeq: m.searchSeq})
+	require.Equal(t, map[string]bool{"bead-1": true}, m.selectedBeads)
+	require.Equal(t, "Cleared 1 hidden selection(s)", m.statusMessage)
+	require.False(t, m.statusIsError)
+
+	// Widening it again doesn't bring the dropped one back, so W only takes
+	// the issue that stayed in view, and shows it before creating anything
+	press(m, "esc")
+	m.Update(planDataMsg{beads: []beadItem{
+		testBeadItem("bead-1", "Fix login", "open", 2, "task"),
+		testBeadItem("bead-2", "Add logout", "open", 2, "task"),
+		testBeadItem("bead-3", "Write docs", "open", 2, "task"),
+	}, searchSeq: m.searchSeq})
+	require.Equal(t, map[string]bool{"bead-1": true}, m.selectedBeads)
+	m.beadsCursor = 2
+	press(m, "W")
+	require.Equal(t, ViewCreateWork, m.viewMode)
+	require.Contains(t, ansi.Strip(m.View()), "Creating work from issue: bead-1 ")
+	require.Empty(t, m.createWorkPanel.GetResult().AdditionalBeadIDs)
+}
+
+func TestPlanFlowCreateDialogs(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+
+	// w opens the create work dialog for the cursor issue; Esc cancels it
+	press(m, "w")
+	require.Equal(t, ViewCreateWork, m.viewMode)
+	require.Equal(t, "bead-1", m.createWorkPanel.GetResult().BeadID)
+	press(m, "esc")
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	// The base branch starts at the configured one and completes from the
+	// repo's branches
+	m.createWorkPanel.SetDefaultBase("main")
+	press(m, "w", "tab", "tab")
+	m.createWorkPanel.SetBranches([]string{"feat/other", "release/1.2"})
+	require.Equal(t, "main", m.createWorkPanel.GetResult().BaseBranch)
+	for range len("main") {
+		m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	press(m, "r", "e")
+	m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	require.Equal(t, "release/1.2", m.createWorkPanel.GetResult().BaseBranch)
+	require.Contains(t, m.View(), "Base branch:")
+
+	// Tabbing to the buttons and pressing Execute starts the creation
+	press(m, "tab")
+	cmd := press(m, "enter")
+	require.NotNil(t, cmd)
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	// n opens the new issue form and Esc closes it
+	press(m, "n")
+	require.Equal(t, ViewCreateBeadInline, m.viewMode)
+	press(m, "esc")
+	require.Equal(t, ViewNormal, m.viewMode)
+}
+
+func TestPlanFlowWatcherEvents(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.CreateWork("w-a", "feat/a")
+	h.CreateWork("w-b", "feat/b")
+
+	m := newFlowTestModel(t, h)
+
+	// A tracking DB change reloads the work tiles exactly once
+	_, cmd := m.Update(trackingWatcherEventMsg{Type: trackingwatcher.DBChanged})
+	require.NotNil(t, cmd)
+	msg := cmd()
+	require.IsType(t, workTilesLoadedMsg{}, msg, "one load, not a batch of refreshes")
+	require.NoError(t, msg.(workTilesLoadedMsg).err)
+	m.Update(msg)
+	require.Len(t, m.workTiles, 2)
+	untouched := m.findWorkByID("w-b")
+
+	// Commits that touched none of the logged tables reload nothing
+	_, cmd = m.Update(trackingWatcherEventMsg{Type: trackingwatcher.DBChanged, Changes: []trackingwatcher.Change{}})
+	require.Nil(t, cmd)
+
+	// A change to one work reloads only that work and patches it in place
+	require.NoError(t, h.DB.SetWorkNotes(ctx, "w-a", "patched"))
+	_, cmd = m.Update(trackingWatcherEventMsg{Type: trackingwatcher.DBChanged, Changes: []trackingwatcher.Change{
+		{Entity: trackingwatcher.EntityWork, ID: "w-a", WorkID: "w-a"},
+	}})
+	require.NotNil(t, cmd)
+	msg = cmd()
+	require.IsType(t, workTilesReloadedMsg{}, msg)
+	require.Len(t, msg.(workTilesReloadedMsg).works, 1)
+	m.Update(msg)
+	require.Len(t, m.workTiles, 2)
+	require.Equal(t, "patched", m.findWorkByID("w-a").Work.Notes)
+	require.Same(t, untouched, m.findWorkByID("w-b"))
+
+	// A work the model doesn't know yet falls back to a full reload
+	h.CreateWork("w-c", "feat/c")
+	_, cmd = m.Update(trackingWatcherEventMsg{Type: trackingwatcher.DBChanged, Changes: []trackingwatcher.Change{
+		{Entity: trackingwatcher.EntityWork, ID: "w-c", WorkID: "w-c"},
+	}})
+	_, cmd = m.Update(cmd())
+	require.NotNil(t, cmd)
+	require.IsType(t, workTilesLoadedMsg{}, cmd())
+
+	// Watcher errors don't trigger reloads
+	_, cmd = m.Update(trackingWatcherEventMsg{Type: trackingwatcher.WatcherError})
+	require.Nil(t, cmd)
+	_, cmd = m.Update(watcherEventMsg{Type: beadswatcher.WatcherError})
+	require.Nil(t, cmd)
+
+	// A beads DB change schedules a data refresh
+	_, cmd = m.Update(watcherEventMsg{Type: beadswatcher.DBChanged})
+	require.NotNil(t, cmd)
+}
+
+func TestPlanFlowPauseWork(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+
+	// z pauses the focused work
+	cmd := press(m, "z")
+	require.NotNil(t, cmd)
+	msg := cmd()
+	require.NoError(t, msg.(workCommandMsg).err)
+	w, err := h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+	require.True(t, w.Paused)
+
+	// The reloaded tab shows the badge, and running warns about the pause
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{{Work: w}}})
+	m.workTabsBar.SetSize(200)
+	require.Contains(t, m.workTabsBar.Render(), "⏸ paused")
+	m.Update(workCommandMsg{action: "Run work", workID: "w-abc"})
+	require.Contains(t, m.statusMessage, "work is paused")
+
+	// z again resumes it and makes sure the orchestrator runs
+	msg = press(m, "z")()
+	require.NoError(t, msg.(workCommandMsg).err)
+	w, err = h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+	require.False(t, w.Paused)
+	require.Len(t, h.OrchestratorManager.EnsureWorkOrchestratorCalls(), 1)
+}
+
+func TestPlanFlowToggleAutoPR(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+
+	// O turns auto-PR on for the work
+	msg := press(m, "O")()
+	require.NoError(t, msg.(workCommandMsg).err)
+	require.Equal(t, "Enable auto-PR", msg.(workCommandMsg).action)
+	w, err := h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+	require.NotNil(t, w.AutoPR)
+	require.True(t, *w.AutoPR)
+
+	summary := NewWorkSummaryPanel(DarkTheme())
+	summary.SetSize(60, 40)
+	summary.SetFocusedWork(&progress.WorkProgress{Work: w})
+	require.Contains(t, summary.renderFullContent(60), "Auto-PR: enabled")
+
+	// Turning it off again matches the project, so the override is dropped
+	msg = press(m, "O")()
+	require.NoError(t, msg.(workCommandMsg).err)
+	require.Equal(t, "Disable auto-PR", msg.(workCommandMsg).action)
+	w, err = h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Nil(t, w.AutoPR)
+	summary.SetFocusedWork(&progress.WorkProgress{Work: w})
+	require.NotContains(t, summary.renderFullContent(60), "Auto-PR")
+
+	// With auto_pr on for the project, O turns it off for just this work
+	m.proj.Config.Workflow.AutoPR = true
+	msg = press(m, "O")()
+	require.NoError(t, msg.(workCommandMsg).err)
+	w, err = h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+	require.NotNil(t, w.AutoPR)
+	require.False(t, *w.AutoPR)
+	summary.SetProjectAutoPR(true)
+	summary.SetFocusedWork(&progress.WorkProgress{Work: w})
+	require.Contains(t, summary.renderFullContent(60), "Auto-PR: disabled for this work")
+}
+
+func TestPlanFlowCustomTaskPicker(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+
+	// Without configured types T only reports it
+	require.Nil(t, press(m, "T"))
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.True(t, m.statusIsError)
+
+	require.NoError(t, os.WriteFile(filepath.Join(m.proj.Root, "audit.tmpl"), []byte("Audit {{.BranchName}}"), 0644))
+	h.WorkService.ProjectRoot = m.proj.Root
+	h.Config.Workflow.TaskTypes = map[string]project.TaskTypeConfig{
+		"audit":  {Prompt: "audit.tmpl"},
+		"bench":  {Prompt: "audit.tmpl"},
+		"review": {Prompt: "audit.tmpl"},
+	}
+
+	// The picker lists the custom types only; Esc backs out
+	press(m, "T")
+	require.Equal(t, ViewTaskTypePicker, m.viewMode)
+	view := m.View()
+	require.Contains(t, view, "audit")
+	require.Contains(t, view, "bench")
+	press(m, "esc")
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	// j moves to the second type and Enter creates it
+	press(m, "T", "j")
+	cmd := press(m, "enter")
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.NotNil(t, cmd)
+	msg := cmd()
+	require.NoError(t, msg.(workCommandMsg).err)
+
+	tasks, err := h.DB.GetWorkTasks(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, "bench", tasks[0].TaskType)
+}
+
+func TestPlanFlowStaleWorkCleanup(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	h.CreateWork("w-abc", "feat/abc")
+	require.NoError(t, h.DB.IdleWork(ctx, "w-abc"))
+	w, err := h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+	h.Git.CheckRemoteBranchFunc = func(ctx context.Context, repoPath, branch, baseBranch string) (*git.RemoteBranchStatus, error) {
+		return &git.RemoteBranchStatus{Deleted: true}, nil
+	}
+
+	m := newFlowTestModel(t, h)
+	m.workTiles = []*progress.WorkProgress{{Work: w}}
+
+	// C on a work that hasn't been checked yet explains itself
+	focusWork(t, m, w)
+	require.Nil(t, press(m, "C"))
+	require.True(t, m.statusIsError)
+
+	// Focusing loaded the tiles, which started a check whose command was
+	// dropped; run one for real. Refreshes while it runs don't start another
+	m.staleCheckInFlight = false
+	cmd := m.checkStaleWorks()
+	require.NotNil(t, cmd)
+	require.Nil(t, m.checkStaleWorks())
+	m.Update(cmd())
+	require.Contains(t, m.staleWorks["w-abc"], "deleted")
+	require.Nil(t, m.checkStaleWorks(), "the cached result is still fresh")
+	require.Len(t, h.Git.CheckRemoteBranchCalls(), 1)
+
+	m.workTabsBar.SetSize(200)
+	require.Contains(t, m.workTabsBar.Render(), "⌫ stale")
+
+	// C opens the complete dialog with the PR check already skipped
+	cmd = press(m, "C")
+	require.NotNil(t, cmd)
+	m.Update(cmd())
+	require.Equal(t, ViewCompleteWorkConfirm, m.viewMode)
+	require.True(t, m.completionOpts.SkipPRCheck)
+	require.Contains(t, m.View(), "Stale: branch feat/abc was deleted")
+}
+
+func TestPlanFlowWorktreeDiskUsage(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	tree := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tree, "big.bin"), make([]byte, 3<<20), 0644))
+	h.CreateWork("w-abc", "feat/abc")
+	require.NoError(t, h.DB.UpdateWorkWorktreePath(ctx, "w-abc", tree))
+	w, err := h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+
+	// Loading the tiles started a measurement whose command was dropped;
+	// run one for real. Refreshes while it runs don't start another
+	m.worktreeMeasureInFlight = false
+	cmd := m.measureWorktrees()
+	require.NotNil(t, cmd)
+	require.Nil(t, m.measureWorktrees())
+	m.Update(cmd())
+	require.Equal(t, "3.0 MiB", m.worktreeSize("w-abc"))
+	require.Nil(t, m.measureWorktrees(), "the cached sizes are still fresh")
+
+	m.statusMessage = ""
+	m.width = 240
+	m.syncPanels()
+	require.Contains(t, m.workDetails.summaryPanel.renderFullContent(80), "Worktree: 3.0 MiB")
+	require.Contains(t, m.statusBar.Render(), "Worktrees: 3.0 MiB")
+}
+
+func TestPlanFlowCommandPalette(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+
+	// Typing filters the issue actions; Enter runs the highlighted one
+	press(m, ":")
+	require.Equal(t, ViewCommandPalette, m.viewMode)
+	press(m, "s", "o", "r", "t")
+	require.Contains(t, m.View(), "Cycle sort mode")
+	press(m, "enter")
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.NotEqual(t, "default", m.filters.sortBy)
+
+	// Unavailable actions are listed with their reason and only report it
+	press(m, ":", "f", "o", "c", "u", "s", "e", "d")
+	require.Contains(t, m.View(), "select a work first")
+	require.Nil(t, press(m, "enter"))
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.True(t, m.statusIsError)
+	require.Contains(t, m.statusMessage, "select a work first")
+
+	// With a work focused the palette offers the work's actions instead
+	focusWork(t, m, w)
+	press(m, ":")
+	var names []string
+	for _, a := range m.paletteActions() {
+		names = append(names, a.name)
+	}
+	require.Contains(t, names, "Open a terminal in the worktree")
+	require.Contains(t, names, "Help")
+	require.NotContains(t, names, "Create new issue")
+	press(m, "esc")
+	require.Equal(t, ViewNormal, m.viewMode)
+}
+
+func TestPlanFlowReviewPlan(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	h.CreateBead("bead-1", "Feature A")
+	h.CreateBead("bead-2", "Feature B")
+	h.CreateBead("bead-3", "Feature C")
+	w := h.CreateWork("w-abc", "feat/abc")
+	h.AddBeadToWork("w-abc", "bead-1")
+	h.AddBeadToWork("w-abc", "bead-2")
+	h.AddBeadToWork("w-abc", "bead-3")
+	h.Worktree.ExistsPathFunc = func(string) bool { return true }
+	h.TaskPlanner.PlanFunc = func(ctx context.Context, beadList []beads.Bead, dependencies map[string][]beads.Dependency, budget int) ([]task.Task, error) {
+		return []task.Task{{BeadIDs: []string{"bead-1", "bead-2", "bead-3"}}}, nil
+	}
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+
+	// g previews the grouping without creating tasks
+	cmd := press(m, "g")
+	require.NotNil(t, cmd)
+	m.Update(cmd())
+	require.Equal(t, ViewPlanReview, m.viewMode)
+	require.Contains(t, m.View(), "Feature B")
+	tasks, err := h.DB.GetWorkTasks(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Empty(t, tasks)
+
+	// Esc discards the plan
+	press(m, "esc")
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.Nil(t, m.planReview)
+
+	// A rejected edit is explained in the dialog, not the status bar
+	m.Update(press(m, "g")())
+	press(m, "K")
+	require.Equal(t, ViewPlanReview, m.viewMode)
+	require.Contains(t, m.View(), "select an issue to move")
+	require.False(t, m.statusIsError)
+
+	// Split before bead-2, name the new task, then move bead-2 back to the first
+	press(m, "j", "j", "s", "e", "a", "p", "i", "enter", "j", "K")
+	require.Contains(t, m.View(), "Task 2: api")
+	require.Equal(t, [][]string{{"bead-1", "bead-2"}, {"bead-3"}}, [][]string{
+		m.planReview.plan.Groups[0].BeadIDs(), m.planReview.plan.Groups[1].BeadIDs(),
+	})
+
+	// Enter creates the edited tasks
+	cmd = press(m, "enter")
+	require.Equal(t, ViewNormal, m.viewMode)
+	msg := cmd().(workCommandMsg)
+	require.NoError(t, msg.err)
+	require.Len(t, msg.taskIDs, 2)
+	title, err := h.DB.GetTaskMetadata(ctx, msg.taskIDs[1], "title")
+	require.NoError(t, err)
+	require.Equal(t, "api", title)
+}
+
+func TestPlanFlowRemoveBeadInPendingTask(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	h.CreateBead("bead-1", "Feature A")
+	w := h.CreateWork("w-abc", "feat/abc")
+	h.AddBeadToWork("w-abc", "bead-1")
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{{
+		Work:            w,
+		UnassignedBeads: []progress.BeadProgress{{ID: "bead-1", Title: "Feature A"}},
+	}}})
+	m.workDetails.SetSelectedIndex(1)
+	require.True(t, m.workDetails.IsUnassignedBeadSelected())
+
+	// The orchestrator picked the bead up after the list was loaded
+	h.CreateTask("w-abc.pending", "w-abc", []string{"bead-1"})
+
+	cmd := press(m, "x")
+	require.NotNil(t, cmd)
+	m.Update(cmd())
+	require.Equal(t, ViewRemoveBeadConfirm, m.viewMode)
+	require.Contains(t, m.View(), "w-abc.pending")
+
+	// n keeps everything as it was
+	press(m, "n")
+	require.Equal(t, ViewNormal, m.viewMode)
+	task, err := h.DB.GetTask(ctx, "w-abc.pending")
+	require.NoError(t, err)
+	require.NotNil(t, task)
+
+	// y takes the bead out of the task, deleting the emptied task
+	m.Update(press(m, "x")())
+	msg := press(m, "y")().(workCommandMsg)
+	require.NoError(t, msg.err)
+	task, err = h.DB.GetTask(ctx, "w-abc.pending")
+	require.NoError(t, err)
+	require.Nil(t, task)
+	workBeads, err := h.DB.GetWorkBeads(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Empty(t, workBeads)
+}
+
+func TestPlanFlowMoveBeadToWork(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	h.CreateBead("bead-1", "Feature A")
+	w := h.CreateWork("w-abc", "feat/abc")
+	other := h.CreateWork("w-def", "feat/def")
+	h.AddBeadToWork("w-abc", "bead-1")
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{
+		{Work: w, UnassignedBeads: []progress.BeadProgress{{ID: "bead-1", Title: "Feature A"}}},
+		{Work: other},
+	}})
+	m.workDetails.SetSelectedIndex(1)
+	require.True(t, m.workDetails.IsUnassignedBeadSelected())
+
+	// M still mutes notifications with an issue selected
+	press(m, "M")
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.True(t, m.notificationsMuted)
+
+	press(m, "b")
+	require.Equal(t, ViewMoveBeadPicker, m.viewMode)
+	view := m.View()
+	require.Contains(t, view, "w-def")
+
+	cmd := press(m, "enter")
+	require.NotNil(t, cmd)
+	m.Update(cmd())
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.False(t, m.statusIsError, m.statusMessage)
+	require.Contains(t, m.statusMessage, "w-abc")
+	require.Contains(t, m.statusMessage, "w-def")
+
+	workBeads, err := h.DB.GetWorkBeads(ctx, "w-def")
+	require.NoError(t, err)
+	require.Len(t, workBeads, 1)
+	require.Equal(t, "bead-1", workBeads[0].BeadID)
+	workBeads, err = h.DB.GetWorkBeads(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Empty(t, workBeads)
+}
+
+func TestPlanFlowRunSelectedTask(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	w := h.CreateWork("w-abc", "feat/abc")
+	h.CreateTask("w-abc.review", "w-abc", nil)
+	task, err := h.DB.GetTask(ctx, "w-abc.review")
+	require.NoError(t, err)
+
+	var spawnedTask, claimedBy string
+	h.OrchestratorManager.SpawnTaskSessionFunc = func(ctx context.Context, workID, taskID, projName, workDir, claimant string, w io.Writer) error {
+		spawnedTask, claimedBy = taskID, claimant
+		return nil
+	}
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{
+		{Work: w, Tasks: []*progress.TaskProgress{{Task: task}}},
+	}})
+	m.workDetails.SetSelectedIndex(1)
+	require.True(t, m.workDetails.IsSelectedTaskPending())
+
+	cmd := press(m, "!")
+	require.NotNil(t, cmd)
+	m.Update(cmd())
+	require.False(t, m.statusIsError, m.statusMessage)
+	require.Contains(t, m.statusMessage, "w-abc.review")
+	require.Equal(t, "w-abc.review", spawnedTask)
+
+	// The orchestrator sees the task as claimed by the TUI
+	claimant, err := h.DB.GetTaskClaimant(ctx, "w-abc.review")
+	require.NoError(t, err)
+	require.Equal(t, claimedBy, claimant)
+	require.Equal(t, db.TaskClaimant(), claimant)
+}
+
+func TestPlanFlowBrowseTaskArtifacts(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	w := h.CreateWork("w-abc", "feat/abc")
+	h.CreateTask("w-abc.review", "w-abc", nil)
+	task, err := h.DB.GetTask(ctx, "w-abc.review")
+	require.NoError(t, err)
+
+	m := newFlowTestModel(t, h)
+	m.columnRatio = 0.4
+	dir := m.proj.TaskArtifactDir("w-abc", "w-abc.review")
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "review.md"), []byte("# Review summary\n\n- **Blocking**: none\n"), 0644))
+	artifacts, err := m.proj.ListTaskArtifacts("w-abc", "w-abc.review")
+	require.NoError(t, err)
+	require.Len(t, artifacts, 1)
+
+	focusWork(t, m, w)
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{
+		{Work: w, Tasks: []*progress.TaskProgress{{Task: task, Artifacts: artifacts}}},
+	}})
+	m.workDetails.SetSelectedIndex(1)
+	require.True(t, m.workDetails.SelectedTaskHasArtifacts())
+	require.Contains(t, m.View(), "Artifacts (1)")
+
+	press(m, "enter")
+	require.Equal(t, ViewArtifacts, m.viewMode)
+	require.Contains(t, m.View(), "review.md")
+
+	// Markdown is rendered rather than shown as source
+	press(m, "enter")
+	view := m.View()
+	require.Contains(t, view, "Review summary")
+	require.NotContains(t, view, "# Review summary")
+	require.Contains(t, view, "• Blocking: none")
+
+	press(m, "esc")
+	require.Equal(t, ViewArtifacts, m.viewMode)
+	press(m, "esc")
+	require.Equal(t, ViewNormal, m.viewMode)
+}
+
+func TestPlanFlowImportWorkManifest(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+
+	// ctrl+o swaps the form for a manifest path and back
+	press(m, "w", "ctrl+o")
+	require.True(t, m.createWorkPanel.IsImporting())
+	require.Contains(t, m.View(), "Manifest file:")
+	press(m, "ctrl+o")
+	require.False(t, m.createWorkPanel.IsImporting())
+
+	// Enter does nothing until a path is typed
+	press(m, "ctrl+o")
+	require.Nil(t, press(m, "enter"))
+	require.Equal(t, ViewCreateWork, m.viewMode)
+	for _, r := range "work.yaml" {
+		press(m, string(r))
+	}
+	require.NotNil(t, press(m, "enter"))
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	// Reopening the dialog starts from the form again
+	press(m, "w")
+	require.False(t, m.createWorkPanel.IsImporting())
+	press(m, "esc")
+
+	// The result names the skipped beads and focuses the new work
+	m.Update(workImportedMsg{path: "work.yaml", result: &workpkg.ImportWorkResult{
+		WorkID: "w-new",
+		Added:  []string{"bead-1", "bead-2"},
+		Closed: []string{"bead-3"},
+		Taken:  map[string]string{"bead-4": "w-abc"},
+	}})
+	require.False(t, m.statusIsError)
+	require.Equal(t, "Imported work w-new with 2 issues (skipped bead-3: closed, bead-4: in w-abc)", m.statusMessage)
+	require.Equal(t, "w-new", m.pendingFocusWorkID)
+
+	m.Update(workImportedMsg{path: "/tmp/work.yaml", err: errors.New("boom")})
+	require.True(t, m.statusIsError)
+	require.Equal(t, "Failed to import work.yaml: boom", m.statusMessage)
+}
+
+func TestPlanFlowCreateWorkBranchValidation(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	h.MockBranchExists("feat/fix-login", true, false)
+
+	m := newFlowTestModel(t, h)
+	// checkBranch runs the debounced check for the name as typed so far
+	checkBranch := func() {
+		t.Helper()
+		_, cmd := m.Update(branchCheckTickMsg{seq: m.createWorkPanel.branchCheckSeq})
+		require.NotNil(t, cmd)
+		m.Update(cmd())
+	}
+
+	press(m, "w")
+	require.Equal(t, ViewCreateWork, m.viewMode)
+	require.Equal(t, "feat/fix-login", m.createWorkPanel.GetResult().BranchName)
+	checkBranch()
+	view := m.View()
+	require.Contains(t, view, "already exists locally")
+	require.Contains(t, view, "feat/fix-login-2")
+
+	// Submitting a taken name is refused with the ways out
+	m.createWorkPanel.fieldIdx = 3
+	press(m, "enter")
+	require.Equal(t, ViewCreateWork, m.viewMode)
+	require.True(t, m.statusIsError)
+	require.Contains(t, m.statusMessage, "ctrl+s to use feat/fix-login-2")
+
+	// ctrl+s switches to the suffixed name; the earlier check is stale
+	staleSeq := m.createWorkPanel.branchCheckSeq
+	press(m, "ctrl+s")
+	require.Equal(t, "feat/fix-login-2", m.createWorkPanel.GetResult().BranchName)
+	_, cmd := m.Update(branchCheckTickMsg{seq: staleSeq})
+	require.Nil(t, cmd)
+	checkBranch()
+	require.Empty(t, m.createWorkPanel.BranchProblem())
+	require.Contains(t, m.View(), "Branch name available")
+
+	// Typing an invalid name explains the problem inline
+	m.createWorkPanel.fieldIdx = 1
+	m.createWorkPanel.updateFocus()
+	press(m, " ", "x")
+	checkBranch()
+	require.Contains(t, m.View(), "cannot contain spaces; will be created as feat/fix-login-2-x")
+
+	// ctrl+x attaches to the existing branch instead
+	m.createWorkPanel.branchInput.SetValue("feat/fix-login")
+	checkBranch()
+	press(m, "ctrl+x")
+	result := m.createWorkPanel.GetResult()
+	require.True(t, result.UseExistingBranch)
+	require.Equal(t, "feat/fix-login", result.BranchName)
+}
+
+func TestPlanFlowOrchestratorLogPane(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	t.Cleanup(m.stopLogTail)
+	focusWork(t, m, w)
+	logPanel := m.workDetails.LogPanel()
+
+	// L shows the log column, which waits for a log that doesn't exist yet
+	press(m, "L")
+	require.True(t, m.workDetails.IsLogShown())
+	require.NotNil(t, m.logTail)
+	path := m.proj.OrchestratorLogPath("w-abc")
+	require.Equal(t, path, m.logTail.Path())
+	require.Contains(t, m.View(), "Orchestrator log")
+	require.Contains(t, logPanel.Render(10, 80), "No log yet")
+
+	// Lines written to the log show up as the tailer reports them
+	var b strings.Builder
+	for i := 1; i <= 50; i++ {
+		fmt.Fprintf(&b, "line %d\n", i)
+	}
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(b.String()), 0o644))
+	waitForLog := func() {
+		t.Helper()
+		msgs := make(chan tea.Msg, 1)
+		go func() { msgs <- m.waitForLogTail(m.logTail)() }()
+		select {
+		case msg := <-msgs:
+			m.Update(msg)
+		case <-time.After(5 * time.Second):
+			t.Fatal("no log update")
+		}
+	}
+	waitForLog()
+	require.True(t, strings.HasSuffix(logPanel.Render(10, 80), "line 50"))
+
+	// Scrolling up pauses the view, so new output doesn't move it
+	press(m, "pgup")
+	require.True(t, logPanel.IsPaused())
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = f.WriteString("line 51\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	waitForLog()
+	require.NotContains(t, logPanel.Render(10, 80), "line 51")
+
+	// End follows the log again
+	press(m, "end")
+	require.False(t, logPanel.IsPaused())
+	require.True(t, strings.HasSuffix(logPanel.Render(10, 80), "line 51"))
+
+	// Leaving the work stops the tailer, and L hides the column
+	m.focusedWorkID = ""
+	m.Update(nil)
+	require.Nil(t, m.logTail)
+	m.focusedWorkID = "w-abc"
+	m.Update(nil)
+	require.NotNil(t, m.logTail)
+	press(m, "L")
+	require.False(t, m.workDetails.IsLogShown())
+	require.Nil(t, m.logTail)
+}
+
+func TestPlanFlowUndo(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+	m.activePanel = PanelLeft
+
+	// With nothing done yet, u shows an empty journal
+	press(m, "u")
+	require.Equal(t, ViewUndoConfirm, m.viewMode)
+	require.Nil(t, m.undoTarget)
+	require.Contains(t, m.renderUndoConfirmContent(), "Nothing to undo")
+	press(m, "esc")
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	// An assignment is journaled once it succeeds
+	m.Update(m.addBeadsToWork([]string{"bead-1", "bead-2"}, "w-abc")())
+	workBeads, err := h.DB.GetWorkBeads(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Len(t, workBeads, 2)
+
+	// Destroying a work is listed but skipped by undo
+	m.recordJournal(&journalEntry{kind: journalDestroyWork, workID: "w-old"})
+	press(m, "u")
+	require.NotNil(t, m.undoTarget)
+	require.Equal(t, journalAssign, m.undoTarget.kind)
+	content := m.renderUndoConfirmContent()
+	require.Contains(t, content, "Destroyed w-old")
+	require.Contains(t, content, "can't be undone")
+	require.Contains(t, content, "Removes bead-1, bead-2 from w-abc.")
+
+	// y reverses the assignment
+	msg := press(m, "y")()
+	require.NoError(t, msg.(journalUndoneMsg).err)
+	m.Update(msg)
+	workBeads, err = h.DB.GetWorkBeads(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Empty(t, workBeads)
+	require.Contains(t, m.statusMessage, "Undone: Assigned bead-1, bead-2 to w-abc")
+
+	// An undone action isn't offered again
+	press(m, "u")
+	require.Nil(t, m.undoTarget)
+	press(m, "n")
+
+	// Created tasks are deleted, but only while none has started
+	h.CreateTask("w-abc.1", "w-abc", nil)
+	h.CreateTask("w-abc.2", "w-abc", nil)
+	m.recordJournal(createdTasksEntry("w-abc", []string{"w-abc.1", "w-abc.2"}))
+	require.NoError(t, h.DB.StartTask(ctx, "w-abc.2", ""))
+	press(m, "u")
+	msg = press(m, "y")()
+	require.ErrorIs(t, msg.(journalUndoneMsg).err, coerrors.Conflict)
+	m.Update(msg)
+	task, err := h.DB.GetTask(ctx, "w-abc.1")
+	require.NoError(t, err)
+	require.NotNil(t, task, "no task is deleted unless all can be")
+
+	require.NoError(t, h.DB.ResetTaskStatus(ctx, "w-abc.2"))
+	press(m, "u")
+	msg = press(m, "y")()
+	require.NoError(t, msg.(journalUndoneMsg).err)
+	for _, id := range []string{"w-abc.1", "w-abc.2"} {
+		task, err := h.DB.GetTask(ctx, id)
+		require.NoError(t, err)
+		require.Nil(t, task)
+	}
+}
+
+func TestPlanFlowRetryRateLimitedTasks(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	w := h.CreateWork("w-abc", "feat/abc")
+	h.CreateTask("w-abc.1", "w-abc", []string{"bead-1"})
+	h.CreateTask("w-abc.2", "w-abc", []string{"bead-2"})
+	h.CreateTask("w-abc.3", "w-abc", []string{"bead-3"})
+	require.NoError(t, h.DB.FailTaskWithKind(ctx, "w-abc.1", db.FailureRateLimited, "Claude hit a rate limit"))
+	require.NoError(t, h.DB.FailTaskWithKind(ctx, "w-abc.2", db.FailureRateLimited, "Claude hit a rate limit"))
+	require.NoError(t, h.DB.FailTaskWithKind(ctx, "w-abc.3", db.FailureToolError, "Claude exited with error"))
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+	wp := &progress.WorkProgress{Work: w}
+	for _, id := range []string{"w-abc.1", "w-abc.2", "w-abc.3"} {
+		task, err := h.DB.GetTask(ctx, id)
+		require.NoError(t, err)
+		wp.Tasks = append(wp.Tasks, &progress.TaskProgress{Task: task})
+	}
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{wp}})
+	m.workDetails.SetFocusedWork(wp)
+
+	// The work panel counts them and the task details show the kind
+	require.Contains(t, m.View(), "2 rate-limited task(s)")
+	require.Contains(t, m.theme.failureBadge(db.FailureToolError), "[tool error]")
+
+	// X resets the rate-limited tasks and leaves the other failure alone
+	cmd := press(m, "X")
+	require.NotNil(t, cmd)
+	m.Update(cmd())
+	require.False(t, m.statusIsError, m.statusMessage)
+	require.Contains(t, m.statusMessage, "Reset 2 rate-limited task(s)")
+	for id, status := range map[string]string{"w-abc.1": db.StatusPending, "w-abc.2": db.StatusPending, "w-abc.3": db.StatusFailed} {
+		task, err := h.DB.GetTask(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, status, task.Status, id)
+	}
+}
+
+func TestPlanFlowWorktreeSetupWarnings(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	m := newFlowTestModel(t, h)
+
+	// The work exists before the control plane has set up its worktree
+	pending := &db.Work{ID: "w-new", BranchName: "feat/new"}
+	m.Update(planWorkCreatedMsg{beadID: "bead-1", workID: pending.ID})
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{{Work: pending}}})
+	require.False(t, m.statusIsError)
+	require.True(t, m.awaitingWorktree["w-new"])
+
+	// Once it's ready, its setup warnings are reported once
+	ready := &db.Work{ID: "w-new", BranchName: "feat/new", WorktreePath: "/tmp/w-new/tree",
+		SetupWarnings: []string{"copy .env: not found", "post_create \"make\" failed: exit status 2\nmake: *** no rule"}}
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{{Work: ready}}})
+	require.True(t, m.statusIsError)
+	require.Equal(t, "Worktree setup for w-new had 2 problem(s): copy .env: not found", m.statusMessage)
+	require.Empty(t, m.awaitingWorktree)
+
+	m.statusMessage, m.statusIsError = "", false
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{{Work: ready}}})
+	require.False(t, m.statusIsError)
+}
+
+func TestPlanFlowRelocateMissingWorktree(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	w := h.CreateWork("w-abc", "feat/abc")
+	require.NoError(t, h.DB.UpdateWorkWorktreePath(ctx, "w-abc", "/old/project/w-abc/tree"))
+	w.WorktreePath = "/old/project/w-abc/tree"
+	moved := t.TempDir()
+	h.Worktree.ExistsPathFunc = func(path string) bool { return path == moved }
+	h.Worktree.ListFunc = func(ctx context.Context, repoPath string) ([]worktree.Worktree, error) {
+		return []worktree.Worktree{{Path: moved, Branch: "feat/abc"}}, nil
+	}
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+	wp := &progress.WorkProgress{Work: w, WorktreeMissing: true}
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{wp}})
+	m.workDetails.SetFocusedWork(wp)
+	require.Contains(t, m.View(), "worktree missing")
+
+	// Opening a console explains what's wrong instead of failing in the tab
+	m.Update(m.openConsole()())
+	require.True(t, m.statusIsError)
+	require.Contains(t, m.statusMessage, "press H to relocate it")
+
+	// H asks where the worktree went; Esc leaves it alone
+	press(m, "H")
+	require.Equal(t, ViewWorkRelocate, m.viewMode)
+	require.Contains(t, m.View(), "Missing: /old/project/w-abc/tree")
+	press(m, "esc")
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	cmd := press(m, "H", moved, "enter")
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.NotNil(t, cmd)
+	m.Update(cmd())
+	require.False(t, m.statusIsError, m.statusMessage)
+	require.Equal(t, "Relocate worktree completed for w-abc", m.statusMessage)
+	got, err := h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Equal(t, moved, got.WorktreePath)
+}
+
+func TestPlanFlowTriage(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	h.CreateBead("bead-1", "Fix login")
+	h.CreateBead("bead-2", "Add logout")
+	h.CreateBead("bead-3", "Write docs")
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{{Work: w}}})
+
+	require.NotNil(t, press(m, "T"))
+	require.Equal(t, ViewTriage, m.viewMode)
+	m.Update(triageQueueLoadedMsg{items: m.beadItems})
+	require.Contains(t, m.View(), "0/3 triaged")
+	require.Contains(t, m.View(), "Fix login")
+
+	// Skipping moves on to the next issue
+	m.Update(press(m, "s")())
+	require.Contains(t, m.View(), "1/3 triaged")
+	require.Contains(t, m.View(), "Add logout")
+
+	// a reuses the move picker, offering every active work
+	press(m, "a")
+	require.Equal(t, ViewMoveBeadPicker, m.viewMode)
+	require.Contains(t, m.View(), "Add bead-2 to")
+	cmd := press(m, "enter")
+	require.Equal(t, ViewTriage, m.viewMode)
+	m.Update(cmd())
+	require.False(t, m.statusIsError, m.statusMessage)
+	require.Contains(t, m.View(), "2/3 triaged")
+	workBeads, err := h.DB.GetWorkBeads(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Len(t, workBeads, 1)
+
+	// Undo takes the issue out of the work again and goes back to it
+	m.Update(press(m, "u")())
+	require.False(t, m.statusIsError, m.statusMessage)
+	require.Contains(t, m.View(), "1/3 triaged")
+	require.Contains(t, m.View(), "Add logout")
+	workBeads, err = h.DB.GetWorkBeads(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Empty(t, workBeads)
+
+	// Finishing early shows what was done, then returns to the issues
+	press(m, "esc")
+	require.Contains(t, m.View(), "Triaged 1 of 3 issues")
+	require.Contains(t, m.View(), "bead-1: skipped")
+	press(m, "x")
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.Nil(t, m.triage)
+}
+
+func TestPlanFlowDialogDraftRestore(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+	require.NoError(t, os.MkdirAll(filepath.Join(m.proj.Root, project.ConfigDir), 0755))
+	draftPath := filepath.Join(m.proj.Root, project.ConfigDir, tuiDraftFile)
+
+	// The draft is written every few key presses while typing
+	press(m, "n", "C", "r", "a", "s")
+	require.NoFileExists(t, draftPath)
+	press(m, "h")
+	require.FileExists(t, draftPath)
+
+	// The next session offers to put it back into the dialog
+	next := newFlowTestModel(t, h)
+	next.proj = m.proj
+	next.pendingDraft = loadDialogDraft(m.proj.Root)
+	require.NotNil(t, next.pendingDraft)
+	next.viewMode = ViewDraftRestore
+	require.Contains(t, next.View(), "new issue")
+	press(next, "y")
+	require.Equal(t, ViewCreateBeadInline, next.viewMode)
+	require.Equal(t, "Crash", next.beadFormPanel.GetResult().Title)
+
+	// Cancelling the dialog discards the draft
+	press(next, "esc")
+	require.Equal(t, ViewNormal, next.viewMode)
+	require.NoFileExists(t, draftPath)
+}
+
+func TestPlanFlowDebugEventsScrubText(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+	logPath := filepath.Join(m.proj.Root, project.ConfigDir, project.LogsDir, tuiDebugLogFile)
+
+	// Off unless asked for
+	stop := startDebugEvents(m.proj.Root)
+	press(m, "n")
+	stop()
+	require.NoFileExists(t, logPath)
+
+	t.Setenv(tuiDebugEnv, "1")
+	stop = startDebugEvents(m.proj.Root)
+	press(m, "S", "e", "c", "r", "e", "t", "tab")
+	stop()
+
+	data, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+	log := string(data)
+	require.Contains(t, log, `"msg":"bead form key"`)
+	require.Contains(t, log, `"key":"tab"`)
+	require.Contains(t, log, `"field":1`)
+	require.NotContains(t, log, "Secret")
+	require.NotContains(t, log, `"S"`)
+}
+
+func TestPlanFlowReviewIncludingCIFailures(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	w := h.CreateWork("w-abc", "feat/abc")
+	w.PRURL = "https://github.com/owner/repo/pull/3"
+	require.NoError(t, h.DB.IdleWorkWithPR(ctx, w.ID, w.PRURL))
+	h.GitHub.GetPRChecksFunc = func(ctx context.Context, prURL string, requiredOnly bool) ([]github.PRCheck, error) {
+		return []github.PRCheck{{Name: "lint", Bucket: "pass"}, {Name: "test", Bucket: "fail"}}, nil
+	}
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+
+	// While CI passes, v creates the review right away
+	press(m, "v")
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	m.findWorkByID(w.ID).CIStatus = db.CIStatusFailure
+	cmd := press(m, "v")
+	require.Equal(t, ViewReviewChoice, m.viewMode)
+	m.Update(cmd())
+	require.Contains(t, m.View(), "Failing: test")
+
+	cmd = press(m, "c")
+	require.Equal(t, ViewNormal, m.viewMode)
+	msg, ok := cmd().(workCommandMsg)
+	require.True(t, ok)
+	require.NoError(t, msg.err)
+	require.Len(t, msg.taskIDs, 1)
+	failures, err := h.DB.GetTaskMetadata(ctx, msg.taskIDs[0], workpkg.CIFailuresMetadataKey)
+	require.NoError(t, err)
+	require.Contains(t, failures, "### test")
+
+	// The summary lists the failing checks too
+	m.syncPanels()
+	require.Contains(t, m.workDetails.summaryPanel.Render(80), "Checks: test")
+}
+
+func TestPlanFlowMergeDuplicate(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	h.CreateBead("bead-1", "Fix login")
+	h.CreateBead("bead-4", "Fix the login page")
+	h.CreateWork("w-abc", "feat/abc")
+	h.AddBeadToWork("w-abc", "bead-4")
+
+	m := newFlowTestModel(t, h)
+	m.beadItems = append(m.beadItems,
+		testBeadItem("bead-4", "Fix the login page", "open", 2, "task"),
+		testBeadItem("bead-5", "Login", "closed", 2, "task"))
+
+	require.Nil(t, press(m, "="))
+	require.Equal(t, ViewDedupe, m.viewMode)
+	view := m.View()
+	require.Contains(t, view, "Likely duplicates of bead-1")
+	require.Contains(t, view, "bead-4")
+	require.NotContains(t, view, "bead-5", "closed issues aren't offered")
+	require.NotContains(t, view, "Add logout")
+
+	cmd := press(m, "enter")
+	require.NotNil(t, cmd)
+	require.Equal(t, ViewNormal, m.viewMode)
+	msg := cmd()
+	require.Equal(t, duplicateMergedMsg{survivor: "bead-1", duplicate: "bead-4"}, msg)
+	m.Update(msg)
+	require.Contains(t, m.statusMessage, "Merged bead-4 into bead-1")
+
+	closes := h.Beads.CloseCalls()
+	require.Len(t, closes, 1)
+	require.Equal(t, "bead-4", closes[0].BeadID)
+	require.Equal(t, "Duplicate of bead-1", h.Beads.AddCommentCalls()[0].Comment)
+	workBeads, err := h.DB.GetWorkBeads(context.Background(), "w-abc")
+	require.NoError(t, err)
+	require.Len(t, workBeads, 1)
+	require.Equal(t, "bead-1", workBeads[0].BeadID, "the work now has the survivor")
+
+	// Nothing alike to the second issue
+	press(m, "j", "=")
+	require.Contains(t, m.View(), "No open issue has a title alike enough")
+	press(m, "esc")
+	require.Equal(t, ViewNormal, m.viewMode)
+}
+
+func TestPlanFlowTour(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	// First run in a project without works: the tour opens once they load
+	m := newFlowTestModel(t, h)
+	require.NoError(t, os.MkdirAll(filepath.Join(m.proj.Root, ".co"), 0755))
+	m.offerTour = true
+	m.Update(workTilesLoadedMsg{})
+	require.Equal(t, ViewTour, m.viewMode)
+	view := m.View()
+	require.Contains(t, view, "Welcome to co")
+	require.Contains(t, view, "Issues (beads)")
+
+	press(m, "right")
+	require.Contains(t, m.View(), "A work is a unit of delivery")
+	press(m, "left", "left")
+	require.Contains(t, m.View(), "Issues (beads)", "paging stops at the first page")
+	for range len(tourPages) {
+		press(m, "right")
+	}
+	require.Contains(t, m.View(), "Create my first issue")
+
+	press(m, "enter")
+	require.Equal(t, ViewCreateBeadInline, m.viewMode)
+	_, found := loadTUIState(m.proj.Root)
+	require.True(t, found, "closing the tour writes the state file")
+
+	// Offered only once
+	press(m, "esc")
+	m.Update(workTilesLoadedMsg{})
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	// Not offered when the project has works
+	w := h.CreateWork("w-abc", "feat/abc")
+	m = newFlowTestModel(t, h)
+	m.offerTour = true
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{{Work: w}}})
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	// --tour replays it anyway, and an action key ends it and runs
+	m = newFlowTestModel(t, h)
+	m.startTour = true
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{{Work: w}}})
+	require.Equal(t, ViewTour, m.viewMode)
+	press(m, "?")
+	require.Equal(t, ViewHelp, m.viewMode)
+	require.Nil(t, m.tour)
+}
+
+func TestPlanFlowAssignBeadOwnedByAnotherWork(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+	h.CreateWork("w-other", "feat/other")
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+	m.activePanel = PanelLeft
+	press(m, " ", "j", " ")
+	press(m, "A")
+	require.Equal(t, ViewAssignBeads, m.viewMode)
+	require.Contains(t, m.View(), "bead-1 Fix login")
+
+	// Another work takes one of them before the confirmation
+	h.AddBeadToWork("w-other", "bead-2")
+	m.beadItems[1].assignedWorkID = "w-other"
+	view := m.View()
+	require.Contains(t, view, "bead-2 Add logout [w-other]")
+	require.Contains(t, view, "Already in another work: bead-2")
+	require.NotContains(t, view, "[Enter] Add")
+
+	require.Nil(t, press(m, "enter"))
+	require.Equal(t, ViewAssignBeads, m.viewMode)
+	require.True(t, m.statusIsError)
+	require.Contains(t, m.statusMessage, "Cannot add: bead-2 already in another work")
+	press(m, "esc")
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	// The service refuses it too, whatever the TUI shows
+	_, err := h.WorkService.AddBeads(context.Background(), "w-abc", []string{"bead-2"}, false)
+	require.ErrorIs(t, err, coerrors.Conflict)
+}
+
+func TestPlanFlowReconcileWorkStatus(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	h.CreateWork("w-abc", "feat/abc")
+	task := h.CreateTask("w-abc.1", "w-abc", []string{"bead-1"})
+	require.NoError(t, h.DB.StartWork(ctx, "w-abc", "", ""))
+	h.CompleteTask(task.ID)
+	w, err := h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+	task, err = h.DB.GetTask(ctx, task.ID)
+	require.NoError(t, err)
+	works := []*progress.WorkProgress{{Work: w, Tasks: []*progress.TaskProgress{{Task: task}}}}
+
+	m := newFlowTestModel(t, h)
+
+	// A live orchestrator moves its own work along
+	require.Nil(t, m.reconcileWorkStatuses(works, map[string]db.OrchestratorHealth{"w-abc": db.OrchestratorRunning}))
+
+	// Its orchestrator died after the last task completed
+	cmd := m.reconcileWorkStatuses(works, map[string]db.OrchestratorHealth{"w-abc": db.OrchestratorDown})
+	require.NotNil(t, cmd)
+	m.Update(cmd())
+	require.Equal(t, "Work w-abc status auto-corrected: processing → idle", m.statusMessage)
+	w, err = h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Equal(t, db.StatusIdle, w.Status)
+
+	m.readOnly = true
+	require.Nil(t, m.reconcileWorkStatuses(works, map[string]db.OrchestratorHealth{"w-abc": db.OrchestratorDown}))
+}
+
+func TestPlanFlowDiffIgnoresLateFileDiffs(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+	h.Git.DiffStatFunc = func(ctx context.Context, repoPath, baseBranch string) (*git.DiffStat, error) {
+		return &git.DiffStat{Files: []git.DiffFileStat{{Path: "a.go", Added: 1}, {Path: "b.go", Added: 1}}}, nil
+	}
+	h.Git.DiffFileFunc = func(ctx context.Context, repoPath, baseBranch, path string, maxBytes int) (*git.FileDiff, error) {
+		return &git.FileDiff{Path: path, Text: "+changed " + path + "\n"}, nil
+	}
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+	cmd := press(m, "D")
+	require.Equal(t, ViewDiff, m.viewMode)
+	m.Update(cmd())
+
+	// The user leaves a.go before its diff arrives; the file list stays up
+	loadA := press(m, "enter")
+	press(m, "esc")
+	m.Update(loadA())
+	require.Nil(t, m.diffView.file)
+	require.Equal(t, ViewDiff, m.viewMode)
+
+	// Picking a.go then b.go shows b.go, whichever diff arrives last
+	loadA = press(m, "enter")
+	press(m, "esc", "j")
+	loadB := press(m, "enter")
+	m.Update(loadB())
+	m.Update(loadA())
+	require.Equal(t, "b.go", m.diffView.file.Path)
+	require.Contains(t, m.View(), "+changed b.go")
+}
+
+func TestPlanFlowManualRefreshCountsOnlyItsOwnLoads(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	m.newBeads = make(map[string]time.Time)
+	m.statusBar = NewStatusBar(m.theme)
+	batch, ok := m.manualRefresh()().(tea.BatchMsg)
+	require.True(t, ok)
+	require.Len(t, batch, 2)
+
+	// Loads started by anything else while the refresh runs don't end it
+	m.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{{Work: w}}})
+	m.Update(planDataMsg{beads: m.beadItems})
+	require.Equal(t, 2, m.refreshPending)
+
+	// The refresh's own tile load counts down; its issues load needs bd, so
+	// it's stood in for
+	tiles := batch[1]()
+	require.True(t, tiles.(workTilesLoadedMsg).manual)
+	m.Update(tiles)
+	require.Equal(t, 1, m.refreshPending)
+	m.Update(planDataMsg{beads: m.beadItems, manual: true})
+	require.Equal(t, 0, m.refreshPending)
+}
@@ -0,0 +1,172 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/logging"
+	"github.com/newhook/co/internal/progress"
+)
+
+// workGroupHeader labels a group of works in the tabs bar while works are
+// grouped by root issue
+type workGroupHeader struct {
+	rootID  string // Empty for the works without a root issue
+	title   string
+	percent int // Closed issues across the group's works
+}
+
+// rootTitlesMsg carries the titles of root issues looked up for the group
+// headers
+type rootTitlesMsg struct {
+	titles map[string]string
+}
+
+// orderWorksByRoot orders works so those with the same root issue sit
+// together, groups in the order of their first work and works without a root
+// issue last
+func orderWorksByRoot(works []*progress.WorkProgress) []*progress.WorkProgress {
+	var roots []string
+	byRoot := make(map[string][]*progress.WorkProgress)
+	var ungrouped []*progress.WorkProgress
+	for _, wp := range works {
+		if wp == nil {
+			continue
+		}
+		root := wp.Work.RootIssueID
+		if root == "" {
+			ungrouped = append(ungrouped, wp)
+			continue
+		}
+		if _, ok := byRoot[root]; !ok {
+			roots = append(roots, root)
+		}
+		byRoot[root] = append(byRoot[root], wp)
+	}
+	ordered := make([]*progress.WorkProgress, 0, len(works))
+	for _, root := range roots {
+		ordered = append(ordered, byRoot[root]...)
+	}
+	return append(ordered, ungrouped...)
+}
+
+// workGroupHeaders returns the header shown before the first work of each
+// group, by work ID, for works already ordered by orderWorksByRoot
+func workGroupHeaders(works []*progress.WorkProgress, titles map[string]string) map[string]workGroupHeader {
+	headers := make(map[string]workGroupHeader)
+	closed, total := make(map[string]int), make(map[string]int)
+	counted := make(map[string]map[string]bool) // Root issue -> issues counted, as works can share the root issue itself
+	for _, wp := range works {
+		if wp == nil {
+			continue
+		}
+		root := wp.Work.RootIssueID
+		if counted[root] == nil {
+			counted[root] = make(map[string]bool)
+			headers[wp.Work.ID] = workGroupHeader{rootID: root, title: titles[root]}
+		}
+		for _, bead := range wp.WorkBeads {
+			if counted[root][bead.ID] {
+				continue
+			}
+			counted[root][bead.ID] = true
+			total[root]++
+			if bead.BeadStatus == beads.StatusClosed {
+				closed[root]++
+			}
+		}
+	}
+	for id, header := range headers {
+		if n := total[header.rootID]; n > 0 {
+			header.percent = closed[header.rootID] * 100 / n
+			headers[id] = header
+		}
+	}
+	return headers
+}
+
+// syncWorkTabs shows the visible works in the tabs bar, with group headers
+// while works are grouped
+func (m *planModel) syncWorkTabs() {
+	works := m.visibleWorkTiles()
+	m.workTabsBar.SetWorkTiles(works)
+	if m.groupByRoot {
+		m.workTabsBar.SetGroupHeaders(workGroupHeaders(works, m.rootTitles))
+	} else {
+		m.workTabsBar.SetGroupHeaders(nil)
+	}
+}
+
+// toggleWorkGrouping turns grouping works by root issue on or off. The
+// focused work stays focused wherever it moves to.
+func (m *planModel) toggleWorkGrouping() tea.Cmd {
+	m.groupByRoot = !m.groupByRoot
+	m.syncWorkTabs()
+	m.statusIsError = false
+	if !m.groupByRoot {
+		m.statusMessage = "Works no longer grouped"
+		return nil
+	}
+	m.statusMessage = "Grouping works by root issue (G to ungroup)"
+	return m.loadRootTitles()
+}
+
+// loadRootTitles looks up the titles of root issues the group headers don't
+// have yet. Each is looked up once; one that can't be found shows its ID only.
+func (m *planModel) loadRootTitles() tea.Cmd {
+	if !m.groupByRoot || m.proj == nil {
+		return nil
+	}
+	if m.rootTitles == nil {
+		m.rootTitles = make(map[string]string)
+	}
+	var missing []string
+	for _, wp := range m.workTiles {
+		if wp == nil || wp.Work.RootIssueID == "" {
+			continue
+		}
+		if _, ok := m.rootTitles[wp.Work.RootIssueID]; !ok {
+			m.rootTitles[wp.Work.RootIssueID] = ""
+			missing = append(missing, wp.Work.RootIssueID)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		titles := make(map[string]string)
+		result, err := m.proj.Beads.GetBeadsWithDeps(m.ctx, missing)
+		if err != nil {
+			logging.Warn("loadRootTitles failed", "error", err)
+			return rootTitlesMsg{titles: titles}
+		}
+		for _, id := range missing {
+			if bead := result.GetBead(id); bead != nil {
+				titles[id] = bead.Title
+			}
+		}
+		return rootTitlesMsg{titles: titles}
+	}
+}
+
+// handleRootTitles adds looked up root issue titles to the group headers
+func (m *planModel) handleRootTitles(msg rootTitlesMsg) {
+	for id, title := range msg.titles {
+		m.rootTitles[id] = title
+	}
+	m.syncWorkTabs()
+}
+
+// label is the text of a group header in the tabs bar
+func (h workGroupHeader) label() string {
+	if h.rootID == "" {
+		return "ungrouped"
+	}
+	label := h.rootID
+	if h.title != "" {
+		label += " " + ansi.Truncate(h.title, 20, "…")
+	}
+	return fmt.Sprintf("%s %d%%", label, h.percent)
+}
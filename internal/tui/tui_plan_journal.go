@@ -0,0 +1,278 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/db"
+	workpkg "github.com/newhook/co/internal/work"
+)
+
+// maxJournalEntries is how many actions the session journal keeps
+const maxJournalEntries = 50
+
+// journalShown is how many of the newest actions the undo overlay lists
+const journalShown = 10
+
+// journalKind is the kind of action a journal entry records
+type journalKind int
+
+const (
+	journalAssign      journalKind = iota // Beads added to a work
+	journalRemove                         // A bead removed from a work
+	journalMove                           // A bead moved from one work to another
+	journalClose                          // Beads closed
+	journalReopen                         // A bead reopened
+	journalCreateTasks                    // Tasks created for a work
+	journalDestroyWork                    // A work scheduled for destruction, which can't be undone
+)
+
+// journalEntry is an action taken in this TUI session. It holds the IDs
+// needed to reverse the action without looking anything up again.
+type journalEntry struct {
+	kind       journalKind
+	workID     string   // Work acted on; for a move, the work the bead went to
+	fromWorkID string   // Work a moved bead came from
+	beadIDs    []string // Beads assigned, removed, moved, closed or reopened
+	taskIDs    []string // Tasks created, or for a removal the pending tasks the bead was taken out of
+	at         time.Time
+	undone     bool
+}
+
+// describe says what the action did
+func (e *journalEntry) describe() string {
+	ids := strings.Join(e.beadIDs, ", ")
+	switch e.kind {
+	case journalAssign:
+		return fmt.Sprintf("Assigned %s to %s", ids, e.workID)
+	case journalRemove:
+		return fmt.Sprintf("Removed %s from %s", ids, e.workID)
+	case journalMove:
+		return fmt.Sprintf("Moved %s from %s to %s", ids, e.fromWorkID, e.workID)
+	case journalClose:
+		return "Closed " + ids
+	case journalReopen:
+		return "Reopened " + ids
+	case journalCreateTasks:
+		return fmt.Sprintf("Created %s in %s", strings.Join(e.taskIDs, ", "), e.workID)
+	case journalDestroyWork:
+		return "Destroyed " + e.workID
+	}
+	return ""
+}
+
+// undoable reports whether the action can still be undone
+func (e *journalEntry) undoable() bool {
+	return e.kind != journalDestroyWork && !e.undone
+}
+
+// describeUndo says what undoing the action will do
+func (e *journalEntry) describeUndo() string {
+	ids := strings.Join(e.beadIDs, ", ")
+	switch e.kind {
+	case journalAssign:
+		return fmt.Sprintf("Removes %s from %s.", ids, e.workID)
+	case journalRemove:
+		if len(e.taskIDs) > 0 {
+			return fmt.Sprintf("Adds %s back to %s, unassigned: it isn't put back in %s.", ids, e.workID, strings.Join(e.taskIDs, ", "))
+		}
+		return fmt.Sprintf("Adds %s back to %s.", ids, e.workID)
+	case journalMove:
+		return fmt.Sprintf("Moves %s back to %s.", ids, e.fromWorkID)
+	case journalClose:
+		return "Reopens " + ids + "."
+	case journalReopen:
+		return "Closes " + ids + "."
+	case journalCreateTasks:
+		return fmt.Sprintf("Deletes %s, if none of them has started.", strings.Join(e.taskIDs, ", "))
+	}
+	return ""
+}
+
+// createdTasksEntry returns the journal entry for tasks a command created,
+// or nil if it created none
+func createdTasksEntry(workID string, taskIDs []string) *journalEntry {
+	if len(taskIDs) == 0 {
+		return nil
+	}
+	return &journalEntry{kind: journalCreateTasks, workID: workID, taskIDs: taskIDs}
+}
+
+// journalUndoneMsg reports the result of undoing a journal entry
+type journalUndoneMsg struct {
+	entry *journalEntry
+	err   error
+}
+
+// recordJournal adds a completed action to the session journal. A nil entry
+// is ignored, so callers can pass whatever their command attached.
+func (m *planModel) recordJournal(entry *journalEntry) {
+	if entry == nil {
+		return
+	}
+	if entry.at.IsZero() {
+		entry.at = time.Now()
+	}
+	m.journal = append(m.journal, entry)
+	if over := len(m.journal) - maxJournalEntries; over > 0 {
+		m.journal = append([]*journalEntry(nil), m.journal[over:]...)
+	}
+}
+
+// lastUndoable returns the newest action that can still be undone, or nil
+func (m *planModel) lastUndoable() *journalEntry {
+	for i := len(m.journal) - 1; i >= 0; i-- {
+		if m.journal[i].undoable() {
+			return m.journal[i]
+		}
+	}
+	return nil
+}
+
+// openUndo shows the recent actions and offers to undo the newest one that
+// can be undone
+func (m *planModel) openUndo() {
+	m.undoTarget = m.lastUndoable()
+	m.viewMode = ViewUndoConfirm
+}
+
+func (m *planModel) updateUndoConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		entry := m.undoTarget
+		if entry == nil {
+			return m, nil
+		}
+		m.viewMode = ViewNormal
+		m.undoTarget = nil
+		m.statusMessage = "Undoing: " + entry.describe()
+		m.statusIsError = false
+		return m, m.undoJournalEntry(entry)
+	case "n", "N", "esc", "u":
+		m.viewMode = ViewNormal
+		m.undoTarget = nil
+	}
+	return m, nil
+}
+
+// undoJournalEntry reverses an action using the IDs its entry recorded
+func (m *planModel) undoJournalEntry(entry *journalEntry) tea.Cmd {
+	return func() tea.Msg {
+		return journalUndoneMsg{entry: entry, err: m.reverse(entry)}
+	}
+}
+
+func (m *planModel) reverse(entry *journalEntry) error {
+	beadsPath := m.proj.BeadsPath()
+	switch entry.kind {
+	case journalAssign:
+		for _, beadID := range entry.beadIDs {
+			_, err := m.workService.RemoveBeadFromWork(m.ctx, entry.workID, beadID, false)
+			var inTask *workpkg.BeadInTaskError
+			if errors.As(err, &inTask) {
+				return coerrors.Errorf(coerrors.Conflict, "%s is in task %s now; remove it from the work with x", beadID, strings.Join(inTask.TaskIDs, ", "))
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case journalRemove:
+		_, err := m.workService.AddBeads(m.ctx, entry.workID, entry.beadIDs, false)
+		return err
+	case journalMove:
+		for _, beadID := range entry.beadIDs {
+			if err := m.proj.DB.MoveWorkBead(m.ctx, entry.workID, entry.fromWorkID, beadID); err != nil {
+				return err
+			}
+		}
+		return nil
+	case journalClose:
+		for _, beadID := range entry.beadIDs {
+			if err := beads.Reopen(m.ctx, beadID, beadsPath); err != nil {
+				return coerrors.Wrap(coerrors.ExternalTool, err)
+			}
+		}
+		return nil
+	case journalReopen:
+		if err := beads.CloseMany(m.ctx, entry.beadIDs, beadsPath); err != nil {
+			return coerrors.Wrap(coerrors.ExternalTool, err)
+		}
+		return nil
+	case journalCreateTasks:
+		// Check every task first so none is deleted unless all can be
+		var remaining []string
+		for _, taskID := range entry.taskIDs {
+			task, err := m.proj.DB.GetTask(m.ctx, taskID)
+			if err != nil {
+				return fmt.Errorf("failed to get task %s: %w", taskID, err)
+			}
+			if task == nil {
+				continue
+			}
+			if task.Status != db.StatusPending {
+				return coerrors.Errorf(coerrors.Conflict, "task %s is already %s", taskID, task.Status)
+			}
+			remaining = append(remaining, taskID)
+		}
+		for _, taskID := range remaining {
+			if err := m.proj.DB.DeleteTask(m.ctx, taskID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return coerrors.Errorf(coerrors.Validation, "%s can't be undone", entry.describe())
+}
+
+// handleJournalUndone reports an undo and refreshes what it changed
+func (m *planModel) handleJournalUndone(msg journalUndoneMsg) tea.Cmd {
+	if msg.err != nil {
+		return m.showCommandError("Undo", msg.entry.workID, msg.err, nil)
+	}
+	msg.entry.undone = true
+	m.statusMessage = "Undone: " + msg.entry.describe()
+	m.statusIsError = false
+	return tea.Batch(m.refreshData(), m.loadWorkTiles())
+}
+
+func (m *planModel) renderUndoConfirmContent() string {
+	var b strings.Builder
+	b.WriteString(m.theme.Title.Render("Recent actions"))
+	b.WriteString("\n\n")
+
+	if len(m.journal) == 0 {
+		b.WriteString("  " + m.theme.Dim.Render("Nothing done in this session yet") + "\n")
+	}
+	shown := m.journal[max(len(m.journal)-journalShown, 0):]
+	for i := len(shown) - 1; i >= 0; i-- {
+		entry := shown[i]
+		line := fmt.Sprintf("  %s  %s", entry.at.Format("15:04"), entry.describe())
+		switch {
+		case entry == m.undoTarget:
+			line = lipgloss.NewStyle().Foreground(m.theme.AccentColor).Bold(true).Render(line + "  ↶")
+		case entry.undone:
+			line = m.theme.Dim.Render(line + "  (undone)")
+		case entry.kind == journalDestroyWork:
+			line += m.theme.Dim.Render("  (can't be undone)")
+		}
+		b.WriteString(line + "\n")
+	}
+	if hidden := len(m.journal) - len(shown); hidden > 0 {
+		b.WriteString(m.theme.Dim.Render(fmt.Sprintf("  ... and %d earlier", hidden)) + "\n")
+	}
+
+	b.WriteString("\n")
+	if m.undoTarget == nil {
+		b.WriteString("  Nothing to undo.\n\n  [Esc] Close")
+	} else {
+		fmt.Fprintf(&b, "  Undo %q?\n  %s\n\n  [y] Undo  [n/Esc] Cancel", m.undoTarget.describe(), m.undoTarget.describeUndo())
+	}
+	return m.theme.Dialog.Render(b.String())
+}
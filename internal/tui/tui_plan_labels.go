@@ -0,0 +1,237 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/beads"
+)
+
+// maxLabelSuggestions caps how many labels the picker and filter dialogs list
+const maxLabelSuggestions = 8
+
+// projectLabelsLoadedMsg carries the labels in use across the project's beads
+type projectLabelsLoadedMsg struct {
+	labels []string
+	err    error
+}
+
+// labelsAppliedMsg reports the outcome of adding or removing a label on beads
+type labelsAppliedMsg struct {
+	label   string
+	beadIDs []string
+	removed bool
+	err     error
+}
+
+// parseLabels splits a comma separated label list, dropping blanks and duplicates.
+func parseLabels(s string) []string {
+	var labels []string
+	for _, part := range strings.Split(s, ",") {
+		label := strings.TrimSpace(part)
+		if label != "" && !slices.Contains(labels, label) {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+// labelChanges returns the labels to add and remove to turn before into after.
+func labelChanges(before, after []string) (add, remove []string) {
+	for _, label := range after {
+		if !slices.Contains(before, label) {
+			add = append(add, label)
+		}
+	}
+	for _, label := range before {
+		if !slices.Contains(after, label) {
+			remove = append(remove, label)
+		}
+	}
+	return add, remove
+}
+
+// matchingLabels returns the labels containing query (case-insensitive),
+// with prefix matches first.
+func matchingLabels(labels []string, query string) []string {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return labels
+	}
+	var prefix, contains []string
+	for _, label := range labels {
+		lower := strings.ToLower(label)
+		if strings.HasPrefix(lower, query) {
+			prefix = append(prefix, label)
+		} else if strings.Contains(lower, query) {
+			contains = append(contains, label)
+		}
+	}
+	return append(prefix, contains...)
+}
+
+// loadProjectLabels collects the labels used by the project's beads
+func (m *planModel) loadProjectLabels() tea.Cmd {
+	return func() tea.Msg {
+		labels, err := m.proj.Beads.ListLabels(m.ctx)
+		return projectLabelsLoadedMsg{labels: labels, err: err}
+	}
+}
+
+// labelPickerOptions returns the picker entries for the typed text: the text
+// itself first when it isn't an existing label, then the existing matches.
+func (m *planModel) labelPickerOptions() []string {
+	typed := strings.TrimSpace(m.textInput.Value())
+	matches := matchingLabels(m.projectLabels, typed)
+	if typed != "" && !slices.Contains(m.projectLabels, typed) {
+		return append([]string{typed}, matches...)
+	}
+	return matches
+}
+
+// labelOnAllTargets reports whether every bead the picker applies to already has label
+func (m *planModel) labelOnAllTargets(label string) bool {
+	if len(m.labelTargets) == 0 {
+		return false
+	}
+	for _, beadID := range m.labelTargets {
+		item := m.findBeadItem(beadID)
+		if item == nil || !slices.Contains(item.Labels, label) {
+			return false
+		}
+	}
+	return true
+}
+
+// findBeadItem returns the loaded bead item with the given ID, or nil
+func (m *planModel) findBeadItem(beadID string) *beadItem {
+	for i := range m.beadItems {
+		if m.beadItems[i].ID == beadID {
+			return &m.beadItems[i]
+		}
+	}
+	return nil
+}
+
+// openLabelPicker opens the label picker for the selected beads, or the
+// cursor bead when nothing is selected.
+func (m *planModel) openLabelPicker() tea.Cmd {
+	var targets []string
+	for _, item := range m.beadItems {
+		if m.selectedBeads[item.ID] {
+			targets = append(targets, item.ID)
+		}
+	}
+	if len(targets) == 0 {
+		if m.beadsCursor >= len(m.beadItems) {
+			return nil
+		}
+		targets = []string{m.beadItems[m.beadsCursor].ID}
+	}
+
+	m.labelTargets = targets
+	m.labelCursor = 0
+	m.viewMode = ViewLabelPicker
+	m.textInput.Reset()
+	m.textInput.Focus()
+	return m.loadProjectLabels()
+}
+
+func (m *planModel) updateLabelPicker(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyEsc || msg.String() == "esc" || msg.String() == "escape" {
+		m.viewMode = ViewNormal
+		m.textInput.Blur()
+		return m, nil
+	}
+	options := m.labelPickerOptions()
+	switch msg.String() {
+	case "down", "ctrl+n":
+		if m.labelCursor < len(options)-1 {
+			m.labelCursor++
+		}
+		return m, nil
+	case "up", "ctrl+p":
+		if m.labelCursor > 0 {
+			m.labelCursor--
+		}
+		return m, nil
+	case "enter":
+		if m.labelCursor >= len(options) {
+			return m, nil
+		}
+		label := options[m.labelCursor]
+		m.viewMode = ViewNormal
+		m.textInput.Blur()
+		// Applying a label every target already has takes it off instead
+		remove := m.labelOnAllTargets(label)
+		return m, m.applyLabel(label, m.labelTargets, remove)
+	default:
+		var cmd tea.Cmd
+		m.textInput, cmd = m.textInput.Update(msg)
+		m.labelCursor = 0
+		return m, cmd
+	}
+}
+
+// applyLabel adds label to, or removes it from, each of the given beads
+func (m *planModel) applyLabel(label string, beadIDs []string, remove bool) tea.Cmd {
+	return func() tea.Msg {
+		beadsPath := m.proj.BeadsPath()
+		for _, beadID := range beadIDs {
+			var err error
+			if remove {
+				err = beads.RemoveLabels(m.ctx, beadID, beadsPath, []string{label})
+			} else {
+				err = beads.AddLabels(m.ctx, beadID, beadsPath, []string{label})
+			}
+			if err != nil {
+				return labelsAppliedMsg{label: label, beadIDs: beadIDs, removed: remove, err: err}
+			}
+		}
+		return labelsAppliedMsg{label: label, beadIDs: beadIDs, removed: remove}
+	}
+}
+
+func (m *planModel) renderLabelPickerContent() string {
+	var b strings.Builder
+	if len(m.labelTargets) == 1 {
+		fmt.Fprintf(&b, "\n  Label %s\n\n", m.labelTargets[0])
+	} else {
+		fmt.Fprintf(&b, "\n  Label %d issues\n\n", len(m.labelTargets))
+	}
+	fmt.Fprintf(&b, "  %s\n\n", m.textInput.View())
+
+	options := m.labelPickerOptions()
+	if len(options) == 0 {
+		b.WriteString(m.theme.Dim.Render("  No labels yet; type one to create it") + "\n")
+	}
+	// Keep the cursor in view when there are more options than rows
+	start := 0
+	if m.labelCursor >= maxLabelSuggestions {
+		start = m.labelCursor - maxLabelSuggestions + 1
+	}
+	for i := start; i < len(options) && i < start+maxLabelSuggestions; i++ {
+		label := options[i]
+		cursor := "  "
+		if i == m.labelCursor {
+			cursor = "> "
+		}
+		var note string
+		switch {
+		case !slices.Contains(m.projectLabels, label):
+			note = " (new)"
+		case m.labelOnAllTargets(label):
+			note = " " + m.theme.Icons.Completed + " (Enter removes)"
+		}
+		fmt.Fprintf(&b, "  %s%s%s\n", cursor, label, m.theme.Dim.Render(note))
+	}
+	if len(options) > start+maxLabelSuggestions {
+		b.WriteString(m.theme.Dim.Render(fmt.Sprintf("    ... %d more", len(options)-start-maxLabelSuggestions)) + "\n")
+	}
+
+	b.WriteString("\n  [↑/↓] Select  [Enter] Apply  [Esc] Cancel\n")
+
+	return m.theme.Dialog.Render(b.String())
+}
@@ -0,0 +1,108 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLabels(t *testing.T) {
+	require.Equal(t, []string{"ui", "backend"}, parseLabels(" ui, backend ,, ui"))
+	require.Nil(t, parseLabels(" , "))
+}
+
+func TestLabelChanges(t *testing.T) {
+	add, remove := labelChanges([]string{"ui", "old"}, []string{"ui", "new"})
+	require.Equal(t, []string{"new"}, add)
+	require.Equal(t, []string{"old"}, remove)
+
+	add, remove = labelChanges(nil, nil)
+	require.Empty(t, add)
+	require.Empty(t, remove)
+}
+
+func TestMatchingLabels(t *testing.T) {
+	labels := []string{"backend", "needs-ui", "UI", "urgent"}
+	require.Equal(t, []string{"UI", "needs-ui"}, matchingLabels(labels, "ui"))
+	require.Equal(t, labels, matchingLabels(labels, " "))
+	require.Empty(t, matchingLabels(labels, "zzz"))
+}
+
+func TestBeadFormEditLabels(t *testing.T) {
+	p := NewBeadFormPanel(DarkTheme())
+	p.SetEditMode("bead-1", "Fix login", "", "task", 2, "open", []string{"ui"})
+	require.Contains(t, p.Render(30), "ui")
+
+	// title -> type -> priority -> status -> labels
+	for range 4 {
+		p.Update(tea.KeyMsg{Type: tea.KeyTab})
+	}
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(", auth")})
+
+	result := p.GetResult()
+	require.Equal(t, []string{"ui", "auth"}, result.Labels)
+	require.Equal(t, []string{"ui"}, result.PrevLabels)
+
+	// Enter in the labels field submits the form
+	_, action := p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.Equal(t, BeadFormActionSubmit, action)
+}
+
+func TestPlanFlowLabelPicker(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+	m.beadItems[0].Labels = []string{"ui"}
+	m.beadItems[1].Labels = []string{"ui"}
+	m.Update(projectLabelsLoadedMsg{labels: []string{"backend", "ui"}})
+
+	// Without a selection # applies to the cursor issue
+	require.NotNil(t, press(m, "#"))
+	require.Equal(t, ViewLabelPicker, m.viewMode)
+	require.Equal(t, []string{"bead-1"}, m.labelTargets)
+	require.Equal(t, []string{"backend", "ui"}, m.labelPickerOptions())
+	press(m, "esc")
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	// With a selection it applies to every selected issue
+	press(m, " ", "j", " ", "#")
+	require.Equal(t, []string{"bead-1", "bead-2"}, m.labelTargets)
+
+	// Typed text that isn't a label yet is offered first, as a new label
+	press(m, "u")
+	require.Equal(t, []string{"u", "ui"}, m.labelPickerOptions())
+	require.Contains(t, m.View(), "(new)")
+
+	// Both issues already have ui, so picking it removes it
+	press(m, "down")
+	require.True(t, m.labelOnAllTargets("ui"))
+	require.NotNil(t, press(m, "enter"))
+	require.Equal(t, ViewNormal, m.viewMode)
+
+	m.Update(labelsAppliedMsg{label: "ui", beadIDs: []string{"bead-1", "bead-2"}, removed: true})
+	require.False(t, m.statusIsError)
+	require.Equal(t, "Removed label ui from bead-1, bead-2", m.statusMessage)
+	require.Empty(t, m.selectedBeads)
+}
+
+func TestLabelFilterCompletion(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+	press(m, "L")
+	require.Equal(t, ViewLabelFilter, m.viewMode)
+	m.Update(projectLabelsLoadedMsg{labels: []string{"backend", "ui"}})
+
+	press(m, "b", "a")
+	require.Contains(t, m.View(), "backend")
+	press(m, "tab")
+	require.Equal(t, "backend", m.textInput.Value())
+
+	press(m, "enter")
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.Equal(t, "backend", m.filters.label)
+}
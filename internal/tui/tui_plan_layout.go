@@ -0,0 +1,175 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// defaultColumnRatio gives the issues column 40% of the width
+	defaultColumnRatio = 0.4
+	// columnRatioStep is how much < and > move the split
+	columnRatioStep = 0.05
+	// minColumnRatio and maxColumnRatio bound the split on any width
+	minColumnRatio = 0.2
+	maxColumnRatio = 0.8
+	// minColumnWidth is the narrowest a column of the split gets, wide
+	// enough for an issue line or a task's details to stay readable
+	minColumnWidth = 30
+)
+
+// Panels as detectClickedPanel names them, also used for the maximized panel
+const (
+	panelWorkLeft    = "work-left"
+	panelWorkRight   = "work-right"
+	panelIssuesLeft  = "issues-left"
+	panelIssuesRight = "issues-right"
+)
+
+// clampColumnRatio keeps a column ratio within bounds, and narrows them so
+// that neither column of a split of the given width drops below
+// minColumnWidth. A width too narrow for two such columns splits evenly.
+func clampColumnRatio(ratio float64, width int) float64 {
+	lo, hi := minColumnRatio, maxColumnRatio
+	if total := width - 4; total > 0 {
+		// Rounded up to a whole percent, so the truncated width still fits
+		minRatio := math.Ceil(float64(minColumnWidth)*100/float64(total)) / 100
+		lo = max(lo, minRatio)
+		hi = min(hi, 1-minRatio)
+	}
+	if lo > hi {
+		return 0.5
+	}
+	return min(max(ratio, lo), hi)
+}
+
+// effectiveColumnRatio returns the column ratio clamped to the current width.
+// The stored ratio is left as is, so it comes back when the window widens.
+func (m *planModel) effectiveColumnRatio() float64 {
+	return clampColumnRatio(m.columnRatio, m.width)
+}
+
+// adjustColumnRatio moves the split between the left and right columns by
+// delta, in steps of columnRatioStep, and saves it for the next session
+func (m *planModel) adjustColumnRatio(delta float64) {
+	m.maximized = false
+	ratio := math.Round((m.effectiveColumnRatio()+delta)/columnRatioStep) * columnRatioStep
+	ratio = clampColumnRatio(ratio, m.width)
+	if math.Abs(ratio-m.columnRatio) < 0.001 {
+		m.statusMessage = "Columns can't be resized further"
+		m.statusIsError = false
+		return
+	}
+	m.columnRatio = ratio
+	m.saveState()
+	left := int(math.Round(ratio * 100))
+	m.statusMessage = fmt.Sprintf("Columns %d%% / %d%%", left, 100-left)
+	m.statusIsError = false
+}
+
+// toggleMaximize maximizes the focused panel, or restores the split layout
+func (m *planModel) toggleMaximize() {
+	m.maximized = !m.maximized
+	if m.maximized {
+		m.statusMessage = "Panel maximized (_ to restore)"
+	} else {
+		m.statusMessage = ""
+	}
+	m.statusIsError = false
+}
+
+// maximizedPanel returns the panel filling the view while maximize is on, or
+// "" when the layout is split. The maximized panel follows focus, so
+// switching panels with tab maximizes the next one.
+func (m *planModel) maximizedPanel() string {
+	if !m.maximized {
+		return ""
+	}
+	switch m.activePanel {
+	case PanelLeft:
+		return panelIssuesLeft
+	case PanelRight:
+		return panelIssuesRight
+	case PanelWorkDetails:
+		if m.focusedWorkID == "" {
+			return ""
+		}
+		if m.workDetailsFocusLeft {
+			return panelWorkLeft
+		}
+		return panelWorkRight
+	}
+	return ""
+}
+
+// planColumnWidths returns the content widths of the issues and details
+// columns. A maximized column takes the whole width and the other gets 0.
+func (m *planModel) planColumnWidths() (issues, details int) {
+	switch m.maximizedPanel() {
+	case panelIssuesLeft:
+		return m.width - 2, 0
+	case panelIssuesRight:
+		return 0, m.width - 2
+	}
+	totalContentWidth := m.width - 4
+	issues = int(float64(totalContentWidth) * m.effectiveColumnRatio())
+	return issues, totalContentWidth - issues
+}
+
+// workSectionEndY returns the screen row just below the work details panel
+// of the focused work view, for event handling
+func (m *planModel) workSectionEndY() int {
+	tabsBarHeight := m.workTabsBar.Height()
+	switch m.maximizedPanel() {
+	case panelWorkLeft, panelWorkRight:
+		return m.height
+	case panelIssuesLeft, panelIssuesRight:
+		return tabsBarHeight
+	}
+	return tabsBarHeight + m.calculateWorkPanelHeightForEvents() + 2 // +2 for border
+}
+
+// planLayout is where the panels of the focused work view are on screen, for
+// hit-testing. It's worked out from the model by planLayout(), so panelAt
+// stays a pure function of the geometry.
+type planLayout struct {
+	tabsBarHeight int    // Rows taken by the work tabs bar at the top
+	workEndY      int    // First row below the work panel, where the issues start
+	workSplitX    int    // First column of the work panel's right-hand side
+	issuesSplitX  int    // First column of the issue details panel
+	maximized     string // The maximized panel, or "" when split
+}
+
+// planLayout returns the current layout of the focused work view
+func (m *planModel) planLayout() planLayout {
+	issues, _ := m.planColumnWidths()
+	workLeft, _, _ := m.workDetails.columnWidths()
+	return planLayout{
+		tabsBarHeight: m.workTabsBar.Height(),
+		workEndY:      m.workSectionEndY(),
+		workSplitX:    workLeft + 2, // +2 for the left column's border
+		issuesSplitX:  issues + 2,
+		maximized:     m.maximizedPanel(),
+	}
+}
+
+// panelAt returns the panel of the focused work view at screen position
+// (x, y), or "" over the tabs bar
+func panelAt(x, y int, l planLayout) string {
+	switch {
+	case y < l.tabsBarHeight:
+		return ""
+	case l.maximized != "":
+		// A maximized panel is all there is below the tabs bar
+		return l.maximized
+	case y < l.workEndY:
+		if x < l.workSplitX {
+			return panelWorkLeft
+		}
+		return panelWorkRight
+	case x < l.issuesSplitX:
+		return panelIssuesLeft
+	default:
+		return panelIssuesRight
+	}
+}
@@ -0,0 +1,132 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClampColumnRatio(t *testing.T) {
+	// Wide enough that only the fixed bounds apply
+	require.InDelta(t, 0.45, clampColumnRatio(0.45, 300), 0.001)
+	require.InDelta(t, minColumnRatio, clampColumnRatio(0.05, 300), 0.001)
+	require.InDelta(t, maxColumnRatio, clampColumnRatio(0.95, 300), 0.001)
+
+	// Narrower, each column keeps minColumnWidth
+	for _, ratio := range []float64{0, 0.2, 0.8, 1} {
+		clamped := clampColumnRatio(ratio, 100)
+		left := int(float64(96) * clamped)
+		require.GreaterOrEqual(t, left, minColumnWidth, "ratio %v", ratio)
+		require.GreaterOrEqual(t, 96-left, minColumnWidth, "ratio %v", ratio)
+	}
+
+	// Too narrow for two such columns, the split is even
+	require.InDelta(t, 0.5, clampColumnRatio(0.3, 50), 0.001)
+}
+
+func TestPlanFlowResizeColumns(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+	require.NoError(t, os.Mkdir(filepath.Join(m.proj.Root, project.ConfigDir), 0755))
+	m.columnRatio = defaultColumnRatio
+
+	press(m, ">")
+	require.InDelta(t, 0.45, m.columnRatio, 0.001)
+	require.Equal(t, "Columns 45% / 55%", m.statusMessage)
+	press(m, "<", "<", "[")
+	require.InDelta(t, 0.3, m.columnRatio, 0.001)
+
+	// At 120 columns the details column can't drop below minColumnWidth
+	for range 20 {
+		press(m, ">")
+	}
+	_, details := m.planColumnWidths()
+	require.GreaterOrEqual(t, details, minColumnWidth)
+	require.Equal(t, "Columns can't be resized further", m.statusMessage)
+
+	// The split comes back on the next start
+	state, found := loadTUIState(m.proj.Root)
+	require.True(t, found)
+	require.InDelta(t, m.columnRatio, state.columnRatio(), 0.001)
+	require.InDelta(t, defaultColumnRatio, tuiState{}.columnRatio(), 0.001)
+}
+
+func TestPlanFlowMaximizePanel(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	focusWork(t, m, w)
+
+	split := ansi.Strip(m.View())
+	require.Contains(t, split, "Issues")
+
+	// The work panel's task list fills the screen, without the issues below
+	press(m, "_")
+	require.Equal(t, panelWorkLeft, m.maximizedPanel())
+	maximized := ansi.Strip(m.View())
+	require.NotContains(t, maximized, "Issues")
+	require.NotContains(t, maximized, "Details")
+	for _, line := range strings.Split(maximized, "\n") {
+		require.LessOrEqual(t, ansi.StringWidth(line), m.width)
+	}
+
+	// Focus moves the maximized panel along
+	m.activePanel = PanelLeft
+	require.Equal(t, panelIssuesLeft, m.maximizedPanel())
+	require.Contains(t, ansi.Strip(m.View()), "Issues")
+
+	// Resizing restores the split, as does pressing _ again
+	press(m, ">")
+	require.Empty(t, m.maximizedPanel())
+	press(m, "_", "_")
+	require.Empty(t, m.maximizedPanel())
+}
+
+func TestPanelAt(t *testing.T) {
+	layout := planLayout{tabsBarHeight: 3, workEndY: 20, workSplitX: 40, issuesSplitX: 50}
+
+	require.Empty(t, panelAt(10, 2, layout), "the tabs bar isn't a panel")
+	require.Equal(t, panelWorkLeft, panelAt(39, 3, layout))
+	require.Equal(t, panelWorkRight, panelAt(40, 19, layout))
+	// The issues split is its own, the work panel may show the log column
+	require.Equal(t, panelIssuesLeft, panelAt(45, 20, layout))
+	require.Equal(t, panelIssuesRight, panelAt(50, 39, layout))
+
+	layout.maximized = panelIssuesRight
+	require.Equal(t, panelIssuesRight, panelAt(0, 5, layout))
+	require.Empty(t, panelAt(0, 0, layout))
+}
+
+func TestPlanFlowClickFollowsColumnSplit(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	m := newFlowTestModel(t, h)
+	m.columnRatio = defaultColumnRatio
+	focusWork(t, m, w)
+	m.View()
+
+	// At 40% of 116 columns the issue details start at column 48
+	layout := m.planLayout()
+	require.Equal(t, 48, layout.issuesSplitX)
+	require.Equal(t, panelIssuesRight, panelAt(60, layout.workEndY, layout))
+
+	// Widening the issues column moves the click target with it
+	m.columnRatio = 0.6
+	m.View()
+	layout = m.planLayout()
+	require.Equal(t, 71, layout.issuesSplitX)
+	require.Equal(t, panelIssuesLeft, panelAt(60, layout.workEndY, layout))
+	require.Equal(t, panelWorkLeft, panelAt(60, layout.tabsBarHeight, layout))
+}
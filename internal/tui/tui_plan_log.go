@@ -0,0 +1,81 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/tail"
+)
+
+// orchestratorLogMsg reports that the tailed orchestrator log changed
+type orchestratorLogMsg struct {
+	tailer *tail.Tailer
+}
+
+// toggleLog shows or hides the focused work's orchestrator log column
+func (m *planModel) toggleLog() {
+	show := !m.workDetails.IsLogShown()
+	m.workDetails.SetShowLog(show)
+	if show {
+		m.statusMessage = "Showing the orchestrator log (L to hide)"
+	} else {
+		m.statusMessage = "Orchestrator log hidden"
+	}
+	m.statusIsError = false
+}
+
+// syncLogTail makes the log tailer follow the focused work's log while the
+// log column is shown, and stops it otherwise. It runs after every update, so
+// focusing another work switches logs whichever way the focus moved.
+func (m *planModel) syncLogTail() tea.Cmd {
+	path := ""
+	if m.focusedWorkID != "" && m.workDetails.IsLogShown() {
+		path = m.proj.OrchestratorLogPath(m.focusedWorkID)
+	}
+	if m.logTail != nil && m.logTail.Path() == path {
+		return nil
+	}
+
+	m.stopLogTail()
+	if path == "" {
+		return nil
+	}
+	m.logTail = tail.New(tail.DefaultConfig(path))
+	m.logTail.Start()
+	m.workDetails.LogPanel().SetLog(m.logTail.Lines(), m.logTail.Exists())
+	return m.waitForLogTail(m.logTail)
+}
+
+// stopLogTail stops the log tailer, if one is running, and clears the log
+func (m *planModel) stopLogTail() {
+	if m.logTail == nil {
+		return
+	}
+	m.logTail.Stop()
+	m.logTail = nil
+	m.workDetails.LogPanel().Clear()
+}
+
+// waitForLogTail waits for the tailer's next change. It returns nil once the
+// tailer is stopped.
+func (m *planModel) waitForLogTail(tailer *tail.Tailer) tea.Cmd {
+	return func() tea.Msg {
+		select {
+		case _, ok := <-tailer.Updates():
+			if !ok {
+				return nil
+			}
+			return orchestratorLogMsg{tailer: tailer}
+		case <-m.ctx.Done():
+			return nil
+		}
+	}
+}
+
+// handleOrchestratorLog shows the tailer's latest lines and waits for more
+func (m *planModel) handleOrchestratorLog(msg orchestratorLogMsg) tea.Cmd {
+	if msg.tailer != m.logTail {
+		// A tailer since replaced; its wait ended when it was stopped
+		return nil
+	}
+	m.workDetails.LogPanel().SetLog(msg.tailer.Lines(), msg.tailer.Exists())
+	return m.waitForLogTail(msg.tailer)
+}
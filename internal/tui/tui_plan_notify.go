@@ -0,0 +1,87 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/logging"
+	"github.com/newhook/co/internal/notify"
+	"github.com/newhook/co/internal/progress"
+)
+
+// taskEvent is a task reaching a terminal status between two work tile loads
+type taskEvent struct {
+	workID   string
+	workName string
+	taskID   string
+	status   string // db.StatusCompleted or db.StatusFailed
+}
+
+// taskTransitions returns the tasks that were known in prev and have since
+// completed or failed in curr. Tasks seen for the first time are skipped, so
+// the initial load never produces events.
+func taskTransitions(prev, curr []*progress.WorkProgress) []taskEvent {
+	statuses := make(map[string]string) // taskID -> status in prev
+	for _, wp := range prev {
+		if wp == nil {
+			continue
+		}
+		for _, tp := range wp.Tasks {
+			statuses[tp.Task.ID] = tp.Task.Status
+		}
+	}
+
+	var events []taskEvent
+	for _, wp := range curr {
+		if wp == nil {
+			continue
+		}
+		for _, tp := range wp.Tasks {
+			status := tp.Task.Status
+			if status != db.StatusCompleted && status != db.StatusFailed {
+				continue
+			}
+			before, seen := statuses[tp.Task.ID]
+			if !seen || before == status {
+				continue
+			}
+			events = append(events, taskEvent{
+				workID:   wp.Work.ID,
+				workName: wp.Work.Name,
+				taskID:   tp.Task.ID,
+				status:   status,
+			})
+		}
+	}
+	return events
+}
+
+// notifyTaskEvents sends a desktop notification for each event the config
+// asks for, unless notifications are muted for this session.
+func (m *planModel) notifyTaskEvents(events []taskEvent) tea.Cmd {
+	if m.notificationsMuted {
+		return nil
+	}
+	var wanted []taskEvent
+	for _, e := range events {
+		if m.proj.Config.TUI.ShouldNotify(e.status) {
+			wanted = append(wanted, e)
+		}
+	}
+	if len(wanted) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		for _, e := range wanted {
+			title := "co: " + e.workID
+			if e.workName != "" {
+				title = fmt.Sprintf("co: %s (%s)", e.workName, e.workID)
+			}
+			if err := notify.Send(m.ctx, title, fmt.Sprintf("Task %s %s", e.taskID, e.status)); err != nil {
+				logging.Debug("task notification failed", "taskID", e.taskID, "error", err)
+			}
+		}
+		return nil
+	}
+}
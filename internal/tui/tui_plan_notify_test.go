@@ -0,0 +1,62 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/progress"
+	"github.com/newhook/co/internal/project"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tilesWithTasks builds one work tile whose tasks have the given statuses
+func tilesWithTasks(statuses map[string]string) []*progress.WorkProgress {
+	wp := &progress.WorkProgress{Work: &db.Work{ID: "w-abc", Name: "brave_turing"}}
+	for _, id := range []string{"w-abc.1", "w-abc.2", "w-abc.3", "w-abc.4"} {
+		if status, ok := statuses[id]; ok {
+			wp.Tasks = append(wp.Tasks, &progress.TaskProgress{Task: &db.Task{ID: id, Status: status}})
+		}
+	}
+	return []*progress.WorkProgress{wp}
+}
+
+func TestTaskTransitions(t *testing.T) {
+	prev := tilesWithTasks(map[string]string{
+		"w-abc.1": db.StatusProcessing,
+		"w-abc.2": db.StatusPending,
+		"w-abc.3": db.StatusCompleted,
+	})
+	curr := tilesWithTasks(map[string]string{
+		"w-abc.1": db.StatusCompleted,
+		"w-abc.2": db.StatusFailed,
+		"w-abc.3": db.StatusCompleted, // unchanged
+		"w-abc.4": db.StatusCompleted, // first seen
+	})
+
+	events := taskTransitions(prev, curr)
+	assert.Equal(t, []taskEvent{
+		{workID: "w-abc", workName: "brave_turing", taskID: "w-abc.1", status: db.StatusCompleted},
+		{workID: "w-abc", workName: "brave_turing", taskID: "w-abc.2", status: db.StatusFailed},
+	}, events)
+
+	assert.Empty(t, taskTransitions(nil, curr), "the first load never notifies")
+	assert.Empty(t, taskTransitions(curr, curr))
+}
+
+func TestNotifyTaskEventsHonorsConfigAndMute(t *testing.T) {
+	cfg := &project.Config{}
+	m := &planModel{proj: &project.Project{Config: cfg}}
+	events := []taskEvent{{workID: "w-abc", taskID: "w-abc.1", status: db.StatusCompleted}}
+
+	require.Nil(t, m.notifyTaskEvents(events), "notifications are off by default")
+
+	cfg.TUI.NotifyOnFail = true
+	require.Nil(t, m.notifyTaskEvents(events), "completions aren't enabled")
+
+	cfg.TUI.NotifyOnComplete = true
+	require.NotNil(t, m.notifyTaskEvents(events))
+
+	m.notificationsMuted = true
+	require.Nil(t, m.notifyTaskEvents(events))
+}
@@ -0,0 +1,118 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// maxPaletteRows caps how many actions the command palette lists at once
+const maxPaletteRows = 12
+
+// openCommandPalette shows the fuzzy-searchable list of actions for the active panel
+func (m *planModel) openCommandPalette() (tea.Model, tea.Cmd) {
+	m.viewMode = ViewCommandPalette
+	m.paletteCursor = 0
+	m.textInput.Reset()
+	m.textInput.Focus()
+	return m, nil
+}
+
+// paletteActions returns the actions for the active panel matching the palette
+// query. Actions bound to the query or containing it come before fuzzy matches.
+func (m *planModel) paletteActions() []*planAction {
+	query := strings.TrimSpace(m.textInput.Value())
+	if query == "" {
+		return m.contextActions()
+	}
+	var exact, fuzzy []*planAction
+	for _, a := range m.contextActions() {
+		switch {
+		case a.key == query || strings.Contains(strings.ToLower(a.name), strings.ToLower(query)):
+			exact = append(exact, a)
+		case fuzzyMatch(a.name, query):
+			fuzzy = append(fuzzy, a)
+		}
+	}
+	return append(exact, fuzzy...)
+}
+
+func (m *planModel) updateCommandPalette(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if msg.Type == tea.KeyEsc || msg.String() == "esc" || msg.String() == "escape" {
+		m.viewMode = ViewNormal
+		m.textInput.Blur()
+		return m, nil
+	}
+	actions := m.paletteActions()
+	switch msg.String() {
+	case "down", "ctrl+n":
+		if m.paletteCursor < len(actions)-1 {
+			m.paletteCursor++
+		}
+		return m, nil
+	case "up", "ctrl+p":
+		if m.paletteCursor > 0 {
+			m.paletteCursor--
+		}
+		return m, nil
+	case "enter":
+		m.viewMode = ViewNormal
+		m.textInput.Blur()
+		if m.paletteCursor >= len(actions) {
+			return m, nil
+		}
+		action := actions[m.paletteCursor]
+		if reason := action.reason(m); reason != "" {
+			m.statusMessage = fmt.Sprintf("%s: %s", action.name, reason)
+			m.statusIsError = true
+			return m, nil
+		}
+		return m, action.run(m)
+	default:
+		var cmd tea.Cmd
+		m.textInput, cmd = m.textInput.Update(msg)
+		m.paletteCursor = 0
+		return m, cmd
+	}
+}
+
+func (m *planModel) renderCommandPaletteContent() string {
+	var b strings.Builder
+	b.WriteString("\n  Commands\n\n")
+	fmt.Fprintf(&b, "  %s\n\n", m.textInput.View())
+
+	actions := m.paletteActions()
+	if len(actions) == 0 {
+		b.WriteString(m.theme.Dim.Render("  No matching commands") + "\n")
+	}
+
+	// Scroll so the cursor stays in view
+	start := max(m.paletteCursor-maxPaletteRows+1, 0)
+	end := min(start+maxPaletteRows, len(actions))
+	nameWidth := 0
+	for _, a := range actions[start:end] {
+		nameWidth = max(nameWidth, ansi.StringWidth(a.name))
+	}
+	for i := start; i < end; i++ {
+		a := actions[i]
+		cursor := "  "
+		if i == m.paletteCursor {
+			cursor = "> "
+		}
+		row := fmt.Sprintf("%s%s%s  %s", cursor, a.name, strings.Repeat(" ", nameWidth-ansi.StringWidth(a.name)), a.displayKey())
+		if reason := a.reason(m); reason != "" {
+			b.WriteString("  " + m.theme.Dim.Render(row+"  ("+reason+")") + "\n")
+		} else {
+			b.WriteString("  " + row + "\n")
+		}
+	}
+	if end < len(actions) {
+		b.WriteString(m.theme.Dim.Render(fmt.Sprintf("    ... %d more", len(actions)-end)) + "\n")
+	}
+
+	b.WriteString("\n  [↑/↓] Select  [Enter] Run  [Esc] Cancel\n")
+
+	return m.theme.Dialog.Render(b.String())
+}
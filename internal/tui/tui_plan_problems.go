@@ -0,0 +1,92 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/progress"
+)
+
+// isProblemWork reports whether a work needs attention: one of its tasks
+// failed, or it's processing while its orchestrator is dead. Works whose
+// orchestrator health hasn't been checked yet don't count as dead.
+func isProblemWork(wp *progress.WorkProgress, health map[string]db.OrchestratorHealth) bool {
+	if wp == nil {
+		return false
+	}
+	if firstFailedTask(wp) >= 0 {
+		return true
+	}
+	orchestrator, checked := health[wp.Work.ID]
+	return wp.Work.Status == db.StatusProcessing && checked && orchestrator != db.OrchestratorRunning
+}
+
+// firstFailedTask returns the index of the work's first failed task, or -1
+func firstFailedTask(wp *progress.WorkProgress) int {
+	for i, task := range wp.Tasks {
+		if task.Task.Status == db.StatusFailed {
+			return i
+		}
+	}
+	return -1
+}
+
+// problemWorkCount counts the loaded works that need attention. It only
+// looks at data the regular refresh already loaded.
+func (m *planModel) problemWorkCount() int {
+	count := 0
+	for _, wp := range m.workTiles {
+		if isProblemWork(wp, m.orchestratorHealth) {
+			count++
+		}
+	}
+	return count
+}
+
+// visibleWorkTiles returns the works shown in the tabs bar and reachable with
+// 1-9 and h/l: all of them, or only the problem works while the problems
+// filter is on, in groups of the same root issue while works are grouped
+func (m *planModel) visibleWorkTiles() []*progress.WorkProgress {
+	works := m.workTiles
+	if m.problemsOnly {
+		works = nil
+		for _, wp := range m.workTiles {
+			if isProblemWork(wp, m.orchestratorHealth) {
+				works = append(works, wp)
+			}
+		}
+	}
+	if m.groupByRoot {
+		works = orderWorksByRoot(works)
+	}
+	return works
+}
+
+// toggleProblemsFilter turns the problems filter on or off. Turning it on
+// while zoomed into a work with a failed task moves the cursor to that task.
+func (m *planModel) toggleProblemsFilter() tea.Cmd {
+	m.problemsOnly = !m.problemsOnly
+	m.syncWorkTabs()
+	m.statusIsError = false
+	if !m.problemsOnly {
+		m.statusMessage = "Showing all works"
+		return nil
+	}
+
+	count := m.problemWorkCount()
+	if count == 0 {
+		m.statusMessage = "Showing problem works: none right now"
+		return nil
+	}
+	m.statusMessage = fmt.Sprintf("Showing %d problem work(s): failed tasks or dead orchestrators (F shows all)", count)
+
+	if wp := m.findWorkByID(m.focusedWorkID); wp != nil {
+		if idx := firstFailedTask(wp); idx >= 0 {
+			m.activePanel = PanelWorkDetails
+			m.workDetails.SetSelectedIndex(idx + 1) // 0 is the root issue
+			return m.updateWorkSelectionFilter()
+		}
+	}
+	return nil
+}
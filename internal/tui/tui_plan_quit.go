@@ -0,0 +1,75 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/db"
+)
+
+// quitConfirmedMsg asks the root model to quit once the user confirmed
+// quitting while tasks are processing
+type quitConfirmedMsg struct{}
+
+// maxQuitTasksShown is how many processing tasks the quit dialog lists
+const maxQuitTasksShown = 5
+
+// processingTasks returns the tasks processing across all works, as
+// "work/task" pairs
+func (m *planModel) processingTasks() []string {
+	var tasks []string
+	for _, wp := range m.workTiles {
+		if wp == nil {
+			continue
+		}
+		for _, tp := range wp.Tasks {
+			if tp.Task.Status == db.StatusProcessing {
+				tasks = append(tasks, wp.Work.ID+"/"+tp.Task.ID)
+			}
+		}
+	}
+	return tasks
+}
+
+// openQuitConfirm opens the quit dialog when tasks are processing and
+// [tui] confirm_quit is on, and reports whether it did. Otherwise the caller
+// quits right away.
+func (m *planModel) openQuitConfirm() bool {
+	if !m.confirmQuit || len(m.processingTasks()) == 0 {
+		return false
+	}
+	m.viewMode = ViewQuitConfirm
+	return true
+}
+
+// updateQuitConfirm handles the quit dialog
+func (m *planModel) updateQuitConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y", "q":
+		m.viewMode = ViewNormal
+		return m, func() tea.Msg { return quitConfirmedMsg{} }
+	case "n", "N", "esc":
+		m.viewMode = ViewNormal
+	}
+	return m, nil
+}
+
+func (m *planModel) renderQuitConfirmContent() string {
+	tasks := m.processingTasks()
+	var b strings.Builder
+	b.WriteString(m.theme.Title.Render("Quit?") + "\n\n")
+	noun := "tasks"
+	if len(tasks) == 1 {
+		noun = "task"
+	}
+	fmt.Fprintf(&b, "  %d %s still processing — orchestrators keep running in the background.\n\n", len(tasks), noun)
+	for _, task := range tasks[:min(len(tasks), maxQuitTasksShown)] {
+		b.WriteString("  " + m.theme.Dim.Render(m.theme.Icons.Processing+" "+task) + "\n")
+	}
+	if hidden := len(tasks) - maxQuitTasksShown; hidden > 0 {
+		b.WriteString(m.theme.Dim.Render(fmt.Sprintf("  ... and %d more", hidden)) + "\n")
+	}
+	b.WriteString("\n" + m.theme.styleHotkeys("  [y] Quit  [n/Esc] Stay  (ctrl+c twice quits at once)"))
+	return m.theme.Dialog.Render(b.String())
+}
@@ -0,0 +1,83 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/logging"
+	"github.com/newhook/co/internal/progress"
+)
+
+// workStatusesReconciledMsg carries the works whose status was corrected to
+// match their tasks
+type workStatusesReconciledMsg struct {
+	corrections []*db.WorkStatusCorrection
+}
+
+// reconcileWorkStatuses corrects the status of loaded works that doesn't
+// match their tasks, such as a work left processing by an orchestrator that
+// died after its last task. Only works without an orchestrator are
+// corrected: a live one moves its work's status along itself. It returns nil when every
+// status matches, in read-only mode, and in a secondary TUI, which leaves
+// corrections to the primary.
+func (m *planModel) reconcileWorkStatuses(works []*progress.WorkProgress, health map[string]db.OrchestratorHealth) tea.Cmd {
+	if m.readOnly || m.secondary() {
+		return nil
+	}
+	var workIDs []string
+	for _, wp := range works {
+		if wp == nil {
+			continue
+		}
+		if h, ok := health[wp.Work.ID]; !ok || h != db.OrchestratorDown {
+			continue
+		}
+		tasks := make([]*db.Task, 0, len(wp.Tasks))
+		for _, tp := range wp.Tasks {
+			tasks = append(tasks, tp.Task)
+		}
+		if db.ExpectedWorkStatus(wp.Work.Status, tasks) != wp.Work.Status {
+			workIDs = append(workIDs, wp.Work.ID)
+		}
+	}
+	if len(workIDs) == 0 {
+		return nil
+	}
+
+	return func() tea.Msg {
+		var corrections []*db.WorkStatusCorrection
+		for _, workID := range workIDs {
+			// The work is read again, so a status that changed since the
+			// tiles loaded is checked as it is now
+			correction, err := m.proj.DB.ReconcileWorkStatus(m.ctx, workID)
+			if err != nil {
+				logging.Debug("reconcileWorkStatuses skipped work", "workID", workID, "error", err)
+				continue
+			}
+			if correction != nil {
+				corrections = append(corrections, correction)
+			}
+		}
+		return workStatusesReconciledMsg{corrections: corrections}
+	}
+}
+
+// handleWorkStatusesReconciled reports the corrected works and reloads them
+func (m *planModel) handleWorkStatusesReconciled(msg workStatusesReconciledMsg) tea.Cmd {
+	if len(msg.corrections) == 0 {
+		return nil
+	}
+	for _, c := range msg.corrections {
+		logging.Info("work status auto-corrected", "workID", c.WorkID, "from", c.From, "to", c.To)
+		debugEvent("work status auto-corrected", "workID", c.WorkID, "from", c.From, "to", c.To)
+	}
+	if len(msg.corrections) == 1 {
+		c := msg.corrections[0]
+		m.statusMessage = fmt.Sprintf("Work %s status auto-corrected: %s → %s", c.WorkID, c.From, c.To)
+	} else {
+		m.statusMessage = fmt.Sprintf("Status auto-corrected for %d works", len(msg.corrections))
+	}
+	m.statusIsError = false
+	return m.loadWorkTiles()
+}
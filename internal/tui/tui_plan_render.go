@@ -1,10 +1,13 @@
 package tui
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 	zone "github.com/lrstanley/bubblezone"
 )
 
@@ -15,6 +18,14 @@ func (m *planModel) renderFocusedWorkSplitView() string {
 	// Note: m.height has already been adjusted for tabs bar in View()
 	totalHeight := m.height - 1 // -1 for status bar
 
+	switch m.maximizedPanel() {
+	case panelWorkLeft, panelWorkRight:
+		m.workDetails.SetSize(m.width, totalHeight)
+		return m.workDetails.RenderWithPanel(totalHeight)
+	case panelIssuesLeft, panelIssuesRight:
+		return m.renderPlanSection(totalHeight)
+	}
+
 	// Calculate work panel height
 	// calculateWorkPanelHeight returns content height (10-23)
 	// Add 2 for border to get total panel height
@@ -26,11 +37,15 @@ func (m *planModel) renderFocusedWorkSplitView() string {
 	m.workDetails.SetSize(m.width, workPanelHeight)
 	workPanel := m.workDetails.RenderWithPanel(workPanelHeight)
 
-	// === Render Plan Mode Panel (Bottom) ===
+	// Combine everything vertically (panel borders provide visual separation)
+	return lipgloss.JoinVertical(lipgloss.Left, workPanel, m.renderPlanSection(planPanelHeight))
+}
+
+// renderPlanSection renders the issues and details panels below the work
+// panel of the split view, or just the maximized one of them
+func (m *planModel) renderPlanSection(planPanelHeight int) string {
 	// Update issues and details panel sizes for the reduced height
-	totalContentWidth := m.width - 4
-	issuesWidth := int(float64(totalContentWidth) * m.columnRatio)
-	detailsWidth := totalContentWidth - issuesWidth
+	issuesWidth, detailsWidth := m.planColumnWidths()
 
 	// Temporarily update panel sizes for the reduced height
 	m.issuesPanel.SetSize(issuesWidth, planPanelHeight)
@@ -38,6 +53,9 @@ func (m *planModel) renderFocusedWorkSplitView() string {
 
 	// Render issues panel
 	issuesPanel := m.issuesPanel.RenderWithPanel(planPanelHeight)
+	if m.maximizedPanel() == panelIssuesLeft {
+		return issuesPanel
+	}
 
 	// Select the right panel based on view mode
 	var detailsPanel string
@@ -57,12 +75,12 @@ func (m *planModel) renderFocusedWorkSplitView() string {
 	default:
 		detailsPanel = m.detailsPanel.RenderWithPanel(planPanelHeight)
 	}
+	if m.maximizedPanel() == panelIssuesRight {
+		return detailsPanel
+	}
 
 	// Combine plan mode columns (panels have their own borders)
-	planSection := lipgloss.JoinHorizontal(lipgloss.Top, issuesPanel, detailsPanel)
-
-	// Combine everything vertically (panel borders provide visual separation)
-	return lipgloss.JoinVertical(lipgloss.Left, workPanel, planSection)
+	return lipgloss.JoinHorizontal(lipgloss.Top, issuesPanel, detailsPanel)
 }
 
 // renderTwoColumnLayout renders the issues and details panels side-by-side
@@ -78,6 +96,9 @@ func (m *planModel) renderTwoColumnLayout() string {
 
 	// Use panels for rendering (they're already synced with correct sizes and data)
 	issuesPanel := m.issuesPanel.RenderWithPanel(contentHeight)
+	if m.maximizedPanel() == panelIssuesLeft {
+		return issuesPanel
+	}
 
 	// Select the right panel based on view mode
 	var rightPanel string
@@ -93,6 +114,9 @@ func (m *planModel) renderTwoColumnLayout() string {
 	default:
 		rightPanel = m.detailsPanel.RenderWithPanel(contentHeight)
 	}
+	if m.maximizedPanel() == panelIssuesRight {
+		return rightPanel
+	}
 
 	// Combine columns horizontally (panels have their own borders)
 	return lipgloss.JoinHorizontal(lipgloss.Top, issuesPanel, rightPanel)
@@ -155,43 +179,7 @@ func (m *planModel) detectClickedPanel(msg tea.MouseMsg) string {
 	if m.focusedWorkID == "" {
 		return ""
 	}
-
-	x, y := msg.X, msg.Y
-
-	// Calculate panel boundaries using calculateWorkPanelHeightForEvents (event handling context)
-	tabsBarHeight := m.workTabsBar.Height()
-	workPanelHeight := m.calculateWorkPanelHeightForEvents() + 2 // +2 for border
-	halfWidth := (m.width - 4) / 2                               // Half width
-
-	// Determine Y section (top = work, bottom = issues)
-	// Account for tabs bar at the top
-	workPanelEndY := tabsBarHeight + workPanelHeight
-	isWorkSection := y >= tabsBarHeight && y < workPanelEndY
-	isIssuesSection := y >= workPanelEndY
-
-	// Determine X section (left or right)
-	isLeftSide := x <= halfWidth
-	isRightSide := x > halfWidth
-
-	if isWorkSection {
-		if isLeftSide {
-			return "work-left"
-		}
-		if isRightSide {
-			return "work-right"
-		}
-	}
-
-	if isIssuesSection {
-		if isLeftSide {
-			return "issues-left"
-		}
-		if isRightSide {
-			return "issues-right"
-		}
-	}
-
-	return ""
+	return panelAt(msg.X, msg.Y, m.planLayout())
 }
 
 // detectDialogButton determines which dialog button is at the mouse position using bubblezone.
@@ -222,57 +210,78 @@ func (m *planModel) renderWithDialog(dialog string) string {
 }
 
 func (m *planModel) renderHelp() string {
+	// Indicators are drawn from the icon set, padded to line up their text
+	icons := m.theme.Icons
+	indicator := func(glyph string) string {
+		return glyph + strings.Repeat(" ", max(14-ansi.StringWidth(glyph), 1))
+	}
+	// Help's padding and the key reference's indent
+	width := m.width - 8 - 2
 	help := `
   Plan Mode - Help
 
-  Each issue gets its own dedicated Claude session in a separate tab.
-  Use 'p' to start or resume a planning session for an issue.
-
-  Layout
-  ────────────────────────────
-  Two-column layout:
-    - Left: Issues list (default 40% width)
-    - Right: Issue details (default 60% width)
-  [ / ]         Adjust column ratio (30/70, 40/60, 50/50)
-
-  Navigation
-  ────────────────────────────
-  j/k, ↑/↓      Navigate list
-  1-9           Select work by position
-  p             Start/Resume planning session
-
-  Issue Management
-  ────────────────────────────
-  n             Create new issue (any type)
-  e             Edit issue inline (textarea)
-  E             Edit issue in $EDITOR
-  a             Add child issue (blocked by selected)
-  x             Close selected issue
-  Space         Toggle issue selection (for multi-select)
-  w             Create work from issue(s)
-  A             Add issue to existing work
-  i             Import issue from Linear
-  I             Import from GitHub PR
-
-  Filtering & Sorting
-  ────────────────────────────
-  o             Show open issues
-  c             Show closed issues
-  r             Show ready issues
-  /             Fuzzy search
-  L             Filter by label
-  s             Cycle sort mode
-  v             Toggle expanded view
-
+  Each issue gets its own dedicated Claude session in a separate tab. The
+  left column lists issues, the right shows the selected issue's details.
+` + m.helpContext() + `
+` + m.helpText(width) + `
   Indicators
   ────────────────────────────
-  ●             Issue is selected for multi-select
+  ` + indicator(icons.Selected) + `Issue is selected for multi-select
   P             Issue is processing (active Claude session)
   [w-xxx]       Issue is assigned to work w-xxx
-
+  ⌫ stale       Work's branch is merged or deleted on the remote
+  ⚠ worktree missing
+                Work's worktree directory is gone
+  ⏰ 02:00      Work is scheduled to run (co run --at)
+  ` + indicator(icons.Activity+" 2"+icons.Completed+" 1"+icons.Failed) + `Work changed since last viewed (tasks completed/failed)
+` + m.refreshHelp() + `
   Press any key to close...
 `
-	return tuiHelpStyle.Width(m.width).Height(m.height).Render(help)
+	if m.configInvalid() {
+		help = "\n  " + m.theme.Error.Render(configInvalidMessage) +
+			"\n  Dimmed keys change the project. Fix .co/config.toml and press ctrl+r to re-enable them.\n" + help
+	}
+	if m.bdMissing {
+		// helpText dims the keys that need bd; explain why
+		help = "\n  " + m.theme.Error.Render(bdMissingMessage) +
+			"\n  Dimmed keys need bd. Install it and press ctrl+r to re-enable them.\n" + help
+	}
+	return m.theme.Help.Width(m.width).Height(m.height).Render(help)
+}
+
+// helpContext says which keys the help screen lists, since it leaves out
+// those that can't be pressed in the current mode
+func (m *planModel) helpContext() string {
+	scopes := m.helpScopes()
+	switch {
+	case !scopes[scopeIssues]:
+		return "  Showing the keys of the maximized work panel (_ restores the split).\n"
+	case !scopes[scopeWork] && m.focusedWorkID != "":
+		return "  Showing the keys of the maximized issues panel (_ restores the split).\n"
+	case !scopes[scopeWork]:
+		return "  Work panel keys are listed once a work is selected (1-9).\n"
+	}
+	return ""
+}
+
+// refreshHelp describes how the issues and works are kept up to date, with
+// the intervals in effect from [tui]
+func (m *planModel) refreshHelp() string {
+	issues := "on change (watcher)"
+	if m.beadsWatcher == nil {
+		issues = fmt.Sprintf("every %s", m.planRefresh)
+	}
+	works := "on change (watcher)"
+	if m.trackingWatcher == nil {
+		works = fmt.Sprintf("every %s", m.workRefresh)
+	}
+	return fmt.Sprintf(`
+  Refresh
+  ────────────────────────────
+  Issues        %s
+  Works         %s
+  Orchestrators every %s
+`, issues, works, m.workRefresh)
 }
 
 // handleMouseWheel handles mouse wheel events by routing them to the appropriate panel
@@ -293,17 +302,30 @@ func (m *planModel) handleMouseWheel(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 	scrollUp := msg.Button == tea.MouseButtonWheelUp
 
 	// Calculate panel widths
-	totalContentWidth := m.width - 4
-	leftPanelWidth := int(float64(totalContentWidth) * m.columnRatio)
+	leftPanelWidth, _ := m.planColumnWidths()
 	rightPanelStartX := leftPanelWidth + 2 // +2 for left panel border
+	switch m.maximizedPanel() {
+	case panelWorkLeft:
+		rightPanelStartX = m.width
+	case panelWorkRight:
+		rightPanelStartX = 0
+	}
 
 	// If focused work mode, determine if mouse is over work details or issues panel
 	if m.focusedWorkID != "" {
-		workPanelHeight := m.calculateWorkPanelHeightForEvents() + 2 // +2 for border
-		workPanelEndY := tabsBarHeight + workPanelHeight
+		workPanelEndY := m.workSectionEndY()
 
 		// Check if mouse is in work details area (top panel)
 		if msg.Y >= tabsBarHeight && msg.Y < workPanelEndY {
+			// The log column, when shown, scrolls the log
+			if logStartX := m.workDetails.LogColumnStartX(); logStartX >= 0 && msg.X >= logStartX {
+				if scrollUp {
+					m.workDetails.LogPanel().ScrollUp(3)
+				} else {
+					m.workDetails.LogPanel().ScrollDown(3)
+				}
+				return m, nil
+			}
 			// Check if over the right panel (details)
 			if msg.X >= rightPanelStartX {
 				// Scroll the work details right panel (summary or task)
@@ -334,7 +356,7 @@ func (m *planModel) handleMouseWheel(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 	// Normal mode (no focused work) - check which panel mouse is over
 
 	// Check if mouse is over the issues panel (left side)
-	if msg.X <= leftPanelWidth+2 {
+	if m.maximizedPanel() != panelIssuesRight && msg.X <= leftPanelWidth+2 {
 		// Issues panel - move cursor
 		if scrollUp {
 			if m.beadsCursor > 0 {
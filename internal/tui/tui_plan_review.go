@@ -0,0 +1,252 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/coerrors"
+	workpkg "github.com/newhook/co/internal/work"
+)
+
+// planPreviewMsg carries the proposed task grouping for a work
+type planPreviewMsg struct {
+	workID string
+	plan   *workpkg.PlanResult
+	err    error
+}
+
+// planReview is the plan review dialog's state: the proposed tasks of a
+// work, edited in place until they're created or discarded
+type planReview struct {
+	plan     *workpkg.PlanResult
+	cursor   int    // index into rows()
+	renaming bool   // the name of the task under the cursor is being edited
+	err      string // why the last edit was rejected, shown in the dialog
+}
+
+// planRow is a line of the plan review: a task header (bead -1) or one of its beads
+type planRow struct {
+	group int
+	bead  int
+}
+
+func (r *planReview) rows() []planRow {
+	var rows []planRow
+	for g, group := range r.plan.Groups {
+		rows = append(rows, planRow{group: g, bead: -1})
+		for b := range group.Beads {
+			rows = append(rows, planRow{group: g, bead: b})
+		}
+	}
+	return rows
+}
+
+func (r *planReview) current() planRow {
+	rows := r.rows()
+	if len(rows) == 0 {
+		return planRow{group: -1, bead: -1}
+	}
+	r.cursor = min(max(r.cursor, 0), len(rows)-1)
+	return rows[r.cursor]
+}
+
+// focus moves the cursor to a row, or the task header if the bead is gone
+func (r *planReview) focus(group, bead int) {
+	for i, row := range r.rows() {
+		if row.group == group && (row.bead == bead || row.bead == -1) {
+			r.cursor = i
+			if row.bead == bead {
+				return
+			}
+		}
+	}
+}
+
+// previewFocusedWorkPlan asks the work service for the LLM task grouping of
+// the focused work's unassigned beads, without creating anything
+func (m *planModel) previewFocusedWorkPlan() tea.Cmd {
+	workID := m.focusedWorkID
+	return func() tea.Msg {
+		plan, err := m.workService.PreviewPlan(m.ctx, workID, workpkg.RunWorkOptions{UsePlan: true}, io.Discard)
+		return planPreviewMsg{workID: workID, plan: plan, err: err}
+	}
+}
+
+func (m *planModel) handlePlanPreview(msg planPreviewMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.statusMessage = fmt.Sprintf("Plan failed: %v", msg.err)
+		m.statusIsError = true
+		return m, nil
+	}
+	if len(msg.plan.Groups) == 0 {
+		m.statusMessage = fmt.Sprintf("Work %s has no unassigned issues to plan", msg.workID)
+		m.statusIsError = false
+		return m, nil
+	}
+	m.planReview = &planReview{plan: msg.plan}
+	m.viewMode = ViewPlanReview
+	m.statusMessage = ""
+	return m, nil
+}
+
+// applyReviewedPlan creates the reviewed plan's tasks and starts the orchestrator
+func (m *planModel) applyReviewedPlan(plan *workpkg.PlanResult) tea.Cmd {
+	return func() tea.Msg {
+		out := &spawnOutput{}
+		result, err := m.workService.ApplyPlan(m.ctx, plan, out)
+		if err != nil {
+			return workCommandMsg{action: "Run work", workID: plan.WorkID, err: err, spawnErr: orchestratorSpawnError(m.proj.Root, "Run work", plan.WorkID, err, out)}
+		}
+		return workCommandMsg{action: "Run work", workID: plan.WorkID, taskIDs: result.TaskIDs}
+	}
+}
+
+func (m *planModel) updatePlanReview(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	r := m.planReview
+	if r == nil {
+		m.viewMode = ViewNormal
+		return m, nil
+	}
+	row := r.current()
+	isEsc := msg.Type == tea.KeyEsc || msg.String() == "esc" || msg.String() == "escape"
+
+	if r.renaming {
+		switch {
+		case isEsc:
+			r.renaming = false
+			m.textInput.Blur()
+		case msg.String() == "enter":
+			r.plan.Groups[row.group].Name = strings.TrimSpace(m.textInput.Value())
+			r.renaming = false
+			m.textInput.Blur()
+		default:
+			var cmd tea.Cmd
+			m.textInput, cmd = m.textInput.Update(msg)
+			return m, cmd
+		}
+		return m, nil
+	}
+
+	if isEsc {
+		m.viewMode = ViewNormal
+		m.planReview = nil
+		m.statusMessage = "Plan discarded"
+		m.statusIsError = false
+		return m, nil
+	}
+
+	var err error
+	r.err = ""
+	switch msg.String() {
+	case "j", "down":
+		r.cursor++
+		r.current()
+	case "k", "up":
+		r.cursor--
+		r.current()
+	case "J", "K":
+		if row.bead < 0 {
+			err = coerrors.Errorf(coerrors.Validation, "select an issue to move")
+			break
+		}
+		to := row.group + 1
+		if msg.String() == "K" {
+			to = row.group - 1
+		}
+		if to < 0 {
+			err = coerrors.Errorf(coerrors.Validation, "already in the first task")
+			break
+		}
+		emptied := len(r.plan.Groups[row.group].Beads) == 1
+		if err = r.plan.MoveBead(row.group, row.bead, to); err == nil {
+			if emptied && to > row.group {
+				to-- // the emptied task was removed
+			}
+			r.focus(to, len(r.plan.Groups[to].Beads)-1)
+		}
+	case "m":
+		err = r.plan.MergeGroups(row.group)
+		r.focus(row.group, -1)
+	case "s":
+		if err = r.plan.SplitGroup(row.group, row.bead); err == nil {
+			r.focus(row.group+1, -1)
+		}
+	case "e":
+		r.renaming = true
+		m.textInput.Reset()
+		m.textInput.SetValue(r.plan.Groups[row.group].Name)
+		m.textInput.CursorEnd()
+		m.textInput.Focus()
+	case "enter":
+		m.viewMode = ViewNormal
+		m.planReview = nil
+		m.submittedDialog = &submittedDialog{view: ViewPlanReview, action: "Run work", id: r.plan.WorkID, plan: r.plan}
+		m.statusMessage = fmt.Sprintf("Creating %d task(s) for %s...", len(r.plan.Groups), r.plan.WorkID)
+		m.statusIsError = false
+		return m, m.applyReviewedPlan(r.plan)
+	}
+	if err != nil {
+		r.err = err.Error()
+	}
+	return m, nil
+}
+
+func (m *planModel) renderPlanReviewContent() string {
+	r := m.planReview
+	if r == nil {
+		return ""
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n  Review Plan for %s\n\n", r.plan.WorkID)
+
+	rows := r.rows()
+	r.current()
+	// Scroll so the cursor stays in view
+	visible := max(m.height-12, 5)
+	start := max(r.cursor-visible+1, 0)
+	end := min(start+visible, len(rows))
+	width := max(m.width-16, 30)
+	for i := start; i < end; i++ {
+		row := rows[i]
+		group := r.plan.Groups[row.group]
+		cursor := "  "
+		if i == r.cursor {
+			cursor = "> "
+		}
+		if row.bead < 0 {
+			name := group.Name
+			if r.renaming && i == r.cursor {
+				name = m.textInput.View()
+			} else if name == "" {
+				name = m.theme.Dim.Render("(untitled)")
+			}
+			line := fmt.Sprintf("%sTask %d: %s", cursor, row.group+1, name)
+			if complexity := group.Complexity(); complexity > 0 {
+				line += m.theme.Dim.Render(fmt.Sprintf("  complexity %d", complexity))
+			}
+			b.WriteString("  " + line + "\n")
+			continue
+		}
+		bead := group.Beads[row.bead]
+		line := fmt.Sprintf("%s    %s  %s", cursor, bead.ID, bead.Title)
+		b.WriteString("  " + ansi.Truncate(line, width, "...") + "\n")
+	}
+	if end < len(rows) {
+		b.WriteString(m.theme.Dim.Render(fmt.Sprintf("    ... %d more", len(rows)-end)) + "\n")
+	}
+
+	if r.err != "" {
+		b.WriteString("\n  " + m.theme.Error.Render(r.err) + "\n")
+	}
+	if r.renaming {
+		b.WriteString("\n  [Enter] Save name  [Esc] Cancel\n")
+	} else {
+		b.WriteString("\n  [j/k] Select  [J/K] Move issue to next/previous task  [m] Merge with next task\n")
+		b.WriteString("  [s] Split task before issue  [e] Rename task  [Enter] Create tasks  [Esc] Discard\n")
+	}
+
+	return m.theme.Dialog.Render(b.String())
+}
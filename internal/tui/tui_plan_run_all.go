@@ -0,0 +1,254 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/work"
+)
+
+// runAllDialog lists the idle works a bulk run (R) starts, and then how
+// each one's run went
+type runAllDialog struct {
+	theme    *Theme
+	works    []*db.Work
+	stagger  time.Duration
+	outcomes map[string]work.RunAllOutcome
+	running  bool
+	done     bool
+	err      error // Why the runs were cut short, if they were
+	cancel   context.CancelFunc
+}
+
+// idleWorksLoadedMsg carries the works a bulk run would start
+type idleWorksLoadedMsg struct {
+	works []*db.Work
+	err   error
+}
+
+// runAllOutcomeMsg carries the outcome of one work's run in a bulk run
+type runAllOutcomeMsg struct {
+	dialog  *runAllDialog
+	outcome work.RunAllOutcome
+	updates <-chan tea.Msg
+}
+
+// runAllDoneMsg says a bulk run has finished
+type runAllDoneMsg struct {
+	dialog *runAllDialog
+	err    error
+}
+
+// loadIdleWorks finds the works a bulk run would start
+func (m *planModel) loadIdleWorks() tea.Cmd {
+	return func() tea.Msg {
+		works, err := m.workService.IdleWorks(m.ctx)
+		return idleWorksLoadedMsg{works: works, err: err}
+	}
+}
+
+// handleIdleWorksLoaded opens the bulk run dialog on the idle works
+func (m *planModel) handleIdleWorksLoaded(msg idleWorksLoadedMsg) tea.Cmd {
+	switch {
+	case msg.err != nil:
+		m.statusMessage = fmt.Sprintf("Failed to find idle works: %v", msg.err)
+		m.statusIsError = true
+	case len(msg.works) == 0:
+		m.statusMessage = "No idle works with pending issues or tasks"
+		m.statusIsError = false
+	default:
+		m.runAll = &runAllDialog{
+			theme:    m.theme,
+			works:    msg.works,
+			stagger:  m.proj.Config.Scheduler.GetRunAllStagger(),
+			outcomes: make(map[string]work.RunAllOutcome),
+		}
+		m.viewMode = ViewRunAll
+	}
+	return nil
+}
+
+// startRunAll runs the dialog's works in the background, with LLM grouping
+// when usePlan is set. Outcomes come back one at a time as the runs finish.
+func (m *planModel) startRunAll(usePlan bool) tea.Cmd {
+	d := m.runAll
+	ctx, cancel := context.WithCancel(m.ctx)
+	d.running, d.cancel = true, cancel
+
+	workIDs := make([]string, len(d.works))
+	for i, w := range d.works {
+		workIDs[i] = w.ID
+	}
+	// Room for every outcome and the end, so the runs never wait on the TUI
+	updates := make(chan tea.Msg, len(workIDs)+1)
+	opts := work.RunAllOptions{
+		UsePlan:                usePlan,
+		Stagger:                d.stagger,
+		MaxConsecutiveFailures: m.proj.Config.Scheduler.GetRunAllMaxFailures(),
+		OnOutcome: func(outcome work.RunAllOutcome) {
+			updates <- runAllOutcomeMsg{dialog: d, outcome: outcome, updates: updates}
+		},
+	}
+	go func() {
+		defer cancel()
+		_, err := m.workService.RunAllWorks(ctx, workIDs, opts, io.Discard)
+		updates <- runAllDoneMsg{dialog: d, err: err}
+	}()
+	return waitForRunAll(updates)
+}
+
+// waitForRunAll waits for the next message from a bulk run
+func waitForRunAll(updates <-chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return <-updates
+	}
+}
+
+// handleRunAllOutcome records a work's outcome and waits for the next one
+func (m *planModel) handleRunAllOutcome(msg runAllOutcomeMsg) tea.Cmd {
+	if msg.dialog != m.runAll {
+		// From another project's TUI; the channel is buffered, so it can be left
+		return nil
+	}
+	msg.dialog.outcomes[msg.outcome.WorkID] = msg.outcome
+	return waitForRunAll(msg.updates)
+}
+
+// handleRunAllDone reports how a bulk run went once its last work is done
+func (m *planModel) handleRunAllDone(msg runAllDoneMsg) tea.Cmd {
+	d := msg.dialog
+	if d != m.runAll {
+		return nil
+	}
+	d.running, d.done, d.err = false, true, msg.err
+
+	started, failed := 0, 0
+	for _, outcome := range d.outcomes {
+		switch {
+		case outcome.Err != nil:
+			failed++
+		case outcome.Result != nil:
+			started++
+		}
+	}
+	m.statusMessage = fmt.Sprintf("Bulk run: %d of %d works started", started, len(d.works))
+	if failed > 0 {
+		m.statusMessage += fmt.Sprintf(", %d failed", failed)
+	}
+	m.statusIsError = failed > 0
+	return m.refreshData()
+}
+
+// updateRunAll handles a key press in the bulk run dialog
+func (m *planModel) updateRunAll(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	d := m.runAll
+	switch {
+	case d.running:
+		// Closing would hide runs still starting; Esc stops the ones left instead
+		if msg.String() == "esc" {
+			d.cancel()
+		}
+		return m, nil
+	case d.done:
+		switch msg.String() {
+		case "esc", "enter", "q":
+			m.viewMode = ViewNormal
+			m.runAll = nil
+		}
+		return m, nil
+	}
+	switch msg.String() {
+	case "enter", "y":
+		return m, m.startRunAll(false)
+	case "p":
+		return m, m.startRunAll(true)
+	case "esc", "n", "q":
+		m.viewMode = ViewNormal
+		m.runAll = nil
+	}
+	return m, nil
+}
+
+// render returns the dialog content sized to fit width x height
+func (d *runAllDialog) render(width, height int) string {
+	frameW, frameH := d.theme.Dialog.GetFrameSize()
+	innerWidth := min(max(width-frameW, 30), 90)
+	innerHeight := max(height-frameH, 8)
+
+	lines := []string{
+		d.theme.Title.Render(fmt.Sprintf("Run %d idle works", len(d.works))),
+		d.theme.Dim.Render(fmt.Sprintf("Works with pending issues or tasks and no running orchestrator, started %s apart", d.stagger)),
+		"",
+	}
+
+	// Leave room for the header and hotkeys
+	shown := max(innerHeight-7, 1)
+	current := d.currentIndex()
+	start := max(0, min(current-shown/2, len(d.works)-shown))
+	if start > 0 {
+		lines = append(lines, d.theme.Dim.Render(fmt.Sprintf("  ... %d earlier", start)))
+	}
+	end := min(len(d.works), start+shown)
+	for i := start; i < end; i++ {
+		lines = append(lines, ansi.Truncate(d.workLine(d.works[i], i == current), innerWidth, "…"))
+	}
+	if end < len(d.works) {
+		lines = append(lines, d.theme.Dim.Render(fmt.Sprintf("  ... %d more", len(d.works)-end)))
+	}
+
+	lines = append(lines, "")
+	switch {
+	case d.running:
+		lines = append(lines, d.theme.styleHotkeys("[Esc] Stop starting the works left"))
+	case d.done:
+		if d.err != nil {
+			lines = append(lines, d.theme.Error.Render(ansi.Truncate(d.err.Error(), innerWidth, "…")))
+		}
+		lines = append(lines, d.theme.styleHotkeys("[Esc] Close"))
+	default:
+		lines = append(lines, d.theme.styleHotkeys("[Enter] Run  [p] Run with LLM grouping  [Esc] Cancel"))
+	}
+	return d.theme.Dialog.Width(innerWidth + d.theme.Dialog.GetHorizontalPadding()).Render(strings.Join(lines, "\n"))
+}
+
+// currentIndex returns the position of the work being run, or -1 if none is
+func (d *runAllDialog) currentIndex() int {
+	if !d.running {
+		return -1
+	}
+	for i, w := range d.works {
+		if _, ok := d.outcomes[w.ID]; !ok {
+			return i
+		}
+	}
+	return -1
+}
+
+// workLine renders one work of the dialog with its outcome so far
+func (d *runAllDialog) workLine(w *db.Work, current bool) string {
+	icons := d.theme.Icons
+	label := w.ID
+	if w.Name != "" {
+		label += "  " + w.Name
+	}
+	outcome, ok := d.outcomes[w.ID]
+	switch {
+	case current:
+		return fmt.Sprintf("  %s %s  %s", icons.Processing, label, d.theme.Dim.Render("starting..."))
+	case !ok:
+		return fmt.Sprintf("  %s %s", icons.Pending, label)
+	case outcome.Aborted:
+		return d.theme.Dim.Render(fmt.Sprintf("  %s %s  not started", icons.Pending, label))
+	case outcome.Err != nil:
+		reason, _, _ := strings.Cut(outcome.Err.Error(), "\n")
+		return fmt.Sprintf("  %s %s  %s", d.theme.Error.Render(icons.Failed), label, d.theme.Error.Render(reason))
+	default:
+		return fmt.Sprintf("  %s %s  %s", icons.Completed, label, d.theme.Dim.Render(fmt.Sprintf("started, %d task(s) created", outcome.Result.TasksCreated)))
+	}
+}
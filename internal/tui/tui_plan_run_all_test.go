@@ -0,0 +1,55 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanFlowRunAllIdleWorks(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	h.CreateBead("bead-1", "Fix login")
+	h.CreateBead("bead-2", "Add logout")
+	h.CreateWork("w-one", "feat/one")
+	h.AddBeadToWork("w-one", "bead-1")
+	h.CreateWork("w-two", "feat/two")
+	h.AddBeadToWork("w-two", "bead-2")
+	h.CreateWork("w-idle", "feat/idle")
+	h.Worktree.ExistsPathFunc = func(path string) bool { return path != "/test/project/w-two/tree" }
+	noStagger := 0
+	h.Config.Scheduler.RunAllStaggerSeconds = &noStagger
+
+	m := newFlowTestModel(t, h)
+
+	// R lists the works with something to do
+	m.Update(press(m, "R")())
+	require.Equal(t, ViewRunAll, m.viewMode)
+	view := ansi.Strip(m.View())
+	require.Contains(t, view, "Run 2 idle works")
+	require.Contains(t, view, "w-one")
+	require.NotContains(t, view, "w-idle")
+
+	// Enter runs them, each outcome coming back as it's done
+	cmd := press(m, "enter")
+	require.True(t, m.runAll.running)
+	for {
+		msg := cmd()
+		_, cmd = m.Update(msg)
+		if _, done := msg.(runAllDoneMsg); done {
+			break
+		}
+	}
+	require.True(t, m.runAll.done)
+	require.True(t, m.statusIsError)
+	require.Equal(t, "Bulk run: 1 of 2 works started, 1 failed", m.statusMessage)
+	view = ansi.Strip(m.View())
+	require.Contains(t, view, "started, 1 task(s) created")
+	require.Contains(t, view, "worktree does not exist")
+
+	press(m, "esc")
+	require.Equal(t, ViewNormal, m.viewMode)
+	require.Nil(t, m.runAll)
+}
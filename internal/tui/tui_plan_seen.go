@@ -0,0 +1,200 @@
+package tui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/logging"
+	"github.com/newhook/co/internal/progress"
+	"github.com/newhook/co/internal/project"
+)
+
+// tuiStateFile is where TUI state that outlives a session is kept, under .co/
+const tuiStateFile = "tui-state.json"
+
+// workSnapshot is what a work's tasks and beads looked like when it was last viewed
+type workSnapshot struct {
+	Tasks map[string]string `json:"tasks"` // taskID -> status
+	Beads map[string]string `json:"beads"` // beadID -> bead status
+}
+
+// tuiState is the TUI state persisted across restarts
+type tuiState struct {
+	SeenWorks   map[string]workSnapshot `json:"seen_works"`             // workID -> last seen snapshot
+	ColumnRatio float64                 `json:"column_ratio,omitempty"` // Width of the left column, set with < and >
+}
+
+// columnRatio returns the saved column ratio, or the default if none was saved
+func (s tuiState) columnRatio() float64 {
+	if s.ColumnRatio <= 0 || s.ColumnRatio >= 1 {
+		return defaultColumnRatio
+	}
+	return s.ColumnRatio
+}
+
+// loadTUIState reads the state file and reports whether there was one. A
+// missing or unreadable file gives an empty state, so the TUI always starts.
+func loadTUIState(root string) (tuiState, bool) {
+	state := tuiState{SeenWorks: make(map[string]workSnapshot)}
+	data, err := os.ReadFile(filepath.Join(root, project.ConfigDir, tuiStateFile))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logging.Debug("loadTUIState failed", "error", err)
+		}
+		return state, false
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		logging.Debug("loadTUIState ignored invalid state file", "error", err)
+	}
+	if state.SeenWorks == nil {
+		state.SeenWorks = make(map[string]workSnapshot)
+	}
+	return state, true
+}
+
+// saveTUIState writes the state file
+func saveTUIState(root string, state tuiState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode TUI state: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(root, project.ConfigDir, tuiStateFile), data, 0644); err != nil {
+		return fmt.Errorf("failed to write TUI state: %w", err)
+	}
+	return nil
+}
+
+// snapshotWork records the current task and bead statuses of a work
+func snapshotWork(wp *progress.WorkProgress) workSnapshot {
+	snap := workSnapshot{
+		Tasks: make(map[string]string, len(wp.Tasks)),
+		Beads: make(map[string]string, len(wp.WorkBeads)),
+	}
+	for _, tp := range wp.Tasks {
+		snap.Tasks[tp.Task.ID] = tp.Task.Status
+	}
+	for _, bp := range wp.WorkBeads {
+		snap.Beads[bp.ID] = bp.BeadStatus
+	}
+	return snap
+}
+
+// workActivity is what changed in a work since it was last seen
+type workActivity struct {
+	changed   bool
+	completed int // tasks that completed since
+	failed    int // tasks that failed since
+}
+
+// activitySince compares a work against its last seen snapshot
+func activitySince(seen workSnapshot, wp *progress.WorkProgress) workActivity {
+	curr := snapshotWork(wp)
+	var a workActivity
+	for taskID, status := range curr.Tasks {
+		if before, ok := seen.Tasks[taskID]; ok && before == status {
+			continue
+		}
+		a.changed = true
+		switch status {
+		case db.StatusCompleted:
+			a.completed++
+		case db.StatusFailed:
+			a.failed++
+		}
+	}
+	if len(curr.Tasks) != len(seen.Tasks) || !maps.Equal(curr.Beads, seen.Beads) {
+		a.changed = true
+	}
+	return a
+}
+
+// updateSeenWorks refreshes the new-activity badges after the work tiles
+// load. Works seen for the first time are taken as seen, and the focused work
+// is kept seen while the user is looking at it.
+func (m *planModel) updateSeenWorks() {
+	seenWorks := m.seen()
+	dirty := false
+	current := make(map[string]bool, len(m.workTiles))
+	activity := make(map[string]workActivity)
+	for _, wp := range m.workTiles {
+		if wp == nil {
+			continue
+		}
+		id := wp.Work.ID
+		current[id] = true
+		seen, ok := seenWorks[id]
+		if !ok || id == m.focusedWorkID {
+			if !ok || activitySince(seen, wp).changed {
+				seenWorks[id] = snapshotWork(wp)
+				dirty = true
+			}
+			continue
+		}
+		if a := activitySince(seen, wp); a.changed {
+			activity[id] = a
+		}
+	}
+	// Forget works that have been destroyed
+	for id := range seenWorks {
+		if !current[id] {
+			delete(seenWorks, id)
+			dirty = true
+		}
+	}
+	m.workTabsBar.SetWorkActivity(activity)
+	if dirty {
+		m.saveState()
+	}
+}
+
+// markWorkSeen records a work's current state as seen, clearing its badge
+// and its unseen PR changes flag
+func (m *planModel) markWorkSeen(workID string) {
+	if !m.readOnly {
+		_ = m.proj.DB.MarkWorkPRSeen(m.ctx, workID)
+	}
+	wp := m.findWorkByID(workID)
+	if wp == nil {
+		return
+	}
+	m.seen()[workID] = snapshotWork(wp)
+	m.workTabsBar.ClearWorkActivity(workID)
+	m.saveState()
+}
+
+// markAllWorksSeen records every work's current state as seen
+func (m *planModel) markAllWorksSeen() {
+	for _, wp := range m.workTiles {
+		if wp != nil {
+			m.seen()[wp.Work.ID] = snapshotWork(wp)
+		}
+	}
+	m.workTabsBar.SetWorkActivity(nil)
+	m.saveState()
+}
+
+// seen returns the seen snapshots, creating them for models built without any
+func (m *planModel) seen() map[string]workSnapshot {
+	if m.seenWorks == nil {
+		m.seenWorks = make(map[string]workSnapshot)
+	}
+	return m.seenWorks
+}
+
+// saveState writes the seen works and the layout to the state file
+func (m *planModel) saveState() {
+	if m.readOnly || m.secondary() {
+		// .co may be on a read-only mount, or the primary TUI keeps the
+		// file; the state lasts for the session
+		return
+	}
+	state := tuiState{SeenWorks: m.seenWorks, ColumnRatio: m.columnRatio}
+	if err := saveTUIState(m.proj.Root, state); err != nil {
+		logging.Debug("saveState failed", "error", err)
+	}
+}
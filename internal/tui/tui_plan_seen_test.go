@@ -0,0 +1,71 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/progress"
+	"github.com/newhook/co/internal/project"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivitySince(t *testing.T) {
+	seen := snapshotWork(tilesWithTasks(map[string]string{
+		"w-abc.1": db.StatusProcessing,
+		"w-abc.2": db.StatusPending,
+	})[0])
+
+	unchanged := tilesWithTasks(map[string]string{
+		"w-abc.1": db.StatusProcessing,
+		"w-abc.2": db.StatusPending,
+	})[0]
+	assert.Equal(t, workActivity{}, activitySince(seen, unchanged))
+
+	changed := tilesWithTasks(map[string]string{
+		"w-abc.1": db.StatusCompleted,
+		"w-abc.2": db.StatusFailed,
+		"w-abc.3": db.StatusCompleted, // created since
+	})[0]
+	assert.Equal(t, workActivity{changed: true, completed: 2, failed: 1}, activitySince(seen, changed))
+
+	// A bead closing counts as activity without a task finishing
+	unchanged.WorkBeads = []progress.BeadProgress{{ID: "bead-1", BeadStatus: "closed"}}
+	assert.Equal(t, workActivity{changed: true}, activitySince(seen, unchanged))
+}
+
+func TestSeenWorksPersist(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(root, project.ConfigDir), 0755))
+	theme := DarkTheme()
+	m := &planModel{proj: &project.Project{Root: root}, workTabsBar: NewWorkTabsBar(theme)}
+
+	// The first load takes every work as seen
+	m.workTiles = tilesWithTasks(map[string]string{"w-abc.1": db.StatusProcessing})
+	m.updateSeenWorks()
+	assert.Empty(t, m.workTabsBar.workActivity)
+
+	// A restarted TUI flags what finished while it was closed
+	restarted := &planModel{
+		proj:        m.proj,
+		workTabsBar: NewWorkTabsBar(theme),
+		seenWorks:   loadSeenWorks(t, root),
+		workTiles:   tilesWithTasks(map[string]string{"w-abc.1": db.StatusCompleted}),
+	}
+	restarted.updateSeenWorks()
+	assert.Equal(t, workActivity{changed: true, completed: 1}, restarted.workTabsBar.workActivity["w-abc"])
+
+	restarted.markAllWorksSeen()
+	assert.Empty(t, restarted.workTabsBar.workActivity)
+	assert.Equal(t, map[string]string{"w-abc.1": db.StatusCompleted}, loadSeenWorks(t, root)["w-abc"].Tasks)
+}
+
+// loadSeenWorks reads the seen snapshots back from the state file
+func loadSeenWorks(t *testing.T, root string) map[string]workSnapshot {
+	t.Helper()
+	state, found := loadTUIState(root)
+	require.True(t, found, "the state file was written")
+	return state.SeenWorks
+}
@@ -0,0 +1,83 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/logging"
+)
+
+// staleWorkCheckInterval is the minimum time between stale branch checks.
+// Each check contacts the remote, so it piggybacks on work tile refreshes
+// rather than running on every one.
+const staleWorkCheckInterval = 5 * time.Minute
+
+// staleWorksCheckedMsg carries the result of a background stale branch check
+type staleWorksCheckedMsg struct {
+	stale     map[string]string // workID -> why the work is stale
+	checkedAt time.Time
+}
+
+// checkStaleWorks checks the loaded works' branches against the remote when
+// the last check is older than staleWorkCheckInterval. It returns nil while a
+// check is in flight or the cached result is still fresh, and in read-only
+// mode, where git may not be available.
+func (m *planModel) checkStaleWorks() tea.Cmd {
+	if m.readOnly || m.staleCheckInFlight || time.Since(m.staleCheckedAt) < staleWorkCheckInterval {
+		return nil
+	}
+
+	type branchCheck struct {
+		workID, branch, baseBranch string
+	}
+	var checks []branchCheck
+	for _, wp := range m.workTiles {
+		// Completed works have already been cleaned up
+		if wp == nil || wp.Work.BranchName == "" || wp.Work.Status == db.StatusCompleted {
+			continue
+		}
+		baseBranch := wp.Work.BaseBranch
+		if baseBranch == "" {
+			baseBranch = m.proj.Config.Repo.GetBaseBranch()
+		}
+		checks = append(checks, branchCheck{wp.Work.ID, wp.Work.BranchName, baseBranch})
+	}
+	if len(checks) == 0 {
+		return nil
+	}
+
+	m.staleCheckInFlight = true
+	repoPath := m.proj.MainRepoPath()
+	return func() tea.Msg {
+		stale := make(map[string]string)
+		for _, c := range checks {
+			status, err := m.workService.Git.CheckRemoteBranch(m.ctx, repoPath, c.branch, c.baseBranch)
+			if err != nil {
+				// Offline or no remote; try again next interval
+				logging.Debug("checkStaleWorks skipped work", "workID", c.workID, "error", err)
+				continue
+			}
+			switch {
+			case status.Deleted:
+				stale[c.workID] = fmt.Sprintf("branch %s was deleted on the remote", c.branch)
+			case status.Merged:
+				stale[c.workID] = fmt.Sprintf("branch %s is merged into %s", c.branch, c.baseBranch)
+			}
+		}
+		return staleWorksCheckedMsg{stale: stale, checkedAt: time.Now()}
+	}
+}
+
+// cleanupStaleWork opens the complete-work dialog for a stale work. The
+// remote check already showed the branch is merged or gone, so the PR
+// verification step starts unchecked.
+func (m *planModel) cleanupStaleWork(workID string) tea.Cmd {
+	if m.staleWorks[workID] == "" {
+		m.statusMessage = fmt.Sprintf("Work %s isn't stale; use m to complete it", workID)
+		m.statusIsError = true
+		return nil
+	}
+	return loadCompletionPlanCmd(m.ctx, m.workService, workID, true)
+}
@@ -0,0 +1,474 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/git"
+	"github.com/newhook/co/internal/work"
+)
+
+// triageShownActions is how many of the latest dispositions the triage
+// summary lists
+const triageShownActions = 10
+
+// triageKind is the disposition an issue got in triage
+type triageKind int
+
+const (
+	triageSkip     triageKind = iota // Left as it is
+	triagePriority                   // Priority set
+	triageAssign                     // Added to an existing work
+	triageClose                      // Closed
+	triageNewWork                    // A work was created from it
+)
+
+// triageAction is a disposition taken in a triage session
+type triageAction struct {
+	kind         triageKind
+	index        int // Position of the issue in the queue
+	beadID       string
+	priority     int           // Priority set
+	prevPriority int           // Priority before it was set, for undo
+	workID       string        // Work the issue was added to or created for
+	journal      *journalEntry // Session journal entry reversed by undo, if any
+}
+
+// describe says what the disposition did
+func (a *triageAction) describe() string {
+	switch a.kind {
+	case triagePriority:
+		return fmt.Sprintf("%s: priority P%d (was P%d)", a.beadID, a.priority, a.prevPriority)
+	case triageAssign:
+		return fmt.Sprintf("%s: added to %s", a.beadID, a.workID)
+	case triageClose:
+		return fmt.Sprintf("%s: closed", a.beadID)
+	case triageNewWork:
+		return fmt.Sprintf("%s: created work %s", a.beadID, a.workID)
+	}
+	return fmt.Sprintf("%s: skipped", a.beadID)
+}
+
+// triageSession walks the open issues that aren't in a work one at a time,
+// in triage sort order. The queue is a snapshot taken when triage starts.
+type triageSession struct {
+	queue       []beadItem
+	index       int // Issue being triaged; len(queue) once all have been
+	actions     []triageAction
+	loading     bool
+	busy        bool   // A disposition is being applied
+	finished    bool   // Showing the summary
+	creatingFor string // Issue the create work dialog was opened for
+}
+
+// current returns the issue being triaged, or nil
+func (t *triageSession) current() *beadItem {
+	if t.index >= len(t.queue) {
+		return nil
+	}
+	return &t.queue[t.index]
+}
+
+// advance moves to the next issue, finishing after the last
+func (t *triageSession) advance() {
+	t.index++
+	if t.index >= len(t.queue) {
+		t.finished = true
+	}
+}
+
+// counts returns how many issues got each disposition
+func (t *triageSession) counts() map[triageKind]int {
+	counts := make(map[triageKind]int)
+	for _, a := range t.actions {
+		counts[a.kind]++
+	}
+	return counts
+}
+
+// triageQueueLoadedMsg carries the issues to triage
+type triageQueueLoadedMsg struct {
+	items []beadItem
+	err   error
+}
+
+// triageAppliedMsg reports a disposition that was applied
+type triageAppliedMsg struct {
+	action triageAction
+	err    error
+}
+
+// triageUndoneMsg reports the undo of the last disposition
+type triageUndoneMsg struct {
+	action triageAction
+	err    error
+}
+
+// openTriage starts a triage session and loads its queue
+func (m *planModel) openTriage() tea.Cmd {
+	m.triage = &triageSession{loading: true}
+	m.viewMode = ViewTriage
+	return m.loadTriageQueue()
+}
+
+// loadTriageQueue fetches the open issues that aren't in a work, in triage
+// sort order
+func (m *planModel) loadTriageQueue() tea.Cmd {
+	return func() tea.Msg {
+		items, _, err := fetchBeadsWithFilters(m.ctx, m.proj.Beads, beadFilters{status: beads.StatusOpen, sortBy: "triage"})
+		if err != nil {
+			return triageQueueLoadedMsg{err: err}
+		}
+		if err := markAssignedWorks(m.ctx, m.proj.DB, items); err != nil {
+			return triageQueueLoadedMsg{err: err}
+		}
+		var queue []beadItem
+		for _, item := range items {
+			if item.Status == beads.StatusOpen && item.assignedWorkID == "" {
+				queue = append(queue, item)
+			}
+		}
+		return triageQueueLoadedMsg{items: queue}
+	}
+}
+
+func (m *planModel) handleTriageQueueLoaded(msg triageQueueLoadedMsg) (tea.Model, tea.Cmd) {
+	if m.triage == nil {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.closeTriage()
+		m.statusMessage = fmt.Sprintf("Failed to load issues to triage: %v", msg.err)
+		m.statusIsError = true
+		return m, nil
+	}
+	if len(msg.items) == 0 {
+		m.closeTriage()
+		m.statusMessage = "Nothing to triage: every open issue is in a work"
+		m.statusIsError = false
+		return m, nil
+	}
+	m.triage.queue = msg.items
+	m.triage.loading = false
+	return m, nil
+}
+
+// closeTriage ends the triage session and returns to the issues list
+func (m *planModel) closeTriage() {
+	m.triage = nil
+	m.viewMode = ViewNormal
+}
+
+// dialogReturnView is the view a dialog opened from triage returns to
+func (m *planModel) dialogReturnView() ViewMode {
+	if m.triage != nil {
+		return ViewTriage
+	}
+	return ViewNormal
+}
+
+func (m *planModel) updateTriage(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	t := m.triage
+	key := msg.String()
+	if t.finished {
+		m.closeTriage()
+		return m, tea.Batch(m.refreshData(), m.loadWorkTiles())
+	}
+	if t.loading {
+		if key == "esc" || key == "q" {
+			m.closeTriage()
+		}
+		return m, nil
+	}
+	if t.busy {
+		return m, nil
+	}
+
+	bead := t.current()
+	switch key {
+	case "0", "1", "2", "3", "4":
+		priority := int(key[0] - '0')
+		if priority == bead.Priority {
+			return m, m.recordTriage(triageAction{kind: triagePriority, index: t.index, beadID: bead.ID, priority: priority, prevPriority: priority})
+		}
+		t.busy = true
+		return m, m.setTriagePriority(triageAction{kind: triagePriority, index: t.index, beadID: bead.ID, priority: priority, prevPriority: bead.Priority})
+	case "a":
+		m.moveAssign = true
+		if len(m.moveTargets()) == 0 {
+			m.moveAssign = false
+			m.statusMessage = "No active works to add the issue to; w creates one"
+			m.statusIsError = true
+			return m, nil
+		}
+		m.moveBeadID = bead.ID
+		m.moveTargetCursor = 0
+		m.viewMode = ViewMoveBeadPicker
+		return m, nil
+	case "w":
+		t.creatingFor = bead.ID
+		m.createWorkPanel.Reset(bead.ID, work.GenerateBranchNameFromIssues([]*beads.Bead{bead.Bead}))
+		if branches, err := git.NewOperations().ListBranches(m.ctx, m.proj.MainRepoPath()); err == nil {
+			m.createWorkPanel.SetBranches(branches)
+		}
+		m.viewMode = ViewCreateWork
+		return m, m.createWorkPanel.Init()
+	case "c":
+		t.busy = true
+		return m, m.closeTriageBead(triageAction{kind: triageClose, index: t.index, beadID: bead.ID})
+	case "s", " ", "right", "l":
+		return m, m.recordTriage(triageAction{kind: triageSkip, index: t.index, beadID: bead.ID})
+	case "u", "backspace":
+		return m, m.undoTriage()
+	case "esc", "q":
+		t.finished = true
+	}
+	return m, nil
+}
+
+// recordTriage records a disposition that needed no command and advances
+func (m *planModel) recordTriage(action triageAction) tea.Cmd {
+	return func() tea.Msg { return triageAppliedMsg{action: action} }
+}
+
+// setTriagePriority changes the priority of the issue being triaged
+func (m *planModel) setTriagePriority(action triageAction) tea.Cmd {
+	return func() tea.Msg {
+		priority := action.priority
+		err := beads.Update(m.ctx, action.beadID, m.proj.BeadsPath(), beads.UpdateOptions{Priority: &priority})
+		if err != nil {
+			err = coerrors.Wrap(coerrors.ExternalTool, err)
+		}
+		return triageAppliedMsg{action: action, err: err}
+	}
+}
+
+// closeTriageBead closes the issue being triaged
+func (m *planModel) closeTriageBead(action triageAction) tea.Cmd {
+	return func() tea.Msg {
+		if err := beads.CloseMany(m.ctx, []string{action.beadID}, m.proj.BeadsPath()); err != nil {
+			return triageAppliedMsg{action: action, err: coerrors.Wrap(coerrors.ExternalTool, err)}
+		}
+		action.journal = &journalEntry{kind: journalClose, beadIDs: []string{action.beadID}}
+		return triageAppliedMsg{action: action}
+	}
+}
+
+// assignTriageBead adds the issue being triaged to a work picked in the
+// move picker
+func (m *planModel) assignTriageBead(workID string) tea.Cmd {
+	t := m.triage
+	bead := t.current()
+	if bead == nil {
+		return nil
+	}
+	t.busy = true
+	action := triageAction{kind: triageAssign, index: t.index, beadID: bead.ID, workID: workID}
+	return func() tea.Msg {
+		if _, err := m.workService.AddBeads(m.ctx, workID, []string{action.beadID}, false); err != nil {
+			return triageAppliedMsg{action: action, err: fmt.Errorf("failed to add issue to work: %w", err)}
+		}
+		action.journal = &journalEntry{kind: journalAssign, workID: workID, beadIDs: []string{action.beadID}}
+		return triageAppliedMsg{action: action}
+	}
+}
+
+func (m *planModel) handleTriageApplied(msg triageAppliedMsg) (tea.Model, tea.Cmd) {
+	t := m.triage
+	if t == nil {
+		return m, nil
+	}
+	t.busy = false
+	if msg.err != nil {
+		m.statusMessage = fmt.Sprintf("Triage of %s failed: %v", msg.action.beadID, msg.err)
+		m.statusIsError = true
+		return m, nil
+	}
+	if msg.action.kind == triagePriority && msg.action.index < len(t.queue) {
+		t.queue[msg.action.index].Priority = msg.action.priority
+	}
+	m.recordJournal(msg.action.journal)
+	t.actions = append(t.actions, msg.action)
+	m.statusMessage = msg.action.describe()
+	m.statusIsError = false
+	t.advance()
+	return m, nil
+}
+
+// triageWorkCreated records a work created from the issue being triaged. It
+// reports whether the created work belonged to triage.
+func (m *planModel) triageWorkCreated(msg planWorkCreatedMsg) bool {
+	t := m.triage
+	if t == nil || t.creatingFor == "" || t.creatingFor != msg.beadID {
+		return false
+	}
+	t.creatingFor = ""
+	t.busy = false
+	if msg.workID == "" {
+		return true
+	}
+	t.actions = append(t.actions, triageAction{kind: triageNewWork, index: t.index, beadID: msg.beadID, workID: msg.workID})
+	t.advance()
+	return true
+}
+
+// undoTriage reverses the last disposition and goes back to its issue
+func (m *planModel) undoTriage() tea.Cmd {
+	t := m.triage
+	if len(t.actions) == 0 {
+		m.statusMessage = "Nothing to undo in this triage session"
+		m.statusIsError = false
+		return nil
+	}
+	action := t.actions[len(t.actions)-1]
+	switch action.kind {
+	case triageSkip:
+		return func() tea.Msg { return triageUndoneMsg{action: action} }
+	case triageNewWork:
+		m.statusMessage = fmt.Sprintf("Creating %s can't be undone here; destroy the work from its tab", action.workID)
+		m.statusIsError = true
+		return nil
+	}
+	t.busy = true
+	return func() tea.Msg {
+		var err error
+		switch action.kind {
+		case triagePriority:
+			if action.priority != action.prevPriority {
+				prev := action.prevPriority
+				if err = beads.Update(m.ctx, action.beadID, m.proj.BeadsPath(), beads.UpdateOptions{Priority: &prev}); err != nil {
+					err = coerrors.Wrap(coerrors.ExternalTool, err)
+				}
+			}
+		case triageAssign, triageClose:
+			err = m.reverse(action.journal)
+		}
+		return triageUndoneMsg{action: action, err: err}
+	}
+}
+
+func (m *planModel) handleTriageUndone(msg triageUndoneMsg) (tea.Model, tea.Cmd) {
+	t := m.triage
+	if t == nil {
+		return m, nil
+	}
+	t.busy = false
+	if msg.err != nil {
+		m.statusMessage = fmt.Sprintf("Undo failed: %v", msg.err)
+		m.statusIsError = true
+		return m, nil
+	}
+	if msg.action.journal != nil {
+		msg.action.journal.undone = true
+	}
+	if msg.action.kind == triagePriority {
+		t.queue[msg.action.index].Priority = msg.action.prevPriority
+	}
+	t.actions = t.actions[:len(t.actions)-1]
+	t.index = msg.action.index
+	t.finished = false
+	m.statusMessage = "Undone: " + msg.action.describe()
+	m.statusIsError = false
+	return m, nil
+}
+
+// renderTriageContent renders the issue being triaged, or the summary once
+// triage is over
+func (m *planModel) renderTriageContent() string {
+	t := m.triage
+	width := min(max(m.width-10, 30), 90)
+	var b strings.Builder
+
+	progress := fmt.Sprintf("%d/%d triaged", min(t.index, len(t.queue)), len(t.queue))
+	b.WriteString(m.theme.Title.Render("Triage") + "  " + m.theme.Dim.Render(progress) + "\n\n")
+
+	switch {
+	case t.loading:
+		b.WriteString("  Loading open issues...\n\n  [Esc] Cancel")
+	case t.finished:
+		b.WriteString(m.renderTriageSummary(width))
+		b.WriteString("\n  Press any key to return to the issues")
+	default:
+		b.WriteString(m.renderTriageBead(t.current(), width))
+		b.WriteString("\n")
+		if len(t.actions) > 0 {
+			last := t.actions[len(t.actions)-1]
+			b.WriteString(ansi.Truncate(m.theme.Dim.Render("  Last: "+last.describe()), width, "…") + "\n")
+		}
+		b.WriteString(m.theme.styleHotkeys("  [0-4] Priority  [a] Add to work  [w] New work  [c] Close  [s] Skip") + "\n")
+		b.WriteString(m.theme.styleHotkeys("  [u] Undo last  [Esc] Finish"))
+	}
+	return m.theme.Dialog.Width(width + m.theme.Dialog.GetHorizontalPadding()).Render(b.String())
+}
+
+// renderTriageBead renders the full details of an issue in triage
+func (m *planModel) renderTriageBead(bead *beadItem, width int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "  %s  %s\n", m.theme.IssueID.Render(bead.ID), ansi.Truncate(bead.Title, max(width-len(bead.ID)-4, 10), "…"))
+	info := fmt.Sprintf("  Type: %s   Priority: P%d   Status: %s", bead.Type, bead.Priority, bead.Status)
+	if bead.isReady {
+		info += "   ready"
+	}
+	b.WriteString(info + "\n")
+	if len(bead.Labels) > 0 {
+		fmt.Fprintf(&b, "  Labels: %s\n", strings.Join(bead.Labels, ", "))
+	}
+	if len(bead.Dependencies) > 0 {
+		var deps []string
+		for _, dep := range bead.Dependencies {
+			deps = append(deps, dep.DependsOnID)
+		}
+		fmt.Fprintf(&b, "  Depends on: %s\n", strings.Join(deps, ", "))
+	}
+	if desc := strings.TrimSpace(bead.Description); desc != "" {
+		lines := strings.Split(wrapText(desc, width-4), "\n")
+		maxLines := max(m.height-16, 3)
+		if len(lines) > maxLines {
+			lines = append(lines[:maxLines], "…")
+		}
+		b.WriteString("\n")
+		for _, line := range lines {
+			b.WriteString("  " + line + "\n")
+		}
+	}
+	return b.String()
+}
+
+// renderTriageSummary lists what the triage session did
+func (m *planModel) renderTriageSummary(width int) string {
+	t := m.triage
+	counts := t.counts()
+	var b strings.Builder
+	fmt.Fprintf(&b, "  Triaged %d of %d issues\n\n", len(t.actions), len(t.queue))
+	for _, c := range []struct {
+		kind  triageKind
+		label string
+	}{
+		{triagePriority, "priority set"},
+		{triageAssign, "added to a work"},
+		{triageNewWork, "new work created"},
+		{triageClose, "closed"},
+		{triageSkip, "skipped"},
+	} {
+		if counts[c.kind] > 0 {
+			fmt.Fprintf(&b, "  %3d %s\n", counts[c.kind], c.label)
+		}
+	}
+	if len(t.actions) == 0 {
+		b.WriteString("  " + m.theme.Dim.Render("No issues were triaged") + "\n")
+		return b.String()
+	}
+	b.WriteString("\n")
+	shown := t.actions[max(len(t.actions)-triageShownActions, 0):]
+	for _, a := range shown {
+		b.WriteString(ansi.Truncate("  "+a.describe(), width, "…") + "\n")
+	}
+	if hidden := len(t.actions) - len(shown); hidden > 0 {
+		b.WriteString(m.theme.Dim.Render(fmt.Sprintf("  ... and %d earlier", hidden)) + "\n")
+	}
+	return b.String()
+}
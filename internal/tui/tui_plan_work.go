@@ -2,17 +2,25 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/atotto/clipboard"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/coerrors"
 	"github.com/newhook/co/internal/control"
 	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/git"
 	"github.com/newhook/co/internal/logging"
-	"github.com/newhook/co/internal/process"
+	"github.com/newhook/co/internal/orchestration"
 	"github.com/newhook/co/internal/progress"
+	"github.com/newhook/co/internal/project"
 	workpkg "github.com/newhook/co/internal/work"
 )
 
@@ -52,13 +60,17 @@ func (m *planModel) spawnPlanSession(beadID string) tea.Cmd {
 			"sessionCreated", sessionResult.SessionCreated,
 			"sessionName", sessionResult.SessionName)
 
+		// co plan resumes the bead's last conversation, if it has one
+		conversation, _ := m.proj.DB.GetPlanConversation(m.ctx, beadID)
+
 		// Use the orchestrator manager to spawn the plan session
-		if err := m.workService.OrchestratorManager.SpawnPlanSession(m.ctx, beadID, m.proj.Config.Project.Name, mainRepoPath, io.Discard); err != nil {
+		out := &spawnOutput{}
+		if err := m.workService.OrchestratorManager.SpawnPlanSession(m.ctx, beadID, m.proj.Config.Project.Name, mainRepoPath, out); err != nil {
 			logging.Error("spawnPlanSession SpawnPlanSession failed", "beadID", beadID, "error", err)
-			return planSessionSpawnedMsg{beadID: beadID, err: err}
+			return planSessionSpawnedMsg{beadID: beadID, err: err, spawnErr: newSpawnError(m.proj.Root, "Plan session", beadID, err, out)}
 		}
 
-		msg := planSessionSpawnedMsg{beadID: beadID, resumed: false}
+		msg := planSessionSpawnedMsg{beadID: beadID, resumed: false, conversationResumed: conversation != ""}
 		if sessionResult.SessionCreated {
 			msg.sessionCreated = true
 			msg.sessionName = sessionResult.SessionName
@@ -68,66 +80,119 @@ func (m *planModel) spawnPlanSession(beadID string) tea.Cmd {
 	}
 }
 
-// executeCreateWork creates a work unit with the given branch name.
-// This uses the shared CreateWorkFromBead method which handles:
-// 1. Expanding the bead to collect all issue IDs
-// 2. Creating work record in DB (with auto flag)
-// 3. Initializing the zellij session
-// 4. Ensuring control plane is running
-func (m *planModel) executeCreateWork(beadID string, branchName string, auto bool, useExistingBranch bool) tea.Cmd {
-	return func() tea.Msg {
-		logging.Debug("executeCreateWork started", "beadID", beadID, "branchName", branchName, "auto", auto, "useExistingBranch", useExistingBranch)
-
-		opts := workpkg.CreateWorkFromBeadOptions{
-			BeadID:            beadID,
-			BranchName:        branchName,
-			BaseBranch:        m.proj.Config.Repo.GetBaseBranch(),
-			Auto:              auto,
-			UseExistingBranch: useExistingBranch,
-		}
-		result, err := m.workService.CreateWorkFromBead(m.ctx, opts)
-		if err != nil {
-			logging.Error("executeCreateWork CreateWorkFromBead failed", "beadID", beadID, "error", err)
-			return planWorkCreatedMsg{beadID: beadID, err: err}
-		}
-		logging.Debug("executeCreateWork completed successfully", "workID", result.WorkID)
+// executeCreateWork creates a work from the create work dialog's values
+func (m *planModel) executeCreateWork(req CreateWorkResult, auto bool) tea.Cmd {
+	return createWorkCmd(m.ctx, m.workService, m.startControlPlane, m.proj.Config.Remote.Default, req, auto)
+}
 
-		// Ensure control plane is running to process the work
-		sessionResult, err := control.EnsureControlPlane(m.ctx, m.proj)
-		if err != nil {
-			logging.Warn("executeCreateWork EnsureControlPlane failed", "error", err)
-			// Non-fatal: work was created but control plane might need manual start
-			return planWorkCreatedMsg{beadID: beadID, workID: result.WorkID, err: err}
-		}
+// startControlPlane ensures the project's control plane is running
+func (m *planModel) startControlPlane(ctx context.Context) (*control.InitResult, error) {
+	return control.EnsureControlPlane(ctx, m.proj)
+}
 
-		msg := planWorkCreatedMsg{beadID: beadID, workID: result.WorkID}
-		if sessionResult.SessionCreated {
-			msg.sessionCreated = true
-			msg.sessionName = sessionResult.SessionName
+// reportWorktreeSetup shows the setup warnings of works created in this
+// session once their worktrees are ready. Worktrees are created in the
+// background, so the warnings arrive after the work itself.
+func (m *planModel) reportWorktreeSetup() {
+	for workID := range m.awaitingWorktree {
+		work := m.findWorkByID(workID)
+		if work == nil || work.Work.WorktreePath == "" {
+			continue
+		}
+		delete(m.awaitingWorktree, workID)
+		if warnings := work.Work.SetupWarnings; len(warnings) > 0 {
+			first, _, _ := strings.Cut(warnings[0], "\n")
+			m.statusMessage = fmt.Sprintf("Worktree setup for %s had %d problem(s): %s", workID, len(warnings), first)
+			m.statusIsError = true
 		}
-		return msg
 	}
 }
 
 func (m *planModel) addBeadsToWork(beadIDs []string, workID string) tea.Cmd {
+	return addBeadsCmd(m.ctx, m.proj.DB, m.workService, beadIDs, workID)
+}
+
+// beadsAssignedAndRunMsg reports adding beads to a work and running it in
+// one step
+type beadsAssignedAndRunMsg struct {
+	workID   string
+	beadIDs  []string
+	taskIDs  []string      // Tasks the run created
+	spawned  bool          // Whether the run spawned the orchestrator
+	err      error         // Adding the beads failed, so nothing changed
+	runErr   error         // The beads were added but running the work failed
+	spawnErr *spawnError   // Set when the run failed to spawn the orchestrator
+	journal  *journalEntry // The assignment, recorded for undo
+}
+
+// assignAndRunWork adds beads to a work and then runs it
+func (m *planModel) assignAndRunWork(beadIDs []string, workID string, usePlan bool) tea.Cmd {
+	return assignAndRunCmd(m.ctx, m.proj.DB, m.workService, m.proj.Root, beadIDs, workID, usePlan)
+}
+
+// handleBeadsAssignedAndRun reports the result of assignAndRunWork in the
+// status bar: what was added, the tasks created and the orchestrator's state.
+// It returns the refresh of what changed.
+func (m *planModel) handleBeadsAssignedAndRun(msg beadsAssignedAndRunMsg) tea.Cmd {
+	if msg.err != nil {
+		return m.showCommandError("Add issue", msg.workID, msg.err, nil)
+	}
+	m.commandSucceeded("Add issue", msg.workID)
+	m.recordJournal(msg.journal)
+	if msg.runErr != nil {
+		err := fmt.Errorf("%s stay in the work: %w", strings.Join(msg.beadIDs, ", "), msg.runErr)
+		return m.showCommandError("Run work", msg.workID, err, msg.spawnErr)
+	}
+	m.recordJournal(createdTasksEntry(msg.workID, msg.taskIDs))
+
+	orchestrator := "orchestrator already running"
+	if msg.spawned {
+		orchestrator = "orchestrator spawned"
+	}
+	m.statusMessage = fmt.Sprintf("Assigned %d issue(s), created %d task(s), %s", len(msg.beadIDs), len(msg.taskIDs), orchestrator)
+	m.statusMessage += m.blockedWorkWarning(msg.workID)
+	if m.isWorkPaused(msg.workID) {
+		m.statusMessage += pausedWorkWarning
+	}
+	m.statusIsError = false
+	return tea.Batch(m.refreshData(), m.loadWorkTiles())
+}
+
+// beadMovedMsg reports the result of moving a bead between works
+type beadMovedMsg struct {
+	beadID     string
+	fromWorkID string
+	toWorkID   string
+	err        error
+}
+
+// moveBeadToWork moves an unassigned bead from one work to another
+func (m *planModel) moveBeadToWork(beadID, fromWorkID, toWorkID string) tea.Cmd {
 	return func() tea.Msg {
-		// Use WorkService to add beads
-		_, err := m.workService.AddBeads(m.ctx, workID, beadIDs)
-		if err != nil {
-			beadIDsStr := strings.Join(beadIDs, ", ")
-			return beadAddedToWorkMsg{beadID: beadIDsStr, workID: workID, err: fmt.Errorf("failed to add issues to work: %w", err)}
-		}
+		err := m.proj.DB.MoveWorkBead(m.ctx, fromWorkID, toWorkID, beadID)
+		return beadMovedMsg{beadID: beadID, fromWorkID: fromWorkID, toWorkID: toWorkID, err: err}
+	}
+}
 
-		beadIDsStr := strings.Join(beadIDs, ", ")
-		return beadAddedToWorkMsg{beadID: beadIDsStr, workID: workID}
+// moveTargets returns the works a bead of the focused work can be moved to:
+// every other work that isn't completed, in tabs bar order
+func (m *planModel) moveTargets() []*progress.WorkProgress {
+	var targets []*progress.WorkProgress
+	for _, wp := range m.workTiles {
+		if wp == nil || (wp.Work.ID == m.focusedWorkID && !m.moveAssign) || wp.Work.Status == db.StatusCompleted {
+			continue
+		}
+		targets = append(targets, wp)
 	}
+	return targets
 }
 
 // workTilesLoadedMsg indicates work tiles have been loaded
 type workTilesLoadedMsg struct {
 	works              []*progress.WorkProgress
-	orchestratorHealth map[string]bool // workID -> orchestrator alive
+	orchestratorHealth map[string]db.OrchestratorHealth // workID -> orchestrator health
 	err                error
+	manual             bool // Loaded for ctrl+r/F5, which waits on it
 }
 
 // loadWorkTiles loads work data for the work tabs bar
@@ -139,22 +204,265 @@ func (m *planModel) loadWorkTiles() tea.Cmd {
 		}
 
 		// Compute orchestrator health for all works (async)
-		orchestratorHealth := make(map[string]bool)
+		workIDs := make([]string, 0, len(works))
 		for _, work := range works {
 			if work != nil {
-				orchestratorHealth[work.Work.ID] = checkOrchestratorHealth(m.ctx, m.proj.DB, work.Work.ID)
+				workIDs = append(workIDs, work.Work.ID)
 			}
 		}
+		orchestratorHealth := checkOrchestratorsHealth(m.ctx, m.proj.DB, workIDs)
 
 		return workTilesLoadedMsg{works: works, orchestratorHealth: orchestratorHealth}
 	}
 }
 
+// workTilesReloadedMsg carries the works the tracking watcher reported as
+// changed, to be patched into the loaded works
+type workTilesReloadedMsg struct {
+	works              []*progress.WorkProgress
+	orchestratorHealth map[string]db.OrchestratorHealth // workID -> orchestrator health
+	err                error
+}
+
+// reloadWorkTiles reloads only the given works. A work that no longer exists
+// fails the reload, so all works are reloaded instead.
+func (m *planModel) reloadWorkTiles(workIDs []string) tea.Cmd {
+	if len(workIDs) == 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		works := make([]*progress.WorkProgress, 0, len(workIDs))
+		for _, id := range workIDs {
+			work, err := m.proj.DB.GetWork(m.ctx, id)
+			if err != nil {
+				return workTilesReloadedMsg{err: err}
+			}
+			if work == nil {
+				return workTilesReloadedMsg{err: coerrors.Errorf(coerrors.NotFound, "work %s not found", id)}
+			}
+			wp, err := progress.FetchWorkProgress(m.ctx, m.proj, work)
+			if err != nil {
+				return workTilesReloadedMsg{err: err}
+			}
+			works = append(works, wp)
+		}
+		health := checkOrchestratorsHealth(m.ctx, m.proj.DB, workIDs)
+		return workTilesReloadedMsg{works: works, orchestratorHealth: health}
+	}
+}
+
+// patchWorkTiles returns a copy of tiles with each of the reloaded works in
+// place of its old entry. ok is false when a reloaded work isn't in tiles.
+func patchWorkTiles(tiles, reloaded []*progress.WorkProgress) ([]*progress.WorkProgress, bool) {
+	patched := slices.Clone(tiles)
+	for _, wp := range reloaded {
+		i := slices.IndexFunc(patched, func(old *progress.WorkProgress) bool {
+			return old != nil && old.Work.ID == wp.Work.ID
+		})
+		if i < 0 {
+			return nil, false
+		}
+		patched[i] = wp
+	}
+	return patched, true
+}
+
+// orchestratorHealthMsg carries freshly checked orchestrator health
+type orchestratorHealthMsg struct {
+	health map[string]db.OrchestratorHealth // workID -> orchestrator health
+}
+
+// scheduleOrchestratorHealthCheck rechecks the health of the loaded works'
+// orchestrators after the work refresh interval ([tui] work_refresh).
+// Rendering only ever reads the cached result, so an orchestrator that dies
+// without touching the database still shows up as dead within one interval.
+func (m *planModel) scheduleOrchestratorHealthCheck() tea.Cmd {
+	workIDs := make([]string, 0, len(m.workTiles))
+	for _, work := range m.workTiles {
+		if work != nil {
+			workIDs = append(workIDs, work.Work.ID)
+		}
+	}
+	return tea.Tick(m.workRefresh, func(time.Time) tea.Msg {
+		return orchestratorHealthMsg{health: checkOrchestratorsHealth(m.ctx, m.proj.DB, workIDs)}
+	})
+}
+
+// beadCommitsLoadedMsg carries per-bead commit counts for every work branch
+type beadCommitsLoadedMsg struct {
+	counts map[string]map[string]int // workID -> beadID -> commit count
+}
+
+// loadBeadCommits scans each work's branch for commits that mention its beads.
+// Works without a worktree, or whose branch can't be scanned, are skipped, and
+// so is the whole scan in read-only mode, where git may not be available.
+func (m *planModel) loadBeadCommits(works []*progress.WorkProgress) tea.Cmd {
+	if m.readOnly {
+		return nil
+	}
+	return func() tea.Msg {
+		counts := make(map[string]map[string]int)
+		for _, wp := range works {
+			if wp == nil || wp.Work.WorktreePath == "" || wp.Work.IsRemote() {
+				continue
+			}
+			baseBranch := wp.Work.BaseBranch
+			if baseBranch == "" {
+				baseBranch = m.proj.Config.Repo.GetBaseBranch()
+			}
+			messages, err := m.workService.Git.CommitMessagesSince(m.ctx, wp.Work.WorktreePath, baseBranch)
+			if err != nil {
+				logging.Debug("loadBeadCommits skipped work", "workID", wp.Work.ID, "error", err)
+				continue
+			}
+			beadIDs := make([]string, 0, len(wp.WorkBeads))
+			for _, bead := range wp.WorkBeads {
+				beadIDs = append(beadIDs, bead.ID)
+			}
+			counts[wp.Work.ID] = git.CountBeadCommits(messages, beadIDs)
+		}
+		return beadCommitsLoadedMsg{counts: counts}
+	}
+}
+
+// beadDurationsLoadedMsg carries the task run time each bead has taken
+type beadDurationsLoadedMsg struct {
+	durations map[string]time.Duration
+	err       error
+}
+
+// loadBeadDurations totals the task run time of every bead, across all works
+func (m *planModel) loadBeadDurations() tea.Cmd {
+	return func() tea.Msg {
+		durations, err := m.proj.DB.GetBeadDurations(m.ctx, "")
+		return beadDurationsLoadedMsg{durations: durations, err: err}
+	}
+}
+
+// completionPlanLoadedMsg carries the cleanup plan for the complete-work dialog
+type completionPlanLoadedMsg struct {
+	plan        *workpkg.CompletionPlan
+	skipPRCheck bool // Preset when the branch is already known to be merged or gone
+	err         error
+}
+
+// generateWorkReport renders the standup report for a work, saves it under
+// .co/reports/ and copies it to the clipboard when one is available
+func (m *planModel) generateWorkReport(workID string) tea.Cmd {
+	return func() tea.Msg {
+		if workID == "" {
+			return workReportMsg{err: fmt.Errorf("no work selected")}
+		}
+		now := time.Now()
+		since := now.Add(-workpkg.DefaultReportWindow)
+		data, err := m.workService.GatherReportData(m.ctx, workID, since)
+		if err != nil {
+			return workReportMsg{workID: workID, err: err}
+		}
+		report := workpkg.RenderReport(data, since, now)
+
+		reportDir := filepath.Join(m.proj.Root, project.ConfigDir, "reports")
+		if err := os.MkdirAll(reportDir, 0755); err != nil {
+			return workReportMsg{workID: workID, err: fmt.Errorf("failed to create reports directory: %w", err)}
+		}
+		path := filepath.Join(reportDir, fmt.Sprintf("%s-%s.md", workID, now.Format("2006-01-02")))
+		if err := os.WriteFile(path, []byte(report), 0644); err != nil {
+			return workReportMsg{workID: workID, err: fmt.Errorf("failed to write report: %w", err)}
+		}
+
+		copied := clipboard.WriteAll(report) == nil
+		return workReportMsg{workID: workID, path: path, copied: copied}
+	}
+}
+
+// openDiffView opens the diff overlay for the focused work and starts loading its changes
+func (m *planModel) openDiffView() tea.Cmd {
+	focusedWork := m.workDetails.GetFocusedWork()
+	if focusedWork == nil || focusedWork.Work.WorktreePath == "" {
+		m.statusMessage = "Work has no worktree to diff"
+		m.statusIsError = true
+		return nil
+	}
+	if focusedWork.Work.IsRemote() {
+		m.statusMessage = fmt.Sprintf("Work's worktree is on %s; diff it there", focusedWork.Work.RemoteHost)
+		m.statusIsError = true
+		return nil
+	}
+	baseBranch := focusedWork.Work.BaseBranch
+	if baseBranch == "" {
+		baseBranch = m.proj.Config.Repo.GetBaseBranch()
+	}
+	m.diffView = newDiffView(m.theme, focusedWork.Work.ID, focusedWork.Work.WorktreePath, baseBranch)
+	m.viewMode = ViewDiff
+	return m.diffView.loadStat(m.ctx, m.workService.Git)
+}
+
+// loadCompletionPlan gathers what completing a work would touch
+func (m *planModel) loadCompletionPlan(workID string) tea.Cmd {
+	return loadCompletionPlanCmd(m.ctx, m.workService, workID, false)
+}
+
+// destructionPlanLoadedMsg carries what destroying a work does, for the
+// destroy dialog
+type destructionPlanLoadedMsg struct {
+	workID string
+	plan   *workpkg.DestructionPlan
+	err    error
+}
+
+// loadDestructionPlan gathers what destroying a work would do
+func (m *planModel) loadDestructionPlan(workID string) tea.Cmd {
+	return func() tea.Msg {
+		plan, err := m.workService.PlanDestroyWork(m.ctx, workID)
+		return destructionPlanLoadedMsg{workID: workID, plan: plan, err: err}
+	}
+}
+
+// completeWork runs the merged-work cleanup with the steps chosen in the
+// dialog. If a step fails, the output of the steps so far is shown with the
+// error.
+func (m *planModel) completeWork(workID string, opts workpkg.CompleteWorkOptions) tea.Cmd {
+	return func() tea.Msg {
+		out := &spawnOutput{}
+		if err := m.workService.CompleteWork(m.ctx, workID, opts, out); err != nil {
+			var se *spawnError
+			if len(out.Tail(1)) > 0 {
+				se = newSpawnError(m.proj.Root, "Cleanup", workID, err, out)
+			}
+			return workCommandMsg{action: "Cleanup", workID: workID, err: err, spawnErr: se}
+		}
+		return workCommandMsg{action: "Cleanup", workID: workID}
+	}
+}
+
+// complexityStatsLoadedMsg carries the budget vs actual report for the stats overlay
+type complexityStatsLoadedMsg struct {
+	report *db.ComplexityReport
+	err    error
+}
+
+// loadComplexityStats aggregates complexity budgets across all works
+func (m *planModel) loadComplexityStats() tea.Cmd {
+	return func() tea.Msg {
+		report, err := m.proj.DB.GetComplexityStats(m.ctx)
+		return complexityStatsLoadedMsg{report: report, err: err}
+	}
+}
+
 // Helper functions for work commands
 
+// lookupWork loads a work by the ID captured when an action's key was
+// pressed; see fetchWork
+func (m *planModel) lookupWork(workID string) (*db.Work, error) {
+	return fetchWork(m.ctx, m.proj.DB, workID)
+}
+
 // destroyWork schedules a work destruction task via the control plane
 func (m *planModel) destroyWork(workID string) tea.Cmd {
 	return func() tea.Msg {
+		if _, err := m.lookupWork(workID); err != nil {
+			return workCommandMsg{action: "Destroy work", workID: workID, err: err}
+		}
 		if err := control.ScheduleDestroyWorktree(m.ctx, m.proj, workID); err != nil {
 			return workCommandMsg{action: "Destroy work", workID: workID, err: err}
 		}
@@ -162,78 +470,62 @@ func (m *planModel) destroyWork(workID string) tea.Cmd {
 		// Ensure control plane is running to process the destroy task
 		if _, err := control.EnsureControlPlane(m.ctx, m.proj); err != nil {
 			// Non-fatal: task was scheduled but control plane might need manual start
-			return workCommandMsg{action: "Destroy work scheduled", workID: workID, err: fmt.Errorf("destroy scheduled but control plane failed: %w", err)}
+			return workCommandMsg{action: "Destroy work scheduled", workID: workID, err: coerrors.Wrap(coerrors.ExternalTool, fmt.Errorf("destroy scheduled but control plane failed: %w", err))}
 		}
 
-		return workCommandMsg{action: "Destroy work scheduled", workID: workID}
+		return workCommandMsg{action: "Destroy work scheduled", workID: workID, journal: &journalEntry{kind: journalDestroyWork, workID: workID}}
 	}
 }
 
-// destroyFocusedWork destroys the currently focused work (used by confirmation dialog)
-func (m *planModel) destroyFocusedWork() tea.Cmd {
-	return m.destroyWork(m.focusedWorkID)
-}
-
 // runFocusedWork creates tasks for the currently focused work and ensures orchestrator is running
 func (m *planModel) runFocusedWork(autoGroup bool) tea.Cmd {
 	workID := m.focusedWorkID
 	return func() tea.Msg {
 		// Check if worktree is ready (it's created asynchronously by control plane)
-		work, err := m.proj.DB.GetWork(m.ctx, workID)
+		work, err := m.lookupWork(workID)
 		if err != nil {
-			return workCommandMsg{action: "Run work", workID: workID, err: fmt.Errorf("failed to get work: %w", err)}
-		}
-		if work == nil {
-			return workCommandMsg{action: "Run work", workID: workID, err: fmt.Errorf("work %s not found", workID)}
+			return workCommandMsg{action: "Run work", workID: workID, err: err}
 		}
 		if work.WorktreePath == "" {
-			return workCommandMsg{action: "Run work", workID: workID, err: fmt.Errorf("worktree is still being created, please wait a moment")}
+			return workCommandMsg{action: "Run work", workID: workID, err: coerrors.Errorf(coerrors.Conflict, "worktree is still being created, please wait a moment")}
 		}
 
+		out := &spawnOutput{}
+		var taskIDs []string
 		if autoGroup {
 			// Use auto mode - creates estimate task and lets orchestrator handle grouping
-			_, err := m.workService.RunWorkAuto(m.ctx, workID, io.Discard)
-			if err != nil {
-				return workCommandMsg{action: "Run work", workID: workID, err: err}
+			var result *workpkg.RunWorkAutoResult
+			if result, err = m.workService.RunWorkAuto(m.ctx, workID, out); err == nil {
+				taskIDs = []string{result.EstimateTaskID}
 			}
 		} else {
 			// Use direct mode - creates one task per bead
-			_, err := m.workService.RunWork(m.ctx, workID, false, io.Discard)
-			if err != nil {
-				return workCommandMsg{action: "Run work", workID: workID, err: err}
+			var result *workpkg.RunWorkResult
+			if result, err = m.workService.RunWork(m.ctx, workID, false, out); err == nil {
+				taskIDs = result.TaskIDs
 			}
 		}
-		return workCommandMsg{action: "Run work", workID: workID}
+		if err != nil {
+			return workCommandMsg{action: "Run work", workID: workID, err: err, spawnErr: orchestratorSpawnError(m.proj.Root, "Run work", workID, err, out)}
+		}
+		return workCommandMsg{action: "Run work", workID: workID, taskIDs: taskIDs, journal: createdTasksEntry(workID, taskIDs)}
 	}
 }
 
-// createReviewTask creates a review task for the currently focused work
-func (m *planModel) createReviewTask() tea.Cmd {
+// createReviewTask creates a review task for the currently focused work,
+// optionally including the checks failing on its PR
+func (m *planModel) createReviewTask(includeCIFailures bool) tea.Cmd {
 	workID := m.focusedWorkID
 	return func() tea.Msg {
-		// Get work details
-		work, err := m.proj.DB.GetWork(m.ctx, workID)
-		if err != nil {
-			return workCommandMsg{action: "Create review", workID: workID, err: fmt.Errorf("failed to get work: %w", err)}
-		}
-		if work == nil {
-			return workCommandMsg{action: "Create review", workID: workID, err: fmt.Errorf("work %s not found", workID)}
-		}
-
-		// Generate task ID for review
-		reviewTaskNum, err := m.proj.DB.GetNextTaskNumber(m.ctx, workID)
-		if err != nil {
-			return workCommandMsg{action: "Create review", workID: workID, err: fmt.Errorf("failed to get next task number: %w", err)}
+		if _, err := m.lookupWork(workID); err != nil {
+			return workCommandMsg{action: "Create review", workID: workID, err: err}
 		}
-		reviewTaskID := fmt.Sprintf("%s.%d", workID, reviewTaskNum)
-
-		// Create the review task
-		err = m.proj.DB.CreateTask(m.ctx, reviewTaskID, "review", []string{}, 0, workID)
+		// The review waits for implement tasks that haven't finished yet
+		result, err := m.workService.CreateReviewTask(m.ctx, workID, workpkg.CreateReviewTaskOptions{AfterImplement: true, IncludeCIFailures: includeCIFailures})
 		if err != nil {
-			return workCommandMsg{action: "Create review", workID: workID, err: fmt.Errorf("failed to create review task: %w", err)}
+			return workCommandMsg{action: "Create review", workID: workID, err: err}
 		}
-
-		return workCommandMsg{action: "Create review", workID: workID}
+		return workCommandMsg{action: "Create review", workID: workID, taskIDs: []string{result.TaskID}, journal: createdTasksEntry(workID, []string{result.TaskID})}
 	}
 }
 
@@ -241,39 +533,34 @@ func (m *planModel) createReviewTask() tea.Cmd {
 func (m *planModel) createPRTask() tea.Cmd {
 	workID := m.focusedWorkID
 	return func() tea.Msg {
-		// Get work details
-		work, err := m.proj.DB.GetWork(m.ctx, workID)
-		if err != nil {
-			return workCommandMsg{action: "Create PR", workID: workID, err: fmt.Errorf("failed to get work: %w", err)}
-		}
-		if work == nil {
-			return workCommandMsg{action: "Create PR", workID: workID, err: fmt.Errorf("work %s not found", workID)}
+		if _, err := m.lookupWork(workID); err != nil {
+			return workCommandMsg{action: "Create PR", workID: workID, err: err}
 		}
-
-		// Check if work is completed
-		if work.Status != db.StatusCompleted {
-			return workCommandMsg{action: "Create PR", workID: workID, err: fmt.Errorf("work %s is not completed (status: %s)", workID, work.Status)}
+		result, err := m.workService.CreatePRTask(m.ctx, workID)
+		if err != nil {
+			return workCommandMsg{action: "Create PR", workID: workID, err: err}
 		}
-
-		// Check if PR already exists
-		if work.PRURL != "" {
-			return workCommandMsg{action: "Create PR", workID: workID, err: fmt.Errorf("PR already exists: %s", work.PRURL)}
+		if result.PRExists {
+			return workCommandMsg{action: "Create PR", workID: workID, err: fmt.Errorf("PR already exists: %s", result.PRURL)}
 		}
+		return workCommandMsg{action: "Create PR", workID: workID, taskIDs: []string{result.TaskID}, journal: createdTasksEntry(workID, []string{result.TaskID})}
+	}
+}
 
-		// Generate task ID for PR
-		prTaskNum, err := m.proj.DB.GetNextTaskNumber(m.ctx, workID)
-		if err != nil {
-			return workCommandMsg{action: "Create PR", workID: workID, err: fmt.Errorf("failed to get next task number: %w", err)}
+// createCustomTask creates a task of a config-defined type for the focused work
+func (m *planModel) createCustomTask(typeName string) tea.Cmd {
+	workID := m.focusedWorkID
+	action := fmt.Sprintf("Create %s task", typeName)
+	return func() tea.Msg {
+		if _, err := m.lookupWork(workID); err != nil {
+			return workCommandMsg{action: action, workID: workID, err: err}
 		}
-		prTaskID := fmt.Sprintf("%s.%d", workID, prTaskNum)
-
-		// Create the PR task
-		err = m.proj.DB.CreateTask(m.ctx, prTaskID, "pr", []string{}, 0, workID)
+		out := &spawnOutput{}
+		result, err := m.workService.CreateCustomTask(m.ctx, workID, typeName, out)
 		if err != nil {
-			return workCommandMsg{action: "Create PR", workID: workID, err: fmt.Errorf("failed to create PR task: %w", err)}
+			return workCommandMsg{action: action, workID: workID, err: err, spawnErr: orchestratorSpawnError(m.proj.Root, action, workID, err, out)}
 		}
-
-		return workCommandMsg{action: "Create PR", workID: workID}
+		return workCommandMsg{action: action, workID: workID, taskIDs: []string{result.TaskID}, journal: createdTasksEntry(workID, []string{result.TaskID})}
 	}
 }
 
@@ -282,12 +569,12 @@ func (m *planModel) openConsole() tea.Cmd {
 	workID := m.focusedWorkID
 	return func() tea.Msg {
 		// Get work details
-		work, err := m.proj.DB.GetWork(m.ctx, workID)
+		work, err := m.lookupWork(workID)
 		if err != nil {
-			return workCommandMsg{action: "Open console", workID: workID, err: fmt.Errorf("failed to get work: %w", err)}
+			return workCommandMsg{action: "Open console", workID: workID, err: err}
 		}
-		if work == nil {
-			return workCommandMsg{action: "Open console", workID: workID, err: fmt.Errorf("work %s not found", workID)}
+		if err := missingWorktreeError(work); err != nil {
+			return workCommandMsg{action: "Open console", workID: workID, err: err}
 		}
 
 		// Ensure control plane is running (creates session if needed)
@@ -296,9 +583,10 @@ func (m *planModel) openConsole() tea.Cmd {
 			return workCommandMsg{action: "Control plane", workID: workID, err: err}
 		}
 
-		err = m.workService.OrchestratorManager.OpenConsole(m.ctx, workID, m.proj.Config.Project.Name, work.WorktreePath, work.Name, m.proj.Config.Hooks.Env, io.Discard)
+		out := &spawnOutput{}
+		err = m.workService.OrchestratorManager.OpenConsole(m.ctx, workID, m.proj.Config.Project.Name, work.WorktreePath, work.RemoteHost, work.Name, project.MergeEnv(m.proj.Config.Hooks.Env, work.Env), out)
 		if err != nil {
-			return workCommandMsg{action: "Open console", workID: workID, err: err}
+			return workCommandMsg{action: "Open console", workID: workID, err: err, spawnErr: newSpawnError(m.proj.Root, "Open console", workID, err, out)}
 		}
 
 		return workCommandMsg{action: "Open console", workID: workID}
@@ -310,12 +598,12 @@ func (m *planModel) openClaude() tea.Cmd {
 	workID := m.focusedWorkID
 	return func() tea.Msg {
 		// Get work details
-		work, err := m.proj.DB.GetWork(m.ctx, workID)
+		work, err := m.lookupWork(workID)
 		if err != nil {
-			return workCommandMsg{action: "Open Claude", workID: workID, err: fmt.Errorf("failed to get work: %w", err)}
+			return workCommandMsg{action: "Open Claude", workID: workID, err: err}
 		}
-		if work == nil {
-			return workCommandMsg{action: "Open Claude", workID: workID, err: fmt.Errorf("work %s not found", workID)}
+		if err := missingWorktreeError(work); err != nil {
+			return workCommandMsg{action: "Open Claude", workID: workID, err: err}
 		}
 
 		// Ensure control plane is running (creates session if needed)
@@ -324,70 +612,113 @@ func (m *planModel) openClaude() tea.Cmd {
 			return workCommandMsg{action: "Control plane", workID: workID, err: err}
 		}
 
-		err = m.workService.OrchestratorManager.OpenClaudeSession(m.ctx, workID, m.proj.Config.Project.Name, work.WorktreePath, work.Name, m.proj.Config.Hooks.Env, m.proj.Config, io.Discard)
+		out := &spawnOutput{}
+		err = m.workService.OrchestratorManager.OpenClaudeSession(m.ctx, workID, m.proj.Config.Project.Name, work.WorktreePath, work.RemoteHost, work.Name, project.MergeEnv(m.proj.Config.Hooks.Env, work.Env), m.proj.Config, out)
 		if err != nil {
-			return workCommandMsg{action: "Open Claude", workID: workID, err: err}
+			return workCommandMsg{action: "Open Claude", workID: workID, err: err, spawnErr: newSpawnError(m.proj.Root, "Open Claude", workID, err, out)}
 		}
 
 		return workCommandMsg{action: "Open Claude", workID: workID}
 	}
 }
 
-// checkOrchestratorHealth checks if the orchestrator has a recent heartbeat for a work
-func checkOrchestratorHealth(ctx context.Context, database *db.DB, workID string) bool {
-	// Check if an orchestrator has a recent heartbeat in the database
-	alive, err := database.IsOrchestratorAlive(ctx, workID, db.DefaultStalenessThreshold)
+// checkOrchestratorHealth checks a work's orchestrator heartbeat: recent,
+// stale while its process still exists, or missing
+func checkOrchestratorHealth(ctx context.Context, database db.Store, workID string) db.OrchestratorHealth {
+	health, _, err := database.GetOrchestratorHealth(ctx, workID, db.DefaultStalenessThreshold)
 	if err != nil {
-		return false
+		return db.OrchestratorDown
 	}
-	return alive
+	return health
+}
+
+// checkOrchestratorsHealth checks the orchestrator heartbeat of each work
+func checkOrchestratorsHealth(ctx context.Context, database db.Store, workIDs []string) map[string]db.OrchestratorHealth {
+	health := make(map[string]db.OrchestratorHealth, len(workIDs))
+	for _, id := range workIDs {
+		health[id] = checkOrchestratorHealth(ctx, database, id)
+	}
+	return health
 }
 
 // restartOrchestrator kills and restarts the orchestrator for the focused work
 func (m *planModel) restartOrchestrator() tea.Cmd {
-	workID := m.focusedWorkID
-	return func() tea.Msg {
-		// Get work details
-		workRec, err := m.proj.DB.GetWork(m.ctx, workID)
-		if err != nil {
-			return workCommandMsg{action: "Restart orchestrator", workID: workID, err: fmt.Errorf("failed to get work: %w", err)}
-		}
-		if workRec == nil {
-			return workCommandMsg{action: "Restart orchestrator", workID: workID, err: fmt.Errorf("work %s not found", workID)}
+	return restartOrchestratorCmd(m.ctx, m.proj.DB, m.workService.OrchestratorManager, m.startControlPlane, m.proj.Config.Project.Name, m.proj.Root, m.focusedWorkID)
+}
+
+// pausedWorkWarning is appended to status messages for actions on a paused work
+const pausedWorkWarning = " (work is paused: press z to resume)"
+
+// blockedWorkWarning returns the note appended to the status message after
+// running a work whose beads are all blocked by open beads, per the last
+// tiles load, or "" if any of them is ready. Their tasks would wait until the
+// blockers are closed.
+func (m *planModel) blockedWorkWarning(workID string) string {
+	for _, w := range m.workTiles {
+		if w == nil || w.Work.ID != workID || len(w.UnassignedBeads) == 0 {
+			continue
+		}
+		var blockers []string
+		for _, bead := range w.UnassignedBeads {
+			if len(bead.BlockedBy) == 0 {
+				return ""
+			}
+			for _, id := range bead.BlockedBy {
+				if !slices.Contains(blockers, id) {
+					blockers = append(blockers, id)
+				}
+			}
 		}
+		return fmt.Sprintf(" (warning: every bead is blocked, by %s)", strings.Join(blockers, ", "))
+	}
+	return ""
+}
 
-		// Kill any existing orchestrator process using pattern-based kill
-		// (we use pattern-based kill since we need to actually terminate the process,
-		// database check only tells us if it's alive)
-		pattern := fmt.Sprintf("co orchestrate --work %s", workID)
-		if alive := checkOrchestratorHealth(m.ctx, m.proj.DB, workID); alive {
-			_ = process.KillProcess(m.ctx, pattern)
-			time.Sleep(500 * time.Millisecond)
+// isWorkPaused reports whether the work's orchestration is paused, per the last tiles load
+func (m *planModel) isWorkPaused(workID string) bool {
+	for _, w := range m.workTiles {
+		if w != nil && w.Work.ID == workID {
+			return w.Work.Paused
 		}
+	}
+	return false
+}
 
-		// Ensure control plane is running (may have been killed along with zellij)
-		if _, err := control.EnsureControlPlane(m.ctx, m.proj); err != nil {
-			return workCommandMsg{action: "Restart orchestrator", workID: workID, err: fmt.Errorf("failed to ensure control plane: %w", err)}
-		}
-
-		// Spawn a new orchestrator
-		spawned, err := m.workService.OrchestratorManager.EnsureWorkOrchestrator(
-			m.ctx,
-			workID,
-			m.proj.Config.Project.Name,
-			workRec.WorktreePath,
-			workRec.Name,
-			io.Discard,
-		)
+// togglePauseFocusedWork pauses the focused work, or resumes it if already paused
+func (m *planModel) togglePauseFocusedWork() tea.Cmd {
+	return togglePauseCmd(m.ctx, m.proj.DB, m.workService, m.proj.Root, m.focusedWorkID, m.isWorkPaused(m.focusedWorkID))
+}
+
+// toggleAutoPRFocusedWork flips whether the focused work's orchestrator
+// creates the PR task once all tasks are done. A value matching the project's
+// auto_pr drops the work's override.
+func (m *planModel) toggleAutoPRFocusedWork() tea.Cmd {
+	workID := m.focusedWorkID
+	return func() tea.Msg {
+		work, err := m.lookupWork(workID)
 		if err != nil {
-			return workCommandMsg{action: "Restart orchestrator", workID: workID, err: err}
+			return workCommandMsg{action: "Toggle auto-PR", workID: workID, err: err}
 		}
-
-		status := "already running"
-		if spawned {
-			status = "restarted"
+		enabled := !orchestration.AutoPR(m.proj.Config, work)
+		action := "Disable auto-PR"
+		if enabled {
+			action = "Enable auto-PR"
 		}
-		return workCommandMsg{action: fmt.Sprintf("Orchestrator %s", status), workID: workID}
+		var override *bool
+		if enabled != m.proj.Config.Workflow.AutoPR {
+			override = &enabled
+		}
+		err = m.proj.DB.SetWorkAutoPR(m.ctx, workID, override)
+		return workCommandMsg{action: action, workID: workID, err: err}
+	}
+}
+
+// cancelScheduledRun cancels the focused work's scheduled run (co run --at)
+func (m *planModel) cancelScheduledRun() tea.Cmd {
+	workID := m.focusedWorkID
+	return func() tea.Msg {
+		err := m.workService.CancelScheduledRun(m.ctx, workID)
+		return workCommandMsg{action: "Cancel scheduled run", workID: workID, err: err}
 	}
 }
 
@@ -395,6 +726,9 @@ func (m *planModel) restartOrchestrator() tea.Cmd {
 func (m *planModel) checkPRFeedback() tea.Cmd {
 	workID := m.focusedWorkID
 	return func() tea.Msg {
+		if _, err := m.lookupWork(workID); err != nil {
+			return workCommandMsg{action: "Check PR feedback", workID: workID, err: err}
+		}
 		if err := control.TriggerPRFeedbackCheck(m.ctx, m.proj, workID); err != nil {
 			return workCommandMsg{action: "Check PR feedback", workID: workID, err: err}
 		}
@@ -408,6 +742,108 @@ func (m *planModel) checkPRFeedback() tea.Cmd {
 	}
 }
 
+// beadInTaskMsg reports a bead removal stopped because pending tasks include the bead
+type beadInTaskMsg struct {
+	workID string
+	err    *workpkg.BeadInTaskError
+}
+
+// removeBeadFromWork removes a bead from a work. Unless fromPendingTasks is
+// set, a bead that pending tasks picked up since the list was loaded is left
+// alone and the user is asked whether to take it out of those tasks too.
+func (m *planModel) removeBeadFromWork(workID, beadID string, fromPendingTasks bool) tea.Cmd {
+	return func() tea.Msg {
+		result, err := m.workService.RemoveBeadFromWork(m.ctx, workID, beadID, fromPendingTasks)
+		var inTask *workpkg.BeadInTaskError
+		if errors.As(err, &inTask) {
+			return beadInTaskMsg{workID: workID, err: inTask}
+		}
+		if err != nil {
+			return workCommandMsg{action: "Remove " + beadID, workID: workID, err: err}
+		}
+		action := "Remove " + beadID
+		if len(result.DeletedTasks) > 0 {
+			action += fmt.Sprintf(" (deleted empty task %s)", strings.Join(result.DeletedTasks, ", "))
+		}
+		journal := &journalEntry{kind: journalRemove, workID: workID, beadIDs: []string{beadID}, taskIDs: result.RemovedFromTasks}
+		return workCommandMsg{action: action, workID: workID, journal: journal}
+	}
+}
+
+// saveWorkNotes replaces a work's notes with what was typed in the notes editor
+func (m *planModel) saveWorkNotes(workID, notes string) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := m.lookupWork(workID); err != nil {
+			return workCommandMsg{action: "Save notes", workID: workID, err: err}
+		}
+		err := m.proj.DB.SetWorkNotes(m.ctx, workID, notes)
+		return workCommandMsg{action: "Save notes", workID: workID, err: err}
+	}
+}
+
+// saveWorkEnv replaces a work's env overrides with what was typed in the env
+// editor. Consoles and Claude sessions opened afterwards get the new values.
+func (m *planModel) saveWorkEnv(workID string, env []string) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := m.lookupWork(workID); err != nil {
+			return workCommandMsg{action: "Save env", workID: workID, err: err}
+		}
+		err := m.proj.DB.SetWorkEnv(m.ctx, workID, env)
+		return workCommandMsg{action: "Save env", workID: workID, err: err}
+	}
+}
+
+// runSelectedTask runs a pending task right away in its own tab. The task is
+// claimed first, so the work's orchestrator leaves it alone.
+func (m *planModel) runSelectedTask(taskID string) tea.Cmd {
+	workID := m.focusedWorkID
+	return func() tea.Msg {
+		if err := m.workService.RunTask(m.ctx, taskID, io.Discard); err != nil {
+			return workCommandMsg{action: "Spawn task", workID: workID, err: err}
+		}
+		return workCommandMsg{action: "Spawn task " + taskID, workID: workID}
+	}
+}
+
+// rateLimitedTaskIDs returns the IDs of the work's tasks that failed on a
+// rate limit
+func rateLimitedTaskIDs(work *progress.WorkProgress) []string {
+	var ids []string
+	for _, task := range work.Tasks {
+		if task.Task.Status == db.StatusFailed && task.Task.FailureKind == db.FailureRateLimited {
+			ids = append(ids, task.Task.ID)
+		}
+	}
+	return ids
+}
+
+// retryRateLimitedTasks resets every task of the focused work that failed on
+// a rate limit to pending, so the orchestrator runs them again
+func (m *planModel) retryRateLimitedTasks() tea.Cmd {
+	work := m.findWorkByID(m.focusedWorkID)
+	if work == nil {
+		return nil
+	}
+	taskIDs := rateLimitedTaskIDs(work)
+	if len(taskIDs) == 0 {
+		m.statusMessage = "No rate-limited tasks to retry"
+		m.statusIsError = false
+		return nil
+	}
+	workID := work.Work.ID
+	return func() tea.Msg {
+		for _, taskID := range taskIDs {
+			if err := m.proj.DB.ResetTaskStatus(m.ctx, taskID); err != nil {
+				return workCommandMsg{action: "Reset rate-limited tasks", workID: workID, err: err}
+			}
+			if err := m.proj.DB.ResetTaskBeadStatuses(m.ctx, taskID); err != nil {
+				return workCommandMsg{action: "Reset rate-limited tasks", workID: workID, err: err}
+			}
+		}
+		return workCommandMsg{action: fmt.Sprintf("Reset %d rate-limited task(s)", len(taskIDs)), workID: workID}
+	}
+}
+
 // resetSelectedTask resets a failed task to pending status
 func (m *planModel) resetSelectedTask() tea.Cmd {
 	taskID := m.workDetails.GetSelectedTaskID()
@@ -0,0 +1,94 @@
+package tui
+
+import (
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/control"
+	"github.com/newhook/co/internal/logging"
+	workpkg "github.com/newhook/co/internal/work"
+)
+
+// workImportedMsg reports the result of importing a work manifest
+type workImportedMsg struct {
+	path           string
+	result         *workpkg.ImportWorkResult
+	err            error
+	sessionCreated bool
+	sessionName    string
+}
+
+// importWorkManifest creates a work from the manifest at path, which is
+// relative to the project root unless absolute.
+func (m *planModel) importWorkManifest(path string) tea.Cmd {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(m.proj.Root, path)
+	}
+	return func() tea.Msg {
+		manifest, err := workpkg.ReadManifest(path)
+		if err != nil {
+			return workImportedMsg{path: path, err: err}
+		}
+		result, err := m.workService.ImportWork(m.ctx, manifest)
+		if err != nil {
+			return workImportedMsg{path: path, result: result, err: err}
+		}
+
+		msg := workImportedMsg{path: path, result: result}
+		sessionResult, err := control.EnsureControlPlane(m.ctx, m.proj)
+		if err != nil {
+			// Non-fatal: the work exists, the control plane can be started later
+			logging.Warn("importWorkManifest EnsureControlPlane failed", "error", err)
+		} else if sessionResult.SessionCreated {
+			msg.sessionCreated = true
+			msg.sessionName = sessionResult.SessionName
+		}
+		return msg
+	}
+}
+
+// handleWorkImported reports an import and focuses the new work
+func (m *planModel) handleWorkImported(msg workImportedMsg) (tea.Model, tea.Cmd) {
+	skipped := ""
+	if msg.result != nil && msg.result.Skipped() {
+		skipped = " (skipped " + skippedManifestBeads(msg.result) + ")"
+	}
+	if msg.err != nil {
+		m.statusMessage = fmt.Sprintf("Failed to import %s: %v%s", filepath.Base(msg.path), msg.err, skipped)
+		m.statusIsError = true
+		return m, nil
+	}
+
+	result := msg.result
+	m.statusMessage = fmt.Sprintf("Imported work %s with %d issues%s", result.WorkID, len(result.Added), skipped)
+	if msg.sessionCreated {
+		m.statusMessage += fmt.Sprintf(" | Zellij: zellij attach %s", msg.sessionName)
+	}
+	m.statusIsError = false
+	if m.awaitingWorktree == nil {
+		m.awaitingWorktree = make(map[string]bool)
+	}
+	m.awaitingWorktree[result.WorkID] = true
+	m.pendingFocusWorkID = result.WorkID
+	return m, tea.Batch(m.refreshData(), m.loadWorkTiles())
+}
+
+// skippedManifestBeads summarizes the issues an import left out, e.g.
+// "bead-1: closed, bead-2: in w-abc"
+func skippedManifestBeads(result *workpkg.ImportWorkResult) string {
+	var parts []string
+	for _, id := range result.Missing {
+		parts = append(parts, id+": not found")
+	}
+	for _, id := range result.Closed {
+		parts = append(parts, id+": closed")
+	}
+	for _, id := range slices.Sorted(maps.Keys(result.Taken)) {
+		parts = append(parts, fmt.Sprintf("%s: in %s", id, result.Taken[id]))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,141 @@
+package tui
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/testutil"
+	workpkg "github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newStoreTestModel builds a plan model focused on w-abc whose tracking
+// database is the given mock, so tests can assert exactly what is written.
+func newStoreTestModel(t *testing.T, store *testutil.StoreMock) *planModel {
+	t.Helper()
+	cfg := &project.Config{}
+	return &planModel{
+		ctx:           context.Background(),
+		proj:          &project.Project{Root: t.TempDir(), Config: cfg, DB: store},
+		workService:   &workpkg.WorkService{DB: store, Config: cfg},
+		focusedWorkID: "w-abc",
+	}
+}
+
+func TestCreateReviewTaskWithStore(t *testing.T) {
+	store := &testutil.StoreMock{
+		GetWorkFunc: func(ctx context.Context, id string) (*db.Work, error) {
+			return &db.Work{ID: id, Status: db.StatusProcessing}, nil
+		},
+		GetNextTaskNumberFunc: func(ctx context.Context, workID string) (int, error) {
+			return 3, nil
+		},
+		GetWorkTasksFunc: func(ctx context.Context, workID string) ([]*db.Task, error) {
+			return []*db.Task{
+				{ID: "w-abc.1", TaskType: "implement", Status: db.StatusProcessing},
+				{ID: "w-abc.2", TaskType: "implement", Status: db.StatusCompleted},
+				{ID: "w-abc.0", TaskType: "estimate", Status: db.StatusPending},
+			}, nil
+		},
+	}
+	m := newStoreTestModel(t, store)
+
+	msg := m.createReviewTask(false)()
+	require.NoError(t, msg.(workCommandMsg).err)
+	assert.Equal(t, []string{"w-abc.3"}, msg.(workCommandMsg).taskIDs)
+
+	// The review and what it waits for are written together
+	require.Empty(t, store.CreateTaskCalls())
+	require.Empty(t, store.AddTaskDependencyCalls())
+	calls := store.CreateDependentTaskCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "w-abc.3", calls[0].ID)
+	assert.Equal(t, "review", calls[0].TaskType)
+	assert.Empty(t, calls[0].BeadIDs)
+	assert.Equal(t, "w-abc", calls[0].WorkID)
+
+	// Only the unfinished implement task blocks the review
+	assert.Equal(t, []string{"w-abc.1"}, calls[0].DependsOn)
+}
+
+func TestCreatePRTaskWithStore(t *testing.T) {
+	work := &db.Work{ID: "w-abc", Status: db.StatusProcessing}
+	store := &testutil.StoreMock{
+		GetWorkFunc: func(ctx context.Context, id string) (*db.Work, error) {
+			return work, nil
+		},
+		GetNextTaskNumberFunc: func(ctx context.Context, workID string) (int, error) {
+			return 4, nil
+		},
+	}
+	m := newStoreTestModel(t, store)
+
+	// A work that's still running can't get a PR task
+	msg := m.createPRTask()()
+	require.ErrorContains(t, msg.(workCommandMsg).err, "not completed")
+	require.Empty(t, store.CreateTaskCalls())
+
+	work.Status = db.StatusCompleted
+	msg = m.createPRTask()()
+	require.NoError(t, msg.(workCommandMsg).err)
+	assert.Equal(t, []string{"w-abc.4"}, msg.(workCommandMsg).taskIDs)
+	calls := store.CreateTaskCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "w-abc.4", calls[0].ID)
+	assert.Equal(t, "pr", calls[0].TaskType)
+	assert.Equal(t, "w-abc", calls[0].WorkID)
+
+	// Once the PR exists another PR task is refused
+	work.PRURL = "https://github.com/o/r/pull/1"
+	msg = m.createPRTask()()
+	require.ErrorContains(t, msg.(workCommandMsg).err, "PR already exists")
+	require.Len(t, store.CreateTaskCalls(), 1)
+}
+
+func TestAddBeadsToWorkWithStore(t *testing.T) {
+	store := &testutil.StoreMock{
+		GetWorkFunc: func(ctx context.Context, id string) (*db.Work, error) {
+			return &db.Work{ID: id}, nil
+		},
+		IsBeadInTaskFunc: func(ctx context.Context, workID string, beadID string) (bool, error) {
+			return beadID == "bead-9", nil
+		},
+	}
+	m := newStoreTestModel(t, store)
+
+	msg := m.addBeadsToWork([]string{"bead-1", "bead-2"}, "w-abc")()
+	require.NoError(t, msg.(beadAddedToWorkMsg).err)
+	calls := store.AddWorkBeadsCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "w-abc", calls[0].WorkID)
+	assert.Equal(t, []string{"bead-1", "bead-2"}, calls[0].BeadIDs)
+
+	// A bead already in a task stops the whole batch
+	msg = m.addBeadsToWork([]string{"bead-3", "bead-9"}, "w-abc")()
+	require.ErrorContains(t, msg.(beadAddedToWorkMsg).err, "bead-9")
+	require.Len(t, store.AddWorkBeadsCalls(), 1)
+}
+
+func TestLoadBeadDurationsWithStore(t *testing.T) {
+	store := &testutil.StoreMock{
+		GetBeadDurationsFunc: func(ctx context.Context, workID string) (map[string]time.Duration, error) {
+			return map[string]time.Duration{"bead-1": 95 * time.Minute, "bead-2": 12 * time.Second}, nil
+		},
+	}
+	m := newStoreTestModel(t, store)
+
+	msg := m.loadBeadDurations()()
+	require.NoError(t, msg.(beadDurationsLoadedMsg).err)
+	calls := store.GetBeadDurationsCalls()
+	require.Len(t, calls, 1)
+	assert.Empty(t, calls[0].WorkID, "every work's beads are totalled")
+
+	durations := msg.(beadDurationsLoadedMsg).durations
+	assert.Equal(t, "1h35m0s", formatBeadTime(durations["bead-1"]))
+	assert.Equal(t, "12s", formatBeadTime(durations["bead-2"]))
+	assert.Equal(t, "", formatBeadTime(durations["bead-3"]))
+}
@@ -0,0 +1,159 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/project"
+)
+
+// projectPickerEntry is a known project shown in the project picker
+type projectPickerEntry struct {
+	project.RegistryEntry
+	activeWorks int
+	countErr    error // set if the project's tracking database couldn't be read
+}
+
+// projectPicker lists known projects so the TUI can switch between them
+type projectPicker struct {
+	theme     *Theme
+	entries   []projectPickerEntry
+	selected  int
+	loading   bool
+	switching bool // a project is being opened
+	err       error
+}
+
+// projectPickerLoadedMsg carries the known projects and their active work counts
+type projectPickerLoadedMsg struct {
+	entries []projectPickerEntry
+	err     error
+}
+
+// projectOpenedMsg indicates a project picked in the picker was opened
+type projectOpenedMsg struct {
+	proj *project.Project
+	err  error
+}
+
+// loadProjectPicker reads the project registry and counts each project's active works
+func loadProjectPicker(ctx context.Context) tea.Cmd {
+	return func() tea.Msg {
+		known, err := project.KnownProjects()
+		if err != nil {
+			return projectPickerLoadedMsg{err: err}
+		}
+		entries := make([]projectPickerEntry, len(known))
+		for i, e := range known {
+			entries[i] = projectPickerEntry{RegistryEntry: e}
+			entries[i].activeWorks, entries[i].countErr = countActiveWorks(ctx, e.Root)
+		}
+		return projectPickerLoadedMsg{entries: entries}
+	}
+}
+
+// countActiveWorks returns how many works in the project haven't been completed or merged
+func countActiveWorks(ctx context.Context, root string) (int, error) {
+	database, err := db.OpenPath(ctx, filepath.Join(root, project.ConfigDir, project.TrackingDB))
+	if err != nil {
+		return 0, err
+	}
+	defer database.Close()
+
+	works, err := database.ListWorks(ctx, "")
+	if err != nil {
+		return 0, err
+	}
+	active := 0
+	for _, w := range works {
+		if w.Status != db.StatusCompleted && w.Status != db.StatusMerged {
+			active++
+		}
+	}
+	return active, nil
+}
+
+// openProject opens the project rooted at root
+func openProject(ctx context.Context, root string) tea.Cmd {
+	return func() tea.Msg {
+		proj, err := project.FindLenient(ctx, root)
+		return projectOpenedMsg{proj: proj, err: err}
+	}
+}
+
+// selectedRoot returns the root of the highlighted project, or empty if there is none
+func (p *projectPicker) selectedRoot() string {
+	if p.selected < 0 || p.selected >= len(p.entries) {
+		return ""
+	}
+	return p.entries[p.selected].Root
+}
+
+// setEntries replaces the listed projects, highlighting currentRoot if present
+func (p *projectPicker) setEntries(entries []projectPickerEntry, currentRoot string) {
+	p.entries = entries
+	p.selected = 0
+	for i, e := range entries {
+		if e.Root == currentRoot {
+			p.selected = i
+			break
+		}
+	}
+}
+
+// moveSelection moves the highlight by delta, clamped to the list
+func (p *projectPicker) moveSelection(delta int) {
+	p.selected = max(0, min(p.selected+delta, len(p.entries)-1))
+}
+
+// render returns the picker dialog content
+func (p *projectPicker) render(currentRoot string) string {
+	var b strings.Builder
+	b.WriteString("\n  Switch Project\n\n")
+
+	switch {
+	case p.err != nil:
+		b.WriteString("  " + p.theme.Error.Render(fmt.Sprintf("Error: %v", p.err)) + "\n\n")
+	case p.loading:
+		b.WriteString("  Loading projects...\n\n")
+	case len(p.entries) == 0:
+		b.WriteString("  No known projects yet.\n")
+		b.WriteString("  " + p.theme.Dim.Render("Projects are listed here once co has opened them.") + "\n\n")
+	default:
+		nameWidth := 0
+		for _, e := range p.entries {
+			nameWidth = max(nameWidth, ansi.StringWidth(e.Name))
+		}
+		for i, e := range p.entries {
+			cursor := "  "
+			if i == p.selected {
+				cursor = "▸ "
+			}
+			works := fmt.Sprintf("%d active", e.activeWorks)
+			if e.countErr != nil {
+				works = "unavailable"
+			}
+			line := fmt.Sprintf("%s%s%s  %-11s  %s", cursor, e.Name, strings.Repeat(" ", nameWidth-ansi.StringWidth(e.Name)), works, p.theme.Dim.Render(e.Root))
+			if e.Root == currentRoot {
+				line += p.theme.Dim.Render("  (current)")
+			}
+			if i == p.selected {
+				line = p.theme.Selected.Render(line)
+			}
+			b.WriteString("  " + line + "\n")
+		}
+		b.WriteString("\n")
+	}
+
+	if p.switching {
+		b.WriteString("  Opening project...\n")
+	} else {
+		b.WriteString("  [j/k] Navigate  [Enter] Open  [Esc] Cancel\n")
+	}
+	return p.theme.Dialog.Render(b.String())
+}
@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/project"
+	"github.com/stretchr/testify/require"
+)
+
+type testPlanMsg struct{}
+
+func TestScopeCmdTagsPlanMessages(t *testing.T) {
+	msg := scopeCmd(3, func() tea.Msg { return testPlanMsg{} })()
+	require.Equal(t, projectScopedMsg{gen: 3, msg: testPlanMsg{}}, msg)
+
+	// Batches are wrapped recursively so every result is tagged
+	batch := scopeCmd(3, tea.Batch(
+		func() tea.Msg { return testPlanMsg{} },
+		func() tea.Msg { return testPlanMsg{} },
+	))()
+	require.IsType(t, tea.BatchMsg{}, batch)
+	for _, cmd := range batch.(tea.BatchMsg) {
+		require.IsType(t, projectScopedMsg{}, cmd())
+	}
+
+	// bubbletea control messages must reach the program untouched
+	require.Equal(t, tea.QuitMsg{}, scopeCmd(3, tea.Quit)())
+	require.Nil(t, scopeCmd(3, nil))
+}
+
+func TestRootModelDropsStaleProjectMessages(t *testing.T) {
+	m := rootModel{gen: 2, picker: &projectPicker{loading: true, theme: DarkTheme()}}
+
+	// A picker load result from the previous project generation is ignored
+	stale := projectScopedMsg{gen: 1, msg: projectPickerLoadedMsg{entries: []projectPickerEntry{{}}}}
+	newModel, cmd := m.Update(stale)
+	require.Nil(t, cmd)
+	require.True(t, newModel.(rootModel).picker.loading)
+
+	current := projectScopedMsg{gen: 2, msg: projectPickerLoadedMsg{}}
+	newModel, _ = m.Update(current)
+	require.False(t, newModel.(rootModel).picker.loading)
+}
+
+func TestProjectPicker(t *testing.T) {
+	p := &projectPicker{theme: DarkTheme()}
+	p.setEntries([]projectPickerEntry{
+		{RegistryEntry: project.RegistryEntry{Name: "alpha", Root: "/projects/alpha"}, activeWorks: 2},
+		{RegistryEntry: project.RegistryEntry{Name: "beta", Root: "/projects/beta"}},
+	}, "/projects/beta")
+	require.Equal(t, "/projects/beta", p.selectedRoot(), "current project is highlighted")
+
+	p.moveSelection(1)
+	require.Equal(t, 1, p.selected, "selection stops at the end")
+	p.moveSelection(-5)
+	require.Equal(t, "/projects/alpha", p.selectedRoot())
+
+	view := p.render("/projects/beta")
+	require.Contains(t, view, "alpha")
+	require.Contains(t, view, "2 active")
+	require.Contains(t, view, "(current)")
+
+	// Without a project to return to, closing the picker quits
+	m := rootModel{picker: p}
+	newModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	require.True(t, newModel.(rootModel).quitting)
+	require.NotNil(t, cmd)
+}
@@ -2,12 +2,16 @@ package tui
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	zone "github.com/lrstanley/bubblezone"
+	"github.com/muesli/termenv"
 	"github.com/newhook/co/internal/project"
 )
 
@@ -17,124 +21,349 @@ func init() {
 	zone.NewGlobal()
 }
 
+// resizeDebounce is how long the terminal size has to hold still before the
+// panels are laid out for it, so dragging a pane border lays them out once
+const resizeDebounce = 100 * time.Millisecond
+
+// forceQuitWindow is how soon a second ctrl+c has to follow the first to
+// quit without asking, whatever is open
+const forceQuitWindow = time.Second
+
+// resizeSettledMsg applies the latest terminal size once resizing has paused
+type resizeSettledMsg struct {
+	seq int
+}
+
 // rootModel is the top-level TUI model
 type rootModel struct {
 	ctx    context.Context
 	proj   *project.Project
+	theme  *Theme
 	width  int
 	height int
 
 	// The plan model (our only model now)
 	planModel *planModel
 
+	// Project switching: gen is bumped whenever the project changes so results
+	// of commands started for the previous project can be recognized and dropped
+	picker *projectPicker
+	gen    int
+
+	// readOnly is --read-only; each project's plan model also turns it on by
+	// itself when the project's tracking database isn't writable
+	readOnly bool
+
 	// Global state
 	spinner    spinner.Model
 	lastUpdate time.Time
 	quitting   bool
+	lastCtrlC  time.Time // When ctrl+c was last pressed, for force quitting
 
 	// Mouse state
 	mouseX int
 	mouseY int
+
+	// Resize debouncing: the first size is applied right away, later ones
+	// once no newer size has arrived for resizeDebounce
+	sized         bool
+	resizeSeq     int
+	pendingWidth  int
+	pendingHeight int
 }
 
-// newRootModel creates a new root TUI model
-func newRootModel(ctx context.Context, proj *project.Project) rootModel {
+// newRootModel creates a new root TUI model. proj may be nil when showPicker
+// is set, in which case the user picks a project before anything else loads.
+func newRootModel(ctx context.Context, proj *project.Project, theme *Theme, showPicker, readOnly bool) rootModel {
 	s := spinner.New()
-	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	s.Spinner = theme.Icons.Spinner
+	s.Style = theme.Spinner
 
-	// Create the plan model
-	planModel := newPlanModel(ctx, proj)
-
-	return rootModel{
+	m := rootModel{
 		ctx:        ctx,
 		proj:       proj,
+		theme:      theme,
 		width:      80,
 		height:     24,
-		planModel:  planModel,
 		spinner:    s,
 		lastUpdate: time.Now(),
+		readOnly:   readOnly,
+	}
+	if proj != nil {
+		m.planModel = newPlanModel(ctx, proj, theme, readOnly)
 	}
+	if showPicker || proj == nil {
+		m.picker = &projectPicker{loading: true, theme: theme}
+	}
+	return m
 }
 
 // Init implements tea.Model
 func (m rootModel) Init() tea.Cmd {
+	var cmds []tea.Cmd
 	// Initialize plan model
 	if m.planModel != nil {
-		return m.planModel.Init()
+		cmds = append(cmds, m.scoped(m.planModel.Init()))
+	}
+	if m.picker != nil {
+		cmds = append(cmds, loadProjectPicker(m.ctx))
+	}
+	return tea.Batch(cmds...)
+}
+
+// projectScopedMsg tags a plan model message with the project generation
+// whose commands produced it
+type projectScopedMsg struct {
+	gen int
+	msg tea.Msg
+}
+
+// scoped wraps a plan model command so its messages are tagged with the
+// current project generation. Batches are wrapped recursively; bubbletea's own
+// control messages (quit, exec, ...) pass through untouched so the program
+// still sees them.
+func (m rootModel) scoped(cmd tea.Cmd) tea.Cmd {
+	return scopeCmd(m.gen, cmd)
+}
+
+func scopeCmd(gen int, cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		msg := cmd()
+		switch msg := msg.(type) {
+		case nil:
+			return nil
+		case tea.BatchMsg:
+			wrapped := make(tea.BatchMsg, len(msg))
+			for i, c := range msg {
+				wrapped[i] = scopeCmd(gen, c)
+			}
+			return wrapped
+		}
+		if reflect.TypeOf(msg).PkgPath() == reflect.TypeOf(tea.QuitMsg{}).PkgPath() {
+			return msg
+		}
+		return projectScopedMsg{gen: gen, msg: msg}
+	}
+}
+
+// updatePlan routes a message to the plan model
+func (m rootModel) updatePlan(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.planModel == nil {
+		return m, nil
+	}
+	newModel, cmd := m.planModel.Update(msg)
+	m.planModel = newModel.(*planModel)
+	return m, m.scoped(cmd)
+}
+
+// openPicker shows the project picker and starts loading known projects
+func (m rootModel) openPicker() (tea.Model, tea.Cmd) {
+	m.picker = &projectPicker{loading: true, theme: m.theme}
+	return m, loadProjectPicker(m.ctx)
+}
+
+// closePicker hides the project picker, quitting if there's no project to return to
+func (m rootModel) closePicker() (tea.Model, tea.Cmd) {
+	if m.planModel == nil {
+		return m.quit()
+	}
+	m.picker = nil
+	return m, nil
+}
+
+// quit exits the program. Teardown happens in cleanup once it has stopped.
+func (m rootModel) quit() (tea.Model, tea.Cmd) {
+	m.quitting = true
+	return m, tea.Quit
+}
+
+// requestQuit quits, unless the plan model asks to confirm it first because
+// tasks are processing
+func (m rootModel) requestQuit() (tea.Model, tea.Cmd) {
+	if m.planModel != nil && m.planModel.openQuitConfirm() {
+		return m, nil
+	}
+	return m.quit()
+}
+
+// cleanup releases what the TUI holds: the plan model's watchers,
+// subscriptions and in-flight commands, and the open project. It runs once
+// the program has stopped, whether the user quit, a signal arrived or the
+// context was cancelled.
+func (m rootModel) cleanup() {
+	if m.planModel != nil {
+		m.planModel.cleanup()
+	}
+	if m.proj != nil {
+		_ = m.proj.Close()
 	}
-	return nil
+}
+
+// setSize lays the panels out for a new terminal size
+func (m rootModel) setSize(width, height int) rootModel {
+	m.width = width
+	m.height = height
+	if m.planModel != nil {
+		m.planModel.SetSize(width, height)
+	}
+	return m
+}
+
+// currentRoot returns the root of the open project, or empty if none is open
+func (m rootModel) currentRoot() string {
+	if m.proj == nil {
+		return ""
+	}
+	return m.proj.Root
+}
+
+// handlePickerKey handles key presses while the project picker is open
+func (m rootModel) handlePickerKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.picker.switching {
+		// Ignore input until the selected project has opened
+		return m, nil
+	}
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m.quit()
+	case "esc", "P":
+		return m.closePicker()
+	case "j", "down":
+		m.picker.moveSelection(1)
+	case "k", "up":
+		m.picker.moveSelection(-1)
+	case "enter":
+		root := m.picker.selectedRoot()
+		if root == "" {
+			return m, nil
+		}
+		if root == m.currentRoot() {
+			return m.closePicker()
+		}
+		m.picker.switching = true
+		return m, openProject(m.ctx, root)
+	}
+	return m, nil
+}
+
+// switchProject tears down the current project and its plan model and
+// starts a fresh plan model for proj. The root model owns project handles,
+// so the previous project is closed here.
+func (m rootModel) switchProject(proj *project.Project) (tea.Model, tea.Cmd) {
+	if m.planModel != nil {
+		m.planModel.cleanup()
+	}
+	if m.proj != nil {
+		_ = m.proj.Close()
+	}
+
+	m.gen++
+	m.proj = proj
+	m.picker = nil
+	m.planModel = newPlanModel(m.ctx, proj, m.theme, m.readOnly)
+	m.planModel.SetSize(m.width, m.height)
+	m.planModel.statusMessage = fmt.Sprintf("Switched to project %s", proj.Config.Project.Name)
+	return m, m.scoped(m.planModel.Init())
 }
 
 // Update implements tea.Model
 func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if scoped, ok := msg.(projectScopedMsg); ok {
+		if scoped.gen != m.gen {
+			// Result of a command started for a project we've switched away from
+			return m, nil
+		}
+		msg = scoped.msg
+	}
+
 	switch msg := msg.(type) {
-	case tea.WindowSizeMsg:
-		m.width = msg.Width
-		m.height = msg.Height
+	case projectPickerLoadedMsg:
+		if m.picker != nil {
+			m.picker.loading = false
+			m.picker.err = msg.err
+			m.picker.setEntries(msg.entries, m.currentRoot())
+		}
+		return m, nil
 
-		// Update plan model dimensions
-		if m.planModel != nil {
-			m.planModel.SetSize(m.width, m.height)
+	case projectOpenedMsg:
+		if msg.err != nil {
+			if m.picker != nil {
+				m.picker.switching = false
+				m.picker.err = fmt.Errorf("failed to open project: %w", msg.err)
+			}
+			return m, nil
 		}
+		return m.switchProject(msg.proj)
 
-		return m, nil
+	case tea.WindowSizeMsg:
+		if !m.sized {
+			m.sized = true
+			return m.setSize(msg.Width, msg.Height), nil
+		}
+		m.pendingWidth, m.pendingHeight = msg.Width, msg.Height
+		m.resizeSeq++
+		seq := m.resizeSeq
+		return m, tea.Tick(resizeDebounce, func(time.Time) tea.Msg {
+			return resizeSettledMsg{seq: seq}
+		})
+
+	case resizeSettledMsg:
+		if msg.seq != m.resizeSeq {
+			// A newer size arrived since; its own tick applies it
+			return m, nil
+		}
+		return m.setSize(m.pendingWidth, m.pendingHeight), nil
 
 	case tea.MouseMsg:
 		m.mouseX = msg.X
 		m.mouseY = msg.Y
 
-		// Route mouse events directly to plan model
-		if m.planModel != nil {
-			var cmd tea.Cmd
-			var newModel tea.Model
-			newModel, cmd = m.planModel.Update(msg)
-			m.planModel = newModel.(*planModel)
-			return m, cmd
+		// The picker is keyboard-only; don't let clicks reach the panels behind it
+		if m.picker != nil {
+			return m, nil
 		}
-		return m, nil
+		// Route mouse events directly to plan model
+		return m.updatePlan(msg)
+
+	case quitConfirmedMsg:
+		return m.quit()
 
 	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			// A second ctrl+c in quick succession quits whatever is going on
+			now := time.Now()
+			if now.Sub(m.lastCtrlC) < forceQuitWindow {
+				return m.quit()
+			}
+			m.lastCtrlC = now
+		}
+
+		if m.picker != nil {
+			return m.handlePickerKey(msg)
+		}
+
 		// Check if plan model is in modal state - if so, route directly to it
 		if m.planModel != nil && m.planModel.InModal() {
-			var cmd tea.Cmd
-			var newModel tea.Model
-			newModel, cmd = m.planModel.Update(msg)
-			m.planModel = newModel.(*planModel)
-			return m, cmd
+			return m.updatePlan(msg)
 		}
 
 		// Global keys (only when not in modal)
 		switch msg.String() {
-		case "q":
-			m.quitting = true
-			// Clean up resources in plan model
-			if m.planModel != nil {
-				m.planModel.cleanup()
-			}
-			return m, tea.Quit
+		case "q", "ctrl+c":
+			return m.requestQuit()
+		case "P":
+			return m.openPicker()
 		}
 
 		// Route to plan model
-		if m.planModel != nil {
-			var cmd tea.Cmd
-			var newModel tea.Model
-			newModel, cmd = m.planModel.Update(msg)
-			m.planModel = newModel.(*planModel)
-			return m, cmd
-		}
-		return m, nil
+		return m.updatePlan(msg)
 
 	default:
 		// Route other messages to plan model
-		if m.planModel != nil {
-			var cmd tea.Cmd
-			var newModel tea.Model
-			newModel, cmd = m.planModel.Update(msg)
-			m.planModel = newModel.(*planModel)
-			return m, cmd
-		}
-		return m, nil
+		return m.updatePlan(msg)
 	}
 }
 
@@ -144,6 +373,10 @@ func (m rootModel) View() string {
 		return ""
 	}
 
+	if m.picker != nil {
+		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.picker.render(m.currentRoot()))
+	}
+
 	// Render plan model content directly and wrap with zone.Scan
 	if m.planModel != nil {
 		return zone.Scan(m.planModel.View())
@@ -152,19 +385,74 @@ func (m rootModel) View() string {
 	return ""
 }
 
-// RunRootTUI starts the TUI with the new root model
-func RunRootTUI(ctx context.Context, proj *project.Project, enableMouse bool) error {
-	model := newRootModel(ctx, proj)
+// RunRootTUI starts the TUI with the new root model. When showPicker is set
+// (or proj is nil) it opens on the project picker. The TUI takes ownership of
+// proj: switching projects closes it, and whichever project is open when the
+// TUI exits is closed before returning. The TUI exits when ctx is cancelled.
+// theme controls the colors used; the mono theme also switches lipgloss to
+// plain ASCII output. readOnly disables every action that changes a project.
+// tour replays the first-run tour once the project has loaded.
+func RunRootTUI(ctx context.Context, proj *project.Project, theme *Theme, enableMouse, showPicker, readOnly, tour bool) error {
+	if theme.Name == ThemeMono {
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+	stopDebug := startDebugEvents(debugProjectRoot(proj))
+	defer stopDebug()
+	model := newRootModel(ctx, proj, theme, showPicker, readOnly)
+	if tour && model.planModel != nil {
+		model.planModel.startTour = true
+	}
+
+	return runRoot(ctx, model, programOptions(enableMouse)...)
+}
+
+// RunTriageTUI starts the TUI in triage, walking the open issues that aren't
+// in a work one at a time. Finishing triage leaves the TUI open on the issues.
+// proj is owned by the TUI as with RunRootTUI.
+func RunTriageTUI(ctx context.Context, proj *project.Project, theme *Theme, enableMouse, readOnly bool) error {
+	if theme.Name == ThemeMono {
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+	stopDebug := startDebugEvents(debugProjectRoot(proj))
+	defer stopDebug()
+	model := newRootModel(ctx, proj, theme, false, readOnly)
+	model.planModel.startTriage = true
 
+	return runRoot(ctx, model, programOptions(enableMouse)...)
+}
+
+// debugProjectRoot returns the root of the project the debug event log is
+// kept in, or "" when the TUI starts without one
+func debugProjectRoot(proj *project.Project) string {
+	if proj == nil {
+		return ""
+	}
+	return proj.Root
+}
+
+// programOptions returns the options the TUI program runs with
+func programOptions(enableMouse bool) []tea.ProgramOption {
 	opts := []tea.ProgramOption{tea.WithAltScreen()}
 	if enableMouse {
 		opts = append(opts, tea.WithMouseAllMotion())
 	}
-	p := tea.NewProgram(model, opts...)
+	return opts
+}
 
-	if _, err := p.Run(); err != nil {
-		return err
-	}
+// runRoot runs model until the user quits, a signal arrives or ctx is
+// cancelled, then cleans up the model that was current when it stopped.
+// Cancellation is a normal way to exit and isn't reported as an error.
+func runRoot(ctx context.Context, model rootModel, opts ...tea.ProgramOption) error {
+	p := tea.NewProgram(model, append(opts, tea.WithContext(ctx))...)
 
-	return nil
+	final, err := p.Run()
+	if final, ok := final.(rootModel); ok {
+		final.cleanup()
+	} else {
+		model.cleanup()
+	}
+	if errors.Is(err, tea.ErrProgramKilled) && ctx.Err() != nil {
+		return nil
+	}
+	return err
 }
@@ -0,0 +1,171 @@
+package tui
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/progress"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// requireGoroutinesDone fails unless the goroutine count drops back to
+// before, giving the ones being torn down a moment to return
+func requireGoroutinesDone(t *testing.T, before int) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			buf := make([]byte, 1<<16)
+			t.Fatalf("goroutines leaked: %d running, %d before\n%s", runtime.NumGoroutine(), before, buf[:runtime.Stack(buf, true)])
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestRootModelDebouncesResize(t *testing.T) {
+	m := rootModel{width: 80, height: 24}
+
+	// The first size is applied right away
+	newModel, cmd := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	m = newModel.(rootModel)
+	require.Nil(t, cmd)
+	require.Equal(t, 100, m.width)
+
+	// A burst of resizes only takes effect once the last one settles
+	newModel, first := m.Update(tea.WindowSizeMsg{Width: 110, Height: 31})
+	m = newModel.(rootModel)
+	newModel, last := m.Update(tea.WindowSizeMsg{Width: 120, Height: 32})
+	m = newModel.(rootModel)
+	require.NotNil(t, first)
+	require.NotNil(t, last)
+	require.Equal(t, 100, m.width)
+
+	newModel, _ = m.Update(first())
+	m = newModel.(rootModel)
+	require.Equal(t, 100, m.width, "a superseded resize is dropped")
+
+	newModel, _ = m.Update(last())
+	m = newModel.(rootModel)
+	require.Equal(t, 120, m.width)
+	require.Equal(t, 32, m.height)
+}
+
+func TestRunRootExitsOnContextCancel(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		model := newRootModel(ctx, nil, DarkTheme(), true, false)
+		done <- runRoot(ctx, model, tea.WithInput(nil), tea.WithOutput(io.Discard), tea.WithoutSignalHandler())
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	select {
+	case err := <-done:
+		require.NoError(t, err, "cancellation is a clean exit")
+	case <-time.After(5 * time.Second):
+		t.Fatal("TUI did not exit after its context was cancelled")
+	}
+	requireGoroutinesDone(t, before)
+}
+
+func TestRootCleanupStopsWatchers(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	m := newFlowTestModel(t, h)
+	require.NoError(t, os.MkdirAll(m.proj.BeadsPath(), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(m.proj.Root, ".co"), 0o755))
+	before := runtime.NumGoroutine()
+
+	m.ctx, m.cancel = context.WithCancel(context.Background())
+	m.beadsWatcher, m.trackingWatcher = startWatchers(m.proj)
+	require.NotNil(t, m.beadsWatcher)
+	require.NotNil(t, m.trackingWatcher)
+
+	// Subscriptions block on the watchers the way the running program does
+	msgs := make(chan tea.Msg, 2)
+	for _, cmd := range []tea.Cmd{m.waitForWatcherEvent(), m.waitForTrackingWatcherEvent()} {
+		go func() { msgs <- cmd() }()
+	}
+	require.Eventually(t, func() bool {
+		return m.beadsWatcher.Broker().SubscriberCount() == 1 && m.trackingWatcher.Broker().SubscriberCount() == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	rootModel{planModel: m}.cleanup()
+	for range 2 {
+		select {
+		case msg := <-msgs:
+			require.Nil(t, msg)
+		case <-time.After(5 * time.Second):
+			t.Fatal("watcher subscription still blocked after cleanup")
+		}
+	}
+	require.Nil(t, m.beadsWatcher)
+	require.Nil(t, m.trackingWatcher)
+	requireGoroutinesDone(t, before)
+}
+
+func TestRootModelConfirmsQuitWhileTasksProcess(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	w := h.CreateWork("w-abc", "feat/abc")
+
+	plan := newFlowTestModel(t, h)
+	plan.confirmQuit = true
+	plan.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{{Work: w, Tasks: []*progress.TaskProgress{
+		{Task: &db.Task{ID: "w-abc.1", Status: db.StatusProcessing}},
+		{Task: &db.Task{ID: "w-abc.2", Status: db.StatusCompleted}},
+	}}}})
+	m := rootModel{planModel: plan}
+	key := func(m rootModel, k tea.KeyMsg) (rootModel, tea.Cmd) {
+		newModel, cmd := m.Update(k)
+		return newModel.(rootModel), cmd
+	}
+	q := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}
+
+	// q asks first, and n stays
+	m, _ = key(m, q)
+	require.False(t, m.quitting)
+	require.Equal(t, ViewQuitConfirm, plan.viewMode)
+	require.Contains(t, plan.View(), "1 task still processing")
+	require.Contains(t, plan.View(), "w-abc/w-abc.1")
+	m, _ = key(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	require.Equal(t, ViewNormal, plan.viewMode)
+
+	// y quits through the root model
+	m, _ = key(m, q)
+	m, cmd := key(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	require.NotNil(t, cmd)
+	quit, _ := m.Update(cmd())
+	require.True(t, quit.(rootModel).quitting)
+
+	// ctrl+c twice quits at once, even from a dialog
+	m = rootModel{planModel: plan}
+	plan.viewMode = ViewHelp
+	m, _ = key(m, tea.KeyMsg{Type: tea.KeyCtrlC})
+	require.False(t, m.quitting)
+	m, _ = key(m, tea.KeyMsg{Type: tea.KeyCtrlC})
+	require.True(t, m.quitting)
+
+	// Without confirm_quit, or with nothing processing, q quits right away
+	plan.viewMode = ViewNormal
+	plan.confirmQuit = false
+	m, _ = key(rootModel{planModel: plan}, q)
+	require.True(t, m.quitting)
+	plan.confirmQuit = true
+	plan.Update(workTilesLoadedMsg{works: []*progress.WorkProgress{{Work: w}}})
+	m, _ = key(rootModel{planModel: plan}, q)
+	require.True(t, m.quitting)
+}
@@ -2,114 +2,29 @@ package tui
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/wordwrap"
+	"github.com/muesli/reflow/wrap"
 	"github.com/newhook/co/internal/beads"
 	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/fuzzy"
 )
 
-// TUI-specific styles - shared across all TUI modes
-var (
-	tuiTitleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("205"))
-
-	tuiHotkeyStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("214")) // Orange for hotkeys
-
-	tuiPanelStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("62")).
-			Padding(0, 1)
-
-	tuiSelectedStyle = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(lipgloss.Color("255")).
-				Background(lipgloss.Color("62"))
-
-	tuiSelectedCheckStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("42"))
-
-	tuiLabelStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("247"))
-
-	tuiValueStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("255"))
-
-	tuiDimStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241"))
-
-	tuiErrorStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196"))
-
-	tuiSuccessStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("42"))
-
-	tuiStatusBarStyle = lipgloss.NewStyle().
-				Background(lipgloss.Color("236")).
-				Padding(0, 1)
-
-	tuiDialogStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("99")).
-			Padding(1, 2).
-			Background(lipgloss.Color("235"))
-
-	tuiHelpStyle = lipgloss.NewStyle().
-			Padding(2, 4).
-			Background(lipgloss.Color("235"))
-
-	// Status indicator styles
-	statusPending = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241"))
-
-	statusProcessing = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("214")).
-				Bold(true)
-
-	statusCompleted = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("42")).
-			Bold(true)
-
-	statusFailed = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")).
-			Bold(true)
-
-	// Issue line styles
-	issueIDStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("214")) // Orange
-
-	issueTreeStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")) // Dim gray for tree connectors
-
-	// Type indicator styles
-	typeTaskStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("75")) // Blue
-
-	typeBugStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")) // Red
-
-	typeFeatureStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("42")) // Green
-
-	typeEpicStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("213")). // Pink/magenta
-			Bold(true)
-
-	typeChoreStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("247")) // Gray
-
-	typeDefaultStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("247")) // Gray for others
-
-	// New bead animation style
-	tuiNewBeadStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFFF00")). // Bright yellow for newly created beads
-			Bold(true)
-)
+// wrapText wraps text to lines of at most width terminal cells. Lines break
+// at spaces where possible and inside words that don't fit on a line of their
+// own, such as CJK text without spaces. Wide runes count as two cells.
+func wrapText(text string, width int) string {
+	if width < 1 {
+		return text
+	}
+	return wrap.String(wordwrap.String(text, width), width)
+}
 
 // Panel represents which panel is currently focused
 type Panel int
@@ -134,12 +49,37 @@ const (
 	ViewAddChildBead // Add child issue to selected issue
 	ViewEditBead     // Edit selected issue
 	ViewDestroyConfirm
+	ViewCompleteWorkConfirm // Checklist confirm for cleaning up a merged work
 	ViewCloseBeadConfirm
-	ViewAssignBeads
+	ViewAssignBeads // Confirm adding issues to the focused work, optionally running it
 	ViewBeadSearch
 	ViewLabelFilter
+	ViewLabelPicker        // Add/remove a label on the selected issues
 	ViewLinearImportInline // Import from Linear (inline in details panel)
 	ViewPRImportInline     // Import from GitHub PR (inline in details panel)
+	ViewComplexityStats    // Budget vs actual complexity overlay
+	ViewSpawnError         // Output of a failed orchestrator/tab spawn
+	ViewDiff               // Diff of a work's branch against its base
+	ViewTaskTypePicker     // Pick a custom task type to create for the focused work
+	ViewCommandPalette     // Fuzzy-searchable list of the actions available in the active panel
+	ViewPlanReview         // Review and edit the proposed task grouping before a run
+	ViewRemoveBeadConfirm  // Offer to take a bead out of its pending tasks before removing it
+	ViewMoveBeadPicker     // Pick another work to move the selected unassigned bead to
+	ViewArtifacts          // Browse and view the selected task's artifacts
+	ViewWorkNotes          // Edit the focused work's notes
+	ViewWorkEnv            // Edit the focused work's environment overrides
+	ViewWorkRelocate       // Point a work at its moved worktree or re-create it
+	ViewTriage             // Walk the open issues one at a time, setting a disposition for each
+	ViewUndoConfirm        // Recent actions, offering to undo the newest that can be undone
+	ViewConfigErrors       // Problems found in config.toml when the project was opened
+	ViewDraftRestore       // Offer to restore a dialog draft left by a session that didn't close cleanly
+	ViewReviewChoice       // Offer a review including CI failures for a work whose PR fails CI
+	ViewQuitConfirm        // Confirm quitting while tasks are processing
+	ViewTimeline           // Chart of when the focused work's tasks ran
+	ViewChecklistImport    // Paste a markdown checklist to create an issue per item
+	ViewDedupe             // Pick a likely duplicate of the selected issue to merge
+	ViewTour               // First-run tour of the concepts and keys
+	ViewRunAll             // Run every idle work with pending issues or tasks, and follow how each went
 	ViewHelp
 )
 
@@ -149,13 +89,18 @@ type beadItem struct {
 	*beads.BeadWithDeps
 
 	// TUI-specific display state
-	isReady bool // computed ready state
+	isReady           bool     // computed ready state
 	treeDepth         int      // depth in tree view (0 = root)
 	assignedWorkID    string   // work ID if already assigned to a work (empty = not assigned)
 	isClosedParent    bool     // true if this is a closed bead included for tree context (has visible children)
 	isLastChild       bool     // true if this bead is the last child of its parent
 	treePrefixPattern string   // precomputed tree prefix pattern (e.g., "│ └─")
 	children          []string // IDs of issues blocked by this one (computed from tree)
+
+	// Search state, set while a search is active
+	searchScore  int   // how well the bead matched; higher is better
+	idMatches    []int // rune indexes of the matched characters in the ID
+	titleMatches []int // rune indexes of the matched characters in the title
 }
 
 // beadFilters holds the current filter state for beads
@@ -187,38 +132,27 @@ var beadTypes = []string{
 	"event",
 }
 
-// statusIcon returns the icon for a given status
-func statusIcon(status string) string {
-	switch status {
-	// Internal db statuses
-	case db.StatusPending:
-		return statusPending.Render("○")
-	case db.StatusProcessing:
-		return statusProcessing.Render("●")
-	case db.StatusCompleted:
-		return statusCompleted.Render("✓")
-	case db.StatusFailed:
-		return statusFailed.Render("✗")
-	// Bead statuses from bd CLI
-	case "open":
-		return statusPending.Render("○")
-	case "in_progress":
-		return statusProcessing.Render("●")
-	case "blocked":
-		return statusFailed.Render("◐")
-	case "deferred":
-		return statusPending.Render("❄")
-	case "closed":
-		return statusCompleted.Render("✓")
-	default:
-		return "?"
+// failureBadge returns a colored badge for the kind of a failed task's
+// failure, or "" if it wasn't recorded
+func (t *Theme) failureBadge(kind string) string {
+	color := t.ErrorColor
+	switch kind {
+	case "":
+		return ""
+	case db.FailureRateLimited:
+		color = t.WarningColor
+	case db.FailureTimeout:
+		color = t.AccentColor
+	case db.FailureCancelled:
+		color = t.MutedColor
 	}
+	label := strings.ReplaceAll(kind, "_", " ")
+	return lipgloss.NewStyle().Foreground(color).Bold(true).Render("[" + label + "]")
 }
 
-
 // styleHotkeys styles text with hotkeys like "[c]reate [d]elete" by coloring the keys
-// The keys inside brackets are rendered with tuiHotkeyStyle
-func styleHotkeys(text string) string {
+// The keys inside brackets are rendered with the theme's hotkey style
+func (t *Theme) styleHotkeys(text string) string {
 	var result strings.Builder
 	i := 0
 	for i < len(text) {
@@ -232,7 +166,7 @@ func styleHotkeys(text string) string {
 				// Found a complete [key] sequence
 				key := text[i+1 : end]
 				result.WriteString("[")
-				result.WriteString(tuiHotkeyStyle.Render(key))
+				result.WriteString(t.Hotkey.Render(key))
 				result.WriteString("]")
 				i = end + 1
 				continue
@@ -246,149 +180,171 @@ func styleHotkeys(text string) string {
 
 // styleButtonWithHover styles a button with hover effect if hovered is true
 // This is used for clickable buttons and mode tabs in the TUI
-func styleButtonWithHover(text string, hovered bool) string {
-	hoverStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("0")).   // Black text
-		Background(lipgloss.Color("214")). // Orange background
-		Bold(true)
-
+func (t *Theme) styleButtonWithHover(text string, hovered bool) string {
 	if hovered {
-		return hoverStyle.Render(text)
+		return t.ButtonHover.Render(text)
 	}
-	return styleHotkeys(text)
+	return t.styleHotkeys(text)
 }
 
-
-// fetchBeadsWithFilters fetches and filters beads based on provided filters
-func fetchBeadsWithFilters(ctx context.Context, beadsClient *beads.Client, _ string, filters beadFilters) ([]beadItem, error) {
-	// For "ready" status, use bd ready command
-	if filters.status == "ready" {
-		return fetchReadyBeads(ctx, beadsClient, filters)
+// highlightMatches renders text in base, with the runes at positions (which
+// must be ascending) in the search match style
+func (t *Theme) highlightMatches(text string, positions []int, base lipgloss.Style) string {
+	if len(positions) == 0 {
+		return base.Render(text)
 	}
-
-	// List issues with optional status filter
-	// "open" means all non-closed statuses (open, in_progress, blocked, deferred)
-	// "all" means no filter
-	// Other values are passed directly as status filter
-	statusFilter := ""
-	filterOutClosed := false
-	if filters.status == beads.StatusOpen {
-		// Fetch all and filter out closed
-		statusFilter = ""
-		filterOutClosed = true
-	} else if filters.status != "" && filters.status != "all" {
-		statusFilter = filters.status
+	var result strings.Builder
+	var run []rune
+	runMatched := false
+	flush := func() {
+		if len(run) == 0 {
+			return
+		}
+		if runMatched {
+			result.WriteString(t.SearchMatch.Render(string(run)))
+		} else {
+			result.WriteString(base.Render(string(run)))
+		}
+		run = run[:0]
 	}
-	issuesList, err := beadsClient.ListBeads(ctx, statusFilter)
-	if err != nil {
-		return nil, err
+	next := 0
+	for i, r := range []rune(text) {
+		matched := next < len(positions) && positions[next] == i
+		if matched {
+			next++
+		}
+		if matched != runMatched {
+			flush()
+			runMatched = matched
+		}
+		run = append(run, r)
 	}
+	flush()
+	return result.String()
+}
 
-	// Filter out closed issues if "open" filter was requested
-	if filterOutClosed {
-		filtered := make([]beads.Bead, 0, len(issuesList))
-		for _, issue := range issuesList {
-			if issue.Status != beads.StatusClosed {
-				filtered = append(filtered, issue)
-			}
+// clipPositions returns the positions below limit
+func clipPositions(positions []int, limit int) []int {
+	for i, p := range positions {
+		if p >= limit {
+			return positions[:i]
 		}
-		issuesList = filtered
 	}
+	return positions
+}
 
-	// TODO: Apply label filter if needed (requires additional query support)
+// beadCounts is how many issues each status filter would show. It honors
+// the label filter but not the search, so it doesn't jump while typing.
+type beadCounts struct {
+	open   int // Everything not closed
+	ready  int
+	closed int
+}
+
+// fetchBeadsWithFilters fetches and filters beads based on provided filters,
+// and counts the issues in each status bucket from the same listing
+func fetchBeadsWithFilters(ctx context.Context, beadsClient *beads.Client, filters beadFilters) ([]beadItem, *beadCounts, error) {
+	// Every issue is listed so the counts of the other filters come for free.
+	// "open" means all non-closed statuses (open, in_progress, blocked, deferred),
+	// "all" means no filter, and other values are matched against the status.
+	allIssues, err := beadsClient.ListBeads(ctx, "")
+	if err != nil {
+		return nil, nil, err
+	}
 
 	// Get ready issues to mark which ones are ready
-	readyIssues, _ := beadsClient.GetReadyBeads(ctx)
+	readyIssues, err := beadsClient.GetReadyBeads(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
 	readySet := make(map[string]bool)
 	for _, issue := range readyIssues {
 		readySet[issue.ID] = true
 	}
 
-	// Fetch dependency/dependent counts for all issues
+	counts := &beadCounts{}
+	var issuesList []beads.Bead
+	for _, issue := range allIssues {
+		if filters.label != "" && !slices.Contains(issue.Labels, filters.label) {
+			continue
+		}
+		if issue.Status == beads.StatusClosed {
+			counts.closed++
+		} else {
+			counts.open++
+		}
+		if readySet[issue.ID] {
+			counts.ready++
+		}
+		if matchesStatusFilter(issue, filters.status, readySet) {
+			issuesList = append(issuesList, issue)
+		}
+	}
+
+	// Fetch dependency/dependent counts for the listed issues
 	issueIDs := make([]string, 0, len(issuesList))
 	for _, issue := range issuesList {
 		issueIDs = append(issueIDs, issue.ID)
 	}
 	depsResult, err := beadsClient.GetBeadsWithDeps(ctx, issueIDs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var items []beadItem
 	for _, issue := range issuesList {
-		// Apply search filter
-		if filters.searchText != "" {
-			searchLower := strings.ToLower(filters.searchText)
-			if !strings.Contains(strings.ToLower(issue.ID), searchLower) &&
-				!strings.Contains(strings.ToLower(issue.Title), searchLower) &&
-				!strings.Contains(strings.ToLower(issue.Description), searchLower) {
-				continue
-			}
-		}
-
 		beadWithDeps := depsResult.GetBead(issue.ID)
 		if beadWithDeps == nil {
 			// Fallback: create BeadWithDeps from the issue
 			bead := issue
 			beadWithDeps = &beads.BeadWithDeps{Bead: &bead}
 		}
-		items = append(items, beadItem{
+		item := beadItem{
 			BeadWithDeps: beadWithDeps,
 			isReady:      readySet[issue.ID],
-		})
+		}
+		// Apply search filter
+		if filters.searchText != "" && !item.matchSearch(filters.searchText) {
+			continue
+		}
+		items = append(items, item)
 	}
 
 	// Apply sorting
 	items = sortBeadItems(items, filters.sortBy)
+	if filters.searchText != "" {
+		sortBySearchScore(items)
+	}
 
-	return items, nil
+	return items, counts, nil
 }
 
-func fetchReadyBeads(ctx context.Context, beadsClient *beads.Client, filters beadFilters) ([]beadItem, error) {
-	// Get ready issues
-	readyIssues, err := beadsClient.GetReadyBeads(ctx)
+// markAssignedWorks sets the work each bead is assigned to, so every view
+// of beads refuses to put one in a second work
+func markAssignedWorks(ctx context.Context, store db.Store, items []beadItem) error {
+	assigned, err := store.GetAllAssignedBeads(ctx)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to get assigned beads: %w", err)
 	}
-
-	// Fetch dependency/dependent counts for ready issues
-	issueIDs := make([]string, 0, len(readyIssues))
-	for _, issue := range readyIssues {
-		issueIDs = append(issueIDs, issue.ID)
+	for i := range items {
+		items[i].assignedWorkID = assigned[items[i].ID]
 	}
-	depsResult, err := beadsClient.GetBeadsWithDeps(ctx, issueIDs)
-	if err != nil {
-		return nil, err
-	}
-
-	var items []beadItem
-	for _, issue := range readyIssues {
-		// Apply search filter
-		if filters.searchText != "" {
-			searchLower := strings.ToLower(filters.searchText)
-			if !strings.Contains(strings.ToLower(issue.ID), searchLower) &&
-				!strings.Contains(strings.ToLower(issue.Title), searchLower) &&
-				!strings.Contains(strings.ToLower(issue.Description), searchLower) {
-				continue
-			}
-		}
+	return nil
+}
 
-		beadWithDeps := depsResult.GetBead(issue.ID)
-		if beadWithDeps == nil {
-			// Fallback: create BeadWithDeps from the issue
-			bead := issue
-			beadWithDeps = &beads.BeadWithDeps{Bead: &bead}
-		}
-		items = append(items, beadItem{
-			BeadWithDeps: beadWithDeps,
-			isReady:      true,
-		})
+// matchesStatusFilter reports whether an issue belongs in the list for a
+// status filter
+func matchesStatusFilter(issue beads.Bead, status string, readySet map[string]bool) bool {
+	switch status {
+	case "", "all":
+		return true
+	case beads.StatusOpen:
+		return issue.Status != beads.StatusClosed
+	case "ready":
+		return readySet[issue.ID]
+	default:
+		return issue.Status == status
 	}
-
-	// Apply sorting
-	items = sortBeadItems(items, filters.sortBy)
-
-	return items, nil
 }
 
 func sortBeadItems(items []beadItem, sortBy string) []beadItem {
@@ -413,3 +369,49 @@ func sortBeadItems(items []beadItem, sortBy string) []beadItem {
 	}
 	return items
 }
+
+// Search weights: a bead matched on its ID or title ranks above one that
+// only mentions the query in its description
+const (
+	searchWeightID          = 3
+	searchWeightTitle       = 2
+	searchWeightDescription = 1
+)
+
+// matchSearch reports whether the bead matches the search query, recording
+// its score and the matched characters of its ID and title. IDs and titles
+// match fuzzily; descriptions only on exact substrings, since scattered
+// matches across long text are mostly noise.
+func (b *beadItem) matchSearch(query string) bool {
+	b.searchScore, b.idMatches, b.titleMatches = 0, nil, nil
+	if m, ok := fuzzy.Find(b.ID, query); ok {
+		b.searchScore = max(b.searchScore, m.Score*searchWeightID)
+		b.idMatches = m.Positions
+	}
+	if m, ok := fuzzy.Find(b.Title, query); ok {
+		b.searchScore = max(b.searchScore, m.Score*searchWeightTitle)
+		b.titleMatches = m.Positions
+	}
+	if b.searchScore == 0 && fuzzy.Contains(b.Description, query) {
+		m, _ := fuzzy.Find(b.Description, query)
+		b.searchScore = m.Score * searchWeightDescription
+	}
+	return b.searchScore > 0
+}
+
+// sortBySearchScore orders search results best match first, keeping the
+// existing order between equal scores
+func sortBySearchScore(items []beadItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].searchScore > items[j].searchScore
+	})
+}
+
+// formatScheduledRun formats a scheduled run time: just the clock time when it
+// falls within the next day, otherwise with the date.
+func formatScheduledRun(at, now time.Time) string {
+	if at.Sub(now) < 24*time.Hour {
+		return at.Format("15:04")
+	}
+	return at.Format("Jan 2 15:04")
+}
@@ -0,0 +1,114 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/newhook/co/internal/project"
+	workpkg "github.com/newhook/co/internal/work"
+)
+
+const (
+	// spawnOutputMaxBytes bounds how much spawn output is kept in memory
+	spawnOutputMaxBytes = 64 * 1024
+	// spawnOutputTailLines is how many lines the spawn error overlay shows
+	spawnOutputTailLines = 20
+)
+
+// spawnOutput captures the progress output of orchestrator and tab spawns so
+// it can be shown if the spawn fails. Only the most recent output is kept.
+type spawnOutput struct {
+	mu  sync.Mutex
+	buf []byte
+}
+
+// Write implements io.Writer.
+func (o *spawnOutput) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.buf = append(o.buf, p...)
+	if over := len(o.buf) - spawnOutputMaxBytes; over > 0 {
+		o.buf = append([]byte(nil), o.buf[over:]...)
+	}
+	return len(p), nil
+}
+
+// String returns all captured output.
+func (o *spawnOutput) String() string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return string(o.buf)
+}
+
+// Tail returns the last n non-blank lines of captured output.
+func (o *spawnOutput) Tail(n int) []string {
+	var lines []string
+	for _, line := range strings.Split(o.String(), "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return lines
+}
+
+// spawnError describes a failed spawn for the spawn error overlay.
+type spawnError struct {
+	action  string
+	id      string // work or bead ID the spawn was for
+	err     error
+	output  []string // tail of the captured output
+	logPath string   // full output on disk, empty if it couldn't be written
+}
+
+// newSpawnError collects a failed spawn's output and writes all of it to
+// .co/logs/orchestrator-spawn-<id>.log.
+func newSpawnError(projectRoot, action, id string, err error, out *spawnOutput) *spawnError {
+	se := &spawnError{
+		action: action,
+		id:     id,
+		err:    err,
+		output: out.Tail(spawnOutputTailLines),
+	}
+
+	logDir := filepath.Join(projectRoot, project.ConfigDir, "logs")
+	if mkErr := os.MkdirAll(logDir, 0755); mkErr != nil {
+		return se
+	}
+	logPath := filepath.Join(logDir, fmt.Sprintf("orchestrator-spawn-%s.log", id))
+	content := fmt.Sprintf("%s failed for %s at %s\nError: %v\n\n%s",
+		action, id, time.Now().Format(time.RFC3339), err, out.String())
+	if writeErr := os.WriteFile(logPath, []byte(content), 0644); writeErr == nil {
+		se.logPath = logPath
+	}
+	return se
+}
+
+// orchestratorSpawnError is newSpawnError for a work command that starts the
+// orchestrator after steps of its own. Only the orchestrator failing to start,
+// with output to show, gets the overlay; the command's other errors return
+// nil and are reported by their kind.
+func orchestratorSpawnError(projectRoot, action, id string, err error, out *spawnOutput) *spawnError {
+	var spawnErr *workpkg.SpawnError
+	if !errors.As(err, &spawnErr) || len(out.Tail(1)) == 0 {
+		return nil
+	}
+	return newSpawnError(projectRoot, action, id, err, out)
+}
+
+// showSpawnError opens the spawn error overlay. It is a no-op for errors that
+// didn't come from a spawn, which stay in the status bar only.
+func (m *planModel) showSpawnError(se *spawnError) {
+	if se == nil {
+		return
+	}
+	m.spawnErr = se
+	m.viewMode = ViewSpawnError
+}
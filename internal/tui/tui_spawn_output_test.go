@@ -0,0 +1,74 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/newhook/co/internal/coerrors"
+	workpkg "github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpawnOutputTail(t *testing.T) {
+	out := &spawnOutput{}
+	for i := 1; i <= 30; i++ {
+		fmt.Fprintf(out, "line %d\n\n", i)
+	}
+
+	tail := out.Tail(spawnOutputTailLines)
+	require.Len(t, tail, spawnOutputTailLines)
+	require.Equal(t, "line 11", tail[0])
+	require.Equal(t, "line 30", tail[len(tail)-1])
+
+	// Output beyond the cap drops the oldest bytes
+	_, _ = out.Write([]byte(strings.Repeat("x", spawnOutputMaxBytes)))
+	require.Len(t, out.String(), spawnOutputMaxBytes)
+}
+
+func TestSpawnErrorOverlay(t *testing.T) {
+	root := t.TempDir()
+	out := &spawnOutput{}
+	fmt.Fprintln(out, "Creating zellij session co-test...")
+	fmt.Fprintln(out, "exec: \"claude\": executable file not found in $PATH")
+
+	se := newSpawnError(root, "Restart orchestrator", "w-abc", errors.New("failed to spawn orchestrator"), out)
+	require.Equal(t, filepath.Join(root, ".co", "logs", "orchestrator-spawn-w-abc.log"), se.logPath)
+
+	logData, err := os.ReadFile(se.logPath)
+	require.NoError(t, err)
+	require.Contains(t, string(logData), "failed to spawn orchestrator")
+	require.Contains(t, string(logData), "executable file not found")
+
+	m := &planModel{theme: DarkTheme()}
+	m.showSpawnError(nil)
+	require.Equal(t, ViewNormal, m.viewMode, "non-spawn errors stay in the status bar")
+
+	m.showSpawnError(se)
+	require.Equal(t, ViewSpawnError, m.viewMode)
+	content := m.renderSpawnErrorContent()
+	require.Contains(t, content, "Restart orchestrator failed for w-abc")
+	require.Contains(t, content, "executable file not found")
+	require.Contains(t, content, "orchestrator-spawn-w-abc.log")
+}
+
+func TestOrchestratorSpawnError(t *testing.T) {
+	root := t.TempDir()
+	out := &spawnOutput{}
+
+	// Without output there's nothing for the overlay to show
+	spawnErr := coerrors.Wrap(coerrors.ExternalTool, &workpkg.SpawnError{Err: errors.New("zellij not found")})
+	require.Nil(t, orchestratorSpawnError(root, "Run work", "w-abc", spawnErr, out))
+
+	// Output written by the command's own steps doesn't make their errors spawn failures
+	fmt.Fprintln(out, "Created task w-abc.1")
+	invalid := coerrors.Errorf(coerrors.Validation, "work w-abc has no worktree path configured")
+	require.Nil(t, orchestratorSpawnError(root, "Run work", "w-abc", invalid, out))
+
+	se := orchestratorSpawnError(root, "Run work", "w-abc", spawnErr, out)
+	require.NotNil(t, se)
+	require.Equal(t, []string{"Created task w-abc.1"}, se.output)
+}
@@ -0,0 +1,58 @@
+package tui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/newhook/co/internal/db"
+)
+
+// taskTypeBadge is how a task type is shown in task lists: a glyph and a
+// short name
+type taskTypeBadge struct {
+	glyph  string
+	abbrev string
+}
+
+// builtinTaskTypeAbbrevs holds the short names of the built-in task types
+var builtinTaskTypeAbbrevs = map[string]string{
+	db.TaskTypeImplement:           "impl",
+	db.TaskTypeEstimate:            "est",
+	db.TaskTypeReview:              "rev",
+	db.TaskTypePR:                  "pr",
+	db.TaskTypeUpdatePRDescription: "pr-upd",
+	db.TaskTypeLogAnalysis:         "log",
+}
+
+// taskTypeBadge returns the badge of a task type. Tasks without a type are
+// implement tasks. Built-in types take their glyph from the icon set; custom
+// types show their own name, with the glyph registered for them under
+// [workflow.task_types.<name>] glyph.
+func (t *Theme) taskTypeBadge(taskType string, customGlyphs map[string]string) taskTypeBadge {
+	if taskType == "" {
+		taskType = db.TaskTypeImplement
+	}
+	if abbrev, ok := builtinTaskTypeAbbrevs[taskType]; ok {
+		return taskTypeBadge{glyph: t.Icons.TaskTypes[taskType], abbrev: abbrev}
+	}
+	glyph := customGlyphs[taskType]
+	if glyph == "" {
+		glyph = t.Icons.CustomTask
+	}
+	return taskTypeBadge{glyph: glyph, abbrev: taskType}
+}
+
+// taskTypeStyle returns the style a task type's glyph is colored with
+func (t *Theme) taskTypeStyle(taskType string) lipgloss.Style {
+	switch taskType {
+	case db.TaskTypeImplement, "":
+		return t.TypeTask
+	case db.TaskTypeEstimate:
+		return lipgloss.NewStyle().Foreground(t.WarningColor)
+	case db.TaskTypeReview:
+		return lipgloss.NewStyle().Foreground(t.HighlightColor)
+	case db.TaskTypePR, db.TaskTypeUpdatePRDescription:
+		return lipgloss.NewStyle().Foreground(t.SuccessColor)
+	case db.TaskTypeLogAnalysis:
+		return lipgloss.NewStyle().Foreground(t.MutedColor)
+	}
+	return lipgloss.NewStyle().Foreground(t.InfoColor)
+}
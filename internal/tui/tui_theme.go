@@ -0,0 +1,270 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Theme names accepted by ResolveTheme
+const (
+	ThemeAuto  = "auto"
+	ThemeDark  = "dark"
+	ThemeLight = "light"
+	ThemeMono  = "mono"
+)
+
+// Theme holds every color and style the TUI renders with. A theme is chosen
+// once at startup and handed to the models and panels, which render through it
+// instead of hardcoding colors.
+type Theme struct {
+	Name string
+
+	// Colors for styles that are assembled while rendering
+	AccentColor    lipgloss.TerminalColor // Hotkeys, focused borders, warnings
+	SuccessColor   lipgloss.TerminalColor
+	ErrorColor     lipgloss.TerminalColor
+	WarningColor   lipgloss.TerminalColor // Partial progress, pending checks
+	InfoColor      lipgloss.TerminalColor // Links, root issues, new activity
+	HighlightColor lipgloss.TerminalColor // Merged PRs, PR section headers
+	MutedColor     lipgloss.TerminalColor
+	FaintColor     lipgloss.TerminalColor
+	TabBarBg       lipgloss.TerminalColor
+
+	Title         lipgloss.Style
+	Hotkey        lipgloss.Style
+	Panel         lipgloss.Style
+	Selected      lipgloss.Style
+	SelectedCheck lipgloss.Style
+	Label         lipgloss.Style
+	Value         lipgloss.Style
+	Dim           lipgloss.Style
+	Error         lipgloss.Style
+	Success       lipgloss.Style
+	StatusBar     lipgloss.Style
+	Dialog        lipgloss.Style
+	Help          lipgloss.Style
+	Spinner       lipgloss.Style
+	Hover         lipgloss.Style // Hovered list rows
+	ButtonHover   lipgloss.Style // Hovered status bar buttons
+
+	// Status indicator styles
+	StatusPending    lipgloss.Style
+	StatusProcessing lipgloss.Style
+	StatusCompleted  lipgloss.Style
+	StatusFailed     lipgloss.Style
+
+	// Issue line styles
+	IssueID         lipgloss.Style
+	IssueTree       lipgloss.Style
+	NewBead         lipgloss.Style // Newly created beads
+	NewBeadSelected lipgloss.Style
+	NewBeadHover    lipgloss.Style
+	SearchMatch     lipgloss.Style // Characters a search matched
+	// Type indicator styles
+	TypeTask    lipgloss.Style
+	TypeBug     lipgloss.Style
+	TypeFeature lipgloss.Style
+	TypeEpic    lipgloss.Style
+	TypeChore   lipgloss.Style
+	TypeDefault lipgloss.Style
+
+	// Diff viewer line styles
+	DiffAdded   lipgloss.Style
+	DiffRemoved lipgloss.Style
+	DiffHunk    lipgloss.Style
+
+	// Work tabs bar
+	TabRibbon   lipgloss.Style
+	TabActive   lipgloss.Style
+	TabInactive lipgloss.Style
+
+	// Icons are the glyphs statuses are marked with
+	Icons IconSet
+}
+
+// palette is the set of colors a built-in theme is generated from
+type palette struct {
+	accent, title, border, dialogBorder    lipgloss.TerminalColor
+	text, label, dim, faint                lipgloss.TerminalColor
+	success, err, warning, info, task      lipgloss.TerminalColor
+	highlight, epic, newBead               lipgloss.TerminalColor
+	selectedFg, selectedBg                 lipgloss.TerminalColor
+	statusBarBg, dialogBg                  lipgloss.TerminalColor
+	hoverFg, hoverBg, buttonHoverFg        lipgloss.TerminalColor
+	matchFg, matchBg, matchHoverBg         lipgloss.TerminalColor
+	tabBarBg, ribbonFg, ribbonBg           lipgloss.TerminalColor
+	tabFg, tabBg, activeTabFg, activeTabBg lipgloss.TerminalColor
+}
+
+var darkPalette = palette{
+	accent: lipgloss.Color("214"), title: lipgloss.Color("205"), border: lipgloss.Color("62"), dialogBorder: lipgloss.Color("99"),
+	text: lipgloss.Color("255"), label: lipgloss.Color("247"), dim: lipgloss.Color("241"), faint: lipgloss.Color("240"),
+	success: lipgloss.Color("42"), err: lipgloss.Color("196"), warning: lipgloss.Color("226"), info: lipgloss.Color("81"), task: lipgloss.Color("75"),
+	highlight: lipgloss.Color("141"), epic: lipgloss.Color("213"), newBead: lipgloss.Color("#FFFF00"),
+	selectedFg: lipgloss.Color("255"), selectedBg: lipgloss.Color("62"),
+	statusBarBg: lipgloss.Color("236"), dialogBg: lipgloss.Color("235"),
+	hoverFg: lipgloss.Color("255"), hoverBg: lipgloss.Color("240"), buttonHoverFg: lipgloss.Color("0"),
+	matchFg: lipgloss.Color("0"), matchBg: lipgloss.Color("226"), matchHoverBg: lipgloss.Color("228"),
+	tabBarBg: lipgloss.Color("235"), ribbonFg: lipgloss.Color("15"), ribbonBg: lipgloss.Color("29"),
+	tabFg: lipgloss.Color("255"), tabBg: lipgloss.Color("240"), activeTabFg: lipgloss.Color("232"), activeTabBg: lipgloss.Color("214"),
+}
+
+var lightPalette = palette{
+	accent: lipgloss.Color("166"), title: lipgloss.Color("162"), border: lipgloss.Color("25"), dialogBorder: lipgloss.Color("91"),
+	text: lipgloss.Color("235"), label: lipgloss.Color("240"), dim: lipgloss.Color("244"), faint: lipgloss.Color("246"),
+	success: lipgloss.Color("28"), err: lipgloss.Color("160"), warning: lipgloss.Color("136"), info: lipgloss.Color("31"), task: lipgloss.Color("26"),
+	highlight: lipgloss.Color("91"), epic: lipgloss.Color("127"), newBead: lipgloss.Color("130"),
+	selectedFg: lipgloss.Color("255"), selectedBg: lipgloss.Color("25"),
+	statusBarBg: lipgloss.Color("254"), dialogBg: lipgloss.Color("255"),
+	hoverFg: lipgloss.Color("235"), hoverBg: lipgloss.Color("252"), buttonHoverFg: lipgloss.Color("255"),
+	matchFg: lipgloss.Color("0"), matchBg: lipgloss.Color("222"), matchHoverBg: lipgloss.Color("229"),
+	tabBarBg: lipgloss.Color("253"), ribbonFg: lipgloss.Color("255"), ribbonBg: lipgloss.Color("30"),
+	tabFg: lipgloss.Color("235"), tabBg: lipgloss.Color("250"), activeTabFg: lipgloss.Color("255"), activeTabBg: lipgloss.Color("166"),
+}
+
+// newTheme builds a theme from a palette
+func newTheme(name string, p palette) *Theme {
+	return &Theme{
+		Name:           name,
+		AccentColor:    p.accent,
+		SuccessColor:   p.success,
+		ErrorColor:     p.err,
+		WarningColor:   p.warning,
+		InfoColor:      p.info,
+		HighlightColor: p.highlight,
+		MutedColor:     p.label,
+		FaintColor:     p.faint,
+		TabBarBg:       p.tabBarBg,
+
+		Title:  lipgloss.NewStyle().Bold(true).Foreground(p.title),
+		Hotkey: lipgloss.NewStyle().Bold(true).Foreground(p.accent),
+		Panel: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(p.border).
+			Padding(0, 1),
+		Selected:      lipgloss.NewStyle().Bold(true).Foreground(p.selectedFg).Background(p.selectedBg),
+		SelectedCheck: lipgloss.NewStyle().Foreground(p.success),
+		Label:         lipgloss.NewStyle().Foreground(p.label),
+		Value:         lipgloss.NewStyle().Foreground(p.text),
+		Dim:           lipgloss.NewStyle().Foreground(p.dim),
+		Error:         lipgloss.NewStyle().Foreground(p.err),
+		Success:       lipgloss.NewStyle().Foreground(p.success),
+		StatusBar:     lipgloss.NewStyle().Background(p.statusBarBg).Padding(0, 1),
+		Dialog: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(p.dialogBorder).
+			Padding(1, 2).
+			Background(p.dialogBg),
+		Help:        lipgloss.NewStyle().Padding(2, 4).Background(p.dialogBg),
+		Spinner:     lipgloss.NewStyle().Foreground(p.accent),
+		Hover:       lipgloss.NewStyle().Bold(true).Foreground(p.hoverFg).Background(p.hoverBg),
+		ButtonHover: lipgloss.NewStyle().Bold(true).Foreground(p.buttonHoverFg).Background(p.accent),
+
+		StatusPending:    lipgloss.NewStyle().Foreground(p.dim),
+		StatusProcessing: lipgloss.NewStyle().Bold(true).Foreground(p.accent),
+		StatusCompleted:  lipgloss.NewStyle().Bold(true).Foreground(p.success),
+		StatusFailed:     lipgloss.NewStyle().Bold(true).Foreground(p.err),
+
+		IssueID:         lipgloss.NewStyle().Foreground(p.accent),
+		IssueTree:       lipgloss.NewStyle().Foreground(p.dim),
+		NewBead:         lipgloss.NewStyle().Bold(true).Foreground(p.newBead),
+		NewBeadSelected: lipgloss.NewStyle().Bold(true).Foreground(p.matchFg).Background(p.matchBg),
+		NewBeadHover:    lipgloss.NewStyle().Bold(true).Foreground(p.matchFg).Background(p.matchHoverBg),
+		SearchMatch:     lipgloss.NewStyle().Bold(true).Underline(true).Foreground(p.highlight),
+
+		TypeTask:    lipgloss.NewStyle().Foreground(p.task),
+		TypeBug:     lipgloss.NewStyle().Foreground(p.err),
+		TypeFeature: lipgloss.NewStyle().Foreground(p.success),
+		TypeEpic:    lipgloss.NewStyle().Bold(true).Foreground(p.epic),
+		TypeChore:   lipgloss.NewStyle().Foreground(p.label),
+		TypeDefault: lipgloss.NewStyle().Foreground(p.label),
+
+		DiffAdded:   lipgloss.NewStyle().Foreground(p.success),
+		DiffRemoved: lipgloss.NewStyle().Foreground(p.err),
+		DiffHunk:    lipgloss.NewStyle().Foreground(p.info),
+
+		TabRibbon:   lipgloss.NewStyle().Bold(true).Foreground(p.ribbonFg).Background(p.ribbonBg),
+		TabActive:   lipgloss.NewStyle().Foreground(p.activeTabFg).Background(p.activeTabBg),
+		TabInactive: lipgloss.NewStyle().Foreground(p.tabFg).Background(p.tabBg),
+
+		Icons: UnicodeIcons(),
+	}
+}
+
+// DarkTheme returns the theme for dark terminal backgrounds.
+func DarkTheme() *Theme {
+	return newTheme(ThemeDark, darkPalette)
+}
+
+// LightTheme returns the theme for light terminal backgrounds.
+func LightTheme() *Theme {
+	return newTheme(ThemeLight, lightPalette)
+}
+
+// MonoTheme returns a theme without any colors. Selection and focus are shown
+// with reverse video, bold, and underline instead.
+func MonoTheme() *Theme {
+	none := lipgloss.NoColor{}
+	t := newTheme(ThemeMono, palette{
+		accent: none, title: none, border: none, dialogBorder: none,
+		text: none, label: none, dim: none, faint: none,
+		success: none, err: none, warning: none, info: none, task: none,
+		highlight: none, epic: none, newBead: none,
+		selectedFg: none, selectedBg: none,
+		statusBarBg: none, dialogBg: none,
+		hoverFg: none, hoverBg: none, buttonHoverFg: none,
+		matchFg: none, matchBg: none, matchHoverBg: none,
+		tabBarBg: none, ribbonFg: none, ribbonBg: none,
+		tabFg: none, tabBg: none, activeTabFg: none, activeTabBg: none,
+	})
+	t.Selected = t.Selected.Reverse(true)
+	t.NewBeadSelected = t.NewBeadSelected.Reverse(true)
+	t.Hover = t.Hover.Underline(true)
+	t.NewBeadHover = t.NewBeadHover.Underline(true)
+	t.ButtonHover = t.ButtonHover.Reverse(true)
+	t.TabActive = t.TabActive.Reverse(true)
+	t.Error = t.Error.Bold(true)
+	return t
+}
+
+// builtinThemes maps theme names to their constructors
+var builtinThemes = map[string]func() *Theme{
+	ThemeDark:  DarkTheme,
+	ThemeLight: LightTheme,
+	ThemeMono:  MonoTheme,
+}
+
+// ThemeNames returns the accepted theme names, including "auto".
+func ThemeNames() []string {
+	names := []string{ThemeAuto}
+	for name := range builtinThemes {
+		names = append(names, name)
+	}
+	sort.Strings(names[1:])
+	return names
+}
+
+// ResolveTheme returns the theme with the given name. An empty name or "auto"
+// picks mono when NO_COLOR is set, and otherwise light or dark based on the
+// terminal's background color.
+func ResolveTheme(name string) (*Theme, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" || name == ThemeAuto {
+		switch {
+		case os.Getenv("NO_COLOR") != "":
+			return MonoTheme(), nil
+		case lipgloss.HasDarkBackground():
+			return DarkTheme(), nil
+		default:
+			return LightTheme(), nil
+		}
+	}
+	if newFn, ok := builtinThemes[name]; ok {
+		return newFn(), nil
+	}
+	return nil, fmt.Errorf("unknown theme %q (valid: %s)", name, strings.Join(ThemeNames(), ", "))
+}
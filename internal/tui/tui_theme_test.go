@@ -0,0 +1,53 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/stretchr/testify/require"
+)
+
+// withColorProfile forces lipgloss to emit colors for the duration of a test
+func withColorProfile(t *testing.T, profile termenv.Profile) {
+	t.Helper()
+	prev := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(profile)
+	t.Cleanup(func() { lipgloss.SetColorProfile(prev) })
+}
+
+func TestResolveTheme(t *testing.T) {
+	for _, name := range []string{"dark", "light", "mono", " Dark "} {
+		theme, err := ResolveTheme(name)
+		require.NoError(t, err)
+		require.NotNil(t, theme)
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	theme, err := ResolveTheme("auto")
+	require.NoError(t, err)
+	require.Equal(t, ThemeMono, theme.Name, "NO_COLOR selects the mono theme")
+
+	_, err = ResolveTheme("solarized")
+	require.ErrorContains(t, err, "unknown theme")
+}
+
+func TestThemesRenderPanels(t *testing.T) {
+	withColorProfile(t, termenv.ANSI256)
+
+	render := func(theme *Theme) string {
+		bar := NewStatusBar(theme)
+		bar.SetSize(120)
+		bar.SetStatus("Saved", false)
+		return bar.Render() + theme.statusIcon("failed") + theme.styleHotkeys("[n]ew")
+	}
+
+	dark := render(DarkTheme())
+	light := render(LightTheme())
+	mono := render(MonoTheme())
+
+	require.Contains(t, dark, "38;5", "dark theme uses colors")
+	require.NotEqual(t, dark, light, "light theme uses its own palette")
+	require.NotContains(t, mono, "38;5", "mono theme sets no foreground colors")
+	require.NotContains(t, mono, "48;5", "mono theme sets no background colors")
+}
@@ -0,0 +1,283 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/progress"
+)
+
+// timelineTickSteps are the ruler intervals the timeline picks from, the
+// smallest that keeps ticks timelineMinTickGap columns apart
+var timelineTickSteps = []time.Duration{
+	time.Second, 5 * time.Second, 10 * time.Second, 30 * time.Second,
+	time.Minute, 5 * time.Minute, 10 * time.Minute, 15 * time.Minute, 30 * time.Minute,
+	time.Hour, 2 * time.Hour, 6 * time.Hour, 12 * time.Hour, 24 * time.Hour,
+}
+
+// timelineMinTickGap is the fewest columns between two ruler ticks, enough
+// for a label like "+1h30m"
+const timelineMinTickGap = 10
+
+// timelineBar is a task's bar on the timeline, in columns from the chart's
+// left edge
+type timelineBar struct {
+	task     *db.Task
+	from, to int // First and last column covered; both -1 when the task hasn't started
+	running  bool
+}
+
+// timelineTick is a mark on the timeline's ruler
+type timelineTick struct {
+	col    int
+	offset time.Duration // From the chart's origin
+}
+
+// layoutTimeline places a bar for each task on a chart width columns wide
+// that starts at origin and ends when the last task did, or now for tasks
+// still processing. A task gets at least one column however short it ran.
+// It also returns the time the chart spans.
+func layoutTimeline(tasks []*db.Task, origin, now time.Time, width int) ([]timelineBar, time.Duration) {
+	width = max(width, 1)
+	ends := make([]time.Time, len(tasks))
+	var span time.Duration
+	for i, task := range tasks {
+		if task.StartedAt == nil {
+			continue
+		}
+		end := *task.StartedAt
+		switch {
+		case task.CompletedAt != nil:
+			end = *task.CompletedAt
+		case task.Status == db.StatusProcessing:
+			end = now
+		}
+		if end.Before(*task.StartedAt) {
+			end = *task.StartedAt
+		}
+		ends[i] = end
+		span = max(span, end.Sub(origin))
+	}
+	if span <= 0 {
+		span = time.Second
+	}
+
+	col := func(t time.Time) int {
+		c := int(int64(t.Sub(origin)) * int64(width-1) / int64(span))
+		return min(max(c, 0), width-1)
+	}
+	bars := make([]timelineBar, len(tasks))
+	for i, task := range tasks {
+		bars[i] = timelineBar{task: task, from: -1, to: -1}
+		if task.StartedAt == nil {
+			continue
+		}
+		bars[i].from = col(*task.StartedAt)
+		bars[i].to = max(col(ends[i]), bars[i].from)
+		bars[i].running = task.Status == db.StatusProcessing && task.CompletedAt == nil
+	}
+	return bars, span
+}
+
+// timelineTicks places ruler ticks on a chart width columns wide spanning
+// span from origin. With wallClock, ticks fall on round clock times (15:10,
+// 15:20) rather than round offsets from origin.
+func timelineTicks(origin time.Time, span time.Duration, width int, wallClock bool) ([]timelineTick, time.Duration) {
+	if width < 2 || span <= 0 {
+		return nil, 0
+	}
+	step := timelineTickSteps[len(timelineTickSteps)-1]
+	for _, s := range timelineTickSteps {
+		if int64(s)*int64(width-1)/int64(span) >= timelineMinTickGap {
+			step = s
+			break
+		}
+	}
+
+	var first time.Duration
+	if wallClock {
+		first = origin.Truncate(step).Sub(origin)
+		if first < 0 {
+			first += step
+		}
+	}
+	var ticks []timelineTick
+	for offset := first; offset <= span; offset += step {
+		ticks = append(ticks, timelineTick{
+			col:    int(int64(offset) * int64(width-1) / int64(span)),
+			offset: offset,
+		})
+	}
+	return ticks, step
+}
+
+// timelineView is the overlay charting when each task of a work ran. It's
+// rendered from the work's latest progress, so running bars keep growing.
+type timelineView struct {
+	theme     *Theme
+	workID    string
+	wallClock bool // Ruler in clock times; otherwise time since the work started
+	now       func() time.Time
+}
+
+// newTimelineView creates the timeline of a work's tasks
+func newTimelineView(theme *Theme, workID string) *timelineView {
+	return &timelineView{theme: theme, workID: workID, wallClock: true, now: time.Now}
+}
+
+// Update handles a key press and reports whether the timeline should be
+// closed
+func (v *timelineView) Update(msg tea.KeyMsg) bool {
+	switch msg.String() {
+	case "esc", "q", "V":
+		return true
+	case "a", "tab":
+		v.wallClock = !v.wallClock
+	}
+	return false
+}
+
+// origin returns when the chart starts: the first task start on the wall
+// clock, or when the work started otherwise
+func (v *timelineView) origin(work *progress.WorkProgress) (time.Time, bool) {
+	var first time.Time
+	for _, tp := range work.Tasks {
+		if started := tp.Task.StartedAt; started != nil && (first.IsZero() || started.Before(first)) {
+			first = *started
+		}
+	}
+	if first.IsZero() {
+		return first, false
+	}
+	if !v.wallClock && work.Work.StartedAt != nil && work.Work.StartedAt.Before(first) {
+		return *work.Work.StartedAt, true
+	}
+	return first, true
+}
+
+// tickLabel renders the ruler label of a tick
+func (v *timelineView) tickLabel(origin time.Time, tick timelineTick, step time.Duration) string {
+	if v.wallClock {
+		at := origin.Add(tick.offset).Local()
+		if step < time.Minute {
+			return at.Format("15:04:05")
+		}
+		return at.Format("15:04")
+	}
+	if tick.offset == 0 {
+		return "0"
+	}
+	// Drop the zero units a round step leaves: 10m0s is +10m, 1h0m0s is +1h
+	label := tick.offset.String()
+	if strings.HasSuffix(label, "m0s") {
+		label = strings.TrimSuffix(label, "0s")
+	}
+	if strings.HasSuffix(label, "h0m") {
+		label = strings.TrimSuffix(label, "0m")
+	}
+	return "+" + label
+}
+
+// barStyle colors a task's bar by its status
+func (v *timelineView) barStyle(status string) lipgloss.Style {
+	switch status {
+	case db.StatusCompleted:
+		return v.theme.StatusCompleted
+	case db.StatusFailed:
+		return v.theme.StatusFailed
+	case db.StatusProcessing:
+		return v.theme.StatusProcessing
+	}
+	return v.theme.StatusPending
+}
+
+func (v *timelineView) render(work *progress.WorkProgress, width, height int) string {
+	var b strings.Builder
+	mode := "wall clock"
+	if !v.wallClock {
+		mode = "since the work started"
+	}
+	b.WriteString(v.theme.Title.Render("Timeline for "+v.workID) + "  " + v.theme.Dim.Render(mode) + "\n\n")
+
+	if work == nil {
+		b.WriteString("  " + v.theme.Dim.Render("The work is gone") + "\n\n")
+		b.WriteString(v.theme.styleHotkeys("  [Esc] Close"))
+		return v.theme.Dialog.Render(b.String())
+	}
+	origin, ok := v.origin(work)
+	if !ok {
+		b.WriteString("  " + v.theme.Dim.Render("No task has started yet") + "\n\n")
+		b.WriteString(v.theme.styleHotkeys("  [Esc] Close"))
+		return v.theme.Dialog.Render(b.String())
+	}
+
+	tasks := make([]*db.Task, len(work.Tasks))
+	labelWidth := 0
+	for i, tp := range work.Tasks {
+		tasks[i] = tp.Task
+		labelWidth = max(labelWidth, ansi.StringWidth(tp.Task.ID))
+	}
+	const durationWidth = 10
+	// Dialog border and padding, the label with its icon and the duration
+	chartWidth := max(width-6-(labelWidth+4)-durationWidth, 10)
+	now := v.now()
+	bars, span := layoutTimeline(tasks, origin, now, chartWidth)
+	indent := strings.Repeat(" ", labelWidth+4)
+
+	// Ruler: labels above their tick marks, skipping labels that would overlap
+	ticks, step := timelineTicks(origin, span, chartWidth, v.wallClock)
+	labels := []rune(strings.Repeat(" ", chartWidth))
+	marks := []rune(strings.Repeat("─", chartWidth))
+	nextFree := 0
+	for _, tick := range ticks {
+		marks[tick.col] = '┬'
+		label := []rune(v.tickLabel(origin, tick, step))
+		if tick.col < nextFree || tick.col+len(label) > chartWidth {
+			continue
+		}
+		copy(labels[tick.col:], label)
+		nextFree = tick.col + len(label) + 1
+	}
+	b.WriteString(indent + v.theme.Dim.Render(string(labels)) + "\n")
+	b.WriteString(indent + v.theme.Dim.Render(string(marks)) + "\n")
+
+	// Leave room for the title, ruler and hotkeys
+	shown := bars[:min(len(bars), max(height-8, 1))]
+	for _, bar := range shown {
+		line := fmt.Sprintf("  %s %-*s ", v.theme.statusIcon(bar.task.Status), labelWidth, bar.task.ID)
+		if bar.from < 0 {
+			b.WriteString(line + v.theme.Dim.Render("not started") + "\n")
+			continue
+		}
+		glyphs := strings.Repeat("█", bar.to-bar.from+1)
+		if bar.running {
+			glyphs = strings.Repeat("█", bar.to-bar.from) + "▶"
+		}
+		line += strings.Repeat(" ", bar.from) + v.barStyle(bar.task.Status).Render(glyphs)
+		line += strings.Repeat(" ", chartWidth-bar.to-1)
+
+		end := now
+		if bar.task.CompletedAt != nil {
+			end = *bar.task.CompletedAt
+		}
+		if took := formatBeadTime(end.Sub(*bar.task.StartedAt)); took != "" {
+			line += " " + v.theme.Dim.Render(took)
+		}
+		b.WriteString(line + "\n")
+	}
+	if hidden := len(bars) - len(shown); hidden > 0 {
+		b.WriteString(v.theme.Dim.Render(fmt.Sprintf("  ... and %d more tasks", hidden)) + "\n")
+	}
+
+	toggle := "[a] Since work start"
+	if !v.wallClock {
+		toggle = "[a] Wall clock"
+	}
+	b.WriteString("\n" + v.theme.styleHotkeys("  "+toggle+"  [Esc] Close"))
+	return v.theme.Dialog.Render(b.String())
+}
@@ -0,0 +1,119 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/progress"
+	"github.com/stretchr/testify/require"
+)
+
+func timelineTask(id, status string, start time.Time, startMin, endMin int) *db.Task {
+	task := &db.Task{ID: id, Status: status}
+	if startMin >= 0 {
+		started := start.Add(time.Duration(startMin) * time.Minute)
+		task.StartedAt = &started
+	}
+	if endMin >= 0 {
+		completed := start.Add(time.Duration(endMin) * time.Minute)
+		task.CompletedAt = &completed
+	}
+	return task
+}
+
+func TestLayoutTimeline(t *testing.T) {
+	origin := time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC)
+	tasks := []*db.Task{
+		timelineTask("w.1", db.StatusCompleted, origin, 0, 10),
+		timelineTask("w.2", db.StatusFailed, origin, 10, 10), // Failed at once
+		timelineTask("w.3", db.StatusProcessing, origin, 15, -1),
+		timelineTask("w.4", db.StatusPending, origin, -1, -1),
+	}
+	now := origin.Add(20 * time.Minute)
+
+	// 21 columns: one per minute
+	bars, span := layoutTimeline(tasks, origin, now, 21)
+	require.Equal(t, 20*time.Minute, span, "processing tasks run until now")
+	require.Equal(t, [2]int{0, 10}, [2]int{bars[0].from, bars[0].to})
+	require.Equal(t, [2]int{10, 10}, [2]int{bars[1].from, bars[1].to}, "an instant task still gets a column")
+	require.Equal(t, [2]int{15, 20}, [2]int{bars[2].from, bars[2].to})
+	require.True(t, bars[2].running)
+	require.Equal(t, [2]int{-1, -1}, [2]int{bars[3].from, bars[3].to}, "unstarted tasks get no bar")
+
+	// Narrower charts scale down and stay within the width
+	bars, _ = layoutTimeline(tasks, origin, now, 5)
+	for _, bar := range bars[:3] {
+		require.GreaterOrEqual(t, bar.from, 0)
+		require.LessOrEqual(t, bar.to, 4)
+		require.LessOrEqual(t, bar.from, bar.to)
+	}
+	require.Equal(t, 4, bars[2].to)
+
+	// Nothing has run yet, or everything ran in an instant
+	bars, span = layoutTimeline([]*db.Task{timelineTask("w.1", db.StatusCompleted, origin, 0, 0)}, origin, now, 1)
+	require.Equal(t, time.Second, span)
+	require.Equal(t, 0, bars[0].to)
+}
+
+func TestTimelineTicks(t *testing.T) {
+	origin := time.Date(2026, 3, 1, 15, 3, 20, 0, time.UTC)
+
+	// 41 columns over 40 minutes: a tick every 10 minutes, 10 columns apart
+	ticks, step := timelineTicks(origin, 40*time.Minute, 41, false)
+	require.Equal(t, 10*time.Minute, step)
+	require.Len(t, ticks, 5)
+	require.Equal(t, 0, ticks[0].col)
+	require.Equal(t, 10, ticks[1].col)
+	require.Equal(t, 40, ticks[4].col)
+
+	// On the wall clock ticks fall on round times: 15:10, 15:20, 15:30, 15:40
+	ticks, _ = timelineTicks(origin, 40*time.Minute, 41, true)
+	require.Len(t, ticks, 4)
+	for _, tick := range ticks {
+		at := origin.Add(tick.offset)
+		require.Zero(t, at.Minute()%10)
+		require.Zero(t, at.Second())
+	}
+	require.Equal(t, 6, ticks[0].col)
+
+	// Long spans on narrow charts use the widest step
+	_, step = timelineTicks(origin, 72*time.Hour, 20, false)
+	require.Equal(t, 24*time.Hour, step)
+	ticks, _ = timelineTicks(origin, time.Minute, 1, false)
+	require.Empty(t, ticks)
+}
+
+func TestTimelineViewRender(t *testing.T) {
+	start := time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC)
+	work := &progress.WorkProgress{
+		Work: &db.Work{ID: "w-abc", StartedAt: &start},
+		Tasks: []*progress.TaskProgress{
+			{Task: timelineTask("w-abc.1", db.StatusCompleted, start, 5, 35)},
+			{Task: timelineTask("w-abc.2", db.StatusProcessing, start, 35, -1)},
+			{Task: timelineTask("w-abc.3", db.StatusPending, start, -1, -1)},
+		},
+	}
+	v := newTimelineView(DarkTheme(), "w-abc")
+	v.now = func() time.Time { return start.Add(65 * time.Minute) }
+
+	out := ansi.Strip(v.render(work, 100, 30))
+	require.Contains(t, out, "wall clock")
+	require.Contains(t, out, "30m")
+	require.Contains(t, out, "▶", "running tasks end in an arrow")
+	require.Contains(t, out, "not started")
+	for _, line := range strings.Split(out, "\n") {
+		require.LessOrEqual(t, ansi.StringWidth(line), 100)
+	}
+
+	// a measures from the work's start instead, with offsets on the ruler
+	require.False(t, v.Update(keyMsgFor("a")))
+	out = ansi.Strip(v.render(work, 100, 30))
+	require.Contains(t, out, "since the work started")
+	require.Contains(t, out, "+10m")
+	require.True(t, v.Update(keyMsgFor("esc")))
+
+	require.Contains(t, ansi.Strip(v.render(&progress.WorkProgress{Work: work.Work}, 100, 30)), "No task has started yet")
+}
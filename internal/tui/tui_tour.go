@@ -0,0 +1,174 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// tourPage is one page of the first-run tour
+type tourPage struct {
+	title string
+	lines []string
+	keys  [][2]string // Key bindings listed under the text: key, what it does
+}
+
+// tourPages is the first-run tour, in order. The last page ends with the
+// prompt to create a first issue.
+var tourPages = []tourPage{
+	{
+		title: "Issues (beads)",
+		lines: []string{
+			"Everything starts as an issue, tracked with beads (bd) in the repository.",
+			"Issues can block each other and nest under epics; ready issues have",
+			"nothing open blocking them.",
+		},
+	},
+	{
+		title: "Works",
+		lines: []string{
+			"A work is a unit of delivery: a branch and a git worktree of its own,",
+			"holding the issues that go into one pull request. Each work gets an",
+			"orchestrator that runs its tasks in the background.",
+		},
+	},
+	{
+		title: "Tasks",
+		lines: []string{
+			"Running a work groups its issues into tasks. Each task is a Claude",
+			"session in the work's worktree that implements, reviews or opens the PR.",
+			"Tasks go pending → processing → completed (or failed, to be reset).",
+		},
+	},
+	{
+		title: "The screen",
+		lines: []string{
+			"The tabs along the top are your works, numbered 1-9. Below them the",
+			"issues list sits on the left with the selected issue's details on the",
+			"right. Selecting a work zooms in on its tasks, and the status bar at",
+			"the bottom shows the keys for wherever you are.",
+		},
+	},
+	{
+		title: "Keys to know",
+		keys: [][2]string{
+			{"n", "Create an issue"},
+			{"w", "Create a work from the selected issue"},
+			{"1-9", "Select a work; r then runs it"},
+			{"tab", "Switch between the work and issues panels"},
+			{"?", "Help, with every key"},
+		},
+	},
+	{
+		title: "Ready?",
+		lines: []string{
+			"Create your first issue to get going. The tour can be replayed with",
+			"co tui --tour.",
+		},
+	},
+}
+
+// tourDialog is the first-run tour overlay
+type tourDialog struct {
+	theme *Theme
+	pages []tourPage
+	page  int
+}
+
+// newTourDialog opens the tour on its first page
+func newTourDialog(theme *Theme) *tourDialog {
+	return &tourDialog{theme: theme, pages: tourPages}
+}
+
+// tourOutcome is what a key press in the tour asks for
+type tourOutcome int
+
+const (
+	tourStay        tourOutcome = iota // Still touring
+	tourClose                          // Dismissed
+	tourCreate                         // Finished: create the first issue
+	tourPassThrough                    // Any other key ends the tour and runs as usual
+)
+
+// Update handles a key press
+func (d *tourDialog) Update(msg tea.KeyMsg) tourOutcome {
+	last := d.page == len(d.pages)-1
+	switch msg.String() {
+	case "esc", "q":
+		return tourClose
+	case "right", "pgdown", " ":
+		if !last {
+			d.page++
+		}
+	case "left", "pgup":
+		if d.page > 0 {
+			d.page--
+		}
+	case "enter":
+		if last {
+			return tourCreate
+		}
+		d.page++
+	default:
+		return tourPassThrough
+	}
+	return tourStay
+}
+
+// render returns the tour content sized to fit width
+func (d *tourDialog) render(width int) string {
+	frameW, _ := d.theme.Dialog.GetFrameSize()
+	innerWidth := min(max(width-frameW, 30), 76)
+	page := d.pages[d.page]
+
+	lines := []string{
+		d.theme.Dim.Render(fmt.Sprintf("Welcome to co  ·  %d/%d", d.page+1, len(d.pages))),
+		d.theme.Title.Render(page.title),
+		"",
+	}
+	for _, line := range page.lines {
+		lines = append(lines, ansi.Truncate(line, innerWidth, "…"))
+	}
+	for _, key := range page.keys {
+		lines = append(lines, ansi.Truncate(fmt.Sprintf("  %s  %s", d.theme.Hotkey.Render(fmt.Sprintf("%-4s", key[0])), key[1]), innerWidth, "…"))
+	}
+
+	// Page dots show where in the tour this is
+	dots := make([]string, len(d.pages))
+	for i := range dots {
+		dots[i] = d.theme.Icons.Pending
+		if i == d.page {
+			dots[i] = d.theme.Icons.Processing
+		}
+	}
+	lines = append(lines, "", d.theme.Dim.Render(strings.Join(dots, " ")))
+
+	hotkeys := "[→/Enter] Next  [←] Back  [Esc] Skip the tour"
+	if d.page == len(d.pages)-1 {
+		hotkeys = "[Enter] Create my first issue  [←] Back  [Esc] Close"
+	}
+	lines = append(lines, ansi.Truncate(d.theme.styleHotkeys(hotkeys), innerWidth, "…"))
+	return d.theme.Dialog.Width(innerWidth + d.theme.Dialog.GetHorizontalPadding()).Render(strings.Join(lines, "\n"))
+}
+
+// maybeOpenTour opens the tour once the works have loaded, when it was asked
+// for or this is the first run in a project without works. It is offered
+// only once per session.
+func (m *planModel) maybeOpenTour() {
+	open := m.startTour || (m.offerTour && len(m.workTiles) == 0)
+	m.startTour, m.offerTour = false, false
+	if open && m.viewMode == ViewNormal {
+		m.tour = newTourDialog(m.theme)
+		m.viewMode = ViewTour
+	}
+}
+
+// closeTour dismisses the tour. Writing the state file keeps it from being
+// offered again.
+func (m *planModel) closeTour() {
+	m.tour = nil
+	m.viewMode = ViewNormal
+	m.saveState()
+}
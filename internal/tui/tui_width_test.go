@@ -0,0 +1,97 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/progress"
+	"github.com/stretchr/testify/require"
+)
+
+// wideTexts are titles and descriptions whose byte length, rune count and
+// display width all differ
+var wideTexts = map[string]string{
+	"emoji":     "🚀 Launch the rocket 🎉🎉🎉 and celebrate with the whole 👩‍👩‍👧 family before lunch",
+	"cjk":       "修复登录页面在移动设备上的布局问题并为所有边界情况添加单元测试覆盖率报告",
+	"combining": "Café résumé naïve façade déjà vu with combining marks everywhere in the title",
+}
+
+// requireLinesFit fails if any line of rendered is wider than width cells
+func requireLinesFit(t *testing.T, rendered string, width int) {
+	t.Helper()
+	for _, line := range strings.Split(rendered, "\n") {
+		require.LessOrEqual(t, ansi.StringWidth(line), width, "line too wide: %q", line)
+	}
+}
+
+func TestWrapTextWidth(t *testing.T) {
+	for name, text := range wideTexts {
+		t.Run(name, func(t *testing.T) {
+			for _, width := range []int{5, 12, 30} {
+				wrapped := wrapText(text, width)
+				requireLinesFit(t, wrapped, width)
+				// Nothing is dropped, only spaces turned into line breaks
+				require.Equal(t, strings.ReplaceAll(text, " ", ""), strings.NewReplacer("\n", "", " ", "").Replace(wrapped))
+			}
+		})
+	}
+}
+
+func TestIssuesPanelWideTitlesFit(t *testing.T) {
+	for name, text := range wideTexts {
+		t.Run(name, func(t *testing.T) {
+			for _, expanded := range []bool{false, true} {
+				p := NewIssuesPanel(DarkTheme())
+				p.SetSize(40, 10)
+				item := testBeadItem("bead-1", text, "open", 2, "task")
+				item.treeDepth = 1
+				item.treePrefixPattern = "├─ "
+				p.SetData([]beadItem{item}, 0, beadFilters{}, expanded, map[string]bool{}, map[string]bool{}, map[string]time.Time{})
+				requireLinesFit(t, p.renderBeadLine(0, item), 40-4)
+			}
+		})
+	}
+}
+
+func TestIssueDetailsPanelWideTextFits(t *testing.T) {
+	for name, text := range wideTexts {
+		t.Run(name, func(t *testing.T) {
+			p := NewIssueDetailsPanel(DarkTheme())
+			p.SetSize(32, 40)
+			item := testBeadItem("bead-1", text, "open", 2, "task")
+			item.Description = text + "\n\n" + text
+			p.SetData(&item, false, map[string]*beadItem{})
+			requireLinesFit(t, p.renderFullIssueContent(), 32-2)
+		})
+	}
+}
+
+func TestWorkPanelsWideTextFits(t *testing.T) {
+	for name, text := range wideTexts {
+		t.Run(name, func(t *testing.T) {
+			bead := progress.BeadProgress{ID: "bead-1", Title: text, Description: text, Status: db.StatusPending}
+			wp := &progress.WorkProgress{
+				Work: &db.Work{ID: "w-abc", Name: text, BranchName: "feat/" + text, RootIssueID: "bead-1", Status: db.StatusProcessing},
+				Tasks: []*progress.TaskProgress{
+					{Task: &db.Task{ID: "w-abc.1", TaskType: "implement", Status: db.StatusProcessing}, Beads: []progress.BeadProgress{bead}},
+				},
+				WorkBeads:       []progress.BeadProgress{bead},
+				UnassignedBeads: []progress.BeadProgress{{ID: "bead-2", Title: text}},
+			}
+			const width = 36
+
+			overview := NewWorkOverviewPanel(DarkTheme())
+			overview.SetSize(width, 30)
+			overview.SetFocusedWork(wp)
+			requireLinesFit(t, overview.Render(30, width), width-2)
+
+			summary := NewWorkSummaryPanel(DarkTheme())
+			summary.SetSize(width, 60)
+			summary.SetFocusedWork(wp)
+			requireLinesFit(t, summary.renderFullContent(width), width-2)
+		})
+	}
+}
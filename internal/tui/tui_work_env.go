@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/project"
+)
+
+// workEnvEditor is the dialog for editing a work's environment overrides, one
+// KEY=value per line
+type workEnvEditor struct {
+	theme    *Theme
+	workID   string
+	textarea textarea.Model
+	err      string
+}
+
+// newWorkEnvEditor creates an env editor pre-filled with the work's overrides
+func newWorkEnvEditor(theme *Theme, workID string, env []string) *workEnvEditor {
+	ta := textarea.New()
+	ta.Placeholder = "DATABASE_URL=postgres://localhost/feature_db"
+	ta.CharLimit = 4000
+	ta.ShowLineNumbers = false
+	ta.SetValue(strings.Join(env, "\n"))
+	ta.Focus()
+	return &workEnvEditor{theme: theme, workID: workID, textarea: ta}
+}
+
+// Update handles a key press. It returns a command to run, whether the
+// editor should be closed, and whether the overrides should be saved. A save
+// with malformed lines keeps the editor open and shows the error.
+func (e *workEnvEditor) Update(msg tea.KeyMsg) (tea.Cmd, bool, bool) {
+	switch msg.String() {
+	case "esc":
+		return nil, true, false
+	case "ctrl+enter", "ctrl+s":
+		if _, err := e.Value(); err != nil {
+			e.err = err.Error()
+			return nil, false, false
+		}
+		return nil, true, true
+	}
+	e.err = ""
+	var cmd tea.Cmd
+	e.textarea, cmd = e.textarea.Update(msg)
+	return cmd, false, false
+}
+
+// Value returns the edited overrides, skipping blank lines
+func (e *workEnvEditor) Value() ([]string, error) {
+	var env []string
+	for _, line := range strings.Split(e.textarea.Value(), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if _, _, err := project.ParseEnvVar(line); err != nil {
+			return nil, err
+		}
+		env = append(env, line)
+	}
+	return env, nil
+}
+
+// render returns the dialog content sized to fit width x height
+func (e *workEnvEditor) render(width, height int) string {
+	frameW, frameH := e.theme.Dialog.GetFrameSize()
+	innerWidth := min(max(width-frameW, 20), 80)
+	innerHeight := max(height-frameH, 6)
+
+	e.textarea.SetWidth(innerWidth)
+	e.textarea.SetHeight(min(max(innerHeight-6, 3), 15))
+
+	lines := []string{
+		e.theme.Title.Render(fmt.Sprintf("Environment for %s", e.workID)),
+		e.theme.Dim.Render("One KEY=value per line, applied over [hooks] env"),
+		"",
+		e.textarea.View(),
+		"",
+	}
+	if e.err != "" {
+		lines = append(lines, ansi.Truncate(e.theme.Error.Render(e.err), innerWidth, "…"))
+	}
+	lines = append(lines, ansi.Truncate(e.theme.styleHotkeys("[Ctrl+Enter/Ctrl+S] Save  [Esc] Cancel"), innerWidth, "…"))
+	return e.theme.Dialog.Width(innerWidth + e.theme.Dialog.GetHorizontalPadding()).Render(strings.Join(lines, "\n"))
+}
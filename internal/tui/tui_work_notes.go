@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// workNotesPreviewLines is how many lines of a work's notes the summary shows
+const workNotesPreviewLines = 3
+
+// workNotesEditor is the dialog for editing a work's free-form notes
+type workNotesEditor struct {
+	theme    *Theme
+	workID   string
+	textarea textarea.Model
+}
+
+// newWorkNotesEditor creates a notes editor pre-filled with the work's notes
+func newWorkNotesEditor(theme *Theme, workID, notes string) *workNotesEditor {
+	ta := textarea.New()
+	ta.Placeholder = "Waiting on design review, links to docs..."
+	ta.CharLimit = 4000
+	ta.ShowLineNumbers = false
+	ta.SetValue(notes)
+	ta.Focus()
+	return &workNotesEditor{theme: theme, workID: workID, textarea: ta}
+}
+
+// Update handles a key press. It returns a command to run, whether the
+// editor should be closed, and whether the notes should be saved.
+func (e *workNotesEditor) Update(msg tea.KeyMsg) (tea.Cmd, bool, bool) {
+	switch msg.String() {
+	case "esc":
+		return nil, true, false
+	case "ctrl+enter", "ctrl+s":
+		return nil, true, true
+	}
+	var cmd tea.Cmd
+	e.textarea, cmd = e.textarea.Update(msg)
+	return cmd, false, false
+}
+
+// Value returns the edited notes
+func (e *workNotesEditor) Value() string {
+	return strings.TrimSpace(e.textarea.Value())
+}
+
+// render returns the dialog content sized to fit width x height
+func (e *workNotesEditor) render(width, height int) string {
+	frameW, frameH := e.theme.Dialog.GetFrameSize()
+	innerWidth := min(max(width-frameW, 20), 80)
+	innerHeight := max(height-frameH, 6)
+
+	e.textarea.SetWidth(innerWidth)
+	e.textarea.SetHeight(min(max(innerHeight-4, 3), 15))
+
+	lines := []string{
+		e.theme.Title.Render(fmt.Sprintf("Notes for %s", e.workID)),
+		"",
+		e.textarea.View(),
+		"",
+		ansi.Truncate(e.theme.styleHotkeys("[Ctrl+Enter/Ctrl+S] Save  [Esc] Cancel"), innerWidth, "…"),
+	}
+	return e.theme.Dialog.Width(innerWidth + e.theme.Dialog.GetHorizontalPadding()).Render(strings.Join(lines, "\n"))
+}
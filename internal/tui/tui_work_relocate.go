@@ -0,0 +1,116 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/db"
+	workpkg "github.com/newhook/co/internal/work"
+)
+
+// workRelocateDialog asks where the worktree of a work went after its
+// directory disappeared: a path to adopt the moved worktree, or nothing to
+// check the branch out again at the default location
+type workRelocateDialog struct {
+	theme       *Theme
+	workID      string
+	oldPath     string
+	defaultPath string
+	input       textinput.Model
+}
+
+// newWorkRelocateDialog creates the relocate dialog for a work. When the
+// default location already holds something, as it does after the whole
+// project was moved, it is offered as the moved worktree.
+func newWorkRelocateDialog(theme *Theme, projectRoot string, work *db.Work) *workRelocateDialog {
+	defaultPath := workpkg.DefaultWorktreePath(projectRoot, work.ID)
+	input := textinput.New()
+	input.Placeholder = "empty re-creates it at " + defaultPath
+	input.CharLimit = 4096
+	if entries, err := os.ReadDir(defaultPath); err == nil && len(entries) > 0 {
+		input.SetValue(defaultPath)
+	}
+	input.Focus()
+	return &workRelocateDialog{
+		theme:       theme,
+		workID:      work.ID,
+		oldPath:     work.WorktreePath,
+		defaultPath: defaultPath,
+		input:       input,
+	}
+}
+
+// Update handles a key press. It returns a command to run, whether the
+// dialog should be closed, and whether the relocation should go ahead.
+func (d *workRelocateDialog) Update(msg tea.KeyMsg) (tea.Cmd, bool, bool) {
+	switch msg.String() {
+	case "esc":
+		return nil, true, false
+	case "enter":
+		return nil, true, true
+	}
+	var cmd tea.Cmd
+	d.input, cmd = d.input.Update(msg)
+	return cmd, false, false
+}
+
+// Options returns how the worktree should be relocated
+func (d *workRelocateDialog) Options(projectRoot string) workpkg.RelocateOptions {
+	path := strings.TrimSpace(d.input.Value())
+	if path == "" {
+		return workpkg.RelocateOptions{Recreate: true}
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(projectRoot, path)
+	}
+	return workpkg.RelocateOptions{Path: path}
+}
+
+// render returns the dialog content sized to fit width
+func (d *workRelocateDialog) render(width, _ int) string {
+	frameW, _ := d.theme.Dialog.GetFrameSize()
+	innerWidth := min(max(width-frameW, 20), 80)
+	d.input.Width = innerWidth - 2
+
+	lines := []string{
+		d.theme.Title.Render(fmt.Sprintf("Relocate worktree of %s", d.workID)),
+		ansi.Truncate(d.theme.Error.Render("Missing: "+d.oldPath), innerWidth, "…"),
+		"",
+		d.theme.Dim.Render("Path the worktree was moved to:"),
+		d.input.View(),
+		"",
+		ansi.Truncate(d.theme.Dim.Render("Leave it empty to check the branch out again at "+d.defaultPath+" (must be empty)"), innerWidth, "…"),
+		ansi.Truncate(d.theme.styleHotkeys("[Enter] Relocate  [Esc] Cancel"), innerWidth, "…"),
+	}
+	return d.theme.Dialog.Width(innerWidth + d.theme.Dialog.GetHorizontalPadding()).Render(strings.Join(lines, "\n"))
+}
+
+// relocateWorktree repairs a work whose worktree directory is gone
+func (m *planModel) relocateWorktree(workID string, opts workpkg.RelocateOptions) tea.Cmd {
+	action := "Relocate worktree"
+	if opts.Recreate {
+		action = "Recreate worktree"
+	}
+	return func() tea.Msg {
+		_, err := m.workService.RelocateWorktree(m.ctx, workID, opts)
+		return workCommandMsg{action: action, workID: workID, err: err}
+	}
+}
+
+// missingWorktreeError explains that an action can't run in a work's
+// worktree because the directory is gone, or returns nil if it's there.
+func missingWorktreeError(work *db.Work) error {
+	if work.WorktreePath == "" || work.IsRemote() {
+		return nil
+	}
+	if info, err := os.Stat(work.WorktreePath); err == nil && info.IsDir() {
+		return nil
+	}
+	return coerrors.Errorf(coerrors.Conflict, "worktree is missing at %s; press H to relocate it", work.WorktreePath)
+}
@@ -0,0 +1,88 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/github"
+	"github.com/newhook/co/internal/logging"
+	"github.com/newhook/co/internal/progress"
+)
+
+// prChecksMsg carries the checks failing on a work's PR
+type prChecksMsg struct {
+	workID  string
+	failing []string
+	err     error
+}
+
+// loadFailingChecks looks up the checks failing on a work's PR, when CI
+// reports it failing. Checks are cached for a minute, so zooming in and out
+// doesn't call gh each time.
+func (m *planModel) loadFailingChecks(wp *progress.WorkProgress) tea.Cmd {
+	if wp == nil || wp.Work.PRURL == "" || wp.CIStatus != db.CIStatusFailure || m.workService == nil {
+		return nil
+	}
+	if m.prChecks == nil {
+		m.prChecks = github.NewChecksCache(m.workService.GitHubClient, time.Minute)
+	}
+	return loadFailingChecksCmd(m.ctx, m.prChecks, wp.Work.ID, wp.Work.PRURL)
+}
+
+// handlePRChecks keeps the failing checks of a work for its summary and the
+// review dialog
+func (m *planModel) handlePRChecks(msg prChecksMsg) {
+	if msg.err != nil {
+		logging.Debug("loadFailingChecks failed", "work_id", msg.workID, "error", msg.err)
+		return
+	}
+	if m.failingChecks == nil {
+		m.failingChecks = make(map[string][]string)
+	}
+	m.failingChecks[msg.workID] = msg.failing
+}
+
+// startReview creates a review task for the focused work. When CI fails on
+// the work's PR, it first offers to include the failures in the review.
+func (m *planModel) startReview() tea.Cmd {
+	wp := m.findWorkByID(m.focusedWorkID)
+	if wp == nil || wp.Work.PRURL == "" || wp.CIStatus != db.CIStatusFailure {
+		return m.createReviewTask(false)
+	}
+	m.viewMode = ViewReviewChoice
+	return m.loadFailingChecks(wp)
+}
+
+// updateReviewChoice handles the choice between a plain review and one
+// including the CI failures
+func (m *planModel) updateReviewChoice(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "r", "enter":
+		m.viewMode = ViewNormal
+		return m, m.createReviewTask(false)
+	case "c":
+		m.viewMode = ViewNormal
+		m.statusMessage = "Fetching CI failures for the review..."
+		m.statusIsError = false
+		return m, m.createReviewTask(true)
+	case "esc":
+		m.viewMode = ViewNormal
+	}
+	return m, nil
+}
+
+func (m *planModel) renderReviewChoiceContent() string {
+	var b strings.Builder
+	b.WriteString(m.theme.Title.Render("Create review for "+m.focusedWorkID) + "\n\n")
+	b.WriteString("  " + m.theme.Error.Render(m.theme.Icons.Failed+" CI is failing on the PR") + "\n")
+	if failing := m.failingChecks[m.focusedWorkID]; len(failing) > 0 {
+		fmt.Fprintf(&b, "  %s\n", m.theme.Dim.Render("Failing: "+strings.Join(failing, ", ")))
+	}
+	b.WriteString("\n  Including the failures gives the review the failing checks\n")
+	b.WriteString("  and the end of their logs.\n\n")
+	b.WriteString(m.theme.styleHotkeys("  [r] Review  [c] Review including CI failures  [Esc] Cancel"))
+	return m.theme.Dialog.Render(b.String())
+}
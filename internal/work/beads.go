@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/coerrors"
 )
 
 // CollectIssueIDsForAutomatedWorkflow collects all issue IDs to include in the workflow.
@@ -24,7 +25,7 @@ func CollectIssueIDsForAutomatedWorkflow(ctx context.Context, beadID string, bea
 		return nil, fmt.Errorf("failed to get bead %s: %w", beadID, err)
 	}
 	if mainIssue == nil {
-		return nil, fmt.Errorf("bead %s not found", beadID)
+		return nil, coerrors.Errorf(coerrors.NotFound, "bead %s not found", beadID)
 	}
 
 	// Check if this issue has children or blocked issues
@@ -114,3 +115,27 @@ func collectChildrenAndBlocked(ctx context.Context, beadID string, beadsReader b
 
 	return orderedIDs, nil
 }
+
+// MergeDuplicateBeads merges the bead duplicateID into survivorID with
+// beads.MergeDuplicate, then hands its work and task assignments over to the
+// survivor.
+func (s *WorkService) MergeDuplicateBeads(ctx context.Context, survivorID, duplicateID string) error {
+	survivor, err := s.BeadsReader.GetBead(ctx, survivorID)
+	if err != nil {
+		return fmt.Errorf("failed to get bead %s: %w", survivorID, err)
+	}
+	if survivor == nil {
+		return coerrors.Errorf(coerrors.NotFound, "bead %s not found", survivorID)
+	}
+	duplicate, err := s.BeadsReader.GetBead(ctx, duplicateID)
+	if err != nil {
+		return fmt.Errorf("failed to get bead %s: %w", duplicateID, err)
+	}
+	if duplicate == nil {
+		return coerrors.Errorf(coerrors.NotFound, "bead %s not found", duplicateID)
+	}
+	if err := beads.MergeDuplicate(ctx, s.BeadsCLI, survivor, duplicate); err != nil {
+		return err
+	}
+	return s.DB.TransferBeadAssignments(ctx, duplicateID, survivorID)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/git"
 )
 
@@ -112,6 +113,172 @@ func EnsureUniqueBranchName(ctx context.Context, gitOps git.Operations, repoPath
 	return "", fmt.Errorf("could not find unique branch name after 100 attempts (base: %s)", baseName)
 }
 
+// invalidRefChars are characters git never allows in a ref name
+const invalidRefChars = "~^:?*[\\"
+
+// ValidateBranchName checks a branch name against git check-ref-format's
+// rules for branches and returns the first one it breaks, or nil.
+func ValidateBranchName(name string) error {
+	switch {
+	case name == "":
+		return fmt.Errorf("branch name is empty")
+	case name == "@":
+		return fmt.Errorf("branch name cannot be '@'")
+	case strings.HasPrefix(name, "-"):
+		return fmt.Errorf("branch name cannot start with '-'")
+	case strings.HasPrefix(name, "/") || strings.HasSuffix(name, "/"):
+		return fmt.Errorf("branch name cannot start or end with '/'")
+	case strings.HasSuffix(name, "."):
+		return fmt.Errorf("branch name cannot end with '.'")
+	case strings.Contains(name, ".."):
+		return fmt.Errorf("branch name cannot contain '..'")
+	case strings.Contains(name, "//"):
+		return fmt.Errorf("branch name cannot contain '//'")
+	case strings.Contains(name, "@{"):
+		return fmt.Errorf("branch name cannot contain '@{'")
+	}
+	for _, r := range name {
+		if r == ' ' {
+			return fmt.Errorf("branch name cannot contain spaces")
+		}
+		if r < 0x20 || r == 0x7f {
+			return fmt.Errorf("branch name cannot contain control characters")
+		}
+		if strings.ContainsRune(invalidRefChars, r) {
+			return fmt.Errorf("branch name cannot contain '%c'", r)
+		}
+	}
+	for _, component := range strings.Split(name, "/") {
+		if strings.HasPrefix(component, ".") {
+			return fmt.Errorf("branch name components cannot start with '.'")
+		}
+		if strings.HasSuffix(component, ".lock") {
+			return fmt.Errorf("branch name components cannot end with '.lock'")
+		}
+	}
+	return nil
+}
+
+// NormalizeBranchName turns a proposed branch name into one git accepts:
+// whitespace becomes hyphens and whatever ValidateBranchName rejects is
+// dropped. Returns "" if nothing usable is left.
+func NormalizeBranchName(name string) string {
+	name = strings.Join(strings.Fields(name), "-")
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || strings.ContainsRune(invalidRefChars, r) {
+			return -1
+		}
+		return r
+	}, name)
+
+	// Dropping one thing can expose another ("a/.b." -> "a/b."), so repeat
+	// until nothing changes
+	for {
+		prev := name
+		name = strings.ReplaceAll(name, "@{", "@")
+		name = strings.ReplaceAll(name, "..", ".")
+
+		var components []string
+		for _, component := range strings.Split(name, "/") {
+			component = strings.TrimLeft(component, ".")
+			for strings.HasSuffix(component, ".lock") {
+				component = strings.TrimSuffix(component, ".lock")
+			}
+			if component != "" {
+				components = append(components, component)
+			}
+		}
+		name = strings.Join(components, "/")
+		name = strings.TrimRight(name, ".")
+		name = strings.TrimLeft(name, "-")
+		if name == prev {
+			break
+		}
+	}
+	if name == "@" {
+		return ""
+	}
+	return name
+}
+
+// BranchCheck is the result of checking a proposed branch name for a new work.
+type BranchCheck struct {
+	Name         string // The proposed name, normalized; empty if nothing usable is left
+	Invalid      error  // Why the name as proposed isn't a valid branch name
+	ExistsLocal  bool
+	ExistsRemote bool
+	WorktreePath string // Worktree that already has the branch checked out
+	WorkID       string // Active work already using the branch
+	Suggestion   string // A free name with a numeric suffix, when Name is taken
+}
+
+// Taken reports whether a new branch can't be created under Name.
+func (c *BranchCheck) Taken() bool {
+	return c.ExistsLocal || c.ExistsRemote || c.WorktreePath != "" || c.WorkID != ""
+}
+
+// CanAttach reports whether a new work can use the existing branch: it
+// exists and neither a worktree nor another work holds it.
+func (c *BranchCheck) CanAttach() bool {
+	return (c.ExistsLocal || c.ExistsRemote) && c.WorktreePath == "" && c.WorkID == ""
+}
+
+// CheckBranch normalizes a proposed branch name for a new work and checks it
+// against local and remote branches, worktrees and the project's active works.
+func (s *WorkService) CheckBranch(ctx context.Context, name string) (*BranchCheck, error) {
+	check := &BranchCheck{
+		Name:    NormalizeBranchName(name),
+		Invalid: ValidateBranchName(strings.TrimSpace(name)),
+	}
+	if check.Name == "" {
+		return check, nil
+	}
+
+	existsLocal, existsRemote, err := s.Git.ValidateExistingBranch(ctx, s.MainRepoPath, check.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check branch: %w", err)
+	}
+	check.ExistsLocal, check.ExistsRemote = existsLocal, existsRemote
+
+	worktrees, err := s.Worktree.List(ctx, s.MainRepoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list worktrees: %w", err)
+	}
+	for _, wt := range worktrees {
+		if wt.Branch == check.Name {
+			check.WorktreePath = wt.Path
+			break
+		}
+	}
+
+	// A work's branch may not exist in git yet while its worktree is being created
+	works, err := s.DB.ListWorks(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list works: %w", err)
+	}
+	workBranches := make(map[string]bool)
+	for _, w := range works {
+		if w.Status == db.StatusCompleted {
+			continue
+		}
+		workBranches[w.BranchName] = true
+		if w.BranchName == check.Name && check.WorkID == "" {
+			check.WorkID = w.ID
+		}
+	}
+
+	if check.Taken() {
+		for i := 2; i <= 100; i++ {
+			candidate := fmt.Sprintf("%s-%d", check.Name, i)
+			if !workBranches[candidate] && !s.Git.BranchExists(ctx, s.MainRepoPath, candidate) {
+				check.Suggestion = candidate
+				break
+			}
+		}
+	}
+	return check, nil
+}
+
 // ParseBeadIDs parses a comma-delimited string of bead IDs into a slice.
 // It trims whitespace from each ID and filters out empty strings.
 func ParseBeadIDs(beadIDStr string) []string {
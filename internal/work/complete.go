@@ -0,0 +1,230 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/db"
+)
+
+// CompleteWorkOptions controls which cleanup steps CompleteWork performs.
+type CompleteWorkOptions struct {
+	KeepBeads    bool // Leave the work's beads open
+	KeepWorktree bool // Leave the worktree on disk (implies KeepBranch)
+	KeepBranch   bool // Leave the local branch in place
+	SkipPRCheck  bool // Don't require the work's PR to be merged
+}
+
+// CompletionPlan describes what CompleteWork will touch, so callers can
+// show a confirmation before anything is changed.
+type CompletionPlan struct {
+	WorkID       string
+	PRURL        string
+	BranchName   string
+	WorktreePath string
+	RemoteHost   string // Host the worktree is on; empty for a local work
+	// Beads lists the open beads that will be closed.
+	Beads []beads.Bead
+}
+
+// PlanCompleteWork gathers the resources CompleteWork would clean up for a work.
+// It does not contact GitHub or modify anything.
+func (s *WorkService) PlanCompleteWork(ctx context.Context, workID string) (*CompletionPlan, error) {
+	work, err := s.DB.GetWork(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
+	}
+	if work.Status != db.StatusIdle && work.Status != db.StatusMerged {
+		return nil, coerrors.Errorf(coerrors.Validation, "work %s is not idle or merged (current status: %s)", workID, work.Status)
+	}
+
+	workBeads, err := s.DB.GetWorkBeads(ctx, workID)
+	if err != nil {
+		return nil, err
+	}
+	beadIDs := make([]string, 0, len(workBeads)+1)
+	seen := make(map[string]bool)
+	if work.RootIssueID != "" {
+		beadIDs = append(beadIDs, work.RootIssueID)
+		seen[work.RootIssueID] = true
+	}
+	for _, wb := range workBeads {
+		if !seen[wb.BeadID] {
+			beadIDs = append(beadIDs, wb.BeadID)
+			seen[wb.BeadID] = true
+		}
+	}
+
+	plan := &CompletionPlan{
+		WorkID:       work.ID,
+		PRURL:        work.PRURL,
+		BranchName:   work.BranchName,
+		WorktreePath: work.WorktreePath,
+		RemoteHost:   work.RemoteHost,
+	}
+	if len(beadIDs) == 0 {
+		return plan, nil
+	}
+
+	result, err := s.BeadsReader.GetBeadsWithDeps(ctx, beadIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work beads: %w", err)
+	}
+	for _, id := range beadIDs {
+		bead, ok := result.Beads[id]
+		if !ok || bead.Status == beads.StatusClosed {
+			continue
+		}
+		plan.Beads = append(plan.Beads, bead)
+	}
+	return plan, nil
+}
+
+// Print writes what CompleteWork would do with opts to w, one step per line.
+func (p *CompletionPlan) Print(w io.Writer, opts CompleteWorkOptions) {
+	if opts.SkipPRCheck {
+		fmt.Fprintln(w, "  Skip the PR merge check")
+	} else {
+		fmt.Fprintf(w, "  Verify PR is merged: %s\n", p.PRURL)
+	}
+	if !opts.KeepBeads && len(p.Beads) > 0 {
+		ids := make([]string, len(p.Beads))
+		for i, b := range p.Beads {
+			ids[i] = b.ID
+		}
+		fmt.Fprintf(w, "  Close %d bead(s): %s\n", len(ids), strings.Join(ids, ", "))
+	}
+	if !opts.KeepWorktree && p.WorktreePath != "" {
+		fmt.Fprintf(w, "  Remove worktree: %s\n", worktreeLocation(p.RemoteHost, p.WorktreePath))
+	}
+	// The branch is still checked out in a kept worktree, so it can't be deleted
+	if !opts.KeepBranch && !opts.KeepWorktree && p.BranchName != "" {
+		fmt.Fprintf(w, "  Delete %s: %s\n", p.BranchLocation(), p.BranchName)
+	}
+	fmt.Fprintf(w, "  Mark work %s as %s\n", p.WorkID, db.StatusCompleted)
+}
+
+// BranchLocation describes where the work's branch is deleted from: the
+// local repository, or the host of a remote work
+func (p *CompletionPlan) BranchLocation() string {
+	if p.RemoteHost == "" {
+		return "local branch"
+	}
+	return "branch on " + p.RemoteHost
+}
+
+// CompleteWork finishes a work whose PR has merged: it verifies the merge,
+// closes the work's beads, removes the worktree and local branch, and marks
+// the work completed. Each step is reported to w and can be skipped via opts.
+func (s *WorkService) CompleteWork(ctx context.Context, workID string, opts CompleteWorkOptions, w io.Writer) error {
+	plan, err := s.PlanCompleteWork(ctx, workID)
+	if err != nil {
+		return err
+	}
+
+	// Step 1: verify the PR is merged
+	if opts.SkipPRCheck {
+		fmt.Fprintln(w, "Skipping PR merge check")
+	} else {
+		if plan.PRURL == "" {
+			return fmt.Errorf("work %s has no PR to verify", workID)
+		}
+		metadata, err := s.GitHubClient.GetPRMetadata(ctx, plan.PRURL, "")
+		if err != nil {
+			return fmt.Errorf("failed to check PR state: %w", err)
+		}
+		if !strings.EqualFold(metadata.State, "MERGED") {
+			return fmt.Errorf("PR %s is not merged (state: %s)", plan.PRURL, strings.ToLower(metadata.State))
+		}
+		fmt.Fprintf(w, "✓ PR %s is merged\n", plan.PRURL)
+	}
+
+	// The orchestrator of an idle work is still waiting for tasks; stop it
+	// before the work's beads and worktree go away under it
+	stopped, err := StopOrchestrator(ctx, s.DB, workID)
+	if err != nil {
+		return fmt.Errorf("failed to stop orchestrator: %w", err)
+	}
+	if stopped {
+		fmt.Fprintln(w, "✓ Stopped orchestrator")
+	}
+
+	// Step 2: close the work's beads
+	switch {
+	case opts.KeepBeads:
+		fmt.Fprintln(w, "Keeping beads open")
+	case len(plan.Beads) == 0:
+		fmt.Fprintln(w, "✓ No open beads to close")
+	default:
+		ids := make([]string, len(plan.Beads))
+		for i, b := range plan.Beads {
+			ids[i] = b.ID
+		}
+		if err := s.BeadsCLI.CloseMany(ctx, ids); err != nil {
+			return fmt.Errorf("failed to close beads: %w", err)
+		}
+		fmt.Fprintf(w, "✓ Closed %d bead(s): %s\n", len(ids), strings.Join(ids, ", "))
+		if s.Config.Zellij.ShouldKillTabsOnDestroy() {
+			if err := s.OrchestratorManager.TerminatePlanSessions(ctx, ids, s.Config.Project.Name, w); err != nil {
+				fmt.Fprintf(w, "Warning: failed to end plan sessions: %v\n", err)
+			}
+		}
+	}
+
+	// Step 3: remove the worktree
+	keepBranch := opts.KeepBranch
+	if opts.KeepWorktree {
+		fmt.Fprintln(w, "Keeping worktree")
+		// The branch is still checked out in the worktree, so git won't delete it
+		keepBranch = true
+	} else {
+		if s.Config.Zellij.ShouldKillTabsOnDestroy() {
+			if err := s.OrchestratorManager.TerminateWorkTabs(ctx, workID, s.Config.Project.Name, w); err != nil {
+				fmt.Fprintf(w, "Warning: failed to terminate work tabs: %v\n", err)
+			}
+		}
+		if plan.WorktreePath != "" {
+			if err := s.removeWorktree(ctx, workID, plan.RemoteHost, plan.WorktreePath); err != nil {
+				return fmt.Errorf("failed to remove worktree: %w", err)
+			}
+		}
+		workDir := filepath.Join(s.ProjectRoot, workID)
+		if err := os.RemoveAll(workDir); err != nil {
+			fmt.Fprintf(w, "Warning: failed to remove work directory %s: %v\n", workDir, err)
+		}
+		fmt.Fprintln(w, "✓ Removed worktree")
+	}
+
+	// Step 4: delete the branch, on the host for a remote work, which has
+	// no local one
+	if keepBranch || plan.BranchName == "" {
+		fmt.Fprintf(w, "Keeping %s\n", plan.BranchLocation())
+	} else {
+		if err := s.deleteBranch(ctx, plan.RemoteHost, plan.BranchName); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "✓ Deleted %s %s\n", plan.BranchLocation(), plan.BranchName)
+	}
+
+	// Step 5: mark the work completed
+	if err := s.DB.CompleteWork(ctx, workID, plan.PRURL); err != nil {
+		return err
+	}
+	if !opts.KeepWorktree {
+		// The worktree is gone; clear the path so nothing tries to use it
+		if err := s.DB.UpdateWorkWorktreePath(ctx, workID, ""); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(w, "✓ Marked work %s as %s\n", workID, db.StatusCompleted)
+	return nil
+}
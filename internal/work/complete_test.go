@@ -0,0 +1,211 @@
+package work_test
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"syscall"
+	"testing"
+
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/github"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPRURL = "https://github.com/owner/repo/pull/7"
+
+// setupMergedWork creates a work with two open beads, one closed bead, and a PR in the given state.
+func setupMergedWork(t *testing.T, prState string) *testutil.TestHarness {
+	t.Helper()
+	h := testutil.NewTestHarness(t)
+	ctx := context.Background()
+
+	h.CreateBead("bead-1", "First")
+	h.CreateBead("bead-2", "Second")
+	closed := h.CreateBead("bead-3", "Done already")
+	closed.Status = "closed"
+
+	h.CreateWork("w-test", "feat/done")
+	h.AddBeadToWork("w-test", "bead-1")
+	h.AddBeadToWork("w-test", "bead-2")
+	h.AddBeadToWork("w-test", "bead-3")
+	require.NoError(t, h.DB.IdleWorkWithPR(ctx, "w-test", testPRURL))
+
+	h.GitHub.GetPRMetadataFunc = func(ctx context.Context, prURLOrNumber string, repo string) (*github.PRMetadata, error) {
+		return &github.PRMetadata{URL: prURLOrNumber, State: prState}, nil
+	}
+	return h
+}
+
+func TestCompleteWork_CleansUpMergedWork(t *testing.T) {
+	h := setupMergedWork(t, "MERGED")
+	defer h.Cleanup()
+	ctx := context.Background()
+
+	var closedIDs []string
+	h.Beads.CloseManyFunc = func(ctx context.Context, beadIDs []string) error {
+		closedIDs = beadIDs
+		return nil
+	}
+	var deletedBranch string
+	h.Git.DeleteBranchFunc = func(ctx context.Context, repoPath, branchName string) error {
+		deletedBranch = branchName
+		return nil
+	}
+
+	var output bytes.Buffer
+	err := h.WorkService.CompleteWork(ctx, "w-test", work.CompleteWorkOptions{}, &output)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"bead-1", "bead-2"}, closedIDs, "only open beads should be closed")
+	assert.Equal(t, "feat/done", deletedBranch)
+	assert.Len(t, h.Worktree.RemoveForceCalls(), 1)
+
+	w, err := h.DB.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Equal(t, db.StatusCompleted, w.Status)
+	assert.Empty(t, w.WorktreePath)
+	assert.Contains(t, output.String(), "PR "+testPRURL+" is merged")
+}
+
+func TestCompleteWork_RemoteWork(t *testing.T) {
+	h := setupMergedWork(t, "MERGED")
+	defer h.Cleanup()
+	ctx := context.Background()
+	require.NoError(t, h.DB.SetWorkRemoteHost(ctx, "w-test", "desktop"))
+
+	var output bytes.Buffer
+	err := h.WorkService.CompleteWork(ctx, "w-test", work.CompleteWorkOptions{}, &output)
+	require.NoError(t, err)
+
+	// The worktree and the branch are on the host; there's no local branch
+	assert.Empty(t, h.Git.DeleteBranchCalls())
+	assert.Empty(t, h.Worktree.RemoveForceCalls())
+	require.Len(t, h.Remote.RemoveWorktreeCalls(), 1)
+	calls := h.Remote.DeleteBranchCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "desktop", calls[0].Target.Host)
+	assert.Equal(t, "feat/done", calls[0].Branch)
+	assert.Contains(t, output.String(), "Deleted branch on desktop feat/done")
+
+	w, err := h.DB.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Equal(t, db.StatusCompleted, w.Status)
+}
+
+func TestCompleteWork_StopsOrchestrator(t *testing.T) {
+	h := setupMergedWork(t, "MERGED")
+	defer h.Cleanup()
+	ctx := context.Background()
+
+	// An orchestrator idling in the loop, as ps shows it
+	orch := exec.Command("sh", "-c", "sleep 30; :", "co", "orchestrate", "--work", "w-test")
+	require.NoError(t, orch.Start())
+	exited := make(chan struct{})
+	go func() {
+		_ = orch.Wait()
+		close(exited)
+	}()
+	workID := "w-test"
+	require.NoError(t, h.DB.RegisterProcess(ctx, "proc-1", db.ProcessTypeOrchestrator, &workID, orch.Process.Pid))
+
+	var output bytes.Buffer
+	err := h.WorkService.CompleteWork(ctx, "w-test", work.CompleteWorkOptions{}, &output)
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "Stopped orchestrator")
+
+	<-exited
+	status := orch.ProcessState.Sys().(syscall.WaitStatus)
+	assert.Equal(t, syscall.SIGTERM, status.Signal())
+	proc, err := h.DB.GetOrchestratorProcess(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Nil(t, proc)
+}
+
+func TestCompleteWork_RequiresMergedPR(t *testing.T) {
+	h := setupMergedWork(t, "OPEN")
+	defer h.Cleanup()
+	ctx := context.Background()
+
+	var output bytes.Buffer
+	err := h.WorkService.CompleteWork(ctx, "w-test", work.CompleteWorkOptions{}, &output)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not merged")
+
+	assert.Empty(t, h.Beads.CloseManyCalls(), "nothing should be cleaned up")
+	assert.Empty(t, h.Worktree.RemoveForceCalls())
+
+	w, err := h.DB.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Equal(t, db.StatusIdle, w.Status)
+}
+
+func TestCompleteWork_KeepFlags(t *testing.T) {
+	h := setupMergedWork(t, "OPEN")
+	defer h.Cleanup()
+	ctx := context.Background()
+
+	opts := work.CompleteWorkOptions{KeepBeads: true, KeepWorktree: true, SkipPRCheck: true}
+	var output bytes.Buffer
+	err := h.WorkService.CompleteWork(ctx, "w-test", opts, &output)
+	require.NoError(t, err)
+
+	assert.Empty(t, h.GitHub.GetPRMetadataCalls(), "PR check should be skipped")
+	assert.Empty(t, h.Beads.CloseManyCalls())
+	assert.Empty(t, h.Worktree.RemoveForceCalls())
+	assert.Empty(t, h.Git.DeleteBranchCalls(), "keeping the worktree keeps the branch")
+
+	w, err := h.DB.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Equal(t, db.StatusCompleted, w.Status)
+	assert.NotEmpty(t, w.WorktreePath)
+}
+
+func TestPlanCompleteWork_ListsOpenBeads(t *testing.T) {
+	h := setupMergedWork(t, "MERGED")
+	defer h.Cleanup()
+
+	plan, err := h.WorkService.PlanCompleteWork(context.Background(), "w-test")
+	require.NoError(t, err)
+	assert.Equal(t, "feat/done", plan.BranchName)
+	assert.Equal(t, testPRURL, plan.PRURL)
+	require.Len(t, plan.Beads, 2)
+	assert.Equal(t, "bead-1", plan.Beads[0].ID)
+	assert.Equal(t, "bead-2", plan.Beads[1].ID)
+}
+
+func TestPlanCompleteWork_RejectsBusyWork(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	h.CreateWork("w-test", "feat/busy")
+
+	_, err := h.WorkService.PlanCompleteWork(context.Background(), "w-test")
+	require.ErrorContains(t, err, "is not idle or merged")
+	assert.Equal(t, coerrors.Validation, coerrors.KindOf(err))
+}
+
+func TestCompletionPlan_Print(t *testing.T) {
+	h := setupMergedWork(t, "MERGED")
+	defer h.Cleanup()
+
+	plan, err := h.WorkService.PlanCompleteWork(context.Background(), "w-test")
+	require.NoError(t, err)
+
+	var output bytes.Buffer
+	plan.Print(&output, work.CompleteWorkOptions{})
+	assert.Contains(t, output.String(), "Verify PR is merged: "+testPRURL)
+	assert.Contains(t, output.String(), "Close 2 bead(s): bead-1, bead-2")
+	assert.Contains(t, output.String(), "Delete local branch: feat/done")
+
+	// Keeping the worktree keeps the branch too
+	output.Reset()
+	plan.Print(&output, work.CompleteWorkOptions{KeepBeads: true, KeepWorktree: true, SkipPRCheck: true})
+	assert.Contains(t, output.String(), "Skip the PR merge check")
+	assert.NotContains(t, output.String(), "Close")
+	assert.NotContains(t, output.String(), "Remove worktree")
+	assert.NotContains(t, output.String(), "Delete local branch")
+}
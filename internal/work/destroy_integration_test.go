@@ -7,6 +7,7 @@ import (
 	"io"
 	"testing"
 
+	"github.com/newhook/co/internal/coerrors"
 	"github.com/newhook/co/internal/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -205,6 +206,26 @@ func TestDestroyWork_WorktreeRemovalFailure(t *testing.T) {
 	assert.Nil(t, workAfter, "work should be deleted from DB")
 }
 
+func TestDestroyWork_RemoteWorktree(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.Config.Remote.Host = "desktop"
+	h.CreateWork("w-test", "feat/test")
+	require.NoError(t, h.DB.SetWorkRemoteHost(ctx, "w-test", "desktop"))
+
+	// The worktree is removed on its host, not on this machine
+	var output bytes.Buffer
+	require.NoError(t, h.WorkService.DestroyWork(ctx, "w-test", &output))
+	calls := h.Remote.RemoveWorktreeCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "desktop", calls[0].Target.Host)
+	assert.Equal(t, "w-test", calls[0].WorkID)
+	assert.Empty(t, h.Worktree.RemoveForceCalls())
+	assert.NotContains(t, output.String(), "Warning")
+}
+
 func TestDestroyWork_WorkNotFound(t *testing.T) {
 	h := testutil.NewTestHarness(t)
 	defer h.Cleanup()
@@ -215,6 +236,7 @@ func TestDestroyWork_WorkNotFound(t *testing.T) {
 	err := h.WorkService.DestroyWork(ctx, "non-existent-work", io.Discard)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "not found")
+	assert.ErrorIs(t, err, coerrors.NotFound)
 }
 
 func TestDestroyWork_NoWorktreePath(t *testing.T) {
@@ -302,3 +324,39 @@ func TestDestroyWork_KillTabsDisabled(t *testing.T) {
 func boolPtr(b bool) *bool {
 	return &b
 }
+
+func TestPlanDestroyWork_ChangesNothing(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+
+	h.CreateBead("bead-1", "Test Bead 1")
+	workRecord := h.CreateWork("w-test", "feat/test-branch")
+	h.AddBeadToWork("w-test", "bead-1")
+	h.CreateTask("w-test.1", "w-test", []string{"bead-1"})
+
+	plan, err := h.WorkService.PlanDestroyWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Equal(t, workRecord.WorktreePath, plan.WorktreePath)
+	assert.Equal(t, "feat/test-branch", plan.BranchName)
+	assert.Equal(t, []string{"w-test.1"}, plan.TaskIDs)
+	assert.Equal(t, []string{"bead-1"}, plan.BeadIDs)
+	assert.True(t, plan.TerminateTabs)
+
+	var output bytes.Buffer
+	plan.Print(&output)
+	assert.Contains(t, output.String(), "Remove worktree: "+workRecord.WorktreePath)
+	assert.Contains(t, output.String(), "Keep branch: feat/test-branch")
+	assert.Contains(t, output.String(), "Delete 1 task(s): w-test.1")
+
+	// Nothing was touched
+	assert.Empty(t, h.Worktree.RemoveForceCalls())
+	assert.Empty(t, h.OrchestratorManager.TerminateWorkTabsCalls())
+	workAfter, err := h.DB.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.NotNil(t, workAfter)
+
+	_, err = h.WorkService.PlanDestroyWork(ctx, "w-missing")
+	require.ErrorIs(t, err, coerrors.NotFound)
+}
@@ -0,0 +1,79 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/worktree"
+)
+
+// WorktreeUsage is the disk usage of a work's worktree.
+type WorktreeUsage struct {
+	Work  *db.Work
+	Usage worktree.Usage
+	// Artifacts lists the build artifacts that can be removed. Only
+	// completed and merged works are searched.
+	Artifacts []worktree.Artifact
+}
+
+// ArtifactBytes returns the combined size of the worktree's artifacts.
+func (u *WorktreeUsage) ArtifactBytes() int64 {
+	var total int64
+	for _, a := range u.Artifacts {
+		total += a.Usage.Bytes
+	}
+	return total
+}
+
+// Age returns how long ago the work finished, or was created if it hasn't.
+func (u *WorktreeUsage) Age(now time.Time) time.Duration {
+	if u.Work.CompletedAt != nil {
+		return now.Sub(*u.Work.CompletedAt)
+	}
+	return now.Sub(u.Work.CreatedAt)
+}
+
+// CanCollect reports whether the work is finished, so its artifacts are safe to remove.
+func (u *WorktreeUsage) CanCollect() bool {
+	return u.Work.Status == db.StatusCompleted || u.Work.Status == db.StatusMerged
+}
+
+// GatherWorktreeUsage measures the worktree of every work that still has one
+// on disk and returns them largest first. Completed and merged works also get
+// their build artifacts matching [gc] artifact_patterns listed.
+func (s *WorkService) GatherWorktreeUsage(ctx context.Context) ([]*WorktreeUsage, error) {
+	works, err := s.DB.ListWorks(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list works: %w", err)
+	}
+
+	patterns := s.Config.GC.GetArtifactPatterns()
+	var usages []*WorktreeUsage
+	for _, w := range works {
+		if w.WorktreePath == "" || w.IsRemote() || !s.Worktree.ExistsPath(w.WorktreePath) {
+			continue
+		}
+		measureCtx, cancel := context.WithTimeout(ctx, worktree.MeasureTimeout)
+		usage, err := worktree.Measure(measureCtx, w.WorktreePath, 0)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		u := &WorktreeUsage{Work: w, Usage: usage}
+		if u.CanCollect() {
+			u.Artifacts, err = worktree.FindArtifacts(ctx, w.WorktreePath, patterns)
+			if err != nil {
+				return nil, err
+			}
+		}
+		usages = append(usages, u)
+	}
+
+	sort.SliceStable(usages, func(i, j int) bool {
+		return usages[i].Usage.Bytes > usages[j].Usage.Bytes
+	})
+	return usages, nil
+}
@@ -0,0 +1,60 @@
+package work_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/newhook/co/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGatherWorktreeUsage(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.Worktree.ExistsPathFunc = func(worktreePath string) bool {
+		_, err := os.Stat(worktreePath)
+		return err == nil
+	}
+
+	// An active work with a small worktree
+	activeTree := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(activeTree, "main.go"), make([]byte, 10), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(activeTree, "node_modules"), 0755))
+	h.CreateWork("w-active", "feat/active")
+	require.NoError(t, h.DB.UpdateWorkWorktreePath(ctx, "w-active", activeTree))
+
+	// A completed work whose worktree was kept, with node_modules left behind
+	doneTree := t.TempDir()
+	require.NoError(t, exec.Command("git", "init", "-q", doneTree).Run())
+	require.NoError(t, os.MkdirAll(filepath.Join(doneTree, "node_modules"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(doneTree, "node_modules", "dep.js"), make([]byte, 100), 0644))
+	h.CreateWork("w-done", "feat/done")
+	require.NoError(t, h.DB.UpdateWorkWorktreePath(ctx, "w-done", doneTree))
+	require.NoError(t, h.DB.CompleteWork(ctx, "w-done", ""))
+
+	// A work whose worktree is gone is skipped
+	h.CreateWork("w-gone", "feat/gone")
+
+	usages, err := h.WorkService.GatherWorktreeUsage(ctx)
+	require.NoError(t, err)
+	require.Len(t, usages, 2)
+
+	// Largest first
+	assert.Equal(t, "w-done", usages[0].Work.ID)
+	assert.GreaterOrEqual(t, usages[0].Usage.Bytes, int64(100), "the worktree is measured with its .git")
+	assert.True(t, usages[0].CanCollect())
+	require.Len(t, usages[0].Artifacts, 1)
+	assert.Equal(t, filepath.Join(doneTree, "node_modules"), usages[0].Artifacts[0].Path)
+	assert.Equal(t, int64(100), usages[0].ArtifactBytes())
+
+	// Active works are measured but their artifacts are left alone
+	assert.Equal(t, "w-active", usages[1].Work.ID)
+	assert.False(t, usages[1].CanCollect())
+	assert.Empty(t, usages[1].Artifacts)
+}
@@ -0,0 +1,227 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/db"
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestVersion is the version of the manifest format ExportWork writes.
+const ManifestVersion = 1
+
+// WorkManifest describes a work so it can be handed to someone else and
+// recreated with ImportWork: the branch and the issues it groups, without
+// any of its tasks or state.
+type WorkManifest struct {
+	Version    int            `yaml:"version"`
+	Branch     string         `yaml:"branch"`
+	BaseBranch string         `yaml:"base_branch,omitempty"`
+	Name       string         `yaml:"name,omitempty"`
+	RootIssue  string         `yaml:"root_issue,omitempty"`
+	Notes      string         `yaml:"notes,omitempty"`
+	Beads      []ManifestBead `yaml:"beads"`
+}
+
+// ManifestBead is an issue in a manifest. The title is only there for the
+// reader; importing goes by ID.
+type ManifestBead struct {
+	ID    string `yaml:"id"`
+	Title string `yaml:"title,omitempty"`
+}
+
+// Marshal encodes the manifest as YAML.
+func (m *WorkManifest) Marshal() ([]byte, error) {
+	data, err := yaml.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	return data, nil
+}
+
+// ParseManifest decodes and checks a YAML manifest.
+func ParseManifest(data []byte) (*WorkManifest, error) {
+	var m WorkManifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, coerrors.Errorf(coerrors.Validation, "invalid manifest: %v", err)
+	}
+	if m.Version > ManifestVersion {
+		return nil, coerrors.Errorf(coerrors.Validation, "manifest version %d is newer than this co understands (%d)", m.Version, ManifestVersion)
+	}
+	if strings.TrimSpace(m.Branch) == "" {
+		return nil, coerrors.Errorf(coerrors.Validation, "manifest has no branch")
+	}
+	if len(m.Beads) == 0 {
+		return nil, coerrors.Errorf(coerrors.Validation, "manifest lists no beads")
+	}
+	for i, b := range m.Beads {
+		if strings.TrimSpace(b.ID) == "" {
+			return nil, coerrors.Errorf(coerrors.Validation, "bead %d in the manifest has no id", i+1)
+		}
+	}
+	return &m, nil
+}
+
+// ReadManifest reads and parses a manifest file.
+func ReadManifest(path string) (*WorkManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	return ParseManifest(data)
+}
+
+// ExportWork builds the manifest of a work: its branch, name, notes and
+// issues in the order they were added.
+func (s *WorkService) ExportWork(ctx context.Context, workID string) (*WorkManifest, error) {
+	work, err := s.DB.GetWork(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
+	}
+	workBeads, err := s.DB.GetWorkBeads(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work beads: %w", err)
+	}
+
+	beadIDs := make([]string, len(workBeads))
+	for i, wb := range workBeads {
+		beadIDs[i] = wb.BeadID
+	}
+	titles := make(map[string]string)
+	if len(beadIDs) > 0 {
+		result, err := s.BeadsReader.GetBeadsWithDeps(ctx, beadIDs)
+		if err != nil {
+			return nil, coerrors.Wrap(coerrors.ExternalTool, fmt.Errorf("failed to get beads: %w", err))
+		}
+		for id, bead := range result.Beads {
+			titles[id] = bead.Title
+		}
+	}
+
+	manifest := &WorkManifest{
+		Version:    ManifestVersion,
+		Branch:     work.BranchName,
+		BaseBranch: work.BaseBranch,
+		Name:       work.Name,
+		RootIssue:  work.RootIssueID,
+		Notes:      work.Notes,
+	}
+	for _, id := range beadIDs {
+		manifest.Beads = append(manifest.Beads, ManifestBead{ID: id, Title: titles[id]})
+	}
+	return manifest, nil
+}
+
+// ImportWorkResult reports what ImportWork created and which of the
+// manifest's issues it left out.
+type ImportWorkResult struct {
+	WorkID     string
+	WorkerName string
+	BranchName string
+	BaseBranch string
+	Added      []string          // Issues assigned to the new work
+	Missing    []string          // Issues that don't exist in this project
+	Closed     []string          // Issues that are closed
+	Taken      map[string]string // Issues already in another work, by ID -> work ID
+}
+
+// Skipped reports whether any of the manifest's issues were left out.
+func (r *ImportWorkResult) Skipped() bool {
+	return len(r.Missing) > 0 || len(r.Closed) > 0 || len(r.Taken) > 0
+}
+
+// ImportWork creates a work from a manifest. Issues that no longer exist,
+// are closed, or are already in a work are left out and reported; the work
+// is created with the rest, unless none are left. The branch goes through
+// the same checks as any new work, so a taken name gets a suffix.
+func (s *WorkService) ImportWork(ctx context.Context, m *WorkManifest) (*ImportWorkResult, error) {
+	ids := make([]string, 0, len(m.Beads))
+	seen := make(map[string]bool)
+	for _, b := range m.Beads {
+		if !seen[b.ID] {
+			seen[b.ID] = true
+			ids = append(ids, b.ID)
+		}
+	}
+
+	found, err := s.BeadsReader.GetBeadsWithDeps(ctx, ids)
+	if err != nil {
+		return nil, coerrors.Wrap(coerrors.ExternalTool, fmt.Errorf("failed to get beads: %w", err))
+	}
+	assigned, err := s.DB.GetAllAssignedBeads(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ImportWorkResult{Taken: make(map[string]string)}
+	for _, id := range ids {
+		bead, ok := found.Beads[id]
+		switch {
+		case !ok:
+			result.Missing = append(result.Missing, id)
+		case bead.Status == beads.StatusClosed:
+			result.Closed = append(result.Closed, id)
+		case assigned[id] != "":
+			result.Taken[id] = assigned[id]
+		default:
+			result.Added = append(result.Added, id)
+		}
+	}
+	if len(result.Added) == 0 {
+		return result, coerrors.Errorf(coerrors.Validation, "none of the manifest's %d beads can be assigned", len(ids))
+	}
+
+	// The root issue only carries over if it's part of the new work
+	rootIssue := ""
+	for _, id := range result.Added {
+		if id == m.RootIssue {
+			rootIssue = id
+		}
+	}
+
+	// Keep the manifest's name unless an active work already has it
+	name := m.Name
+	if name != "" {
+		works, err := s.DB.ListWorks(ctx, "")
+		if err != nil {
+			return result, fmt.Errorf("failed to list works: %w", err)
+		}
+		for _, w := range works {
+			if w.Name == name && w.Status != db.StatusCompleted && w.Status != db.StatusFailed {
+				name = ""
+				break
+			}
+		}
+	}
+
+	created, err := s.CreateWorkAsyncWithOptions(ctx, CreateWorkAsyncOptions{
+		BranchName:  m.Branch,
+		BaseBranch:  m.BaseBranch,
+		RootIssueID: rootIssue,
+		BeadIDs:     result.Added,
+		WorkerName:  name,
+		Remote:      s.Config.Remote.Default,
+	})
+	if err != nil {
+		return result, err
+	}
+	result.WorkID = created.WorkID
+	result.WorkerName = created.WorkerName
+	result.BranchName = created.BranchName
+	result.BaseBranch = created.BaseBranch
+
+	if m.Notes != "" {
+		if err := s.DB.SetWorkNotes(ctx, created.WorkID, m.Notes); err != nil {
+			return result, fmt.Errorf("failed to set work notes: %w", err)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,120 @@
+package work_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/require"
+)
+
+// exportTestWork creates a work with four beads and notes in a fresh project
+// and returns its manifest as written to a file
+func exportTestWork(t *testing.T) []byte {
+	t.Helper()
+	ctx := context.Background()
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	for _, id := range []string{"bead-1", "bead-2", "bead-3", "bead-4"} {
+		h.CreateBead(id, "Title of "+id)
+	}
+	created, err := h.WorkService.CreateWorkAsyncWithOptions(ctx, work.CreateWorkAsyncOptions{
+		BranchName:  "feat/login",
+		BaseBranch:  "main",
+		RootIssueID: "bead-1",
+		BeadIDs:     []string{"bead-1", "bead-2", "bead-3", "bead-4"},
+		WorkerName:  "brave_curie",
+	})
+	require.NoError(t, err)
+	require.NoError(t, h.DB.SetWorkNotes(ctx, created.WorkID, "Waiting on the API review"))
+
+	manifest, err := h.WorkService.ExportWork(ctx, created.WorkID)
+	require.NoError(t, err)
+	data, err := manifest.Marshal()
+	require.NoError(t, err)
+	return data
+}
+
+func TestWorkManifest_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	data := exportTestWork(t)
+	require.Contains(t, string(data), "title: Title of bead-3")
+
+	manifest, err := work.ParseManifest(data)
+	require.NoError(t, err)
+	require.Equal(t, work.ManifestVersion, manifest.Version)
+	require.Equal(t, "feat/login", manifest.Branch)
+	require.Len(t, manifest.Beads, 4)
+
+	// A fresh project with the same beads gets the same work
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	for _, id := range []string{"bead-1", "bead-2", "bead-3", "bead-4"} {
+		h.CreateBead(id, "Title of "+id)
+	}
+
+	result, err := h.WorkService.ImportWork(ctx, manifest)
+	require.NoError(t, err)
+	require.False(t, result.Skipped())
+	require.Equal(t, []string{"bead-1", "bead-2", "bead-3", "bead-4"}, result.Added)
+
+	imported, err := h.DB.GetWork(ctx, result.WorkID)
+	require.NoError(t, err)
+	require.Equal(t, "feat/login", imported.BranchName)
+	require.Equal(t, "main", imported.BaseBranch)
+	require.Equal(t, "bead-1", imported.RootIssueID)
+	require.Equal(t, "brave_curie", imported.Name)
+	require.Equal(t, "Waiting on the API review", imported.Notes)
+
+	workBeads, err := h.DB.GetWorkBeads(ctx, result.WorkID)
+	require.NoError(t, err)
+	require.Len(t, workBeads, 4)
+}
+
+func TestWorkManifest_ImportSkipsUnavailableBeads(t *testing.T) {
+	ctx := context.Background()
+	manifest, err := work.ParseManifest(exportTestWork(t))
+	require.NoError(t, err)
+
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	h.CreateBead("bead-1", "Title of bead-1")
+	h.CreateBead("bead-2", "Title of bead-2")
+	h.CreateBead("bead-3", "Title of bead-3").Status = beads.StatusClosed
+	h.CreateWork("w-other", "feat/other")
+	h.AddBeadToWork("w-other", "bead-2")
+
+	result, err := h.WorkService.ImportWork(ctx, manifest)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bead-1"}, result.Added)
+	require.Equal(t, []string{"bead-3"}, result.Closed)
+	require.Equal(t, []string{"bead-4"}, result.Missing)
+	require.Equal(t, map[string]string{"bead-2": "w-other"}, result.Taken)
+	require.True(t, result.Skipped())
+
+	// With nothing left to assign no work is created
+	h.CreateBead("bead-1", "Title of bead-1").Status = beads.StatusClosed
+	result, err = h.WorkService.ImportWork(ctx, manifest)
+	require.Error(t, err)
+	require.Equal(t, coerrors.Validation, coerrors.KindOf(err))
+	require.Empty(t, result.WorkID)
+}
+
+func TestParseManifest_Invalid(t *testing.T) {
+	for name, data := range map[string]string{
+		"no branch":  "version: 1\nbeads:\n  - id: bead-1\n",
+		"no beads":   "version: 1\nbranch: feat/x\n",
+		"bead no id": "version: 1\nbranch: feat/x\nbeads:\n  - title: Something\n",
+		"newer":      "version: 99\nbranch: feat/x\nbeads:\n  - id: bead-1\n",
+		"not yaml":   "branch: [unclosed\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := work.ParseManifest([]byte(data))
+			require.Error(t, err)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/logging"
+	"github.com/newhook/co/internal/process"
 	"github.com/newhook/co/internal/project"
 	"github.com/newhook/co/internal/zellij"
 )
@@ -25,28 +26,102 @@ type OrchestratorManager interface {
 	// SpawnWorkOrchestrator creates a zellij tab and runs the orchestrate command for a work unit.
 	SpawnWorkOrchestrator(ctx context.Context, workID, projName, workDir, friendlyName string, w io.Writer) error
 
+	// SpawnTaskSession creates a zellij tab that runs a single claimed task of a work.
+	SpawnTaskSession(ctx context.Context, workID, taskID, projName, workDir, claimedBy string, w io.Writer) error
+
 	// TerminateWorkTabs terminates all zellij tabs associated with a work unit.
 	TerminateWorkTabs(ctx context.Context, workID, projName string, w io.Writer) error
 
+	// TerminatePlanSessions closes the planning tabs of beads and unregisters their sessions.
+	TerminatePlanSessions(ctx context.Context, beadIDs []string, projName string, w io.Writer) error
+
 	// SpawnPlanSession creates a zellij tab and runs the plan command for a bead.
 	SpawnPlanSession(ctx context.Context, beadID, projName, mainRepoPath string, w io.Writer) error
 
 	// OpenConsole creates a zellij tab with a shell in the work's worktree.
-	OpenConsole(ctx context.Context, workID, projName, workDir, friendlyName string, hooksEnv []string, w io.Writer) error
+	// remoteHost is set for a remote work, whose workDir is on that host.
+	OpenConsole(ctx context.Context, workID, projName, workDir, remoteHost, friendlyName string, hooksEnv []string, w io.Writer) error
 
 	// OpenClaudeSession creates a zellij tab with an interactive Claude Code session.
-	OpenClaudeSession(ctx context.Context, workID, projName, workDir, friendlyName string, hooksEnv []string, cfg *project.Config, w io.Writer) error
+	OpenClaudeSession(ctx context.Context, workID, projName, workDir, remoteHost, friendlyName string, hooksEnv []string, cfg *project.Config, w io.Writer) error
+}
+
+// IsOrchestratorCommand reports whether a process command line is that of
+// the orchestrator SpawnWorkOrchestrator starts for workID, rather than a
+// task it runs in its own tab or an unrelated process that was given a
+// recorded PID after the orchestrator exited.
+func IsOrchestratorCommand(cmdline, workID string) bool {
+	args := strings.Fields(cmdline)
+	orchestrate, work := false, false
+	for i, arg := range args {
+		switch {
+		case arg == "orchestrate":
+			orchestrate = true
+		case arg == "--work" && i+1 < len(args) && args[i+1] == workID, arg == "--work="+workID:
+			work = true
+		case arg == "--task" || strings.HasPrefix(arg, "--task="):
+			return false
+		}
+	}
+	return orchestrate && work
+}
+
+// StopOrchestrator stops a work's orchestrator by the PID it registered with
+// its heartbeat, whether it's running or wedged, and drops its record so a new
+// one can register. The record outlives a dead orchestrator while its PID is
+// in use, so the PID is only signalled while it still runs this work's
+// orchestrator; another process that was given the PID since is left alone.
+// Returns whether an orchestrator was stopped.
+func StopOrchestrator(ctx context.Context, store db.Store, workID string) (bool, error) {
+	proc, err := store.GetOrchestratorProcess(ctx, workID)
+	if err != nil || proc == nil {
+		return false, err
+	}
+	stopped := false
+	if proc.IsRunning() {
+		cmdline, err := process.CommandLine(ctx, proc.PID)
+		if err != nil {
+			return false, err
+		}
+		if IsOrchestratorCommand(cmdline, workID) {
+			if err := process.TerminatePID(proc.PID, 2*time.Second); err != nil {
+				return false, err
+			}
+			stopped = true
+		} else if cmdline != "" {
+			logging.Warn("orchestrator's PID now belongs to another process, not stopping it", "workID", workID, "pid", proc.PID, "command", cmdline)
+		}
+	}
+	if err := store.UnregisterProcess(ctx, proc.ID); err != nil {
+		return stopped, err
+	}
+	return stopped, nil
+}
+
+// SpawnError is the error of a work command whose own steps succeeded but
+// whose orchestrator couldn't be started afterwards. What the spawn printed
+// went to the command's writer.
+type SpawnError struct {
+	Err error
+}
+
+func (e *SpawnError) Error() string {
+	return fmt.Sprintf("failed to ensure orchestrator: %v", e.Err)
+}
+
+func (e *SpawnError) Unwrap() error {
+	return e.Err
 }
 
 // DefaultOrchestratorManager is the default implementation of OrchestratorManager.
 // It holds the database reference needed for orchestrator heartbeat checking.
 type DefaultOrchestratorManager struct {
-	database *db.DB
+	database db.Store
 	zellij   zellij.SessionManager
 }
 
 // NewOrchestratorManager creates a new DefaultOrchestratorManager with the given database.
-func NewOrchestratorManager(database *db.DB) OrchestratorManager {
+func NewOrchestratorManager(database db.Store) OrchestratorManager {
 	return &DefaultOrchestratorManager{
 		database: database,
 		zellij:   zellij.New(),
@@ -55,7 +130,7 @@ func NewOrchestratorManager(database *db.DB) OrchestratorManager {
 
 // NewOrchestratorManagerWithDeps creates a new DefaultOrchestratorManager with explicit dependencies.
 // This is the preferred constructor for testing.
-func NewOrchestratorManagerWithDeps(database *db.DB, zc zellij.SessionManager) OrchestratorManager {
+func NewOrchestratorManagerWithDeps(database db.Store, zc zellij.SessionManager) OrchestratorManager {
 	return &DefaultOrchestratorManager{
 		database: database,
 		zellij:   zc,
@@ -70,7 +145,8 @@ func (m *DefaultOrchestratorManager) tabExists(ctx context.Context, sessionName,
 
 // TerminateWorkTabs terminates all zellij tabs associated with a work unit.
 // This includes the work orchestrator tab (work-<workID>), task tabs (task-<workID>.*),
-// console tabs (console-<workID>*), and claude tabs (claude-<workID>*).
+// console tabs (console-<workID>), and claude tabs (claude-<workID>), each with or
+// without a friendly name suffix.
 // Each tab's running process is terminated with Ctrl+C before the tab is closed.
 // Progress messages are written to the provided writer. Pass io.Discard to suppress output.
 func (m *DefaultOrchestratorManager) TerminateWorkTabs(ctx context.Context, workID string, projectName string, w io.Writer) error {
@@ -107,26 +183,12 @@ func (m *DefaultOrchestratorManager) TerminateWorkTabs(ctx context.Context, work
 		"tab_count", len(tabNames),
 		"tabs", tabNames)
 
-	// Find tabs to terminate
-	// Use prefix matching for all tab types to handle friendly names
-	// Tab names can be "prefix-workID" or "prefix-workID (friendlyName)"
-	workTabPrefix := fmt.Sprintf("work-%s", workID)
-	taskTabPrefix := fmt.Sprintf("task-%s.", workID)
-	consoleTabPrefix := fmt.Sprintf("console-%s", workID)
-	claudeTabPrefix := fmt.Sprintf("claude-%s", workID)
-
+	// Find the work orchestrator, task, console and claude tabs of this
+	// work. Names are matched exactly, so closing w-abc leaves w-abcd alone.
 	var tabsToClose []string
 	for _, tabName := range tabNames {
 		tabName = strings.TrimSpace(tabName)
-		if tabName == "" {
-			continue
-		}
-		// Match work orchestrator tab, task tabs, console tabs, or claude tabs for this work
-		// Use prefix matching for work tabs too since they may include a friendly name suffix
-		if strings.HasPrefix(tabName, workTabPrefix) ||
-			strings.HasPrefix(tabName, taskTabPrefix) ||
-			strings.HasPrefix(tabName, consoleTabPrefix) ||
-			strings.HasPrefix(tabName, claudeTabPrefix) {
+		if tabName != "" && TabWorkID(tabName) == workID {
 			tabsToClose = append(tabsToClose, tabName)
 		}
 	}
@@ -165,6 +227,36 @@ func (m *DefaultOrchestratorManager) TerminateWorkTabs(ctx context.Context, work
 	return nil
 }
 
+// TerminatePlanSessions closes the planning tab ("plan-<bead-id>") of each of
+// the given beads, such as beads that were just closed, and unregisters their
+// plan sessions so they no longer show as active.
+// Progress messages are written to the provided writer. Pass io.Discard to suppress output.
+func (m *DefaultOrchestratorManager) TerminatePlanSessions(ctx context.Context, beadIDs []string, projectName string, w io.Writer) error {
+	sessionName := project.SessionNameForProject(projectName)
+	exists, err := m.zellij.SessionExists(ctx, sessionName)
+	if err != nil {
+		return fmt.Errorf("failed to check session existence: %w", err)
+	}
+
+	session := m.zellij.Session(sessionName)
+	for _, beadID := range beadIDs {
+		if exists {
+			tabName := PlanTabName(beadID)
+			if tabExists, _ := session.TabExists(ctx, tabName); tabExists {
+				if err := session.TerminateAndCloseTab(ctx, tabName); err != nil {
+					fmt.Fprintf(w, "Warning: failed to terminate tab %s: %v\n", tabName, err)
+				} else {
+					fmt.Fprintf(w, "  Terminated tab: %s\n", tabName)
+				}
+			}
+		}
+		if err := m.database.UnregisterPlanSession(ctx, beadID); err != nil {
+			return fmt.Errorf("failed to unregister plan session for %s: %w", beadID, err)
+		}
+	}
+	return nil
+}
+
 // SpawnWorkOrchestrator creates a zellij tab and runs the orchestrate command for a work unit.
 // The tab is named "work-<work-id>" or "work-<work-id> (friendlyName)" for easy identification.
 // The function returns immediately after spawning - the orchestrator runs in the tab.
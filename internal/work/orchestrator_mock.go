@@ -23,18 +23,24 @@ var _ OrchestratorManager = &OrchestratorManagerMock{}
 //			EnsureWorkOrchestratorFunc: func(ctx context.Context, workID string, projName string, workDir string, friendlyName string, w io.Writer) (bool, error) {
 //				panic("mock out the EnsureWorkOrchestrator method")
 //			},
-//			OpenClaudeSessionFunc: func(ctx context.Context, workID string, projName string, workDir string, friendlyName string, hooksEnv []string, cfg *project.Config, w io.Writer) error {
+//			OpenClaudeSessionFunc: func(ctx context.Context, workID string, projName string, workDir string, remoteHost string, friendlyName string, hooksEnv []string, cfg *project.Config, w io.Writer) error {
 //				panic("mock out the OpenClaudeSession method")
 //			},
-//			OpenConsoleFunc: func(ctx context.Context, workID string, projName string, workDir string, friendlyName string, hooksEnv []string, w io.Writer) error {
+//			OpenConsoleFunc: func(ctx context.Context, workID string, projName string, workDir string, remoteHost string, friendlyName string, hooksEnv []string, w io.Writer) error {
 //				panic("mock out the OpenConsole method")
 //			},
 //			SpawnPlanSessionFunc: func(ctx context.Context, beadID string, projName string, mainRepoPath string, w io.Writer) error {
 //				panic("mock out the SpawnPlanSession method")
 //			},
+//			SpawnTaskSessionFunc: func(ctx context.Context, workID string, taskID string, projName string, workDir string, claimedBy string, w io.Writer) error {
+//				panic("mock out the SpawnTaskSession method")
+//			},
 //			SpawnWorkOrchestratorFunc: func(ctx context.Context, workID string, projName string, workDir string, friendlyName string, w io.Writer) error {
 //				panic("mock out the SpawnWorkOrchestrator method")
 //			},
+//			TerminatePlanSessionsFunc: func(ctx context.Context, beadIDs []string, projName string, w io.Writer) error {
+//				panic("mock out the TerminatePlanSessions method")
+//			},
 //			TerminateWorkTabsFunc: func(ctx context.Context, workID string, projName string, w io.Writer) error {
 //				panic("mock out the TerminateWorkTabs method")
 //			},
@@ -49,17 +55,23 @@ type OrchestratorManagerMock struct {
 	EnsureWorkOrchestratorFunc func(ctx context.Context, workID string, projName string, workDir string, friendlyName string, w io.Writer) (bool, error)
 
 	// OpenClaudeSessionFunc mocks the OpenClaudeSession method.
-	OpenClaudeSessionFunc func(ctx context.Context, workID string, projName string, workDir string, friendlyName string, hooksEnv []string, cfg *project.Config, w io.Writer) error
+	OpenClaudeSessionFunc func(ctx context.Context, workID string, projName string, workDir string, remoteHost string, friendlyName string, hooksEnv []string, cfg *project.Config, w io.Writer) error
 
 	// OpenConsoleFunc mocks the OpenConsole method.
-	OpenConsoleFunc func(ctx context.Context, workID string, projName string, workDir string, friendlyName string, hooksEnv []string, w io.Writer) error
+	OpenConsoleFunc func(ctx context.Context, workID string, projName string, workDir string, remoteHost string, friendlyName string, hooksEnv []string, w io.Writer) error
 
 	// SpawnPlanSessionFunc mocks the SpawnPlanSession method.
 	SpawnPlanSessionFunc func(ctx context.Context, beadID string, projName string, mainRepoPath string, w io.Writer) error
 
+	// SpawnTaskSessionFunc mocks the SpawnTaskSession method.
+	SpawnTaskSessionFunc func(ctx context.Context, workID string, taskID string, projName string, workDir string, claimedBy string, w io.Writer) error
+
 	// SpawnWorkOrchestratorFunc mocks the SpawnWorkOrchestrator method.
 	SpawnWorkOrchestratorFunc func(ctx context.Context, workID string, projName string, workDir string, friendlyName string, w io.Writer) error
 
+	// TerminatePlanSessionsFunc mocks the TerminatePlanSessions method.
+	TerminatePlanSessionsFunc func(ctx context.Context, beadIDs []string, projName string, w io.Writer) error
+
 	// TerminateWorkTabsFunc mocks the TerminateWorkTabs method.
 	TerminateWorkTabsFunc func(ctx context.Context, workID string, projName string, w io.Writer) error
 
@@ -90,6 +102,8 @@ type OrchestratorManagerMock struct {
 			ProjName string
 			// WorkDir is the workDir argument value.
 			WorkDir string
+			// RemoteHost is the remoteHost argument value.
+			RemoteHost string
 			// FriendlyName is the friendlyName argument value.
 			FriendlyName string
 			// HooksEnv is the hooksEnv argument value.
@@ -109,6 +123,8 @@ type OrchestratorManagerMock struct {
 			ProjName string
 			// WorkDir is the workDir argument value.
 			WorkDir string
+			// RemoteHost is the remoteHost argument value.
+			RemoteHost string
 			// FriendlyName is the friendlyName argument value.
 			FriendlyName string
 			// HooksEnv is the hooksEnv argument value.
@@ -129,6 +145,23 @@ type OrchestratorManagerMock struct {
 			// W is the w argument value.
 			W io.Writer
 		}
+		// SpawnTaskSession holds details about calls to the SpawnTaskSession method.
+		SpawnTaskSession []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WorkID is the workID argument value.
+			WorkID string
+			// TaskID is the taskID argument value.
+			TaskID string
+			// ProjName is the projName argument value.
+			ProjName string
+			// WorkDir is the workDir argument value.
+			WorkDir string
+			// ClaimedBy is the claimedBy argument value.
+			ClaimedBy string
+			// W is the w argument value.
+			W io.Writer
+		}
 		// SpawnWorkOrchestrator holds details about calls to the SpawnWorkOrchestrator method.
 		SpawnWorkOrchestrator []struct {
 			// Ctx is the ctx argument value.
@@ -144,6 +177,17 @@ type OrchestratorManagerMock struct {
 			// W is the w argument value.
 			W io.Writer
 		}
+		// TerminatePlanSessions holds details about calls to the TerminatePlanSessions method.
+		TerminatePlanSessions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BeadIDs is the beadIDs argument value.
+			BeadIDs []string
+			// ProjName is the projName argument value.
+			ProjName string
+			// W is the w argument value.
+			W io.Writer
+		}
 		// TerminateWorkTabs holds details about calls to the TerminateWorkTabs method.
 		TerminateWorkTabs []struct {
 			// Ctx is the ctx argument value.
@@ -160,7 +204,9 @@ type OrchestratorManagerMock struct {
 	lockOpenClaudeSession      sync.RWMutex
 	lockOpenConsole            sync.RWMutex
 	lockSpawnPlanSession       sync.RWMutex
+	lockSpawnTaskSession       sync.RWMutex
 	lockSpawnWorkOrchestrator  sync.RWMutex
+	lockTerminatePlanSessions  sync.RWMutex
 	lockTerminateWorkTabs      sync.RWMutex
 }
 
@@ -221,12 +267,13 @@ func (mock *OrchestratorManagerMock) EnsureWorkOrchestratorCalls() []struct {
 }
 
 // OpenClaudeSession calls OpenClaudeSessionFunc.
-func (mock *OrchestratorManagerMock) OpenClaudeSession(ctx context.Context, workID string, projName string, workDir string, friendlyName string, hooksEnv []string, cfg *project.Config, w io.Writer) error {
+func (mock *OrchestratorManagerMock) OpenClaudeSession(ctx context.Context, workID string, projName string, workDir string, remoteHost string, friendlyName string, hooksEnv []string, cfg *project.Config, w io.Writer) error {
 	callInfo := struct {
 		Ctx          context.Context
 		WorkID       string
 		ProjName     string
 		WorkDir      string
+		RemoteHost   string
 		FriendlyName string
 		HooksEnv     []string
 		Cfg          *project.Config
@@ -236,6 +283,7 @@ func (mock *OrchestratorManagerMock) OpenClaudeSession(ctx context.Context, work
 		WorkID:       workID,
 		ProjName:     projName,
 		WorkDir:      workDir,
+		RemoteHost:   remoteHost,
 		FriendlyName: friendlyName,
 		HooksEnv:     hooksEnv,
 		Cfg:          cfg,
@@ -250,7 +298,7 @@ func (mock *OrchestratorManagerMock) OpenClaudeSession(ctx context.Context, work
 		)
 		return errOut
 	}
-	return mock.OpenClaudeSessionFunc(ctx, workID, projName, workDir, friendlyName, hooksEnv, cfg, w)
+	return mock.OpenClaudeSessionFunc(ctx, workID, projName, workDir, remoteHost, friendlyName, hooksEnv, cfg, w)
 }
 
 // OpenClaudeSessionCalls gets all the calls that were made to OpenClaudeSession.
@@ -262,6 +310,7 @@ func (mock *OrchestratorManagerMock) OpenClaudeSessionCalls() []struct {
 	WorkID       string
 	ProjName     string
 	WorkDir      string
+	RemoteHost   string
 	FriendlyName string
 	HooksEnv     []string
 	Cfg          *project.Config
@@ -272,6 +321,7 @@ func (mock *OrchestratorManagerMock) OpenClaudeSessionCalls() []struct {
 		WorkID       string
 		ProjName     string
 		WorkDir      string
+		RemoteHost   string
 		FriendlyName string
 		HooksEnv     []string
 		Cfg          *project.Config
@@ -284,12 +334,13 @@ func (mock *OrchestratorManagerMock) OpenClaudeSessionCalls() []struct {
 }
 
 // OpenConsole calls OpenConsoleFunc.
-func (mock *OrchestratorManagerMock) OpenConsole(ctx context.Context, workID string, projName string, workDir string, friendlyName string, hooksEnv []string, w io.Writer) error {
+func (mock *OrchestratorManagerMock) OpenConsole(ctx context.Context, workID string, projName string, workDir string, remoteHost string, friendlyName string, hooksEnv []string, w io.Writer) error {
 	callInfo := struct {
 		Ctx          context.Context
 		WorkID       string
 		ProjName     string
 		WorkDir      string
+		RemoteHost   string
 		FriendlyName string
 		HooksEnv     []string
 		W            io.Writer
@@ -298,6 +349,7 @@ func (mock *OrchestratorManagerMock) OpenConsole(ctx context.Context, workID str
 		WorkID:       workID,
 		ProjName:     projName,
 		WorkDir:      workDir,
+		RemoteHost:   remoteHost,
 		FriendlyName: friendlyName,
 		HooksEnv:     hooksEnv,
 		W:            w,
@@ -311,7 +363,7 @@ func (mock *OrchestratorManagerMock) OpenConsole(ctx context.Context, workID str
 		)
 		return errOut
 	}
-	return mock.OpenConsoleFunc(ctx, workID, projName, workDir, friendlyName, hooksEnv, w)
+	return mock.OpenConsoleFunc(ctx, workID, projName, workDir, remoteHost, friendlyName, hooksEnv, w)
 }
 
 // OpenConsoleCalls gets all the calls that were made to OpenConsole.
@@ -323,6 +375,7 @@ func (mock *OrchestratorManagerMock) OpenConsoleCalls() []struct {
 	WorkID       string
 	ProjName     string
 	WorkDir      string
+	RemoteHost   string
 	FriendlyName string
 	HooksEnv     []string
 	W            io.Writer
@@ -332,6 +385,7 @@ func (mock *OrchestratorManagerMock) OpenConsoleCalls() []struct {
 		WorkID       string
 		ProjName     string
 		WorkDir      string
+		RemoteHost   string
 		FriendlyName string
 		HooksEnv     []string
 		W            io.Writer
@@ -393,6 +447,65 @@ func (mock *OrchestratorManagerMock) SpawnPlanSessionCalls() []struct {
 	return calls
 }
 
+// SpawnTaskSession calls SpawnTaskSessionFunc.
+func (mock *OrchestratorManagerMock) SpawnTaskSession(ctx context.Context, workID string, taskID string, projName string, workDir string, claimedBy string, w io.Writer) error {
+	callInfo := struct {
+		Ctx       context.Context
+		WorkID    string
+		TaskID    string
+		ProjName  string
+		WorkDir   string
+		ClaimedBy string
+		W         io.Writer
+	}{
+		Ctx:       ctx,
+		WorkID:    workID,
+		TaskID:    taskID,
+		ProjName:  projName,
+		WorkDir:   workDir,
+		ClaimedBy: claimedBy,
+		W:         w,
+	}
+	mock.lockSpawnTaskSession.Lock()
+	mock.calls.SpawnTaskSession = append(mock.calls.SpawnTaskSession, callInfo)
+	mock.lockSpawnTaskSession.Unlock()
+	if mock.SpawnTaskSessionFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.SpawnTaskSessionFunc(ctx, workID, taskID, projName, workDir, claimedBy, w)
+}
+
+// SpawnTaskSessionCalls gets all the calls that were made to SpawnTaskSession.
+// Check the length with:
+//
+//	len(mockedOrchestratorManager.SpawnTaskSessionCalls())
+func (mock *OrchestratorManagerMock) SpawnTaskSessionCalls() []struct {
+	Ctx       context.Context
+	WorkID    string
+	TaskID    string
+	ProjName  string
+	WorkDir   string
+	ClaimedBy string
+	W         io.Writer
+} {
+	var calls []struct {
+		Ctx       context.Context
+		WorkID    string
+		TaskID    string
+		ProjName  string
+		WorkDir   string
+		ClaimedBy string
+		W         io.Writer
+	}
+	mock.lockSpawnTaskSession.RLock()
+	calls = mock.calls.SpawnTaskSession
+	mock.lockSpawnTaskSession.RUnlock()
+	return calls
+}
+
 // SpawnWorkOrchestrator calls SpawnWorkOrchestratorFunc.
 func (mock *OrchestratorManagerMock) SpawnWorkOrchestrator(ctx context.Context, workID string, projName string, workDir string, friendlyName string, w io.Writer) error {
 	callInfo := struct {
@@ -448,6 +561,53 @@ func (mock *OrchestratorManagerMock) SpawnWorkOrchestratorCalls() []struct {
 	return calls
 }
 
+// TerminatePlanSessions calls TerminatePlanSessionsFunc.
+func (mock *OrchestratorManagerMock) TerminatePlanSessions(ctx context.Context, beadIDs []string, projName string, w io.Writer) error {
+	callInfo := struct {
+		Ctx      context.Context
+		BeadIDs  []string
+		ProjName string
+		W        io.Writer
+	}{
+		Ctx:      ctx,
+		BeadIDs:  beadIDs,
+		ProjName: projName,
+		W:        w,
+	}
+	mock.lockTerminatePlanSessions.Lock()
+	mock.calls.TerminatePlanSessions = append(mock.calls.TerminatePlanSessions, callInfo)
+	mock.lockTerminatePlanSessions.Unlock()
+	if mock.TerminatePlanSessionsFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.TerminatePlanSessionsFunc(ctx, beadIDs, projName, w)
+}
+
+// TerminatePlanSessionsCalls gets all the calls that were made to TerminatePlanSessions.
+// Check the length with:
+//
+//	len(mockedOrchestratorManager.TerminatePlanSessionsCalls())
+func (mock *OrchestratorManagerMock) TerminatePlanSessionsCalls() []struct {
+	Ctx      context.Context
+	BeadIDs  []string
+	ProjName string
+	W        io.Writer
+} {
+	var calls []struct {
+		Ctx      context.Context
+		BeadIDs  []string
+		ProjName string
+		W        io.Writer
+	}
+	mock.lockTerminatePlanSessions.RLock()
+	calls = mock.calls.TerminatePlanSessions
+	mock.lockTerminatePlanSessions.RUnlock()
+	return calls
+}
+
 // TerminateWorkTabs calls TerminateWorkTabsFunc.
 func (mock *OrchestratorManagerMock) TerminateWorkTabs(ctx context.Context, workID string, projName string, w io.Writer) error {
 	callInfo := struct {
@@ -0,0 +1,20 @@
+package work_test
+
+import (
+	"testing"
+
+	"github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsOrchestratorCommand(t *testing.T) {
+	assert.True(t, work.IsOrchestratorCommand("co orchestrate --work w-abc", "w-abc"))
+	assert.True(t, work.IsOrchestratorCommand("/usr/local/bin/co orchestrate --work=w-abc", "w-abc"))
+
+	// Another work's orchestrator, a task run in its own tab, or a process
+	// that was given the PID since
+	assert.False(t, work.IsOrchestratorCommand("co orchestrate --work w-abcd", "w-abc"))
+	assert.False(t, work.IsOrchestratorCommand("co orchestrate --work w-abc --task w-abc.1 --claimed-by x", "w-abc"))
+	assert.False(t, work.IsOrchestratorCommand("vim --work w-abc", "w-abc"))
+	assert.False(t, work.IsOrchestratorCommand("", "w-abc"))
+}
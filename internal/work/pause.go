@@ -0,0 +1,54 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/newhook/co/internal/coerrors"
+)
+
+// PauseWork stops a work's orchestrator from claiming new tasks. A task that
+// is already running is left to finish.
+func (s *WorkService) PauseWork(ctx context.Context, workID string) error {
+	work, err := s.DB.GetWork(ctx, workID)
+	if err != nil {
+		return fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
+	}
+	if work.Paused {
+		return fmt.Errorf("work %s is already paused", workID)
+	}
+	return s.DB.SetWorkPaused(ctx, workID, true)
+}
+
+// ResumeWork lets a paused work's orchestrator pick up tasks again and makes
+// sure the orchestrator is running. It returns whether a new orchestrator was spawned.
+// Progress messages are written to the provided writer. Pass io.Discard to suppress output.
+func (s *WorkService) ResumeWork(ctx context.Context, workID string, w io.Writer) (bool, error) {
+	work, err := s.DB.GetWork(ctx, workID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return false, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
+	}
+	if !work.Paused {
+		return false, fmt.Errorf("work %s is not paused", workID)
+	}
+	if err := s.DB.SetWorkPaused(ctx, workID, false); err != nil {
+		return false, err
+	}
+
+	// The worktree is created asynchronously; without it there is nothing to run yet
+	if work.WorktreePath == "" {
+		return false, nil
+	}
+	spawned, err := s.OrchestratorManager.EnsureWorkOrchestrator(ctx, workID, s.Config.Project.Name, work.SessionDir(s.ProjectRoot), work.Name, w)
+	if err != nil {
+		return false, &SpawnError{Err: err}
+	}
+	return spawned, nil
+}
@@ -0,0 +1,45 @@
+package work_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/newhook/co/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPauseAndResumeWork(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.CreateWork("w-test", "feat/test-branch")
+
+	require.NoError(t, h.WorkService.PauseWork(ctx, "w-test"))
+	work, err := h.DB.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.True(t, work.Paused)
+	assert.Empty(t, h.OrchestratorManager.EnsureWorkOrchestratorCalls())
+
+	// Pausing twice is an error
+	require.ErrorContains(t, h.WorkService.PauseWork(ctx, "w-test"), "already paused")
+
+	spawned, err := h.WorkService.ResumeWork(ctx, "w-test", io.Discard)
+	require.NoError(t, err)
+	assert.True(t, spawned)
+	work, err = h.DB.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.False(t, work.Paused)
+
+	// Resuming makes sure the orchestrator is running
+	calls := h.OrchestratorManager.EnsureWorkOrchestratorCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "w-test", calls[0].WorkID)
+
+	_, err = h.WorkService.ResumeWork(ctx, "w-test", io.Discard)
+	require.ErrorContains(t, err, "not paused")
+
+	require.ErrorContains(t, h.WorkService.PauseWork(ctx, "w-missing"), "not found")
+}
@@ -0,0 +1,92 @@
+package work
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Step is one step of a Pipeline.
+type Step struct {
+	// Name says what the step does, as in "add the issues"; failures are
+	// reported as "failed to <name>".
+	Name string
+	// Run does the step.
+	Run func(ctx context.Context) error
+	// Rollback undoes what Run did, once a later step fails. Nil means the
+	// step leaves nothing behind that needs undoing.
+	Rollback func(ctx context.Context) error
+}
+
+// Pipeline runs steps in order and, when one fails, undoes the ones that
+// completed, newest first, so a failure doesn't leave half of something.
+type Pipeline struct {
+	Steps []Step
+	// KeepPartial leaves what the completed steps did in place when a later
+	// step fails, rather than rolling it back.
+	KeepPartial bool
+}
+
+// PipelineError is the error of a Pipeline whose step failed. It says what
+// was undone and what is still in place.
+type PipelineError struct {
+	Step       string   // Name of the step that failed
+	Err        error    // Why it failed
+	RolledBack []string // Names of the completed steps that were undone
+	Kept       []string // Names of the completed steps whose work is still in place
+	// RollbackErrs are the rollbacks that failed; their steps are in Kept.
+	RollbackErrs []error
+}
+
+func (e *PipelineError) Error() string {
+	msg := fmt.Sprintf("failed to %s: %v", e.Step, e.Err)
+	if len(e.RolledBack) > 0 {
+		msg += fmt.Sprintf("; undone: %s", strings.Join(e.RolledBack, ", "))
+	}
+	switch {
+	case len(e.Kept) > 0:
+		msg += fmt.Sprintf("; still in place: %s", strings.Join(e.Kept, ", "))
+	case len(e.RolledBack) > 0:
+		msg += "; nothing was left behind"
+	}
+	if len(e.RollbackErrs) > 0 {
+		msg += fmt.Sprintf(" (%v)", errors.Join(e.RollbackErrs...))
+	}
+	return msg
+}
+
+func (e *PipelineError) Unwrap() error {
+	return e.Err
+}
+
+// Run runs the steps in order. When one fails, it rolls back the completed
+// steps, unless KeepPartial is set, and returns a *PipelineError. Rollbacks
+// run even when ctx is cancelled, which is often why the step failed.
+func (p *Pipeline) Run(ctx context.Context) error {
+	for i, step := range p.Steps {
+		err := step.Run(ctx)
+		if err == nil {
+			continue
+		}
+		pErr := &PipelineError{Step: step.Name, Err: err}
+		rollbackCtx := context.WithoutCancel(ctx)
+		for j := i - 1; j >= 0; j-- {
+			done := p.Steps[j]
+			switch {
+			case done.Rollback == nil:
+			case p.KeepPartial:
+				pErr.Kept = append(pErr.Kept, done.Name)
+			default:
+				if rbErr := done.Rollback(rollbackCtx); rbErr != nil {
+					pErr.Kept = append(pErr.Kept, done.Name)
+					pErr.RollbackErrs = append(pErr.RollbackErrs, fmt.Errorf("failed to undo %s: %w", done.Name, rbErr))
+					continue
+				}
+				pErr.RolledBack = append(pErr.RolledBack, done.Name)
+			}
+		}
+		return pErr
+	}
+	return nil
+}
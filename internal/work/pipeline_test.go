@@ -0,0 +1,214 @@
+package work_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingSteps returns n steps named "step <i>" that record their runs and
+// rollbacks in log, with step fail failing
+func recordingSteps(n, fail int, log *[]string) []work.Step {
+	var steps []work.Step
+	for i := range n {
+		name := fmt.Sprintf("step %d", i)
+		steps = append(steps, work.Step{
+			Name: name,
+			Run: func(ctx context.Context) error {
+				if i == fail {
+					return errors.New("boom")
+				}
+				*log = append(*log, "run "+name)
+				return nil
+			},
+			Rollback: func(ctx context.Context) error {
+				*log = append(*log, "undo "+name)
+				return nil
+			},
+		})
+	}
+	return steps
+}
+
+func TestPipeline(t *testing.T) {
+	ctx := context.Background()
+
+	var log []string
+	require.NoError(t, (&work.Pipeline{Steps: recordingSteps(3, -1, &log)}).Run(ctx))
+	require.Equal(t, []string{"run step 0", "run step 1", "run step 2"}, log)
+
+	// A failure at each step undoes the ones before it, newest first
+	for fail := range 3 {
+		log = nil
+		err := (&work.Pipeline{Steps: recordingSteps(3, fail, &log)}).Run(ctx)
+		var pErr *work.PipelineError
+		require.ErrorAs(t, err, &pErr)
+		require.Equal(t, fmt.Sprintf("step %d", fail), pErr.Step)
+		require.Len(t, pErr.RolledBack, fail)
+		require.Empty(t, pErr.Kept)
+		require.Len(t, log, 2*fail)
+		for i := range fail {
+			require.Equal(t, fmt.Sprintf("undo step %d", fail-1-i), log[fail+i])
+		}
+	}
+
+	log = nil
+	err := (&work.Pipeline{Steps: recordingSteps(3, 2, &log)}).Run(ctx)
+	require.EqualError(t, err, "failed to step 2: boom; undone: step 1, step 0; nothing was left behind")
+
+	// Kept partial, nothing is undone and the error says what is left
+	log = nil
+	err = (&work.Pipeline{Steps: recordingSteps(3, 2, &log), KeepPartial: true}).Run(ctx)
+	require.EqualError(t, err, "failed to step 2: boom; still in place: step 1, step 0")
+	require.Equal(t, []string{"run step 0", "run step 1"}, log)
+
+	// A rollback that fails leaves its step in place; the others are still undone
+	log = nil
+	steps := recordingSteps(3, 2, &log)
+	steps[1].Rollback = func(ctx context.Context) error { return errors.New("stuck") }
+	steps[0].Rollback = nil // Nothing to undo
+	err = (&work.Pipeline{Steps: steps}).Run(ctx)
+	var pErr *work.PipelineError
+	require.ErrorAs(t, err, &pErr)
+	require.Empty(t, pErr.RolledBack)
+	require.Equal(t, []string{"step 1"}, pErr.Kept)
+	require.ErrorContains(t, err, "failed to undo step 1: stuck")
+}
+
+func TestPipeline_RollbackOutlivesCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	undone := false
+	err := (&work.Pipeline{Steps: []work.Step{
+		{
+			Name: "start",
+			Run:  func(ctx context.Context) error { return nil },
+			Rollback: func(ctx context.Context) error {
+				undone = ctx.Err() == nil
+				return nil
+			},
+		},
+		{
+			Name: "wait",
+			Run: func(ctx context.Context) error {
+				cancel()
+				return ctx.Err()
+			},
+		},
+	}}).Run(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.True(t, undone)
+}
+
+func TestCreateAndStartWork(t *testing.T) {
+	ctx := context.Background()
+	create := work.CreateWorkAsyncOptions{
+		BranchName:  "feat/x",
+		BaseBranch:  "main",
+		RootIssueID: "bead-1",
+		BeadIDs:     []string{"bead-1"},
+	}
+	newHarness := func(t *testing.T) *testutil.TestHarness {
+		h := testutil.NewTestHarness(t)
+		t.Cleanup(h.Cleanup)
+		h.CreateBead("bead-1", "Fix login")
+		h.CreateBead("bead-2", "Add logout")
+		return h
+	}
+	// activeWorks returns the IDs of the works in the database
+	activeWorks := func(t *testing.T, h *testutil.TestHarness) []string {
+		works, err := h.DB.ListWorks(ctx, "")
+		require.NoError(t, err)
+		var ids []string
+		for _, w := range works {
+			ids = append(ids, w.ID)
+		}
+		return ids
+	}
+	workBeads := func(t *testing.T, h *testutil.TestHarness, workID string) []string {
+		beads, err := h.DB.GetWorkBeads(ctx, workID)
+		require.NoError(t, err)
+		var ids []string
+		for _, b := range beads {
+			ids = append(ids, b.BeadID)
+		}
+		return ids
+	}
+
+	t.Run("all steps", func(t *testing.T) {
+		h := newHarness(t)
+		started := false
+		result, err := h.WorkService.CreateAndStartWork(ctx, create, work.StartWorkOptions{
+			AdditionalBeadIDs: []string{"bead-1", "bead-2", "bead-2"},
+			Start:             func(ctx context.Context) error { started = true; return nil },
+		})
+		require.NoError(t, err)
+		require.True(t, started)
+		require.ElementsMatch(t, []string{"bead-1", "bead-2"}, workBeads(t, h, result.WorkID))
+	})
+
+	t.Run("create fails", func(t *testing.T) {
+		h := newHarness(t)
+		remote := create
+		remote.Remote = true // No [remote] host is configured
+		result, err := h.WorkService.CreateAndStartWork(ctx, remote, work.StartWorkOptions{
+			Start: func(ctx context.Context) error { t.Fatal("started a work that wasn't created"); return nil },
+		})
+		require.Nil(t, result)
+		require.ErrorContains(t, err, "failed to create the work")
+		require.Empty(t, activeWorks(t, h))
+	})
+
+	t.Run("adding issues fails", func(t *testing.T) {
+		h := newHarness(t)
+		h.CreateWork("w-other", "feat/other")
+		h.AddBeadToWork("w-other", "bead-2")
+
+		result, err := h.WorkService.CreateAndStartWork(ctx, create, work.StartWorkOptions{
+			AdditionalBeadIDs: []string{"bead-2"},
+		})
+		require.Nil(t, result)
+		var pErr *work.PipelineError
+		require.ErrorAs(t, err, &pErr)
+		require.Equal(t, "add the issues", pErr.Step)
+		require.Equal(t, []string{"create the work"}, pErr.RolledBack)
+		require.Equal(t, []string{"w-other"}, activeWorks(t, h), "the new work is removed again")
+	})
+
+	t.Run("starting fails", func(t *testing.T) {
+		h := newHarness(t)
+		result, err := h.WorkService.CreateAndStartWork(ctx, create, work.StartWorkOptions{
+			AdditionalBeadIDs: []string{"bead-2"},
+			Start:             func(ctx context.Context) error { return errors.New("no zellij") },
+		})
+		require.Nil(t, result)
+		require.EqualError(t, err, "failed to start the work: no zellij; undone: add the issues, create the work; nothing was left behind")
+		require.Empty(t, activeWorks(t, h))
+
+		// The issues are free for the next try
+		owners, err := h.DB.GetActiveWorksForBeads(ctx, []string{"bead-1", "bead-2"})
+		require.NoError(t, err)
+		require.Empty(t, owners)
+	})
+
+	t.Run("starting fails, partial work kept", func(t *testing.T) {
+		h := newHarness(t)
+		result, err := h.WorkService.CreateAndStartWork(ctx, create, work.StartWorkOptions{
+			AdditionalBeadIDs: []string{"bead-2"},
+			Start:             func(ctx context.Context) error { return errors.New("no zellij") },
+			KeepPartial:       true,
+		})
+		require.ErrorContains(t, err, "still in place: add the issues, create the work")
+		require.NotNil(t, result)
+		require.Equal(t, []string{result.WorkID}, activeWorks(t, h))
+		require.ElementsMatch(t, []string{"bead-1", "bead-2"}, workBeads(t, h, result.WorkID))
+		w, err := h.DB.GetWork(ctx, result.WorkID)
+		require.NoError(t, err)
+		require.Equal(t, db.StatusPending, w.Status)
+	})
+}
@@ -0,0 +1,223 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/task"
+)
+
+// PlanBead is a bead in a proposed task.
+type PlanBead struct {
+	ID         string
+	Title      string
+	Complexity int // Estimated complexity score (0 if not estimated)
+}
+
+// PlanGroup is a proposed implement task and the beads it covers.
+type PlanGroup struct {
+	Name  string // Task title, stored as the task's "title" metadata; empty for none
+	Beads []PlanBead
+}
+
+// BeadIDs returns the IDs of the group's beads.
+func (g PlanGroup) BeadIDs() []string {
+	ids := make([]string, len(g.Beads))
+	for i, b := range g.Beads {
+		ids[i] = b.ID
+	}
+	return ids
+}
+
+// Complexity returns the sum of the group's bead complexity estimates.
+func (g PlanGroup) Complexity() int {
+	total := 0
+	for _, b := range g.Beads {
+		total += b.Complexity
+	}
+	return total
+}
+
+// PlanResult is a proposed grouping of a work's unassigned beads into
+// implement tasks. PreviewPlan returns one without persisting anything, the
+// plan can be edited, and ApplyPlan turns it into tasks.
+type PlanResult struct {
+	WorkID string
+	Groups []PlanGroup
+}
+
+// NewPlanResult builds a plan from the task groups a task.Planner returned.
+// Bead titles are looked up in beadsByID when the planner didn't include them.
+func NewPlanResult(workID string, planned []task.Task, beadsByID map[string]beads.Bead) *PlanResult {
+	plan := &PlanResult{WorkID: workID}
+	for _, t := range planned {
+		if len(t.BeadIDs) == 0 {
+			continue
+		}
+		var group PlanGroup
+		for _, id := range t.BeadIDs {
+			group.Beads = append(group.Beads, PlanBead{
+				ID:         id,
+				Title:      beadsByID[id].Title,
+				Complexity: t.BeadComplexity[id],
+			})
+		}
+		plan.Groups = append(plan.Groups, group)
+	}
+	return plan
+}
+
+// MoveBead moves a bead from one group to another. Moving it to
+// len(p.Groups) puts it in a new group of its own; a group left empty is
+// removed.
+func (p *PlanResult) MoveBead(from, beadIdx, to int) error {
+	if from < 0 || from >= len(p.Groups) || beadIdx < 0 || beadIdx >= len(p.Groups[from].Beads) {
+		return coerrors.Errorf(coerrors.Validation, "no bead %d in group %d", beadIdx, from)
+	}
+	if to < 0 || to > len(p.Groups) {
+		return coerrors.Errorf(coerrors.Validation, "no group %d", to)
+	}
+	if to == from {
+		return nil
+	}
+	bead := p.Groups[from].Beads[beadIdx]
+	if to == len(p.Groups) {
+		p.Groups = append(p.Groups, PlanGroup{})
+	}
+	p.Groups[to].Beads = append(p.Groups[to].Beads, bead)
+	p.Groups[from].Beads = slices.Delete(p.Groups[from].Beads, beadIdx, beadIdx+1)
+	if len(p.Groups[from].Beads) == 0 {
+		p.Groups = slices.Delete(p.Groups, from, from+1)
+	}
+	return nil
+}
+
+// MergeGroups folds group i+1 into group i. The merged group keeps group i's name.
+func (p *PlanResult) MergeGroups(i int) error {
+	if i < 0 || i+1 >= len(p.Groups) {
+		return coerrors.Errorf(coerrors.Validation, "no group after group %d to merge", i)
+	}
+	p.Groups[i].Beads = append(p.Groups[i].Beads, p.Groups[i+1].Beads...)
+	p.Groups = slices.Delete(p.Groups, i+1, i+2)
+	return nil
+}
+
+// SplitGroup moves the beads of group i from beadIdx on into a new group
+// right after it.
+func (p *PlanResult) SplitGroup(i, beadIdx int) error {
+	if i < 0 || i >= len(p.Groups) {
+		return coerrors.Errorf(coerrors.Validation, "no group %d", i)
+	}
+	if beadIdx <= 0 || beadIdx >= len(p.Groups[i].Beads) {
+		return coerrors.Errorf(coerrors.Validation, "group %d can't be split before bead %d", i, beadIdx)
+	}
+	rest := slices.Clone(p.Groups[i].Beads[beadIdx:])
+	p.Groups[i].Beads = p.Groups[i].Beads[:beadIdx]
+	p.Groups = slices.Insert(p.Groups, i+1, PlanGroup{Beads: rest})
+	return nil
+}
+
+// PreviewPlan is the dry run of RunWorkWithOptions: it groups the work's
+// unassigned beads into tasks the same way, but creates no tasks and spawns
+// no orchestrator. With opts.UsePlan, complexity estimates may still be cached.
+// Progress messages are written to the provided writer. Pass io.Discard to suppress output.
+func (s *WorkService) PreviewPlan(ctx context.Context, workID string, opts RunWorkOptions, w io.Writer) (*PlanResult, error) {
+	work, err := s.DB.GetWork(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
+	}
+	return s.planWorkBeads(ctx, workID, opts.UsePlan, opts.ForceEstimate, w)
+}
+
+// ApplyPlan creates the tasks of a previewed (and possibly edited) plan and
+// ensures an orchestrator is running. It fails without creating anything if
+// any of the plan's beads has been assigned to a task since the preview.
+// Progress messages are written to the provided writer. Pass io.Discard to suppress output.
+func (s *WorkService) ApplyPlan(ctx context.Context, plan *PlanResult, w io.Writer) (*RunWorkResult, error) {
+	work, err := s.DB.GetWork(ctx, plan.WorkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", plan.WorkID)
+	}
+	if work.WorktreePath == "" {
+		return nil, fmt.Errorf("work %s has no worktree path configured", work.ID)
+	}
+	if !work.IsRemote() && !s.Worktree.ExistsPath(work.WorktreePath) {
+		return nil, fmt.Errorf("work %s worktree does not exist at %s", work.ID, work.WorktreePath)
+	}
+
+	unassigned, err := s.DB.GetUnassignedWorkBeads(ctx, plan.WorkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unassigned beads: %w", err)
+	}
+	available := make(map[string]bool, len(unassigned))
+	for _, wb := range unassigned {
+		available[wb.BeadID] = true
+	}
+	for _, g := range plan.Groups {
+		for _, b := range g.Beads {
+			if !available[b.ID] {
+				return nil, fmt.Errorf("bead %s is no longer unassigned in work %s; preview the plan again", b.ID, plan.WorkID)
+			}
+			delete(available, b.ID) // a bead can only be planned once
+		}
+	}
+
+	taskIDs, err := s.createPlannedTasks(ctx, plan, w)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tasks: %w", err)
+	}
+
+	spawned, err := s.OrchestratorManager.EnsureWorkOrchestrator(ctx, work.ID, s.Config.Project.Name, work.SessionDir(s.ProjectRoot), work.Name, w)
+	if err != nil {
+		return nil, &SpawnError{Err: err}
+	}
+
+	return &RunWorkResult{
+		WorkID:              work.ID,
+		TasksCreated:        len(taskIDs),
+		TaskIDs:             taskIDs,
+		OrchestratorSpawned: spawned,
+	}, nil
+}
+
+// createPlannedTasks creates an implement task for each non-empty group of the plan.
+// Returns the IDs of the tasks created.
+func (s *WorkService) createPlannedTasks(ctx context.Context, plan *PlanResult, w io.Writer) ([]string, error) {
+	var taskIDs []string
+	for _, group := range plan.Groups {
+		if len(group.Beads) == 0 {
+			continue
+		}
+
+		// Get next task number
+		taskNum, err := s.DB.GetNextTaskNumber(ctx, plan.WorkID)
+		if err != nil {
+			return taskIDs, fmt.Errorf("failed to get next task number: %w", err)
+		}
+
+		taskID := fmt.Sprintf("%s.%d", plan.WorkID, taskNum)
+		if err := s.DB.CreateTask(ctx, taskID, db.TaskTypeImplement, group.BeadIDs(), group.Complexity(), plan.WorkID); err != nil {
+			return taskIDs, fmt.Errorf("failed to create task: %w", err)
+		}
+		if group.Name != "" {
+			if err := s.DB.SetTaskMetadata(ctx, taskID, "title", group.Name); err != nil {
+				return taskIDs, fmt.Errorf("failed to set title of task %s: %w", taskID, err)
+			}
+		}
+
+		fmt.Fprintf(w, "  Created task %s with %d bead(s)\n", taskID, len(group.Beads))
+		taskIDs = append(taskIDs, taskID)
+	}
+	return taskIDs, nil
+}
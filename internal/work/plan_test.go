@@ -0,0 +1,110 @@
+package work_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/task"
+	"github.com/newhook/co/internal/testutil"
+	workpkg "github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// beadIDGroups lists the bead IDs of each group of a plan
+func beadIDGroups(plan *workpkg.PlanResult) [][]string {
+	var groups [][]string
+	for _, g := range plan.Groups {
+		groups = append(groups, g.BeadIDs())
+	}
+	return groups
+}
+
+func TestPlanResultEdits(t *testing.T) {
+	newPlan := func() *workpkg.PlanResult {
+		return workpkg.NewPlanResult("w-test", []task.Task{
+			{BeadIDs: []string{"a", "b", "c"}, BeadComplexity: map[string]int{"a": 2, "b": 3, "c": 1}},
+			{BeadIDs: []string{"d"}, BeadComplexity: map[string]int{"d": 5}},
+		}, map[string]beads.Bead{"a": {ID: "a", Title: "Bead A"}})
+	}
+
+	plan := newPlan()
+	assert.Equal(t, "Bead A", plan.Groups[0].Beads[0].Title)
+	assert.Equal(t, 6, plan.Groups[0].Complexity())
+
+	// Moving the last bead out of a group removes the group
+	require.NoError(t, plan.MoveBead(1, 0, 0))
+	assert.Equal(t, [][]string{{"a", "b", "c", "d"}}, beadIDGroups(plan))
+
+	// Moving past the last group starts a new one
+	require.NoError(t, plan.MoveBead(0, 1, 1))
+	assert.Equal(t, [][]string{{"a", "c", "d"}, {"b"}}, beadIDGroups(plan))
+
+	require.NoError(t, plan.SplitGroup(0, 2))
+	assert.Equal(t, [][]string{{"a", "c"}, {"d"}, {"b"}}, beadIDGroups(plan))
+	require.Error(t, plan.SplitGroup(1, 0), "a split must leave beads on both sides")
+
+	plan.Groups[1].Name = "Backend"
+	require.NoError(t, plan.MergeGroups(1))
+	assert.Equal(t, [][]string{{"a", "c"}, {"d", "b"}}, beadIDGroups(plan))
+	assert.Equal(t, "Backend", plan.Groups[1].Name)
+	assert.Equal(t, 8, plan.Groups[1].Complexity())
+	require.Error(t, plan.MergeGroups(1), "the last group has nothing to merge with")
+}
+
+func TestPreviewAndApplyPlan(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.CreateBead("bead-1", "Feature A")
+	h.CreateBead("bead-2", "Feature B")
+	h.CreateBead("bead-3", "Feature C")
+	workRecord := h.CreateWork("w-test", "feat/test-branch")
+	h.AddBeadToWork("w-test", "bead-1")
+	h.AddBeadToWork("w-test", "bead-2")
+	h.AddBeadToWork("w-test", "bead-3")
+	h.Worktree.ExistsPathFunc = func(worktreePath string) bool {
+		return worktreePath == workRecord.WorktreePath
+	}
+	h.TaskPlanner.PlanFunc = func(ctx context.Context, beadList []beads.Bead, dependencies map[string][]beads.Dependency, budget int) ([]task.Task, error) {
+		return []task.Task{
+			{BeadIDs: []string{"bead-1", "bead-2"}, BeadComplexity: map[string]int{"bead-1": 2, "bead-2": 3}},
+			{BeadIDs: []string{"bead-3"}, BeadComplexity: map[string]int{"bead-3": 4}},
+		}, nil
+	}
+
+	// The preview creates nothing
+	plan, err := h.WorkService.PreviewPlan(ctx, "w-test", workpkg.RunWorkOptions{UsePlan: true}, io.Discard)
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"bead-1", "bead-2"}, {"bead-3"}}, beadIDGroups(plan))
+	assert.Equal(t, "Feature A", plan.Groups[0].Beads[0].Title)
+	tasks, err := h.DB.GetWorkTasks(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Empty(t, tasks)
+	assert.Empty(t, h.OrchestratorManager.EnsureWorkOrchestratorCalls())
+
+	// The edited plan is what gets created
+	require.NoError(t, plan.MoveBead(0, 1, 1))
+	plan.Groups[1].Name = "Feature B and C"
+	result, err := h.WorkService.ApplyPlan(ctx, plan, io.Discard)
+	require.NoError(t, err)
+	require.Len(t, result.TaskIDs, 2)
+	assert.Len(t, h.OrchestratorManager.EnsureWorkOrchestratorCalls(), 1)
+
+	second, err := h.DB.GetTask(ctx, result.TaskIDs[1])
+	require.NoError(t, err)
+	assert.Equal(t, 7, second.ComplexityBudget)
+	secondBeads, err := h.DB.GetTaskBeads(ctx, result.TaskIDs[1])
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"bead-2", "bead-3"}, secondBeads)
+	title, err := h.DB.GetTaskMetadata(ctx, result.TaskIDs[1], "title")
+	require.NoError(t, err)
+	assert.Equal(t, "Feature B and C", title)
+
+	// Applying again fails: the beads now belong to tasks
+	_, err = h.WorkService.ApplyPlan(ctx, plan, io.Discard)
+	require.ErrorContains(t, err, "no longer unassigned")
+}
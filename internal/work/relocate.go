@@ -0,0 +1,166 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/worktree"
+)
+
+// DefaultWorktreePath returns where a work's worktree is created:
+// <project>/<work-id>/tree.
+func DefaultWorktreePath(projectRoot, workID string) string {
+	return filepath.Join(projectRoot, workID, "tree")
+}
+
+// RelocateOptions says how RelocateWorktree repairs a work whose worktree is
+// gone from its recorded path. Exactly one of them is set.
+type RelocateOptions struct {
+	Recreate bool   // Check the branch out again at the default location
+	Path     string // Directory the worktree was moved to
+}
+
+// RelocateResult reports where a relocated work's worktree is now.
+type RelocateResult struct {
+	OldPath       string
+	NewPath       string
+	Recreated     bool     // The worktree was checked out again rather than found
+	SetupWarnings []string // Problems copying files or running post_create in a recreated worktree
+}
+
+// RelocateWorktree repairs a work whose worktree directory no longer exists,
+// e.g. because the repository was moved. With opts.Path it records the
+// directory the worktree was moved to, after checking that it is a worktree
+// of the repository with the work's branch checked out. With opts.Recreate it
+// checks the branch out again at DefaultWorktreePath, refusing to use a
+// directory that isn't empty; uncommitted changes in the lost worktree are
+// not recovered.
+func (s *WorkService) RelocateWorktree(ctx context.Context, workID string, opts RelocateOptions) (*RelocateResult, error) {
+	if opts.Recreate == (opts.Path != "") {
+		return nil, coerrors.Errorf(coerrors.Validation, "either recreate the worktree or give the path it was moved to")
+	}
+
+	work, err := s.DB.GetWork(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
+	}
+	if work.WorktreePath == "" {
+		return nil, coerrors.Errorf(coerrors.Validation, "work %s has no worktree yet", workID)
+	}
+	if s.Worktree.ExistsPath(work.WorktreePath) {
+		return nil, coerrors.Errorf(coerrors.Conflict, "worktree of %s still exists at %s", workID, work.WorktreePath)
+	}
+
+	result := &RelocateResult{OldPath: work.WorktreePath}
+	if opts.Path != "" {
+		result.NewPath, err = s.adoptWorktree(ctx, opts.Path, work.BranchName)
+	} else {
+		result.NewPath, result.SetupWarnings, err = s.recreateWorktree(ctx, workID, work.BranchName)
+		result.Recreated = err == nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.DB.UpdateWorkWorktreePath(ctx, workID, result.NewPath); err != nil {
+		return nil, fmt.Errorf("failed to update work worktree path: %w", err)
+	}
+	return result, nil
+}
+
+// adoptWorktree checks that path is a worktree of the main repository with
+// branch checked out, repairing git's links to it first, and returns its
+// absolute path.
+func (s *WorkService) adoptWorktree(ctx context.Context, path, branch string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", path, err)
+	}
+	if !s.Worktree.ExistsPath(abs) {
+		return "", coerrors.Errorf(coerrors.Validation, "%s is not a directory", abs)
+	}
+
+	// A worktree moved along with the repository has links pointing at the
+	// old locations on both sides
+	if err := s.Worktree.Repair(ctx, s.MainRepoPath, abs); err != nil {
+		return "", coerrors.Wrap(coerrors.ExternalTool, err)
+	}
+	worktrees, err := s.Worktree.List(ctx, s.MainRepoPath)
+	if err != nil {
+		return "", coerrors.Wrap(coerrors.ExternalTool, err)
+	}
+	for _, wt := range worktrees {
+		if !samePath(wt.Path, abs) {
+			continue
+		}
+		if wt.Branch != branch {
+			return "", coerrors.Errorf(coerrors.Validation, "%s has %s checked out, not %s", abs, describeBranch(wt.Branch), branch)
+		}
+		return abs, nil
+	}
+	return "", coerrors.Errorf(coerrors.Validation, "%s is not a worktree of %s", abs, s.MainRepoPath)
+}
+
+// recreateWorktree checks branch out again at the work's default worktree
+// path and sets it up like a new worktree.
+func (s *WorkService) recreateWorktree(ctx context.Context, workID, branch string) (string, []string, error) {
+	path := DefaultWorktreePath(s.ProjectRoot, workID)
+	entries, err := os.ReadDir(path)
+	if err != nil && !os.IsNotExist(err) {
+		return "", nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if len(entries) > 0 {
+		return "", nil, coerrors.Errorf(coerrors.Conflict, "%s is not empty; if it is the moved worktree, give its path instead", path)
+	}
+
+	// git still has the lost worktree registered, which keeps the branch
+	// from being checked out anywhere else
+	if err := s.Worktree.Prune(ctx, s.MainRepoPath); err != nil {
+		return "", nil, coerrors.Wrap(coerrors.ExternalTool, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return "", nil, fmt.Errorf("failed to create work directory: %w", err)
+	}
+	if err := s.Worktree.CreateFromExisting(ctx, s.MainRepoPath, path, branch); err != nil {
+		return "", nil, coerrors.Wrap(coerrors.ExternalTool, err)
+	}
+
+	cfg := s.Config.Worktree
+	commitHook := s.Config.Git.GetCommitTrailer()
+	setup := worktree.Setup(ctx, s.MainRepoPath, path, worktree.SetupOptions{
+		CopyFiles:      cfg.CopyFiles,
+		PostCreate:     cfg.PostCreate,
+		Env:            s.Config.Hooks.Env,
+		CommitHook:     commitHook,
+		ProtectManaged: true,
+	})
+	if err := s.DB.SetWorkSetupWarnings(ctx, workID, setup.Warnings); err != nil {
+		return "", nil, fmt.Errorf("failed to store worktree setup warnings: %w", err)
+	}
+	return path, setup.Warnings, nil
+}
+
+// samePath reports whether two paths name the same directory, following
+// symlinks where they can be resolved.
+func samePath(a, b string) bool {
+	if a == b {
+		return true
+	}
+	ra, errA := filepath.EvalSymlinks(a)
+	rb, errB := filepath.EvalSymlinks(b)
+	return errA == nil && errB == nil && ra == rb
+}
+
+// describeBranch names a worktree's branch for an error message.
+func describeBranch(branch string) string {
+	if branch == "" {
+		return "a detached HEAD"
+	}
+	return branch
+}
@@ -0,0 +1,99 @@
+package work_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/newhook/co/internal/work"
+	"github.com/newhook/co/internal/worktree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelocateWorktreeToMovedPath(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+
+	h.CreateWork("w-abc", "feat/abc")
+	require.NoError(t, h.DB.UpdateWorkWorktreePath(ctx, "w-abc", "/old/project/w-abc/tree"))
+	moved := t.TempDir()
+	other := t.TempDir()
+	h.Worktree.ExistsPathFunc = func(path string) bool { return path == moved || path == other }
+	h.Worktree.ListFunc = func(ctx context.Context, repoPath string) ([]worktree.Worktree, error) {
+		return []worktree.Worktree{
+			{Path: "/test/project/main", Branch: "main"},
+			{Path: moved, Branch: "feat/abc"},
+			{Path: other, Branch: "feat/other"},
+		}, nil
+	}
+
+	// Both options, or neither, are refused
+	_, err := h.WorkService.RelocateWorktree(ctx, "w-abc", work.RelocateOptions{})
+	require.Equal(t, coerrors.Validation, coerrors.KindOf(err))
+	_, err = h.WorkService.RelocateWorktree(ctx, "w-abc", work.RelocateOptions{Recreate: true, Path: moved})
+	require.Equal(t, coerrors.Validation, coerrors.KindOf(err))
+
+	// A worktree with another branch checked out isn't the work's
+	_, err = h.WorkService.RelocateWorktree(ctx, "w-abc", work.RelocateOptions{Path: other})
+	require.ErrorContains(t, err, "has feat/other checked out, not feat/abc")
+
+	// Nor is a directory git doesn't know
+	_, err = h.WorkService.RelocateWorktree(ctx, "w-abc", work.RelocateOptions{Path: "/elsewhere"})
+	require.ErrorContains(t, err, "is not a directory")
+
+	result, err := h.WorkService.RelocateWorktree(ctx, "w-abc", work.RelocateOptions{Path: moved})
+	require.NoError(t, err)
+	require.Equal(t, "/old/project/w-abc/tree", result.OldPath)
+	require.Equal(t, moved, result.NewPath)
+	require.False(t, result.Recreated)
+	require.Len(t, h.Worktree.RepairCalls(), 2)
+	require.Equal(t, moved, h.Worktree.RepairCalls()[1].WorktreePath)
+
+	w, err := h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Equal(t, moved, w.WorktreePath)
+
+	// Now that it's there, there's nothing to repair
+	_, err = h.WorkService.RelocateWorktree(ctx, "w-abc", work.RelocateOptions{Path: moved})
+	require.Equal(t, coerrors.Conflict, coerrors.KindOf(err))
+}
+
+func TestRelocateWorktreeRecreate(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	h.WorkService.ProjectRoot = t.TempDir()
+
+	h.CreateWork("w-abc", "feat/abc")
+	require.NoError(t, h.DB.UpdateWorkWorktreePath(ctx, "w-abc", "/old/project/w-abc/tree"))
+	target := work.DefaultWorktreePath(h.WorkService.ProjectRoot, "w-abc")
+
+	// Something already at the default location is never overwritten
+	require.NoError(t, os.MkdirAll(target, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(target, "main.go"), nil, 0644))
+	_, err := h.WorkService.RelocateWorktree(ctx, "w-abc", work.RelocateOptions{Recreate: true})
+	require.Equal(t, coerrors.Conflict, coerrors.KindOf(err))
+	require.Empty(t, h.Worktree.CreateFromExistingCalls())
+
+	// An empty directory is fine
+	require.NoError(t, os.Remove(filepath.Join(target, "main.go")))
+	result, err := h.WorkService.RelocateWorktree(ctx, "w-abc", work.RelocateOptions{Recreate: true})
+	require.NoError(t, err)
+	require.True(t, result.Recreated)
+	require.Equal(t, target, result.NewPath)
+
+	// The lost worktree is pruned so the branch can be checked out again
+	require.Len(t, h.Worktree.PruneCalls(), 1)
+	calls := h.Worktree.CreateFromExistingCalls()
+	require.Len(t, calls, 1)
+	require.Equal(t, target, calls[0].WorktreePath)
+	require.Equal(t, "feat/abc", calls[0].Branch)
+
+	w, err := h.DB.GetWork(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Equal(t, target, w.WorktreePath)
+}
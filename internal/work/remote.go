@@ -0,0 +1,49 @@
+package work
+
+import (
+	"context"
+	"os"
+
+	"github.com/newhook/co/internal/remote"
+)
+
+// removeWorktree removes a work's worktree, on its host for a remote work.
+func (s *WorkService) removeWorktree(ctx context.Context, workID, remoteHost, worktreePath string) error {
+	if remoteHost == "" {
+		return s.Worktree.RemoveForce(ctx, s.MainRepoPath, worktreePath)
+	}
+	target := remote.TargetFor(s.Config)
+	target.Host = remoteHost
+	return s.Remote.RemoveWorktree(ctx, target, workID)
+}
+
+// deleteBranch force-deletes a work's branch from the repository its
+// worktree was made from: the main repository for a local work, the host's
+// clone for a remote one.
+func (s *WorkService) deleteBranch(ctx context.Context, remoteHost, branch string) error {
+	if remoteHost == "" {
+		return s.Git.DeleteBranch(ctx, s.MainRepoPath, branch)
+	}
+	target := remote.TargetFor(s.Config)
+	target.Host = remoteHost
+	return s.Remote.DeleteBranch(ctx, target, branch)
+}
+
+// worktreeLocation describes where a worktree is, prefixed by its host for a
+// remote work.
+func worktreeLocation(remoteHost, worktreePath string) string {
+	if remoteHost == "" {
+		return worktreePath
+	}
+	return remoteHost + ":" + worktreePath
+}
+
+// localTabDir returns the local directory a remote work's zellij tabs start
+// in, since its worktree is on the host.
+func localTabDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return os.TempDir()
+	}
+	return home
+}
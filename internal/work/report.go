@@ -0,0 +1,234 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/db"
+)
+
+// DefaultReportWindow is how far back a work report looks by default.
+const DefaultReportWindow = 24 * time.Hour
+
+// WorkReportData is the data a work's section of a report is built from.
+type WorkReportData struct {
+	Work  *db.Work
+	Tasks []*db.Task
+	// Beads holds the work's beads, including its root issue.
+	Beads []beads.Bead
+	// BeadTime is the task run time each bead has taken; see db.SplitTaskDurations.
+	BeadTime map[string]time.Duration
+	// Runs holds the attempts at each task, keyed by task ID.
+	Runs map[string][]*db.TaskRun
+}
+
+// GatherReportData fetches the data for a work report. With a workID only
+// that work is included; otherwise every work that is still in progress or
+// was completed after since.
+func (s *WorkService) GatherReportData(ctx context.Context, workID string, since time.Time) ([]WorkReportData, error) {
+	var works []*db.Work
+	if workID != "" {
+		work, err := s.DB.GetWork(ctx, workID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get work: %w", err)
+		}
+		if work == nil {
+			return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
+		}
+		works = []*db.Work{work}
+	} else {
+		all, err := s.DB.ListWorks(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range all {
+			if w.Status == db.StatusCompleted && (w.CompletedAt == nil || w.CompletedAt.Before(since)) {
+				continue
+			}
+			works = append(works, w)
+		}
+	}
+
+	data := make([]WorkReportData, 0, len(works))
+	for _, w := range works {
+		tasks, err := s.DB.GetWorkTasks(ctx, w.ID)
+		if err != nil {
+			return nil, err
+		}
+		workBeads, err := s.DB.GetWorkBeads(ctx, w.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		beadIDs := make([]string, 0, len(workBeads)+1)
+		if w.RootIssueID != "" {
+			beadIDs = append(beadIDs, w.RootIssueID)
+		}
+		for _, wb := range workBeads {
+			if wb.BeadID != w.RootIssueID {
+				beadIDs = append(beadIDs, wb.BeadID)
+			}
+		}
+
+		beadTime, err := s.DB.GetBeadDurations(ctx, w.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		runs, err := s.DB.GetTaskRunsForWork(ctx, w.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := WorkReportData{Work: w, Tasks: tasks, BeadTime: beadTime, Runs: runs}
+		if len(beadIDs) > 0 {
+			result, err := s.BeadsReader.GetBeadsWithDeps(ctx, beadIDs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get beads for work %s: %w", w.ID, err)
+			}
+			for _, id := range beadIDs {
+				if bead, ok := result.Beads[id]; ok {
+					entry.Beads = append(entry.Beads, bead)
+				}
+			}
+		}
+		data = append(data, entry)
+	}
+	return data, nil
+}
+
+// RenderReport formats a markdown standup report covering since..now. For each
+// work it lists the name, branch and PR, the beads closed in the window, the
+// task time each bead has taken, the tasks that finished in the window, and
+// failed tasks as blockers.
+func RenderReport(data []WorkReportData, since, now time.Time) string {
+	var b strings.Builder
+	b.WriteString("# Work report\n\n")
+	fmt.Fprintf(&b, "_%s – %s_\n", since.Format("2006-01-02 15:04"), now.Format("2006-01-02 15:04"))
+
+	if len(data) == 0 {
+		b.WriteString("\nNo work activity in this period.\n")
+		return b.String()
+	}
+
+	for _, d := range data {
+		w := d.Work
+		title := w.ID
+		if w.Name != "" {
+			title = fmt.Sprintf("%s: %s", w.ID, w.Name)
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", title)
+		fmt.Fprintf(&b, "- **Status:** %s\n", w.Status)
+		if w.BranchName != "" {
+			fmt.Fprintf(&b, "- **Branch:** `%s`\n", w.BranchName)
+		}
+		if w.PRURL != "" {
+			fmt.Fprintf(&b, "- **PR:** %s\n", w.PRURL)
+		} else {
+			b.WriteString("- **PR:** none yet\n")
+		}
+		if notes := strings.TrimSpace(w.Notes); notes != "" {
+			fmt.Fprintf(&b, "\n### Notes\n\n%s\n", notes)
+		}
+
+		var closed []beads.Bead
+		for _, bead := range d.Beads {
+			if bead.Status == beads.StatusClosed && inWindow(bead.ClosedAt, since, now) {
+				closed = append(closed, bead)
+			}
+		}
+		b.WriteString("\n### Beads closed\n\n")
+		if len(closed) == 0 {
+			b.WriteString("_None_\n")
+		}
+		for _, bead := range closed {
+			fmt.Fprintf(&b, "- %s %s\n", bead.ID, bead.Title)
+		}
+
+		var timed []beads.Bead
+		for _, bead := range d.Beads {
+			if d.BeadTime[bead.ID] > 0 {
+				timed = append(timed, bead)
+			}
+		}
+		if len(timed) > 0 {
+			// Totals since the work started, not just this window
+			b.WriteString("\n### Time by bead\n\n")
+			b.WriteString("| Bead | Title | Status | Time |\n")
+			b.WriteString("|------|-------|--------|------|\n")
+			for _, bead := range timed {
+				title := strings.ReplaceAll(bead.Title, "|", "\\|")
+				fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", bead.ID, title, bead.Status, d.BeadTime[bead.ID].Round(time.Second))
+			}
+		}
+
+		var finished, blockers []*db.Task
+		for _, t := range d.Tasks {
+			if t.Status == db.StatusFailed {
+				blockers = append(blockers, t)
+			}
+			if (t.Status == db.StatusCompleted || t.Status == db.StatusFailed) &&
+				t.CompletedAt != nil && inWindow(*t.CompletedAt, since, now) {
+				finished = append(finished, t)
+			}
+		}
+		sort.SliceStable(finished, func(i, j int) bool {
+			return finished[i].CompletedAt.Before(*finished[j].CompletedAt)
+		})
+
+		b.WriteString("\n### Tasks\n\n")
+		if len(finished) == 0 {
+			b.WriteString("_None finished_\n")
+		} else {
+			b.WriteString("| Task | Type | Status | Duration | Ran with |\n")
+			b.WriteString("|------|------|--------|----------|----------|\n")
+			for _, t := range finished {
+				duration := "-"
+				if t.StartedAt != nil {
+					duration = t.CompletedAt.Sub(*t.StartedAt).Round(time.Second).String()
+				}
+				fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n", t.ID, t.TaskType, t.Status, duration, reportRuns(d.Runs[t.ID]))
+			}
+		}
+
+		if len(blockers) > 0 {
+			b.WriteString("\n### Blockers\n\n")
+			for _, t := range blockers {
+				msg := strings.TrimSpace(t.ErrorMessage)
+				if msg == "" {
+					msg = "no error message recorded"
+				}
+				// Keep multi-line errors inside their list item
+				msg = strings.ReplaceAll(msg, "\n", " ")
+				fmt.Fprintf(&b, "- %s (%s) failed: %s\n", t.ID, t.TaskType, msg)
+			}
+		}
+	}
+	return b.String()
+}
+
+// reportRuns describes what a task ran with. Each attempt of a retried task
+// gets its own line, with how it ended.
+func reportRuns(runs []*db.TaskRun) string {
+	switch len(runs) {
+	case 0:
+		return "-"
+	case 1:
+		return strings.ReplaceAll(runs[0].Summary(), "|", "\\|")
+	}
+	lines := make([]string, len(runs))
+	for i, run := range runs {
+		lines[i] = fmt.Sprintf("#%d %s (%s)", i+1, strings.ReplaceAll(run.Summary(), "|", "\\|"), run.Status)
+	}
+	return strings.Join(lines, "<br>")
+}
+
+// inWindow reports whether t falls within [since, now].
+func inWindow(t, since, now time.Time) bool {
+	return !t.IsZero() && !t.Before(since) && !t.After(now)
+}
@@ -0,0 +1,103 @@
+package work_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderReport(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+	since := now.Add(-work.DefaultReportWindow)
+	at := func(d time.Duration) *time.Time {
+		ts := now.Add(-d)
+		return &ts
+	}
+
+	data := []work.WorkReportData{{
+		Work: &db.Work{
+			ID:         "w-abc",
+			Name:       "Login flow",
+			Status:     db.StatusProcessing,
+			BranchName: "feat/login",
+			PRURL:      "https://github.com/owner/repo/pull/12",
+			Notes:      "Waiting on design review\n- spec: https://example.com/spec\n",
+		},
+		Tasks: []*db.Task{
+			{ID: "w-abc.1", TaskType: "implement", Status: db.StatusCompleted, StartedAt: at(5 * time.Hour), CompletedAt: at(4 * time.Hour)},
+			{ID: "w-abc.2", TaskType: "review", Status: db.StatusFailed, StartedAt: at(2 * time.Hour), CompletedAt: at(90 * time.Minute), ErrorMessage: "claude exited\nwith status 1"},
+			{ID: "w-abc.0", TaskType: "estimate", Status: db.StatusCompleted, StartedAt: at(50 * time.Hour), CompletedAt: at(49 * time.Hour)},
+			{ID: "w-abc.3", TaskType: "implement", Status: db.StatusPending},
+		},
+		Beads: []beads.Bead{
+			{ID: "bd-1", Title: "Add login form", Status: beads.StatusClosed, ClosedAt: now.Add(-3 * time.Hour)},
+			{ID: "bd-2", Title: "Old fix", Status: beads.StatusClosed, ClosedAt: now.Add(-72 * time.Hour)},
+			{ID: "bd-3", Title: "Still open", Status: beads.StatusOpen},
+		},
+		BeadTime: map[string]time.Duration{"bd-1": 40 * time.Minute, "bd-2": 20*time.Minute + 400*time.Millisecond},
+		Runs: map[string][]*db.TaskRun{
+			"w-abc.1": {{Model: "opus", ClaudeVersion: "2.0.14", Status: db.StatusCompleted}},
+			"w-abc.2": {
+				{Model: "sonnet", Status: db.StatusFailed},
+				{Model: "opus", Settings: "max_thinking_tokens=16000", Status: db.StatusFailed},
+			},
+		},
+	}}
+
+	report := work.RenderReport(data, since, now)
+
+	assert.Contains(t, report, "## w-abc: Login flow")
+	assert.Contains(t, report, "- **Branch:** `feat/login`")
+	assert.Contains(t, report, "- **PR:** https://github.com/owner/repo/pull/12")
+	assert.Contains(t, report, "### Notes\n\nWaiting on design review\n- spec: https://example.com/spec\n")
+	assert.Contains(t, report, "- bd-1 Add login form")
+	assert.NotContains(t, report, "- bd-2", "beads closed before the window are left out")
+	assert.Contains(t, report, "### Time by bead\n\n| Bead | Title | Status | Time |\n|------|-------|--------|------|\n"+
+		"| bd-1 | Add login form | closed | 40m0s |\n| bd-2 | Old fix | closed | 20m0s |\n\n")
+	assert.NotContains(t, report, "bd-3", "beads without task time aren't listed")
+	assert.Contains(t, report, "| w-abc.1 | implement | completed | 1h0m0s | opus, claude 2.0.14 |")
+	assert.Contains(t, report, "| w-abc.2 | review | failed | 30m0s | #1 sonnet (failed)<br>#2 opus, max_thinking_tokens=16000 (failed) |")
+	assert.NotContains(t, report, "w-abc.0", "tasks finished before the window are left out")
+	assert.NotContains(t, report, "w-abc.3")
+	assert.Contains(t, report, "### Blockers\n\n- w-abc.2 (review) failed: claude exited with status 1\n")
+
+	empty := work.RenderReport(nil, since, now)
+	assert.Contains(t, empty, "No work activity in this period.")
+}
+
+func TestGatherReportData(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	ctx := context.Background()
+
+	h.CreateBead("bead-1", "First")
+	h.CreateWork("w-active", "feat/active")
+	h.AddBeadToWork("w-active", "bead-1")
+	h.CreateTask("w-active.1", "w-active", []string{"bead-1"})
+	h.CompleteTask("w-active.1")
+
+	h.CreateWork("w-old", "feat/old")
+	require.NoError(t, h.DB.CompleteWork(ctx, "w-old", ""))
+
+	// Completed works only show up if they finished inside the window
+	data, err := h.WorkService.GatherReportData(ctx, "", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	assert.Equal(t, "w-active", data[0].Work.ID)
+	require.Len(t, data[0].Tasks, 1)
+	require.Len(t, data[0].Beads, 1)
+	assert.Equal(t, "First", data[0].Beads[0].Title)
+
+	data, err = h.WorkService.GatherReportData(ctx, "w-old", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+
+	_, err = h.WorkService.GatherReportData(ctx, "w-missing", time.Now())
+	require.Error(t, err)
+}
@@ -0,0 +1,75 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/github"
+	"github.com/newhook/co/internal/logging"
+)
+
+// CIFailuresMetadataKey is the task metadata key holding the CI failures a
+// review task was created to include.
+const CIFailuresMetadataKey = "ci_failures"
+
+// ciLogExcerptLines is how many lines from the end of a failing job's log go
+// into the review.
+const ciLogExcerptLines = 40
+
+// describeCIFailures lists the checks failing on a work's PR for a review
+// task, each with the end of its job log when it ran on GitHub Actions.
+func (s *WorkService) describeCIFailures(ctx context.Context, work *db.Work) (string, error) {
+	if work.PRURL == "" {
+		return "", coerrors.Errorf(coerrors.Validation, "work %s has no PR to take CI failures from", work.ID)
+	}
+	checks, err := s.GitHubClient.GetPRChecks(ctx, work.PRURL, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch PR checks: %w", err)
+	}
+	failing := github.FailingChecks(checks)
+	if len(failing) == 0 {
+		return "", coerrors.Errorf(coerrors.Conflict, "no checks are failing on %s", work.PRURL)
+	}
+	repo, err := github.ExtractRepoFromPRURL(work.PRURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse PR URL: %w", err)
+	}
+
+	var b strings.Builder
+	for _, check := range failing {
+		name := check.Name
+		if check.Workflow != "" {
+			name = check.Workflow + " / " + check.Name
+		}
+		fmt.Fprintf(&b, "### %s\n", name)
+		if check.Link != "" {
+			fmt.Fprintf(&b, "%s\n", check.Link)
+		}
+		jobID := github.JobIDFromCheckLink(check.Link)
+		if jobID == 0 {
+			b.WriteString("\n")
+			continue
+		}
+		logs, err := s.GitHubClient.GetJobLogs(ctx, repo, jobID)
+		if err != nil {
+			// The check's name and link are still worth reviewing
+			logging.Warn("failed to fetch job logs for review", "work_id", work.ID, "job_id", jobID, "error", err)
+			b.WriteString("\n")
+			continue
+		}
+		fmt.Fprintf(&b, "\nLast lines of the job log:\n```\n%s\n```\n\n", logTail(logs, ciLogExcerptLines))
+	}
+	return strings.TrimSpace(b.String()), nil
+}
+
+// logTail returns the last n lines of a log
+func logTail(log string, n int) string {
+	lines := strings.Split(strings.TrimRight(log, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
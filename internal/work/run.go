@@ -6,6 +6,8 @@ import (
 	"io"
 
 	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/task"
 )
 
@@ -13,6 +15,7 @@ import (
 type RunWorkResult struct {
 	WorkID              string
 	TasksCreated        int
+	TaskIDs             []string // IDs of the created tasks
 	OrchestratorSpawned bool
 }
 
@@ -20,6 +23,7 @@ type RunWorkResult struct {
 type RunWorkAutoResult struct {
 	WorkID              string
 	EstimateTaskCreated bool
+	EstimateTaskID      string
 	OrchestratorSpawned bool
 }
 
@@ -50,33 +54,34 @@ func (s *WorkService) RunWorkWithOptions(ctx context.Context, workID string, opt
 		return nil, fmt.Errorf("failed to get work: %w", err)
 	}
 	if work == nil {
-		return nil, fmt.Errorf("work %s not found", workID)
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
 	}
 
 	// Check if worktree exists
 	if work.WorktreePath == "" {
-		return nil, fmt.Errorf("work %s has no worktree path configured", work.ID)
+		return nil, coerrors.Errorf(coerrors.Validation, "work %s has no worktree path configured", work.ID)
 	}
 
-	if !s.Worktree.ExistsPath(work.WorktreePath) {
-		return nil, fmt.Errorf("work %s worktree does not exist at %s", work.ID, work.WorktreePath)
+	if !work.IsRemote() && !s.Worktree.ExistsPath(work.WorktreePath) {
+		return nil, coerrors.Errorf(coerrors.ExternalTool, "work %s worktree does not exist at %s", work.ID, work.WorktreePath)
 	}
 
 	// Create tasks from unassigned work beads
-	tasksCreated, err := s.createTasksFromWorkBeads(ctx, workID, opts.UsePlan, opts.ForceEstimate, w)
+	taskIDs, err := s.createTasksFromWorkBeads(ctx, workID, opts.UsePlan, opts.ForceEstimate, w)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create tasks: %w", err)
 	}
 
 	// Ensure orchestrator is running
-	spawned, err := s.OrchestratorManager.EnsureWorkOrchestrator(ctx, workID, s.Config.Project.Name, work.WorktreePath, work.Name, w)
+	spawned, err := s.OrchestratorManager.EnsureWorkOrchestrator(ctx, workID, s.Config.Project.Name, work.SessionDir(s.ProjectRoot), work.Name, w)
 	if err != nil {
-		return nil, fmt.Errorf("failed to ensure orchestrator: %w", err)
+		return nil, coerrors.Wrap(coerrors.ExternalTool, &SpawnError{Err: err})
 	}
 
 	return &RunWorkResult{
 		WorkID:              workID,
-		TasksCreated:        tasksCreated,
+		TasksCreated:        len(taskIDs),
+		TaskIDs:             taskIDs,
 		OrchestratorSpawned: spawned,
 	}, nil
 }
@@ -92,33 +97,34 @@ func (s *WorkService) RunWorkAuto(ctx context.Context, workID string, w io.Write
 		return nil, fmt.Errorf("failed to get work: %w", err)
 	}
 	if work == nil {
-		return nil, fmt.Errorf("work %s not found", workID)
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
 	}
 
 	// Check if worktree exists
 	if work.WorktreePath == "" {
-		return nil, fmt.Errorf("work %s has no worktree path configured", work.ID)
+		return nil, coerrors.Errorf(coerrors.Validation, "work %s has no worktree path configured", work.ID)
 	}
 
-	if !s.Worktree.ExistsPath(work.WorktreePath) {
-		return nil, fmt.Errorf("work %s worktree does not exist at %s", work.ID, work.WorktreePath)
+	if !work.IsRemote() && !s.Worktree.ExistsPath(work.WorktreePath) {
+		return nil, coerrors.Errorf(coerrors.ExternalTool, "work %s worktree does not exist at %s", work.ID, work.WorktreePath)
 	}
 
 	// Create estimate task from unassigned work beads (post-estimation will create implement tasks)
-	err = s.CreateEstimateTaskFromWorkBeads(ctx, workID, w)
+	estimateTaskID, err := s.CreateEstimateTaskFromWorkBeads(ctx, workID, w)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create estimate task: %w", err)
 	}
 
 	// Ensure orchestrator is running
-	spawned, err := s.OrchestratorManager.EnsureWorkOrchestrator(ctx, workID, s.Config.Project.Name, work.WorktreePath, work.Name, w)
+	spawned, err := s.OrchestratorManager.EnsureWorkOrchestrator(ctx, workID, s.Config.Project.Name, work.SessionDir(s.ProjectRoot), work.Name, w)
 	if err != nil {
-		return nil, fmt.Errorf("failed to ensure orchestrator: %w", err)
+		return nil, coerrors.Wrap(coerrors.ExternalTool, &SpawnError{Err: err})
 	}
 
 	return &RunWorkAutoResult{
 		WorkID:              workID,
 		EstimateTaskCreated: true,
+		EstimateTaskID:      estimateTaskID,
 		OrchestratorSpawned: spawned,
 	}, nil
 }
@@ -134,32 +140,33 @@ func (s *WorkService) PlanWorkTasks(ctx context.Context, workID string, autoGrou
 		return nil, fmt.Errorf("failed to get work: %w", err)
 	}
 	if work == nil {
-		return nil, fmt.Errorf("work %s not found", workID)
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
 	}
 
 	// Create tasks from unassigned work beads
-	tasksCreated, err := s.createTasksFromWorkBeads(ctx, workID, autoGroup, false, w)
+	taskIDs, err := s.createTasksFromWorkBeads(ctx, workID, autoGroup, false, w)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create tasks: %w", err)
 	}
 
 	return &PlanWorkTasksResult{
-		TasksCreated: tasksCreated,
+		TasksCreated: len(taskIDs),
 	}, nil
 }
 
 // CreateEstimateTaskFromWorkBeads creates an estimate task from unassigned work beads.
 // This is used in --auto mode where the full automated workflow includes estimation.
 // After the estimate task completes, handlePostEstimation creates implement tasks.
-func (s *WorkService) CreateEstimateTaskFromWorkBeads(ctx context.Context, workID string, w io.Writer) error {
+// Returns the ID of the estimate task.
+func (s *WorkService) CreateEstimateTaskFromWorkBeads(ctx context.Context, workID string, w io.Writer) (string, error) {
 	// Get unassigned beads
 	unassigned, err := s.DB.GetUnassignedWorkBeads(ctx, workID)
 	if err != nil {
-		return fmt.Errorf("failed to get unassigned beads: %w", err)
+		return "", fmt.Errorf("failed to get unassigned beads: %w", err)
 	}
 
 	if len(unassigned) == 0 {
-		return fmt.Errorf("no unassigned beads found for work %s", workID)
+		return "", fmt.Errorf("no unassigned beads found for work %s", workID)
 	}
 
 	fmt.Fprintf(w, "\nFound %d unassigned bead(s)\n", len(unassigned))
@@ -173,33 +180,44 @@ func (s *WorkService) CreateEstimateTaskFromWorkBeads(ctx context.Context, workI
 	// Get next task number
 	taskNum, err := s.DB.GetNextTaskNumber(ctx, workID)
 	if err != nil {
-		return fmt.Errorf("failed to get next task number: %w", err)
+		return "", fmt.Errorf("failed to get next task number: %w", err)
 	}
 
 	// Create the estimate task
 	taskID := fmt.Sprintf("%s.%d", workID, taskNum)
-	if err := s.DB.CreateTask(ctx, taskID, "estimate", beadIDs, 0, workID); err != nil {
-		return fmt.Errorf("failed to create estimate task: %w", err)
+	if err := s.DB.CreateTask(ctx, taskID, db.TaskTypeEstimate, beadIDs, 0, workID); err != nil {
+		return "", fmt.Errorf("failed to create estimate task: %w", err)
 	}
 
 	fmt.Fprintf(w, "  Created estimate task %s with %d bead(s)\n", taskID, len(beadIDs))
 	fmt.Fprintln(w, "  Implement tasks will be created after estimation completes.")
 
-	return nil
+	return taskID, nil
 }
 
 // createTasksFromWorkBeads creates tasks from unassigned beads in work_beads.
 // If usePlan is true, uses LLM complexity estimation to group beads.
-// Returns the number of tasks created.
-func (s *WorkService) createTasksFromWorkBeads(ctx context.Context, workID string, usePlan bool, forceEstimate bool, w io.Writer) (int, error) {
+// Returns the IDs of the tasks created.
+func (s *WorkService) createTasksFromWorkBeads(ctx context.Context, workID string, usePlan bool, forceEstimate bool, w io.Writer) ([]string, error) {
+	plan, err := s.planWorkBeads(ctx, workID, usePlan, forceEstimate, w)
+	if err != nil {
+		return nil, err
+	}
+	return s.createPlannedTasks(ctx, plan, w)
+}
+
+// planWorkBeads groups the unassigned beads in work_beads into tasks without creating them.
+// If usePlan is true, uses LLM complexity estimation to group beads; otherwise
+// each bead becomes its own task.
+func (s *WorkService) planWorkBeads(ctx context.Context, workID string, usePlan bool, forceEstimate bool, w io.Writer) (*PlanResult, error) {
 	// Get unassigned beads
 	unassigned, err := s.DB.GetUnassignedWorkBeads(ctx, workID)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get unassigned beads: %w", err)
+		return nil, fmt.Errorf("failed to get unassigned beads: %w", err)
 	}
 
 	if len(unassigned) == 0 {
-		return 0, nil
+		return &PlanResult{WorkID: workID}, nil
 	}
 
 	fmt.Fprintf(w, "\nFound %d unassigned bead(s)\n", len(unassigned))
@@ -213,62 +231,38 @@ func (s *WorkService) createTasksFromWorkBeads(ctx context.Context, workID strin
 	// Get all issues with dependencies in one call
 	issuesResult, err := s.BeadsReader.GetBeadsWithDeps(ctx, beadIDs)
 	if err != nil {
-		return 0, fmt.Errorf("failed to get bead details: %w", err)
+		return nil, fmt.Errorf("failed to get bead details: %w", err)
 	}
 
 	// Verify all beads were found
 	for _, beadID := range beadIDs {
 		if _, found := issuesResult.Beads[beadID]; !found {
-			return 0, fmt.Errorf("bead %s not found", beadID)
+			return nil, coerrors.Errorf(coerrors.NotFound, "bead %s not found", beadID)
 		}
 	}
 
-	// Group beads into tasks
-	var taskGroups [][]string // Each inner slice is a group of bead IDs for one task
-
-	if usePlan {
-		// Use LLM complexity estimation to group beads
-		fmt.Fprintln(w, "Using LLM complexity estimation to group beads...")
-		taskGroups, err = s.planBeadsWithComplexity(ctx, issuesResult, workID, forceEstimate)
-		if err != nil {
-			return 0, fmt.Errorf("failed to plan beads: %w", err)
-		}
-	} else {
+	if !usePlan {
 		// Each bead becomes its own task
-		for _, wb := range unassigned {
-			taskGroups = append(taskGroups, []string{wb.BeadID})
+		planned := make([]task.Task, len(beadIDs))
+		for i, beadID := range beadIDs {
+			planned[i] = task.Task{BeadIDs: []string{beadID}}
 		}
+		return NewPlanResult(workID, planned, issuesResult.Beads), nil
 	}
 
-	// Create tasks from groups
-	tasksCreated := 0
-	for _, groupBeadIDs := range taskGroups {
-		if len(groupBeadIDs) == 0 {
-			continue
-		}
-
-		// Get next task number
-		taskNum, err := s.DB.GetNextTaskNumber(ctx, workID)
-		if err != nil {
-			return tasksCreated, fmt.Errorf("failed to get next task number: %w", err)
-		}
-
-		taskID := fmt.Sprintf("%s.%d", workID, taskNum)
-		if err := s.DB.CreateTask(ctx, taskID, "implement", groupBeadIDs, 0, workID); err != nil {
-			return tasksCreated, fmt.Errorf("failed to create task: %w", err)
-		}
-
-		fmt.Fprintf(w, "  Created task %s with %d bead(s)\n", taskID, len(groupBeadIDs))
-		tasksCreated++
+	// Use LLM complexity estimation to group beads
+	fmt.Fprintln(w, "Using LLM complexity estimation to group beads...")
+	planned, err := s.planBeadsWithComplexity(ctx, issuesResult, workID, forceEstimate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan beads: %w", err)
 	}
-
-	return tasksCreated, nil
+	return NewPlanResult(workID, planned, issuesResult.Beads), nil
 }
 
 // planBeadsWithComplexity uses LLM complexity estimation to group beads.
 // If forceEstimate is true, re-estimates complexity even if cached values exist.
 // If s.TaskPlanner is set, uses it directly; otherwise creates a default planner.
-func (s *WorkService) planBeadsWithComplexity(ctx context.Context, issuesResult *beads.BeadsWithDepsResult, workID string, forceEstimate bool) ([][]string, error) {
+func (s *WorkService) planBeadsWithComplexity(ctx context.Context, issuesResult *beads.BeadsWithDepsResult, workID string, forceEstimate bool) ([]task.Task, error) {
 	// Convert map to slice of beads
 	beadList := make([]beads.Bead, 0, len(issuesResult.Beads))
 	for _, b := range issuesResult.Beads {
@@ -283,13 +277,7 @@ func (s *WorkService) planBeadsWithComplexity(ctx context.Context, issuesResult
 		if err != nil {
 			return nil, fmt.Errorf("failed to plan tasks: %w", err)
 		}
-
-		// Convert planned tasks to bead ID groups
-		var groups [][]string
-		for _, p := range planned {
-			groups = append(groups, p.BeadIDs)
-		}
-		return groups, nil
+		return planned, nil
 	}
 
 	// Fall back to creating LLM estimator and planner inline
@@ -314,11 +302,5 @@ func (s *WorkService) planBeadsWithComplexity(ctx context.Context, issuesResult
 		return nil, fmt.Errorf("failed to plan tasks: %w", err)
 	}
 
-	// Convert planned tasks to bead ID groups
-	var groups [][]string
-	for _, p := range planned {
-		groups = append(groups, p.BeadIDs)
-	}
-
-	return groups, nil
+	return planned, nil
 }
@@ -0,0 +1,139 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/newhook/co/internal/db"
+)
+
+// RunAllOptions contains options for running several works at once.
+type RunAllOptions struct {
+	// UsePlan groups each work's issues into tasks with LLM complexity
+	// estimation, as co run --plan does.
+	UsePlan bool
+	// Stagger is the delay between orchestrator spawns, so a batch of works
+	// doesn't start all its Claude sessions at once.
+	Stagger time.Duration
+	// MaxConsecutiveFailures aborts the remaining runs once this many runs
+	// have failed in a row. 0 never aborts.
+	MaxConsecutiveFailures int
+	// OnOutcome, if set, is called as each work's run finishes or is aborted.
+	OnOutcome func(RunAllOutcome)
+}
+
+// RunAllOutcome is what became of one work in RunAllWorks.
+type RunAllOutcome struct {
+	WorkID  string
+	Result  *RunWorkResult // Set when the run succeeded
+	Err     error          // Set when the run failed
+	Aborted bool           // Not run: too many runs failed in a row, or the context was cancelled
+}
+
+// IdleWorks returns the works a bulk run would start: works with unassigned
+// issues or pending tasks whose orchestrator isn't running. Completed,
+// merged and paused works are left out, as are works whose worktree is still
+// being created.
+func (s *WorkService) IdleWorks(ctx context.Context) ([]*db.Work, error) {
+	works, err := s.DB.ListWorks(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list works: %w", err)
+	}
+
+	var idle []*db.Work
+	for _, work := range works {
+		if work.Status == db.StatusCompleted || work.Status == db.StatusMerged || work.Paused || work.WorktreePath == "" {
+			continue
+		}
+		pending, err := s.hasPendingWork(ctx, work.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !pending {
+			continue
+		}
+		health, _, err := s.DB.GetOrchestratorHealth(ctx, work.ID, db.DefaultStalenessThreshold)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check orchestrator for %s: %w", work.ID, err)
+		}
+		if health == db.OrchestratorRunning {
+			continue
+		}
+		idle = append(idle, work)
+	}
+	return idle, nil
+}
+
+// hasPendingWork reports whether a work has unassigned issues or pending tasks
+func (s *WorkService) hasPendingWork(ctx context.Context, workID string) (bool, error) {
+	unassigned, err := s.DB.GetUnassignedWorkBeads(ctx, workID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get unassigned issues for %s: %w", workID, err)
+	}
+	if len(unassigned) > 0 {
+		return true, nil
+	}
+	tasks, err := s.DB.GetWorkTasks(ctx, workID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get tasks for %s: %w", workID, err)
+	}
+	for _, t := range tasks {
+		if t.Status == db.StatusPending {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// RunAllWorks runs each of the works in turn, as RunWork does, waiting
+// opts.Stagger after each orchestrator it spawns. It stops early once
+// opts.MaxConsecutiveFailures runs have failed in a row or ctx is cancelled;
+// the works left are reported as aborted. It returns the outcome of every
+// work, in order, and an error if the runs were cut short.
+// Progress messages are written to the provided writer. Pass io.Discard to suppress output.
+func (s *WorkService) RunAllWorks(ctx context.Context, workIDs []string, opts RunAllOptions, w io.Writer) ([]RunAllOutcome, error) {
+	outcomes := make([]RunAllOutcome, 0, len(workIDs))
+	report := func(outcome RunAllOutcome) {
+		outcomes = append(outcomes, outcome)
+		if opts.OnOutcome != nil {
+			opts.OnOutcome(outcome)
+		}
+	}
+
+	var stopErr error
+	failures := 0
+	spawned := false
+	for _, workID := range workIDs {
+		if stopErr == nil && spawned && opts.Stagger > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(opts.Stagger):
+			}
+		}
+		if stopErr == nil && ctx.Err() != nil {
+			stopErr = fmt.Errorf("bulk run cancelled: %w", ctx.Err())
+		}
+		if stopErr != nil {
+			report(RunAllOutcome{WorkID: workID, Aborted: true})
+			continue
+		}
+
+		fmt.Fprintf(w, "Running %s\n", workID)
+		result, err := s.RunWorkWithOptions(ctx, workID, RunWorkOptions{UsePlan: opts.UsePlan}, w)
+		if err != nil {
+			report(RunAllOutcome{WorkID: workID, Err: err})
+			failures++
+			spawned = false
+			if opts.MaxConsecutiveFailures > 0 && failures >= opts.MaxConsecutiveFailures {
+				stopErr = fmt.Errorf("stopped after %d runs failed in a row", failures)
+			}
+			continue
+		}
+		report(RunAllOutcome{WorkID: workID, Result: result})
+		failures = 0
+		spawned = result.OrchestratorSpawned
+	}
+	return outcomes, stopErr
+}
@@ -0,0 +1,107 @@
+package work_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/testutil"
+	workpkg "github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdleWorks(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	for _, id := range []string{"bead-1", "bead-2", "bead-3", "bead-4"} {
+		h.CreateBead(id, "Feature "+id)
+	}
+
+	// Unassigned issues, or a pending task, make a work idle
+	h.CreateWork("w-beads", "feat/beads")
+	h.AddBeadToWork("w-beads", "bead-1")
+	h.CreateWork("w-task", "feat/task")
+	h.CreateTask("w-task.1", "w-task", nil)
+
+	// Nothing left to do
+	h.CreateWork("w-done", "feat/done")
+	h.CreateTask("w-done.1", "w-done", nil)
+	h.CompleteTask("w-done.1")
+
+	// Its orchestrator is already running
+	h.CreateWork("w-running", "feat/running")
+	h.AddBeadToWork("w-running", "bead-2")
+	workID := "w-running"
+	require.NoError(t, h.DB.RegisterProcess(ctx, "orch-1", db.ProcessTypeOrchestrator, &workID, os.Getpid()))
+
+	// Paused
+	h.CreateWork("w-paused", "feat/paused")
+	h.AddBeadToWork("w-paused", "bead-3")
+	require.NoError(t, h.DB.SetWorkPaused(ctx, "w-paused", true))
+
+	// Completed
+	h.CreateWork("w-complete", "feat/complete")
+	h.AddBeadToWork("w-complete", "bead-4")
+	require.NoError(t, h.DB.CompleteWork(ctx, "w-complete", ""))
+
+	works, err := h.WorkService.IdleWorks(ctx)
+	require.NoError(t, err)
+	var ids []string
+	for _, w := range works {
+		ids = append(ids, w.ID)
+	}
+	assert.ElementsMatch(t, []string{"w-beads", "w-task"}, ids)
+}
+
+func TestRunAllWorks(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	for _, id := range []string{"w-one", "w-two", "w-three", "w-four"} {
+		h.CreateBead("bead-"+id, "Feature "+id)
+		h.CreateWork(id, "feat/"+id)
+		h.AddBeadToWork(id, "bead-"+id)
+	}
+	h.Worktree.ExistsPathFunc = func(worktreePath string) bool { return true }
+
+	var reported []string
+	opts := workpkg.RunAllOptions{
+		MaxConsecutiveFailures: 2,
+		OnOutcome:              func(o workpkg.RunAllOutcome) { reported = append(reported, o.WorkID) },
+	}
+	outcomes, err := h.WorkService.RunAllWorks(ctx, []string{"w-one", "w-two"}, opts, io.Discard)
+	require.NoError(t, err)
+	require.Len(t, outcomes, 2)
+	for _, o := range outcomes {
+		require.NoError(t, o.Err)
+		assert.Equal(t, 1, o.Result.TasksCreated)
+	}
+	assert.Equal(t, []string{"w-one", "w-two"}, reported)
+
+	// After two spawns fail in a row, the remaining works aren't started
+	spawnErr := errors.New("zellij is not running")
+	h.OrchestratorManager.EnsureWorkOrchestratorFunc = func(ctx context.Context, workID string, projName string, workDir string, friendlyName string, w io.Writer) (bool, error) {
+		return false, spawnErr
+	}
+	outcomes, err = h.WorkService.RunAllWorks(ctx, []string{"w-three", "w-four", "w-one"}, opts, io.Discard)
+	require.ErrorContains(t, err, "2 runs failed in a row")
+	require.Len(t, outcomes, 3)
+	assert.ErrorIs(t, outcomes[0].Err, spawnErr)
+	assert.ErrorIs(t, outcomes[1].Err, spawnErr)
+	assert.True(t, outcomes[2].Aborted)
+	assert.Len(t, h.OrchestratorManager.EnsureWorkOrchestratorCalls(), 4)
+
+	// A cancelled bulk run starts nothing more
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	outcomes, err = h.WorkService.RunAllWorks(cancelled, []string{"w-one"}, opts, io.Discard)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.True(t, outcomes[0].Aborted)
+}
@@ -2,10 +2,12 @@ package work_test
 
 import (
 	"context"
+	"errors"
 	"io"
 	"testing"
 
 	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/coerrors"
 	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/task"
 	"github.com/newhook/co/internal/testutil"
@@ -447,7 +449,7 @@ func TestCreateEstimateTaskFromWorkBeads_FailsWithNoUnassignedBeads(t *testing.T
 	h.CreateWork("w-test", "feat/test-branch")
 
 	// Should fail with no unassigned beads
-	err := h.WorkService.CreateEstimateTaskFromWorkBeads(ctx, "w-test", io.Discard)
+	_, err := h.WorkService.CreateEstimateTaskFromWorkBeads(ctx, "w-test", io.Discard)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "no unassigned beads")
 }
@@ -486,3 +488,39 @@ func TestRunWork_WithEpicBeads(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, tasks, 4)
 }
+
+func TestRunWork_ErrorKinds(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+
+	_, err := h.WorkService.RunWork(ctx, "w-missing", false, io.Discard)
+	require.ErrorIs(t, err, coerrors.NotFound)
+
+	h.CreateBead("bead-1", "Test bead")
+	workRecord := h.CreateWork("w-test", "feat/test-branch")
+	h.AddBeadToWork("w-test", "bead-1")
+
+	// The worktree is gone from disk
+	h.Worktree.ExistsPathFunc = func(string) bool { return false }
+	_, err = h.WorkService.RunWork(ctx, "w-test", false, io.Discard)
+	require.ErrorIs(t, err, coerrors.ExternalTool)
+
+	// The orchestrator can't be started
+	h.Worktree.ExistsPathFunc = func(worktreePath string) bool {
+		return worktreePath == workRecord.WorktreePath
+	}
+	h.OrchestratorManager.EnsureWorkOrchestratorFunc = func(ctx context.Context, workID string, projName string, workDir string, friendlyName string, w io.Writer) (bool, error) {
+		return false, errors.New("zellij not running")
+	}
+	_, err = h.WorkService.RunWork(ctx, "w-test", false, io.Discard)
+	require.ErrorIs(t, err, coerrors.ExternalTool)
+	require.ErrorContains(t, err, "zellij not running")
+
+	// Beads already assigned to a task can't be added again
+	_, err = h.WorkService.AddBeads(ctx, "w-test", nil, false)
+	require.ErrorIs(t, err, coerrors.Validation)
+	_, err = h.WorkService.AddBeads(ctx, "w-test", []string{"bead-1"}, false)
+	require.ErrorIs(t, err, coerrors.Conflict)
+}
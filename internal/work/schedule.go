@@ -0,0 +1,121 @@
+package work
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/newhook/co/internal/coerrors"
+)
+
+// ParseRunTime resolves the time for a scheduled run from either at, a clock
+// time ("02:00", the next time the clock reads that) or a local date and time
+// ("2006-01-02 15:04"), or in, a delay from now ("4h", "90m"). Exactly one of
+// at and in must be set.
+func ParseRunTime(at, in string, now time.Time) (time.Time, error) {
+	switch {
+	case at != "" && in != "":
+		return time.Time{}, fmt.Errorf("use either --at or --in, not both")
+	case in != "":
+		d, err := time.ParseDuration(in)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid delay %q: %w", in, err)
+		}
+		if d <= 0 {
+			return time.Time{}, fmt.Errorf("delay %q must be positive", in)
+		}
+		return now.Add(d), nil
+	case at != "":
+		if t, err := time.ParseInLocation("15:04", at, now.Location()); err == nil {
+			next := time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location())
+			if !next.After(now) {
+				next = next.AddDate(0, 0, 1)
+			}
+			return next, nil
+		}
+		t, err := time.ParseInLocation("2006-01-02 15:04", at, now.Location())
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid time %q: expected HH:MM or YYYY-MM-DD HH:MM", at)
+		}
+		if !t.After(now) {
+			return time.Time{}, fmt.Errorf("time %q is in the past", at)
+		}
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("no time given: use --at or --in")
+}
+
+// ScheduleRun records that a work should be run once at has passed. The
+// control plane starts it; a run that was due while the machine was asleep
+// starts on the next check after it wakes.
+func (s *WorkService) ScheduleRun(ctx context.Context, workID string, at time.Time) error {
+	work, err := s.DB.GetWork(ctx, workID)
+	if err != nil {
+		return fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
+	}
+	return s.DB.SetWorkScheduledRunAt(ctx, workID, &at)
+}
+
+// CancelScheduledRun removes a work's scheduled run.
+func (s *WorkService) CancelScheduledRun(ctx context.Context, workID string) error {
+	work, err := s.DB.GetWork(ctx, workID)
+	if err != nil {
+		return fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
+	}
+	if work.ScheduledRunAt == nil {
+		return fmt.Errorf("work %s has no scheduled run", workID)
+	}
+	return s.DB.SetWorkScheduledRunAt(ctx, workID, nil)
+}
+
+// StartDueScheduledRuns runs the works whose scheduled run time has passed.
+// Runs are kept at least stagger apart: lastStart is when the previous
+// scheduled run started (zero if none), and works that can't start yet are
+// pushed back to their turn. It returns the new lastStart. A run's schedule
+// is cleared before it starts, so a failing run isn't retried.
+// Progress messages are written to the provided writer. Pass io.Discard to suppress output.
+func (s *WorkService) StartDueScheduledRuns(ctx context.Context, now, lastStart time.Time, stagger time.Duration, w io.Writer) (time.Time, error) {
+	due, err := s.DB.GetWorksDueToRun(ctx, now)
+	if err != nil {
+		return lastStart, err
+	}
+
+	next := now
+	if !lastStart.IsZero() && lastStart.Add(stagger).After(now) {
+		next = lastStart.Add(stagger)
+	}
+
+	var errs []error
+	for _, workID := range due {
+		if next.After(now) {
+			at := next
+			if err := s.DB.SetWorkScheduledRunAt(ctx, workID, &at); err != nil {
+				errs = append(errs, err)
+			} else {
+				fmt.Fprintf(w, "Scheduled run of %s staggered to %s\n", workID, at.Format("15:04:05"))
+			}
+			next = next.Add(stagger)
+			continue
+		}
+
+		if err := s.DB.SetWorkScheduledRunAt(ctx, workID, nil); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		lastStart = now
+		next = now.Add(stagger)
+		fmt.Fprintf(w, "Starting scheduled run of %s\n", workID)
+		if _, err := s.RunWork(ctx, workID, false, w); err != nil {
+			errs = append(errs, fmt.Errorf("scheduled run of %s failed: %w", workID, err))
+		}
+	}
+	return lastStart, errors.Join(errs...)
+}
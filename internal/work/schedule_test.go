@@ -0,0 +1,126 @@
+package work_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/newhook/co/internal/testutil"
+	workpkg "github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRunTime(t *testing.T) {
+	now := time.Date(2026, 3, 10, 22, 30, 0, 0, time.Local)
+
+	tests := []struct {
+		name    string
+		at, in  string
+		want    time.Time
+		wantErr string
+	}{
+		{name: "clock time later today", at: "23:15", want: time.Date(2026, 3, 10, 23, 15, 0, 0, time.Local)},
+		{name: "clock time rolls to tomorrow", at: "02:00", want: time.Date(2026, 3, 11, 2, 0, 0, 0, time.Local)},
+		{name: "date and time", at: "2026-03-12 08:00", want: time.Date(2026, 3, 12, 8, 0, 0, 0, time.Local)},
+		{name: "delay", in: "4h", want: now.Add(4 * time.Hour)},
+		{name: "date in the past", at: "2026-03-01 08:00", wantErr: "in the past"},
+		{name: "bad time", at: "tonight", wantErr: "invalid time"},
+		{name: "negative delay", in: "-1h", wantErr: "must be positive"},
+		{name: "both", at: "02:00", in: "1h", wantErr: "not both"},
+		{name: "neither", wantErr: "no time given"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := workpkg.ParseRunTime(tt.at, tt.in, now)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %s, want %s", got, tt.want)
+		})
+	}
+}
+
+func TestScheduleRun(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.CreateWork("w-test", "feat/test-branch")
+
+	err := h.WorkService.CancelScheduledRun(ctx, "w-test")
+	require.ErrorContains(t, err, "no scheduled run")
+
+	at := time.Now().Add(time.Hour).Truncate(time.Second)
+	require.NoError(t, h.WorkService.ScheduleRun(ctx, "w-test", at))
+	work, err := h.DB.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	require.NotNil(t, work.ScheduledRunAt)
+	assert.True(t, at.Equal(*work.ScheduledRunAt))
+
+	require.NoError(t, h.WorkService.CancelScheduledRun(ctx, "w-test"))
+	work, err = h.DB.GetWork(ctx, "w-test")
+	require.NoError(t, err)
+	assert.Nil(t, work.ScheduledRunAt)
+
+	err = h.WorkService.ScheduleRun(ctx, "w-missing", at)
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestStartDueScheduledRuns(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.CreateBead("bead-1", "Feature A")
+	h.CreateBead("bead-2", "Feature B")
+	first := h.CreateWork("w-one", "feat/one")
+	h.AddBeadToWork("w-one", "bead-1")
+	second := h.CreateWork("w-two", "feat/two")
+	h.AddBeadToWork("w-two", "bead-2")
+	h.CreateWork("w-later", "feat/later")
+	h.Worktree.ExistsPathFunc = func(worktreePath string) bool {
+		return worktreePath == first.WorktreePath || worktreePath == second.WorktreePath
+	}
+
+	now := time.Now().Truncate(time.Second)
+	require.NoError(t, h.WorkService.ScheduleRun(ctx, "w-one", now.Add(-2*time.Minute)))
+	require.NoError(t, h.WorkService.ScheduleRun(ctx, "w-two", now.Add(-time.Minute)))
+	require.NoError(t, h.WorkService.ScheduleRun(ctx, "w-later", now.Add(time.Hour)))
+
+	stagger := 2 * time.Minute
+	lastStart, err := h.WorkService.StartDueScheduledRuns(ctx, now, time.Time{}, stagger, io.Discard)
+	require.NoError(t, err)
+	assert.True(t, now.Equal(lastStart))
+
+	// The first due work started and its schedule was cleared
+	one, err := h.DB.GetWork(ctx, "w-one")
+	require.NoError(t, err)
+	assert.Nil(t, one.ScheduledRunAt)
+	tasks, err := h.DB.GetWorkTasks(ctx, "w-one")
+	require.NoError(t, err)
+	assert.Len(t, tasks, 1)
+
+	// The second was pushed back by the stagger
+	two, err := h.DB.GetWork(ctx, "w-two")
+	require.NoError(t, err)
+	require.NotNil(t, two.ScheduledRunAt)
+	assert.True(t, now.Add(stagger).Equal(*two.ScheduledRunAt))
+
+	// Works that aren't due are left alone
+	later, err := h.DB.GetWork(ctx, "w-later")
+	require.NoError(t, err)
+	require.NotNil(t, later.ScheduledRunAt)
+	assert.True(t, now.Add(time.Hour).Equal(*later.ScheduledRunAt))
+
+	// Once the stagger has passed the second work starts
+	lastStart, err = h.WorkService.StartDueScheduledRuns(ctx, now.Add(stagger), lastStart, stagger, io.Discard)
+	require.NoError(t, err)
+	assert.True(t, now.Add(stagger).Equal(lastStart))
+	two, err = h.DB.GetWork(ctx, "w-two")
+	require.NoError(t, err)
+	assert.Nil(t, two.ScheduledRunAt)
+}
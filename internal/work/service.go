@@ -9,6 +9,7 @@ import (
 	"github.com/newhook/co/internal/github"
 	"github.com/newhook/co/internal/names"
 	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/remote"
 	"github.com/newhook/co/internal/task"
 	"github.com/newhook/co/internal/worktree"
 )
@@ -17,7 +18,7 @@ import (
 // This enables both CLI and TUI to share the same tested core logic,
 // and allows integration testing without external dependencies.
 type WorkService struct {
-	DB                  *db.DB
+	DB                  db.Store
 	Git                 git.Operations
 	Worktree            worktree.Operations
 	GitHubClient        github.ClientInterface
@@ -26,6 +27,7 @@ type WorkService struct {
 	OrchestratorManager OrchestratorManager
 	TaskPlanner         task.Planner
 	NameGenerator       names.Generator
+	Remote              remote.Operations
 	Config              *project.Config
 	ProjectRoot         string // Root directory of the project
 	MainRepoPath        string // Path to the main repository
@@ -47,6 +49,7 @@ func NewWorkService(proj *project.Project) *WorkService {
 		OrchestratorManager: NewOrchestratorManager(proj.DB),
 		TaskPlanner:         nil, // Planner needs specific initialization, set separately if needed
 		NameGenerator:       names.NewGenerator(),
+		Remote:              remote.NewOperations(),
 		Config:              proj.Config,
 		ProjectRoot:         proj.Root,
 		MainRepoPath:        proj.MainRepoPath(),
@@ -57,7 +60,7 @@ func NewWorkService(proj *project.Project) *WorkService {
 // WorkServiceDeps contains all dependencies for a WorkService.
 // Used for testing to inject mocks for all dependencies.
 type WorkServiceDeps struct {
-	DB                  *db.DB
+	DB                  db.Store
 	Git                 git.Operations
 	Worktree            worktree.Operations
 	GitHubClient        github.ClientInterface
@@ -66,6 +69,7 @@ type WorkServiceDeps struct {
 	OrchestratorManager OrchestratorManager
 	TaskPlanner         task.Planner
 	NameGenerator       names.Generator
+	Remote              remote.Operations
 	Config              *project.Config
 	ProjectRoot         string
 	MainRepoPath        string
@@ -85,6 +89,7 @@ func NewWorkServiceWithDeps(deps WorkServiceDeps) *WorkService {
 		OrchestratorManager: deps.OrchestratorManager,
 		TaskPlanner:         deps.TaskPlanner,
 		NameGenerator:       deps.NameGenerator,
+		Remote:              deps.Remote,
 		Config:              deps.Config,
 		ProjectRoot:         deps.ProjectRoot,
 		MainRepoPath:        deps.MainRepoPath,
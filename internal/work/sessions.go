@@ -0,0 +1,77 @@
+package work
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/db"
+)
+
+// StaleTab is a zellij tab co created whose work, task or bead is gone.
+type StaleTab struct {
+	Name   string
+	Kind   string // One of the TabKind constants
+	ID     string // Work, task or bead ID parsed from the name
+	Reason string
+}
+
+// FindStaleTabs returns the tabs among tabNames that no longer belong to a
+// live work or bead: tabs of deleted works, of deleted or finished tasks, and
+// planning tabs of deleted or closed beads. Tabs co didn't create are never
+// returned.
+func (s *WorkService) FindStaleTabs(ctx context.Context, tabNames []string) ([]StaleTab, error) {
+	var stale []StaleTab
+	for _, name := range tabNames {
+		kind, id, ok := ParseTabName(name)
+		if !ok {
+			continue
+		}
+		reason, err := s.staleTabReason(ctx, kind, id, name)
+		if err != nil {
+			return nil, err
+		}
+		if reason != "" {
+			stale = append(stale, StaleTab{Name: name, Kind: kind, ID: id, Reason: reason})
+		}
+	}
+	return stale, nil
+}
+
+// staleTabReason returns why a co tab is stale, or "" if it is still in use
+func (s *WorkService) staleTabReason(ctx context.Context, kind, id, name string) (string, error) {
+	switch kind {
+	case TabKindPlan:
+		bead, err := s.BeadsReader.GetBead(ctx, id)
+		if err != nil {
+			return "", fmt.Errorf("failed to get bead %s: %w", id, err)
+		}
+		if bead == nil {
+			return fmt.Sprintf("bead %s no longer exists", id), nil
+		}
+		if bead.Status == beads.StatusClosed {
+			return fmt.Sprintf("bead %s is closed", id), nil
+		}
+	case TabKindTask:
+		task, err := s.DB.GetTask(ctx, id)
+		if err != nil {
+			return "", fmt.Errorf("failed to get task %s: %w", id, err)
+		}
+		if task == nil {
+			return fmt.Sprintf("task %s no longer exists", id), nil
+		}
+		if task.Status == db.StatusCompleted || task.Status == db.StatusFailed {
+			return fmt.Sprintf("task %s is %s", id, task.Status), nil
+		}
+	default:
+		workID := TabWorkID(name)
+		work, err := s.DB.GetWork(ctx, workID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get work %s: %w", workID, err)
+		}
+		if work == nil {
+			return fmt.Sprintf("work %s no longer exists", workID), nil
+		}
+	}
+	return "", nil
+}
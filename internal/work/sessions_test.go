@@ -0,0 +1,98 @@
+package work_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/newhook/co/internal/beads"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTabName(t *testing.T) {
+	tests := []struct {
+		name   string
+		kind   string
+		id     string
+		workID string
+	}{
+		{name: "work-w-abc", kind: work.TabKindWork, id: "w-abc", workID: "w-abc"},
+		{name: "work-w-abc (swift-fox)", kind: work.TabKindWork, id: "w-abc", workID: "w-abc"},
+		{name: "console-w-abc (swift-fox)", kind: work.TabKindConsole, id: "w-abc", workID: "w-abc"},
+		{name: "claude-w-abc", kind: work.TabKindClaude, id: "w-abc", workID: "w-abc"},
+		{name: "task-w-abc.2", kind: work.TabKindTask, id: "w-abc.2", workID: "w-abc"},
+		{name: "plan-proj-12", kind: work.TabKindPlan, id: "proj-12"},
+		{name: "control"},
+		{name: "Tab #3"},
+		{name: "editor-w-abc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, id, ok := work.ParseTabName(tt.name)
+			assert.Equal(t, tt.kind != "", ok)
+			assert.Equal(t, tt.kind, kind)
+			assert.Equal(t, tt.id, id)
+			assert.Equal(t, tt.workID, work.TabWorkID(tt.name))
+		})
+	}
+}
+
+func TestFindStaleTabs(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+
+	h.CreateWork("w-live", "feat/live")
+	h.CreateTask("w-live.1", "w-live", nil)
+	h.CreateTask("w-live.2", "w-live", nil)
+	h.CompleteTask("w-live.2")
+	h.CreateBead("bead-open", "Open")
+	h.CreateBead("bead-closed", "Closed").Status = beads.StatusClosed
+
+	stale, err := h.WorkService.FindStaleTabs(ctx, []string{
+		"control",
+		"work-w-live (swift-fox)",
+		"console-w-live",
+		"task-w-live.1",
+		"task-w-live.2",
+		"work-w-gone",
+		"claude-w-livelier",
+		"plan-bead-open",
+		"plan-bead-closed",
+		"plan-bead-gone",
+	})
+	require.NoError(t, err)
+
+	reasons := make(map[string]string)
+	for _, tab := range stale {
+		reasons[tab.Name] = tab.Reason
+	}
+	assert.Equal(t, map[string]string{
+		"task-w-live.2":     "task w-live.2 is completed",
+		"work-w-gone":       "work w-gone no longer exists",
+		"claude-w-livelier": "work w-livelier no longer exists",
+		"plan-bead-closed":  "bead bead-closed is closed",
+		"plan-bead-gone":    "bead bead-gone no longer exists",
+	}, reasons)
+}
+
+func TestDestroyWork_EndsRootIssuePlanSession(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+
+	h.CreateBead("root-bead", "Root Issue")
+	h.CreateWorkWithRootIssue("w-test", "feat/test", "root-bead")
+
+	var ended []string
+	h.OrchestratorManager.TerminatePlanSessionsFunc = func(ctx context.Context, beadIDs []string, projName string, w io.Writer) error {
+		ended = append(ended, beadIDs...)
+		return nil
+	}
+
+	require.NoError(t, h.WorkService.DestroyWork(ctx, "w-test", io.Discard))
+	assert.Equal(t, []string{"root-bead"}, ended)
+}
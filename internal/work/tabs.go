@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/remote"
 )
 
 // PlanTabName returns the zellij tab name for a bead's planning session.
@@ -18,15 +19,65 @@ func PlanTabName(beadID string) string {
 	return fmt.Sprintf("plan-%s", beadID)
 }
 
+// TaskTabName returns the zellij tab name for a task run in its own session.
+func TaskTabName(taskID string) string {
+	return fmt.Sprintf("task-%s", taskID)
+}
+
+// Kinds of zellij tabs co creates, named "<kind>-<id>" with an optional
+// " (friendly name)" suffix.
+const (
+	TabKindWork    = "work"    // Work orchestrator; the ID is a work ID
+	TabKindTask    = "task"    // Single task run; the ID is a task ID
+	TabKindConsole = "console" // Shell in a worktree; the ID is a work ID
+	TabKindClaude  = "claude"  // Interactive Claude session; the ID is a work ID
+	TabKindPlan    = "plan"    // Planning session; the ID is a bead ID
+)
+
+// ParseTabName returns the kind of a tab co created and the ID of the work,
+// task or bead it belongs to. ok is false for any other tab, such as the
+// control plane or one the user opened.
+func ParseTabName(name string) (kind, id string, ok bool) {
+	name = strings.TrimSpace(name)
+	if i := strings.Index(name, " ("); i >= 0 && strings.HasSuffix(name, ")") {
+		name = name[:i]
+	}
+	kind, id, found := strings.Cut(name, "-")
+	if !found || id == "" {
+		return "", "", false
+	}
+	switch kind {
+	case TabKindWork, TabKindTask, TabKindConsole, TabKindClaude, TabKindPlan:
+		return kind, id, true
+	}
+	return "", "", false
+}
+
+// TabWorkID returns the ID of the work a tab belongs to, or "" for plan
+// tabs and tabs co didn't create.
+func TabWorkID(name string) string {
+	kind, id, ok := ParseTabName(name)
+	switch {
+	case !ok || kind == TabKindPlan:
+		return ""
+	case kind == TabKindTask:
+		// Task IDs are "<work-id>.<n>"
+		workID, _, _ := strings.Cut(id, ".")
+		return workID
+	}
+	return id
+}
+
 // OpenConsole creates a zellij tab with a shell in the work's worktree.
 // The tab is named "console-<work-id>" or "console-<work-id> (friendlyName)" for easy identification.
 // The hooksEnv parameter contains environment variables to export (format: "KEY=value").
+// For a remote work, remoteHost is set and the shell runs there over ssh.
 // Progress messages are written to the provided writer. Pass io.Discard to suppress output.
 //
 // IMPORTANT: The zellij session must already exist before calling this function.
 // Callers should use control.EnsureControlPlane to ensure
 // the session exists with the control plane running.
-func (m *DefaultOrchestratorManager) OpenConsole(ctx context.Context, workID string, projectName string, workDir string, friendlyName string, hooksEnv []string, w io.Writer) error {
+func (m *DefaultOrchestratorManager) OpenConsole(ctx context.Context, workID string, projectName string, workDir string, remoteHost string, friendlyName string, hooksEnv []string, w io.Writer) error {
 	sessionName := project.SessionNameForProject(projectName)
 	tabName := project.FormatTabName("console", workID, friendlyName)
 
@@ -57,7 +108,13 @@ func (m *DefaultOrchestratorManager) OpenConsole(ctx context.Context, workID str
 
 	var command string
 	var args []string
-	if len(hooksEnv) > 0 {
+	if remoteHost != "" {
+		// The remote user's own shell, in the worktree on the host
+		command = "ssh"
+		args = remote.InteractiveArgs(remoteHost, workDir, remote.ExportCommand(hooksEnv, `exec "${SHELL:-bash}" -l`))
+		shellName = "ssh"
+		workDir = localTabDir()
+	} else if len(hooksEnv) > 0 {
 		var exports []string
 		for _, env := range hooksEnv {
 			exports = append(exports, fmt.Sprintf("export %s", env))
@@ -85,12 +142,13 @@ func (m *DefaultOrchestratorManager) OpenConsole(ctx context.Context, workID str
 // The tab is named "claude-<work-id>" or "claude-<work-id> (friendlyName)" for easy identification.
 // The hooksEnv parameter contains environment variables to export (format: "KEY=value").
 // The config parameter controls Claude settings like --dangerously-skip-permissions.
+// For a remote work, remoteHost is set and Claude runs there over ssh.
 // Progress messages are written to the provided writer. Pass io.Discard to suppress output.
 //
 // IMPORTANT: The zellij session must already exist before calling this function.
 // Callers should use control.EnsureControlPlane to ensure
 // the session exists with the control plane running.
-func (m *DefaultOrchestratorManager) OpenClaudeSession(ctx context.Context, workID string, projectName string, workDir string, friendlyName string, hooksEnv []string, cfg *project.Config, w io.Writer) error {
+func (m *DefaultOrchestratorManager) OpenClaudeSession(ctx context.Context, workID string, projectName string, workDir string, remoteHost string, friendlyName string, hooksEnv []string, cfg *project.Config, w io.Writer) error {
 	sessionName := project.SessionNameForProject(projectName)
 	tabName := project.FormatTabName("claude", workID, friendlyName)
 
@@ -120,7 +178,12 @@ func (m *DefaultOrchestratorManager) OpenClaudeSession(ctx context.Context, work
 	// If we have environment variables, use bash -c to export them
 	var command string
 	var args []string
-	if len(hooksEnv) > 0 {
+	if remoteHost != "" {
+		claudeCmd := strings.Join(append([]string{"claude"}, claudeArgs...), " ")
+		command = "ssh"
+		args = remote.InteractiveArgs(remoteHost, workDir, remote.ExportCommand(hooksEnv, claudeCmd))
+		workDir = localTabDir()
+	} else if len(hooksEnv) > 0 {
 		var exports []string
 		for _, env := range hooksEnv {
 			exports = append(exports, fmt.Sprintf("export %s", env))
@@ -190,3 +253,30 @@ func (m *DefaultOrchestratorManager) SpawnPlanSession(ctx context.Context, beadI
 	fmt.Fprintf(w, "Plan session spawned in zellij session %s, tab %s\n", sessionName, tabName)
 	return nil
 }
+
+// SpawnTaskSession creates a zellij tab that runs a single task of a work, so
+// an orchestrator running tasks in parallel gives each its own Claude session.
+// The tab is named "task-<task-id>". claimedBy is the orchestrator that claimed
+// the task; the spawned process takes the claim over from it.
+// Progress messages are written to the provided writer. Pass io.Discard to suppress output.
+func (m *DefaultOrchestratorManager) SpawnTaskSession(ctx context.Context, workID, taskID, projectName, workDir, claimedBy string, w io.Writer) error {
+	sessionName := project.SessionNameForProject(projectName)
+	tabName := TaskTabName(taskID)
+
+	session := m.zellij.Session(sessionName)
+	if tabExists, _ := session.TabExists(ctx, tabName); tabExists {
+		// A leftover tab from an earlier run of the task
+		if err := session.TerminateAndCloseTab(ctx, tabName); err != nil {
+			fmt.Fprintf(w, "Warning: failed to terminate existing tab: %v\n", err)
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+
+	args := []string{"orchestrate", "--work", workID, "--task", taskID, "--claimed-by", claimedBy}
+	if err := session.CreateTabWithCommand(ctx, tabName, workDir, "co", args, "task"); err != nil {
+		return fmt.Errorf("failed to create tab: %w", err)
+	}
+
+	fmt.Fprintf(w, "Task %s spawned in tab %s\n", taskID, tabName)
+	return nil
+}
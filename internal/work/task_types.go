@@ -0,0 +1,96 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/newhook/co/internal/claude"
+	"github.com/newhook/co/internal/coerrors"
+)
+
+// CreateCustomTaskResult contains the result of creating a custom task.
+type CreateCustomTaskResult struct {
+	TaskID              string
+	OrchestratorSpawned bool
+}
+
+// CreateCustomTask creates a task of a custom type from [workflow.task_types]
+// for a work and makes sure the work's orchestrator is running to pick it up.
+// Progress messages are written to the provided writer. Pass io.Discard to suppress output.
+func (s *WorkService) CreateCustomTask(ctx context.Context, workID, typeName string, w io.Writer) (*CreateCustomTaskResult, error) {
+	taskType, ok := s.Config.Workflow.GetTaskType(typeName)
+	if !ok {
+		names := s.Config.Workflow.TaskTypeNames()
+		if len(names) == 0 {
+			return nil, fmt.Errorf("unknown task type %q: no task types configured in [workflow.task_types]", typeName)
+		}
+		return nil, fmt.Errorf("unknown task type %q (configured: %s)", typeName, strings.Join(names, ", "))
+	}
+
+	work, err := s.DB.GetWork(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
+	}
+
+	// Catch template mistakes now rather than when the orchestrator runs the task
+	tmpl, err := claude.LoadCustomTaskTemplate(s.ProjectRoot, taskType.Prompt)
+	if err != nil {
+		return nil, fmt.Errorf("task type %s: %w", typeName, err)
+	}
+	if _, err := claude.BuildCustomTaskPrompt(tmpl, claude.CustomTaskParams{}); err != nil {
+		return nil, fmt.Errorf("task type %s: %w", typeName, err)
+	}
+
+	if taskType.NeedsBeads {
+		workBeads, err := s.DB.GetWorkBeads(ctx, workID)
+		if err != nil {
+			return nil, err
+		}
+		if len(workBeads) == 0 {
+			return nil, fmt.Errorf("task type %s needs beads but work %s has none", typeName, workID)
+		}
+	}
+
+	if taskType.MaxIterations > 0 {
+		tasks, err := s.DB.GetWorkTasks(ctx, workID)
+		if err != nil {
+			return nil, err
+		}
+		count := 0
+		for _, t := range tasks {
+			if t.TaskType == typeName {
+				count++
+			}
+		}
+		if count >= taskType.MaxIterations {
+			return nil, fmt.Errorf("work %s already has %d %s task(s) (max_iterations = %d)", workID, count, typeName, taskType.MaxIterations)
+		}
+	}
+
+	taskNum, err := s.DB.GetNextTaskNumber(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get next task number: %w", err)
+	}
+	taskID := fmt.Sprintf("%s.%d", workID, taskNum)
+	if err := s.DB.CreateTask(ctx, taskID, typeName, []string{}, 0, workID); err != nil {
+		return nil, fmt.Errorf("failed to create %s task: %w", typeName, err)
+	}
+	fmt.Fprintf(w, "Created %s task %s\n", typeName, taskID)
+
+	result := &CreateCustomTaskResult{TaskID: taskID}
+
+	// The worktree is created asynchronously; the orchestrator picks the task up once it exists
+	if work.WorktreePath == "" {
+		return result, nil
+	}
+	result.OrchestratorSpawned, err = s.OrchestratorManager.EnsureWorkOrchestrator(ctx, workID, s.Config.Project.Name, work.SessionDir(s.ProjectRoot), work.Name, w)
+	if err != nil {
+		return nil, &SpawnError{Err: err}
+	}
+	return result, nil
+}
@@ -0,0 +1,74 @@
+package work_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/newhook/co/internal/project"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCustomTask(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	root := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(root, "audit.tmpl"), []byte("Audit {{.BranchName}}"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "typo.tmpl"), []byte("{{.Branch}}"), 0644))
+	h.WorkService.ProjectRoot = root
+	h.Config.Workflow.TaskTypes = map[string]project.TaskTypeConfig{
+		"audit":   {Prompt: "audit.tmpl", MaxIterations: 1},
+		"summary": {Prompt: "audit.tmpl", NeedsBeads: true},
+		"typo":    {Prompt: "typo.tmpl"},
+		"review":  {Prompt: "audit.tmpl"},
+	}
+	h.CreateWork("w-test", "feat/test-branch")
+
+	result, err := h.WorkService.CreateCustomTask(ctx, "w-test", "audit", io.Discard)
+	require.NoError(t, err)
+	task, err := h.DB.GetTask(ctx, result.TaskID)
+	require.NoError(t, err)
+	assert.Equal(t, "audit", task.TaskType)
+	assert.Equal(t, "w-test", task.WorkID)
+	assert.True(t, result.OrchestratorSpawned)
+	assert.Len(t, h.OrchestratorManager.EnsureWorkOrchestratorCalls(), 1)
+
+	// max_iterations caps how many tasks of the type a work gets
+	_, err = h.WorkService.CreateCustomTask(ctx, "w-test", "audit", io.Discard)
+	require.ErrorContains(t, err, "max_iterations")
+
+	// needs_beads refuses a work without beads
+	_, err = h.WorkService.CreateCustomTask(ctx, "w-test", "summary", io.Discard)
+	require.ErrorContains(t, err, "needs beads")
+	h.AddBeadToWork("w-test", "bead-1")
+	_, err = h.WorkService.CreateCustomTask(ctx, "w-test", "summary", io.Discard)
+	require.NoError(t, err)
+
+	// Template errors surface when creating, not when the task runs
+	_, err = h.WorkService.CreateCustomTask(ctx, "w-test", "typo", io.Discard)
+	require.ErrorContains(t, err, "failed to render prompt template")
+
+	// Built-in names can't be overridden, and unknown names list the configured ones
+	_, err = h.WorkService.CreateCustomTask(ctx, "w-test", "review", io.Discard)
+	require.ErrorContains(t, err, "configured: audit, summary, typo")
+	_, err = h.WorkService.CreateCustomTask(ctx, "w-missing", "audit", io.Discard)
+	require.ErrorContains(t, err, "not found")
+
+	// A task created before the orchestrator failed to start is reported as
+	// a spawn failure
+	h.OrchestratorManager.EnsureWorkOrchestratorFunc = func(ctx context.Context, workID, projName, workDir, friendlyName string, w io.Writer) (bool, error) {
+		return false, errors.New("zellij not found")
+	}
+	_, err = h.WorkService.CreateCustomTask(ctx, "w-test", "summary", io.Discard)
+	var spawnErr *work.SpawnError
+	require.ErrorAs(t, err, &spawnErr)
+	require.EqualError(t, err, "failed to ensure orchestrator: zellij not found")
+}
@@ -0,0 +1,173 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/db"
+)
+
+// CreatePRTaskResult contains the result of creating a PR task.
+type CreatePRTaskResult struct {
+	TaskID string
+	// PRExists is true if a PR already exists for this work
+	PRExists bool
+	PRURL    string
+}
+
+// CreatePRTask creates a PR task for a work unit.
+// The work must be completed before a PR task can be created.
+// Returns an error if the work is not completed, or PRExists=true if a PR already exists.
+func (s *WorkService) CreatePRTask(ctx context.Context, workID string) (*CreatePRTaskResult, error) {
+	work, err := s.DB.GetWork(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
+	}
+
+	if work.Status != db.StatusCompleted {
+		return nil, fmt.Errorf("work %s is not completed (status: %s)", workID, work.Status)
+	}
+
+	if work.PRURL != "" {
+		return &CreatePRTaskResult{
+			PRExists: true,
+			PRURL:    work.PRURL,
+		}, nil
+	}
+
+	prTaskNum, err := s.DB.GetNextTaskNumber(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get next task number for PR: %w", err)
+	}
+	prTaskID := fmt.Sprintf("%s.%d", workID, prTaskNum)
+
+	if err := s.DB.CreateTask(ctx, prTaskID, db.TaskTypePR, []string{}, 0, workID); err != nil {
+		return nil, fmt.Errorf("failed to create PR task: %w", err)
+	}
+
+	return &CreatePRTaskResult{
+		TaskID: prTaskID,
+	}, nil
+}
+
+// CreateReviewTaskOptions contains options for creating a review task.
+type CreateReviewTaskOptions struct {
+	// AfterImplement makes the review wait for implement tasks that haven't
+	// finished yet, for reviews the orchestrator picks up.
+	AfterImplement bool
+	// IncludeCIFailures gives the review the checks failing on the work's
+	// PR, with an excerpt of their logs. The work must have a PR with a
+	// failing check.
+	IncludeCIFailures bool
+}
+
+// CreateReviewTaskResult contains the result of creating a review task.
+type CreateReviewTaskResult struct {
+	TaskID    string
+	DependsOn []string // Implement tasks the review waits for
+}
+
+// CreateReviewTask creates a review task for a work unit.
+// Review tasks examine code changes for quality and security issues.
+func (s *WorkService) CreateReviewTask(ctx context.Context, workID string, opts CreateReviewTaskOptions) (*CreateReviewTaskResult, error) {
+	work, err := s.DB.GetWork(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
+	}
+
+	// Look the failures up first, so a failed lookup doesn't leave a task behind
+	var ciFailures string
+	if opts.IncludeCIFailures {
+		ciFailures, err = s.describeCIFailures(ctx, work)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reviewTaskNum, err := s.DB.GetNextTaskNumber(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get next task number for review: %w", err)
+	}
+	reviewTaskID := fmt.Sprintf("%s.%d", workID, reviewTaskNum)
+
+	var tasks []*db.Task
+	if opts.AfterImplement {
+		tasks, err = s.DB.GetWorkTasks(ctx, workID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get work tasks: %w", err)
+		}
+	}
+
+	result := &CreateReviewTaskResult{TaskID: reviewTaskID}
+	for _, t := range tasks {
+		if t.TaskType != db.TaskTypeImplement || (t.Status != db.StatusPending && t.Status != db.StatusProcessing) {
+			continue
+		}
+		result.DependsOn = append(result.DependsOn, t.ID)
+	}
+	var metadata map[string]string
+	if ciFailures != "" {
+		metadata = map[string]string{CIFailuresMetadataKey: ciFailures}
+	}
+
+	// All at once, so the review never exists without the tasks it waits for
+	if err := s.DB.CreateDependentTask(ctx, reviewTaskID, db.TaskTypeReview, []string{}, 0, workID, result.DependsOn, metadata); err != nil {
+		return nil, fmt.Errorf("failed to create review task: %w", err)
+	}
+	return result, nil
+}
+
+// RunTask runs a single pending task of a work right away in its own tab,
+// without waiting for the orchestrator or running other pending tasks.
+// The task is claimed for this process and handed over to the spawned
+// process, so a running orchestrator skips it.
+func (s *WorkService) RunTask(ctx context.Context, taskID string, w io.Writer) error {
+	t, err := s.DB.GetTask(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to get task: %w", err)
+	}
+	if t == nil {
+		return coerrors.Errorf(coerrors.NotFound, "task %s not found", taskID)
+	}
+	if t.Status != db.StatusPending {
+		return fmt.Errorf("task %s is not pending (status: %s)", taskID, t.Status)
+	}
+
+	work, err := s.DB.GetWork(ctx, t.WorkID)
+	if err != nil {
+		return fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return coerrors.Errorf(coerrors.NotFound, "work %s not found", t.WorkID)
+	}
+	if work.WorktreePath == "" {
+		return fmt.Errorf("work %s has no worktree yet", work.ID)
+	}
+
+	claimant := db.TaskClaimant()
+	ok, err := s.DB.ClaimTask(ctx, taskID, claimant, "")
+	if err != nil {
+		return fmt.Errorf("failed to claim task: %w", err)
+	}
+	if !ok {
+		holder, _ := s.DB.GetTaskClaimant(ctx, taskID)
+		return fmt.Errorf("task %s is already claimed by %s", taskID, holder)
+	}
+
+	if err := s.OrchestratorManager.SpawnTaskSession(ctx, work.ID, taskID, s.Config.Project.Name, work.SessionDir(s.ProjectRoot), claimant, w); err != nil {
+		// Release the claim so the orchestrator can still run the task
+		if resetErr := s.DB.ResetTaskStatus(ctx, taskID); resetErr != nil {
+			fmt.Fprintf(w, "Warning: failed to release claim on %s: %v\n", taskID, resetErr)
+		}
+		return fmt.Errorf("failed to spawn task: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package work_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/github"
+	"github.com/newhook/co/internal/testutil"
+	workpkg "github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateReviewTask(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.CreateWork("w-test", "feat/test-branch")
+	h.CreateTask("w-test.a", "w-test", nil)
+	h.CreateTask("w-test.b", "w-test", nil)
+	h.CompleteTask("w-test.b")
+
+	// A manual review doesn't wait for anything
+	result, err := h.WorkService.CreateReviewTask(ctx, "w-test", workpkg.CreateReviewTaskOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "w-test.1", result.TaskID)
+	assert.Empty(t, result.DependsOn)
+
+	// An orchestrated review waits for the unfinished implement task only
+	result, err = h.WorkService.CreateReviewTask(ctx, "w-test", workpkg.CreateReviewTaskOptions{AfterImplement: true})
+	require.NoError(t, err)
+	assert.Equal(t, "w-test.2", result.TaskID)
+	assert.Equal(t, []string{"w-test.a"}, result.DependsOn)
+	deps, err := h.DB.GetTaskDependencies(ctx, result.TaskID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"w-test.a"}, deps)
+
+	_, err = h.WorkService.CreateReviewTask(ctx, "w-missing", workpkg.CreateReviewTaskOptions{})
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestCreateReviewTaskIncludingCIFailures(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.CreateWork("w-test", "feat/test-branch")
+	opts := workpkg.CreateReviewTaskOptions{IncludeCIFailures: true}
+
+	// There's nothing to include without a PR
+	_, err := h.WorkService.CreateReviewTask(ctx, "w-test", opts)
+	require.Equal(t, coerrors.Validation, coerrors.KindOf(err))
+
+	const prURL = "https://github.com/owner/repo/pull/7"
+	require.NoError(t, h.DB.IdleWorkWithPR(ctx, "w-test", prURL))
+	var checks []github.PRCheck
+	h.GitHub.GetPRChecksFunc = func(ctx context.Context, url string, requiredOnly bool) ([]github.PRCheck, error) {
+		require.Equal(t, prURL, url)
+		return checks, nil
+	}
+	h.GitHub.GetJobLogsFunc = func(ctx context.Context, repo string, jobID int64) (string, error) {
+		require.Equal(t, "owner/repo", repo)
+		require.Equal(t, int64(42), jobID)
+		return "setup\n--- FAIL: TestParse\nexit status 1\n", nil
+	}
+
+	// Nor when every check passes; no task is left behind
+	checks = []github.PRCheck{{Name: "test", Bucket: "pass"}}
+	_, err = h.WorkService.CreateReviewTask(ctx, "w-test", opts)
+	require.Equal(t, coerrors.Conflict, coerrors.KindOf(err))
+	tasks, err := h.DB.GetWorkTasks(ctx, "w-test")
+	require.NoError(t, err)
+	require.Empty(t, tasks)
+
+	checks = []github.PRCheck{
+		{Name: "test", Workflow: "CI", Bucket: "fail", Link: "https://github.com/owner/repo/actions/runs/9/job/42"},
+		{Name: "license/cla", Bucket: "fail", Link: "https://cla.example.com/check"},
+		{Name: "lint", Bucket: "pass"},
+	}
+	result, err := h.WorkService.CreateReviewTask(ctx, "w-test", opts)
+	require.NoError(t, err)
+	failures, err := h.DB.GetTaskMetadata(ctx, result.TaskID, workpkg.CIFailuresMetadataKey)
+	require.NoError(t, err)
+	assert.Contains(t, failures, "### CI / test")
+	assert.Contains(t, failures, "--- FAIL: TestParse\nexit status 1")
+	assert.Contains(t, failures, "### license/cla\nhttps://cla.example.com/check")
+	assert.NotContains(t, failures, "lint")
+	require.Len(t, h.GitHub.GetJobLogsCalls(), 1)
+}
+
+func TestCreatePRTask(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.CreateWork("w-test", "feat/test-branch")
+
+	_, err := h.WorkService.CreatePRTask(ctx, "w-test")
+	require.ErrorContains(t, err, "not completed")
+
+	require.NoError(t, h.DB.CompleteWork(ctx, "w-test", ""))
+	result, err := h.WorkService.CreatePRTask(ctx, "w-test")
+	require.NoError(t, err)
+	require.False(t, result.PRExists)
+	task, err := h.DB.GetTask(ctx, result.TaskID)
+	require.NoError(t, err)
+	assert.Equal(t, "pr", task.TaskType)
+
+	require.NoError(t, h.DB.CompleteWork(ctx, "w-test", "https://github.com/o/r/pull/1"))
+	result, err = h.WorkService.CreatePRTask(ctx, "w-test")
+	require.NoError(t, err)
+	assert.True(t, result.PRExists)
+	assert.Equal(t, "https://github.com/o/r/pull/1", result.PRURL)
+}
+
+func TestRunTask(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.CreateWork("w-test", "feat/test-branch")
+	h.CreateTask("w-test.a", "w-test", nil)
+	h.CreateTask("w-test.b", "w-test", nil)
+	h.CompleteTask("w-test.b")
+
+	spawnErr := errors.New("zellij not running")
+	h.OrchestratorManager.SpawnTaskSessionFunc = func(ctx context.Context, workID, taskID, projName, workDir, claimedBy string, w io.Writer) error {
+		return spawnErr
+	}
+
+	// A failed spawn releases the claim so the orchestrator can still run it
+	err := h.WorkService.RunTask(ctx, "w-test.a", io.Discard)
+	require.ErrorIs(t, err, spawnErr)
+	claimant, err := h.DB.GetTaskClaimant(ctx, "w-test.a")
+	require.NoError(t, err)
+	assert.Empty(t, claimant)
+
+	spawnErr = nil
+	require.NoError(t, h.WorkService.RunTask(ctx, "w-test.a", io.Discard))
+	calls := h.OrchestratorManager.SpawnTaskSessionCalls()
+	require.Len(t, calls, 2)
+	assert.Equal(t, "w-test", calls[1].WorkID)
+	assert.Equal(t, "/test/project/w-test/tree", calls[1].WorkDir)
+	claimant, err = h.DB.GetTaskClaimant(ctx, "w-test.a")
+	require.NoError(t, err)
+	assert.Equal(t, calls[1].ClaimedBy, claimant)
+
+	// A claimed task can't be started twice
+	err = h.WorkService.RunTask(ctx, "w-test.a", io.Discard)
+	require.ErrorContains(t, err, "already claimed")
+
+	err = h.WorkService.RunTask(ctx, "w-test.b", io.Discard)
+	require.ErrorContains(t, err, "not pending")
+
+	err = h.WorkService.RunTask(ctx, "w-missing.1", io.Discard)
+	require.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,149 @@
+package work
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/newhook/co/internal/beads/pubsub"
+	"github.com/newhook/co/internal/coerrors"
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/project"
+	trackingwatcher "github.com/newhook/co/internal/tracking/watcher"
+)
+
+// DefaultWaitPollInterval is how often WaitForWork re-checks task status when
+// no database change event arrives.
+const DefaultWaitPollInterval = 5 * time.Second
+
+// WaitOptions controls how WaitForWork waits for a work's tasks.
+type WaitOptions struct {
+	Timeout      time.Duration // Give up after this long (0 = wait forever)
+	PollInterval time.Duration // Safety-net poll interval (0 = DefaultWaitPollInterval)
+	// OnTransition is called whenever a task appears or changes status.
+	OnTransition func(TaskTransition)
+}
+
+// TaskTransition describes a task status change observed while waiting.
+// From is empty the first time a task is seen.
+type TaskTransition struct {
+	TaskID   string
+	TaskType string
+	From     string
+	To       string
+}
+
+// WaitResult is the state of a work's tasks when WaitForWork returns.
+type WaitResult struct {
+	Tasks  []*db.Task
+	Failed int
+}
+
+// WaitForWork blocks until every task in the work has finished, i.e. nothing
+// is pending or processing. It reacts to tracking database changes and falls
+// back to polling, reporting status transitions through opts.OnTransition.
+// Once a task has failed the orchestrator starts nothing new, so the wait
+// ends as soon as no task is processing, with every failure in the result.
+func (s *WorkService) WaitForWork(ctx context.Context, workID string, opts WaitOptions) (*WaitResult, error) {
+	pollInterval := opts.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultWaitPollInterval
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	// Database events make transitions show up promptly; the ticker covers
+	// the case where the watcher can't be started.
+	var watcherSub <-chan pubsub.Event[trackingwatcher.WatcherEvent]
+	trackingDBPath := filepath.Join(s.ProjectRoot, project.ConfigDir, project.TrackingDB)
+	if watcher, err := trackingwatcher.New(trackingwatcher.DefaultConfig(trackingDBPath)); err == nil {
+		if err := watcher.Start(); err == nil {
+			defer watcher.Stop()
+			watcherSub = watcher.Broker().Subscribe(ctx)
+		} else {
+			_ = watcher.Stop()
+		}
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	// A query cut short by the deadline reports the timeout, not the query
+	ctxErr := func() error {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("timed out after %s waiting for work %s", opts.Timeout, workID)
+		}
+		return ctx.Err()
+	}
+
+	lastStatus := make(map[string]string)
+	check := func() (*WaitResult, error) {
+		work, err := s.DB.GetWork(ctx, workID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get work: %w", err)
+		}
+		if work == nil {
+			return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
+		}
+		tasks, err := s.DB.GetWorkTasks(ctx, workID)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &WaitResult{Tasks: tasks}
+		active, processing := 0, 0
+		for _, t := range tasks {
+			if prev, seen := lastStatus[t.ID]; !seen || prev != t.Status {
+				lastStatus[t.ID] = t.Status
+				if opts.OnTransition != nil {
+					opts.OnTransition(TaskTransition{TaskID: t.ID, TaskType: t.TaskType, From: prev, To: t.Status})
+				}
+			}
+			switch t.Status {
+			case db.StatusPending:
+				active++
+			case db.StatusProcessing:
+				active++
+				processing++
+			case db.StatusFailed:
+				result.Failed++
+			}
+		}
+
+		// No tasks yet means they haven't been created, not that we're done
+		if len(tasks) == 0 {
+			return nil, nil
+		}
+		if active == 0 || (result.Failed > 0 && processing == 0) {
+			return result, nil
+		}
+		return nil, nil
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return nil, ctxErr()
+		}
+		result, err := check()
+		if err != nil && ctx.Err() != nil {
+			return nil, ctxErr()
+		}
+		if err != nil || result != nil {
+			return result, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctxErr()
+		case _, ok := <-watcherSub:
+			if !ok {
+				watcherSub = nil
+			}
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package work_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForWork_ReportsTransitionsUntilDone(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+
+	h.CreateWork("w-test", "feat/wait")
+	h.CreateTask("w-test.1", "w-test", nil)
+	h.CreateTask("w-test.2", "w-test", nil)
+
+	var mu sync.Mutex
+	var transitions []work.TaskTransition
+	go func() {
+		time.Sleep(30 * time.Millisecond)
+		_ = h.DB.StartTask(ctx, "w-test.1", "")
+		time.Sleep(30 * time.Millisecond)
+		_ = h.DB.CompleteTask(ctx, "w-test.1", "")
+		_ = h.DB.CompleteTask(ctx, "w-test.2", "")
+	}()
+
+	result, err := h.WorkService.WaitForWork(ctx, "w-test", work.WaitOptions{
+		Timeout:      5 * time.Second,
+		PollInterval: 10 * time.Millisecond,
+		OnTransition: func(tr work.TaskTransition) {
+			mu.Lock()
+			defer mu.Unlock()
+			transitions = append(transitions, tr)
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Failed)
+	require.Len(t, result.Tasks, 2)
+	for _, task := range result.Tasks {
+		assert.Equal(t, db.StatusCompleted, task.Status)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.NotEmpty(t, transitions)
+	assert.Equal(t, "", transitions[0].From, "first sighting has no previous status")
+	assert.Contains(t, transitions, work.TaskTransition{TaskID: "w-test.1", TaskType: "implement", From: db.StatusPending, To: db.StatusProcessing})
+}
+
+func TestWaitForWork_StopsOnFailure(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+
+	h.CreateWork("w-test", "feat/wait")
+	h.CreateTask("w-test.1", "w-test", nil)
+	h.CreateTask("w-test.2", "w-test", nil)
+	h.FailTask("w-test.1", "boom")
+
+	// w-test.2 is still pending, but the orchestrator halts on failure
+	result, err := h.WorkService.WaitForWork(ctx, "w-test", work.WaitOptions{
+		Timeout:      time.Second,
+		PollInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Failed)
+}
+
+func TestWaitForWork_FailureWaitsForRunningTasks(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+
+	h.CreateWork("w-test", "feat/wait")
+	h.CreateTask("w-test.1", "w-test", nil)
+	h.CreateTask("w-test.2", "w-test", nil)
+	h.CreateTask("w-test.3", "w-test", nil)
+	require.NoError(t, h.DB.StartTask(ctx, "w-test.2", ""))
+	h.FailTask("w-test.1", "boom")
+
+	// w-test.2 runs in parallel and fails later; w-test.3 never starts
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = h.DB.FailTask(ctx, "w-test.2", "bang")
+	}()
+
+	result, err := h.WorkService.WaitForWork(ctx, "w-test", work.WaitOptions{
+		Timeout:      5 * time.Second,
+		PollInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Failed)
+}
+
+func TestWaitForWork_Timeout(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	h.CreateWork("w-test", "feat/wait")
+	h.CreateTask("w-test.1", "w-test", nil)
+
+	_, err := h.WorkService.WaitForWork(context.Background(), "w-test", work.WaitOptions{
+		Timeout:      50 * time.Millisecond,
+		PollInterval: 10 * time.Millisecond,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out")
+}
+
+func TestWaitForWork_TimeoutBeforeQuery(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	h.CreateWork("w-test", "feat/wait")
+	h.CreateTask("w-test.1", "w-test", nil)
+
+	// The deadline passes before the first query runs
+	_, err := h.WorkService.WaitForWork(context.Background(), "w-test", work.WaitOptions{
+		Timeout:      time.Nanosecond,
+		PollInterval: 10 * time.Millisecond,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out")
+}
+
+func TestWaitForWork_WorkNotFound(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	_, err := h.WorkService.WaitForWork(context.Background(), "w-missing", work.WaitOptions{Timeout: time.Second})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
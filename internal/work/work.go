@@ -6,14 +6,20 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/newhook/co/internal/coerrors"
 	"github.com/newhook/co/internal/db"
 )
 
 // AddBeadsToWorkResult contains the result of adding beads to a work.
 type AddBeadsToWorkResult struct {
 	BeadsAdded int
+	// Skipped maps the beads left out because another active work has them
+	// to that work. Only set when adding with force.
+	Skipped map[string]string
 }
 
 // RemoveBeadsResult contains the result of removing beads from a work.
@@ -38,6 +44,8 @@ type CreateWorkAsyncOptions struct {
 	Auto              bool
 	UseExistingBranch bool
 	BeadIDs           []string // Beads to add to the work (added immediately, not by control plane)
+	WorkerName        string   // Name for the work; one is generated when empty
+	Remote            bool     // Run the work on the [remote] host
 }
 
 // CreateWorkFromBeadOptions contains options for creating a work from a bead.
@@ -48,6 +56,11 @@ type CreateWorkFromBeadOptions struct {
 	BaseBranch        string
 	Auto              bool
 	UseExistingBranch bool
+	Remote            bool     // Run the work on the [remote] host
+	AdditionalBeadIDs []string // Other issues to add once the work is created
+	// Start gets the work going once it has its issues; see StartWorkOptions.
+	Start       func(ctx context.Context) error
+	KeepPartial bool // Keep the work when adding issues or starting it fails
 }
 
 // CreateWorkFromBeadResult contains the result of creating a work from a bead.
@@ -61,10 +74,11 @@ type CreateWorkFromBeadResult struct {
 
 // CreateWorkFromBead creates a work unit from a bead, handling all common steps:
 // 1. Expands the bead to collect all issue IDs (epics, transitive deps)
-// 2. Creates the work asynchronously via CreateWorkAsyncWithOptions
+// 2. Creates the work, adds the additional issues and starts it with
+// CreateAndStartWork, which removes the work again if a step fails
 //
 // This is the shared implementation used by both CLI and TUI.
-// Callers are responsible for ensuring the control plane is running via control.EnsureControlPlane.
+// Callers pass control.EnsureControlPlane as opts.Start to get the work going.
 func (s *WorkService) CreateWorkFromBead(ctx context.Context, opts CreateWorkFromBeadOptions) (*CreateWorkFromBeadResult, error) {
 	// 1. Collect issue IDs (handles epics and transitive deps)
 	allIssueIDs, err := CollectIssueIDsForAutomatedWorkflow(ctx, opts.BeadID, s.BeadsReader)
@@ -83,19 +97,121 @@ func (s *WorkService) CreateWorkFromBead(ctx context.Context, opts CreateWorkFro
 		Auto:              opts.Auto,
 		UseExistingBranch: opts.UseExistingBranch,
 		BeadIDs:           allIssueIDs,
+		Remote:            opts.Remote,
 	}
-	result, err := s.CreateWorkAsyncWithOptions(ctx, createOpts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create work: %w", err)
+	result, err := s.CreateAndStartWork(ctx, createOpts, StartWorkOptions{
+		AdditionalBeadIDs: opts.AdditionalBeadIDs,
+		Start:             opts.Start,
+		KeepPartial:       opts.KeepPartial,
+	})
+	if result == nil {
+		return nil, err
 	}
 
+	// With KeepPartial the work may outlive a failed step; err says which
 	return &CreateWorkFromBeadResult{
 		WorkID:     result.WorkID,
 		WorkerName: result.WorkerName,
 		BranchName: result.BranchName,
 		BaseBranch: result.BaseBranch,
 		BeadIDs:    allIssueIDs,
-	}, nil
+	}, err
+}
+
+// StartWorkOptions are the steps CreateAndStartWork takes once the work is
+// created.
+type StartWorkOptions struct {
+	// AdditionalBeadIDs are added to the work after it's created, except
+	// those it already has.
+	AdditionalBeadIDs []string
+	// Start gets the work going once it has its issues, such as by making sure
+	// the control plane that creates its worktree is running. Nil skips it.
+	Start func(ctx context.Context) error
+	// KeepPartial keeps the work, and the issues added to it, when a later
+	// step fails, rather than removing them again.
+	KeepPartial bool
+}
+
+// CreateAndStartWork creates a work as CreateWorkAsyncWithOptions does, adds
+// the additional issues and starts it, as one Pipeline. When a step fails the
+// completed ones are undone: the issues are taken out of the work and the
+// work is removed, worktree and all if one was created already. The returned
+// error is then a *PipelineError saying what was undone and what is left.
+// The result is nil unless the work still exists, which after a failure only
+// happens with opts.KeepPartial or when undoing failed.
+func (s *WorkService) CreateAndStartWork(ctx context.Context, create CreateWorkAsyncOptions, opts StartWorkOptions) (*CreateWorkAsyncResult, error) {
+	var result *CreateWorkAsyncResult
+	var added []string
+	steps := []Step{
+		{
+			Name: "create the work",
+			Run: func(ctx context.Context) error {
+				var err error
+				result, err = s.CreateWorkAsyncWithOptions(ctx, create)
+				return err
+			},
+			Rollback: func(ctx context.Context) error {
+				if err := s.discardWork(ctx, result.WorkID); err != nil {
+					return err
+				}
+				result = nil
+				return nil
+			},
+		},
+		{
+			Name: "add the issues",
+			Run: func(ctx context.Context) error {
+				extra := beadsNotIn(opts.AdditionalBeadIDs, create.BeadIDs)
+				if len(extra) == 0 {
+					return nil
+				}
+				if _, err := s.AddBeads(ctx, result.WorkID, extra, false); err != nil {
+					return err
+				}
+				added = extra
+				return nil
+			},
+			Rollback: func(ctx context.Context) error {
+				if len(added) == 0 {
+					return nil
+				}
+				_, err := s.RemoveBeads(ctx, result.WorkID, added)
+				return err
+			},
+		},
+	}
+	if opts.Start != nil {
+		steps = append(steps, Step{Name: "start the work", Run: opts.Start})
+	}
+
+	pipeline := &Pipeline{Steps: steps, KeepPartial: opts.KeepPartial}
+	if err := pipeline.Run(ctx); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// discardWork removes a work that was just created, and its worktree if the
+// control plane made one already. Unlike DestroyWork it leaves the root
+// issue open, since the work never got going.
+func (s *WorkService) discardWork(ctx context.Context, workID string) error {
+	plan, err := s.PlanDestroyWork(ctx, workID)
+	if err != nil {
+		return err
+	}
+	plan.RootIssueID = ""
+	return s.ApplyDestruction(ctx, plan, io.Discard)
+}
+
+// beadsNotIn returns the beads in wanted that are not in have, without duplicates
+func beadsNotIn(wanted, have []string) []string {
+	var extra []string
+	for _, id := range wanted {
+		if !slices.Contains(have, id) && !slices.Contains(extra, id) {
+			extra = append(extra, id)
+		}
+	}
+	return extra
 }
 
 // ImportPRAsyncOptions contains options for importing a PR asynchronously.
@@ -126,7 +242,7 @@ func (s *WorkService) ImportPRAsync(ctx context.Context, opts ImportPRAsyncOptio
 	}
 
 	// Get a human-readable name for this worker
-	workerName, err := s.NameGenerator.GetNextAvailableName(ctx, s.DB.DB)
+	workerName, err := s.NameGenerator.GetNextAvailableName(ctx, s.DB)
 	if err != nil {
 		workerName = "" // Non-fatal
 	}
@@ -170,20 +286,54 @@ func (s *WorkService) ImportPRAsync(ctx context.Context, opts ImportPRAsyncOptio
 // 2. Schedules TaskTypeCreateWorktree task for the control plane
 // The control plane will handle worktree creation, git push, and orchestrator spawning.
 func (s *WorkService) CreateWorkAsyncWithOptions(ctx context.Context, opts CreateWorkAsyncOptions) (*CreateWorkAsyncResult, error) {
+	if opts.Remote && !s.Config.Remote.Enabled() {
+		return nil, coerrors.Errorf(coerrors.Validation, "no remote host is configured; set [remote] host in .co/config.toml")
+	}
 	baseBranch := opts.BaseBranch
 	if baseBranch == "" {
 		baseBranch = s.Config.Repo.GetBaseBranch()
 	}
+	if baseBranch != s.Config.Repo.GetBaseBranch() {
+		// A base other than the configured one was picked for this work
+		existsLocal, existsRemote, err := s.Git.ValidateExistingBranch(ctx, s.MainRepoPath, baseBranch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check base branch: %w", err)
+		}
+		if !existsLocal && !existsRemote {
+			return nil, coerrors.Errorf(coerrors.Validation, "base branch %s does not exist locally or on origin", baseBranch)
+		}
+	}
 
-	branchName := opts.BranchName
+	// Catch branch problems here rather than as a git error in the control plane
+	check, err := s.CheckBranch(ctx, opts.BranchName)
+	if err != nil {
+		return nil, err
+	}
+	if check.Name == "" {
+		return nil, fmt.Errorf("invalid branch name %q", opts.BranchName)
+	}
 
-	// For new branches, ensure unique name; for existing branches, use as-is
-	if !opts.UseExistingBranch {
-		var err error
-		branchName, err = EnsureUniqueBranchName(ctx, s.Git, s.MainRepoPath, branchName)
-		if err != nil {
-			return nil, fmt.Errorf("failed to find unique branch name: %w", err)
+	// For new branches, normalize the name and ensure it's unique; existing
+	// branches are used as-is
+	branchName := check.Name
+	if opts.UseExistingBranch {
+		if check.Invalid != nil {
+			return nil, fmt.Errorf("invalid branch name %q: %w", opts.BranchName, check.Invalid)
+		}
+		if check.WorkID != "" {
+			return nil, coerrors.Errorf(coerrors.Conflict, "branch %s is already used by work %s", branchName, check.WorkID)
+		}
+		if !check.ExistsLocal && !check.ExistsRemote {
+			return nil, fmt.Errorf("branch %s does not exist locally or on remote", branchName)
+		}
+		if check.WorktreePath != "" {
+			return nil, coerrors.Errorf(coerrors.Conflict, "branch %s is already checked out at %s", branchName, check.WorktreePath)
 		}
+	} else if check.Taken() {
+		if check.Suggestion == "" {
+			return nil, fmt.Errorf("could not find unique branch name after 100 attempts (base: %s)", branchName)
+		}
+		branchName = check.Suggestion
 	}
 
 	// Generate work ID
@@ -193,15 +343,25 @@ func (s *WorkService) CreateWorkAsyncWithOptions(ctx context.Context, opts Creat
 	}
 
 	// Get a human-readable name for this worker
-	workerName, err := s.NameGenerator.GetNextAvailableName(ctx, s.DB.DB)
-	if err != nil {
-		workerName = "" // Non-fatal
+	workerName := opts.WorkerName
+	if workerName == "" {
+		workerName, err = s.NameGenerator.GetNextAvailableName(ctx, s.DB)
+		if err != nil {
+			workerName = "" // Non-fatal
+		}
 	}
 
 	// Create work record in DB (without worktree path - control plane will set it)
 	if err := s.DB.CreateWork(ctx, workID, workerName, "", branchName, baseBranch, opts.RootIssueID, opts.Auto); err != nil {
 		return nil, fmt.Errorf("failed to create work record: %w", err)
 	}
+	if opts.Remote {
+		// Set before the worktree task runs, so the worktree is made on the host
+		if err := s.DB.SetWorkRemoteHost(ctx, workID, s.Config.Remote.Host); err != nil {
+			_ = s.DB.DeleteWork(ctx, workID)
+			return nil, err
+		}
+	}
 
 	// Add beads to work_beads (done immediately, not by control plane)
 	if len(opts.BeadIDs) > 0 {
@@ -257,9 +417,11 @@ func (s *WorkService) addBeadsInternal(ctx context.Context, workID string, beadI
 // AddBeads adds beads to an existing work.
 // This is the core logic for adding beads that can be called from both the CLI and TUI.
 // Each bead is added as its own group (no grouping).
-func (s *WorkService) AddBeads(ctx context.Context, workID string, beadIDs []string) (*AddBeadsToWorkResult, error) {
+// A bead can only be in one active work: beads another active work has fail
+// the call, or with force are skipped and reported in the result.
+func (s *WorkService) AddBeads(ctx context.Context, workID string, beadIDs []string, force bool) (*AddBeadsToWorkResult, error) {
 	if len(beadIDs) == 0 {
-		return nil, fmt.Errorf("no beads specified")
+		return nil, coerrors.Errorf(coerrors.Validation, "no beads specified")
 	}
 
 	// Verify work exists
@@ -268,7 +430,7 @@ func (s *WorkService) AddBeads(ctx context.Context, workID string, beadIDs []str
 		return nil, fmt.Errorf("failed to get work: %w", err)
 	}
 	if work == nil {
-		return nil, fmt.Errorf("work %s not found", workID)
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
 	}
 
 	// Check if any bead is already in a task
@@ -278,25 +440,46 @@ func (s *WorkService) AddBeads(ctx context.Context, workID string, beadIDs []str
 			return nil, fmt.Errorf("failed to check bead %s: %w", beadID, err)
 		}
 		if inTask {
-			return nil, fmt.Errorf("bead %s is already assigned to a task", beadID)
+			return nil, coerrors.Errorf(coerrors.Conflict, "bead %s is already assigned to a task", beadID)
 		}
 	}
 
+	// Two works on the same bead would be two branches solving one issue
+	owners, err := s.DB.GetActiveWorksForBeads(ctx, beadIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := &AddBeadsToWorkResult{}
+	var toAdd, owned []string
+	for _, beadID := range beadIDs {
+		owner, ok := owners[beadID]
+		if !ok || owner == workID {
+			toAdd = append(toAdd, beadID)
+			continue
+		}
+		owned = append(owned, fmt.Sprintf("%s (%s)", beadID, owner))
+		if result.Skipped == nil {
+			result.Skipped = make(map[string]string)
+		}
+		result.Skipped[beadID] = owner
+	}
+	if len(owned) > 0 && !force {
+		return nil, coerrors.Errorf(coerrors.Conflict, "already in another active work: %s", strings.Join(owned, ", "))
+	}
+
 	// Add beads to work
-	if err := s.DB.AddWorkBeads(ctx, workID, beadIDs); err != nil {
+	if err := s.DB.AddWorkBeads(ctx, workID, toAdd); err != nil {
 		return nil, fmt.Errorf("failed to add beads: %w", err)
 	}
-
-	return &AddBeadsToWorkResult{
-		BeadsAdded: len(beadIDs),
-	}, nil
+	result.BeadsAdded = len(toAdd)
+	return result, nil
 }
 
 // RemoveBeads removes beads from an existing work.
 // Beads that are already assigned to a task cannot be removed.
 func (s *WorkService) RemoveBeads(ctx context.Context, workID string, beadIDs []string) (*RemoveBeadsResult, error) {
 	if len(beadIDs) == 0 {
-		return nil, fmt.Errorf("no beads specified")
+		return nil, coerrors.Errorf(coerrors.Validation, "no beads specified")
 	}
 
 	// Verify work exists
@@ -305,7 +488,7 @@ func (s *WorkService) RemoveBeads(ctx context.Context, workID string, beadIDs []
 		return nil, fmt.Errorf("failed to get work: %w", err)
 	}
 	if work == nil {
-		return nil, fmt.Errorf("work %s not found", workID)
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
 	}
 
 	// Check if any bead is assigned to a task and remove those that aren't
@@ -331,49 +514,206 @@ func (s *WorkService) RemoveBeads(ctx context.Context, workID string, beadIDs []
 	}, nil
 }
 
+// BeadInTaskError is returned by RemoveBeadFromWork when the bead is part of
+// pending tasks and the caller did not ask to remove it from them.
+type BeadInTaskError struct {
+	BeadID  string
+	TaskIDs []string // The pending tasks that include the bead
+}
+
+func (e *BeadInTaskError) Error() string {
+	return fmt.Sprintf("bead %s is in pending task(s) %s", e.BeadID, strings.Join(e.TaskIDs, ", "))
+}
+
+// RemoveBeadFromWorkResult contains the result of removing a bead from a work.
+type RemoveBeadFromWorkResult struct {
+	RemovedFromTasks []string // Pending tasks the bead was removed from
+	DeletedTasks     []string // Tasks deleted because the bead was their only one
+}
+
+// RemoveBeadFromWork removes a single bead from a work, checking its task
+// membership first. A bead in a processing or completed task is never removed.
+// A bead in pending tasks is removed from them only when fromPendingTasks is
+// set; otherwise a *BeadInTaskError lists them. Tasks left without beads are deleted.
+func (s *WorkService) RemoveBeadFromWork(ctx context.Context, workID, beadID string, fromPendingTasks bool) (*RemoveBeadFromWorkResult, error) {
+	work, err := s.DB.GetWork(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
+	}
+
+	tasks, err := s.DB.GetTasksForBead(ctx, workID, beadID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks for bead %s: %w", beadID, err)
+	}
+	var pending []string
+	for _, t := range tasks {
+		if t.Status != db.StatusPending {
+			return nil, fmt.Errorf("bead %s cannot be removed: its task %s is %s", beadID, t.ID, t.Status)
+		}
+		pending = append(pending, t.ID)
+	}
+	if len(pending) > 0 && !fromPendingTasks {
+		return nil, &BeadInTaskError{BeadID: beadID, TaskIDs: pending}
+	}
+
+	result := &RemoveBeadFromWorkResult{}
+	for _, taskID := range pending {
+		if err := s.DB.RemoveTaskBead(ctx, taskID, beadID); err != nil {
+			return nil, fmt.Errorf("failed to remove bead %s from task %s: %w", beadID, taskID, err)
+		}
+		result.RemovedFromTasks = append(result.RemovedFromTasks, taskID)
+
+		remaining, err := s.DB.GetTaskBeads(ctx, taskID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get beads of task %s: %w", taskID, err)
+		}
+		if len(remaining) == 0 {
+			if err := s.DB.DeleteTask(ctx, taskID); err != nil {
+				return nil, fmt.Errorf("failed to delete empty task %s: %w", taskID, err)
+			}
+			result.DeletedTasks = append(result.DeletedTasks, taskID)
+		}
+	}
+
+	if err := s.DB.RemoveWorkBead(ctx, workID, beadID); err != nil {
+		return nil, fmt.Errorf("failed to remove bead %s: %w", beadID, err)
+	}
+	return result, nil
+}
+
+// DestructionPlan describes what DestroyWork will do, so callers can show
+// the consequences, or only print them with --dry-run, before anything is
+// changed.
+type DestructionPlan struct {
+	WorkID       string
+	RootIssueID  string // Closed, along with its planning session; empty when the work has none
+	BranchName   string // Left in place; destroying a work never deletes its branch
+	WorktreePath string // Removed with git worktree remove --force; empty when there is none
+	RemoteHost   string // Host the worktree is on; empty for a local work
+	WorkDir      string // The work's directory in the project, removed with everything in it
+	// TerminateTabs is set when the work's zellij tabs are closed too.
+	TerminateTabs bool
+	// TaskIDs lists the tasks deleted from the database with the work.
+	TaskIDs []string
+	// BeadIDs lists the issues unassigned from the work. They stay open,
+	// apart from the root issue.
+	BeadIDs []string
+}
+
+// Print writes what the plan does to w, one step per line.
+func (p *DestructionPlan) Print(w io.Writer) {
+	if p.RootIssueID != "" {
+		fmt.Fprintf(w, "  Close root issue: %s\n", p.RootIssueID)
+	}
+	if p.TerminateTabs {
+		fmt.Fprintf(w, "  Close the zellij tabs of %s\n", p.WorkID)
+	}
+	if p.WorktreePath != "" {
+		fmt.Fprintf(w, "  Remove worktree: %s\n", worktreeLocation(p.RemoteHost, p.WorktreePath))
+	}
+	fmt.Fprintf(w, "  Remove work directory: %s\n", p.WorkDir)
+	if p.BranchName != "" {
+		fmt.Fprintf(w, "  Keep branch: %s\n", p.BranchName)
+	}
+	fmt.Fprintf(w, "  Delete work %s from the database\n", p.WorkID)
+	if len(p.TaskIDs) > 0 {
+		fmt.Fprintf(w, "  Delete %d task(s): %s\n", len(p.TaskIDs), strings.Join(p.TaskIDs, ", "))
+	}
+	if len(p.BeadIDs) > 0 {
+		fmt.Fprintf(w, "  Unassign %d issue(s): %s\n", len(p.BeadIDs), strings.Join(p.BeadIDs, ", "))
+	}
+}
+
+// PlanDestroyWork gathers what DestroyWork would do to a work, without
+// changing anything.
+func (s *WorkService) PlanDestroyWork(ctx context.Context, workID string) (*DestructionPlan, error) {
+	work, err := s.DB.GetWork(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work: %w", err)
+	}
+	if work == nil {
+		return nil, coerrors.Errorf(coerrors.NotFound, "work %s not found", workID)
+	}
+	tasks, err := s.DB.GetWorkTasks(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work tasks: %w", err)
+	}
+	workBeads, err := s.DB.GetWorkBeads(ctx, workID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work beads: %w", err)
+	}
+
+	plan := &DestructionPlan{
+		WorkID:        work.ID,
+		RootIssueID:   work.RootIssueID,
+		BranchName:    work.BranchName,
+		WorktreePath:  work.WorktreePath,
+		RemoteHost:    work.RemoteHost,
+		WorkDir:       filepath.Join(s.ProjectRoot, workID),
+		TerminateTabs: s.Config.Zellij.ShouldKillTabsOnDestroy(),
+	}
+	for _, task := range tasks {
+		plan.TaskIDs = append(plan.TaskIDs, task.ID)
+	}
+	for _, wb := range workBeads {
+		plan.BeadIDs = append(plan.BeadIDs, wb.BeadID)
+	}
+	return plan, nil
+}
+
 // DestroyWork destroys a work unit and all its resources.
 // This is the core work destruction logic that can be called from both the CLI and TUI.
 // It does not perform interactive confirmation - that should be handled by the caller.
 // Progress messages are written to the provided writer. Pass io.Discard to suppress output.
 func (s *WorkService) DestroyWork(ctx context.Context, workID string, w io.Writer) error {
-	// Get work to verify it exists
-	work, err := s.DB.GetWork(ctx, workID)
+	plan, err := s.PlanDestroyWork(ctx, workID)
 	if err != nil {
-		return fmt.Errorf("failed to get work: %w", err)
-	}
-	if work == nil {
-		return fmt.Errorf("work %s not found", workID)
+		return err
 	}
+	return s.ApplyDestruction(ctx, plan, w)
+}
+
+// ApplyDestruction carries out a plan from PlanDestroyWork.
+func (s *WorkService) ApplyDestruction(ctx context.Context, plan *DestructionPlan, w io.Writer) error {
+	workID := plan.WorkID
 
 	// Close the root issue if it exists
-	if work.RootIssueID != "" {
-		fmt.Fprintf(w, "Closing root issue %s...\n", work.RootIssueID)
-		if err := s.BeadsCLI.Close(ctx, work.RootIssueID); err != nil {
+	if plan.RootIssueID != "" {
+		fmt.Fprintf(w, "Closing root issue %s...\n", plan.RootIssueID)
+		if err := s.BeadsCLI.Close(ctx, plan.RootIssueID); err != nil {
 			// Warn but continue - issue might already be closed or deleted
-			fmt.Fprintf(w, "Warning: failed to close root issue %s: %v\n", work.RootIssueID, err)
+			fmt.Fprintf(w, "Warning: failed to close root issue %s: %v\n", plan.RootIssueID, err)
 		}
 	}
 
-	// Terminate any running zellij tabs (orchestrator, task, console, and claude tabs) for this work
+	// Terminate any running zellij tabs (orchestrator, task, console, and claude tabs) for this work,
+	// and the planning session of the root issue that was just closed
 	// Only if configured to do so (defaults to true)
-	if s.Config.Zellij.ShouldKillTabsOnDestroy() {
+	if plan.TerminateTabs {
 		if err := s.OrchestratorManager.TerminateWorkTabs(ctx, workID, s.Config.Project.Name, w); err != nil {
 			// Warn but continue - tab termination is non-fatal
 			fmt.Fprintf(w, "Warning: failed to terminate work tabs: %v\n", err)
 		}
+		if plan.RootIssueID != "" {
+			if err := s.OrchestratorManager.TerminatePlanSessions(ctx, []string{plan.RootIssueID}, s.Config.Project.Name, w); err != nil {
+				fmt.Fprintf(w, "Warning: failed to end plan session: %v\n", err)
+			}
+		}
 	}
 
 	// Remove git worktree if it exists
-	if work.WorktreePath != "" {
-		if err := s.Worktree.RemoveForce(ctx, s.MainRepoPath, work.WorktreePath); err != nil {
+	if plan.WorktreePath != "" {
+		if err := s.removeWorktree(ctx, workID, plan.RemoteHost, plan.WorktreePath); err != nil {
 			fmt.Fprintf(w, "Warning: failed to remove worktree: %v\n", err)
 		}
 	}
 
 	// Remove work directory
-	workDir := filepath.Join(s.ProjectRoot, workID)
-	if err := os.RemoveAll(workDir); err != nil {
-		fmt.Fprintf(w, "Warning: failed to remove work directory %s: %v\n", workDir, err)
+	if err := os.RemoveAll(plan.WorkDir); err != nil {
+		fmt.Fprintf(w, "Warning: failed to remove work directory %s: %v\n", plan.WorkDir, err)
 	}
 
 	// Delete work from database (also deletes associated tasks and relationships)
@@ -442,3 +442,61 @@ func TestGenerateBranchNameFromBeads_Nil(t *testing.T) {
 
 	assert.Equal(t, "feat/automated-work", result)
 }
+
+func TestValidateBranchName(t *testing.T) {
+	valid := []string{"feat/login", "fix-123", "user/feat/a.b", "v1.2"}
+	for _, name := range valid {
+		assert.NoError(t, ValidateBranchName(name), name)
+	}
+
+	invalid := map[string]string{
+		"":              "empty",
+		"@":             "'@'",
+		"-feat":         "'-'",
+		"/feat":         "'/'",
+		"feat/":         "'/'",
+		"feat.":         "'.'",
+		"feat..x":       "'..'",
+		"feat//x":       "'//'",
+		"feat@{1}":      "'@{'",
+		"my branch":     "spaces",
+		"feat\tx":       "control characters",
+		"feat~1":        "'~'",
+		"feat:x":        "':'",
+		"feat?":         "'?'",
+		"feat[1]":       "'['",
+		"feat\\x":       "'\\'",
+		"feat/.hidden":  "start with '.'",
+		"feat/new.lock": "'.lock'",
+	}
+	for name, want := range invalid {
+		err := ValidateBranchName(name)
+		if assert.Error(t, err, name) {
+			assert.Contains(t, err.Error(), want, name)
+		}
+	}
+}
+
+func TestNormalizeBranchName(t *testing.T) {
+	tests := map[string]string{
+		"feat/login":            "feat/login",
+		"  my new   branch ":    "my-new-branch",
+		"feat/fix: the ~bug?":   "feat/fix-the-bug",
+		"feat//x/":              "feat/x",
+		"feat/..x..y.":          "feat/x.y",
+		"feat/.a.lock.lock/b":   "feat/a/b",
+		"-feat@{1}":             "feat@1}",
+		"a/.b.":                 "a/b",
+		"@":                     "",
+		"~^:?*[":                "",
+		"/./":                   "",
+		"feat/[wip] login page": "feat/wip]-login-page",
+	}
+	for in, want := range tests {
+		got := NormalizeBranchName(in)
+		assert.Equal(t, want, got, in)
+		if got != "" {
+			assert.NoError(t, ValidateBranchName(got), in)
+		}
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/newhook/co/internal/coerrors"
 	"github.com/newhook/co/internal/db"
 	"github.com/newhook/co/internal/testutil"
 	"github.com/newhook/co/internal/work"
+	"github.com/newhook/co/internal/worktree"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -54,6 +56,69 @@ func TestWorkCreation_Success(t *testing.T) {
 	assert.Equal(t, "bead-1", tasks[0].Metadata["root_issue_id"])
 }
 
+func TestWorkCreation_OtherBaseBranch(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.CreateBead("bead-1", "Hotfix the login")
+	h.MockBranchExists("release/1.2", false, true)
+
+	// A base other than the configured one must exist
+	_, err := h.WorkService.CreateWorkAsyncWithOptions(ctx, work.CreateWorkAsyncOptions{
+		BranchName:  "fix/login",
+		BaseBranch:  "release/9.9",
+		RootIssueID: "bead-1",
+	})
+	require.Error(t, err)
+	assert.Equal(t, coerrors.Validation, coerrors.KindOf(err))
+
+	result, err := h.WorkService.CreateWorkAsyncWithOptions(ctx, work.CreateWorkAsyncOptions{
+		BranchName:  "fix/login",
+		BaseBranch:  "release/1.2",
+		RootIssueID: "bead-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "release/1.2", result.BaseBranch)
+
+	workRecord, err := h.DB.GetWork(ctx, result.WorkID)
+	require.NoError(t, err)
+	assert.Equal(t, "release/1.2", workRecord.BaseBranch)
+	tasks, err := h.DB.GetScheduledTasksForWork(ctx, result.WorkID)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "release/1.2", tasks[0].Metadata["base_branch"])
+}
+
+func TestWorkCreation_Remote(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.CreateBead("bead-1", "Build on the desktop")
+
+	// A remote work needs a host to run on
+	_, err := h.WorkService.CreateWorkAsyncWithOptions(ctx, work.CreateWorkAsyncOptions{
+		BranchName:  "feat/desktop",
+		RootIssueID: "bead-1",
+		Remote:      true,
+	})
+	require.Error(t, err)
+	assert.Equal(t, coerrors.Validation, coerrors.KindOf(err))
+
+	h.Config.Remote.Host = "desktop"
+	result, err := h.WorkService.CreateWorkAsyncWithOptions(ctx, work.CreateWorkAsyncOptions{
+		BranchName:  "feat/desktop",
+		RootIssueID: "bead-1",
+		Remote:      true,
+	})
+	require.NoError(t, err)
+
+	workRecord, err := h.DB.GetWork(ctx, result.WorkID)
+	require.NoError(t, err)
+	assert.Equal(t, "desktop", workRecord.RemoteHost)
+}
+
 func TestWorkCreation_WithEpicExpansion(t *testing.T) {
 	h := testutil.NewTestHarness(t)
 	defer h.Cleanup()
@@ -283,3 +348,102 @@ func TestWorkCreation_GeneratesWorkerName(t *testing.T) {
 	require.Len(t, tasks1, 1)
 	assert.Equal(t, result1.WorkerName, tasks1[0].Metadata["worker_name"])
 }
+
+func TestCheckBranch(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.MockBranchExists("feat/existing", true, true)
+	h.Worktree.ListFunc = func(ctx context.Context, repoPath string) ([]worktree.Worktree, error) {
+		return []worktree.Worktree{{Path: "/repo", Branch: "main"}}, nil
+	}
+	h.CreateWork("w-abc", "feat/in-work")
+
+	// A free name only gets normalized
+	check, err := h.WorkService.CheckBranch(ctx, "feat/new thing")
+	require.NoError(t, err)
+	assert.Equal(t, "feat/new-thing", check.Name)
+	assert.ErrorContains(t, check.Invalid, "spaces")
+	assert.False(t, check.Taken())
+
+	// An existing branch can be attached to or suffixed
+	check, err = h.WorkService.CheckBranch(ctx, "feat/existing")
+	require.NoError(t, err)
+	assert.NoError(t, check.Invalid)
+	assert.True(t, check.ExistsLocal)
+	assert.True(t, check.ExistsRemote)
+	assert.True(t, check.CanAttach())
+	assert.Equal(t, "feat/existing-2", check.Suggestion)
+
+	// A checked out branch can't be attached to
+	check, err = h.WorkService.CheckBranch(ctx, "main")
+	require.NoError(t, err)
+	assert.Equal(t, "/repo", check.WorktreePath)
+	assert.False(t, check.CanAttach())
+
+	// Neither can a branch another work uses, even before it exists in git
+	check, err = h.WorkService.CheckBranch(ctx, "feat/in-work")
+	require.NoError(t, err)
+	assert.Equal(t, "w-abc", check.WorkID)
+	assert.True(t, check.Taken())
+	assert.False(t, check.CanAttach())
+
+	check, err = h.WorkService.CheckBranch(ctx, "~~~")
+	require.NoError(t, err)
+	assert.Empty(t, check.Name)
+	assert.Error(t, check.Invalid)
+}
+
+func TestWorkCreation_BranchValidation(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+
+	ctx := context.Background()
+	h.MockBranchExists("feat/existing", true, false)
+	h.CreateWork("w-abc", "feat/in-work")
+
+	// New branch names are normalized
+	result, err := h.WorkService.CreateWorkAsyncWithOptions(ctx, work.CreateWorkAsyncOptions{
+		BranchName: "feat/my new: branch",
+		BaseBranch: "main",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "feat/my-new-branch", result.BranchName)
+
+	// A new branch never reuses another work's branch
+	result, err = h.WorkService.CreateWorkAsyncWithOptions(ctx, work.CreateWorkAsyncOptions{
+		BranchName: "feat/in-work",
+		BaseBranch: "main",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "feat/in-work-2", result.BranchName)
+
+	_, err = h.WorkService.CreateWorkAsyncWithOptions(ctx, work.CreateWorkAsyncOptions{
+		BranchName: "::",
+		BaseBranch: "main",
+	})
+	require.ErrorContains(t, err, "invalid branch name")
+
+	// Existing branches must exist, be valid and be free
+	_, err = h.WorkService.CreateWorkAsyncWithOptions(ctx, work.CreateWorkAsyncOptions{
+		BranchName:        "feat/missing",
+		BaseBranch:        "main",
+		UseExistingBranch: true,
+	})
+	require.ErrorContains(t, err, "does not exist")
+
+	_, err = h.WorkService.CreateWorkAsyncWithOptions(ctx, work.CreateWorkAsyncOptions{
+		BranchName:        "feat/exist ing",
+		BaseBranch:        "main",
+		UseExistingBranch: true,
+	})
+	require.ErrorContains(t, err, "invalid branch name")
+
+	_, err = h.WorkService.CreateWorkAsyncWithOptions(ctx, work.CreateWorkAsyncOptions{
+		BranchName:        "feat/in-work",
+		BaseBranch:        "main",
+		UseExistingBranch: true,
+	})
+	require.ErrorContains(t, err, "already used by work w-abc")
+}
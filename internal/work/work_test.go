@@ -0,0 +1,91 @@
+package work_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/newhook/co/internal/db"
+	"github.com/newhook/co/internal/testutil"
+	"github.com/newhook/co/internal/work"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveBeadsWithStore(t *testing.T) {
+	ctx := context.Background()
+	store := &testutil.StoreMock{
+		GetWorkFunc: func(ctx context.Context, id string) (*db.Work, error) {
+			if id != "w-abc" {
+				return nil, nil
+			}
+			return &db.Work{ID: id}, nil
+		},
+		IsBeadInTaskFunc: func(ctx context.Context, workID string, beadID string) (bool, error) {
+			return beadID == "bead-9", nil
+		},
+	}
+	svc := &work.WorkService{DB: store}
+
+	result, err := svc.RemoveBeads(ctx, "w-abc", []string{"bead-1", "bead-2"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.BeadsRemoved)
+	calls := store.RemoveWorkBeadCalls()
+	require.Len(t, calls, 2)
+	assert.Equal(t, "w-abc", calls[0].WorkID)
+	assert.Equal(t, "bead-1", calls[0].BeadID)
+	assert.Equal(t, "bead-2", calls[1].BeadID)
+
+	// Beads already in a task stay put
+	_, err = svc.RemoveBeads(ctx, "w-abc", []string{"bead-9"})
+	require.ErrorContains(t, err, "assigned to a task")
+	require.Len(t, store.RemoveWorkBeadCalls(), 2)
+
+	_, err = svc.RemoveBeads(ctx, "w-missing", []string{"bead-1"})
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestRemoveBeadFromWork(t *testing.T) {
+	h := testutil.NewTestHarness(t)
+	defer h.Cleanup()
+	ctx := context.Background()
+	h.CreateBead("bead-1", "Feature A")
+	h.CreateBead("bead-2", "Feature B")
+	h.CreateBead("bead-3", "Feature C")
+	h.CreateWork("w-abc", "feat/abc")
+	h.AddBeadToWork("w-abc", "bead-1")
+	h.AddBeadToWork("w-abc", "bead-2")
+	h.AddBeadToWork("w-abc", "bead-3")
+	h.CreateTask("w-abc.pending", "w-abc", []string{"bead-1", "bead-2"})
+	h.CreateTask("w-abc.done", "w-abc", []string{"bead-3"})
+	h.CompleteTask("w-abc.done")
+
+	// A bead in a pending task is only removed when asked to
+	_, err := h.WorkService.RemoveBeadFromWork(ctx, "w-abc", "bead-1", false)
+	var inTask *work.BeadInTaskError
+	require.ErrorAs(t, err, &inTask)
+	assert.Equal(t, []string{"w-abc.pending"}, inTask.TaskIDs)
+
+	result, err := h.WorkService.RemoveBeadFromWork(ctx, "w-abc", "bead-1", true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"w-abc.pending"}, result.RemovedFromTasks)
+	assert.Empty(t, result.DeletedTasks)
+	taskBeads, err := h.DB.GetTaskBeads(ctx, "w-abc.pending")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bead-2"}, taskBeads)
+
+	// Removing the last bead deletes the task
+	result, err = h.WorkService.RemoveBeadFromWork(ctx, "w-abc", "bead-2", true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"w-abc.pending"}, result.DeletedTasks)
+	task, err := h.DB.GetTask(ctx, "w-abc.pending")
+	require.NoError(t, err)
+	assert.Nil(t, task)
+
+	// Completed tasks always keep their beads
+	_, err = h.WorkService.RemoveBeadFromWork(ctx, "w-abc", "bead-3", true)
+	require.ErrorContains(t, err, "its task w-abc.done is completed")
+	workBeads, err := h.DB.GetWorkBeads(ctx, "w-abc")
+	require.NoError(t, err)
+	require.Len(t, workBeads, 1)
+	assert.Equal(t, "bead-3", workBeads[0].BeadID)
+}
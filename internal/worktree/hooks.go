@@ -0,0 +1,99 @@
+package worktree
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// commitHookMarker identifies a prepare-commit-msg hook installed by co, so
+// it can be replaced by a newer version but a user's own hook is left alone
+const commitHookMarker = "# Installed by co: commit trailer for orchestrated tasks"
+
+// commitHookScript adds the Co-Orchestrated-By trailer to commits made with
+// CO_WORK_ID and CO_TASK_ID set, which only tasks run by the orchestrator
+// have. Other commits in the repository are left untouched.
+const commitHookScript = `#!/bin/sh
+` + commitHookMarker + `
+[ -n "$CO_WORK_ID" ] && [ -n "$CO_TASK_ID" ] || exit 0
+case "$2" in merge|squash) exit 0 ;; esac
+exec git interpret-trailers --in-place --if-exists doNothing \
+	--trailer "Co-Orchestrated-By: co $CO_WORK_ID/$CO_TASK_ID" "$1"
+`
+
+// Hook is a git hook co installs into a worktree's hooks directory.
+type Hook struct {
+	Name   string // File name in the hooks directory, such as "pre-commit"
+	Marker string // Line identifying the hook as co's, so only co's is replaced
+	Script string
+}
+
+// commitHook is the hook InstallCommitHook installs
+var commitHook = Hook{Name: "prepare-commit-msg", Marker: commitHookMarker, Script: commitHookScript}
+
+// Hooks returns the hooks Setup installs with opts, for a worktree that is
+// set up some other way, such as on a remote host.
+func Hooks(opts SetupOptions) []Hook {
+	var hooks []Hook
+	if opts.ProtectManaged {
+		hooks = append(hooks, guardHook())
+	}
+	if opts.CommitHook {
+		hooks = append(hooks, commitHook)
+	}
+	return hooks
+}
+
+// InstallCommitHook installs the prepare-commit-msg hook that adds the
+// Co-Orchestrated-By trailer to commits made by orchestrated tasks. git
+// shares hooks between a repository's worktrees, so the hook goes into the
+// hooks directory the worktree uses. It refuses to replace a hook it didn't
+// install, and to write into a hooks directory that lives in the worktree,
+// where it would be committed.
+func InstallCommitHook(ctx context.Context, worktreePath string) error {
+	return installHook(ctx, worktreePath, commitHook)
+}
+
+// installHook writes a hook of co's into the hooks directory the worktree
+// uses, replacing only a hook carrying the same marker.
+func installHook(ctx context.Context, worktreePath string, hook Hook) error {
+	cmd := exec.CommandContext(ctx, "git", "-C", worktreePath, "rev-parse", "--git-path", "hooks")
+	output, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("failed to find the hooks directory: %w", err)
+	}
+	hooksDir := strings.TrimSpace(string(output))
+	if !filepath.IsAbs(hooksDir) {
+		hooksDir = filepath.Join(worktreePath, hooksDir)
+	}
+	if rel, err := filepath.Rel(worktreePath, hooksDir); err == nil && !isOutside(rel) && !isUnder(rel, ".git") {
+		return fmt.Errorf("hooks directory %s is in the worktree, where it would be committed", rel)
+	}
+
+	path := filepath.Join(hooksDir, hook.Name)
+	existing, err := os.ReadFile(path)
+	if err == nil && !bytes.Contains(existing, []byte(hook.Marker)) {
+		return fmt.Errorf("%s already exists", path)
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.MkdirAll(hooksDir, 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(hook.Script), 0o755)
+}
+
+// isOutside reports whether a relative path leads out of its base
+func isOutside(rel string) bool {
+	return isUnder(rel, "..")
+}
+
+// isUnder reports whether a relative path is dir or inside it
+func isUnder(rel, dir string) bool {
+	return rel == dir || strings.HasPrefix(rel, dir+string(filepath.Separator))
+}
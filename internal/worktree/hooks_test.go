@@ -0,0 +1,71 @@
+package worktree
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// runGit runs git in dir with extra environment and returns its output
+func runGit(t *testing.T, dir string, env []string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-c", "user.name=Someone", "-c", "user.email=someone@example.com"}, args...)...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), env...)
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+	return strings.TrimSpace(string(output))
+}
+
+func TestInstallCommitHook(t *testing.T) {
+	ctx := context.Background()
+	repo := t.TempDir()
+	runGit(t, repo, nil, "init", "-q", "-b", "main")
+	runGit(t, repo, nil, "commit", "-q", "--allow-empty", "-m", "Initial")
+	tree := filepath.Join(t.TempDir(), "tree")
+	runGit(t, repo, nil, "worktree", "add", "-q", tree, "-b", "feat/x")
+
+	require.NoError(t, InstallCommitHook(ctx, tree))
+	require.NoError(t, InstallCommitHook(ctx, tree), "installing again replaces its own hook")
+	require.FileExists(t, filepath.Join(repo, ".git", "hooks", "prepare-commit-msg"), "worktrees share the repository's hooks")
+
+	taskEnv := []string{"CO_WORK_ID=w-abc", "CO_TASK_ID=w-abc.2"}
+	runGit(t, tree, taskEnv, "commit", "-q", "--allow-empty", "-m", "Fix the thing")
+	require.Equal(t, "Fix the thing\n\nCo-Orchestrated-By: co w-abc/w-abc.2", runGit(t, tree, nil, "log", "-1", "--format=%B"))
+	runGit(t, tree, taskEnv, "commit", "-q", "--amend", "--allow-empty", "--no-edit")
+	require.Equal(t, 1, strings.Count(runGit(t, tree, nil, "log", "-1", "--format=%B"), "Co-Orchestrated-By"), "amending doesn't add it twice")
+
+	// Commits made outside a task are left alone
+	runGit(t, tree, nil, "commit", "-q", "--allow-empty", "-m", "By hand")
+	require.Equal(t, "By hand", runGit(t, tree, nil, "log", "-1", "--format=%B"))
+
+	// A hook of the user's own is kept
+	other := t.TempDir()
+	runGit(t, other, nil, "init", "-q")
+	hook := filepath.Join(other, ".git", "hooks", "prepare-commit-msg")
+	require.NoError(t, os.WriteFile(hook, []byte("#!/bin/sh\nexit 0\n"), 0o755))
+	require.ErrorContains(t, InstallCommitHook(ctx, other), "already exists")
+
+	// Hooks kept in the repository would be committed
+	runGit(t, other, nil, "config", "core.hooksPath", ".githooks")
+	require.ErrorContains(t, InstallCommitHook(ctx, other), "would be committed")
+}
+
+func TestSetup_InstallsCommitHook(t *testing.T) {
+	repo := t.TempDir()
+	runGit(t, repo, nil, "init", "-q")
+
+	result := Setup(context.Background(), repo, repo, SetupOptions{CommitHook: true})
+	require.Empty(t, result.Warnings)
+	require.FileExists(t, filepath.Join(repo, ".git", "hooks", "prepare-commit-msg"))
+
+	// Outside a repository the hook can't go anywhere
+	result = Setup(context.Background(), repo, t.TempDir(), SetupOptions{CommitHook: true})
+	require.Len(t, result.Warnings, 1)
+	require.Contains(t, result.Warnings[0], "commit trailer hook not installed")
+}
@@ -0,0 +1,160 @@
+package worktree
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/newhook/co/internal/managed"
+)
+
+// excludeMarker heads the entries co adds to a repository's info/exclude
+const excludeMarker = "# co-managed paths, added by co"
+
+// guardHookMarker identifies the pre-commit hook installed by co, so it can
+// be replaced by a newer version but a user's own hook is left alone
+const guardHookMarker = "# Installed by co: keeps co-managed files out of orchestrated task commits"
+
+// guardHookScript returns the pre-commit hook that refuses commits made with
+// CO_TASK_ID set, which only tasks run by the orchestrator have, when they
+// stage any managed.Patterns path. It lists the paths it refused.
+func guardHookScript() string {
+	var cases []string
+	for _, pattern := range managed.Patterns {
+		if dir, ok := strings.CutSuffix(pattern, "/"); ok {
+			cases = append(cases, dir+"/*", "*/"+dir+"/*")
+		} else {
+			cases = append(cases, pattern, "*/"+pattern)
+		}
+	}
+	return `#!/bin/sh
+` + guardHookMarker + `
+[ -n "$CO_TASK_ID" ] || exit 0
+refused=$(git diff --cached --name-only --diff-filter=ACMR | while IFS= read -r path; do
+	case "$path" in ` + strings.Join(cases, "|") + `) echo "$path" ;; esac
+done)
+[ -z "$refused" ] && exit 0
+echo "co: refusing to commit co-managed files:" >&2
+echo "$refused" | sed 's/^/  /' >&2
+echo "Unstage them with git restore --staged <path> and commit again." >&2
+exit 1
+`
+}
+
+// InstallGuardHook installs the pre-commit hook that keeps co-managed files
+// out of commits made by orchestrated tasks. Like InstallCommitHook, it
+// refuses to replace a hook it didn't install.
+func InstallGuardHook(ctx context.Context, worktreePath string) error {
+	return installHook(ctx, worktreePath, guardHook())
+}
+
+// guardHook is the hook InstallGuardHook installs
+func guardHook() Hook {
+	return Hook{Name: "pre-commit", Marker: guardHookMarker, Script: guardHookScript()}
+}
+
+// EnsureExcludes adds the managed.Patterns missing from the info/exclude
+// file the worktree uses, so git status and git add -A pass over co's files.
+func EnsureExcludes(ctx context.Context, worktreePath string) error {
+	cmd := exec.CommandContext(ctx, "git", "-C", worktreePath, "rev-parse", "--git-path", "info/exclude")
+	output, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("failed to find the exclude file: %w", err)
+	}
+	path := strings.TrimSpace(string(output))
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(worktreePath, path)
+	}
+
+	existing, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	present := make(map[string]bool)
+	for _, line := range strings.Split(string(existing), "\n") {
+		present[strings.TrimSpace(line)] = true
+	}
+	var missing []string
+	for _, pattern := range managed.Patterns {
+		if !present[pattern] {
+			missing = append(missing, pattern)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	var b strings.Builder
+	b.Write(existing)
+	if len(existing) > 0 && !strings.HasSuffix(string(existing), "\n") {
+		b.WriteString("\n")
+	}
+	if !present[excludeMarker] {
+		b.WriteString(excludeMarker + "\n")
+	}
+	for _, pattern := range missing {
+		b.WriteString(pattern + "\n")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
+
+// ManagedChanges returns the co-managed paths a task put in the worktree's
+// history since base, the commit it started from, along with any it left
+// staged. The pre-commit hook normally stops them; this catches commits made
+// around it. An empty base checks the staged paths only.
+func ManagedChanges(ctx context.Context, worktreePath, base string) ([]string, error) {
+	var paths []string
+	if base != "" {
+		committed, err := gitNames(ctx, worktreePath, "diff", "--name-only", "--diff-filter=ACMR", base, "HEAD")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list committed files: %w", err)
+		}
+		paths = append(paths, committed...)
+	}
+	staged, err := gitNames(ctx, worktreePath, "diff", "--cached", "--name-only", "--diff-filter=ACMR")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list staged files: %w", err)
+	}
+	seen := make(map[string]bool)
+	var changed []string
+	for _, p := range managed.Filter(append(paths, staged...)) {
+		if !seen[p] {
+			seen[p] = true
+			changed = append(changed, p)
+		}
+	}
+	return changed, nil
+}
+
+// HeadCommit returns the commit the worktree has checked out, or "" if it
+// has none yet.
+func HeadCommit(ctx context.Context, worktreePath string) string {
+	cmd := exec.CommandContext(ctx, "git", "-C", worktreePath, "rev-parse", "--verify", "-q", "HEAD")
+	output, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// gitNames runs git in dir and returns the non-empty lines of its output
+func gitNames(ctx context.Context, dir string, args ...string) ([]string, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, line := range strings.Split(string(output), "\n") {
+		if line != "" {
+			names = append(names, line)
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,110 @@
+package worktree
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/newhook/co/internal/managed"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestWorktree creates a repository with one commit and a worktree of it
+func newTestWorktree(t *testing.T) (repo, tree string) {
+	t.Helper()
+	repo = t.TempDir()
+	runGit(t, repo, nil, "init", "-q", "-b", "main")
+	runGit(t, repo, nil, "commit", "-q", "--allow-empty", "-m", "Initial")
+	tree = filepath.Join(t.TempDir(), "tree")
+	runGit(t, repo, nil, "worktree", "add", "-q", tree, "-b", "feat/x")
+	return repo, tree
+}
+
+func TestEnsureExcludes(t *testing.T) {
+	ctx := context.Background()
+	repo, tree := newTestWorktree(t)
+
+	require.NoError(t, EnsureExcludes(ctx, tree))
+	require.NoError(t, EnsureExcludes(ctx, tree), "running again adds nothing")
+	exclude, err := os.ReadFile(filepath.Join(repo, ".git", "info", "exclude"))
+	require.NoError(t, err)
+	for _, pattern := range managed.Patterns {
+		require.Equal(t, 1, strings.Count(string(exclude), "\n"+pattern+"\n"), pattern)
+	}
+
+	// git add -A passes over co's files
+	require.NoError(t, os.MkdirAll(filepath.Join(tree, ".co", "tasks"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(tree, ".co", "tasks", "review.md"), []byte("x"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tree, "main.go"), []byte("package main\n"), 0o644))
+	runGit(t, tree, nil, "add", "-A")
+	require.Equal(t, "main.go", runGit(t, tree, nil, "diff", "--cached", "--name-only"))
+}
+
+func TestInstallGuardHook(t *testing.T) {
+	ctx := context.Background()
+	repo, tree := newTestWorktree(t)
+
+	require.NoError(t, InstallGuardHook(ctx, tree))
+	require.NoError(t, InstallGuardHook(ctx, tree), "installing again replaces its own hook")
+	require.FileExists(t, filepath.Join(repo, ".git", "hooks", "pre-commit"))
+
+	require.NoError(t, os.MkdirAll(filepath.Join(tree, "sub", ".co"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(tree, "sub", ".co", "tracking.db"), []byte("x"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(tree, "main.go"), []byte("package main\n"), 0o644))
+	runGit(t, tree, nil, "add", "-f", "sub/.co/tracking.db", "main.go")
+
+	// A task's commit is refused, naming the managed file
+	cmd := exec.Command("git", "-c", "user.name=Someone", "-c", "user.email=someone@example.com", "commit", "-q", "-m", "Oops")
+	cmd.Dir = tree
+	cmd.Env = append(os.Environ(), "CO_TASK_ID=w-abc.1")
+	output, err := cmd.CombinedOutput()
+	require.Error(t, err)
+	require.Contains(t, string(output), "sub/.co/tracking.db")
+	require.NotContains(t, string(output), "main.go")
+
+	// Once unstaged, it goes through
+	runGit(t, tree, nil, "restore", "--staged", "sub/.co/tracking.db")
+	runGit(t, tree, []string{"CO_TASK_ID=w-abc.1"}, "commit", "-q", "-m", "Add main")
+
+	// Commits made outside a task are left alone
+	runGit(t, tree, nil, "add", "-f", "sub/.co/tracking.db")
+	runGit(t, tree, nil, "commit", "-q", "-m", "By hand")
+
+	// A hook of the user's own is kept
+	hook := filepath.Join(repo, ".git", "hooks", "pre-commit")
+	require.NoError(t, os.WriteFile(hook, []byte("#!/bin/sh\nexit 0\n"), 0o755))
+	require.ErrorContains(t, InstallGuardHook(ctx, tree), "already exists")
+}
+
+func TestManagedChanges(t *testing.T) {
+	ctx := context.Background()
+	_, tree := newTestWorktree(t)
+	base := HeadCommit(ctx, tree)
+	require.NotEmpty(t, base)
+
+	changed, err := ManagedChanges(ctx, tree, base)
+	require.NoError(t, err)
+	require.Empty(t, changed)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(tree, ".co"), 0o755))
+	for _, name := range []string{".co/config.toml", "tui-draft.json", "main.go"} {
+		require.NoError(t, os.WriteFile(filepath.Join(tree, name), []byte("x"), 0o644))
+	}
+	runGit(t, tree, nil, "add", ".co/config.toml", "main.go")
+	runGit(t, tree, nil, "commit", "-q", "-m", "Commit config")
+	runGit(t, tree, nil, "add", "tui-draft.json")
+
+	changed, err = ManagedChanges(ctx, tree, base)
+	require.NoError(t, err)
+	require.Equal(t, []string{".co/config.toml", "tui-draft.json"}, changed)
+
+	// Without a base only the staged files count
+	changed, err = ManagedChanges(ctx, tree, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"tui-draft.json"}, changed)
+
+	require.Empty(t, HeadCommit(ctx, t.TempDir()))
+}
@@ -0,0 +1,155 @@
+package worktree
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// SetupOptions configures the setup applied to a freshly created worktree.
+type SetupOptions struct {
+	// CopyFiles are paths relative to the repository root copied from the
+	// main repository into the worktree. Directories are copied recursively.
+	CopyFiles []string
+	// PostCreate is a shell command run in the worktree after copying.
+	PostCreate string
+	// Env is extra environment for PostCreate, as KEY=value pairs.
+	Env []string
+	// CommitHook installs the hook that adds the Co-Orchestrated-By trailer
+	// to commits made by tasks.
+	CommitHook bool
+	// ProtectManaged adds the co-managed paths to the worktree's info/exclude
+	// and installs the pre-commit hook that keeps them out of task commits.
+	ProtectManaged bool
+}
+
+// SetupResult reports what Setup did.
+type SetupResult struct {
+	Copied   []string // CopyFiles entries that were copied
+	Output   string   // Combined output of the post-create command
+	Warnings []string // Problems that didn't stop the setup
+}
+
+// Setup protects the co-managed paths, installs the commit trailer hook,
+// copies the configured files from repoPath into worktreePath and runs the
+// post-create command. Nothing it does is fatal to the worktree, so
+// failures are collected as warnings instead of being returned as errors.
+func Setup(ctx context.Context, repoPath, worktreePath string, opts SetupOptions) *SetupResult {
+	result := &SetupResult{}
+	if opts.ProtectManaged {
+		if err := EnsureExcludes(ctx, worktreePath); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("co-managed paths not excluded: %v", err))
+		}
+		if err := InstallGuardHook(ctx, worktreePath); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("co-managed files pre-commit hook not installed: %v", err))
+		}
+	}
+	if opts.CommitHook {
+		if err := InstallCommitHook(ctx, worktreePath); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("commit trailer hook not installed: %v", err))
+		}
+	}
+	for _, rel := range opts.CopyFiles {
+		if err := copyIntoWorktree(repoPath, worktreePath, rel); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("copy %s: %v", rel, err))
+			continue
+		}
+		result.Copied = append(result.Copied, rel)
+	}
+
+	if strings.TrimSpace(opts.PostCreate) == "" {
+		return result
+	}
+	cmd := exec.CommandContext(ctx, "sh", "-c", opts.PostCreate)
+	cmd.Dir = worktreePath
+	cmd.Env = append(os.Environ(), opts.Env...)
+	cmd.Env = append(cmd.Env, "CO_WORKTREE_PATH="+worktreePath, "CO_MAIN_REPO_PATH="+repoPath)
+	output, err := cmd.CombinedOutput()
+	result.Output = string(output)
+	if err != nil {
+		warning := fmt.Sprintf("post_create %q failed: %v", opts.PostCreate, err)
+		if out := strings.TrimSpace(result.Output); out != "" {
+			warning += "\n" + lastLines(out, 10)
+		}
+		result.Warnings = append(result.Warnings, warning)
+	}
+	return result
+}
+
+// copyIntoWorktree copies rel from the main repository to the same place in
+// the worktree. It refuses paths outside the repository and never replaces a
+// file the worktree already has, since that one is tracked by git.
+func copyIntoWorktree(repoPath, worktreePath, rel string) error {
+	clean := filepath.Clean(rel)
+	if filepath.IsAbs(clean) || clean == "." || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("path must be inside the repository")
+	}
+	src := filepath.Join(repoPath, clean)
+	dst := filepath.Join(worktreePath, clean)
+
+	info, err := os.Stat(src)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("not found in %s", repoPath)
+	}
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return copyFile(src, dst, info.Mode().Perm())
+	}
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, strings.TrimPrefix(path, src))
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		if !d.Type().IsRegular() {
+			// Symlinks and other special files are left out
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return copyFile(path, target, info.Mode().Perm())
+	})
+}
+
+func copyFile(src, dst string, perm fs.FileMode) error {
+	if _, err := os.Lstat(dst); err == nil {
+		return fmt.Errorf("%s already exists in the worktree", filepath.Base(dst))
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// lastLines returns the last n lines of s.
+func lastLines(s string, n int) string {
+	lines := strings.Split(s, "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,69 @@
+package worktree
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetup_CopiesFiles(t *testing.T) {
+	repo := t.TempDir()
+	tree := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(repo, ".env"), []byte("KEY=1\n"), 0600))
+	writeFile(t, filepath.Join(repo, "config", "local", "a.yaml"), 3)
+	writeFile(t, filepath.Join(repo, "tracked.txt"), 5)
+	writeFile(t, filepath.Join(tree, "tracked.txt"), 1)
+
+	result := Setup(context.Background(), repo, tree, SetupOptions{
+		CopyFiles: []string{".env", "config/local", "tracked.txt", "missing.env", "../outside"},
+	})
+
+	require.Equal(t, []string{".env", "config/local"}, result.Copied)
+	require.Len(t, result.Warnings, 3)
+	require.Contains(t, result.Warnings[0], "already exists")
+	require.Contains(t, result.Warnings[1], "not found")
+	require.Contains(t, result.Warnings[2], "inside the repository")
+
+	data, err := os.ReadFile(filepath.Join(tree, ".env"))
+	require.NoError(t, err)
+	require.Equal(t, "KEY=1\n", string(data))
+	info, err := os.Stat(filepath.Join(tree, ".env"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm(), "the mode is kept")
+	require.FileExists(t, filepath.Join(tree, "config", "local", "a.yaml"))
+
+	// The worktree's own copy isn't replaced
+	info, err = os.Stat(filepath.Join(tree, "tracked.txt"))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), info.Size())
+}
+
+func TestSetup_RunsPostCreate(t *testing.T) {
+	repo := t.TempDir()
+	tree := t.TempDir()
+
+	result := Setup(context.Background(), repo, tree, SetupOptions{
+		PostCreate: `echo "$CO_WORKTREE_PATH $CO_MAIN_REPO_PATH $EXTRA" > out.txt; echo done`,
+		Env:        []string{"EXTRA=yes"},
+	})
+	require.Empty(t, result.Warnings)
+	require.Equal(t, "done\n", result.Output)
+
+	data, err := os.ReadFile(filepath.Join(tree, "out.txt"))
+	require.NoError(t, err, "the command runs in the worktree")
+	require.Equal(t, tree+" "+repo+" yes\n", string(data))
+}
+
+func TestSetup_PostCreateFailureIsAWarning(t *testing.T) {
+	result := Setup(context.Background(), t.TempDir(), t.TempDir(), SetupOptions{
+		PostCreate: "echo installing; echo broken >&2; exit 3",
+	})
+	require.Len(t, result.Warnings, 1)
+	require.True(t, strings.HasPrefix(result.Warnings[0], `post_create "echo installing; echo broken >&2; exit 3" failed`))
+	require.Contains(t, result.Warnings[0], "broken")
+	require.Contains(t, result.Output, "installing")
+}
@@ -0,0 +1,186 @@
+package worktree
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultMeasureMaxEntries caps how many directory entries Measure visits, so a
+// worktree with a huge dependency tree can't keep a measurement running forever.
+const DefaultMeasureMaxEntries = 500_000
+
+// MeasureTimeout bounds how long measuring a single worktree may take; a
+// slower worktree reports a truncated lower bound.
+const MeasureTimeout = 30 * time.Second
+
+// Usage is the disk usage of a directory tree.
+type Usage struct {
+	Bytes int64
+	// Truncated is set when the walk stopped at the entry cap or because the
+	// context ended, in which case Bytes is a lower bound.
+	Truncated bool
+}
+
+// String formats the usage, marking truncated measurements with a "+".
+func (u Usage) String() string {
+	if u.Truncated {
+		return FormatBytes(u.Bytes) + "+"
+	}
+	return FormatBytes(u.Bytes)
+}
+
+// Measure sums the sizes of the regular files under path without following
+// symlinks. It stops early, returning a truncated Usage, once maxEntries
+// entries have been visited (0 means DefaultMeasureMaxEntries) or ctx ends.
+func Measure(ctx context.Context, path string, maxEntries int) (Usage, error) {
+	if maxEntries <= 0 {
+		maxEntries = DefaultMeasureMaxEntries
+	}
+	var usage Usage
+	entries := 0
+	err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if p == path {
+				return err
+			}
+			// Unreadable entries are skipped rather than failing the whole walk
+			return nil
+		}
+		entries++
+		if entries > maxEntries || ctx.Err() != nil {
+			usage.Truncated = true
+			return filepath.SkipAll
+		}
+		if d.Type().IsRegular() {
+			if info, err := d.Info(); err == nil {
+				usage.Bytes += info.Size()
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return Usage{}, fmt.Errorf("failed to measure %s: %w", path, err)
+	}
+	return usage, nil
+}
+
+// Artifact is a build artifact found under a worktree.
+type Artifact struct {
+	Path  string
+	Usage Usage
+}
+
+// FindArtifacts returns the files and directories under root, a git
+// worktree, whose name matches one of patterns (filepath.Match syntax against
+// the base name). A pattern ending in "/" only matches directories. Only
+// untracked and ignored paths are artifacts: a match that git tracks, or a
+// directory holding anything it tracks, is source and is passed over.
+// Matched directories are not searched further, and .git is never searched.
+func FindArtifacts(ctx context.Context, root string, patterns []string) ([]Artifact, error) {
+	tracked, err := trackedPaths(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	var artifacts []Artifact
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if p == root {
+				return err
+			}
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if p == root {
+			return nil
+		}
+		if d.IsDir() && d.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if !matchesArtifact(d, patterns) {
+			return nil
+		}
+		if rel, err := filepath.Rel(root, p); err != nil || tracked[filepath.ToSlash(rel)] {
+			return nil
+		}
+		usage, err := Measure(ctx, p, 0)
+		if err != nil {
+			return err
+		}
+		artifacts = append(artifacts, Artifact{Path: p, Usage: usage})
+		if d.IsDir() {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find artifacts in %s: %w", root, err)
+	}
+	return artifacts, nil
+}
+
+// trackedPaths returns the paths under root that git tracks, relative to
+// root with forward slashes: the tracked files and every directory holding
+// one.
+func trackedPaths(ctx context.Context, root string) (map[string]bool, error) {
+	output, err := exec.CommandContext(ctx, "git", "-C", root, "ls-files", "-z", "--cached").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the files git tracks in %s: %w", root, err)
+	}
+	tracked := make(map[string]bool)
+	for _, file := range strings.Split(string(output), "\x00") {
+		// A directory already seen has had its parents added too
+		for p := file; p != "" && p != "." && !tracked[p]; p = path.Dir(p) {
+			tracked[p] = true
+		}
+	}
+	return tracked, nil
+}
+
+// matchesArtifact reports whether d's name matches one of patterns.
+func matchesArtifact(d fs.DirEntry, patterns []string) bool {
+	for _, pattern := range patterns {
+		dirOnly := strings.HasSuffix(pattern, "/")
+		if dirOnly && !d.IsDir() {
+			continue
+		}
+		if ok, _ := filepath.Match(strings.TrimSuffix(pattern, "/"), d.Name()); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveArtifacts deletes the given artifacts and returns how many bytes were freed.
+func RemoveArtifacts(artifacts []Artifact) (int64, error) {
+	var freed int64
+	for _, a := range artifacts {
+		if err := os.RemoveAll(a.Path); err != nil {
+			return freed, fmt.Errorf("failed to remove %s: %w", a.Path, err)
+		}
+		freed += a.Usage.Bytes
+	}
+	return freed, nil
+}
+
+// FormatBytes formats n with binary units, e.g. "1.2 GiB".
+func FormatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit && exp < 4; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTP"[exp])
+}
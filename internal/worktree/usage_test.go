@@ -0,0 +1,94 @@
+package worktree
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, path string, size int) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, make([]byte, size), 0644))
+}
+
+func TestMeasure(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "a.txt"), 100)
+	writeFile(t, filepath.Join(root, "sub", "b.txt"), 50)
+	require.NoError(t, os.Symlink(filepath.Join(root, "a.txt"), filepath.Join(root, "link")))
+
+	usage, err := Measure(context.Background(), root, 0)
+	require.NoError(t, err)
+	require.Equal(t, Usage{Bytes: 150}, usage, "symlinks aren't followed")
+
+	// The entry cap truncates the walk
+	usage, err = Measure(context.Background(), root, 2)
+	require.NoError(t, err)
+	require.True(t, usage.Truncated)
+
+	// So does a cancelled context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	usage, err = Measure(ctx, root, 0)
+	require.NoError(t, err)
+	require.True(t, usage.Truncated)
+
+	_, err = Measure(context.Background(), filepath.Join(root, "missing"), 0)
+	require.Error(t, err)
+}
+
+func TestFindAndRemoveArtifacts(t *testing.T) {
+	root := t.TempDir()
+	runGit(t, root, nil, "init", "-q")
+	writeFile(t, filepath.Join(root, "node_modules", "pkg", "index.js"), 300)
+	writeFile(t, filepath.Join(root, "web", "node_modules", "x.js"), 200)
+	writeFile(t, filepath.Join(root, "target", "app"), 100)
+	writeFile(t, filepath.Join(root, "src", "target"), 10) // a file, not a build dir
+	writeFile(t, filepath.Join(root, ".git", "node_modules", "y"), 10)
+	writeFile(t, filepath.Join(root, "main.go"), 10)
+	// Source that happens to have an artifact's name
+	writeFile(t, filepath.Join(root, "dist", "index.html"), 20)
+	writeFile(t, filepath.Join(root, "lib", "dist"), 5)
+	writeFile(t, filepath.Join(root, "web", "dist", "app.js"), 40) // untracked build output
+	runGit(t, root, nil, "add", "main.go", "src", "dist", "lib")
+
+	artifacts, err := FindArtifacts(context.Background(), root, []string{"node_modules", "target/", "dist"})
+	require.NoError(t, err)
+
+	got := make(map[string]int64)
+	for _, a := range artifacts {
+		rel, err := filepath.Rel(root, a.Path)
+		require.NoError(t, err)
+		got[rel] = a.Usage.Bytes
+	}
+	require.Equal(t, map[string]int64{
+		"node_modules":                       300,
+		filepath.Join("web", "node_modules"): 200,
+		"target":                             100,
+		filepath.Join("web", "dist"):         40,
+	}, got)
+
+	freed, err := RemoveArtifacts(artifacts)
+	require.NoError(t, err)
+	require.Equal(t, int64(640), freed)
+	require.NoDirExists(t, filepath.Join(root, "node_modules"))
+	require.FileExists(t, filepath.Join(root, "src", "target"))
+	require.FileExists(t, filepath.Join(root, "main.go"))
+	require.FileExists(t, filepath.Join(root, "dist", "index.html"), "tracked files are never removed")
+	require.FileExists(t, filepath.Join(root, "lib", "dist"))
+
+	// Outside a git worktree there's no telling source from artifacts
+	_, err = FindArtifacts(context.Background(), t.TempDir(), []string{"dist"})
+	require.Error(t, err)
+}
+
+func TestFormatBytes(t *testing.T) {
+	require.Equal(t, "512 B", FormatBytes(512))
+	require.Equal(t, "1.5 KiB", FormatBytes(1536))
+	require.Equal(t, "1.2 GiB", FormatBytes(1288490189))
+	require.Equal(t, "2.0 MiB+", Usage{Bytes: 2 << 20, Truncated: true}.String())
+}
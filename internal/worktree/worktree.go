@@ -31,6 +31,11 @@ type Operations interface {
 	List(ctx context.Context, repoPath string) ([]Worktree, error)
 	// ExistsPath checks if the worktree path exists on disk.
 	ExistsPath(worktreePath string) bool
+	// Prune forgets worktrees whose directories no longer exist.
+	Prune(ctx context.Context, repoPath string) error
+	// Repair reconnects a worktree that was moved with the repository at
+	// repoPath, in both directions.
+	Repair(ctx context.Context, repoPath, worktreePath string) error
 }
 
 // CLIOperations implements Operations using the git CLI.
@@ -76,6 +81,24 @@ func (c *CLIOperations) RemoveForce(ctx context.Context, repoPath, worktreePath
 	return nil
 }
 
+// Prune implements Operations.Prune.
+func (c *CLIOperations) Prune(ctx context.Context, repoPath string) error {
+	cmd := exec.CommandContext(ctx, "git", "-C", repoPath, "worktree", "prune")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to prune worktrees: %w\n%s", err, output)
+	}
+	return nil
+}
+
+// Repair implements Operations.Repair.
+func (c *CLIOperations) Repair(ctx context.Context, repoPath, worktreePath string) error {
+	cmd := exec.CommandContext(ctx, "git", "-C", repoPath, "worktree", "repair", worktreePath)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to repair worktree: %w\n%s", err, output)
+	}
+	return nil
+}
+
 // List implements Operations.List.
 func (c *CLIOperations) List(ctx context.Context, repoPath string) ([]Worktree, error) {
 	cmd := exec.CommandContext(ctx, "git", "-C", repoPath, "worktree", "list", "--porcelain")
@@ -30,9 +30,15 @@ var _ Operations = &WorktreeOperationsMock{}
 //			ListFunc: func(ctx context.Context, repoPath string) ([]Worktree, error) {
 //				panic("mock out the List method")
 //			},
+//			PruneFunc: func(ctx context.Context, repoPath string) error {
+//				panic("mock out the Prune method")
+//			},
 //			RemoveForceFunc: func(ctx context.Context, repoPath string, worktreePath string) error {
 //				panic("mock out the RemoveForce method")
 //			},
+//			RepairFunc: func(ctx context.Context, repoPath string, worktreePath string) error {
+//				panic("mock out the Repair method")
+//			},
 //		}
 //
 //		// use mockedOperations in code that requires Operations
@@ -52,9 +58,15 @@ type WorktreeOperationsMock struct {
 	// ListFunc mocks the List method.
 	ListFunc func(ctx context.Context, repoPath string) ([]Worktree, error)
 
+	// PruneFunc mocks the Prune method.
+	PruneFunc func(ctx context.Context, repoPath string) error
+
 	// RemoveForceFunc mocks the RemoveForce method.
 	RemoveForceFunc func(ctx context.Context, repoPath string, worktreePath string) error
 
+	// RepairFunc mocks the Repair method.
+	RepairFunc func(ctx context.Context, repoPath string, worktreePath string) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// Create holds details about calls to the Create method.
@@ -93,6 +105,13 @@ type WorktreeOperationsMock struct {
 			// RepoPath is the repoPath argument value.
 			RepoPath string
 		}
+		// Prune holds details about calls to the Prune method.
+		Prune []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RepoPath is the repoPath argument value.
+			RepoPath string
+		}
 		// RemoveForce holds details about calls to the RemoveForce method.
 		RemoveForce []struct {
 			// Ctx is the ctx argument value.
@@ -102,12 +121,23 @@ type WorktreeOperationsMock struct {
 			// WorktreePath is the worktreePath argument value.
 			WorktreePath string
 		}
+		// Repair holds details about calls to the Repair method.
+		Repair []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RepoPath is the repoPath argument value.
+			RepoPath string
+			// WorktreePath is the worktreePath argument value.
+			WorktreePath string
+		}
 	}
 	lockCreate             sync.RWMutex
 	lockCreateFromExisting sync.RWMutex
 	lockExistsPath         sync.RWMutex
 	lockList               sync.RWMutex
+	lockPrune              sync.RWMutex
 	lockRemoveForce        sync.RWMutex
+	lockRepair             sync.RWMutex
 }
 
 // Create calls CreateFunc.
@@ -283,6 +313,45 @@ func (mock *WorktreeOperationsMock) ListCalls() []struct {
 	return calls
 }
 
+// Prune calls PruneFunc.
+func (mock *WorktreeOperationsMock) Prune(ctx context.Context, repoPath string) error {
+	callInfo := struct {
+		Ctx      context.Context
+		RepoPath string
+	}{
+		Ctx:      ctx,
+		RepoPath: repoPath,
+	}
+	mock.lockPrune.Lock()
+	mock.calls.Prune = append(mock.calls.Prune, callInfo)
+	mock.lockPrune.Unlock()
+	if mock.PruneFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.PruneFunc(ctx, repoPath)
+}
+
+// PruneCalls gets all the calls that were made to Prune.
+// Check the length with:
+//
+//	len(mockedOperations.PruneCalls())
+func (mock *WorktreeOperationsMock) PruneCalls() []struct {
+	Ctx      context.Context
+	RepoPath string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		RepoPath string
+	}
+	mock.lockPrune.RLock()
+	calls = mock.calls.Prune
+	mock.lockPrune.RUnlock()
+	return calls
+}
+
 // RemoveForce calls RemoveForceFunc.
 func (mock *WorktreeOperationsMock) RemoveForce(ctx context.Context, repoPath string, worktreePath string) error {
 	callInfo := struct {
@@ -325,3 +394,46 @@ func (mock *WorktreeOperationsMock) RemoveForceCalls() []struct {
 	mock.lockRemoveForce.RUnlock()
 	return calls
 }
+
+// Repair calls RepairFunc.
+func (mock *WorktreeOperationsMock) Repair(ctx context.Context, repoPath string, worktreePath string) error {
+	callInfo := struct {
+		Ctx          context.Context
+		RepoPath     string
+		WorktreePath string
+	}{
+		Ctx:          ctx,
+		RepoPath:     repoPath,
+		WorktreePath: worktreePath,
+	}
+	mock.lockRepair.Lock()
+	mock.calls.Repair = append(mock.calls.Repair, callInfo)
+	mock.lockRepair.Unlock()
+	if mock.RepairFunc == nil {
+		var (
+			errOut error
+		)
+		return errOut
+	}
+	return mock.RepairFunc(ctx, repoPath, worktreePath)
+}
+
+// RepairCalls gets all the calls that were made to Repair.
+// Check the length with:
+//
+//	len(mockedOperations.RepairCalls())
+func (mock *WorktreeOperationsMock) RepairCalls() []struct {
+	Ctx          context.Context
+	RepoPath     string
+	WorktreePath string
+} {
+	var calls []struct {
+		Ctx          context.Context
+		RepoPath     string
+		WorktreePath string
+	}
+	mock.lockRepair.RLock()
+	calls = mock.calls.Repair
+	mock.lockRepair.RUnlock()
+	return calls
+}
@@ -11,7 +11,46 @@ WHERE bead_id = ? AND description_hash = ?;
 SELECT bead_id, complexity_score, estimated_tokens
 FROM complexity_cache;
 
+-- name: ListComplexityEstimates :many
+SELECT bead_id, description_hash, complexity_score, estimated_tokens, created_at
+FROM complexity_cache
+ORDER BY bead_id;
+
 -- name: CountEstimatedBeads :one
 SELECT COUNT(DISTINCT bead_id) as count
 FROM complexity_cache
-WHERE bead_id IN (sqlc.slice('bead_ids'));
\ No newline at end of file
+WHERE bead_id IN (sqlc.slice('bead_ids'));
+
+-- name: GetComplexityStats :many
+-- Summarizes complexity budget against actual usage in a single pass.
+-- Each row has a kind: 'type' rows total each work's task types, 'overrun'
+-- rows are the five tasks per work that most exceeded budget, and 'histogram'
+-- rows count tasks per actual/budget bucket (bucket n covers n*25% up to
+-- (n+1)*25%; bucket 8 is 200% and above).
+WITH scored AS (
+    SELECT id, work_id, task_type, complexity_budget, actual_complexity,
+           ROW_NUMBER() OVER (
+               PARTITION BY work_id
+               ORDER BY actual_complexity - complexity_budget DESC, id
+           ) AS overrun_rank
+    FROM tasks
+    WHERE complexity_budget > 0 AND actual_complexity > 0
+)
+SELECT CAST('type' AS TEXT) as kind,
+       work_id,
+       task_type,
+       CAST('' AS TEXT) as task_id,
+       CAST(0 AS INTEGER) as bucket,
+       COUNT(*) as task_count,
+       CAST(SUM(complexity_budget) AS INTEGER) as total_budget,
+       CAST(SUM(actual_complexity) AS INTEGER) as total_actual
+FROM scored
+GROUP BY work_id, task_type
+UNION ALL
+SELECT 'overrun', work_id, task_type, id, 0, 1, complexity_budget, actual_complexity
+FROM scored
+WHERE overrun_rank <= 5 AND actual_complexity > complexity_budget
+UNION ALL
+SELECT 'histogram', work_id, '', '', MIN(actual_complexity * 4 / complexity_budget, 8), COUNT(*), 0, 0
+FROM scored
+GROUP BY work_id, MIN(actual_complexity * 4 / complexity_budget, 8);
@@ -16,6 +16,11 @@ UPDATE processes
 SET heartbeat = ?
 WHERE id = ?;
 
+-- name: SetProcessCurrentTask :exec
+UPDATE processes
+SET current_task = ?
+WHERE id = ?;
+
 -- name: GetProcess :one
 SELECT * FROM processes WHERE id = ?;
 
@@ -12,10 +12,21 @@ SELECT task_id
 FROM task_dependencies
 WHERE depends_on_task_id = ?;
 
+-- name: GetTaskDependenciesForWork :many
+SELECT td.task_id, td.depends_on_task_id
+FROM task_dependencies td
+INNER JOIN work_tasks wt ON td.task_id = wt.task_id
+WHERE wt.work_id = ?
+ORDER BY td.task_id, td.depends_on_task_id;
+
 -- name: DeleteTaskDependencies :execrows
 DELETE FROM task_dependencies
 WHERE task_id = ?;
 
+-- name: DeleteTaskDependents :execrows
+DELETE FROM task_dependencies
+WHERE depends_on_task_id = ?;
+
 -- name: DeleteTaskDependenciesForWork :execrows
 DELETE FROM task_dependencies
 WHERE task_id IN (
@@ -35,7 +46,8 @@ SELECT t.id, t.status,
        t.completed_at,
        t.created_at,
        t.spawned_at,
-       t.spawn_status
+       t.spawn_status,
+       t.failure_kind
 FROM tasks t
 INNER JOIN work_tasks wt ON t.id = wt.task_id
 WHERE wt.work_id = ?
@@ -24,6 +24,7 @@ WHERE id = ?;
 UPDATE tasks
 SET status = 'failed',
     error_message = ?,
+    failure_kind = ?,
     completed_at = ?
 WHERE id = ?;
 
@@ -31,7 +32,26 @@ WHERE id = ?;
 UPDATE tasks
 SET status = 'pending',
     started_at = NULL,
-    error_message = ''
+    error_message = '',
+    failure_kind = '',
+    claimed_by = '',
+    claimed_at = NULL
+WHERE id = ?;
+
+-- name: ClaimTask :execrows
+UPDATE tasks
+SET claimed_by = ?,
+    claimed_at = ?
+WHERE id = ? AND status = 'pending' AND claimed_by = ?;
+
+-- name: GetTaskClaimant :one
+SELECT claimed_by
+FROM tasks
+WHERE id = ?;
+
+-- name: GetTaskClaimedAt :one
+SELECT claimed_at
+FROM tasks
 WHERE id = ?;
 
 -- name: GetTask :one
@@ -47,7 +67,8 @@ SELECT id, status,
        completed_at,
        created_at,
        spawned_at,
-       spawn_status
+       spawn_status,
+       failure_kind
 FROM tasks
 WHERE id = ?;
 
@@ -61,6 +82,31 @@ SELECT task_id
 FROM task_beads
 WHERE bead_id = ?;
 
+-- name: GetTasksForBead :many
+SELECT t.id, t.status,
+       COALESCE(t.task_type, 'implement') as task_type,
+       t.complexity_budget,
+       t.actual_complexity,
+       t.work_id,
+       t.worktree_path,
+       t.pr_url,
+       t.error_message,
+       t.started_at,
+       t.completed_at,
+       t.created_at,
+       t.spawned_at,
+       t.spawn_status,
+       t.failure_kind
+FROM tasks t
+JOIN task_beads tb ON t.id = tb.task_id
+JOIN work_tasks wt ON t.id = wt.task_id
+WHERE wt.work_id = ? AND tb.bead_id = ?
+ORDER BY wt.position;
+
+-- name: DeleteTaskBead :execrows
+DELETE FROM task_beads
+WHERE task_id = ? AND bead_id = ?;
+
 -- name: CompleteTaskBead :execrows
 UPDATE task_beads
 SET status = 'completed'
@@ -90,7 +136,8 @@ SELECT id, status,
        completed_at,
        created_at,
        spawned_at,
-       spawn_status
+       spawn_status,
+       failure_kind
 FROM tasks
 ORDER BY created_at DESC;
 
@@ -107,7 +154,8 @@ SELECT id, status,
        completed_at,
        created_at,
        spawned_at,
-       spawn_status
+       spawn_status,
+       failure_kind
 FROM tasks
 WHERE status = ?
 ORDER BY created_at DESC;
@@ -171,6 +219,14 @@ FROM task_beads tb
 JOIN tasks t ON tb.task_id = t.id
 WHERE t.work_id = ?;
 
+-- name: GetBeadTaskRuns :many
+SELECT tb.task_id, tb.bead_id, t.started_at, t.completed_at
+FROM task_beads tb
+JOIN tasks t ON tb.task_id = t.id
+WHERE t.started_at IS NOT NULL
+  AND (CAST(sqlc.arg(work_id) AS TEXT) = '' OR t.work_id = sqlc.arg(work_id))
+ORDER BY tb.task_id, tb.bead_id;
+
 -- name: GetTasksWithActivity :many
 SELECT id, status,
        COALESCE(task_type, 'implement') as task_type,
@@ -203,7 +259,8 @@ SELECT id, status,
        completed_at,
        created_at,
        spawned_at,
-       spawn_status
+       spawn_status,
+       failure_kind
 FROM tasks
 WHERE work_id = ?
   AND task_type = 'pr'
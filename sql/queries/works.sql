@@ -67,7 +67,15 @@ SELECT id, status,
        last_pr_poll_at,
        has_unseen_pr_changes,
        pr_state,
-       mergeable_state
+       mergeable_state,
+       paused,
+       scheduled_run_at,
+       max_parallel_tasks,
+       notes,
+       env,
+       auto_pr,
+       setup_warnings,
+       remote_host
 FROM works
 WHERE id = ?;
 
@@ -92,7 +100,15 @@ SELECT id, status,
        last_pr_poll_at,
        has_unseen_pr_changes,
        pr_state,
-       mergeable_state
+       mergeable_state,
+       paused,
+       scheduled_run_at,
+       max_parallel_tasks,
+       notes,
+       env,
+       auto_pr,
+       setup_warnings,
+       remote_host
 FROM works
 ORDER BY created_at DESC;
 
@@ -117,7 +133,15 @@ SELECT id, status,
        last_pr_poll_at,
        has_unseen_pr_changes,
        pr_state,
-       mergeable_state
+       mergeable_state,
+       paused,
+       scheduled_run_at,
+       max_parallel_tasks,
+       notes,
+       env,
+       auto_pr,
+       setup_warnings,
+       remote_host
 FROM works
 WHERE status = ?
 ORDER BY created_at DESC;
@@ -148,7 +172,15 @@ SELECT id, status,
        last_pr_poll_at,
        has_unseen_pr_changes,
        pr_state,
-       mergeable_state
+       mergeable_state,
+       paused,
+       scheduled_run_at,
+       max_parallel_tasks,
+       notes,
+       env,
+       auto_pr,
+       setup_warnings,
+       remote_host
 FROM works
 WHERE worktree_path LIKE ?
 LIMIT 1;
@@ -170,7 +202,8 @@ SELECT t.id, t.status,
        t.completed_at,
        t.created_at,
        t.spawned_at,
-       t.spawn_status
+       t.spawn_status,
+       t.failure_kind
 FROM tasks t
 JOIN work_tasks wt ON t.id = wt.task_id
 WHERE wt.work_id = ?
@@ -222,6 +255,51 @@ UPDATE works
 SET has_unseen_pr_changes = ?
 WHERE id = ?;
 
+-- name: SetWorkNotes :execrows
+UPDATE works
+SET notes = ?
+WHERE id = ?;
+
+-- name: SetWorkEnv :execrows
+UPDATE works
+SET env = ?
+WHERE id = ?;
+
+-- name: SetWorkAutoPR :execrows
+UPDATE works
+SET auto_pr = ?
+WHERE id = ?;
+
+-- name: SetWorkRemoteHost :execrows
+UPDATE works
+SET remote_host = ?
+WHERE id = ?;
+
+-- name: SetWorkSetupWarnings :execrows
+UPDATE works
+SET setup_warnings = ?
+WHERE id = ?;
+
+-- name: SetWorkMaxParallelTasks :execrows
+UPDATE works
+SET max_parallel_tasks = ?
+WHERE id = ?;
+
+-- name: SetWorkPaused :execrows
+UPDATE works
+SET paused = ?
+WHERE id = ?;
+
+-- name: SetWorkScheduledRunAt :execrows
+UPDATE works
+SET scheduled_run_at = ?
+WHERE id = ?;
+
+-- name: GetWorksDueToRun :many
+SELECT id FROM works
+WHERE scheduled_run_at IS NOT NULL AND scheduled_run_at <= ?
+ORDER BY scheduled_run_at, created_at;
+
 -- name: MarkWorkPRSeen :execrows
 UPDATE works
 SET has_unseen_pr_changes = FALSE
@@ -248,7 +326,15 @@ SELECT id, status,
        last_pr_poll_at,
        has_unseen_pr_changes,
        pr_state,
-       mergeable_state
+       mergeable_state,
+       paused,
+       scheduled_run_at,
+       max_parallel_tasks,
+       notes,
+       env,
+       auto_pr,
+       setup_warnings,
+       remote_host
 FROM works
 WHERE has_unseen_pr_changes = TRUE
 ORDER BY created_at DESC;
@@ -274,7 +360,15 @@ SELECT id, status,
        last_pr_poll_at,
        has_unseen_pr_changes,
        pr_state,
-       mergeable_state
+       mergeable_state,
+       paused,
+       scheduled_run_at,
+       max_parallel_tasks,
+       notes,
+       env,
+       auto_pr,
+       setup_warnings,
+       remote_host
 FROM works
 WHERE pr_url != ''
 ORDER BY created_at DESC;